@@ -105,6 +105,8 @@ import (
 	btclightclienttypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	"github.com/babylonchain/babylon/x/btcstaking"
 	btcstakingtypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/btcstkconsumer"
+	bsctypes "github.com/babylonchain/babylon/x/btcstkconsumer/types"
 	"github.com/babylonchain/babylon/x/checkpointing"
 	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
 	"github.com/babylonchain/babylon/x/epoching"
@@ -155,6 +157,7 @@ var (
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		ibcfeetypes.ModuleName:         nil,
 		incentivetypes.ModuleName:      nil, // this line is needed to create an account for incentive module
+		btclightclienttypes.ModuleName: nil, // this line is needed to create an account for locking the bonds of BTC header reporters
 	}
 
 	// software upgrades and forks
@@ -318,6 +321,7 @@ func NewBabylonApp(
 		// Babylon modules - btc staking
 		btcstaking.NewAppModule(appCodec, app.BTCStakingKeeper),
 		finality.NewAppModule(appCodec, app.FinalityKeeper),
+		btcstkconsumer.NewAppModule(appCodec, app.BTCStkConsumerKeeper),
 		// Babylon modules - tokenomics
 		incentive.NewAppModule(appCodec, app.IncentiveKeeper, app.AccountKeeper, app.BankKeeper),
 	)
@@ -437,6 +441,7 @@ func NewBabylonApp(
 		// BTC staking related modules
 		btcstakingtypes.ModuleName,
 		finalitytypes.ModuleName,
+		bsctypes.ModuleName,
 		// tokenomics-related modules
 		incentivetypes.ModuleName,
 	}
@@ -539,6 +544,7 @@ func NewBabylonApp(
 	})
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
+	app.SetPrecommiter(app.Precommiter)
 	app.SetAnteHandler(anteHandler)
 
 	// set postHandler
@@ -594,6 +600,12 @@ func NewBabylonApp(
 		if err := app.WasmKeeper.InitializePinnedCodes(ctx); err != nil {
 			cmtos.Exit(fmt.Sprintf("failed initialize pinned codes %s", err))
 		}
+
+		// the node needs to be configured with the BTC network in genesis
+		// upon each start, not only upon the genesis of the chain
+		if err := app.BTCLightClientKeeper.ValidateBTCNetwork(ctx); err != nil {
+			cmtos.Exit(fmt.Sprintf("invalid BTC network config: %s", err))
+		}
 	}
 
 	return app
@@ -634,6 +646,13 @@ func (app *BabylonApp) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
 	return app.ModuleManager.EndBlock(ctx)
 }
 
+// Precommiter application updates before the commital of a block
+func (app *BabylonApp) Precommiter(ctx sdk.Context) {
+	if err := app.ModuleManager.Precommit(ctx); err != nil {
+		panic(err)
+	}
+}
+
 // InitChainer application update at chain initialization
 func (app *BabylonApp) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abci.ResponseInitChain, error) {
 	var genesisState GenesisState
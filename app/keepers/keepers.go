@@ -1,6 +1,7 @@
 package keepers
 
 import (
+	"fmt"
 	"path/filepath"
 
 	errorsmod "cosmossdk.io/errors"
@@ -17,6 +18,7 @@ import (
 	"github.com/CosmWasm/wasmd/x/wasm"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -60,6 +62,7 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types" // ibc module puts types under `ibchost` rather than `ibctypes`
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	"github.com/spf13/cast"
 
 	appparams "github.com/babylonchain/babylon/app/params"
 	bbn "github.com/babylonchain/babylon/types"
@@ -70,6 +73,8 @@ import (
 	btclightclienttypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	btcstakingkeeper "github.com/babylonchain/babylon/x/btcstaking/keeper"
 	btcstakingtypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	bsckeeper "github.com/babylonchain/babylon/x/btcstkconsumer/keeper"
+	bsctypes "github.com/babylonchain/babylon/x/btcstkconsumer/types"
 	checkpointingkeeper "github.com/babylonchain/babylon/x/checkpointing/keeper"
 	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
 	epochingkeeper "github.com/babylonchain/babylon/x/epoching/keeper"
@@ -136,8 +141,9 @@ type AppKeepers struct {
 	ZoneConciergeKeeper zckeeper.Keeper          // for cross-chain fungible token transfers
 
 	// BTC staking related modules
-	BTCStakingKeeper btcstakingkeeper.Keeper
-	FinalityKeeper   finalitykeeper.Keeper
+	BTCStakingKeeper     btcstakingkeeper.Keeper
+	FinalityKeeper       finalitykeeper.Keeper
+	BTCStkConsumerKeeper bsckeeper.Keeper
 
 	// wasm smart contract module
 	WasmKeeper wasmkeeper.Keeper
@@ -198,6 +204,7 @@ func (ak *AppKeepers) InitKeepers(
 		// BTC staking related modules
 		btcstakingtypes.StoreKey,
 		finalitytypes.StoreKey,
+		bsctypes.StoreKey,
 		// WASM
 		wasmtypes.StoreKey,
 		// tokenomics-related modules
@@ -436,6 +443,9 @@ func (ak *AppKeepers) InitKeepers(
 		appCodec,
 		runtime.NewKVStoreService(keys[btclightclienttypes.StoreKey]),
 		*btcConfig,
+		ak.BankKeeper,
+		&ak.IncentiveKeeper,
+		&ak.BtcCheckpointKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -476,6 +486,9 @@ func (ak *AppKeepers) InitKeepers(
 		&checkpointingKeeper,
 		&btcCheckpointKeeper,
 		epochingKeeper,
+		&ak.BTCStakingKeeper,
+		&ak.BTCStkConsumerKeeper,
+		&ak.FinalityKeeper,
 		storeQuerier,
 		scopedZoneConciergeKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -518,6 +531,15 @@ func (ak *AppKeepers) InitKeepers(
 		btclightclienttypes.NewMultiBTCLightClientHooks(ak.BtcCheckpointKeeper.Hooks()),
 	)
 
+	// set up BTC staking consumer keeper
+	ak.BTCStkConsumerKeeper = bsckeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[bsctypes.StoreKey]),
+		ak.IBCKeeper.ClientKeeper,
+		&ak.BTCStakingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// set up BTC staking keeper
 	ak.BTCStakingKeeper = btcstakingkeeper.NewKeeper(
 		appCodec,
@@ -525,9 +547,19 @@ func (ak *AppKeepers) InitKeepers(
 		&btclightclientKeeper,
 		&btcCheckpointKeeper,
 		&checkpointingKeeper,
+		&ak.IncentiveKeeper,
+		&ak.BTCStkConsumerKeeper,
 		btcNetParams,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	// optionally keep the pruned BTC delegations in a local archive
+	if cast.ToBool(appOpts.Get("btcstaking-config.archive-pruned-delegations")) {
+		archiveDB, err := dbm.NewGoLevelDB("btc_delegation_archive", filepath.Join(homePath, "data"), nil)
+		if err != nil {
+			panic(fmt.Errorf("failed to open the archive of pruned BTC delegations: %w", err))
+		}
+		ak.BTCStakingKeeper = *ak.BTCStakingKeeper.SetArchive(btcstakingkeeper.NewDBArchive(archiveDB, appCodec))
+	}
 
 	// set up finality keeper
 	ak.FinalityKeeper = finalitykeeper.NewKeeper(
@@ -535,6 +567,7 @@ func (ak *AppKeepers) InitKeepers(
 		runtime.NewKVStoreService(keys[finalitytypes.StoreKey]),
 		ak.BTCStakingKeeper,
 		ak.IncentiveKeeper,
+		storeQuerier,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	ak.BTCStakingKeeper = *ak.BTCStakingKeeper.SetHooks(btcstakingtypes.NewMultiBtcStakingHooks(ak.FinalityKeeper.Hooks()))
@@ -464,6 +464,67 @@ func SignTxWithOneScriptSpendInputFromTapLeaf(
 	)
 }
 
+// newPrevOutputFetcher returns a fetcher of the outputs spent by the inputs of
+// the given tx, where prevOutputs[i] is the output spent by the i-th input
+func newPrevOutputFetcher(tx *wire.MsgTx, prevOutputs []*wire.TxOut) (*txscript.MultiPrevOutFetcher, error) {
+	if len(prevOutputs) != len(tx.TxIn) {
+		return nil, fmt.Errorf("number of previous outputs (%d) does not match number of inputs (%d)", len(prevOutputs), len(tx.TxIn))
+	}
+
+	inputFetcher := txscript.NewMultiPrevOutFetcher(nil)
+	for i, txIn := range tx.TxIn {
+		if prevOutputs[i] == nil {
+			return nil, fmt.Errorf("previous output of input %d must not be nil", i)
+		}
+		inputFetcher.AddPrevOut(txIn.PreviousOutPoint, prevOutputs[i])
+	}
+	return inputFetcher, nil
+}
+
+// SignTxWithScriptSpendInput signs the given input of a transaction with
+// possibly several inputs, where the signed input is a script spend of the
+// output committing to the provided script.
+// prevOutputs are the outputs spent by the inputs of txToSign, in input order,
+// as all of them are committed to by the signature (SigHashDefault)
+func SignTxWithScriptSpendInput(
+	txToSign *wire.MsgTx,
+	prevOutputs []*wire.TxOut,
+	inputIdx int,
+	privKey *btcec.PrivateKey,
+	script []byte,
+) (*schnorr.Signature, error) {
+	if txToSign == nil {
+		return nil, fmt.Errorf("tx to sign must not be nil")
+	}
+
+	if privKey == nil {
+		return nil, fmt.Errorf("private key must not be nil")
+	}
+
+	if inputIdx < 0 || inputIdx >= len(txToSign.TxIn) {
+		return nil, fmt.Errorf("invalid input index %d, tx has %d inputs", inputIdx, len(txToSign.TxIn))
+	}
+
+	inputFetcher, err := newPrevOutputFetcher(txToSign, prevOutputs)
+	if err != nil {
+		return nil, err
+	}
+
+	sigHashes := txscript.NewTxSigHashes(txToSign, inputFetcher)
+
+	sig, err := txscript.RawTxInTapscriptSignature(
+		txToSign, sigHashes, inputIdx, prevOutputs[inputIdx].Value,
+		prevOutputs[inputIdx].PkScript, txscript.NewBaseTapLeaf(script), txscript.SigHashDefault,
+		privKey,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return schnorr.ParseSignature(sig)
+}
+
 // SignTxWithOneScriptSpendInputFromScript signs transaction with one input coming
 // from script spend output with provided script.
 // It does not do any validations, expect that txToSign has exactly one input.
@@ -633,6 +694,58 @@ func VerifyTransactionSigWithOutput(
 	return nil
 }
 
+// VerifyTransactionSigWithPrevOutputs verifies that:
+// - provided signature is valid schnorr BIP340 signature
+// - provided signature is signing the given input of the whole provided
+// transaction (SigHashDefault), which spends the given previous outputs
+func VerifyTransactionSigWithPrevOutputs(
+	transaction *wire.MsgTx,
+	prevOutputs []*wire.TxOut,
+	inputIdx int,
+	script []byte,
+	pubKey *btcec.PublicKey,
+	signature []byte) error {
+
+	if transaction == nil {
+		return fmt.Errorf("tx to verify not be nil")
+	}
+
+	if inputIdx < 0 || inputIdx >= len(transaction.TxIn) {
+		return fmt.Errorf("invalid input index %d, tx has %d inputs", inputIdx, len(transaction.TxIn))
+	}
+
+	if pubKey == nil {
+		return fmt.Errorf("public key must not be nil")
+	}
+
+	inputFetcher, err := newPrevOutputFetcher(transaction, prevOutputs)
+	if err != nil {
+		return err
+	}
+
+	sigHashes := txscript.NewTxSigHashes(transaction, inputFetcher)
+
+	sigHash, err := txscript.CalcTapscriptSignaturehash(
+		sigHashes, txscript.SigHashDefault, transaction, inputIdx, inputFetcher, txscript.NewBaseTapLeaf(script),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	parsedSig, err := schnorr.ParseSignature(signature)
+
+	if err != nil {
+		return err
+	}
+
+	if !parsedSig.Verify(sigHash, pubKey) {
+		return fmt.Errorf("signature is not valid")
+	}
+
+	return nil
+}
+
 // EncVerifyTransactionSigWithOutput verifies that:
 // - provided transaction has exactly one input
 // - provided signature is valid adaptor signature
@@ -249,6 +249,70 @@ func FuzzGeneratingSignatureValidation(f *testing.F) {
 	})
 }
 
+// genTaprootPkScript generates a random taproot output script, i.e., a
+// segwit v1 output script with a random 32-byte witness program
+func genTaprootPkScript(r *rand.Rand) []byte {
+	return append([]byte{txscript.OP_1, txscript.OP_DATA_32}, datagen.GenRandomByteArray(r, 32)...)
+}
+
+func FuzzGeneratingSignatureValidationWithPrevOutputs(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		pk, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		// tx with several inputs, each spending a different output
+		numInputs := int(datagen.RandomInt(r, 4)) + 1
+		tx := wire.NewMsgTx(2)
+		prevOutputs := make([]*wire.TxOut, 0, numInputs)
+		for i := 0; i < numInputs; i++ {
+			inputHash, err := chainhash.NewHash(datagen.GenRandomByteArray(r, 32))
+			require.NoError(t, err)
+			tx.AddTxIn(
+				wire.NewTxIn(wire.NewOutPoint(inputHash, uint32(r.Intn(20))), nil, nil),
+			)
+			prevOutputs = append(prevOutputs, wire.NewTxOut(int64(r.Intn(1000)), genTaprootPkScript(r)))
+		}
+		tx.AddTxOut(
+			wire.NewTxOut(int64(r.Intn(1000)), datagen.GenRandomByteArray(r, 32)),
+		)
+		script := datagen.GenRandomByteArray(r, 150)
+		inputIdx := r.Intn(numInputs)
+
+		sig, err := btcstaking.SignTxWithScriptSpendInput(
+			tx,
+			prevOutputs,
+			inputIdx,
+			pk,
+			script,
+		)
+		require.NoError(t, err)
+
+		err = btcstaking.VerifyTransactionSigWithPrevOutputs(
+			tx,
+			prevOutputs,
+			inputIdx,
+			script,
+			pk.PubKey(),
+			sig.Serialize(),
+		)
+		require.NoError(t, err)
+
+		// the signature commits to all previous outputs
+		prevOutputs[(inputIdx+1)%numInputs] = wire.NewTxOut(int64(r.Intn(1000))+1000, genTaprootPkScript(r))
+		err = btcstaking.VerifyTransactionSigWithPrevOutputs(
+			tx,
+			prevOutputs,
+			inputIdx,
+			script,
+			pk.PubKey(),
+			sig.Serialize(),
+		)
+		require.Error(t, err)
+	})
+}
+
 func TestSlashingTxWithOverflowMustNotAccepted(t *testing.T) {
 	r := rand.New(rand.NewSource(time.Now().Unix()))
 	// we do not care for inputs in staking tx
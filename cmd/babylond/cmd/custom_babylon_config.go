@@ -9,6 +9,14 @@ import (
 
 type BtcConfig struct {
 	Network string `mapstructure:"network"`
+
+	// parameters of a custom network overriding those of the network above
+	SignetChallenge          string `mapstructure:"signet-challenge"`
+	GenesisHash              string `mapstructure:"genesis-hash"`
+	PowLimit                 string `mapstructure:"pow-limit"`
+	TargetTimespan           string `mapstructure:"target-timespan"`
+	TargetTimePerBlock       string `mapstructure:"target-time-per-block"`
+	RetargetAdjustmentFactor int64  `mapstructure:"retarget-adjustment-factor"`
 }
 
 func defaultBabylonBtcConfig() BtcConfig {
@@ -17,19 +25,32 @@ func defaultBabylonBtcConfig() BtcConfig {
 	}
 }
 
+type BtcStakingConfig struct {
+	ArchivePrunedDelegations bool `mapstructure:"archive-pruned-delegations"`
+}
+
+func defaultBabylonBtcStakingConfig() BtcStakingConfig {
+	return BtcStakingConfig{
+		ArchivePrunedDelegations: false,
+	}
+}
+
 type BabylonAppConfig struct {
 	serverconfig.Config `mapstructure:",squash"`
 
 	Wasm wasmtypes.WasmConfig `mapstructure:"wasm"`
 
 	BtcConfig BtcConfig `mapstructure:"btc-config"`
+
+	BtcStakingConfig BtcStakingConfig `mapstructure:"btcstaking-config"`
 }
 
 func DefaultBabylonConfig() *BabylonAppConfig {
 	return &BabylonAppConfig{
-		Config:    *serverconfig.DefaultConfig(),
-		Wasm:      wasmtypes.DefaultWasmConfig(),
-		BtcConfig: defaultBabylonBtcConfig(),
+		Config:           *serverconfig.DefaultConfig(),
+		Wasm:             wasmtypes.DefaultWasmConfig(),
+		BtcConfig:        defaultBabylonBtcConfig(),
+		BtcStakingConfig: defaultBabylonBtcStakingConfig(),
 	}
 }
 
@@ -44,5 +65,38 @@ func DefaultBabylonTemplate() string {
 # Configures which bitcoin network should be used for checkpointing
 # valid values are: [mainnet, testnet, simnet, signet, regtest]
 network = "{{ .BtcConfig.Network }}"
+
+# The options below define a custom bitcoin network, e.g., a signet with its own
+# challenge and genesis block, or a private regtest network. They override the
+# parameters of the network above, and are left empty to keep them.
+
+# Hex-encoded challenge script of a custom signet, only valid for network = "signet"
+signet-challenge = "{{ .BtcConfig.SignetChallenge }}"
+
+# Hash of the genesis block
+genesis-hash = "{{ .BtcConfig.GenesisHash }}"
+
+# Hex-encoded highest proof-of-work target
+pow-limit = "{{ .BtcConfig.PowLimit }}"
+
+# Desired time between difficulty adjustments, e.g., "336h"
+target-timespan = "{{ .BtcConfig.TargetTimespan }}"
+
+# Desired time between blocks, e.g., "10m"
+target-time-per-block = "{{ .BtcConfig.TargetTimePerBlock }}"
+
+# Factor by which the difficulty can change at most at each difficulty
+# adjustment, 0 keeps the one of the network above
+retarget-adjustment-factor = {{ .BtcConfig.RetargetAdjustmentFactor }}
+
+###############################################################################
+###                      Babylon BTC staking configuration                  ###
+###############################################################################
+
+[btcstaking-config]
+
+# Keeps the unbonded BTC delegations pruned from the state in a local archive,
+# such that they can still be queried from this node
+archive-pruned-delegations = {{ .BtcStakingConfig.ArchivePrunedDelegations }}
 `
 }
@@ -140,6 +140,7 @@ func PrepareGenesis(
 	btclightclientGenState := btclightclienttypes.DefaultGenesis()
 	btclightclientGenState.BtcHeaders = []*btclightclienttypes.BTCHeaderInfo{&genesisParams.BtclightclientBaseBtcHeader}
 	btclightclientGenState.Params = genesisParams.BtclightclientParams
+	btclightclientGenState.BtcNetwork = genesisParams.BtclightclientBtcNetwork
 	genesisState[btclightclienttypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(btclightclientGenState)
 
 	// epoching module genesis
@@ -234,6 +235,7 @@ type GenesisParams struct {
 	FinalityParams              finalitytypes.Params
 	BtclightclientBaseBtcHeader btclightclienttypes.BTCHeaderInfo
 	BtclightclientParams        btclightclienttypes.Params
+	BtclightclientBtcNetwork    *btclightclienttypes.BTCNetwork
 	BlockGasLimit               int64
 	VoteExtensionsEnableHeight  int64
 }
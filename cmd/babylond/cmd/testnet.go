@@ -40,6 +40,7 @@ import (
 	"github.com/babylonchain/babylon/privval"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	bbn "github.com/babylonchain/babylon/types"
+	btclightclienttypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
 )
 
@@ -50,6 +51,7 @@ var (
 	flagNodeDaemonHome          = "node-daemon-home"
 	flagStartingIPAddress       = "starting-ip-address"
 	flagBtcNetwork              = "btc-network"
+	flagBtcSignetChallenge      = "btc-signet-challenge"
 	flagAdditionalSenderAccount = "additional-sender-account"
 	flagTimeBetweenBlocks       = "time-between-blocks-seconds"
 )
@@ -86,6 +88,7 @@ Example:
 			numValidators, _ := cmd.Flags().GetInt(flagNumValidators)
 			algo, _ := cmd.Flags().GetString(flags.FlagKeyType)
 			btcNetwork, _ := cmd.Flags().GetString(flagBtcNetwork)
+			btcSignetChallenge, _ := cmd.Flags().GetString(flagBtcSignetChallenge)
 			additionalAccount, _ := cmd.Flags().GetBool(flagAdditionalSenderAccount)
 			timeBetweenBlocks, _ := cmd.Flags().GetUint64(flagTimeBetweenBlocks)
 			if err != nil {
@@ -104,7 +107,7 @@ Example:
 			return InitTestnet(
 				clientCtx, cmd, config, mbm, genBalIterator, outputDir, genesisCliArgs.ChainID, minGasPrices,
 				nodeDirPrefix, nodeDaemonHome, startingIPAddress, keyringBackend, algo, numValidators,
-				btcNetwork, additionalAccount, timeBetweenBlocks,
+				btcNetwork, btcSignetChallenge, additionalAccount, timeBetweenBlocks,
 				clientCtx.TxConfig.SigningContext().ValidatorAddressCodec(), genesisParams,
 			)
 		},
@@ -118,7 +121,8 @@ Example:
 	cmd.Flags().String(server.FlagMinGasPrices, fmt.Sprintf("0.000006%s", appparams.BaseCoinUnit), "Minimum gas prices to accept for transactions; All fees in a tx must meet this minimum (e.g. 0.001bbn)")
 	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
 	cmd.Flags().String(flags.FlagKeyType, string(hd.Secp256k1Type), "Key signing algorithm to generate keys for")
-	cmd.Flags().String(flagBtcNetwork, string(bbn.BtcSimnet), "Bitcoin network to use. Available networks: simnet, testnet, regtest, mainnet")
+	cmd.Flags().String(flagBtcNetwork, string(bbn.BtcSimnet), "Bitcoin network to use. Available networks: simnet, testnet, regtest, signet, mainnet")
+	cmd.Flags().String(flagBtcSignetChallenge, "", "Hex-encoded challenge script of a custom signet, only valid with --btc-network=signet")
 	cmd.Flags().Bool(flagAdditionalSenderAccount, false, "If there should be additional pre funded account per validator")
 	cmd.Flags().Uint64(flagTimeBetweenBlocks, 5, "Time between blocks in seconds")
 	addGenesisFlags(cmd)
@@ -145,6 +149,7 @@ func InitTestnet(
 	algoStr string,
 	numValidators int,
 	btcNetwork string,
+	btcSignetChallenge string,
 	additionalAccount bool,
 	timeBetweenBlocks uint64,
 	valAddrCodec runtime.ValidatorAddressCodec,
@@ -164,6 +169,13 @@ func InitTestnet(
 	babylonConfig.Telemetry.GlobalLabels = [][]string{{"chain_id", chainID}}
 	// BTC related config. Default values "simnet" and "BBT1"
 	babylonConfig.BtcConfig.Network = btcNetwork
+	babylonConfig.BtcConfig.SignetChallenge = btcSignetChallenge
+	// the BTC network is also defined in genesis, such that the nodes with
+	// another network configured refuse to start
+	genesisParams.BtclightclientBtcNetwork = &btclightclienttypes.BTCNetwork{
+		Name:            btcNetwork,
+		SignetChallenge: btcSignetChallenge,
+	}
 	// Explorer related config. Allow CORS connections.
 	babylonConfig.API.EnableUnsafeCORS = true
 	babylonConfig.GRPC.Enable = true
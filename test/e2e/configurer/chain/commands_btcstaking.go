@@ -23,7 +23,7 @@ import (
 	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
 )
 
-func (n *NodeConfig) CreateFinalityProvider(walletAddrOrName string, btcPK *bbn.BIP340PubKey, pop *bstypes.ProofOfPossessionBTC, moniker, identity, website, securityContract, details string, commission *sdkmath.LegacyDec) {
+func (n *NodeConfig) CreateFinalityProvider(walletAddrOrName string, btcPK *bbn.BIP340PubKey, pop *bstypes.ProofOfPossessionBTC, moniker, identity, website, securityContract, details string, commission, maxRate, maxChangeRate *sdkmath.LegacyDec) {
 	n.LogActionF("creating finality provider")
 
 	// get BTC PK hex
@@ -36,6 +36,7 @@ func (n *NodeConfig) CreateFinalityProvider(walletAddrOrName string, btcPK *bbn.
 		"babylond", "tx", "btcstaking", "create-finality-provider", btcPKHex, popHex,
 		fmt.Sprintf("--from=%s", walletAddrOrName), "--moniker", moniker, "--identity", identity, "--website", website,
 		"--security-contact", securityContract, "--details", details, "--commission-rate", commission.String(),
+		"--commission-max-rate", maxRate.String(), "--commission-max-change-rate", maxChangeRate.String(),
 	}
 	_, _, err = n.containerManager.ExecTxCmd(n.t, n.chainId, n.Name, cmd)
 	require.NoError(n.t, err)
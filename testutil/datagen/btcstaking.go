@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/txscript"
@@ -59,6 +60,8 @@ func GenRandomDescription(r *rand.Rand) *stakingtypes.Description {
 func GenRandomFinalityProviderWithBTCBabylonSKs(r *rand.Rand, btcSK *btcec.PrivateKey, fpAddr sdk.AccAddress) (*bstypes.FinalityProvider, error) {
 	// commission
 	commission := GenRandomCommission(r)
+	maxRate := commission.Add(GenRandomCommission(r))
+	maxChangeRate := sdkmath.LegacyMinDec(GenRandomCommission(r), maxRate)
 	// description
 	description := GenRandomDescription(r)
 	// key pairs
@@ -70,14 +73,42 @@ func GenRandomFinalityProviderWithBTCBabylonSKs(r *rand.Rand, btcSK *btcec.Priva
 		return nil, err
 	}
 	return &bstypes.FinalityProvider{
-		Description: description,
-		Commission:  &commission,
-		BtcPk:       bip340PK,
-		Addr:        fpAddr.String(),
-		Pop:         pop,
+		Description:   description,
+		Commission:    &commission,
+		BtcPk:         bip340PK,
+		Addr:          fpAddr.String(),
+		Pop:           pop,
+		MaxRate:       &maxRate,
+		MaxChangeRate: &maxChangeRate,
 	}, nil
 }
 
+// NewMsgRotateFinalityProviderKey generates a MsgRotateFinalityProviderKey
+// that rotates the finality provider's BTC PK from oldSK to newSK
+func NewMsgRotateFinalityProviderKey(fpAddr sdk.AccAddress, oldSK *btcec.PrivateKey, newSK *btcec.PrivateKey, rotationHeight uint64) (*bstypes.MsgRotateFinalityProviderKey, error) {
+	newPop, err := bstypes.NewPoPBTC(fpAddr, newSK)
+	if err != nil {
+		return nil, err
+	}
+	msg := &bstypes.MsgRotateFinalityProviderKey{
+		Addr:           fpAddr.String(),
+		OldBtcPk:       bbn.NewBIP340PubKeyFromBTCPK(oldSK.PubKey()),
+		NewBtcPk:       bbn.NewBIP340PubKeyFromBTCPK(newSK.PubKey()),
+		NewPop:         newPop,
+		RotationHeight: rotationHeight,
+	}
+	hash, err := msg.HandoverHashToSign()
+	if err != nil {
+		return nil, err
+	}
+	schnorrSig, err := schnorr.Sign(oldSK, hash)
+	if err != nil {
+		return nil, err
+	}
+	msg.HandoverSig = bbn.NewBIP340SignatureFromBTCSig(schnorrSig)
+	return msg, nil
+}
+
 // TODO: accomodate presign unbonding flow
 func GenRandomBTCDelegation(
 	r *rand.Rand,
@@ -246,6 +277,41 @@ func GenBTCStakingSlashingInfoWithOutPoint(
 	slashingRate sdkmath.LegacyDec,
 	slashingChangeLockTime uint16,
 ) *TestStakingSlashingInfo {
+	return GenBTCStakingSlashingInfoWithOutPoints(
+		r,
+		t,
+		btcNet,
+		[]*wire.OutPoint{outPoint},
+		stakerSK,
+		fpPKs,
+		covenantPKs,
+		covenantQuorum,
+		stakingTimeBlocks,
+		stakingValue,
+		slashingAddress,
+		slashingRate,
+		slashingChangeLockTime,
+	)
+}
+
+// GenBTCStakingSlashingInfoWithOutPoints generates a staking tx spending all
+// the given outpoints, e.g., a previous staking output together with extra
+// funding inputs in a stake expansion, and its slashing tx
+func GenBTCStakingSlashingInfoWithOutPoints(
+	r *rand.Rand,
+	t testing.TB,
+	btcNet *chaincfg.Params,
+	outPoints []*wire.OutPoint,
+	stakerSK *btcec.PrivateKey,
+	fpPKs []*btcec.PublicKey,
+	covenantPKs []*btcec.PublicKey,
+	covenantQuorum uint32,
+	stakingTimeBlocks uint16,
+	stakingValue int64,
+	slashingAddress string,
+	slashingRate sdkmath.LegacyDec,
+	slashingChangeLockTime uint16,
+) *TestStakingSlashingInfo {
 
 	stakingInfo, err := btcstaking.BuildStakingInfo(
 		stakerSK.PubKey(),
@@ -259,9 +325,11 @@ func GenBTCStakingSlashingInfoWithOutPoint(
 
 	require.NoError(t, err)
 	tx := wire.NewMsgTx(2)
-	// add the given tx input
-	txIn := wire.NewTxIn(outPoint, nil, nil)
-	tx.AddTxIn(txIn)
+	// add the given tx inputs
+	for _, outPoint := range outPoints {
+		txIn := wire.NewTxIn(outPoint, nil, nil)
+		tx.AddTxIn(txIn)
+	}
 	tx.AddTxOut(stakingInfo.StakingOutput)
 
 	// 2 outputs for changes and staking output
@@ -0,0 +1,19 @@
+package datagen
+
+import (
+	"fmt"
+	"math/rand"
+
+	bsctypes "github.com/babylonchain/babylon/x/btcstkconsumer/types"
+)
+
+// GenRandomConsumerRegister generates the register of a random consumer chain
+// tracked by a random Tendermint light client
+func GenRandomConsumerRegister(r *rand.Rand) *bsctypes.ConsumerRegister {
+	return &bsctypes.ConsumerRegister{
+		ChainId:     fmt.Sprintf("%s-%d", GenRandomHexStr(r, 5), RandomInt(r, 100)),
+		Name:        GenRandomHexStr(r, 10),
+		Description: GenRandomHexStr(r, 30),
+		ClientId:    fmt.Sprintf("07-tendermint-%d", RandomInt(r, 100)),
+	}
+}
@@ -57,3 +57,21 @@ func GenCovenantUnbondingSigs(covenantSKs []*btcec.PrivateKey, stakingTx *wire.M
 	}
 	return sigs, nil
 }
+
+func GenCovenantStakeSpendingSigs(covenantSKs []*btcec.PrivateKey, stakingTx *wire.MsgTx, spentOutputs []*wire.TxOut, inputIdx int, unbondingPkScriptPath []byte) ([]*schnorr.Signature, error) {
+	sigs := []*schnorr.Signature{}
+	for i := range covenantSKs {
+		sig, err := btcstaking.SignTxWithScriptSpendInput(
+			stakingTx,
+			spentOutputs,
+			inputIdx,
+			covenantSKs[i],
+			unbondingPkScriptPath,
+		)
+		if err != nil {
+			return nil, err
+		}
+		sigs = append(sigs, sig)
+	}
+	return sigs, nil
+}
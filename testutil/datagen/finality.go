@@ -69,6 +69,24 @@ func GenRandomMsgCommitPubRandList(r *rand.Rand, sk *btcec.PrivateKey, startHeig
 	return randListInfo, msg, nil
 }
 
+func NewMsgRevokePubRandCommit(sk *btcec.PrivateKey, revokedFromHeight uint64, commitment []byte) (*ftypes.MsgRevokePubRandCommit, error) {
+	msg := &ftypes.MsgRevokePubRandCommit{
+		Signer:            GenRandomAccount().Address,
+		FpBtcPk:           bbn.NewBIP340PubKeyFromBTCPK(sk.PubKey()),
+		RevokedFromHeight: revokedFromHeight,
+	}
+	hash, err := msg.HashToSign(commitment)
+	if err != nil {
+		return nil, err
+	}
+	schnorrSig, err := schnorr.Sign(sk, hash)
+	if err != nil {
+		return nil, err
+	}
+	msg.Sig = bbn.NewBIP340SignatureFromBTCSig(schnorrSig)
+	return msg, nil
+}
+
 func NewMsgAddFinalitySig(
 	signer string,
 	sk *btcec.PrivateKey,
@@ -98,6 +116,48 @@ func NewMsgAddFinalitySig(
 	return msg, nil
 }
 
+// NewMsgAddFinalitySigs creates a MsgAddFinalitySigs voting for the given
+// block app hashes at the contiguous heights starting from blockHeight
+func NewMsgAddFinalitySigs(
+	signer string,
+	sk *btcec.PrivateKey,
+	startHeight uint64,
+	blockHeight uint64,
+	randListInfo *RandListInfo,
+	blockAppHashList [][]byte,
+) (*ftypes.MsgAddFinalitySigs, error) {
+	startIdx := blockHeight - startHeight
+	endIdx := startIdx + uint64(len(blockAppHashList))
+
+	prByteList := [][]byte{}
+	for i := range randListInfo.PRList {
+		prByteList = append(prByteList, randListInfo.PRList[i])
+	}
+	_, proof, err := ftypes.NewRangeProof(prByteList, startIdx, endIdx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &ftypes.MsgAddFinalitySigs{
+		Signer:           signer,
+		FpBtcPk:          bbn.NewBIP340PubKeyFromBTCPK(sk.PubKey()),
+		StartHeight:      blockHeight,
+		PubRandList:      randListInfo.PRList[startIdx:endIdx],
+		Proof:            proof,
+		BlockAppHashList: blockAppHashList,
+		FinalitySigList:  []bbn.SchnorrEOTSSig{},
+	}
+	for i := range blockAppHashList {
+		sig, err := eots.Sign(sk, randListInfo.SRList[startIdx+uint64(i)], msg.MsgToSign(i))
+		if err != nil {
+			return nil, err
+		}
+		msg.FinalitySigList = append(msg.FinalitySigList, *bbn.NewSchnorrEOTSSigFromModNScalar(sig))
+	}
+
+	return msg, nil
+}
+
 func GenRandomEvidence(r *rand.Rand, sk *btcec.PrivateKey, height uint64) (*ftypes.Evidence, error) {
 	pk := sk.PubKey()
 	bip340PK := bbn.NewBIP340PubKeyFromBTCPK(pk)
@@ -41,6 +41,15 @@ func NewBTCHeaderBytesList(chain []*wire.BlockHeader) []bbn.BTCHeaderBytes {
 }
 
 func BTCLightClientKeeperWithCustomParams(t testing.TB, p btclightclientt.Params) (*btclightclientk.Keeper, sdk.Context, corestore.KVStoreService) {
+	return BTCLightClientKeeperWithKeepers(t, p, nil, nil)
+}
+
+func BTCLightClientKeeperWithKeepers(
+	t testing.TB,
+	p btclightclientt.Params,
+	bankKeeper btclightclientt.BankKeeper,
+	incentiveKeeper btclightclientt.IncentiveKeeper,
+) (*btclightclientk.Keeper, sdk.Context, corestore.KVStoreService) {
 	storeKey := storetypes.NewKVStoreKey(btclightclientt.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -58,6 +67,9 @@ func BTCLightClientKeeperWithCustomParams(t testing.TB, p btclightclientt.Params
 		cdc,
 		stServ,
 		testCfg,
+		bankKeeper,
+		incentiveKeeper,
+		nil,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
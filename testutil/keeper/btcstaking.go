@@ -28,6 +28,8 @@ func BTCStakingKeeper(
 	btclcKeeper types.BTCLightClientKeeper,
 	btccKeeper types.BtcCheckpointKeeper,
 	ckptKeeper types.CheckpointingKeeper,
+	iKeeper types.IncentiveKeeper,
+	bscKeeper types.BTCStkConsumerKeeper,
 ) (*keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
@@ -45,6 +47,8 @@ func BTCStakingKeeper(
 		btclcKeeper,
 		btccKeeper,
 		ckptKeeper,
+		iKeeper,
+		bscKeeper,
 		&chaincfg.SimNetParams,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
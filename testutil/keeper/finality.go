@@ -38,6 +38,7 @@ func FinalityKeeper(t testing.TB, bsKeeper types.BTCStakingKeeper, iKeeper types
 		runtime.NewKVStoreService(storeKey),
 		bsKeeper,
 		iKeeper,
+		stateStore.(storetypes.Queryable),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -54,9 +54,11 @@ func (mr *MockEpochingKeeperMockRecorder) CheckMsgCreateValidator(ctx, msg inter
 }
 
 // EnqueueMsg mocks base method.
-func (m *MockEpochingKeeper) EnqueueMsg(ctx context.Context, msg types0.QueuedMessage) {
+func (m *MockEpochingKeeper) EnqueueMsg(ctx context.Context, msg types0.QueuedMessage) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "EnqueueMsg", ctx, msg)
+	ret := m.ctrl.Call(m, "EnqueueMsg", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // EnqueueMsg indicates an expected call of EnqueueMsg.
@@ -1,9 +1,15 @@
 package types
 
 import (
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"math/big"
+	"time"
 
+	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/spf13/cast"
 )
@@ -22,6 +28,177 @@ const (
 	BtcSignet  SupportedBtcNetwork = "signet"
 )
 
+var errInvalidNetwork = errors.New("Bitcoin network should be one of [mainet, testnet, simnet, regtest, signet]")
+
+// options of a custom BTC network, which override the parameters of the
+// network preset in `btc-config.network`
+const (
+	flagSignetChallenge          = "btc-config.signet-challenge"
+	flagGenesisHash              = "btc-config.genesis-hash"
+	flagPowLimit                 = "btc-config.pow-limit"
+	flagTargetTimespan           = "btc-config.target-timespan"
+	flagTargetTimePerBlock       = "btc-config.target-time-per-block"
+	flagRetargetAdjustmentFactor = "btc-config.retarget-adjustment-factor"
+)
+
+// CustomBtcNetworkOptions are the parameters of a custom BTC network, e.g., a
+// signet with its own challenge and genesis block, that override those of the
+// preset network. Empty values keep the parameters of the preset network.
+type CustomBtcNetworkOptions struct {
+	// SignetChallenge is the hex-encoded challenge script of a custom signet.
+	// It is only allowed with the signet preset.
+	SignetChallenge string
+	// GenesisHash is the hash of the genesis block
+	GenesisHash string
+	// PowLimit is the hex-encoded highest proof-of-work target
+	PowLimit string
+	// TargetTimespan is the desired time between difficulty adjustments
+	TargetTimespan time.Duration
+	// TargetTimePerBlock is the desired time between blocks
+	TargetTimePerBlock time.Duration
+	// RetargetAdjustmentFactor is the factor by which the difficulty can
+	// change at most at each difficulty adjustment
+	RetargetAdjustmentFactor int64
+}
+
+func getPresetParams(network string) (*chaincfg.Params, error) {
+	if network == string(BtcMainnet) {
+		return &chaincfg.MainNetParams, nil
+	} else if network == string(BtcTestnet) {
+		return &chaincfg.TestNet3Params, nil
+	} else if network == string(BtcSimnet) {
+		return &chaincfg.SimNetParams, nil
+	} else if network == string(BtcRegtest) {
+		return &chaincfg.RegressionNetParams, nil
+	} else if network == string(BtcSignet) {
+		return &chaincfg.SigNetParams, nil
+	} else {
+		return nil, errInvalidNetwork
+	}
+}
+
+func getOptionalString(opts servertypes.AppOptions, key string) string {
+	valueInterface := opts.Get(key)
+	if valueInterface == nil {
+		return ""
+	}
+	value, err := cast.ToStringE(valueInterface)
+	if err != nil {
+		panic(fmt.Sprintf("Bitcoin config %s should be valid string", key))
+	}
+	return value
+}
+
+func getOptionalDuration(opts servertypes.AppOptions, key string) time.Duration {
+	value := getOptionalString(opts, key)
+	if value == "" {
+		return 0
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		panic(fmt.Sprintf("Bitcoin config %s should be valid duration: %v", key, err))
+	}
+	return duration
+}
+
+func getOptionalInt64(opts servertypes.AppOptions, key string) int64 {
+	valueInterface := opts.Get(key)
+	if valueInterface == nil {
+		return 0
+	}
+	value, err := cast.ToInt64E(valueInterface)
+	if err != nil {
+		panic(fmt.Sprintf("Bitcoin config %s should be valid integer", key))
+	}
+	return value
+}
+
+func getCustomOptions(opts servertypes.AppOptions) *CustomBtcNetworkOptions {
+	return &CustomBtcNetworkOptions{
+		SignetChallenge:          getOptionalString(opts, flagSignetChallenge),
+		GenesisHash:              getOptionalString(opts, flagGenesisHash),
+		PowLimit:                 getOptionalString(opts, flagPowLimit),
+		TargetTimespan:           getOptionalDuration(opts, flagTargetTimespan),
+		TargetTimePerBlock:       getOptionalDuration(opts, flagTargetTimePerBlock),
+		RetargetAdjustmentFactor: getOptionalInt64(opts, flagRetargetAdjustmentFactor),
+	}
+}
+
+// IsEmpty returns whether none of the parameters of the preset network is
+// overridden
+func (o *CustomBtcNetworkOptions) IsEmpty() bool {
+	return *o == CustomBtcNetworkOptions{}
+}
+
+// NewCustomBtcNetParams returns the parameters of the given preset network,
+// overridden by the given custom options. The parameters of the preset network
+// are left untouched.
+func NewCustomBtcNetParams(network SupportedBtcNetwork, o *CustomBtcNetworkOptions) (*chaincfg.Params, error) {
+	preset, err := getPresetParams(string(network))
+	if err != nil {
+		return nil, err
+	}
+	// copy the preset, such that it is not modified
+	params := *preset
+
+	if o.SignetChallenge != "" {
+		if network != BtcSignet {
+			return nil, fmt.Errorf("signet challenge is only allowed for the %s network, got %s", BtcSignet, network)
+		}
+		challenge, err := hex.DecodeString(o.SignetChallenge)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signet challenge: %w", err)
+		}
+		// the network magic of a custom signet is derived from its challenge
+		params = chaincfg.CustomSignetParams(challenge, nil)
+	}
+
+	if o.GenesisHash != "" {
+		genesisHash, err := chainhash.NewHashFromStr(o.GenesisHash)
+		if err != nil {
+			return nil, fmt.Errorf("invalid genesis hash: %w", err)
+		}
+		params.GenesisHash = genesisHash
+		// only the hash of the custom genesis block is known
+		params.GenesisBlock = nil
+	}
+
+	if o.PowLimit != "" {
+		powLimit, ok := new(big.Int).SetString(o.PowLimit, 16)
+		if !ok || powLimit.Sign() <= 0 || powLimit.BitLen() > 256 {
+			return nil, fmt.Errorf("pow limit should be a positive 256-bit hex number, got %s", o.PowLimit)
+		}
+		params.PowLimit = powLimit
+		params.PowLimitBits = blockchain.BigToCompact(powLimit)
+	}
+
+	if o.TargetTimespan != 0 {
+		params.TargetTimespan = o.TargetTimespan
+	}
+	if o.TargetTimePerBlock != 0 {
+		params.TargetTimePerBlock = o.TargetTimePerBlock
+		// as in all preset networks
+		params.MinDiffReductionTime = 2 * o.TargetTimePerBlock
+	}
+	if o.RetargetAdjustmentFactor != 0 {
+		params.RetargetAdjustmentFactor = o.RetargetAdjustmentFactor
+	}
+
+	// the BTC light client derives the difficulty adjustment interval and
+	// bounds from these parameters
+	if params.TargetTimePerBlock < time.Second {
+		return nil, fmt.Errorf("target time per block should be at least 1s, got %s", params.TargetTimePerBlock)
+	}
+	if params.TargetTimespan < params.TargetTimePerBlock {
+		return nil, fmt.Errorf("target timespan %s should be at least the target time per block %s", params.TargetTimespan, params.TargetTimePerBlock)
+	}
+	if params.RetargetAdjustmentFactor <= 0 {
+		return nil, fmt.Errorf("retarget adjustment factor should be positive, got %d", params.RetargetAdjustmentFactor)
+	}
+
+	return &params, nil
+}
+
 func getParams(opts servertypes.AppOptions) *chaincfg.Params {
 	valueInterface := opts.Get("btc-config.network")
 
@@ -35,19 +212,20 @@ func getParams(opts servertypes.AppOptions) *chaincfg.Params {
 		panic("Bitcoin network config should be valid string")
 	}
 
-	if network == string(BtcMainnet) {
-		return &chaincfg.MainNetParams
-	} else if network == string(BtcTestnet) {
-		return &chaincfg.TestNet3Params
-	} else if network == string(BtcSimnet) {
-		return &chaincfg.SimNetParams
-	} else if network == string(BtcRegtest) {
-		return &chaincfg.RegressionNetParams
-	} else if network == string(BtcSignet) {
-		return &chaincfg.SigNetParams
-	} else {
-		panic("Bitcoin network should be one of [mainet, testnet, simnet, regtest, signet]")
+	customOpts := getCustomOptions(opts)
+	if customOpts.IsEmpty() {
+		params, err := getPresetParams(network)
+		if err != nil {
+			panic(err.Error())
+		}
+		return params
+	}
+
+	params, err := NewCustomBtcNetParams(SupportedBtcNetwork(network), customOpts)
+	if err != nil {
+		panic(fmt.Sprintf("Bitcoin custom network config should be valid: %v", err))
 	}
+	return params
 }
 
 func ParseBtcOptionsFromConfig(opts servertypes.AppOptions) BtcConfig {
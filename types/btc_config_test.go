@@ -0,0 +1,174 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	simsutils "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/types"
+)
+
+func TestParseBtcOptionsFromConfig(t *testing.T) {
+	t.Run("preset network", func(t *testing.T) {
+		cfg := types.ParseBtcOptionsFromConfig(simsutils.AppOptionsMap{
+			"btc-config.network": string(types.BtcRegtest),
+		})
+		require.Equal(t, &chaincfg.RegressionNetParams, cfg.NetParams())
+	})
+
+	t.Run("custom signet", func(t *testing.T) {
+		challenge := "51" // OP_TRUE
+		cfg := types.ParseBtcOptionsFromConfig(simsutils.AppOptionsMap{
+			"btc-config.network":          string(types.BtcSignet),
+			"btc-config.signet-challenge": challenge,
+		})
+		params := cfg.NetParams()
+		expected := chaincfg.CustomSignetParams([]byte{0x51}, nil)
+		require.Equal(t, expected.Net, params.Net)
+		require.NotEqual(t, chaincfg.SigNetParams.Net, params.Net)
+		require.Equal(t, chaincfg.SigNetParams.GenesisHash, params.GenesisHash)
+		require.Equal(t, chaincfg.SigNetParams.Bech32HRPSegwit, params.Bech32HRPSegwit)
+
+		// the default signet challenge leads to the default signet magic
+		defaultCfg := types.ParseBtcOptionsFromConfig(simsutils.AppOptionsMap{
+			"btc-config.network":          string(types.BtcSignet),
+			"btc-config.signet-challenge": hex.EncodeToString(chaincfg.DefaultSignetChallenge),
+		})
+		require.Equal(t, chaincfg.SigNetParams.Net, defaultCfg.NetParams().Net)
+	})
+
+	t.Run("custom regtest", func(t *testing.T) {
+		genesisHash := "00000000000000000000000000000000000000000000000000000000deadbeef"
+		powLimit := "00ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"
+		cfg := types.ParseBtcOptionsFromConfig(simsutils.AppOptionsMap{
+			"btc-config.network":                    string(types.BtcRegtest),
+			"btc-config.genesis-hash":               genesisHash,
+			"btc-config.pow-limit":                  powLimit,
+			"btc-config.target-timespan":            "24h",
+			"btc-config.target-time-per-block":      "1m",
+			"btc-config.retarget-adjustment-factor": 2,
+		})
+		params := cfg.NetParams()
+
+		expectedGenesisHash, err := chainhash.NewHashFromStr(genesisHash)
+		require.NoError(t, err)
+		require.Equal(t, expectedGenesisHash, params.GenesisHash)
+		require.Nil(t, params.GenesisBlock)
+		expectedPowLimit, ok := new(big.Int).SetString(powLimit, 16)
+		require.True(t, ok)
+		require.Zero(t, expectedPowLimit.Cmp(params.PowLimit))
+		require.Equal(t, blockchain.BigToCompact(params.PowLimit), params.PowLimitBits)
+		require.Equal(t, 24*time.Hour, params.TargetTimespan)
+		require.Equal(t, time.Minute, params.TargetTimePerBlock)
+		require.Equal(t, 2*time.Minute, params.MinDiffReductionTime)
+		require.Equal(t, int64(2), cfg.RetargetAdjustmentFactor())
+		// the other parameters are those of the preset
+		require.Equal(t, chaincfg.RegressionNetParams.Net, params.Net)
+		require.True(t, params.PoWNoRetargeting)
+		require.True(t, cfg.ReduceMinDifficulty())
+
+		// the preset is not modified
+		require.NotEqual(t, params.GenesisHash, chaincfg.RegressionNetParams.GenesisHash)
+		require.NotNil(t, chaincfg.RegressionNetParams.GenesisBlock)
+		require.Equal(t, 14*24*time.Hour, chaincfg.RegressionNetParams.TargetTimespan)
+	})
+
+	t.Run("addresses of a custom network", func(t *testing.T) {
+		cfg := types.ParseBtcOptionsFromConfig(simsutils.AppOptionsMap{
+			"btc-config.network":          string(types.BtcSignet),
+			"btc-config.signet-challenge": "51",
+		})
+		sk, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+		addr, err := btcutil.NewAddressTaproot(sk.PubKey().SerializeCompressed()[1:], cfg.NetParams())
+		require.NoError(t, err)
+
+		decoded, err := btcutil.DecodeAddress(addr.EncodeAddress(), cfg.NetParams())
+		require.NoError(t, err)
+		require.True(t, decoded.IsForNet(cfg.NetParams()))
+		require.Equal(t, addr.EncodeAddress(), decoded.EncodeAddress())
+	})
+}
+
+func TestNewCustomBtcNetParams(t *testing.T) {
+	for _, tc := range []struct {
+		desc    string
+		network types.SupportedBtcNetwork
+		opts    *types.CustomBtcNetworkOptions
+		valid   bool
+	}{
+		{
+			desc:    "empty options keep the preset",
+			network: types.BtcMainnet,
+			opts:    &types.CustomBtcNetworkOptions{},
+			valid:   true,
+		},
+		{
+			desc:    "unknown network",
+			network: types.SupportedBtcNetwork("unknown"),
+			opts:    &types.CustomBtcNetworkOptions{},
+			valid:   false,
+		},
+		{
+			desc:    "signet challenge for a non-signet network",
+			network: types.BtcRegtest,
+			opts:    &types.CustomBtcNetworkOptions{SignetChallenge: "51"},
+			valid:   false,
+		},
+		{
+			desc:    "invalid signet challenge",
+			network: types.BtcSignet,
+			opts:    &types.CustomBtcNetworkOptions{SignetChallenge: "zz"},
+			valid:   false,
+		},
+		{
+			desc:    "invalid genesis hash",
+			network: types.BtcSignet,
+			opts:    &types.CustomBtcNetworkOptions{GenesisHash: "zz"},
+			valid:   false,
+		},
+		{
+			desc:    "zero pow limit",
+			network: types.BtcRegtest,
+			opts:    &types.CustomBtcNetworkOptions{PowLimit: "0"},
+			valid:   false,
+		},
+		{
+			desc:    "pow limit larger than 256 bits",
+			network: types.BtcRegtest,
+			opts:    &types.CustomBtcNetworkOptions{PowLimit: "1" + strings.Repeat("0", 64)},
+			valid:   false,
+		},
+		{
+			desc:    "target timespan shorter than the target time per block",
+			network: types.BtcRegtest,
+			opts:    &types.CustomBtcNetworkOptions{TargetTimespan: time.Minute, TargetTimePerBlock: time.Hour},
+			valid:   false,
+		},
+		{
+			desc:    "negative retarget adjustment factor",
+			network: types.BtcRegtest,
+			opts:    &types.CustomBtcNetworkOptions{RetargetAdjustmentFactor: -1},
+			valid:   false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := types.NewCustomBtcNetParams(tc.network, tc.opts)
+			if tc.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+		})
+	}
+}
@@ -85,6 +85,27 @@ func SerializeBTCTx(tx *wire.MsgTx) ([]byte, error) {
 	return txBuf.Bytes(), nil
 }
 
+func NewBTCTxOutFromBytes(txOutBytes []byte) (*wire.TxOut, error) {
+	var txOut wire.TxOut
+	rbuf := bytes.NewReader(txOutBytes)
+	if err := wire.ReadTxOut(rbuf, 0, wire.TxVersion, &txOut); err != nil {
+		return nil, err
+	}
+	if rbuf.Len() != 0 {
+		return nil, fmt.Errorf("tx output has %d trailing bytes", rbuf.Len())
+	}
+
+	return &txOut, nil
+}
+
+func SerializeBTCTxOut(txOut *wire.TxOut) ([]byte, error) {
+	var txOutBuf bytes.Buffer
+	if err := wire.WriteTxOut(&txOutBuf, 0, wire.TxVersion, txOut); err != nil {
+		return nil, err
+	}
+	return txOutBuf.Bytes(), nil
+}
+
 func GetOutputIdxInBTCTx(tx *wire.MsgTx, output *wire.TxOut) (uint32, error) {
 	for i, txOut := range tx.TxOut {
 		if bytes.Equal(txOut.PkScript, output.PkScript) && txOut.Value == output.Value {
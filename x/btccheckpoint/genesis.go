@@ -2,13 +2,22 @@ package btccheckpoint
 
 import (
 	"context"
+
 	"github.com/babylonchain/babylon/x/btccheckpoint/keeper"
 	"github.com/babylonchain/babylon/x/btccheckpoint/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
 )
 
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx context.Context, k keeper.Keeper, genState types.GenesisState) {
+	// the light client is initialised before this module, and must not prune
+	// the headers of unfinalized checkpoints
+	keepRecent := k.GetBTCLightClientPruningKeepRecent(ctx)
+	if err := btclctypes.ValidatePruningKeepRecentWithFinalizationTimeout(keepRecent, genState.Params.CheckpointFinalizationTimeout); err != nil {
+		panic(err)
+	}
+
 	// set params for this module
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
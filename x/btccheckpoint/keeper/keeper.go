@@ -106,6 +106,12 @@ func (k Keeper) GetBlockHeight(ctx context.Context, b *bbn.BTCHeaderHashBytes) (
 	return k.btcLightClientKeeper.BlockHeight(ctx, b)
 }
 
+// GetBTCLightClientPruningKeepRecent returns the number of the most recent
+// headers kept in the light client storage, or 0 if pruning is disabled
+func (k Keeper) GetBTCLightClientPruningKeepRecent(ctx context.Context) uint64 {
+	return k.btcLightClientKeeper.GetPruningKeepRecent(ctx)
+}
+
 func (k Keeper) headerDepth(ctx context.Context, headerHash *bbn.BTCHeaderHashBytes) (uint64, error) {
 	blockDepth, err := k.btcLightClientKeeper.MainChainDepth(ctx, headerHash)
 
@@ -402,6 +408,13 @@ func (k Keeper) checkCheckpoints(ctx context.Context) {
 			currentEpoch.Status = types.Finalized
 			k.checkpointingKeeper.SetCheckpointFinalized(ctx, epoch)
 			k.setLastFinalizedEpochNumber(ctx, epoch)
+			// keep the headers of the best submission in the light client after
+			// they are pruned, as they are looked up for the finalized epoch
+			for _, tk := range epochChanges.EpochBestSubmission.SubmissionKey.Key {
+				if err := k.btcLightClientKeeper.RetainHeader(ctx, tk.Hash); err != nil {
+					panic("Finalized epoch submission must be on main chain")
+				}
+			}
 		}
 
 		if currentEpoch.Status == types.Finalized {
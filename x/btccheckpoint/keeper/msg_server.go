@@ -5,6 +5,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/babylonchain/babylon/x/btccheckpoint/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -103,6 +104,11 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 	if err := req.Params.Validate(); err != nil {
 		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid parameter: %v", err)
 	}
+	// the light client must not prune the headers of unfinalized checkpoints
+	keepRecent := ms.k.GetBTCLightClientPruningKeepRecent(goCtx)
+	if err := btclctypes.ValidatePruningKeepRecentWithFinalizationTimeout(keepRecent, req.Params.CheckpointFinalizationTimeout); err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid parameter: %v", err)
+	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if err := ms.k.SetParams(ctx, req.Params); err != nil {
@@ -13,8 +13,11 @@ import (
 	bbn "github.com/babylonchain/babylon/types"
 	bkeeper "github.com/babylonchain/babylon/x/btccheckpoint/keeper"
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	"github.com/btcsuite/btcd/chaincfg"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -434,6 +437,29 @@ func TestLeaveOnlyBestSubmissionWhenEpochFinalized(t *testing.T) {
 
 	require.Equal(t, finalSubKey.Key[0].Hash, b1Hash(msg3))
 	require.Equal(t, finalSubKey.Key[1].Hash, b2Hash(msg3))
+
+	// only the headers of the best submission are retained in the light client
+	require.True(t, tk.BTCLightClient.IsRetained(b1Hash(msg3)))
+	require.True(t, tk.BTCLightClient.IsRetained(b2Hash(msg3)))
+	require.False(t, tk.BTCLightClient.IsRetained(b1Hash(msg1)))
+	require.False(t, tk.BTCLightClient.IsRetained(b2Hash(msg2)))
+}
+
+func TestUpdateParamsRespectsLightClientPruning(t *testing.T) {
+	tk := InitTestKeepers(t)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	params := btcctypes.DefaultParams()
+	keepRecent := uint64(btclctypes.MaxStakingTime)
+	tk.BTCLightClient.SetPruningKeepRecent(keepRecent)
+
+	// the finalization timeout cannot exceed what the light client keeps
+	params.CheckpointFinalizationTimeout = keepRecent - btclctypes.PruningFinalizationMargin + 1
+	_, err := tk.MsgSrv.UpdateParams(tk.Ctx, &btcctypes.MsgUpdateParams{Authority: authority, Params: params})
+	require.ErrorIs(t, err, govtypes.ErrInvalidProposalMsg)
+
+	params.CheckpointFinalizationTimeout--
+	_, err = tk.MsgSrv.UpdateParams(tk.Ctx, &btcctypes.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
 }
 
 func TestTxIdxShouldBreakTies(t *testing.T) {
@@ -14,6 +14,14 @@ type BTCLightClientKeeper interface {
 
 	// MainChainDepth returns the depth of the header in the main chain or error if the header does not exist
 	MainChainDepth(ctx context.Context, headerBytes *bbn.BTCHeaderHashBytes) (uint64, error)
+
+	// RetainHeader keeps the header with the given hash in the light client
+	// storage after it is pruned, or returns error if the header does not exist
+	RetainHeader(ctx context.Context, headerHash *bbn.BTCHeaderHashBytes) error
+
+	// GetPruningKeepRecent returns the number of the most recent headers kept
+	// in the light client storage, or 0 if pruning is disabled
+	GetPruningKeepRecent(ctx context.Context) uint64
 }
 
 type CheckpointingKeeper interface {
@@ -9,7 +9,9 @@ import (
 )
 
 type MockBTCLightClientKeeper struct {
-	headers map[string]uint64
+	headers    map[string]uint64
+	retained   map[string]bool
+	keepRecent uint64
 }
 
 type MockCheckpointingKeeper struct {
@@ -21,7 +23,8 @@ type MockIncentiveKeeper struct {
 
 func NewMockBTCLightClientKeeper() *MockBTCLightClientKeeper {
 	lc := MockBTCLightClientKeeper{
-		headers: make(map[string]uint64),
+		headers:  make(map[string]uint64),
+		retained: make(map[string]bool),
 	}
 	return &lc
 }
@@ -49,6 +52,14 @@ func (mc *MockBTCLightClientKeeper) SetDepth(header *bbn.BTCHeaderHashBytes, dd
 	mc.headers[header.String()] = dd
 }
 
+func (mc *MockBTCLightClientKeeper) SetPruningKeepRecent(keepRecent uint64) {
+	mc.keepRecent = keepRecent
+}
+
+func (mc *MockBTCLightClientKeeper) IsRetained(header *bbn.BTCHeaderHashBytes) bool {
+	return mc.retained[header.String()]
+}
+
 func (mc *MockBTCLightClientKeeper) DeleteHeader(header *bbn.BTCHeaderHashBytes) {
 	delete(mc.headers, header.String())
 }
@@ -67,6 +78,18 @@ func (ck MockBTCLightClientKeeper) MainChainDepth(ctx context.Context, headerByt
 	}
 }
 
+func (ck MockBTCLightClientKeeper) RetainHeader(ctx context.Context, headerBytes *bbn.BTCHeaderHashBytes) error {
+	if _, ok := ck.headers[headerBytes.String()]; !ok {
+		return errors.New("unknown header")
+	}
+	ck.retained[headerBytes.String()] = true
+	return nil
+}
+
+func (ck MockBTCLightClientKeeper) GetPruningKeepRecent(ctx context.Context) uint64 {
+	return ck.keepRecent
+}
+
 func (ck MockCheckpointingKeeper) VerifyCheckpoint(ctx context.Context, checkpoint txformat.RawBtcCheckpoint) error {
 	if ck.returnError {
 		return errors.New("bad checkpoints")
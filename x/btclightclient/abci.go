@@ -0,0 +1,22 @@
+package btclightclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/babylonchain/babylon/x/btclightclient/keeper"
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	// settle the reported headers before pruning, as settling them requires
+	// the canonical headers at the confirmation depth
+	k.SettleReportedHeaders(ctx)
+	k.PruneHeaders(ctx, types.MaxHeadersToPrunePerBlock)
+
+	return []abci.ValidatorUpdate{}, nil
+}
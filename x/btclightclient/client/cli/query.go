@@ -27,6 +27,9 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdTip())
 	cmd.AddCommand(CmdBaseHeader())
 	cmd.AddCommand(CmdHeaderDepth())
+	cmd.AddCommand(CmdHeadersMMR())
+	cmd.AddCommand(CmdReorgHistory())
+	cmd.AddCommand(CmdReporter())
 
 	return cmd
 }
@@ -198,3 +201,82 @@ func CmdHeaderDepth() *cobra.Command {
 
 	return cmd
 }
+
+func CmdHeadersMMR() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "headers-mmr",
+		Short: "retrieve the MMR accumulator of the pruned headers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.HeadersMMR(context.Background(), &types.QueryHeadersMMRRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdReorgHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reorg-history",
+		Short: "retrieve the reorgs of the bitcoin blockchain observed by the module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryReorgHistoryRequest(pageReq)
+			res, err := queryClient.ReorgHistory(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "reorg-history")
+
+	return cmd
+}
+
+func CmdReporter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reporter [address]",
+		Short: "retrieve the information of a bonded reporter",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Reporter(context.Background(), &types.QueryReporterRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
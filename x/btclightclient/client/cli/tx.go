@@ -3,6 +3,8 @@ package cli
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/babylonchain/babylon/x/btclightclient/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -21,6 +23,8 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdTxInsertHeader())
+	cmd.AddCommand(CmdTxBondReporter())
+	cmd.AddCommand(CmdTxUnbondReporter())
 
 	return cmd
 }
@@ -49,3 +53,57 @@ func CmdTxInsertHeader() *cobra.Command {
 
 	return cmd
 }
+
+func CmdTxBondReporter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bond-reporter [amount]",
+		Short: "lock a bond for inserting BTC headers as a bonded reporter",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBondReporter{
+				Signer: clientCtx.GetFromAddress().String(),
+				Amount: amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdTxUnbondReporter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbond-reporter",
+		Short: "unlock the whole bond of a bonded reporter",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUnbondReporter{
+				Signer: clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
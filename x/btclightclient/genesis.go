@@ -10,21 +10,52 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx context.Context, k keeper.Keeper, gs types.GenesisState) {
-	if err := gs.Validate(); err != nil {
+	// the base header is validated against the BTC network that the node is
+	// configured with, which might be a custom one, and which needs to be the
+	// BTC network defined in genesis, if any
+	if err := gs.ValidateWithBTCNet(k.GetBTCNet()); err != nil {
 		panic(err)
 	}
+	if gs.BtcNetwork != nil {
+		k.SetBTCNetwork(ctx, gs.BtcNetwork)
+	}
 
 	if err := k.SetParams(ctx, gs.Params); err != nil {
 		panic(err)
 	}
 
 	k.InsertHeaderInfos(ctx, gs.BtcHeaders)
+	if gs.BaseHeaderContext != nil {
+		k.SetBaseHeaderContext(ctx, gs.BaseHeaderContext)
+	}
+	if gs.HeadersMmr != nil {
+		k.SetHeadersMMR(ctx, gs.HeadersMmr)
+	}
+	for _, reorg := range gs.ReorgHistory {
+		k.AppendReorg(ctx, reorg)
+	}
+	for _, reporter := range gs.Reporters {
+		k.SetReporter(ctx, reporter)
+	}
+	for _, reportedHeader := range gs.ReportedHeaders {
+		k.SetReportedHeader(ctx, reportedHeader)
+	}
+	for _, height := range gs.RetainedHeaderHeights {
+		k.SetRetainedHeaderHeight(ctx, height)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx context.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:     k.GetParams(ctx),
-		BtcHeaders: k.GetMainChainFrom(ctx, 0),
+		Params:                k.GetParams(ctx),
+		BtcHeaders:            k.GetAllStoredHeaders(ctx),
+		BaseHeaderContext:     k.GetBaseHeaderContext(ctx),
+		HeadersMmr:            k.GetHeadersMMR(ctx),
+		ReorgHistory:          k.GetReorgHistory(ctx),
+		Reporters:             k.GetAllReporters(ctx),
+		ReportedHeaders:       k.GetAllReportedHeaders(ctx),
+		RetainedHeaderHeights: k.GetRetainedHeaderHeights(ctx),
+		BtcNetwork:            k.GetBTCNetwork(ctx),
 	}
 }
@@ -9,9 +9,11 @@ import (
 	thelper "github.com/babylonchain/babylon/testutil/helper"
 	keepertest "github.com/babylonchain/babylon/testutil/keeper"
 	"github.com/babylonchain/babylon/testutil/nullify"
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btclightclient"
 	"github.com/babylonchain/babylon/x/btclightclient/keeper"
 	"github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 	"github.com/stretchr/testify/require"
 
@@ -33,6 +35,33 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(got)
 }
 
+func TestGenesisWithBTCNetwork(t *testing.T) {
+	baseHeaderInfo := types.SimnetGenesisBlock()
+	genesisState := types.GenesisState{
+		BtcHeaders: []*types.BTCHeaderInfo{&baseHeaderInfo},
+		BtcNetwork: &types.BTCNetwork{Name: string(bbn.BtcSimnet)},
+	}
+
+	// the BTC network in genesis is kept in the state and exported
+	k, ctx := keepertest.BTCLightClientKeeper(t)
+	require.Equal(t, &chaincfg.SimNetParams, k.GetBTCNet())
+	btclightclient.InitGenesis(ctx, *k, genesisState)
+	require.Equal(t, genesisState.BtcNetwork, k.GetBTCNetwork(ctx))
+	got := btclightclient.ExportGenesis(ctx, *k)
+	require.Equal(t, genesisState.BtcNetwork, got.BtcNetwork)
+	require.NoError(t, k.ValidateBTCNetwork(ctx))
+
+	// a node whose BTC network differs from the one in genesis refuses to
+	// restart
+	k.SetBTCNetwork(ctx, &types.BTCNetwork{Name: string(bbn.BtcRegtest)})
+	require.Error(t, k.ValidateBTCNetwork(ctx))
+
+	// a node configured with another BTC network refuses the genesis
+	genesisState.BtcNetwork = &types.BTCNetwork{Name: string(bbn.BtcRegtest)}
+	k, ctx = keepertest.BTCLightClientKeeper(t)
+	require.Panics(t, func() { btclightclient.InitGenesis(ctx, *k, genesisState) })
+}
+
 func TestImportExport(t *testing.T) {
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	sender1 := secp256k1.GenPrivKey()
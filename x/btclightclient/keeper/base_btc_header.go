@@ -17,3 +17,16 @@ func (k Keeper) SetBaseBTCHeader(ctx context.Context, baseBTCHeader types.BTCHea
 	}
 	k.headersState(ctx).insertHeader(&baseBTCHeader)
 }
+
+// GetBaseHeaderContext returns the context of the base BTC header, or nil if
+// the base BTC header comes without context
+func (k Keeper) GetBaseHeaderContext(ctx context.Context) *types.BaseHeaderContext {
+	return k.headersState(ctx).GetBaseHeaderContext()
+}
+
+// SetBaseHeaderContext sets the context of the base BTC header, which allows
+// fully validating the BTC headers following a base BTC header that is not at
+// a difficulty adjustment boundary
+func (k Keeper) SetBaseHeaderContext(ctx context.Context, baseHeaderCtx *types.BaseHeaderContext) {
+	k.headersState(ctx).setBaseHeaderContext(baseHeaderCtx)
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+)
+
+// SetBTCNetwork sets the definition of the BTC network in genesis
+func (k Keeper) SetBTCNetwork(ctx context.Context, btcNetwork *types.BTCNetwork) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.BTCNetworkKey, k.cdc.MustMarshal(btcNetwork)); err != nil {
+		panic(err)
+	}
+}
+
+// GetBTCNetwork returns the definition of the BTC network in genesis, or nil
+// if the genesis does not define the BTC network
+func (k Keeper) GetBTCNetwork(ctx context.Context) *types.BTCNetwork {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.BTCNetworkKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var btcNetwork types.BTCNetwork
+	k.cdc.MustUnmarshal(bz, &btcNetwork)
+	return &btcNetwork
+}
+
+// ValidateBTCNetwork checks whether the BTC network that the node is
+// configured with is the one defined in genesis, if any. InitGenesis only
+// checks this upon the genesis of the chain, thus it is checked again upon
+// each start of the node
+func (k Keeper) ValidateBTCNetwork(ctx context.Context) error {
+	btcNetwork := k.GetBTCNetwork(ctx)
+	if btcNetwork == nil {
+		return nil
+	}
+	if err := btcNetwork.ValidateBTCNet(k.GetBTCNet()); err != nil {
+		return fmt.Errorf("the BTC network does not match the one in genesis: %w", err)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btclightclient/types"
@@ -60,6 +61,11 @@ func (k Keeper) Contains(ctx context.Context, req *types.QueryContainsRequest) (
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	contains := k.headersState(sdkCtx).HeaderExists(req.Hash)
+	// a pruned header is contained if it is proven to be in the MMR
+	if !contains && req.MmrProof != nil {
+		_, err := k.VerifyPrunedHeader(sdkCtx, req.Hash, req.MmrProof)
+		contains = err == nil
+	}
 	return &types.QueryContainsResponse{Contains: contains}, nil
 }
 
@@ -107,16 +113,18 @@ func (k Keeper) MainChain(c context.Context, req *types.QueryMainChainRequest) (
 	var nextKey []byte
 	if req.Pagination.Reverse {
 		var start, end uint64
-		baseHeader := k.headersState(ctx).BaseHeader()
-		// The base header is located at the end of the mainchain
-		// which requires starting at the end
+		// The base header, or the lowest header that is not pruned, is located
+		// at the end of the mainchain which requires starting at the end
 		mainchain := k.GetMainChainFrom(ctx, 0)
+		firstHeader := mainchain[0]
 
 		if keyHeader == nil {
-			keyHeader = baseHeader
+			keyHeader = firstHeader
 			start = 0
+		} else if keyHeader.Height < firstHeader.Height {
+			return nil, status.Error(codes.InvalidArgument, "header specified by key is pruned from the mainchain")
 		} else {
-			start = keyHeader.Height - baseHeader.Height
+			start = keyHeader.Height - firstHeader.Height
 		}
 		end = start + req.Pagination.Limit
 
@@ -201,9 +209,72 @@ func (k Keeper) HeaderDepth(ctx context.Context, req *types.QueryHeaderDepthRequ
 
 	depth, err := k.MainChainDepth(sdkCtx, &haderHash)
 
+	// the depth of a pruned header is derived from its height proven by the MMR
+	if errors.Is(err, types.ErrHeaderDoesNotExist) && req.MmrProof != nil {
+		var height uint64
+		height, err = k.VerifyPrunedHeader(sdkCtx, &haderHash, req.MmrProof)
+		if err == nil {
+			depth = k.GetTipInfo(sdkCtx).Height - height
+		}
+	}
+
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.QueryHeaderDepthResponse{Depth: uint64(depth)}, nil
 }
+
+func (k Keeper) HeadersMMR(ctx context.Context, req *types.QueryHeadersMMRRequest) (*types.QueryHeadersMMRResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryHeadersMMRResponse{HeadersMmr: k.GetHeadersMMR(sdkCtx)}, nil
+}
+
+func (k Keeper) ReorgHistory(ctx context.Context, req *types.QueryReorgHistoryRequest) (*types.QueryReorgHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var reorgs []*types.BTCReorg
+	store := k.headersState(sdkCtx).reorgs
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var reorg types.BTCReorg
+		if err := k.cdc.Unmarshal(value, &reorg); err != nil {
+			return err
+		}
+		reorgs = append(reorgs, &reorg)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryReorgHistoryResponse{Reorgs: reorgs, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Reporter(ctx context.Context, req *types.QueryReporterRequest) (*types.QueryReporterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	reporterAddress, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	reporter := k.GetReporter(sdkCtx, reporterAddress)
+	if reporter == nil {
+		return nil, types.ErrReporterNotFound.Wrapf("reporter %s", req.Address)
+	}
+
+	return &types.QueryReporterResponse{Reporter: reporter}, nil
+}
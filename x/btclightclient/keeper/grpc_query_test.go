@@ -4,6 +4,9 @@ import (
 	"math/rand"
 	"testing"
 
+	"cosmossdk.io/core/header"
+	"github.com/stretchr/testify/require"
+
 	"github.com/babylonchain/babylon/testutil/datagen"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -407,6 +410,93 @@ func FuzzBaseHeaderQuery(f *testing.F) {
 	})
 }
 
+func FuzzReorgHistoryQuery(f *testing.F) {
+	/*
+		Checks:
+		1. If the request is nil, (nil, error) is returned
+		2. Each reorg is recorded with the Babylon height, the fork point, the
+		   orphaned header hashes and the new tip
+		3. Only the most recent reorgs within the retention are kept
+		4. The paginated query returns all kept reorgs from the oldest to the newest
+
+		Data generation:
+		- Generate a random chain of headers and insert into storage
+		- Insert a random number of better forks, each at a new Babylon height
+	*/
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		params := types.DefaultParams()
+		params.ReorgHistoryRetention = datagen.RandomInt(r, 10) + 1
+		blcKeeper, ctx, _ := keepertest.BTCLightClientKeeperWithCustomParams(t, params)
+
+		// Test nil input
+		_, err := blcKeeper.ReorgHistory(ctx, nil)
+		require.Error(t, err)
+
+		_, _ = datagen.GenRandBtcChainInsertingInKeeper(
+			t,
+			r,
+			blcKeeper,
+			ctx,
+			datagen.RandomInt(r, 50)+10,
+			datagen.RandomInt(r, 50)+10,
+		)
+
+		numReorgs := int(datagen.RandomInt(r, 15)) + 1
+		for i := 0; i < numReorgs; i++ {
+			ctx = ctx.WithHeaderInfo(header.Info{Height: int64(i + 1)})
+			mainChain := blcKeeper.GetMainChainFrom(ctx, 0)
+			tip := mainChain[len(mainChain)-1]
+			forkPoint := mainChain[datagen.RandomInt(r, len(mainChain)-1)]
+			orphaned := mainChain[forkPoint.Height-mainChain[0].Height+1:]
+
+			// the fork is always longer, and thus better, than the main chain
+			forkLength := uint32(tip.Height-forkPoint.Height) + uint32(datagen.RandomInt(r, 5)) + 1
+			fork := datagen.GenRandomValidChainStartingFrom(
+				r,
+				forkPoint.Height,
+				forkPoint.Header.ToBlockHeader(),
+				nil,
+				forkLength,
+			)
+			err := blcKeeper.InsertHeaders(ctx, keepertest.NewBTCHeaderBytesList(fork))
+			require.NoError(t, err)
+
+			reorgs := blcKeeper.GetReorgHistory(ctx)
+			require.Len(t, reorgs, min(i+1, int(params.ReorgHistoryRetention)))
+			require.Equal(t, uint64(i+2-len(reorgs)), reorgs[0].BabylonHeight)
+			reorg := reorgs[len(reorgs)-1]
+			require.Equal(t, uint64(i+1), reorg.BabylonHeight)
+			require.True(t, reorg.ForkPoint.Eq(forkPoint))
+			require.True(t, reorg.NewTip.Eq(blcKeeper.GetTipInfo(ctx)))
+			require.Equal(t, forkPoint.Height+uint64(forkLength), reorg.NewTip.Height)
+			require.Len(t, reorg.OrphanedHeaderHashes, len(orphaned))
+			for j, headerInfo := range orphaned {
+				require.True(t, reorg.OrphanedHeaderHashes[j].Eq(headerInfo.Hash))
+			}
+			require.NoError(t, reorg.Validate())
+		}
+
+		// paginate over the reorg history
+		allReorgs := blcKeeper.GetReorgHistory(ctx)
+		limit := datagen.RandomInt(r, numReorgs) + 1
+		pagination := constructRequestWithLimit(r, limit)
+		queriedReorgs := make([]*types.BTCReorg, 0, numReorgs)
+		for {
+			resp, err := blcKeeper.ReorgHistory(ctx, types.NewQueryReorgHistoryRequest(pagination))
+			require.NoError(t, err)
+			require.LessOrEqual(t, uint64(len(resp.Reorgs)), limit)
+			queriedReorgs = append(queriedReorgs, resp.Reorgs...)
+			if len(resp.Pagination.NextKey) == 0 {
+				break
+			}
+			pagination = constructRequestWithKeyAndLimit(r, resp.Pagination.NextKey, limit)
+		}
+		require.Equal(t, allReorgs, queriedReorgs)
+	})
+}
+
 // Constructors for PageRequest objects
 func constructRequestWithKeyAndLimit(r *rand.Rand, key []byte, limit uint64) *query.PageRequest {
 	// If limit is 0, set one randomly
@@ -19,12 +19,15 @@ import (
 
 type (
 	Keeper struct {
-		cdc          codec.BinaryCodec
-		storeService corestoretypes.KVStoreService
-		hooks        types.BTCLightClientHooks
-		btcConfig    bbn.BtcConfig
-		bl           *types.BtcLightClient
-		authority    string
+		cdc             codec.BinaryCodec
+		storeService    corestoretypes.KVStoreService
+		hooks           types.BTCLightClientHooks
+		bankKeeper      types.BankKeeper
+		incentiveKeeper types.IncentiveKeeper
+		btccKeeper      types.BtcCheckpointKeeper
+		btcConfig       bbn.BtcConfig
+		bl              *types.BtcLightClient
+		authority       string
 	}
 )
 
@@ -34,17 +37,23 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService corestoretypes.KVStoreService,
 	btcConfig bbn.BtcConfig,
+	bankKeeper types.BankKeeper,
+	incentiveKeeper types.IncentiveKeeper,
+	btccKeeper types.BtcCheckpointKeeper,
 	authority string,
 ) Keeper {
 	bl := types.NewBtcLightClientFromParams(btcConfig.NetParams())
 
 	return Keeper{
-		cdc:          cdc,
-		storeService: storeService,
-		hooks:        nil,
-		btcConfig:    btcConfig,
-		bl:           bl,
-		authority:    authority,
+		cdc:             cdc,
+		storeService:    storeService,
+		hooks:           nil,
+		bankKeeper:      bankKeeper,
+		incentiveKeeper: incentiveKeeper,
+		btccKeeper:      btccKeeper,
+		btcConfig:       btcConfig,
+		bl:              bl,
+		authority:       authority,
 	}
 }
 
@@ -77,6 +86,7 @@ func (k *Keeper) SetHooks(bh types.BTCLightClientHooks) *Keeper {
 
 func (k Keeper) insertHeaders(
 	ctx context.Context,
+	reporter sdk.AccAddress,
 	headers []*wire.BlockHeader,
 ) error {
 
@@ -92,9 +102,16 @@ func (k Keeper) insertHeaders(
 	}
 
 	// if we have rollback, first delete all headers up to the rollback point
+	var orphanedHeaders []*types.BTCHeaderInfo
 	if result.RollbackInfo != nil {
+		// the chain cannot be rolled back to a header below the pruned height,
+		// as the headers in between are not maintained anymore
+		if forkHeight := result.RollbackInfo.HeaderToRollbackTo.Height; forkHeight < headerState.unprunedHeight() {
+			return types.ErrForkFromPrunedHeader.Wrapf("fork height %d, pruned height %d", forkHeight, headerState.unprunedHeight())
+		}
+
 		// roll back to the height
-		headerState.rollBackHeadersUpTo(result.RollbackInfo.HeaderToRollbackTo.Height)
+		orphanedHeaders = headerState.rollBackHeadersUpTo(result.RollbackInfo.HeaderToRollbackTo.Height)
 		// trigger rollback event
 		k.triggerRollBack(ctx, result.RollbackInfo.HeaderToRollbackTo)
 	}
@@ -105,6 +122,23 @@ func (k Keeper) insertHeaders(
 		k.triggerHeaderInserted(ctx, h)
 		k.triggerRollForward(ctx, h)
 	}
+
+	// once the new fork is inserted, record the reorg with the new tip
+	if result.RollbackInfo != nil {
+		reorg := types.NewBTCReorg(
+			uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height),
+			result.RollbackInfo.HeaderToRollbackTo,
+			orphanedHeaders,
+			headerState.GetTip(),
+		)
+		headerState.appendReorg(reorg, k.getReorgHistoryRetention(ctx))
+		k.triggerReorg(ctx, reorg)
+	}
+
+	// record the inserted headers for settling them with their reporter later
+	if reporter != nil {
+		k.recordReportedHeaders(ctx, reporter, result.HeadersToInsert)
+	}
 	return nil
 }
 
@@ -117,6 +151,14 @@ func (k Keeper) InsertHeaderInfos(ctx context.Context, infos []*types.BTCHeaderI
 }
 
 func (k Keeper) InsertHeaders(ctx context.Context, headers []bbn.BTCHeaderBytes) error {
+	return k.InsertHeadersFromReporter(ctx, nil, headers)
+}
+
+// InsertHeadersFromReporter inserts the headers reported by the given
+// reporter. If the reporter is bonded, the headers it inserts are settled once
+// they are `ReporterConfirmationDepth` deep, upon which the reporter is
+// rewarded or slashed.
+func (k Keeper) InsertHeadersFromReporter(ctx context.Context, reporter sdk.AccAddress, headers []bbn.BTCHeaderBytes) error {
 	if len(headers) == 0 {
 		return types.ErrEmptyMessage
 	}
@@ -126,7 +168,7 @@ func (k Keeper) InsertHeaders(ctx context.Context, headers []bbn.BTCHeaderBytes)
 		blockHeaders[i] = header.ToBlockHeader()
 	}
 
-	return k.insertHeaders(ctx, blockHeaders)
+	return k.insertHeaders(ctx, reporter, blockHeaders)
 }
 
 // BlockHeight returns the height of the provided header
@@ -196,25 +238,34 @@ func (k Keeper) GetHeaderByHeight(ctx context.Context, height uint64) *types.BTC
 // GetMainChainFrom returns the current canonical chain from the given height up to the tip
 // If the height is higher than the tip, it returns an empty slice
 // If startHeight is 0, it returns the entire main chain
+// If headers are pruned, the main chain starts from the lowest header that is not pruned
 func (k Keeper) GetMainChainFrom(ctx context.Context, startHeight uint64) []*types.BTCHeaderInfo {
+	hs := k.headersState(ctx)
+	if unprunedHeight := hs.unprunedHeight(); startHeight < unprunedHeight {
+		startHeight = unprunedHeight
+	}
+
 	headers := make([]*types.BTCHeaderInfo, 0)
 	accHeaderFn := func(header *types.BTCHeaderInfo) bool {
 		headers = append(headers, header)
 		return false
 	}
-	k.headersState(ctx).IterateForwardHeaders(startHeight, accHeaderFn)
+	hs.IterateForwardHeaders(startHeight, accHeaderFn)
 	return headers
 }
 
 // GetMainChainUpTo returns the current canonical chain as a collection of block headers
 // starting from the tip and ending on the header that has `depth` distance from it.
+// If headers are pruned, it ends on the lowest header that is not pruned at the latest.
 func (k Keeper) GetMainChainUpTo(ctx context.Context, depth uint64) []*types.BTCHeaderInfo {
+	hs := k.headersState(ctx)
+	unprunedHeight := hs.unprunedHeight()
 	headers := make([]*types.BTCHeaderInfo, 0)
 
 	var currentDepth = uint64(0)
 	accHeaderFn := func(header *types.BTCHeaderInfo) bool {
 		// header header is at depth 0.
-		if currentDepth > depth {
+		if currentDepth > depth || header.Height < unprunedHeight {
 			return true
 		}
 
@@ -223,19 +274,38 @@ func (k Keeper) GetMainChainUpTo(ctx context.Context, depth uint64) []*types.BTC
 		return false
 	}
 
-	k.headersState(ctx).IterateReverseHeaders(accHeaderFn)
+	hs.IterateReverseHeaders(accHeaderFn)
 
 	return headers
 }
 
 // GetMainChainReverse Retrieves whole header chain in reverse order
+// If headers are pruned, it ends on the lowest header that is not pruned.
 func (k Keeper) GetMainChainReverse(ctx context.Context) []*types.BTCHeaderInfo {
+	hs := k.headersState(ctx)
+	unprunedHeight := hs.unprunedHeight()
+	headers := make([]*types.BTCHeaderInfo, 0)
+	accHeaderFn := func(header *types.BTCHeaderInfo) bool {
+		if header.Height < unprunedHeight {
+			return true
+		}
+		headers = append(headers, header)
+		return false
+	}
+	hs.IterateReverseHeaders(accHeaderFn)
+	return headers
+}
+
+// GetAllStoredHeaders returns all headers in the storage in increasing order
+// of heights. Different from GetMainChainFrom, it includes the base header and
+// the headers at difficulty adjustment boundaries that are kept after pruning.
+func (k Keeper) GetAllStoredHeaders(ctx context.Context) []*types.BTCHeaderInfo {
 	headers := make([]*types.BTCHeaderInfo, 0)
 	accHeaderFn := func(header *types.BTCHeaderInfo) bool {
 		headers = append(headers, header)
 		return false
 	}
-	k.headersState(ctx).IterateReverseHeaders(accHeaderFn)
+	k.headersState(ctx).IterateForwardHeaders(0, accHeaderFn)
 	return headers
 }
 
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"math/rand"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	bbn "github.com/babylonchain/babylon/types"
@@ -284,6 +285,7 @@ func FuzzKeeperInsertValidBetterChain(f *testing.F) {
 		rollBackType, _ := sdk.TypedEventToEvent(&types.EventBTCRollBack{})
 		rollForwadType, _ := sdk.TypedEventToEvent(&types.EventBTCRollForward{})
 		headerInsertedType, _ := sdk.TypedEventToEvent(&types.EventBTCHeaderInserted{})
+		reorgType, _ := sdk.TypedEventToEvent(&types.EventBTCReorg{})
 
 		events := ctx.EventManager().Events()
 		numEvents := len(events)
@@ -291,10 +293,21 @@ func FuzzKeeperInsertValidBetterChain(f *testing.F) {
 		require.Len(t, mockHooks.AfterBTCRollForwardStore, len(chainToInsert))
 		// there is one roll back event
 		require.Len(t, mockHooks.AfterBTCRollBackStore, 1)
-		require.Equal(t, numEvents, len(chainToInsert)*2+1)
+		require.Equal(t, numEvents, len(chainToInsert)*2+2)
+
+		// the reorg event comes last, and the reorg is recorded in the history
+		require.Equal(t, events[numEvents-1].Type, reorgType.Type)
+		reorgs := blcKeeper.GetReorgHistory(ctx)
+		require.Len(t, reorgs, 1)
+		require.True(t, reorgs[0].ForkPoint.Eq(forkHeaderParent))
+		require.True(t, reorgs[0].NewTip.Eq(newTip))
+		require.Len(t, reorgs[0].OrphanedHeaderHashes, len(removedBranch))
+		for i, headerInfo := range removedBranch {
+			require.True(t, reorgs[0].OrphanedHeaderHashes[i].Eq(headerInfo.Hash))
+		}
 
 		// Events should be ordered:
-		// Rollback, Insert, RollForward, Insert, RollForward, ...
+		// Rollback, Insert, RollForward, Insert, RollForward, ..., Reorg
 		for i, header := range chainToInsert {
 			if i == 0 {
 				// rollback event
@@ -457,3 +470,98 @@ func FuzzKeeperValdateHeaderAtDifficultyAdjustmentBoundaries(f *testing.F) {
 		require.True(t, types.IsRetargetBlock(newTip, &chaincfg.SimNetParams))
 	})
 }
+
+func FuzzKeeperValidateHeadersFromNonRetargetBaseHeader(f *testing.F) {
+	// less seeds as we generate longer chains
+	datagen.AddRandomSeedsToFuzzer(f, 3)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		numBlockPerRetarget := types.BlocksPerRetarget(&chaincfg.SimNetParams)
+
+		genesisHeader := bbn.NewBTCHeaderBytesFromBlockHeader(&chaincfg.SimNetParams.GenesisBlock.Header)
+		genesisHash := bbn.NewBTCHeaderHashBytesFromChainhash(chaincfg.SimNetParams.GenesisHash)
+		genesisWork := types.CalcWork(&genesisHeader)
+		genesisInfo := types.NewBTCHeaderInfo(
+			&genesisHeader,
+			&genesisHash,
+			0,
+			&genesisWork,
+		)
+		chain := datagen.NewBTCHeaderChainFromParentInfo(
+			r,
+			genesisInfo,
+			uint32(numBlockPerRetarget)-1,
+		)
+		chainInfos := append([]*types.BTCHeaderInfo{genesisInfo}, chain.GetChainInfo()...)
+
+		// the base header is in the middle of the first retarget period,
+		// with enough ancestors for the median-time-past
+		baseHeight := datagen.RandomInt(r, int(numBlockPerRetarget)-types.MedianTimeBlocks-1) + types.MedianTimeBlocks
+		baseHeader := chainInfos[baseHeight]
+		require.False(t, types.IsRetargetBlock(baseHeader, &chaincfg.SimNetParams))
+		ancestorTimestamps := make([]int64, 0, types.MedianTimeBlocks-1)
+		for i := uint64(1); i < types.MedianTimeBlocks; i++ {
+			ancestorTimestamps = append(ancestorTimestamps, chainInfos[baseHeight-i].Header.Time().Unix())
+		}
+		baseHeaderCtx := &types.BaseHeaderContext{
+			RetargetHeader:     genesisInfo,
+			AncestorTimestamps: ancestorTimestamps,
+		}
+		require.NoError(t, baseHeaderCtx.Validate(baseHeader, &chaincfg.SimNetParams))
+
+		blcKeeper, ctx := keepertest.BTCLightClientKeeper(t)
+		blcKeeper.SetBaseBTCHeader(ctx, *baseHeader)
+		blcKeeper.SetBaseHeaderContext(ctx, baseHeaderCtx)
+
+		// the median-time-past of the base header is the timestamp of its
+		// ancestor in the middle of the median time window
+		medianTime := chainInfos[baseHeight-types.MedianTimeBlocks/2].Header.Time()
+		tooOldHeader := datagen.GenRandomBtcdValidHeader(
+			r,
+			baseHeader.Header.ToBlockHeader(),
+			&datagen.TimeBetweenBlocksInfo{Time: medianTime.Sub(baseHeader.Header.Time())},
+			nil,
+		)
+		err := blcKeeper.InsertHeaders(ctx, []bbn.BTCHeaderBytes{bbn.NewBTCHeaderBytesFromBlockHeader(tooOldHeader)})
+		require.ErrorIs(t, err, types.ErrInvalidHeader)
+		// a header after the median-time-past is valid even if it is before
+		// its parent
+		oldHeader := datagen.GenRandomBtcdValidHeader(
+			r,
+			baseHeader.Header.ToBlockHeader(),
+			&datagen.TimeBetweenBlocksInfo{Time: medianTime.Sub(baseHeader.Header.Time()) + time.Second},
+			nil,
+		)
+		err = blcKeeper.InsertHeaders(ctx, []bbn.BTCHeaderBytes{bbn.NewBTCHeaderBytesFromBlockHeader(oldHeader)})
+		require.NoError(t, err)
+
+		// the headers after the base header up to the retarget boundary are valid
+		err = blcKeeper.InsertHeaders(ctx, chain.ChainToBytes()[baseHeight:])
+		require.NoError(t, err)
+
+		// the header at the retarget boundary is validated against the
+		// retarget header in the context of the base header
+		currentTip := blcKeeper.GetTipInfo(ctx)
+		require.Equal(t, uint64(numBlockPerRetarget)-1, currentTip.Height)
+		invalidAdjustedHeader := datagen.GenRandomBtcdValidHeader(
+			r,
+			currentTip.Header.ToBlockHeader(),
+			nil,
+			nil,
+		)
+		err = blcKeeper.InsertHeaders(ctx, []bbn.BTCHeaderBytes{bbn.NewBTCHeaderBytesFromBlockHeader(invalidAdjustedHeader)})
+		require.Error(t, err)
+		validAdjustedHeader := datagen.GenRandomBtcdValidHeader(
+			r,
+			currentTip.Header.ToBlockHeader(),
+			nil,
+			&datagen.RetargetInfo{
+				LastRetargetHeader: genesisHeader.ToBlockHeader(),
+				Params:             &chaincfg.SimNetParams,
+			},
+		)
+		err = blcKeeper.InsertHeaders(ctx, []bbn.BTCHeaderBytes{bbn.NewBTCHeaderBytesFromBlockHeader(validAdjustedHeader)})
+		require.NoError(t, err)
+		require.Equal(t, uint64(numBlockPerRetarget), blcKeeper.GetTipInfo(ctx).Height)
+	})
+}
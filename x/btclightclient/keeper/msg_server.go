@@ -28,6 +28,11 @@ func (m msgServer) canInsertHeaders(sdkCtx sdk.Context, reporterAddress sdk.AccA
 		}
 	}
 
+	// reporters out of the allow list can insert headers with enough bond
+	if !allowInsertHeaders && params.BondedReportingEnabled() {
+		allowInsertHeaders = m.k.canReportWithBond(sdkCtx, reporterAddress, params.ReporterBond)
+	}
+
 	return allowInsertHeaders
 }
 
@@ -47,7 +52,7 @@ func (m msgServer) InsertHeaders(ctx context.Context, msg *types.MsgInsertHeader
 		return nil, types.ErrUnauthorizedReporter.Wrapf("reporter %s is not authorized to insert headers", reporterAddress)
 	}
 
-	err := m.k.InsertHeaders(sdkCtx, msg.Headers)
+	err := m.k.InsertHeadersFromReporter(sdkCtx, reporterAddress, msg.Headers)
 
 	if err != nil {
 		return nil, err
@@ -62,6 +67,11 @@ func (ms msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams
 	if err := req.Params.Validate(); err != nil {
 		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid parameter: %v", err)
 	}
+	// pruning must not prune the BTC headers that x/btccheckpoint needs
+	finalizationTimeout := ms.k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	if err := types.ValidatePruningKeepRecentWithFinalizationTimeout(req.Params.PruningKeepRecent, finalizationTimeout); err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid parameter: %v", err)
+	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -72,6 +82,47 @@ func (ms msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+func (ms msgServer) BondReporter(ctx context.Context, req *types.MsgBondReporter) (*types.MsgBondReporterResponse, error) {
+	reporterAddress, err := sdk.AccAddressFromBech32(req.Signer)
+	if err != nil {
+		return nil, types.ErrInvalidMessageFormat.Wrapf("invalid signer address: %v", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := ms.k.GetParams(sdkCtx)
+
+	if !params.BondedReportingEnabled() {
+		return nil, types.ErrInvalidReporterBond.Wrap("bonded reporting is disabled")
+	}
+	if err := req.Amount.Validate(); err != nil {
+		return nil, types.ErrInvalidReporterBond.Wrapf("invalid amount: %v", err)
+	}
+	if req.Amount.Denom != params.ReporterBond.Denom || !req.Amount.IsPositive() {
+		return nil, types.ErrInvalidReporterBond.Wrapf("the bond must be a positive amount of %s, got %s", params.ReporterBond.Denom, req.Amount)
+	}
+
+	if err := ms.k.bondReporter(sdkCtx, reporterAddress, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBondReporterResponse{}, nil
+}
+
+func (ms msgServer) UnbondReporter(ctx context.Context, req *types.MsgUnbondReporter) (*types.MsgUnbondReporterResponse, error) {
+	reporterAddress, err := sdk.AccAddressFromBech32(req.Signer)
+	if err != nil {
+		return nil, types.ErrInvalidMessageFormat.Wrapf("invalid signer address: %v", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, err := ms.k.unbondReporter(sdkCtx, reporterAddress); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnbondReporterResponse{}, nil
+}
+
 // NewMsgServerImpl returns an implementation of the MsgServer interface
 // for the provided Keeper.
 func NewMsgServerImpl(keeper Keeper) types.MsgServer {
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+)
+
+// PruneHeaders prunes at most maxHeaders BTC headers that are at least
+// `PruningKeepRecent` deep, if pruning is enabled. The pruned headers are
+// appended to the MMR of pruned headers in the order of their heights. The
+// base header and the headers at difficulty adjustment boundaries are
+// accumulated in the MMR as well, but are kept in the storage, as they are
+// needed for validating new headers. So are the headers retained via
+// RetainHeader. `PruningKeepRecent` is at least the maximum staking time, such
+// that the headers including staking txs whose timelocks have not expired are
+// kept.
+// This is triggered upon each `EndBlock`.
+func (k Keeper) PruneHeaders(ctx context.Context, maxHeaders uint64) {
+	params := k.GetParams(ctx)
+	if !params.PruningEnabled() {
+		return
+	}
+
+	hs := k.headersState(ctx)
+	tip := hs.GetTip()
+	if tip == nil {
+		return
+	}
+	mmr := hs.GetHeadersMMR()
+	if mmr == nil {
+		mmr = types.NewHeadersMMR(hs.BaseHeader().Height)
+	}
+
+	numPruned := uint64(0)
+	for ; numPruned < maxHeaders && mmr.NextHeight()+params.PruningKeepRecent <= tip.Height; numPruned++ {
+		header, err := hs.GetHeaderByHeight(mmr.NextHeight())
+		if err != nil {
+			// the headers above the pruned height are contiguous in the storage
+			panic(fmt.Errorf("failed to get the header to prune at height %d: %w", mmr.NextHeight(), err))
+		}
+		mmr.Append(header.Hash)
+		if header.Height != mmr.FirstHeight && !types.IsRetargetBlock(header, k.GetBTCNet()) && !hs.isHeaderRetained(header.Height) {
+			hs.deleteHeader(header)
+		}
+	}
+
+	if numPruned > 0 {
+		hs.setHeadersMMR(mmr)
+	}
+}
+
+// RetainHeader keeps the BTC header with the given hash in the storage after it
+// is pruned, such that other modules can still look it up, e.g., the headers
+// including the best submission of a finalized BTC checkpoint. It returns an
+// error if the header is not in the storage.
+func (k Keeper) RetainHeader(ctx context.Context, hash *bbn.BTCHeaderHashBytes) error {
+	hs := k.headersState(ctx)
+	header, err := hs.GetHeaderByHash(hash)
+	if err != nil {
+		return err
+	}
+	hs.retainHeader(header.Height)
+	return nil
+}
+
+// SetRetainedHeaderHeight marks the BTC header at the given height to be kept
+// in the storage after it is pruned
+func (k Keeper) SetRetainedHeaderHeight(ctx context.Context, height uint64) {
+	k.headersState(ctx).retainHeader(height)
+}
+
+// GetRetainedHeaderHeights returns the heights of the BTC headers that are
+// kept in the storage after they are pruned, in increasing order
+func (k Keeper) GetRetainedHeaderHeights(ctx context.Context) []uint64 {
+	heights := []uint64{}
+	k.headersState(ctx).IterateRetainedHeights(func(height uint64) bool {
+		heights = append(heights, height)
+		return false
+	})
+	return heights
+}
+
+// GetLowestUnprunedHeight returns the height of the lowest BTC header that is
+// not pruned. BTC headers below it are pruned, except for the base header, the
+// headers at difficulty adjustment boundaries and the retained headers.
+func (k Keeper) GetLowestUnprunedHeight(ctx context.Context) uint64 {
+	return k.headersState(ctx).unprunedHeight()
+}
+
+// GetPruningKeepRecent returns the number of the most recent BTC headers that
+// are kept in the storage, or 0 if pruning is disabled
+func (k Keeper) GetPruningKeepRecent(ctx context.Context) uint64 {
+	return k.GetParams(ctx).PruningKeepRecent
+}
+
+// GetHeadersMMR returns the MMR of the pruned BTC headers, or nil if no BTC
+// header has been pruned
+func (k Keeper) GetHeadersMMR(ctx context.Context) *types.HeadersMMR {
+	return k.headersState(ctx).GetHeadersMMR()
+}
+
+// SetHeadersMMR sets the MMR of the pruned BTC headers
+func (k Keeper) SetHeadersMMR(ctx context.Context, mmr *types.HeadersMMR) {
+	k.headersState(ctx).setHeadersMMR(mmr)
+}
+
+// VerifyPrunedHeader verifies that the BTC header with the given hash is
+// accumulated in the MMR of pruned headers, and returns its height
+func (k Keeper) VerifyPrunedHeader(ctx context.Context, hash *bbn.BTCHeaderHashBytes, proof *types.MMRProof) (uint64, error) {
+	mmr := k.headersState(ctx).GetHeadersMMR()
+	if mmr == nil {
+		return 0, types.ErrInvalidMMRProof.Wrap("no header has been pruned")
+	}
+	if err := mmr.VerifyInclusion(hash, proof); err != nil {
+		return 0, types.ErrInvalidMMRProof.Wrap(err.Error())
+	}
+	return mmr.FirstHeight + proof.LeafIndex, nil
+}
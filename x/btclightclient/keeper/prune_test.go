@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btclightclient"
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+)
+
+func FuzzPruneHeaders(f *testing.F) {
+	/*
+		Checks:
+		1. PruneHeaders prunes the headers that are at least `PruningKeepRecent`
+		   deep, at most the given number of headers at a time
+		2. the base header and the retained headers are kept, and the main chain
+		   starts from the lowest header that is not pruned
+		3. pruned headers are contained and have depths given MMR proofs
+		4. a fork from a pruned header is rejected
+		5. the MMR keeps accumulating headers as the chain grows
+		6. the pruned state is exported and imported via genesis
+	*/
+	datagen.AddRandomSeedsToFuzzer(f, 5)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		keepRecent := uint64(types.MinPruningKeepRecent) + datagen.RandomInt(r, 10)
+		params := types.DefaultParams()
+		params.PruningKeepRecent = keepRecent
+		blcKeeper, ctx, _ := keepertest.BTCLightClientKeeperWithCustomParams(t, params)
+
+		chainLength := keepRecent + datagen.RandomInt(r, 50) + 2
+		baseHeader, chain := datagen.GenRandBtcChainInsertingInKeeper(t, r, blcKeeper, ctx, 0, chainLength)
+		allHeaders := append([]*types.BTCHeaderInfo{baseHeader}, chain.GetChainInfo()...)
+		tip := chain.GetTipInfo()
+		hashes := make([]*bbn.BTCHeaderHashBytes, 0, len(allHeaders))
+		for _, header := range allHeaders {
+			hashes = append(hashes, header.Hash)
+		}
+
+		// retain a header to be pruned
+		retainedHeader := allHeaders[1]
+		err := blcKeeper.RetainHeader(ctx, retainedHeader.Hash)
+		require.NoError(t, err)
+
+		// prune headers in two rounds
+		numToPrune := tip.Height - keepRecent + 1
+		numFirstRound := datagen.RandomInt(r, int(numToPrune)) + 1
+		blcKeeper.PruneHeaders(ctx, numFirstRound)
+		require.Equal(t, numFirstRound, blcKeeper.GetHeadersMMR(ctx).NumLeaves)
+		blcKeeper.PruneHeaders(ctx, types.MaxHeadersToPrunePerBlock)
+		mmr := blcKeeper.GetHeadersMMR(ctx)
+		require.Equal(t, baseHeader.Height, mmr.FirstHeight)
+		require.Equal(t, numToPrune, mmr.NumLeaves)
+
+		// the base header and the retained header are kept, while the other
+		// pruned headers are not
+		require.Equal(t, mmr.NextHeight(), blcKeeper.GetLowestUnprunedHeight(ctx))
+		for _, header := range allHeaders {
+			storedHeader := blcKeeper.GetHeaderByHash(ctx, header.Hash)
+			if header.Height == baseHeader.Height || header.Height == retainedHeader.Height || header.Height >= mmr.NextHeight() {
+				require.NotNil(t, storedHeader)
+			} else {
+				require.Nil(t, storedHeader)
+			}
+		}
+		require.True(t, baseHeader.Eq(blcKeeper.GetBaseBTCHeader(ctx)))
+		mainChain := blcKeeper.GetMainChainFrom(ctx, 0)
+		require.Len(t, mainChain, int(keepRecent))
+		require.Equal(t, mmr.NextHeight(), mainChain[0].Height)
+		require.Len(t, blcKeeper.GetMainChainUpTo(ctx, tip.Height), int(keepRecent))
+		depth, err := blcKeeper.MainChainDepth(ctx, retainedHeader.Hash)
+		require.NoError(t, err)
+		require.Equal(t, tip.Height-retainedHeader.Height, depth)
+
+		// a pruned header is only contained given a valid MMR proof
+		prunedHeader := allHeaders[datagen.RandomInt(r, int(numToPrune)-2)+2]
+		proof, err := types.GenMMRProof(hashes[:numToPrune], prunedHeader.Height-mmr.FirstHeight)
+		require.NoError(t, err)
+		containsResp, err := blcKeeper.Contains(ctx, &types.QueryContainsRequest{Hash: prunedHeader.Hash})
+		require.NoError(t, err)
+		require.False(t, containsResp.Contains)
+		containsResp, err = blcKeeper.Contains(ctx, &types.QueryContainsRequest{Hash: prunedHeader.Hash, MmrProof: proof})
+		require.NoError(t, err)
+		require.True(t, containsResp.Contains)
+		_, err = blcKeeper.HeaderDepth(ctx, &types.QueryHeaderDepthRequest{Hash: prunedHeader.Hash.MarshalHex()})
+		require.ErrorIs(t, err, types.ErrHeaderDoesNotExist)
+		depthResp, err := blcKeeper.HeaderDepth(ctx, &types.QueryHeaderDepthRequest{Hash: prunedHeader.Hash.MarshalHex(), MmrProof: proof})
+		require.NoError(t, err)
+		require.Equal(t, tip.Height-prunedHeader.Height, depthResp.Depth)
+		invalidProof := &types.MMRProof{LeafIndex: (proof.LeafIndex + 1) % numToPrune, Siblings: proof.Siblings}
+		containsResp, err = blcKeeper.Contains(ctx, &types.QueryContainsRequest{Hash: prunedHeader.Hash, MmrProof: invalidProof})
+		require.NoError(t, err)
+		require.False(t, containsResp.Contains)
+		_, err = blcKeeper.HeaderDepth(ctx, &types.QueryHeaderDepthRequest{Hash: prunedHeader.Hash.MarshalHex(), MmrProof: invalidProof})
+		require.ErrorIs(t, err, types.ErrInvalidMMRProof)
+
+		// a fork from the base header is rejected, as the headers after it are pruned
+		forkChain := datagen.GenRandomValidChainStartingFrom(
+			r,
+			baseHeader.Height,
+			baseHeader.Header.ToBlockHeader(),
+			nil,
+			uint32(tip.Height)+1,
+		)
+		err = blcKeeper.InsertHeaders(ctx, keepertest.NewBTCHeaderBytesList(forkChain))
+		require.ErrorIs(t, err, types.ErrForkFromPrunedHeader)
+
+		// the MMR keeps accumulating headers as the chain grows
+		chainExtension := datagen.GenRandomValidChainStartingFrom(
+			r,
+			tip.Height,
+			tip.Header.ToBlockHeader(),
+			nil,
+			uint32(datagen.RandomInt(r, 10))+1,
+		)
+		err = blcKeeper.InsertHeaders(ctx, keepertest.NewBTCHeaderBytesList(chainExtension))
+		require.NoError(t, err)
+		for _, header := range chainExtension {
+			blockHash := header.BlockHash()
+			hash := bbn.NewBTCHeaderHashBytesFromChainhash(&blockHash)
+			hashes = append(hashes, &hash)
+		}
+		blcKeeper.PruneHeaders(ctx, types.MaxHeadersToPrunePerBlock)
+		newTip := blcKeeper.GetTipInfo(ctx)
+		mmr = blcKeeper.GetHeadersMMR(ctx)
+		require.Equal(t, newTip.Height-keepRecent+1, mmr.NumLeaves)
+		leafIndex := datagen.RandomInt(r, int(mmr.NumLeaves))
+		proof, err = types.GenMMRProof(hashes[:mmr.NumLeaves], leafIndex)
+		require.NoError(t, err)
+		height, err := blcKeeper.VerifyPrunedHeader(ctx, hashes[leafIndex], proof)
+		require.NoError(t, err)
+		require.Equal(t, mmr.FirstHeight+leafIndex, height)
+
+		// the pruned state is exported and imported via genesis
+		gs := btclightclient.ExportGenesis(ctx, *blcKeeper)
+		require.NoError(t, gs.Validate())
+		require.Equal(t, mmr, gs.HeadersMmr)
+		newKeeper, newCtx := keepertest.BTCLightClientKeeper(t)
+		btclightclient.InitGenesis(newCtx, *newKeeper, *gs)
+		require.Equal(t, mmr, newKeeper.GetHeadersMMR(newCtx))
+		require.True(t, baseHeader.Eq(newKeeper.GetBaseBTCHeader(newCtx)))
+		require.Equal(t, blcKeeper.GetMainChainFrom(ctx, 0), newKeeper.GetMainChainFrom(newCtx, 0))
+		require.Equal(t, []uint64{retainedHeader.Height}, newKeeper.GetRetainedHeaderHeights(newCtx))
+	})
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+)
+
+// GetReorgHistory returns all reorgs of the BTC chain observed by the BTC
+// light client, from the oldest to the newest
+func (k Keeper) GetReorgHistory(ctx context.Context) []*types.BTCReorg {
+	reorgs := make([]*types.BTCReorg, 0)
+	k.headersState(ctx).IterateReorgs(func(reorg *types.BTCReorg) bool {
+		reorgs = append(reorgs, reorg)
+		return false
+	})
+	return reorgs
+}
+
+// getReorgHistoryRetention returns the maximum number of the most recent reorgs
+// kept in the reorg history
+func (k Keeper) getReorgHistoryRetention(ctx context.Context) uint64 {
+	params := k.GetParams(ctx)
+	return params.ReorgHistoryRetentionOrDefault()
+}
+
+// AppendReorg appends the reorg to the reorg history, and prunes the oldest
+// reorgs beyond the retention in the parameters
+func (k Keeper) AppendReorg(ctx context.Context, reorg *types.BTCReorg) {
+	k.headersState(ctx).appendReorg(reorg, k.getReorgHistoryRetention(ctx))
+}
@@ -0,0 +1,273 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+)
+
+// GetReporter returns the information of the given bonded reporter, or nil if
+// the reporter is not bonded
+func (k Keeper) GetReporter(ctx context.Context, reporter sdk.AccAddress) *types.ReporterInfo {
+	bz := k.reporterStore(ctx).Get(reporter)
+	if bz == nil {
+		return nil
+	}
+	var info types.ReporterInfo
+	k.cdc.MustUnmarshal(bz, &info)
+	return &info
+}
+
+// SetReporter sets the information of a bonded reporter
+func (k Keeper) SetReporter(ctx context.Context, info *types.ReporterInfo) {
+	reporter := sdk.MustAccAddressFromBech32(info.Address)
+	k.reporterStore(ctx).Set(reporter, k.cdc.MustMarshal(info))
+}
+
+// GetAllReporters returns the information of all bonded reporters
+func (k Keeper) GetAllReporters(ctx context.Context) []*types.ReporterInfo {
+	reporters := make([]*types.ReporterInfo, 0)
+	it := k.reporterStore(ctx).Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var info types.ReporterInfo
+		k.cdc.MustUnmarshal(it.Value(), &info)
+		reporters = append(reporters, &info)
+	}
+	return reporters
+}
+
+// SetReportedHeader sets a header inserted by a bonded reporter that is not
+// settled yet
+func (k Keeper) SetReportedHeader(ctx context.Context, reportedHeader *types.ReportedHeader) {
+	key := types.ReportedHeaderKey(reportedHeader.Height, reportedHeader.Hash)
+	k.reportedHeaderStore(ctx).Set(key, k.cdc.MustMarshal(reportedHeader))
+}
+
+// GetAllReportedHeaders returns all headers inserted by bonded reporters that
+// are not settled yet, in the order of their heights
+func (k Keeper) GetAllReportedHeaders(ctx context.Context) []*types.ReportedHeader {
+	return k.getReportedHeadersUpTo(ctx, nil)
+}
+
+// getReportedHeadersUpTo returns the reported headers up to the given height,
+// or all reported headers if the given height is nil
+func (k Keeper) getReportedHeadersUpTo(ctx context.Context, height *uint64) []*types.ReportedHeader {
+	var end []byte
+	if height != nil {
+		end = sdk.Uint64ToBigEndian(*height + 1)
+	}
+
+	reportedHeaders := make([]*types.ReportedHeader, 0)
+	it := k.reportedHeaderStore(ctx).Iterator(nil, end)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var reportedHeader types.ReportedHeader
+		k.cdc.MustUnmarshal(it.Value(), &reportedHeader)
+		reportedHeaders = append(reportedHeaders, &reportedHeader)
+	}
+	return reportedHeaders
+}
+
+// recordReportedHeaders records the headers inserted by the given reporter, if
+// the reporter is bonded, such that they are settled with the reporter once
+// they are deep enough
+func (k Keeper) recordReportedHeaders(ctx context.Context, reporter sdk.AccAddress, headers []*types.BTCHeaderInfo) {
+	info := k.GetReporter(ctx, reporter)
+	if info == nil {
+		return
+	}
+
+	store := k.reportedHeaderStore(ctx)
+	for _, header := range headers {
+		key := types.ReportedHeaderKey(header.Height, header.Hash)
+		if store.Has(key) {
+			// the header was orphaned and inserted again before being settled,
+			// in which case it stays with its first reporter
+			continue
+		}
+		k.SetReportedHeader(ctx, &types.ReportedHeader{
+			Height:   header.Height,
+			Hash:     header.Hash,
+			Reporter: info.Address,
+		})
+		info.PendingHeaders++
+	}
+	k.SetReporter(ctx, info)
+}
+
+// canReportWithBond returns whether the given reporter has locked enough bond
+// for inserting headers
+func (k Keeper) canReportWithBond(ctx context.Context, reporter sdk.AccAddress, minBond sdk.Coin) bool {
+	info := k.GetReporter(ctx, reporter)
+	if info == nil {
+		return false
+	}
+	return info.Bond.Denom == minBond.Denom && info.Bond.IsGTE(minBond)
+}
+
+// bondReporter locks the given amount from the reporter's account as its bond
+func (k Keeper) bondReporter(ctx context.Context, reporter sdk.AccAddress, amount sdk.Coin) error {
+	info := k.GetReporter(ctx, reporter)
+	if info == nil {
+		info = &types.ReporterInfo{
+			Address: reporter.String(),
+			Bond:    sdk.NewCoin(amount.Denom, math.ZeroInt()),
+		}
+	}
+	if info.Bond.Denom != amount.Denom {
+		return types.ErrInvalidReporterBond.Wrapf("the existing bond is in %s, unbond it before bonding %s", info.Bond.Denom, amount.Denom)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, reporter, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+	info.Bond = info.Bond.Add(amount)
+	k.SetReporter(ctx, info)
+	return nil
+}
+
+// unbondReporter unlocks the whole bond of the reporter, given that all
+// headers inserted by the reporter are settled
+func (k Keeper) unbondReporter(ctx context.Context, reporter sdk.AccAddress) (sdk.Coin, error) {
+	info := k.GetReporter(ctx, reporter)
+	if info == nil {
+		return sdk.Coin{}, types.ErrReporterNotFound.Wrapf("reporter %s", reporter)
+	}
+	if info.PendingHeaders > 0 {
+		return sdk.Coin{}, types.ErrReporterHasPendingHeaders.Wrapf("reporter %s has %d pending headers", reporter, info.PendingHeaders)
+	}
+
+	if info.Bond.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, reporter, sdk.NewCoins(info.Bond)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+	k.reporterStore(ctx).Delete(reporter)
+	return info.Bond, nil
+}
+
+// SettleReportedHeaders settles the headers inserted by bonded reporters that
+// are at least `ReporterConfirmationDepth` deep. The reporter of a header that
+// is on the canonical chain earns `ReporterReward` from the reporting reward
+// pool in x/incentive. A reporter whose headers settled in this window are
+// all orphaned loses a portion of its bond, at most once per window, such that
+// a reporter relaying a fork that gets reorged is not slashed per header.
+// This is triggered upon each `EndBlock`.
+func (k Keeper) SettleReportedHeaders(ctx context.Context) {
+	params := k.GetParams(ctx)
+	tip := k.GetTipInfo(ctx)
+	// the confirmation depth is not set if bonded reporting has never been
+	// enabled, in which case there is no reported header
+	if params.ReporterConfirmationDepth == 0 || tip == nil || tip.Height < params.ReporterConfirmationDepth {
+		return
+	}
+	settledHeight := tip.Height - params.ReporterConfirmationDepth
+
+	reportedHeaders := k.getReportedHeadersUpTo(ctx, &settledHeight)
+	if len(reportedHeaders) == 0 {
+		return
+	}
+
+	store := k.reportedHeaderStore(ctx)
+	canonicalReporters := make([]sdk.AccAddress, 0, len(reportedHeaders))
+	// the reporters of the settled headers in the order of their first
+	// settled headers, along with whether any of their settled headers is
+	// canonical and their first orphaned header
+	settledReporters := make([]*types.ReporterInfo, 0)
+	reporterInfos := make(map[string]*types.ReporterInfo)
+	hasCanonicalHeaders := make(map[string]bool)
+	firstOrphanedHeaders := make(map[string]*types.ReportedHeader)
+	for _, reportedHeader := range reportedHeaders {
+		store.Delete(types.ReportedHeaderKey(reportedHeader.Height, reportedHeader.Hash))
+
+		reporter := sdk.MustAccAddressFromBech32(reportedHeader.Reporter)
+		info, ok := reporterInfos[reportedHeader.Reporter]
+		if !ok {
+			info = k.GetReporter(ctx, reporter)
+			if info == nil {
+				// a reporter cannot unbond before its headers are settled
+				panic(fmt.Errorf("reporter %s of the reported header %s is not bonded", reportedHeader.Reporter, reportedHeader.Hash.MarshalHex()))
+			}
+			reporterInfos[reportedHeader.Reporter] = info
+			settledReporters = append(settledReporters, info)
+		}
+		info.PendingHeaders--
+
+		header := k.GetHeaderByHeight(ctx, reportedHeader.Height)
+		switch {
+		case header == nil:
+			// the canonical header at this height is pruned, so the reported
+			// header can be settled neither way
+		case header.Hash.Eq(reportedHeader.Hash):
+			info.CanonicalHeaders++
+			canonicalReporters = append(canonicalReporters, reporter)
+			hasCanonicalHeaders[reportedHeader.Reporter] = true
+		default:
+			info.OrphanedHeaders++
+			if _, ok := firstOrphanedHeaders[reportedHeader.Reporter]; !ok {
+				firstOrphanedHeaders[reportedHeader.Reporter] = reportedHeader
+			}
+		}
+	}
+
+	for _, info := range settledReporters {
+		orphanedHeader, ok := firstOrphanedHeaders[info.Address]
+		if ok && !hasCanonicalHeaders[info.Address] {
+			k.slashReporter(ctx, info, orphanedHeader, params.ReporterSlashingRate)
+		}
+		k.SetReporter(ctx, info)
+	}
+
+	if len(canonicalReporters) > 0 {
+		k.incentiveKeeper.RewardBTCHeaderReporters(ctx, canonicalReporters, params.ReporterRewardPerHeader())
+	}
+}
+
+// slashReporter slashes the given portion of the reporter's bond for the
+// orphaned headers settled in a window, represented by the first of them, and
+// sends the slashed amount to the reporting reward pool
+func (k Keeper) slashReporter(ctx context.Context, info *types.ReporterInfo, orphanedHeader *types.ReportedHeader, rate math.LegacyDec) {
+	slashedAmount := math.LegacyNewDecFromInt(info.Bond.Amount).Mul(rate).TruncateInt()
+	if !slashedAmount.IsPositive() {
+		return
+	}
+	slashed := sdk.NewCoin(info.Bond.Denom, slashedAmount)
+	info.Bond = info.Bond.Sub(slashed)
+
+	if err := k.incentiveKeeper.FundBTCHeaderReportingPool(ctx, types.ModuleName, sdk.NewCoins(slashed)); err != nil {
+		// the slashed amount is always locked in the module account
+		panic(fmt.Errorf("failed to send the slashed bond of reporter %s to the reporting pool: %w", info.Address, err))
+	}
+
+	k.emitTypedEventWithLog(ctx, &types.EventBTCReporterSlashed{
+		Reporter:       info.Address,
+		OrphanedHeader: orphanedHeader,
+		SlashedAmount:  slashed,
+	})
+}
+
+// reporterStore returns the KVStore of the bonded reporters
+// prefix: ReporterPrefix
+// key: reporter address
+// value: ReporterInfo
+func (k Keeper) reporterStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.ReporterPrefix)
+}
+
+// reportedHeaderStore returns the KVStore of the headers inserted by bonded
+// reporters that are not settled yet
+// prefix: ReportedHeaderPrefix
+// key: (header height || header hash)
+// value: ReportedHeader
+func (k Keeper) reportedHeaderStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.ReportedHeaderPrefix)
+}
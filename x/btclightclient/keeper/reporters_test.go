@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/btclightclient"
+	"github.com/babylonchain/babylon/x/btclightclient/keeper"
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+)
+
+func FuzzBondedReporting(f *testing.F) {
+	/*
+		Checks:
+		1. reporters out of the allow list can only insert headers with enough bond
+		2. a reporter cannot unbond before the headers it inserted are settled
+		3. the bonded reporters and their pending headers are exported via genesis
+		4. headers settled on the canonical chain earn the reporter reward to
+		   their reporter
+		5. a reporter whose settled headers are all orphaned is slashed once,
+		   and a reporter that also has a settled canonical header is not
+		6. a reporter slashed below the minimum bond cannot insert headers
+		7. a reporter can unbond after the headers it inserted are settled
+	*/
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bankKeeper := types.NewMockBankKeeper(ctrl)
+		incentiveKeeper := types.NewMockIncentiveKeeper(ctrl)
+
+		minBond := sdk.NewInt64Coin("ubbn", int64(datagen.RandomInt(r, 1000)+100))
+		params := types.DefaultParams()
+		params.ReporterBond = minBond
+		params.ReporterReward = sdk.NewInt64Coin("ubbn", int64(datagen.RandomInt(r, 100)+1))
+		// deeper than the reported chains below, such that they are settled
+		// only after being buried
+		params.ReporterConfirmationDepth = datagen.RandomInt(r, 10) + 22
+		require.NoError(t, params.Validate())
+		blcKeeper, ctx, _ := keepertest.BTCLightClientKeeperWithKeepers(t, params, bankKeeper, incentiveKeeper)
+		srv := keeper.NewMsgServerImpl(*blcKeeper)
+
+		_, chain := datagen.GenRandBtcChainInsertingInKeeper(t, r, blcKeeper, ctx, 0, datagen.RandomInt(r, 20)+10)
+		initTip := chain.GetTipInfo()
+
+		honestReporter := datagen.GenRandomAccount().GetAddress()
+		spamReporter := datagen.GenRandomAccount().GetAddress()
+		relayReporter := datagen.GenRandomAccount().GetAddress()
+
+		// an unbonded reporter cannot insert headers
+		spamChain := datagen.GenRandomValidChainStartingFrom(
+			r,
+			initTip.Height,
+			initTip.Header.ToBlockHeader(),
+			nil,
+			uint32(datagen.RandomInt(r, 10))+2,
+		)
+		spamMsg := &types.MsgInsertHeaders{Signer: spamReporter.String(), Headers: keepertest.NewBTCHeaderBytesList(spamChain)}
+		_, err := srv.InsertHeaders(ctx, spamMsg)
+		require.ErrorIs(t, err, types.ErrUnauthorizedReporter)
+
+		// a bond in another denom is rejected
+		_, err = srv.BondReporter(ctx, &types.MsgBondReporter{Signer: spamReporter.String(), Amount: sdk.NewInt64Coin("uatom", minBond.Amount.Int64())})
+		require.ErrorIs(t, err, types.ErrInvalidReporterBond)
+
+		// all reporters bond the minimum bond
+		for _, reporter := range []sdk.AccAddress{honestReporter, spamReporter, relayReporter} {
+			bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), reporter, types.ModuleName, sdk.NewCoins(minBond)).Return(nil).Times(1)
+			_, err = srv.BondReporter(ctx, &types.MsgBondReporter{Signer: reporter.String(), Amount: minBond})
+			require.NoError(t, err)
+			require.Equal(t, minBond, blcKeeper.GetReporter(ctx, reporter).Bond)
+		}
+
+		// the relay reporter inserts a header that gets orphaned by the spam
+		// reporter's chain
+		relayFork := datagen.GenRandomValidChainStartingFrom(
+			r,
+			initTip.Height,
+			initTip.Header.ToBlockHeader(),
+			nil,
+			1,
+		)
+		_, err = srv.InsertHeaders(ctx, &types.MsgInsertHeaders{Signer: relayReporter.String(), Headers: keepertest.NewBTCHeaderBytesList(relayFork)})
+		require.NoError(t, err)
+
+		// the spam reporter extends the chain, and the relay reporter and the
+		// honest reporter insert a better fork, orphaning the spam chain
+		_, err = srv.InsertHeaders(ctx, spamMsg)
+		require.NoError(t, err)
+		require.Equal(t, uint64(len(spamChain)), blcKeeper.GetReporter(ctx, spamReporter).PendingHeaders)
+		betterChain := datagen.GenRandomValidChainStartingFrom(
+			r,
+			initTip.Height,
+			initTip.Header.ToBlockHeader(),
+			nil,
+			uint32(len(spamChain))+uint32(datagen.RandomInt(r, 10))+2,
+		)
+		relayChain, honestChain := betterChain[:len(spamChain)+1], betterChain[len(spamChain)+1:]
+		_, err = srv.InsertHeaders(ctx, &types.MsgInsertHeaders{Signer: relayReporter.String(), Headers: keepertest.NewBTCHeaderBytesList(relayChain)})
+		require.NoError(t, err)
+		require.Equal(t, uint64(len(relayFork)+len(relayChain)), blcKeeper.GetReporter(ctx, relayReporter).PendingHeaders)
+		honestMsg := &types.MsgInsertHeaders{Signer: honestReporter.String(), Headers: keepertest.NewBTCHeaderBytesList(honestChain)}
+		_, err = srv.InsertHeaders(ctx, honestMsg)
+		require.NoError(t, err)
+		require.Equal(t, uint64(len(honestChain)), blcKeeper.GetReporter(ctx, honestReporter).PendingHeaders)
+		numReportedHeaders := len(relayFork) + len(spamChain) + len(betterChain)
+		require.Len(t, blcKeeper.GetAllReportedHeaders(ctx), numReportedHeaders)
+
+		// no reporter can unbond before its headers are settled
+		_, err = srv.UnbondReporter(ctx, &types.MsgUnbondReporter{Signer: honestReporter.String()})
+		require.ErrorIs(t, err, types.ErrReporterHasPendingHeaders)
+
+		// the reporters and their pending headers are exported via genesis
+		gs := btclightclient.ExportGenesis(ctx, *blcKeeper)
+		require.NoError(t, gs.Validate())
+		require.Len(t, gs.Reporters, 3)
+		require.Len(t, gs.ReportedHeaders, numReportedHeaders)
+
+		// nothing is settled before the headers are deep enough
+		blcKeeper.SettleReportedHeaders(ctx)
+		require.Len(t, blcKeeper.GetAllReportedHeaders(ctx), numReportedHeaders)
+
+		// bury the reported headers at the confirmation depth
+		honestTip := blcKeeper.GetTipInfo(ctx)
+		burying := datagen.GenRandomValidChainStartingFrom(
+			r,
+			honestTip.Height,
+			honestTip.Header.ToBlockHeader(),
+			nil,
+			uint32(params.ReporterConfirmationDepth),
+		)
+		err = blcKeeper.InsertHeaders(ctx, keepertest.NewBTCHeaderBytesList(burying))
+		require.NoError(t, err)
+
+		// the relay and honest reporters are rewarded once per canonical
+		// header, in the order of the heights, and the spam reporter is
+		// slashed once for all its orphaned headers. The relay reporter is not
+		// slashed for its orphaned header, as it also relayed the better fork
+		expectedRewarded := make([]sdk.AccAddress, 0, len(betterChain))
+		for range relayChain {
+			expectedRewarded = append(expectedRewarded, relayReporter)
+		}
+		for range honestChain {
+			expectedRewarded = append(expectedRewarded, honestReporter)
+		}
+		incentiveKeeper.EXPECT().RewardBTCHeaderReporters(gomock.Any(), expectedRewarded, sdk.NewCoins(params.ReporterReward)).Times(1)
+		slashed := sdk.NewCoin(minBond.Denom, sdkmath.LegacyNewDecFromInt(minBond.Amount).Mul(params.ReporterSlashingRate).TruncateInt())
+		if slashed.IsPositive() {
+			incentiveKeeper.EXPECT().FundBTCHeaderReportingPool(gomock.Any(), types.ModuleName, sdk.NewCoins(slashed)).Return(nil).Times(1)
+		}
+		expectedBond := minBond.Sub(slashed)
+		blcKeeper.SettleReportedHeaders(ctx)
+		require.Empty(t, blcKeeper.GetAllReportedHeaders(ctx))
+
+		honestInfo := blcKeeper.GetReporter(ctx, honestReporter)
+		require.Zero(t, honestInfo.PendingHeaders)
+		require.Equal(t, uint64(len(honestChain)), honestInfo.CanonicalHeaders)
+		require.Zero(t, honestInfo.OrphanedHeaders)
+		require.Equal(t, minBond, honestInfo.Bond)
+		spamInfo := blcKeeper.GetReporter(ctx, spamReporter)
+		require.Zero(t, spamInfo.PendingHeaders)
+		require.Zero(t, spamInfo.CanonicalHeaders)
+		require.Equal(t, uint64(len(spamChain)), spamInfo.OrphanedHeaders)
+		require.Equal(t, expectedBond, spamInfo.Bond)
+		relayInfo := blcKeeper.GetReporter(ctx, relayReporter)
+		require.Zero(t, relayInfo.PendingHeaders)
+		require.Equal(t, uint64(len(relayChain)), relayInfo.CanonicalHeaders)
+		require.Equal(t, uint64(len(relayFork)), relayInfo.OrphanedHeaders)
+		require.Equal(t, minBond, relayInfo.Bond)
+
+		// the spam reporter, slashed below the minimum bond, cannot insert
+		// headers anymore
+		tip := blcKeeper.GetTipInfo(ctx)
+		newChain := datagen.GenRandomValidChainStartingFrom(r, tip.Height, tip.Header.ToBlockHeader(), nil, 1)
+		_, err = srv.InsertHeaders(ctx, &types.MsgInsertHeaders{Signer: spamReporter.String(), Headers: keepertest.NewBTCHeaderBytesList(newChain)})
+		if expectedBond.IsLT(minBond) {
+			require.ErrorIs(t, err, types.ErrUnauthorizedReporter)
+		} else {
+			require.NoError(t, err)
+		}
+
+		// the honest reporter unbonds its whole bond
+		bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, honestReporter, sdk.NewCoins(minBond)).Return(nil).Times(1)
+		_, err = srv.UnbondReporter(ctx, &types.MsgUnbondReporter{Signer: honestReporter.String()})
+		require.NoError(t, err)
+		require.Nil(t, blcKeeper.GetReporter(ctx, honestReporter))
+		_, err = srv.UnbondReporter(ctx, &types.MsgUnbondReporter{Signer: honestReporter.String()})
+		require.ErrorIs(t, err, types.ErrReporterNotFound)
+	})
+}
@@ -16,8 +16,11 @@ import (
 
 type headersState struct {
 	cdc          codec.BinaryCodec
+	store        storetypes.KVStore
 	headers      storetypes.KVStore
 	hashToHeight storetypes.KVStore
+	reorgs       storetypes.KVStore
+	retained     storetypes.KVStore
 }
 
 func (k Keeper) headersState(ctx context.Context) headersState {
@@ -25,8 +28,11 @@ func (k Keeper) headersState(ctx context.Context) headersState {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	return headersState{
 		cdc:          k.cdc,
+		store:        storeAdapter,
 		headers:      prefix.NewStore(storeAdapter, types.HeadersObjectPrefix),
 		hashToHeight: prefix.NewStore(storeAdapter, types.HashToHeightPrefix),
+		reorgs:       prefix.NewStore(storeAdapter, types.ReorgHistoryPrefix),
+		retained:     prefix.NewStore(storeAdapter, types.RetainedHeaderPrefix),
 	}
 }
 
@@ -53,7 +59,9 @@ func (s headersState) deleteHeader(h *types.BTCHeaderInfo) {
 	s.hashToHeight.Delete(heightKey)
 }
 
-func (s headersState) rollBackHeadersUpTo(height uint64) {
+// rollBackHeadersUpTo deletes all headers above the given height and returns
+// the deleted headers, from the highest to the lowest
+func (s headersState) rollBackHeadersUpTo(height uint64) []*types.BTCHeaderInfo {
 	headersToDelete := make([]*types.BTCHeaderInfo, 0)
 
 	handleInfoFn := func(header *types.BTCHeaderInfo) bool {
@@ -76,7 +84,10 @@ func (s headersState) rollBackHeadersUpTo(height uint64) {
 	// delete rollbacked headers from storage and set up new tip
 	for _, header := range headersToDelete {
 		s.deleteHeader(header)
+		s.retained.Delete(types.HeadersObjectKey(header.Height))
 	}
+
+	return headersToDelete
 }
 
 // GetHeaderByHeight Retrieve a header by its height and hash
@@ -194,3 +205,118 @@ func (s headersState) BaseHeader() *types.BTCHeaderInfo {
 	s.IterateForwardHeaders(0, handleBaseHeaderFn)
 	return baseHeader
 }
+
+// setBaseHeaderContext sets the context of the base header
+func (s headersState) setBaseHeaderContext(baseHeaderCtx *types.BaseHeaderContext) {
+	s.store.Set(types.BaseHeaderCtxKey, s.cdc.MustMarshal(baseHeaderCtx))
+}
+
+// GetBaseHeaderContext returns the context of the base header, or nil if the
+// base header comes without context
+func (s headersState) GetBaseHeaderContext() *types.BaseHeaderContext {
+	bz := s.store.Get(types.BaseHeaderCtxKey)
+	if bz == nil {
+		return nil
+	}
+	var baseHeaderCtx types.BaseHeaderContext
+	s.cdc.MustUnmarshal(bz, &baseHeaderCtx)
+	return &baseHeaderCtx
+}
+
+// setHeadersMMR sets the MMR of the pruned headers
+func (s headersState) setHeadersMMR(mmr *types.HeadersMMR) {
+	s.store.Set(types.HeadersMMRKey, s.cdc.MustMarshal(mmr))
+}
+
+// GetHeadersMMR returns the MMR of the pruned headers, or nil if no header
+// has been pruned
+func (s headersState) GetHeadersMMR() *types.HeadersMMR {
+	bz := s.store.Get(types.HeadersMMRKey)
+	if bz == nil {
+		return nil
+	}
+	var mmr types.HeadersMMR
+	s.cdc.MustUnmarshal(bz, &mmr)
+	return &mmr
+}
+
+// unprunedHeight returns the height of the lowest header that is not pruned.
+// Headers from this height to the tip are contiguous in the storage, while
+// headers below it are pruned, except for the base header and the headers at
+// difficulty adjustment boundaries.
+func (s headersState) unprunedHeight() uint64 {
+	mmr := s.GetHeadersMMR()
+	if mmr == nil {
+		return 0
+	}
+	return mmr.NextHeight()
+}
+
+// retainHeader marks the header at the given height to be kept in the storage
+// after it is pruned
+func (s headersState) retainHeader(height uint64) {
+	s.retained.Set(types.HeadersObjectKey(height), []byte{})
+}
+
+// isHeaderRetained returns whether the header at the given height is kept in
+// the storage after it is pruned
+func (s headersState) isHeaderRetained(height uint64) bool {
+	return s.retained.Has(types.HeadersObjectKey(height))
+}
+
+// IterateRetainedHeights iterates over the heights of the retained headers in
+// increasing order, until the given function returns true
+func (s headersState) IterateRetainedHeights(f func(height uint64) bool) {
+	it := s.retained.Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if f(sdk.BigEndianToUint64(it.Key())) {
+			break
+		}
+	}
+}
+
+// appendReorg appends the reorg to the reorg history, keyed by the index of
+// the reorg in the history, and prunes the oldest reorgs such that at most
+// `retention` reorgs are kept
+func (s headersState) appendReorg(reorg *types.BTCReorg, retention uint64) {
+	index := uint64(0)
+	it := s.reorgs.ReverseIterator(nil, nil)
+	if it.Valid() {
+		index = sdk.BigEndianToUint64(it.Key()) + 1
+	}
+	// close the iterator before writing to the store
+	it.Close()
+
+	s.reorgs.Set(sdk.Uint64ToBigEndian(index), s.cdc.MustMarshal(reorg))
+
+	if index+1 <= retention {
+		return
+	}
+	// the kept reorgs are the ones with index > index - retention
+	pruneIt := s.reorgs.Iterator(nil, sdk.Uint64ToBigEndian(index+1-retention))
+	keysToPrune := [][]byte{}
+	for ; pruneIt.Valid(); pruneIt.Next() {
+		keysToPrune = append(keysToPrune, pruneIt.Key())
+	}
+	pruneIt.Close()
+	for _, key := range keysToPrune {
+		s.reorgs.Delete(key)
+	}
+}
+
+// IterateReorgs iterates over the reorg history from the oldest reorg to the
+// newest one, until the given function returns true
+func (s headersState) IterateReorgs(f func(reorg *types.BTCReorg) bool) {
+	it := s.reorgs.Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var reorg types.BTCReorg
+		s.cdc.MustUnmarshal(it.Value(), &reorg)
+		if f(&reorg) {
+			break
+		}
+	}
+}
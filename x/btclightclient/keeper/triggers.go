@@ -26,3 +26,8 @@ func (k Keeper) triggerRollForward(ctx context.Context, headerInfo *types.BTCHea
 	// Emit BTCRollForward event
 	k.emitTypedEventWithLog(ctx, &types.EventBTCRollForward{Header: headerInfo})
 }
+
+func (k Keeper) triggerReorg(ctx context.Context, reorg *types.BTCReorg) {
+	// Emit BTCReorg event
+	k.emitTypedEventWithLog(ctx, &types.EventBTCReorg{Reorg: reorg})
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+// MedianTimeBlocks is the number of BTC headers whose timestamps are used for
+// computing the median-time-past of a BTC header, including the header itself
+const MedianTimeBlocks = 11
+
+// Validate checks whether the context is consistent with the given base header
+func (c *BaseHeaderContext) Validate(baseHeader *BTCHeaderInfo, params *chaincfg.Params) error {
+	// the median-time-past of a header only needs the timestamps of its
+	// MedianTimeBlocks-1 closest ancestors
+	if len(c.AncestorTimestamps) >= MedianTimeBlocks {
+		return fmt.Errorf("base header context has %d ancestor timestamps, at most %d are needed", len(c.AncestorTimestamps), MedianTimeBlocks-1)
+	}
+	if uint64(len(c.AncestorTimestamps)) > baseHeader.Height {
+		return fmt.Errorf("base header at height %d cannot have %d ancestors", baseHeader.Height, len(c.AncestorTimestamps))
+	}
+
+	if c.RetargetHeader == nil {
+		return nil
+	}
+	if err := c.RetargetHeader.Validate(); err != nil {
+		return err
+	}
+	if IsRetargetBlock(baseHeader, params) {
+		return fmt.Errorf("base header at height %d is already at a difficulty adjustment boundary", baseHeader.Height)
+	}
+	retargetHeight := baseHeader.Height - baseHeader.Height%uint64(BlocksPerRetarget(params))
+	if c.RetargetHeader.Height != retargetHeight {
+		return fmt.Errorf("retarget header is at height %d, expected %d", c.RetargetHeader.Height, retargetHeight)
+	}
+	// the retarget header might be one of the ancestors with timestamps
+	distance := baseHeader.Height - retargetHeight
+	if distance <= uint64(len(c.AncestorTimestamps)) {
+		retargetTimestamp := c.RetargetHeader.Header.Time().Unix()
+		if c.AncestorTimestamps[distance-1] != retargetTimestamp {
+			return fmt.Errorf("ancestor timestamp %d at height %d does not match the retarget header timestamp %d",
+				c.AncestorTimestamps[distance-1], retargetHeight, retargetTimestamp)
+		}
+	}
+
+	return nil
+}
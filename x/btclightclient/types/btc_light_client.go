@@ -16,6 +16,8 @@ type BtcChainReadStore interface {
 	GetHeaderByHash(hash *bbn.BTCHeaderHashBytes) (*BTCHeaderInfo, error)
 	GetHeaderByHeight(height uint64) (*BTCHeaderInfo, error)
 	GetTip() *BTCHeaderInfo
+	BaseHeader() *BTCHeaderInfo
+	GetBaseHeaderContext() *BaseHeaderContext
 }
 
 // Copy from neutrino light client
@@ -189,7 +191,9 @@ func (l *lightHeaderCtx) RelativeAncestorCtx(
 	ancestor := l.store.getHeaderAtHeight(ancU64)
 
 	if ancestor == nil {
-		return nil
+		// The ancestor is not in the store, i.e., it is before the base
+		// header. Try the context of the base header.
+		return l.store.getBaseAncestorCtx(ancU64)
 	}
 
 	return newLightHeaderCtx(
@@ -267,18 +271,31 @@ func (d *DisableHeaderInTheFutureValidationTimeSource) Offset() time.Duration {
 type storeWithExtensionChain struct {
 	headers []*localHeaderInfo
 	store   BtcChainReadStore
+	// height and context of the base header, used for retrieving the
+	// ancestors of the base header
+	baseHeight   uint64
+	baseCtx      *BaseHeaderContext
+	powLimitBits uint32
 }
 
 func newStoreWithExtensionChain(
 	store BtcChainReadStore,
 	maxExentsionHeaders int,
+	powLimitBits uint32,
 ) *storeWithExtensionChain {
 
-	return &storeWithExtensionChain{
+	s := &storeWithExtensionChain{
 		// large capacity to avoid reallocation
-		headers: make([]*localHeaderInfo, 0, maxExentsionHeaders),
-		store:   store,
+		headers:      make([]*localHeaderInfo, 0, maxExentsionHeaders),
+		store:        store,
+		baseCtx:      store.GetBaseHeaderContext(),
+		powLimitBits: powLimitBits,
+	}
+	if baseHeader := store.BaseHeader(); baseHeader != nil {
+		s.baseHeight = baseHeader.Height
 	}
+
+	return s
 }
 
 func (s *storeWithExtensionChain) addHeader(header *localHeaderInfo) {
@@ -299,6 +316,34 @@ func (s *storeWithExtensionChain) getHeaderAtHeight(height uint64) *localHeaderI
 	}
 }
 
+// getBaseAncestorCtx returns the context of the ancestor of the base header at
+// the given height, as far as it is known from the context of the base
+// header. The header at the retarget boundary is fully known. Other ancestors
+// are only known by their timestamps for computing the median-time-past.
+// Their bits are set to the PoW limit, such that the walk-back for the
+// testnet minimum difficulty rule treats them the same as unknown ancestors.
+func (s *storeWithExtensionChain) getBaseAncestorCtx(height uint64) blockchain.HeaderCtx {
+	if s.baseCtx == nil || height >= s.baseHeight {
+		return nil
+	}
+
+	if retargetHeader := s.baseCtx.RetargetHeader; retargetHeader != nil && retargetHeader.Height == height {
+		return newLightHeaderCtx(height, retargetHeader.Header.ToBlockHeader(), s)
+	}
+
+	distance := s.baseHeight - height
+	if distance > uint64(len(s.baseCtx.AncestorTimestamps)) {
+		return nil
+	}
+
+	return &lightHeaderCtx{
+		height:    height,
+		bits:      s.powLimitBits,
+		timestamp: s.baseCtx.AncestorTimestamps[distance-1],
+		store:     s,
+	}
+}
+
 func (l *BtcLightClient) processNewHeadersChain(
 	store *storeWithExtensionChain,
 	chainParent *localHeaderInfo,
@@ -360,7 +405,7 @@ func (l *BtcLightClient) InsertHeaders(readStore BtcChainReadStore, headers []*w
 
 	firstHeaderOfExtensionChain := headers[0]
 
-	store := newStoreWithExtensionChain(readStore, headersLen)
+	store := newStoreWithExtensionChain(readStore, headersLen, l.params.PowLimitBits)
 
 	if firstHeaderOfExtensionChain.PrevBlock.IsEqual(&currentTipHash) {
 		// most common case - extending of current tip
@@ -407,7 +452,11 @@ func (l *BtcLightClient) InsertHeaders(readStore BtcChainReadStore, headers []*w
 // checkHeader checks if the header is valid and can be added to the store.
 // One criticial condition is that to properly validate difficulty adjustments
 // we should have at least one header which is at difficulty adjustment boundary
-// in store.
+// in store. If the base header is not at a difficulty adjustment boundary,
+// the header at the boundary is taken from the context of the base header.
+// Likewise, the median-time-past of the headers right after the base header
+// is computed with the timestamps of the ancestors of the base header in its
+// context.
 func (l *BtcLightClient) checkHeader(
 	s *storeWithExtensionChain,
 	parentHeaderInfo *localHeaderInfo,
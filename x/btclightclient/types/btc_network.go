@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+
+	bbn "github.com/babylonchain/babylon/types"
+)
+
+// NetParams returns the parameters of the BTC network, i.e., the parameters of
+// the preset network overridden by the custom ones
+func (n *BTCNetwork) NetParams() (*chaincfg.Params, error) {
+	return bbn.NewCustomBtcNetParams(bbn.SupportedBtcNetwork(n.Name), &bbn.CustomBtcNetworkOptions{
+		SignetChallenge:          n.SignetChallenge,
+		GenesisHash:              n.GenesisHash,
+		PowLimit:                 n.PowLimit,
+		TargetTimespan:           n.TargetTimespan,
+		TargetTimePerBlock:       n.TargetTimePerBlock,
+		RetargetAdjustmentFactor: n.RetargetAdjustmentFactor,
+	})
+}
+
+// Validate checks whether the definition of the BTC network is valid
+func (n *BTCNetwork) Validate() error {
+	_, err := n.NetParams()
+	return err
+}
+
+// ValidateBTCNet checks whether the given network parameters, e.g., those that
+// the node is configured with, define the same BTC network, i.e., have the
+// same parameters used for validating BTC headers and deriving BTC addresses
+func (n *BTCNetwork) ValidateBTCNet(btcNet *chaincfg.Params) error {
+	expected, err := n.NetParams()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case btcNet.Net != expected.Net:
+		return fmt.Errorf("network magic %s does not match %s", btcNet.Net, expected.Net)
+	case !btcNet.GenesisHash.IsEqual(expected.GenesisHash):
+		return fmt.Errorf("genesis hash %s does not match %s", btcNet.GenesisHash, expected.GenesisHash)
+	case btcNet.PowLimit.Cmp(expected.PowLimit) != 0:
+		return fmt.Errorf("pow limit %x does not match %x", btcNet.PowLimit, expected.PowLimit)
+	case btcNet.TargetTimespan != expected.TargetTimespan:
+		return fmt.Errorf("target timespan %s does not match %s", btcNet.TargetTimespan, expected.TargetTimespan)
+	case btcNet.TargetTimePerBlock != expected.TargetTimePerBlock:
+		return fmt.Errorf("target time per block %s does not match %s", btcNet.TargetTimePerBlock, expected.TargetTimePerBlock)
+	case btcNet.RetargetAdjustmentFactor != expected.RetargetAdjustmentFactor:
+		return fmt.Errorf("retarget adjustment factor %d does not match %d", btcNet.RetargetAdjustmentFactor, expected.RetargetAdjustmentFactor)
+	case btcNet.ReduceMinDifficulty != expected.ReduceMinDifficulty:
+		return fmt.Errorf("reduce min difficulty %t does not match %t", btcNet.ReduceMinDifficulty, expected.ReduceMinDifficulty)
+	case btcNet.Bech32HRPSegwit != expected.Bech32HRPSegwit:
+		return fmt.Errorf("bech32 HRP %s does not match %s", btcNet.Bech32HRPSegwit, expected.Bech32HRPSegwit)
+	}
+
+	return nil
+}
@@ -7,17 +7,23 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -80,142 +86,2132 @@ func (m *BTCHeaderInfo) GetHeight() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*BTCHeaderInfo)(nil), "babylon.btclightclient.v1.BTCHeaderInfo")
+// BaseHeaderContext is the context of the base BTC header needed to fully
+// validate the BTC headers following it, even if the base header is not at a
+// difficulty retarget boundary
+type BaseHeaderContext struct {
+	// retarget_header is the BTC header at the difficulty retarget boundary of
+	// the retarget period of the base header. It is required iff the base
+	// header is not at a difficulty retarget boundary.
+	RetargetHeader *BTCHeaderInfo `protobuf:"bytes,1,opt,name=retarget_header,json=retargetHeader,proto3" json:"retarget_header,omitempty"`
+	// ancestor_timestamps are the Unix timestamps of the ancestors of the base
+	// header, starting from its parent, that are used for computing the
+	// median-time-past of the headers following the base header
+	AncestorTimestamps []int64 `protobuf:"varint,2,rep,packed,name=ancestor_timestamps,json=ancestorTimestamps,proto3" json:"ancestor_timestamps,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("babylon/btclightclient/v1/btclightclient.proto", fileDescriptor_84bf438d909b681d)
+func (m *BaseHeaderContext) Reset()         { *m = BaseHeaderContext{} }
+func (m *BaseHeaderContext) String() string { return proto.CompactTextString(m) }
+func (*BaseHeaderContext) ProtoMessage()    {}
+func (*BaseHeaderContext) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84bf438d909b681d, []int{1}
+}
+func (m *BaseHeaderContext) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseHeaderContext) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseHeaderContext.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseHeaderContext) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseHeaderContext.Merge(m, src)
+}
+func (m *BaseHeaderContext) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseHeaderContext) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseHeaderContext.DiscardUnknown(m)
 }
 
-var fileDescriptor_84bf438d909b681d = []byte{
-	// 282 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4b, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
-	0xfa, 0x65, 0x86, 0x68, 0x22, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x92, 0x50, 0xf5, 0x7a,
-	0x68, 0xb2, 0x65, 0x86, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x55, 0xfa, 0x20, 0x16, 0x44,
-	0x83, 0xd2, 0x6f, 0x46, 0x2e, 0x5e, 0xa7, 0x10, 0x67, 0x8f, 0xd4, 0xc4, 0x94, 0xd4, 0x22, 0xcf,
-	0xbc, 0xb4, 0x7c, 0xa1, 0x00, 0x2e, 0xb6, 0x0c, 0x30, 0x4f, 0x82, 0x51, 0x81, 0x51, 0x83, 0xc7,
-	0xc9, 0xe2, 0xd6, 0x3d, 0x79, 0x93, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c,
-	0x7d, 0xa8, 0x0d, 0xc9, 0x19, 0x89, 0x99, 0x79, 0x30, 0x8e, 0x7e, 0x49, 0x65, 0x41, 0x6a, 0xb1,
-	0x1e, 0xdc, 0x20, 0xa7, 0xca, 0x92, 0xd4, 0xe2, 0x20, 0xa8, 0x39, 0x42, 0x01, 0x5c, 0x2c, 0x19,
-	0x89, 0xc5, 0x19, 0x12, 0x4c, 0x60, 0xf3, 0x6c, 0x6e, 0xdd, 0x93, 0xb7, 0x20, 0xd1, 0x3c, 0x8f,
-	0xc4, 0xe2, 0x0c, 0x88, 0x99, 0x60, 0x93, 0x84, 0xc4, 0x40, 0x6e, 0x04, 0x79, 0x4f, 0x82, 0x59,
-	0x81, 0x51, 0x83, 0x25, 0x08, 0xca, 0x13, 0xd2, 0xe3, 0x62, 0x29, 0xcf, 0x2f, 0xca, 0x96, 0x60,
-	0x01, 0xdb, 0x24, 0x75, 0xeb, 0x9e, 0xbc, 0x58, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x71, 0x4a,
-	0xb6, 0x5e, 0x66, 0xbe, 0x7e, 0x6e, 0x62, 0x49, 0x86, 0x5e, 0x68, 0x66, 0x5e, 0x49, 0x10, 0x58,
-	0x9d, 0x53, 0xc0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x99, 0x11, 0x72,
-	0x61, 0x05, 0x7a, 0x94, 0x80, 0x9d, 0x9c, 0xc4, 0x06, 0x0e, 0x56, 0x63, 0x40, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x13, 0xc6, 0x69, 0x40, 0xb9, 0x01, 0x00, 0x00,
+var xxx_messageInfo_BaseHeaderContext proto.InternalMessageInfo
+
+func (m *BaseHeaderContext) GetRetargetHeader() *BTCHeaderInfo {
+	if m != nil {
+		return m.RetargetHeader
+	}
+	return nil
 }
 
-func (m *BTCHeaderInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BaseHeaderContext) GetAncestorTimestamps() []int64 {
+	if m != nil {
+		return m.AncestorTimestamps
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BTCHeaderInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// HeadersMMR is the Merkle Mountain Range (MMR) accumulator of the BTC headers
+// that are pruned from the storage. Its i-th leaf is the hash of the BTC header
+// at height first_height + i on the canonical chain.
+type HeadersMMR struct {
+	// first_height is the BTC height of the first leaf, i.e., the height of the
+	// base header
+	FirstHeight uint64 `protobuf:"varint,1,opt,name=first_height,json=firstHeight,proto3" json:"first_height,omitempty"`
+	// num_leaves is the number of BTC headers accumulated in the MMR
+	NumLeaves uint64 `protobuf:"varint,2,opt,name=num_leaves,json=numLeaves,proto3" json:"num_leaves,omitempty"`
+	// peaks are the roots of the perfect binary Merkle trees of the MMR, from
+	// the highest tree to the lowest one
+	Peaks [][]byte `protobuf:"bytes,3,rep,name=peaks,proto3" json:"peaks,omitempty"`
 }
 
-func (m *BTCHeaderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Work != nil {
-		{
-			size := m.Work.Size()
-			i -= size
-			if _, err := m.Work.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+func (m *HeadersMMR) Reset()         { *m = HeadersMMR{} }
+func (m *HeadersMMR) String() string { return proto.CompactTextString(m) }
+func (*HeadersMMR) ProtoMessage()    {}
+func (*HeadersMMR) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84bf438d909b681d, []int{2}
+}
+func (m *HeadersMMR) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeadersMMR) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeadersMMR.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		i = encodeVarintBtclightclient(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *HeadersMMR) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeadersMMR.Merge(m, src)
+}
+func (m *HeadersMMR) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeadersMMR) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeadersMMR.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeadersMMR proto.InternalMessageInfo
+
+func (m *HeadersMMR) GetFirstHeight() uint64 {
+	if m != nil {
+		return m.FirstHeight
 	}
-	if m.Hash != nil {
-		{
-			size := m.Hash.Size()
-			i -= size
-			if _, err := m.Hash.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *HeadersMMR) GetNumLeaves() uint64 {
+	if m != nil {
+		return m.NumLeaves
+	}
+	return 0
+}
+
+func (m *HeadersMMR) GetPeaks() [][]byte {
+	if m != nil {
+		return m.Peaks
+	}
+	return nil
+}
+
+// MMRProof is a proof of inclusion of a leaf in the MMR
+type MMRProof struct {
+	// leaf_index is the index of the leaf in the MMR
+	LeafIndex uint64 `protobuf:"varint,1,opt,name=leaf_index,json=leafIndex,proto3" json:"leaf_index,omitempty"`
+	// siblings are the hashes along the path from the leaf to the peak of the
+	// Merkle tree that contains the leaf, from the bottom to the top
+	Siblings [][]byte `protobuf:"bytes,2,rep,name=siblings,proto3" json:"siblings,omitempty"`
+}
+
+func (m *MMRProof) Reset()         { *m = MMRProof{} }
+func (m *MMRProof) String() string { return proto.CompactTextString(m) }
+func (*MMRProof) ProtoMessage()    {}
+func (*MMRProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84bf438d909b681d, []int{3}
+}
+func (m *MMRProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MMRProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MMRProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Header != nil {
-		{
-			size := m.Header.Size()
-			i -= size
-			if _, err := m.Header.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+}
+func (m *MMRProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MMRProof.Merge(m, src)
+}
+func (m *MMRProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MMRProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MMRProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MMRProof proto.InternalMessageInfo
+
+func (m *MMRProof) GetLeafIndex() uint64 {
+	if m != nil {
+		return m.LeafIndex
+	}
+	return 0
+}
+
+func (m *MMRProof) GetSiblings() [][]byte {
+	if m != nil {
+		return m.Siblings
+	}
+	return nil
+}
+
+// BTCReorg is a reorg of the BTC chain observed by the BTC light client, i.e.,
+// the replacement of the headers of the canonical chain after a fork point by
+// the headers of a fork with more work
+type BTCReorg struct {
+	// babylon_height is the Babylon block height at which the reorg is observed
+	BabylonHeight uint64 `protobuf:"varint,1,opt,name=babylon_height,json=babylonHeight,proto3" json:"babylon_height,omitempty"`
+	// fork_point is the greatest common ancestor of the old and the new chain
+	ForkPoint *BTCHeaderInfo `protobuf:"bytes,2,opt,name=fork_point,json=forkPoint,proto3" json:"fork_point,omitempty"`
+	// orphaned_header_hashes are the hashes of the headers of the old chain
+	// after the fork point, from the lowest to the highest
+	OrphanedHeaderHashes []github_com_babylonchain_babylon_types.BTCHeaderHashBytes `protobuf:"bytes,3,rep,name=orphaned_header_hashes,json=orphanedHeaderHashes,proto3,customtype=github.com/babylonchain/babylon/types.BTCHeaderHashBytes" json:"orphaned_header_hashes,omitempty"`
+	// new_tip is the tip of the new chain
+	NewTip *BTCHeaderInfo `protobuf:"bytes,4,opt,name=new_tip,json=newTip,proto3" json:"new_tip,omitempty"`
+}
+
+func (m *BTCReorg) Reset()         { *m = BTCReorg{} }
+func (m *BTCReorg) String() string { return proto.CompactTextString(m) }
+func (*BTCReorg) ProtoMessage()    {}
+func (*BTCReorg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84bf438d909b681d, []int{4}
+}
+func (m *BTCReorg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCReorg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCReorg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BTCReorg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCReorg.Merge(m, src)
+}
+func (m *BTCReorg) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCReorg) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCReorg.DiscardUnknown(m)
 }
 
-func encodeVarintBtclightclient(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBtclightclient(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_BTCReorg proto.InternalMessageInfo
+
+func (m *BTCReorg) GetBabylonHeight() uint64 {
+	if m != nil {
+		return m.BabylonHeight
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *BTCHeaderInfo) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *BTCReorg) GetForkPoint() *BTCHeaderInfo {
+	if m != nil {
+		return m.ForkPoint
 	}
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovBtclightclient(uint64(l))
+	return nil
+}
+
+func (m *BTCReorg) GetNewTip() *BTCHeaderInfo {
+	if m != nil {
+		return m.NewTip
 	}
-	if m.Hash != nil {
-		l = m.Hash.Size()
-		n += 1 + l + sovBtclightclient(uint64(l))
+	return nil
+}
+
+// ReporterInfo is the information of a bonded reporter of BTC headers
+type ReporterInfo struct {
+	// address is the address of the reporter
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// bond is the deposit locked by the reporter
+	Bond types.Coin `protobuf:"bytes,2,opt,name=bond,proto3" json:"bond"`
+	// pending_headers is the number of headers inserted by the reporter that
+	// are not settled yet
+	PendingHeaders uint64 `protobuf:"varint,3,opt,name=pending_headers,json=pendingHeaders,proto3" json:"pending_headers,omitempty"`
+	// canonical_headers is the number of headers inserted by the reporter that
+	// are settled on the canonical chain
+	CanonicalHeaders uint64 `protobuf:"varint,4,opt,name=canonical_headers,json=canonicalHeaders,proto3" json:"canonical_headers,omitempty"`
+	// orphaned_headers is the number of headers inserted by the reporter that
+	// are settled as orphaned
+	OrphanedHeaders uint64 `protobuf:"varint,5,opt,name=orphaned_headers,json=orphanedHeaders,proto3" json:"orphaned_headers,omitempty"`
+}
+
+func (m *ReporterInfo) Reset()         { *m = ReporterInfo{} }
+func (m *ReporterInfo) String() string { return proto.CompactTextString(m) }
+func (*ReporterInfo) ProtoMessage()    {}
+func (*ReporterInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84bf438d909b681d, []int{5}
+}
+func (m *ReporterInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReporterInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReporterInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		n += 1 + sovBtclightclient(uint64(m.Height))
+}
+func (m *ReporterInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReporterInfo.Merge(m, src)
+}
+func (m *ReporterInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReporterInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReporterInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReporterInfo proto.InternalMessageInfo
+
+func (m *ReporterInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.Work != nil {
-		l = m.Work.Size()
-		n += 1 + l + sovBtclightclient(uint64(l))
+	return ""
+}
+
+func (m *ReporterInfo) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return types.Coin{}
+}
+
+func (m *ReporterInfo) GetPendingHeaders() uint64 {
+	if m != nil {
+		return m.PendingHeaders
+	}
+	return 0
+}
+
+func (m *ReporterInfo) GetCanonicalHeaders() uint64 {
+	if m != nil {
+		return m.CanonicalHeaders
+	}
+	return 0
+}
+
+func (m *ReporterInfo) GetOrphanedHeaders() uint64 {
+	if m != nil {
+		return m.OrphanedHeaders
+	}
+	return 0
+}
+
+// ReportedHeader is a BTC header inserted by a bonded reporter that is not
+// settled yet
+type ReportedHeader struct {
+	// height is the height of the header
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// hash is the hash of the header
+	Hash *github_com_babylonchain_babylon_types.BTCHeaderHashBytes `protobuf:"bytes,2,opt,name=hash,proto3,customtype=github.com/babylonchain/babylon/types.BTCHeaderHashBytes" json:"hash,omitempty"`
+	// reporter is the address of the bonded reporter that inserted the header
+	Reporter string `protobuf:"bytes,3,opt,name=reporter,proto3" json:"reporter,omitempty"`
+}
+
+func (m *ReportedHeader) Reset()         { *m = ReportedHeader{} }
+func (m *ReportedHeader) String() string { return proto.CompactTextString(m) }
+func (*ReportedHeader) ProtoMessage()    {}
+func (*ReportedHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84bf438d909b681d, []int{6}
+}
+func (m *ReportedHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReportedHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReportedHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReportedHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportedHeader.Merge(m, src)
+}
+func (m *ReportedHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReportedHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportedHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportedHeader proto.InternalMessageInfo
+
+func (m *ReportedHeader) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReportedHeader) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+// BTCNetwork is the definition of the BTC network whose rules the BTC headers
+// are validated with. It is one of the preset networks, whose parameters are
+// optionally overridden to define a custom network. Empty values keep the
+// parameters of the preset network.
+type BTCNetwork struct {
+	// name is the name of the preset network, i.e., mainnet, testnet, simnet,
+	// regtest or signet
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// signet_challenge is the hex-encoded challenge script of a custom signet.
+	// It is only allowed with the signet preset.
+	SignetChallenge string `protobuf:"bytes,2,opt,name=signet_challenge,json=signetChallenge,proto3" json:"signet_challenge,omitempty"`
+	// genesis_hash is the hex-encoded hash of the genesis block
+	GenesisHash string `protobuf:"bytes,3,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
+	// pow_limit is the hex-encoded highest proof-of-work target
+	PowLimit string `protobuf:"bytes,4,opt,name=pow_limit,json=powLimit,proto3" json:"pow_limit,omitempty"`
+	// target_timespan is the desired time between difficulty adjustments
+	TargetTimespan time.Duration `protobuf:"bytes,5,opt,name=target_timespan,json=targetTimespan,proto3,stdduration" json:"target_timespan"`
+	// target_time_per_block is the desired time between blocks
+	TargetTimePerBlock time.Duration `protobuf:"bytes,6,opt,name=target_time_per_block,json=targetTimePerBlock,proto3,stdduration" json:"target_time_per_block"`
+	// retarget_adjustment_factor is the factor by which the difficulty can
+	// change at most at each difficulty adjustment
+	RetargetAdjustmentFactor int64 `protobuf:"varint,7,opt,name=retarget_adjustment_factor,json=retargetAdjustmentFactor,proto3" json:"retarget_adjustment_factor,omitempty"`
+}
+
+func (m *BTCNetwork) Reset()         { *m = BTCNetwork{} }
+func (m *BTCNetwork) String() string { return proto.CompactTextString(m) }
+func (*BTCNetwork) ProtoMessage()    {}
+func (*BTCNetwork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84bf438d909b681d, []int{7}
+}
+func (m *BTCNetwork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCNetwork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCNetwork.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCNetwork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCNetwork.Merge(m, src)
+}
+func (m *BTCNetwork) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCNetwork) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCNetwork.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCNetwork proto.InternalMessageInfo
+
+func (m *BTCNetwork) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BTCNetwork) GetSignetChallenge() string {
+	if m != nil {
+		return m.SignetChallenge
+	}
+	return ""
+}
+
+func (m *BTCNetwork) GetGenesisHash() string {
+	if m != nil {
+		return m.GenesisHash
+	}
+	return ""
+}
+
+func (m *BTCNetwork) GetPowLimit() string {
+	if m != nil {
+		return m.PowLimit
+	}
+	return ""
+}
+
+func (m *BTCNetwork) GetTargetTimespan() time.Duration {
+	if m != nil {
+		return m.TargetTimespan
+	}
+	return 0
+}
+
+func (m *BTCNetwork) GetTargetTimePerBlock() time.Duration {
+	if m != nil {
+		return m.TargetTimePerBlock
+	}
+	return 0
+}
+
+func (m *BTCNetwork) GetRetargetAdjustmentFactor() int64 {
+	if m != nil {
+		return m.RetargetAdjustmentFactor
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*BTCHeaderInfo)(nil), "babylon.btclightclient.v1.BTCHeaderInfo")
+	proto.RegisterType((*BaseHeaderContext)(nil), "babylon.btclightclient.v1.BaseHeaderContext")
+	proto.RegisterType((*HeadersMMR)(nil), "babylon.btclightclient.v1.HeadersMMR")
+	proto.RegisterType((*MMRProof)(nil), "babylon.btclightclient.v1.MMRProof")
+	proto.RegisterType((*BTCReorg)(nil), "babylon.btclightclient.v1.BTCReorg")
+	proto.RegisterType((*ReporterInfo)(nil), "babylon.btclightclient.v1.ReporterInfo")
+	proto.RegisterType((*ReportedHeader)(nil), "babylon.btclightclient.v1.ReportedHeader")
+	proto.RegisterType((*BTCNetwork)(nil), "babylon.btclightclient.v1.BTCNetwork")
+}
+
+func init() {
+	proto.RegisterFile("babylon/btclightclient/v1/btclightclient.proto", fileDescriptor_84bf438d909b681d)
+}
+
+var fileDescriptor_84bf438d909b681d = []byte{
+	// 929 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0x1b, 0x6f, 0x9b, 0x4c, 0xbb, 0xc9, 0xee, 0x50, 0x2a, 0x37, 0x88, 0x24, 0x44, 0x42,
+	0x04, 0x21, 0x6c, 0x75, 0x77, 0x85, 0xf6, 0xb0, 0x97, 0x3a, 0xfc, 0xe9, 0x4a, 0x2d, 0x0a, 0x43,
+	0xe0, 0xc0, 0xc5, 0x1a, 0xdb, 0x13, 0x7b, 0x88, 0x3d, 0x63, 0xcd, 0x4c, 0x9a, 0xf6, 0x5b, 0x70,
+	0x83, 0x8f, 0x01, 0x12, 0x1f, 0x62, 0x8f, 0x2b, 0x4e, 0xa8, 0x87, 0x82, 0xda, 0x0f, 0xc0, 0x15,
+	0x89, 0x0b, 0xf2, 0x78, 0x9c, 0x6d, 0x2b, 0xa1, 0xa5, 0x82, 0x4b, 0xe4, 0xf7, 0xde, 0xef, 0xfd,
+	0xe6, 0xcd, 0x6f, 0xde, 0x7b, 0x01, 0x6e, 0x88, 0xc3, 0xb3, 0x8c, 0x33, 0x2f, 0x54, 0x51, 0x46,
+	0x93, 0xb4, 0xfc, 0x25, 0x4c, 0x79, 0x27, 0xfb, 0xb7, 0x3c, 0x6e, 0x21, 0xb8, 0xe2, 0x70, 0xcf,
+	0xe0, 0xdd, 0x5b, 0xd1, 0x93, 0xfd, 0xee, 0x4e, 0xc2, 0x13, 0xae, 0x51, 0x5e, 0xf9, 0x55, 0x25,
+	0x74, 0xf7, 0x22, 0x2e, 0x73, 0x2e, 0x83, 0x2a, 0x50, 0x19, 0x26, 0xd4, 0xab, 0x2c, 0x2f, 0xc4,
+	0x92, 0x78, 0x27, 0xfb, 0x21, 0x51, 0x78, 0xdf, 0x8b, 0x38, 0x65, 0x75, 0x3c, 0xe1, 0x3c, 0xc9,
+	0x88, 0xa7, 0xad, 0x70, 0x31, 0xf3, 0xe2, 0x85, 0xc0, 0x8a, 0x72, 0x13, 0x1f, 0xfe, 0x65, 0x81,
+	0xfb, 0xfe, 0x74, 0x7c, 0x48, 0x70, 0x4c, 0xc4, 0x73, 0x36, 0xe3, 0x70, 0x02, 0x36, 0x52, 0x6d,
+	0x39, 0xd6, 0xc0, 0x1a, 0x6d, 0xfb, 0x4f, 0xcf, 0x2f, 0xfa, 0x4f, 0x12, 0xaa, 0xd2, 0x45, 0xe8,
+	0x46, 0x3c, 0xf7, 0x4c, 0xf1, 0x51, 0x8a, 0x29, 0xab, 0x0d, 0x4f, 0x9d, 0x15, 0x44, 0xba, 0x2b,
+	0x22, 0xff, 0x4c, 0x11, 0x89, 0x0c, 0x0f, 0x9c, 0x00, 0x3b, 0xc5, 0x32, 0x75, 0xd6, 0x35, 0xdf,
+	0xb3, 0xf3, 0x8b, 0xfe, 0xd3, 0x3b, 0xf2, 0x1d, 0x62, 0x99, 0x56, 0x9c, 0x9a, 0x09, 0xee, 0x96,
+	0x35, 0x96, 0xca, 0x39, 0x8d, 0x81, 0x35, 0xb2, 0x91, 0xb1, 0xa0, 0x0b, 0xec, 0x25, 0x17, 0x73,
+	0xc7, 0xd6, 0x27, 0x75, 0xcf, 0x2f, 0xfa, 0xbb, 0x95, 0x3e, 0x32, 0x9e, 0xbb, 0x94, 0x7b, 0x39,
+	0x56, 0xa9, 0xfb, 0x15, 0x65, 0x0a, 0x69, 0xdc, 0xf0, 0x7b, 0x0b, 0x3c, 0xf4, 0xb1, 0x24, 0xd5,
+	0x29, 0x63, 0xce, 0x14, 0x39, 0x55, 0xf0, 0x0b, 0xd0, 0x11, 0x44, 0x61, 0x91, 0x10, 0x15, 0x5c,
+	0x93, 0x62, 0xeb, 0xd1, 0xc8, 0xfd, 0xc7, 0x97, 0x73, 0x6f, 0x88, 0x88, 0xda, 0x35, 0x41, 0xe5,
+	0x83, 0x1e, 0x78, 0x03, 0xb3, 0x88, 0x48, 0xc5, 0x45, 0xa0, 0x68, 0x4e, 0xa4, 0xc2, 0x79, 0x21,
+	0x9d, 0xf5, 0x41, 0x63, 0xd4, 0x40, 0xb0, 0x0e, 0x4d, 0x57, 0x91, 0x61, 0x0c, 0x40, 0x95, 0x2a,
+	0x8f, 0x8f, 0x11, 0x7c, 0x07, 0x6c, 0xcf, 0xa8, 0x90, 0x65, 0x39, 0xfa, 0xd6, 0x96, 0xbe, 0xf5,
+	0x96, 0xf6, 0x1d, 0x56, 0x57, 0x7f, 0x1b, 0x00, 0xb6, 0xc8, 0x83, 0x8c, 0xe0, 0x13, 0x22, 0xb5,
+	0xd4, 0x36, 0x6a, 0xb1, 0x45, 0x7e, 0xa4, 0x1d, 0x70, 0x07, 0xdc, 0x2b, 0x08, 0x9e, 0x4b, 0xa7,
+	0x31, 0x68, 0x8c, 0xb6, 0x51, 0x65, 0x0c, 0x3f, 0x01, 0xcd, 0xe3, 0x63, 0x34, 0x11, 0x9c, 0xcf,
+	0x4a, 0x82, 0x8c, 0xe0, 0x59, 0x40, 0x59, 0x4c, 0x4e, 0xcd, 0x09, 0xad, 0xd2, 0xf3, 0xbc, 0x74,
+	0xc0, 0x2e, 0x68, 0x4a, 0x1a, 0x66, 0x94, 0x25, 0x55, 0xd9, 0xdb, 0x68, 0x65, 0x0f, 0x7f, 0x5a,
+	0x07, 0x4d, 0x7f, 0x3a, 0x46, 0x84, 0x8b, 0x04, 0xbe, 0x0b, 0xda, 0x46, 0xa5, 0x9b, 0xd5, 0xde,
+	0x37, 0x5e, 0x53, 0xef, 0x67, 0x00, 0xcc, 0xb8, 0x98, 0x07, 0x05, 0xa7, 0x4c, 0x39, 0xeb, 0x77,
+	0xd4, 0xb7, 0x55, 0xe6, 0x4e, 0xca, 0x54, 0x28, 0xc0, 0x2e, 0x17, 0x45, 0x8a, 0x19, 0x89, 0xcd,
+	0x6b, 0x05, 0x65, 0x8f, 0x10, 0x73, 0xd5, 0xff, 0xd8, 0x6f, 0x3b, 0x35, 0xf7, 0xab, 0x00, 0x91,
+	0xf0, 0x00, 0x6c, 0x32, 0xb2, 0x0c, 0x14, 0x2d, 0x1c, 0xfb, 0x8e, 0x95, 0x6f, 0x30, 0xb2, 0x9c,
+	0xd2, 0x62, 0xf8, 0xa7, 0x05, 0xb6, 0x11, 0x29, 0xb8, 0x50, 0x66, 0xee, 0x1e, 0x81, 0x4d, 0x1c,
+	0xc7, 0x82, 0x48, 0xa9, 0x05, 0x6b, 0xf9, 0xce, 0x2f, 0x3f, 0x7f, 0xb8, 0x63, 0x86, 0xfd, 0xa0,
+	0x8a, 0x7c, 0xa9, 0x04, 0x65, 0x09, 0xaa, 0x81, 0xf0, 0x31, 0xb0, 0x43, 0xce, 0x62, 0x23, 0xdf,
+	0x9e, 0x6b, 0xd0, 0xe5, 0x32, 0x70, 0xcd, 0x32, 0x70, 0xc7, 0x9c, 0x32, 0xdf, 0x7e, 0x71, 0xd1,
+	0x5f, 0x43, 0x1a, 0x0c, 0xdf, 0x03, 0x9d, 0x82, 0xb0, 0x98, 0xb2, 0xc4, 0xe8, 0x25, 0xcd, 0x14,
+	0xb5, 0x8d, 0xdb, 0x34, 0x1e, 0xfc, 0x00, 0x3c, 0x8c, 0x30, 0xe3, 0x8c, 0x46, 0x38, 0x5b, 0x41,
+	0x6d, 0x0d, 0x7d, 0xb0, 0x0a, 0xd4, 0xe0, 0xf7, 0xc1, 0x83, 0x5b, 0xcf, 0x20, 0x9d, 0x7b, 0x1a,
+	0xdb, 0xb9, 0x29, 0xa1, 0x1c, 0xfe, 0x68, 0x81, 0xb6, 0xb9, 0xba, 0xf1, 0x5d, 0x1b, 0x68, 0xeb,
+	0xc6, 0x40, 0xff, 0xff, 0xab, 0xe3, 0x09, 0x68, 0x0a, 0x23, 0xbb, 0xd3, 0x78, 0x8d, 0xce, 0x2b,
+	0xe4, 0xf0, 0x8f, 0x75, 0x00, 0xfc, 0xe9, 0xf8, 0x73, 0xa2, 0xca, 0xbd, 0x01, 0x21, 0xb0, 0x19,
+	0xce, 0x49, 0xf5, 0x50, 0x48, 0x7f, 0x97, 0x02, 0x48, 0x9a, 0x30, 0xa2, 0x82, 0x28, 0xc5, 0x59,
+	0x46, 0x58, 0x42, 0x74, 0xd9, 0x2d, 0xd4, 0xa9, 0xfc, 0xe3, 0xda, 0x5d, 0x8e, 0x73, 0x42, 0x18,
+	0x91, 0x54, 0xea, 0x56, 0xad, 0xea, 0x40, 0x5b, 0xc6, 0x57, 0x56, 0x0c, 0xdf, 0x02, 0xad, 0x82,
+	0x2f, 0x83, 0x8c, 0xe6, 0x54, 0x69, 0xcd, 0x5b, 0xa8, 0x59, 0xf0, 0xe5, 0x51, 0x69, 0xc3, 0x23,
+	0xd0, 0x31, 0xeb, 0x49, 0xef, 0x92, 0x02, 0x33, 0xe7, 0x9e, 0xe9, 0x80, 0x6a, 0xdd, 0xbb, 0xf5,
+	0xba, 0x77, 0x3f, 0x36, 0xeb, 0xde, 0x6f, 0x96, 0x1d, 0xf0, 0xc3, 0x6f, 0x7d, 0x0b, 0xb5, 0xab,
+	0xdc, 0xa9, 0x49, 0x85, 0x5f, 0x83, 0x37, 0xaf, 0xb1, 0x05, 0x05, 0x11, 0x41, 0x98, 0xf1, 0x68,
+	0xee, 0x6c, 0xfc, 0x7b, 0x4e, 0xf8, 0x8a, 0x73, 0x42, 0x84, 0x5f, 0xa6, 0xc3, 0x67, 0xa0, 0xbb,
+	0x5a, 0xa3, 0x38, 0xfe, 0x76, 0x21, 0x55, 0x4e, 0x98, 0x0a, 0x66, 0x38, 0x52, 0x5c, 0x38, 0x9b,
+	0x03, 0x6b, 0xd4, 0x40, 0x4e, 0x8d, 0x38, 0x58, 0x01, 0x3e, 0xd5, 0x71, 0x7f, 0xf2, 0xe2, 0xb2,
+	0x67, 0xbd, 0xbc, 0xec, 0x59, 0xbf, 0x5f, 0xf6, 0xac, 0xef, 0xae, 0x7a, 0x6b, 0x2f, 0xaf, 0x7a,
+	0x6b, 0xbf, 0x5e, 0xf5, 0xd6, 0xbe, 0xf9, 0xe8, 0x75, 0x1d, 0x70, 0x7a, 0xfb, 0x8f, 0x58, 0xb7,
+	0x44, 0xb8, 0xa1, 0x2f, 0xf0, 0xf8, 0xef, 0x01, 0x00, 0xab, 0xc6, 0xb1, 0x6e, 0xaf, 0x07, 0x00,
+	0x00,
+}
+
+func (m *BTCHeaderInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCHeaderInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCHeaderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Work != nil {
+		{
+			size := m.Work.Size()
+			i -= size
+			if _, err := m.Work.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Hash != nil {
+		{
+			size := m.Hash.Size()
+			i -= size
+			if _, err := m.Hash.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header != nil {
+		{
+			size := m.Header.Size()
+			i -= size
+			if _, err := m.Header.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseHeaderContext) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseHeaderContext) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseHeaderContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AncestorTimestamps) > 0 {
+		dAtA2 := make([]byte, len(m.AncestorTimestamps)*10)
+		var j1 int
+		for _, num1 := range m.AncestorTimestamps {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintBtclightclient(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RetargetHeader != nil {
+		{
+			size, err := m.RetargetHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeadersMMR) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeadersMMR) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeadersMMR) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Peaks) > 0 {
+		for iNdEx := len(m.Peaks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Peaks[iNdEx])
+			copy(dAtA[i:], m.Peaks[iNdEx])
+			i = encodeVarintBtclightclient(dAtA, i, uint64(len(m.Peaks[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NumLeaves != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.NumLeaves))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FirstHeight != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.FirstHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MMRProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MMRProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MMRProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Siblings) > 0 {
+		for iNdEx := len(m.Siblings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Siblings[iNdEx])
+			copy(dAtA[i:], m.Siblings[iNdEx])
+			i = encodeVarintBtclightclient(dAtA, i, uint64(len(m.Siblings[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.LeafIndex != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.LeafIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCReorg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCReorg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCReorg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewTip != nil {
+		{
+			size, err := m.NewTip.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OrphanedHeaderHashes) > 0 {
+		for iNdEx := len(m.OrphanedHeaderHashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.OrphanedHeaderHashes[iNdEx].Size()
+				i -= size
+				if _, err := m.OrphanedHeaderHashes[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ForkPoint != nil {
+		{
+			size, err := m.ForkPoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BabylonHeight != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.BabylonHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReporterInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReporterInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReporterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrphanedHeaders != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.OrphanedHeaders))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CanonicalHeaders != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.CanonicalHeaders))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PendingHeaders != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.PendingHeaders))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBtclightclient(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReportedHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportedHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportedHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintBtclightclient(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Hash != nil {
+		{
+			size := m.Hash.Size()
+			i -= size
+			if _, err := m.Hash.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCNetwork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCNetwork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCNetwork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetargetAdjustmentFactor != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.RetargetAdjustmentFactor))
+		i--
+		dAtA[i] = 0x38
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TargetTimePerBlock, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TargetTimePerBlock):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintBtclightclient(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x32
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TargetTimespan, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TargetTimespan):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintBtclightclient(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PowLimit) > 0 {
+		i -= len(m.PowLimit)
+		copy(dAtA[i:], m.PowLimit)
+		i = encodeVarintBtclightclient(dAtA, i, uint64(len(m.PowLimit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintBtclightclient(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SignetChallenge) > 0 {
+		i -= len(m.SignetChallenge)
+		copy(dAtA[i:], m.SignetChallenge)
+		i = encodeVarintBtclightclient(dAtA, i, uint64(len(m.SignetChallenge)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBtclightclient(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBtclightclient(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBtclightclient(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BTCHeaderInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	if m.Hash != nil {
+		l = m.Hash.Size()
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBtclightclient(uint64(m.Height))
+	}
+	if m.Work != nil {
+		l = m.Work.Size()
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	return n
+}
+
+func (m *BaseHeaderContext) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RetargetHeader != nil {
+		l = m.RetargetHeader.Size()
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	if len(m.AncestorTimestamps) > 0 {
+		l = 0
+		for _, e := range m.AncestorTimestamps {
+			l += sovBtclightclient(uint64(e))
+		}
+		n += 1 + sovBtclightclient(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *HeadersMMR) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FirstHeight != 0 {
+		n += 1 + sovBtclightclient(uint64(m.FirstHeight))
+	}
+	if m.NumLeaves != 0 {
+		n += 1 + sovBtclightclient(uint64(m.NumLeaves))
+	}
+	if len(m.Peaks) > 0 {
+		for _, b := range m.Peaks {
+			l = len(b)
+			n += 1 + l + sovBtclightclient(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MMRProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeafIndex != 0 {
+		n += 1 + sovBtclightclient(uint64(m.LeafIndex))
+	}
+	if len(m.Siblings) > 0 {
+		for _, b := range m.Siblings {
+			l = len(b)
+			n += 1 + l + sovBtclightclient(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BTCReorg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BabylonHeight != 0 {
+		n += 1 + sovBtclightclient(uint64(m.BabylonHeight))
+	}
+	if m.ForkPoint != nil {
+		l = m.ForkPoint.Size()
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	if len(m.OrphanedHeaderHashes) > 0 {
+		for _, e := range m.OrphanedHeaderHashes {
+			l = e.Size()
+			n += 1 + l + sovBtclightclient(uint64(l))
+		}
+	}
+	if m.NewTip != nil {
+		l = m.NewTip.Size()
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	return n
+}
+
+func (m *ReporterInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovBtclightclient(uint64(l))
+	if m.PendingHeaders != 0 {
+		n += 1 + sovBtclightclient(uint64(m.PendingHeaders))
+	}
+	if m.CanonicalHeaders != 0 {
+		n += 1 + sovBtclightclient(uint64(m.CanonicalHeaders))
+	}
+	if m.OrphanedHeaders != 0 {
+		n += 1 + sovBtclightclient(uint64(m.OrphanedHeaders))
+	}
+	return n
+}
+
+func (m *ReportedHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBtclightclient(uint64(m.Height))
+	}
+	if m.Hash != nil {
+		l = m.Hash.Size()
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCNetwork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	l = len(m.SignetChallenge)
+	if l > 0 {
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	l = len(m.PowLimit)
+	if l > 0 {
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TargetTimespan)
+	n += 1 + l + sovBtclightclient(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TargetTimePerBlock)
+	n += 1 + l + sovBtclightclient(uint64(l))
+	if m.RetargetAdjustmentFactor != 0 {
+		n += 1 + sovBtclightclient(uint64(m.RetargetAdjustmentFactor))
+	}
+	return n
+}
+
+func sovBtclightclient(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBtclightclient(x uint64) (n int) {
+	return sovBtclightclient(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtclightclient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCHeaderInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCHeaderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BTCHeaderBytes
+			m.Header = &v
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BTCHeaderHashBytes
+			m.Hash = &v
+			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Work", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Uint
+			m.Work = &v
+			if err := m.Work.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtclightclient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseHeaderContext) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtclightclient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseHeaderContext: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseHeaderContext: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetargetHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetargetHeader == nil {
+				m.RetargetHeader = &BTCHeaderInfo{}
+			}
+			if err := m.RetargetHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBtclightclient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AncestorTimestamps = append(m.AncestorTimestamps, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBtclightclient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBtclightclient
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBtclightclient
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AncestorTimestamps) == 0 {
+					m.AncestorTimestamps = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBtclightclient
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AncestorTimestamps = append(m.AncestorTimestamps, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AncestorTimestamps", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtclightclient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeadersMMR) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtclightclient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeadersMMR: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeadersMMR: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstHeight", wireType)
+			}
+			m.FirstHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumLeaves", wireType)
+			}
+			m.NumLeaves = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumLeaves |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peaks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peaks = append(m.Peaks, make([]byte, postIndex-iNdEx))
+			copy(m.Peaks[len(m.Peaks)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtclightclient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MMRProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtclightclient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MMRProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MMRProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafIndex", wireType)
+			}
+			m.LeafIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Siblings", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Siblings = append(m.Siblings, make([]byte, postIndex-iNdEx))
+			copy(m.Siblings[len(m.Siblings)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtclightclient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCReorg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtclightclient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCReorg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCReorg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonHeight", wireType)
+			}
+			m.BabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkPoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ForkPoint == nil {
+				m.ForkPoint = &BTCHeaderInfo{}
+			}
+			if err := m.ForkPoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedHeaderHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BTCHeaderHashBytes
+			m.OrphanedHeaderHashes = append(m.OrphanedHeaderHashes, v)
+			if err := m.OrphanedHeaderHashes[len(m.OrphanedHeaderHashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewTip == nil {
+				m.NewTip = &BTCHeaderInfo{}
+			}
+			if err := m.NewTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtclightclient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReporterInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtclightclient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReporterInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReporterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingHeaders", wireType)
+			}
+			m.PendingHeaders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingHeaders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalHeaders", wireType)
+			}
+			m.CanonicalHeaders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CanonicalHeaders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedHeaders", wireType)
+			}
+			m.OrphanedHeaders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrphanedHeaders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtclightclient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovBtclightclient(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBtclightclient(x uint64) (n int) {
-	return sovBtclightclient(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
+func (m *ReportedHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -238,15 +2234,34 @@ func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BTCHeaderInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReportedHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCHeaderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReportedHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -273,17 +2288,17 @@ func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BTCHeaderBytes
-			m.Header = &v
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BTCHeaderHashBytes
+			m.Hash = &v
+			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtclightclient
@@ -293,32 +2308,143 @@ func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBtclightclient
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtclightclient
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BTCHeaderHashBytes
-			m.Hash = &v
-			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtclightclient(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCNetwork) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtclightclient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCNetwork: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCNetwork: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignetChallenge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignetChallenge = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtclightclient
@@ -328,16 +2454,29 @@ func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Work", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowLimit", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtclightclient
@@ -347,27 +2486,109 @@ func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBtclightclient
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtclightclient
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Uint
-			m.Work = &v
-			if err := m.Work.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PowLimit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetTimespan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TargetTimespan, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetTimePerBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TargetTimePerBlock, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetargetAdjustmentFactor", wireType)
+			}
+			m.RetargetAdjustmentFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetargetAdjustmentFactor |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtclightclient(dAtA[iNdEx:])
@@ -8,12 +8,17 @@ import (
 
 // x/btclightclient module sentinel errors
 var (
-	ErrHeaderDoesNotExist       = errorsmod.Register(ModuleName, 1100, "header does not exist")
-	ErrHeaderParentDoesNotExist = errorsmod.Register(ModuleName, 1101, "parent for provided hash is not maintained")
-	ErrEmptyMessage             = errorsmod.Register(ModuleName, 1102, "empty message provided")
-	ErrInvalidProofOfWOrk       = errorsmod.Register(ModuleName, 1103, "provided header has invalid proof of work")
-	ErrInvalidHeader            = errorsmod.Register(ModuleName, 1104, "provided header does not satisfy header validation rules")
-	ErrChainWithNotEnoughWork   = errorsmod.Register(ModuleName, 1105, "provided chain has not enough work")
-	ErrUnauthorizedReporter     = errorsmod.Register(ModuleName, 1106, "unauthorized reporter")
-	ErrInvalidMessageFormat     = errorsmod.Register(ModuleName, 1107, "invalid message format")
+	ErrHeaderDoesNotExist        = errorsmod.Register(ModuleName, 1100, "header does not exist")
+	ErrHeaderParentDoesNotExist  = errorsmod.Register(ModuleName, 1101, "parent for provided hash is not maintained")
+	ErrEmptyMessage              = errorsmod.Register(ModuleName, 1102, "empty message provided")
+	ErrInvalidProofOfWOrk        = errorsmod.Register(ModuleName, 1103, "provided header has invalid proof of work")
+	ErrInvalidHeader             = errorsmod.Register(ModuleName, 1104, "provided header does not satisfy header validation rules")
+	ErrChainWithNotEnoughWork    = errorsmod.Register(ModuleName, 1105, "provided chain has not enough work")
+	ErrUnauthorizedReporter      = errorsmod.Register(ModuleName, 1106, "unauthorized reporter")
+	ErrInvalidMessageFormat      = errorsmod.Register(ModuleName, 1107, "invalid message format")
+	ErrForkFromPrunedHeader      = errorsmod.Register(ModuleName, 1108, "provided chain forks from a header that is pruned")
+	ErrInvalidMMRProof           = errorsmod.Register(ModuleName, 1109, "invalid MMR proof of a pruned header")
+	ErrReporterNotFound          = errorsmod.Register(ModuleName, 1110, "bonded reporter not found")
+	ErrInvalidReporterBond       = errorsmod.Register(ModuleName, 1111, "invalid reporter bond")
+	ErrReporterHasPendingHeaders = errorsmod.Register(ModuleName, 1112, "bonded reporter has headers that are not settled yet")
 )
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -165,10 +167,127 @@ func (m *EventBTCHeaderInserted) GetHeader() *BTCHeaderInfo {
 	return nil
 }
 
+// EventBTCReorg is emitted on Msg/InsertHeaders
+// It is emitted once per re-org, after the headers of the new fork are
+// inserted, and carries the fork point, the hashes of the orphaned headers and
+// the new tip.
+type EventBTCReorg struct {
+	Reorg *BTCReorg `protobuf:"bytes,1,opt,name=reorg,proto3" json:"reorg,omitempty"`
+}
+
+func (m *EventBTCReorg) Reset()         { *m = EventBTCReorg{} }
+func (m *EventBTCReorg) String() string { return proto.CompactTextString(m) }
+func (*EventBTCReorg) ProtoMessage()    {}
+func (*EventBTCReorg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519f2d655b639c5a, []int{3}
+}
+func (m *EventBTCReorg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBTCReorg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBTCReorg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBTCReorg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBTCReorg.Merge(m, src)
+}
+func (m *EventBTCReorg) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBTCReorg) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBTCReorg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBTCReorg proto.InternalMessageInfo
+
+func (m *EventBTCReorg) GetReorg() *BTCReorg {
+	if m != nil {
+		return m.Reorg
+	}
+	return nil
+}
+
+// EventBTCReporterSlashed is emitted when a bonded reporter is slashed as all
+// of its headers settled upon an `EndBlock` are orphaned at the confirmation
+// depth
+type EventBTCReporterSlashed struct {
+	// reporter is the address of the slashed reporter
+	Reporter string `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	// orphaned_header is the first of the orphaned headers that the reporter is
+	// slashed for
+	OrphanedHeader *ReportedHeader `protobuf:"bytes,2,opt,name=orphaned_header,json=orphanedHeader,proto3" json:"orphaned_header,omitempty"`
+	// slashed_amount is the amount slashed from the bond of the reporter
+	SlashedAmount types.Coin `protobuf:"bytes,3,opt,name=slashed_amount,json=slashedAmount,proto3" json:"slashed_amount"`
+}
+
+func (m *EventBTCReporterSlashed) Reset()         { *m = EventBTCReporterSlashed{} }
+func (m *EventBTCReporterSlashed) String() string { return proto.CompactTextString(m) }
+func (*EventBTCReporterSlashed) ProtoMessage()    {}
+func (*EventBTCReporterSlashed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519f2d655b639c5a, []int{4}
+}
+func (m *EventBTCReporterSlashed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBTCReporterSlashed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBTCReporterSlashed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBTCReporterSlashed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBTCReporterSlashed.Merge(m, src)
+}
+func (m *EventBTCReporterSlashed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBTCReporterSlashed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBTCReporterSlashed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBTCReporterSlashed proto.InternalMessageInfo
+
+func (m *EventBTCReporterSlashed) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *EventBTCReporterSlashed) GetOrphanedHeader() *ReportedHeader {
+	if m != nil {
+		return m.OrphanedHeader
+	}
+	return nil
+}
+
+func (m *EventBTCReporterSlashed) GetSlashedAmount() types.Coin {
+	if m != nil {
+		return m.SlashedAmount
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*EventBTCRollBack)(nil), "babylon.btclightclient.v1.EventBTCRollBack")
 	proto.RegisterType((*EventBTCRollForward)(nil), "babylon.btclightclient.v1.EventBTCRollForward")
 	proto.RegisterType((*EventBTCHeaderInserted)(nil), "babylon.btclightclient.v1.EventBTCHeaderInserted")
+	proto.RegisterType((*EventBTCReorg)(nil), "babylon.btclightclient.v1.EventBTCReorg")
+	proto.RegisterType((*EventBTCReporterSlashed)(nil), "babylon.btclightclient.v1.EventBTCReporterSlashed")
 }
 
 func init() {
@@ -176,22 +295,32 @@ func init() {
 }
 
 var fileDescriptor_519f2d655b639c5a = []byte{
-	// 228 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
-	0xfa, 0x65, 0x86, 0xfa, 0xa9, 0x65, 0xa9, 0x79, 0x25, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42,
-	0x92, 0x50, 0x65, 0x7a, 0xa8, 0xca, 0xf4, 0xca, 0x0c, 0xa5, 0xf4, 0x70, 0x9b, 0x80, 0xa6, 0x18,
-	0x6c, 0x94, 0x52, 0x08, 0x97, 0x80, 0x2b, 0xc8, 0x64, 0xa7, 0x10, 0xe7, 0xa0, 0xfc, 0x9c, 0x1c,
-	0xa7, 0xc4, 0xe4, 0x6c, 0x21, 0x07, 0x2e, 0xb6, 0x8c, 0xd4, 0xc4, 0x94, 0xd4, 0x22, 0x09, 0x46,
-	0x05, 0x46, 0x0d, 0x6e, 0x23, 0x0d, 0x3d, 0x9c, 0xf6, 0xe9, 0x39, 0x85, 0x38, 0x7b, 0x80, 0xd5,
-	0x7a, 0xe6, 0xa5, 0xe5, 0x07, 0x41, 0xf5, 0x29, 0x85, 0x73, 0x09, 0x23, 0x9b, 0xea, 0x96, 0x5f,
-	0x54, 0x9e, 0x58, 0x94, 0x42, 0x05, 0x83, 0xa3, 0xb8, 0xc4, 0x60, 0x06, 0xc3, 0x64, 0x8b, 0x53,
-	0x8b, 0x4a, 0x52, 0xa9, 0x60, 0xb6, 0x53, 0xc0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31,
-	0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb,
-	0x31, 0x44, 0x99, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x4d,
-	0x4d, 0xce, 0x48, 0xcc, 0xcc, 0x83, 0x71, 0xf4, 0x2b, 0xd0, 0x83, 0xbb, 0xa4, 0xb2, 0x20, 0xb5,
-	0x38, 0x89, 0x0d, 0x1c, 0xc6, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x16, 0x0b, 0x33, 0xea,
-	0xd7, 0x01, 0x00, 0x00,
+	// 396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x4f, 0x6b, 0xdb, 0x30,
+	0x18, 0xc6, 0xed, 0xfd, 0x09, 0x9b, 0x46, 0xb2, 0xe1, 0x8d, 0x2d, 0xc9, 0xc1, 0x1b, 0x19, 0x83,
+	0xec, 0x22, 0xe1, 0x0d, 0x06, 0xbb, 0x6d, 0x0e, 0x0b, 0xdb, 0x4e, 0xc5, 0x0d, 0x14, 0x72, 0x09,
+	0xb2, 0xad, 0xda, 0xa6, 0x8e, 0x5e, 0x23, 0x2b, 0x6e, 0xf3, 0x2d, 0xfa, 0xb1, 0x72, 0x4c, 0x6f,
+	0x3d, 0x95, 0x92, 0x7c, 0x91, 0x62, 0x59, 0x4e, 0xdb, 0x40, 0x72, 0xca, 0xc5, 0xe8, 0xb5, 0x9e,
+	0xe7, 0xf7, 0xbc, 0x82, 0x07, 0x7d, 0xf1, 0xa9, 0x3f, 0x4f, 0x81, 0x13, 0x5f, 0x06, 0x69, 0x12,
+	0xc5, 0xe5, 0x97, 0x71, 0x49, 0x0a, 0x87, 0xb0, 0x82, 0x71, 0x89, 0x33, 0x01, 0x12, 0xac, 0x8e,
+	0x96, 0xe1, 0xc7, 0x32, 0x5c, 0x38, 0xdd, 0x77, 0x11, 0x44, 0xa0, 0x54, 0xa4, 0x3c, 0x55, 0x86,
+	0xae, 0x1d, 0x40, 0x3e, 0x85, 0x9c, 0xf8, 0x34, 0x67, 0xa4, 0x70, 0x7c, 0x26, 0xa9, 0x43, 0x02,
+	0x48, 0xb8, 0xbe, 0xc7, 0xbb, 0x73, 0xb7, 0x22, 0x94, 0xbe, 0x37, 0x42, 0x6f, 0xfe, 0x94, 0xfb,
+	0xb8, 0xa3, 0x81, 0x07, 0x69, 0xea, 0xd2, 0xe0, 0xcc, 0xfa, 0x85, 0x1a, 0x31, 0xa3, 0x21, 0x13,
+	0x6d, 0xf3, 0x93, 0xd9, 0x7f, 0xf5, 0xad, 0x8f, 0x77, 0x6e, 0x89, 0xdd, 0xd1, 0xe0, 0xaf, 0xd2,
+	0xfe, 0xe3, 0xa7, 0xe0, 0x69, 0x5f, 0xef, 0x04, 0xbd, 0x7d, 0x48, 0x1d, 0x82, 0x38, 0xa7, 0x22,
+	0x3c, 0x00, 0x78, 0x8c, 0xde, 0xd7, 0xe0, 0xfa, 0x36, 0x67, 0x42, 0xb2, 0x43, 0xb0, 0xff, 0xa3,
+	0xe6, 0x66, 0x69, 0x06, 0x22, 0xb2, 0x7e, 0xa2, 0xe7, 0xa2, 0x3c, 0x68, 0xe2, 0xe7, 0xfd, 0x44,
+	0xe5, 0xf1, 0x2a, 0x47, 0xef, 0xca, 0x44, 0x1f, 0xee, 0x61, 0x19, 0x08, 0xc9, 0xc4, 0x71, 0x4a,
+	0xf3, 0x98, 0x85, 0x56, 0x17, 0xbd, 0x10, 0xfa, 0x97, 0x22, 0xbf, 0xf4, 0x36, 0xb3, 0xe5, 0xa1,
+	0xd7, 0x20, 0xb2, 0x98, 0x72, 0x16, 0x4e, 0xf4, 0x73, 0x9e, 0xa8, 0xf0, 0xaf, 0x7b, 0xc2, 0x75,
+	0x40, 0x58, 0xbd, 0xc9, 0x6b, 0xd5, 0x84, 0x6a, 0xb6, 0x86, 0xa8, 0x95, 0x57, 0xd1, 0x13, 0x3a,
+	0x85, 0x19, 0x97, 0xed, 0xa7, 0x0a, 0xd9, 0xc1, 0x55, 0x97, 0x70, 0xd9, 0x25, 0xac, 0xbb, 0x84,
+	0x07, 0x90, 0x70, 0xf7, 0xd9, 0xe2, 0xe6, 0xa3, 0xe1, 0x35, 0xb5, 0xed, 0xb7, 0x72, 0xb9, 0x47,
+	0x8b, 0x95, 0x6d, 0x2e, 0x57, 0xb6, 0x79, 0xbb, 0xb2, 0xcd, 0xcb, 0xb5, 0x6d, 0x2c, 0xd7, 0xb6,
+	0x71, 0xbd, 0xb6, 0x8d, 0xf1, 0x8f, 0x28, 0x91, 0xf1, 0xcc, 0xc7, 0x01, 0x4c, 0x89, 0x5e, 0x33,
+	0x88, 0x69, 0xc2, 0xeb, 0x81, 0x5c, 0x6c, 0xd7, 0x51, 0xce, 0x33, 0x96, 0xfb, 0x0d, 0xd5, 0xc1,
+	0xef, 0x77, 0x03, 0x00, 0xf4, 0xb3, 0x33, 0x4a, 0x2d, 0x03, 0x00, 0x00,
 }
 
 func (m *EventBTCRollBack) Marshal() (dAtA []byte, err error) {
@@ -299,6 +428,93 @@ func (m *EventBTCHeaderInserted) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBTCReorg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBTCReorg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBTCReorg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reorg != nil {
+		{
+			size, err := m.Reorg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBTCReporterSlashed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBTCReporterSlashed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBTCReporterSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SlashedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.OrphanedHeader != nil {
+		{
+			size, err := m.OrphanedHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -349,6 +565,38 @@ func (m *EventBTCHeaderInserted) Size() (n int) {
 	return n
 }
 
+func (m *EventBTCReorg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reorg != nil {
+		l = m.Reorg.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBTCReporterSlashed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.OrphanedHeader != nil {
+		l = m.OrphanedHeader.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.SlashedAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -613,6 +861,243 @@ func (m *EventBTCHeaderInserted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventBTCReorg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBTCReorg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBTCReorg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reorg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reorg == nil {
+				m.Reorg = &BTCReorg{}
+			}
+			if err := m.Reorg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBTCReporterSlashed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBTCReporterSlashed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBTCReporterSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OrphanedHeader == nil {
+				m.OrphanedHeader = &ReportedHeader{}
+			}
+			if err := m.OrphanedHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
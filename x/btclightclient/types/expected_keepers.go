@@ -2,8 +2,26 @@ package types
 
 import (
 	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 )
 
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+type IncentiveKeeper interface {
+	RewardBTCHeaderReporters(ctx context.Context, reporters []sdk.AccAddress, rewardPerHeader sdk.Coins)
+	FundBTCHeaderReportingPool(ctx context.Context, senderModule string, coins sdk.Coins) error
+}
+
+type BtcCheckpointKeeper interface {
+	GetParams(ctx context.Context) (p btcctypes.Params)
+}
+
 type BTCLightClientHooks interface {
 	AfterBTCRollBack(ctx context.Context, headerInfo *BTCHeaderInfo)       // Must be called after the chain is rolled back
 	AfterBTCRollForward(ctx context.Context, headerInfo *BTCHeaderInfo)    // Must be called after the chain is rolled forward
@@ -39,8 +39,37 @@ func DefaultGenesis() *GenesisState {
 }
 
 // Validate performs basic genesis state validation returning an error upon any
-// failure.
+// failure. The base header is validated against the BTC network defined in the
+// genesis. If the genesis does not define the BTC network, the validation that
+// depends on it is left to InitGenesis, which validates the base header against
+// the BTC network that the node is configured with.
 func (gs GenesisState) Validate() error {
+	if gs.BtcNetwork == nil {
+		return gs.validate(nil)
+	}
+	btcNet, err := gs.BtcNetwork.NetParams()
+	if err != nil {
+		return fmt.Errorf("invalid BTC network in genesis: %w", err)
+	}
+	return gs.validate(btcNet)
+}
+
+// ValidateWithBTCNet performs basic genesis state validation against the
+// given BTC network, returning an error upon any failure. If the genesis
+// defines the BTC network, the given one must be the same network.
+func (gs GenesisState) ValidateWithBTCNet(btcNet *chaincfg.Params) error {
+	if gs.BtcNetwork != nil {
+		if err := gs.BtcNetwork.ValidateBTCNet(btcNet); err != nil {
+			return fmt.Errorf("the BTC network does not match the one in genesis: %w", err)
+		}
+	}
+	return gs.validate(btcNet)
+}
+
+// validate performs basic genesis state validation against the given BTC
+// network, skipping the validation that depends on the BTC network if it is
+// nil
+func (gs GenesisState) validate(btcNet *chaincfg.Params) error {
 	if err := gs.Params.Validate(); err != nil {
 		return fmt.Errorf("invalid params in genesis: %w", err)
 	}
@@ -55,14 +84,21 @@ func (gs GenesisState) Validate() error {
 		return errors.New("no btc header set on genesis")
 	}
 
-	// We Require that genesis block is difficulty adjustment block, so that we can
-	// properly calculate the difficulty adjustments in the future.
-	// TODO: Even though number of block per re-target depends on the network, in reality it
-	// is always 2016. Maybe we should consider moving it to param, or try to pass
-	// it through
-	isRetarget := IsRetargetBlock(gs.BtcHeaders[0], &chaincfg.MainNetParams)
-	if !isRetarget {
-		return fmt.Errorf("genesis block must be a difficulty adjustment block")
+	// We Require that genesis block is difficulty adjustment block, or that the
+	// genesis carries the header at the difficulty adjustment boundary of the
+	// genesis block's retarget period, so that we can properly calculate the
+	// difficulty adjustments in the future.
+	baseHeader := gs.BtcHeaders[0]
+	if btcNet != nil {
+		if gs.BaseHeaderContext != nil {
+			if err := gs.BaseHeaderContext.Validate(baseHeader, btcNet); err != nil {
+				return fmt.Errorf("invalid base header context in genesis: %w", err)
+			}
+		}
+		isRetarget := IsRetargetBlock(baseHeader, btcNet)
+		if !isRetarget && (gs.BaseHeaderContext == nil || gs.BaseHeaderContext.RetargetHeader == nil) {
+			return fmt.Errorf("genesis block must be a difficulty adjustment block, or come with the header at the difficulty adjustment boundary")
+		}
 	}
 
 	for _, header := range gs.BtcHeaders {
@@ -70,11 +106,65 @@ func (gs GenesisState) Validate() error {
 			return err
 		}
 	}
+
+	if gs.HeadersMmr != nil {
+		if err := gs.HeadersMmr.Validate(); err != nil {
+			return fmt.Errorf("invalid headers MMR in genesis: %w", err)
+		}
+		if gs.HeadersMmr.FirstHeight != baseHeader.Height {
+			return fmt.Errorf("the first height %d of the headers MMR is not the base header height %d", gs.HeadersMmr.FirstHeight, baseHeader.Height)
+		}
+	}
+	if retention := gs.Params.ReorgHistoryRetentionOrDefault(); uint64(len(gs.ReorgHistory)) > retention {
+		return fmt.Errorf("the reorg history in genesis has %d reorgs, more than the retention %d", len(gs.ReorgHistory), retention)
+	}
+	for _, reorg := range gs.ReorgHistory {
+		if err := reorg.Validate(); err != nil {
+			return fmt.Errorf("invalid reorg in genesis: %w", err)
+		}
+	}
+
+	if err := validateReporters(gs.Reporters, gs.ReportedHeaders); err != nil {
+		return fmt.Errorf("invalid reporters in genesis: %w", err)
+	}
 	// TODO: validate headers have proper parent-child relationships and proper proof of work
 
 	return nil
 }
 
+// validateReporters validates the bonded reporters, and that the number of
+// pending headers of each of them matches the reported headers
+func validateReporters(reporters []*ReporterInfo, reportedHeaders []*ReportedHeader) error {
+	pendingHeaders := make(map[string]uint64, len(reporters))
+	for _, reporter := range reporters {
+		if err := reporter.Validate(); err != nil {
+			return err
+		}
+		if _, ok := pendingHeaders[reporter.Address]; ok {
+			return fmt.Errorf("duplicate reporter %s", reporter.Address)
+		}
+		pendingHeaders[reporter.Address] = reporter.PendingHeaders
+	}
+
+	for _, reportedHeader := range reportedHeaders {
+		if err := reportedHeader.Validate(); err != nil {
+			return err
+		}
+		if pendingHeaders[reportedHeader.Reporter] == 0 {
+			return fmt.Errorf("reported header %s has no pending bonded reporter %s", reportedHeader.Hash.MarshalHex(), reportedHeader.Reporter)
+		}
+		pendingHeaders[reportedHeader.Reporter]--
+	}
+
+	for _, reporter := range reporters {
+		if pendingHeaders[reporter.Address] != 0 {
+			return fmt.Errorf("reporter %s has %d pending headers that are not reported", reporter.Address, pendingHeaders[reporter.Address])
+		}
+	}
+
+	return nil
+}
+
 // GenesisStateFromAppState returns x/btclightclient GenesisState given raw application
 // genesis state.
 func GenesisStateFromAppState(cdc codec.Codec, appState map[string]json.RawMessage) GenesisState {
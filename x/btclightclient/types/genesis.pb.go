@@ -27,6 +27,28 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params     Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	BtcHeaders []*BTCHeaderInfo `protobuf:"bytes,2,rep,name=btc_headers,json=btcHeaders,proto3" json:"btc_headers,omitempty"`
+	// base_header_context is the optional context of the base BTC header, i.e.,
+	// the first BTC header in btc_headers
+	BaseHeaderContext *BaseHeaderContext `protobuf:"bytes,3,opt,name=base_header_context,json=baseHeaderContext,proto3" json:"base_header_context,omitempty"`
+	// headers_mmr is the MMR accumulator of the BTC headers pruned from the
+	// storage, if any
+	HeadersMmr *HeadersMMR `protobuf:"bytes,4,opt,name=headers_mmr,json=headersMmr,proto3" json:"headers_mmr,omitempty"`
+	// reorg_history is the history of the reorgs of the BTC chain observed by
+	// the BTC light client, from the oldest to the newest
+	ReorgHistory []*BTCReorg `protobuf:"bytes,5,rep,name=reorg_history,json=reorgHistory,proto3" json:"reorg_history,omitempty"`
+	// reporters are the bonded reporters of BTC headers
+	Reporters []*ReporterInfo `protobuf:"bytes,6,rep,name=reporters,proto3" json:"reporters,omitempty"`
+	// reported_headers are the headers inserted by bonded reporters that are
+	// not settled yet
+	ReportedHeaders []*ReportedHeader `protobuf:"bytes,7,rep,name=reported_headers,json=reportedHeaders,proto3" json:"reported_headers,omitempty"`
+	// retained_header_heights are the heights of the headers that are kept in
+	// the storage after they are pruned
+	RetainedHeaderHeights []uint64 `protobuf:"varint,8,rep,packed,name=retained_header_heights,json=retainedHeaderHeights,proto3" json:"retained_header_heights,omitempty"`
+	// btc_network is the optional definition of the BTC network, e.g., a custom
+	// signet. If set, the base header is validated against it, and all nodes
+	// need to be configured with the same network in app.toml. Otherwise, the
+	// base header is validated against the network configured in app.toml.
+	BtcNetwork *BTCNetwork `protobuf:"bytes,9,opt,name=btc_network,json=btcNetwork,proto3" json:"btc_network,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +98,55 @@ func (m *GenesisState) GetBtcHeaders() []*BTCHeaderInfo {
 	return nil
 }
 
+func (m *GenesisState) GetBaseHeaderContext() *BaseHeaderContext {
+	if m != nil {
+		return m.BaseHeaderContext
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHeadersMmr() *HeadersMMR {
+	if m != nil {
+		return m.HeadersMmr
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReorgHistory() []*BTCReorg {
+	if m != nil {
+		return m.ReorgHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReporters() []*ReporterInfo {
+	if m != nil {
+		return m.Reporters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReportedHeaders() []*ReportedHeader {
+	if m != nil {
+		return m.ReportedHeaders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRetainedHeaderHeights() []uint64 {
+	if m != nil {
+		return m.RetainedHeaderHeights
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBtcNetwork() *BTCNetwork {
+	if m != nil {
+		return m.BtcNetwork
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.btclightclient.v1.GenesisState")
 }
@@ -85,23 +156,36 @@ func init() {
 }
 
 var fileDescriptor_4f95902e4096217a = []byte{
-	// 256 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4f, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
-	0xfa, 0x65, 0x86, 0xfa, 0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25,
-	0xf9, 0x42, 0x92, 0x50, 0x85, 0x7a, 0xa8, 0x0a, 0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3,
-	0xf3, 0xc1, 0xaa, 0xf4, 0x41, 0x2c, 0x88, 0x06, 0x29, 0x3d, 0xdc, 0x26, 0xa3, 0x19, 0x01, 0x51,
-	0xaf, 0x86, 0x5b, 0x7d, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x21, 0x4a, 0xb3, 0x18, 0xb9, 0x78,
-	0xdc, 0x21, 0x4e, 0x0b, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0xb2, 0xe7, 0x62, 0x83, 0x28, 0x90, 0x60,
-	0x54, 0x60, 0xd4, 0xe0, 0x36, 0x52, 0xd4, 0xc3, 0xe9, 0x54, 0xbd, 0x00, 0xb0, 0x42, 0x27, 0x96,
-	0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0xda, 0x84, 0x3c, 0xb9, 0xb8, 0x93, 0x4a, 0x92, 0xe3, 0x33,
-	0x52, 0x13, 0x53, 0x52, 0x8b, 0x8a, 0x25, 0x98, 0x14, 0x98, 0x35, 0xb8, 0x8d, 0x34, 0xf0, 0x98,
-	0xe2, 0x14, 0xe2, 0xec, 0x01, 0x56, 0xec, 0x99, 0x97, 0x96, 0x1f, 0xc4, 0x95, 0x54, 0x92, 0x0c,
-	0xe1, 0x16, 0x3b, 0x05, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x59,
-	0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0xe4, 0xe4, 0x8c, 0xc4,
-	0xcc, 0x3c, 0x18, 0x47, 0xbf, 0x02, 0xdd, 0xe3, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60,
-	0x5f, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xed, 0x4f, 0xca, 0x25, 0xa9, 0x01, 0x00, 0x00,
+	// 450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x13, 0xda, 0x15, 0xe6, 0x0c, 0x01, 0x06, 0x44, 0xd8, 0x21, 0x14, 0x10, 0x2c, 0x48,
+	0x28, 0xd1, 0x86, 0xb4, 0x2b, 0x52, 0x2a, 0x46, 0x76, 0x28, 0x9a, 0x4c, 0x4f, 0x08, 0x29, 0xb2,
+	0x53, 0x93, 0x44, 0x2c, 0x76, 0x64, 0x9b, 0xb1, 0xbe, 0x05, 0x6f, 0xc1, 0xab, 0xec, 0xb8, 0x23,
+	0x27, 0x84, 0xda, 0x17, 0x41, 0xb1, 0xbd, 0x4d, 0xad, 0xd4, 0xf4, 0x12, 0xf9, 0x8b, 0xff, 0xff,
+	0x9f, 0xfe, 0xdf, 0x67, 0x1b, 0xec, 0x11, 0x4c, 0x66, 0xa7, 0x9c, 0xc5, 0x44, 0xe5, 0xa7, 0x55,
+	0x51, 0xb6, 0x5f, 0xca, 0x54, 0x7c, 0xb6, 0x1f, 0x17, 0x94, 0x51, 0x59, 0xc9, 0xa8, 0x11, 0x5c,
+	0x71, 0xf8, 0xd4, 0x0a, 0xa3, 0x65, 0x61, 0x74, 0xb6, 0xbf, 0xfb, 0xa8, 0xe0, 0x05, 0xd7, 0xaa,
+	0xb8, 0x5d, 0x19, 0xc3, 0x6e, 0xb4, 0x9e, 0xbc, 0x82, 0x30, 0xfa, 0xd7, 0xeb, 0xf5, 0x0d, 0x16,
+	0xb8, 0xb6, 0x41, 0x5e, 0xfc, 0xde, 0x02, 0x3b, 0x1f, 0x4d, 0xb4, 0xcf, 0x0a, 0x2b, 0x0a, 0xdf,
+	0x83, 0x81, 0x11, 0xf8, 0xee, 0xd0, 0x0d, 0xbd, 0x83, 0xe7, 0xd1, 0xda, 0xa8, 0xd1, 0x89, 0x16,
+	0x26, 0xfd, 0x8b, 0xbf, 0xcf, 0x1c, 0x64, 0x6d, 0xf0, 0x18, 0x78, 0x44, 0xe5, 0x59, 0x49, 0xf1,
+	0x94, 0x0a, 0xe9, 0xdf, 0x1a, 0xf6, 0x42, 0xef, 0x20, 0xec, 0xa0, 0x24, 0x93, 0x51, 0xaa, 0xc5,
+	0xc7, 0xec, 0x1b, 0x47, 0x80, 0xa8, 0xdc, 0x94, 0x12, 0x7e, 0x05, 0x0f, 0x09, 0x96, 0xd4, 0xb2,
+	0xb2, 0x9c, 0x33, 0x45, 0xcf, 0x95, 0xdf, 0xd3, 0xc1, 0xde, 0x76, 0x21, 0xb1, 0xa4, 0x06, 0x32,
+	0x32, 0x1e, 0xf4, 0x80, 0xac, 0xfe, 0x82, 0x47, 0xc0, 0xb3, 0x21, 0xb3, 0xba, 0x16, 0x7e, 0x5f,
+	0x53, 0x5f, 0x75, 0x50, 0x6d, 0xac, 0xf1, 0x18, 0x21, 0x60, 0x9d, 0xe3, 0x5a, 0xc0, 0x14, 0xdc,
+	0x15, 0x94, 0x8b, 0x22, 0x2b, 0x2b, 0xa9, 0xb8, 0x98, 0xf9, 0x5b, 0xba, 0xe5, 0x97, 0xdd, 0x2d,
+	0xa3, 0xd6, 0x82, 0x76, 0xb4, 0x33, 0x35, 0x46, 0xf8, 0x01, 0x6c, 0x0b, 0xda, 0x70, 0xa1, 0xda,
+	0xc1, 0x0d, 0x34, 0x65, 0xaf, 0x83, 0x82, 0xac, 0x56, 0xcf, 0xed, 0xc6, 0x09, 0x27, 0xe0, 0xbe,
+	0x2d, 0xa6, 0xd7, 0xc7, 0x70, 0x5b, 0xd3, 0xde, 0x6c, 0xa6, 0x4d, 0x4d, 0x97, 0xe8, 0x9e, 0x58,
+	0xaa, 0x25, 0x3c, 0x04, 0x4f, 0x04, 0x55, 0xb8, 0x62, 0xd7, 0xd4, 0xac, 0xa4, 0x2d, 0x42, 0xfa,
+	0x77, 0x86, 0xbd, 0xb0, 0x8f, 0x1e, 0x5f, 0x6d, 0x1b, 0x47, 0x6a, 0x36, 0xe1, 0x91, 0xb9, 0x0f,
+	0x8c, 0xaa, 0x9f, 0x5c, 0x7c, 0xf7, 0xb7, 0x37, 0x8e, 0x39, 0x99, 0x8c, 0x3e, 0x19, 0xb1, 0xbe,
+	0x0c, 0x76, 0x9d, 0x9c, 0x5c, 0xcc, 0x03, 0xf7, 0x72, 0x1e, 0xb8, 0xff, 0xe6, 0x81, 0xfb, 0x6b,
+	0x11, 0x38, 0x97, 0x8b, 0xc0, 0xf9, 0xb3, 0x08, 0x9c, 0x2f, 0x87, 0x45, 0xa5, 0xca, 0x1f, 0x24,
+	0xca, 0x79, 0x1d, 0x5b, 0x6c, 0x5e, 0xe2, 0x8a, 0x5d, 0x15, 0xf1, 0xf9, 0xea, 0x2b, 0x50, 0xb3,
+	0x86, 0x4a, 0x32, 0xd0, 0x4f, 0xe0, 0xdd, 0xff, 0x01, 0x00, 0xf2, 0xac, 0xcf, 0x51, 0xb6, 0x03,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -124,6 +208,102 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BtcNetwork != nil {
+		{
+			size, err := m.BtcNetwork.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.RetainedHeaderHeights) > 0 {
+		dAtA3 := make([]byte, len(m.RetainedHeaderHeights)*10)
+		var j2 int
+		for _, num := range m.RetainedHeaderHeights {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintGenesis(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ReportedHeaders) > 0 {
+		for iNdEx := len(m.ReportedHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReportedHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Reporters) > 0 {
+		for iNdEx := len(m.Reporters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reporters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ReorgHistory) > 0 {
+		for iNdEx := len(m.ReorgHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReorgHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.HeadersMmr != nil {
+		{
+			size, err := m.HeadersMmr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BaseHeaderContext != nil {
+		{
+			size, err := m.BaseHeaderContext.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.BtcHeaders) > 0 {
 		for iNdEx := len(m.BtcHeaders) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -176,6 +356,43 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.BaseHeaderContext != nil {
+		l = m.BaseHeaderContext.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.HeadersMmr != nil {
+		l = m.HeadersMmr.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ReorgHistory) > 0 {
+		for _, e := range m.ReorgHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Reporters) > 0 {
+		for _, e := range m.Reporters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReportedHeaders) > 0 {
+		for _, e := range m.ReportedHeaders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RetainedHeaderHeights) > 0 {
+		l = 0
+		for _, e := range m.RetainedHeaderHeights {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if m.BtcNetwork != nil {
+		l = m.BtcNetwork.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -281,6 +498,292 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseHeaderContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseHeaderContext == nil {
+				m.BaseHeaderContext = &BaseHeaderContext{}
+			}
+			if err := m.BaseHeaderContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadersMmr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeadersMmr == nil {
+				m.HeadersMmr = &HeadersMMR{}
+			}
+			if err := m.HeadersMmr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReorgHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReorgHistory = append(m.ReorgHistory, &BTCReorg{})
+			if err := m.ReorgHistory[len(m.ReorgHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporters = append(m.Reporters, &ReporterInfo{})
+			if err := m.Reporters[len(m.Reporters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedHeaders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportedHeaders = append(m.ReportedHeaders, &ReportedHeader{})
+			if err := m.ReportedHeaders[len(m.ReportedHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RetainedHeaderHeights = append(m.RetainedHeaderHeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RetainedHeaderHeights) == 0 {
+					m.RetainedHeaderHeights = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RetainedHeaderHeights = append(m.RetainedHeaderHeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetainedHeaderHeights", wireType)
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcNetwork", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BtcNetwork == nil {
+				m.BtcNetwork = &BTCNetwork{}
+			}
+			if err := m.BtcNetwork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
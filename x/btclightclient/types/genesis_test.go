@@ -1,13 +1,25 @@
 package types_test
 
 import (
+	"math/rand"
 	"testing"
+	"time"
 
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	retargetHeader := datagen.GenRandomBTCHeaderInfoWithParent(r, nil)
+	retargetHeader.Height = 2016
+	baseHeader := datagen.GenRandomBTCHeaderInfoWithParent(r, nil)
+	baseHeader.Height = 2020
+	mainnet := &types.BTCNetwork{Name: string(bbn.BtcMainnet)}
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -32,6 +44,88 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "invalid genesis state, base header not at retarget boundary",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				BtcHeaders: []*types.BTCHeaderInfo{baseHeader},
+				BtcNetwork: mainnet,
+			},
+			valid: false,
+		},
+		{
+			desc: "valid genesis state, base header of an unknown BTC network",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				BtcHeaders: []*types.BTCHeaderInfo{baseHeader},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state, invalid BTC network",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				BtcHeaders: []*types.BTCHeaderInfo{baseHeader},
+				BtcNetwork: &types.BTCNetwork{Name: "unknown"},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid genesis state, base header with retarget header",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				BtcHeaders: []*types.BTCHeaderInfo{baseHeader},
+				BtcNetwork: mainnet,
+				BaseHeaderContext: &types.BaseHeaderContext{
+					RetargetHeader:     retargetHeader,
+					AncestorTimestamps: []int64{1, 2, 3, retargetHeader.Header.Time().Unix()},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state, retarget header timestamp mismatch",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				BtcHeaders: []*types.BTCHeaderInfo{baseHeader},
+				BtcNetwork: mainnet,
+				BaseHeaderContext: &types.BaseHeaderContext{
+					RetargetHeader:     retargetHeader,
+					AncestorTimestamps: []int64{1, 2, 3, 4},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state, retarget header in another retarget period",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				BtcHeaders: []*types.BTCHeaderInfo{baseHeader},
+				BtcNetwork: mainnet,
+				BaseHeaderContext: &types.BaseHeaderContext{
+					RetargetHeader: &types.BTCHeaderInfo{
+						Header: retargetHeader.Header,
+						Hash:   retargetHeader.Hash,
+						Height: 0,
+						Work:   retargetHeader.Work,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state, too many ancestor timestamps",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				BtcHeaders: []*types.BTCHeaderInfo{baseHeader},
+				BtcNetwork: mainnet,
+				BaseHeaderContext: &types.BaseHeaderContext{
+					RetargetHeader:     retargetHeader,
+					AncestorTimestamps: make([]int64, types.MedianTimeBlocks),
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -43,3 +137,41 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisState_ValidateWithBTCNet(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	// a custom network with a difficulty adjustment every 144 blocks
+	btcNet, err := bbn.NewCustomBtcNetParams(bbn.BtcRegtest, &bbn.CustomBtcNetworkOptions{
+		TargetTimespan:     24 * time.Hour,
+		TargetTimePerBlock: 10 * time.Minute,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(144), types.BlocksPerRetarget(btcNet))
+
+	baseHeader := datagen.GenRandomBTCHeaderInfoWithParent(r, nil)
+	baseHeader.Height = 144
+	genState := &types.GenesisState{
+		Params:     types.DefaultParams(),
+		BtcHeaders: []*types.BTCHeaderInfo{baseHeader},
+	}
+
+	// the base header is at a difficulty adjustment boundary of the custom
+	// network, but not of the preset ones
+	require.NoError(t, genState.ValidateWithBTCNet(btcNet))
+	require.Error(t, genState.ValidateWithBTCNet(&chaincfg.RegressionNetParams))
+
+	// the genesis defining the custom network is validated against it, and
+	// only accepts the same network
+	genState.BtcNetwork = &types.BTCNetwork{
+		Name:               string(bbn.BtcRegtest),
+		TargetTimespan:     24 * time.Hour,
+		TargetTimePerBlock: 10 * time.Minute,
+	}
+	require.NoError(t, genState.Validate())
+	require.NoError(t, genState.ValidateWithBTCNet(btcNet))
+	require.Error(t, genState.ValidateWithBTCNet(&chaincfg.RegressionNetParams))
+
+	// the genesis defining the preset network rejects the base header
+	genState.BtcNetwork = &types.BTCNetwork{Name: string(bbn.BtcRegtest)}
+	require.Error(t, genState.Validate())
+}
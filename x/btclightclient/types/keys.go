@@ -23,9 +23,16 @@ const (
 )
 
 var (
-	HeadersObjectPrefix = []byte{0x01} // reserve this namespace mapping: Height -> BTCHeaderInfo
-	HashToHeightPrefix  = []byte{0x02} // reserve this namespace mapping: Hash -> Height
-	ParamsKey           = []byte{0x03} // key for params
+	HeadersObjectPrefix  = []byte{0x01} // reserve this namespace mapping: Height -> BTCHeaderInfo
+	HashToHeightPrefix   = []byte{0x02} // reserve this namespace mapping: Hash -> Height
+	ParamsKey            = []byte{0x03} // key for params
+	BaseHeaderCtxKey     = []byte{0x04} // key for the context of the base header
+	HeadersMMRKey        = []byte{0x05} // key for the MMR of pruned headers
+	ReorgHistoryPrefix   = []byte{0x06} // reserve this namespace mapping: Index -> BTCReorg
+	ReporterPrefix       = []byte{0x07} // reserve this namespace mapping: Address -> ReporterInfo
+	ReportedHeaderPrefix = []byte{0x08} // reserve this namespace mapping: Height || Hash -> ReportedHeader
+	RetainedHeaderPrefix = []byte{0x09} // reserve this namespace mapping: Height -> empty
+	BTCNetworkKey        = []byte{0x0A} // key for the definition of the BTC network in genesis
 )
 
 func HeadersObjectKey(height uint64) []byte {
@@ -35,3 +42,7 @@ func HeadersObjectKey(height uint64) []byte {
 func HeadersObjectHeightKey(hash *bbn.BTCHeaderHashBytes) []byte {
 	return hash.MustMarshal()
 }
+
+func ReportedHeaderKey(height uint64, hash *bbn.BTCHeaderHashBytes) []byte {
+	return append(sdk.Uint64ToBigEndian(height), hash.MustMarshal()...)
+}
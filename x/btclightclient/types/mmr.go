@@ -0,0 +1,164 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/bits"
+
+	bbn "github.com/babylonchain/babylon/types"
+)
+
+// domain separation tags of the leaves and the internal nodes of the MMR,
+// such that an internal node cannot be passed off as a leaf
+var (
+	mmrLeafTag = []byte{0x00}
+	mmrNodeTag = []byte{0x01}
+)
+
+func mmrHashLeaf(hash *bbn.BTCHeaderHashBytes) []byte {
+	h := sha256.New()
+	h.Write(mmrLeafTag)
+	h.Write(hash.MustMarshal())
+	return h.Sum(nil)
+}
+
+func mmrHashNode(left []byte, right []byte) []byte {
+	h := sha256.New()
+	h.Write(mmrNodeTag)
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// NewHeadersMMR creates an empty MMR whose first leaf will be the BTC header
+// at the given height
+func NewHeadersMMR(firstHeight uint64) *HeadersMMR {
+	return &HeadersMMR{
+		FirstHeight: firstHeight,
+		NumLeaves:   0,
+		Peaks:       [][]byte{},
+	}
+}
+
+// NextHeight returns the height of the next BTC header to be appended to the MMR
+func (m *HeadersMMR) NextHeight() uint64 {
+	return m.FirstHeight + m.NumLeaves
+}
+
+// Append appends the hash of the BTC header at height NextHeight() to the MMR
+func (m *HeadersMMR) Append(hash *bbn.BTCHeaderHashBytes) {
+	node := mmrHashLeaf(hash)
+	// merge the new node with the peaks of the same size, i.e., one peak per
+	// trailing 1 bit of the number of leaves
+	for n := m.NumLeaves; n&1 == 1; n >>= 1 {
+		last := len(m.Peaks) - 1
+		node = mmrHashNode(m.Peaks[last], node)
+		m.Peaks = m.Peaks[:last]
+	}
+	m.Peaks = append(m.Peaks, node)
+	m.NumLeaves++
+}
+
+// locateLeaf returns the index of the peak whose tree contains the leaf with
+// the given index, the height of that tree, and the index of the first leaf
+// of that tree
+func (m *HeadersMMR) locateLeaf(leafIndex uint64) (int, int, uint64) {
+	peakIndex := 0
+	offset := uint64(0)
+	for treeHeight := 63; treeHeight >= 0; treeHeight-- {
+		treeSize := uint64(1) << treeHeight
+		if m.NumLeaves&treeSize == 0 {
+			continue
+		}
+		if leafIndex < offset+treeSize {
+			return peakIndex, treeHeight, offset
+		}
+		offset += treeSize
+		peakIndex++
+	}
+	// unreachable as long as leafIndex < NumLeaves
+	panic(fmt.Sprintf("leaf %d is not in the MMR with %d leaves", leafIndex, m.NumLeaves))
+}
+
+// VerifyInclusion verifies that the given BTC header hash is the leaf of the
+// MMR at the index specified in the proof
+func (m *HeadersMMR) VerifyInclusion(hash *bbn.BTCHeaderHashBytes, proof *MMRProof) error {
+	if hash == nil || proof == nil {
+		return errors.New("empty hash or proof")
+	}
+	if proof.LeafIndex >= m.NumLeaves {
+		return fmt.Errorf("leaf index %d is out of range, the MMR has %d leaves", proof.LeafIndex, m.NumLeaves)
+	}
+
+	peakIndex, treeHeight, offset := m.locateLeaf(proof.LeafIndex)
+	if len(proof.Siblings) != treeHeight {
+		return fmt.Errorf("proof has %d siblings, expected %d", len(proof.Siblings), treeHeight)
+	}
+
+	node := mmrHashLeaf(hash)
+	pos := proof.LeafIndex - offset
+	for _, sibling := range proof.Siblings {
+		if pos&1 == 0 {
+			node = mmrHashNode(node, sibling)
+		} else {
+			node = mmrHashNode(sibling, node)
+		}
+		pos >>= 1
+	}
+
+	if !bytes.Equal(node, m.Peaks[peakIndex]) {
+		return errors.New("the computed peak does not match the MMR")
+	}
+	return nil
+}
+
+// Validate performs basic validation of the MMR
+func (m *HeadersMMR) Validate() error {
+	if len(m.Peaks) != bits.OnesCount64(m.NumLeaves) {
+		return fmt.Errorf("MMR with %d leaves has %d peaks, expected %d", m.NumLeaves, len(m.Peaks), bits.OnesCount64(m.NumLeaves))
+	}
+	for _, peak := range m.Peaks {
+		if len(peak) != sha256.Size {
+			return fmt.Errorf("MMR peak has length %d, expected %d", len(peak), sha256.Size)
+		}
+	}
+	return nil
+}
+
+// GenMMRProof generates the proof of inclusion of the leaf at the given index
+// in the MMR of the given BTC header hashes. The proof is valid against the
+// MMR with exactly len(hashes) leaves. It is used by clients that maintain the
+// full BTC header chain to prove the inclusion of a pruned BTC header.
+func GenMMRProof(hashes []*bbn.BTCHeaderHashBytes, leafIndex uint64) (*MMRProof, error) {
+	if leafIndex >= uint64(len(hashes)) {
+		return nil, fmt.Errorf("leaf index %d is out of range, there are %d leaves", leafIndex, len(hashes))
+	}
+
+	mmr := &HeadersMMR{NumLeaves: uint64(len(hashes))}
+	_, treeHeight, offset := mmr.locateLeaf(leafIndex)
+
+	// compute the Merkle tree containing the leaf level by level, and record
+	// the sibling on each level
+	level := make([][]byte, 0, 1<<treeHeight)
+	for _, hash := range hashes[offset : offset+(1<<treeHeight)] {
+		level = append(level, mmrHashLeaf(hash))
+	}
+	pos := leafIndex - offset
+	siblings := make([][]byte, 0, treeHeight)
+	for len(level) > 1 {
+		siblings = append(siblings, level[pos^1])
+		nextLevel := make([][]byte, 0, len(level)/2)
+		for i := 0; i < len(level); i += 2 {
+			nextLevel = append(nextLevel, mmrHashNode(level[i], level[i+1]))
+		}
+		level = nextLevel
+		pos >>= 1
+	}
+
+	return &MMRProof{
+		LeafIndex: leafIndex,
+		Siblings:  siblings,
+	}, nil
+}
@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+)
+
+func FuzzHeadersMMR(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		firstHeight := datagen.RandomInt(r, 1000)
+		mmr := types.NewHeadersMMR(firstHeight)
+		numLeaves := datagen.RandomInt(r, 200) + 1
+		hashes := make([]*bbn.BTCHeaderHashBytes, 0, numLeaves)
+		for i := uint64(0); i < numLeaves; i++ {
+			require.Equal(t, firstHeight+i, mmr.NextHeight())
+			chHash := datagen.GenRandomBtcdHash(r)
+			hash := bbn.NewBTCHeaderHashBytesFromChainhash(&chHash)
+			hashes = append(hashes, &hash)
+			mmr.Append(&hash)
+			require.NoError(t, mmr.Validate())
+
+			// all leaves appended so far can be proven
+			leafIndex := datagen.RandomInt(r, len(hashes))
+			proof, err := types.GenMMRProof(hashes, leafIndex)
+			require.NoError(t, err)
+			require.NoError(t, mmr.VerifyInclusion(hashes[leafIndex], proof))
+		}
+
+		// a proof cannot be used for another leaf
+		leafIndex := datagen.RandomInt(r, int(numLeaves))
+		proof, err := types.GenMMRProof(hashes, leafIndex)
+		require.NoError(t, err)
+		chHash := datagen.GenRandomBtcdHash(r)
+		otherHash := bbn.NewBTCHeaderHashBytesFromChainhash(&chHash)
+		require.Error(t, mmr.VerifyInclusion(&otherHash, proof))
+		if numLeaves > 1 {
+			otherIndex := (leafIndex + 1) % numLeaves
+			require.Error(t, mmr.VerifyInclusion(hashes[otherIndex], proof))
+		}
+
+		// a proof with an out-of-range leaf index is invalid
+		proof.LeafIndex = numLeaves
+		require.Error(t, mmr.VerifyInclusion(hashes[leafIndex], proof))
+	})
+}
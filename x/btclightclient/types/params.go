@@ -2,14 +2,54 @@ package types
 
 import (
 	"fmt"
+	stdmath "math"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/babylonchain/babylon/app/params"
 )
 
-// NewParams creates a new Params instance
+// MinPruningKeepRecent is the minimum number of the most recent BTC headers to
+// keep in the storage when pruning is enabled. It needs to cover the BTC
+// headers for validating new BTC headers, e.g., the timestamps for the
+// median-time-past, and the fork points of BTC reorgs.
+const MinPruningKeepRecent = 100
+
+// PruningFinalizationMargin is the minimum number of BTC headers that pruning
+// keeps in addition to the checkpoint finalization timeout of x/btccheckpoint,
+// such that the BTC headers including unfinalized BTC checkpoints are never
+// pruned
+const PruningFinalizationMargin = 10
+
+// MaxStakingTime is the maximum timelock of BTC staking txs in BTC blocks, as
+// x/btcstaking encodes the timelocks of staking txs in 16 bits. Pruning keeps
+// at least as many BTC headers, such that the staking txs whose timelocks have
+// not expired can still be registered or proven to be included on Bitcoin
+const MaxStakingTime = stdmath.MaxUint16
+
+// MaxHeadersToPrunePerBlock is the maximum number of BTC headers pruned at
+// the end of a block, such that enabling pruning on a long BTC header chain
+// is spread over many blocks
+const MaxHeadersToPrunePerBlock = 1000
+
+// DefaultReorgHistoryRetention is the default maximum number of the most
+// recent BTC reorgs kept in the reorg history
+const DefaultReorgHistoryRetention = 1000
+
+// DefaultReporterConfirmationDepth is the default depth at which the headers
+// reported by bonded reporters are settled
+const DefaultReporterConfirmationDepth = 6
+
+// NewParams creates a new Params instance, where bonded reporting is disabled
 func NewParams(allowedAddresses []string) Params {
 	return Params{
-		InsertHeadersAllowList: allowedAddresses,
+		InsertHeadersAllowList:    allowedAddresses,
+		ReporterBond:              sdk.NewCoin(appparams.DefaultBondDenom, math.ZeroInt()),
+		ReporterConfirmationDepth: DefaultReporterConfirmationDepth,
+		ReporterSlashingRate:      math.LegacyNewDecWithPrec(1, 1), // 1 * 10^{-1} = 0.1
+		ReorgHistoryRetention:     DefaultReorgHistoryRetention,
+		ReporterReward:            sdk.NewCoin(appparams.DefaultBondDenom, math.ZeroInt()),
 	}
 }
 
@@ -44,15 +84,150 @@ func ValidateAddressList(i interface{}) error {
 	return nil
 }
 
+func validatePruningKeepRecent(keepRecent uint64) error {
+	if keepRecent != 0 && keepRecent < MinPruningKeepRecent {
+		return fmt.Errorf("pruning keep recent must be 0 or at least %d, got %d", MinPruningKeepRecent, keepRecent)
+	}
+
+	return nil
+}
+
+// ValidatePruningKeepRecentWithFinalizationTimeout validates that pruning, if
+// enabled, keeps the BTC headers up to the given checkpoint finalization
+// timeout of x/btccheckpoint plus PruningFinalizationMargin, and up to the
+// maximum staking time of x/btcstaking
+func ValidatePruningKeepRecentWithFinalizationTimeout(keepRecent uint64, finalizationTimeout uint64) error {
+	if keepRecent != 0 && keepRecent < finalizationTimeout+PruningFinalizationMargin {
+		return fmt.Errorf("pruning keep recent %d must be at least the checkpoint finalization timeout %d plus %d",
+			keepRecent, finalizationTimeout, PruningFinalizationMargin)
+	}
+	// the header including a staking tx is needed for verifying its inclusion
+	// as long as its timelock has not expired
+	if keepRecent != 0 && keepRecent < MaxStakingTime {
+		return fmt.Errorf("pruning keep recent %d must be at least the maximum staking time %d", keepRecent, MaxStakingTime)
+	}
+
+	return nil
+}
+
+func validateReporterBond(bond sdk.Coin) error {
+	if err := bond.Validate(); err != nil {
+		return fmt.Errorf("invalid reporter bond: %w", err)
+	}
+
+	return nil
+}
+
+func validateReporterReward(reward sdk.Coin) error {
+	// the reporter reward is not set in the params from before it was
+	// introduced, in which case no reward is paid
+	if reward.Denom == "" && reward.Amount.IsNil() {
+		return nil
+	}
+	if err := reward.Validate(); err != nil {
+		return fmt.Errorf("invalid reporter reward: %w", err)
+	}
+
+	return nil
+}
+
+func validateReporterConfirmationDepth(depth uint64, keepRecent uint64) error {
+	if depth == 0 {
+		return fmt.Errorf("reporter confirmation depth must be positive")
+	}
+	// the canonical headers at the confirmation depth need to be kept in the
+	// storage for settling the reported headers
+	if keepRecent != 0 && depth >= keepRecent {
+		return fmt.Errorf("reporter confirmation depth %d must be smaller than pruning keep recent %d", depth, keepRecent)
+	}
+
+	return nil
+}
+
+func validateReporterSlashingRate(rate math.LegacyDec) error {
+	if rate.IsNil() {
+		return fmt.Errorf("reporter slashing rate should not be nil")
+	}
+	if rate.IsNegative() || rate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("reporter slashing rate must be in [0, 1], got %s", rate.String())
+	}
+
+	return nil
+}
+
 // Validate validates the set of params
 func (p Params) Validate() error {
 	if err := ValidateAddressList(p.InsertHeadersAllowList); err != nil {
 		return err
 	}
 
+	if err := validatePruningKeepRecent(p.PruningKeepRecent); err != nil {
+		return err
+	}
+
+	// the reporter params are not set in the params from before bonded
+	// reporting was introduced, in which case bonded reporting is disabled
+	if p.reporterParamsUnset() {
+		return nil
+	}
+
+	if err := validateReporterBond(p.ReporterBond); err != nil {
+		return err
+	}
+
+	if err := validateReporterConfirmationDepth(p.ReporterConfirmationDepth, p.PruningKeepRecent); err != nil {
+		return err
+	}
+
+	if err := validateReporterSlashingRate(p.ReporterSlashingRate); err != nil {
+		return err
+	}
+
+	if err := validateReporterReward(p.ReporterReward); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// AllowAllReporters returns whether anyone can insert headers, which is the
+// case if there is neither an allow list nor bonded reporting
 func (p *Params) AllowAllReporters() bool {
-	return len(p.InsertHeadersAllowList) == 0
+	return len(p.InsertHeadersAllowList) == 0 && !p.BondedReportingEnabled()
+}
+
+// BondedReportingEnabled returns whether reporters who are not in the allow
+// list can insert headers by locking a bond
+func (p *Params) BondedReportingEnabled() bool {
+	return !p.ReporterBond.Amount.IsNil() && p.ReporterBond.IsPositive()
+}
+
+// ReporterRewardPerHeader returns the reward of a bonded reporter for each
+// header settled on the canonical chain, which is empty if the reward is zero
+// or not set
+func (p *Params) ReporterRewardPerHeader() sdk.Coins {
+	if p.ReporterReward.Amount.IsNil() || !p.ReporterReward.IsPositive() {
+		return sdk.NewCoins()
+	}
+	return sdk.NewCoins(p.ReporterReward)
+}
+
+func (p *Params) reporterParamsUnset() bool {
+	return p.ReporterBond.Denom == "" && p.ReporterBond.Amount.IsNil() &&
+		p.ReporterConfirmationDepth == 0 && p.ReporterSlashingRate.IsNil()
+}
+
+// ReorgHistoryRetentionOrDefault returns the maximum number of the most recent
+// BTC reorgs kept in the reorg history, which is DefaultReorgHistoryRetention
+// if the retention is not set
+func (p *Params) ReorgHistoryRetentionOrDefault() uint64 {
+	if p.ReorgHistoryRetention == 0 {
+		return DefaultReorgHistoryRetention
+	}
+	return p.ReorgHistoryRetention
+}
+
+// PruningEnabled returns whether old BTC headers are pruned from the storage
+func (p *Params) PruningEnabled() bool {
+	return p.PruningKeepRecent != 0
 }
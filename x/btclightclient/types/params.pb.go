@@ -4,7 +4,10 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -28,6 +31,34 @@ type Params struct {
 	// List of addresses which are allowed to insert headers to btc light client
 	// if the list is empty, any address can insert headers
 	InsertHeadersAllowList []string `protobuf:"bytes,1,rep,name=insert_headers_allow_list,json=insertHeadersAllowList,proto3" json:"insert_headers_allow_list,omitempty"`
+	// pruning_keep_recent is the number of the most recent BTC headers kept in
+	// the storage. Older BTC headers are pruned and accumulated into an MMR,
+	// except for the base header and the headers at difficulty adjustment
+	// boundaries. 0 disables pruning. Otherwise, it must be at least 100, at
+	// least the checkpoint finalization timeout of x/btccheckpoint plus 10, and
+	// at least the maximum staking time of x/btcstaking, i.e., 65535.
+	PruningKeepRecent uint64 `protobuf:"varint,2,opt,name=pruning_keep_recent,json=pruningKeepRecent,proto3" json:"pruning_keep_recent,omitempty"`
+	// reporter_bond is the minimum bond that a reporter needs to lock for
+	// inserting headers without being in the allow list. A zero amount disables
+	// bonded reporting.
+	ReporterBond types.Coin `protobuf:"bytes,3,opt,name=reporter_bond,json=reporterBond,proto3" json:"reporter_bond"`
+	// reporter_confirmation_depth is the depth at which the headers reported by
+	// bonded reporters are settled. A header that is on the canonical chain at
+	// this depth earns its reporter the reporter reward, and a header that is
+	// orphaned by then gets its reporter slashed.
+	ReporterConfirmationDepth uint64 `protobuf:"varint,4,opt,name=reporter_confirmation_depth,json=reporterConfirmationDepth,proto3" json:"reporter_confirmation_depth,omitempty"`
+	// reporter_slashing_rate is the portion of the bond that a bonded reporter
+	// loses when all of its headers settled upon an `EndBlock` are orphaned
+	ReporterSlashingRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=reporter_slashing_rate,json=reporterSlashingRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reporter_slashing_rate"`
+	// reorg_history_retention is the maximum number of the most recent BTC
+	// reorgs kept in the reorg history. Older reorgs are pruned from the
+	// history. 0 means the default retention of 1000 reorgs.
+	ReorgHistoryRetention uint64 `protobuf:"varint,6,opt,name=reorg_history_retention,json=reorgHistoryRetention,proto3" json:"reorg_history_retention,omitempty"`
+	// reporter_reward is the reward that a bonded reporter earns from the
+	// reporting reward pool of x/incentive for each header settled on the
+	// canonical chain, as long as the pool is not drained. A zero or unset
+	// amount disables the reward.
+	ReporterReward types.Coin `protobuf:"bytes,7,opt,name=reporter_reward,json=reporterReward,proto3" json:"reporter_reward"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,6 +101,41 @@ func (m *Params) GetInsertHeadersAllowList() []string {
 	return nil
 }
 
+func (m *Params) GetPruningKeepRecent() uint64 {
+	if m != nil {
+		return m.PruningKeepRecent
+	}
+	return 0
+}
+
+func (m *Params) GetReporterBond() types.Coin {
+	if m != nil {
+		return m.ReporterBond
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetReporterConfirmationDepth() uint64 {
+	if m != nil {
+		return m.ReporterConfirmationDepth
+	}
+	return 0
+}
+
+func (m *Params) GetReorgHistoryRetention() uint64 {
+	if m != nil {
+		return m.ReorgHistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetReporterReward() types.Coin {
+	if m != nil {
+		return m.ReporterReward
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.btclightclient.v1.Params")
 }
@@ -79,21 +145,37 @@ func init() {
 }
 
 var fileDescriptor_1e4c5f7a17079e1f = []byte{
-	// 211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
-	0xfa, 0x65, 0x86, 0xfa, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0x92, 0x50, 0x75, 0x7a, 0xa8, 0xea, 0xf4, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0xc1, 0xaa, 0xf4, 0x41, 0x2c, 0x88, 0x06, 0x25, 0x4f, 0x2e, 0xb6, 0x00, 0xb0, 0x01, 0x42, 0x96,
-	0x5c, 0x92, 0x99, 0x79, 0xc5, 0xa9, 0x45, 0x25, 0xf1, 0x19, 0xa9, 0x89, 0x29, 0xa9, 0x45, 0xc5,
-	0xf1, 0x89, 0x39, 0x39, 0xf9, 0xe5, 0xf1, 0x39, 0x99, 0xc5, 0x25, 0x12, 0x8c, 0x0a, 0xcc, 0x1a,
-	0x9c, 0x41, 0x62, 0x10, 0x05, 0x1e, 0x10, 0x79, 0x47, 0x90, 0xb4, 0x4f, 0x66, 0x71, 0x89, 0x15,
-	0xcb, 0x8b, 0x05, 0xf2, 0x8c, 0x4e, 0x01, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
-	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
-	0x10, 0x65, 0x96, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x75, 0x60,
-	0x72, 0x46, 0x62, 0x66, 0x1e, 0x8c, 0xa3, 0x5f, 0x81, 0xee, 0xaf, 0x92, 0xca, 0x82, 0xd4, 0xe2,
-	0x24, 0x36, 0xb0, 0x1b, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x30, 0xe4, 0x07, 0x29, 0xfe,
-	0x00, 0x00, 0x00,
+	// 476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0x63, 0x1a, 0x82, 0xba, 0x7c, 0x09, 0x53, 0x8a, 0xd3, 0x4a, 0x4e, 0xc4, 0x01, 0xe5,
+	0xc2, 0xae, 0x02, 0x52, 0x25, 0x38, 0x20, 0x91, 0xe6, 0x10, 0x89, 0x1e, 0x2a, 0x73, 0xe3, 0xb2,
+	0x5a, 0xaf, 0x07, 0x7b, 0x55, 0x7b, 0xc7, 0xda, 0xdd, 0xa6, 0xe4, 0x2d, 0x78, 0x04, 0x1e, 0x82,
+	0x87, 0xe8, 0xb1, 0xe2, 0x84, 0x38, 0x54, 0x28, 0xb9, 0x20, 0x9e, 0x02, 0xf9, 0x2b, 0x02, 0x4e,
+	0x5c, 0x2c, 0x8f, 0x7e, 0xff, 0x99, 0xf9, 0x69, 0x35, 0xe4, 0x69, 0x2c, 0xe2, 0x55, 0x8e, 0x9a,
+	0xc5, 0x4e, 0xe6, 0x2a, 0xcd, 0xaa, 0x2f, 0x68, 0xc7, 0x96, 0x53, 0x56, 0x0a, 0x23, 0x0a, 0x4b,
+	0x4b, 0x83, 0x0e, 0xfd, 0x61, 0x9b, 0xa3, 0x7f, 0xe7, 0xe8, 0x72, 0x7a, 0xb0, 0x97, 0x62, 0x8a,
+	0x75, 0x8a, 0x55, 0x7f, 0x4d, 0xc3, 0xc1, 0x50, 0xa2, 0x2d, 0xd0, 0xf2, 0x06, 0x34, 0x45, 0x8b,
+	0xc2, 0xa6, 0x62, 0xb1, 0xb0, 0xc0, 0x96, 0xd3, 0x18, 0x9c, 0x98, 0x32, 0x89, 0x4a, 0x37, 0xfc,
+	0xc9, 0xaf, 0x1d, 0x32, 0x38, 0xad, 0x97, 0xfb, 0x2f, 0xc9, 0x50, 0x69, 0x0b, 0xc6, 0xf1, 0x0c,
+	0x44, 0x02, 0xc6, 0x72, 0x91, 0xe7, 0x78, 0xc1, 0x73, 0x65, 0x5d, 0xe0, 0x8d, 0x77, 0x26, 0xbb,
+	0xd1, 0x7e, 0x13, 0x58, 0x34, 0xfc, 0x4d, 0x85, 0x4f, 0x94, 0x75, 0x3e, 0x25, 0x0f, 0x4b, 0x73,
+	0xae, 0x95, 0x4e, 0xf9, 0x19, 0x40, 0xc9, 0x0d, 0x48, 0xd0, 0x2e, 0xb8, 0x31, 0xf6, 0x26, 0xfd,
+	0xe8, 0x41, 0x8b, 0xde, 0x02, 0x94, 0x51, 0x0d, 0xfc, 0x39, 0xb9, 0x6b, 0xa0, 0x44, 0xe3, 0xc0,
+	0xf0, 0x18, 0x75, 0x12, 0xec, 0x8c, 0xbd, 0xc9, 0xed, 0xe7, 0x43, 0xda, 0xba, 0x57, 0xb6, 0xb4,
+	0xb5, 0xa5, 0xc7, 0xa8, 0xf4, 0xac, 0x7f, 0x79, 0x3d, 0xea, 0x45, 0x77, 0xba, 0xae, 0x19, 0xea,
+	0xc4, 0x7f, 0x4d, 0x0e, 0xb7, 0x53, 0x24, 0xea, 0x0f, 0xca, 0x14, 0xc2, 0x29, 0xd4, 0x3c, 0x81,
+	0xd2, 0x65, 0x41, 0xbf, 0xde, 0x3e, 0xec, 0x22, 0xc7, 0x7f, 0x24, 0xe6, 0x55, 0xc0, 0x4f, 0xc9,
+	0xfe, 0xb6, 0xdf, 0xe6, 0xc2, 0x66, 0x95, 0xbf, 0x11, 0x0e, 0x82, 0x9b, 0x63, 0x6f, 0xb2, 0x3b,
+	0x9b, 0x56, 0x3b, 0xbf, 0x5f, 0x8f, 0x0e, 0x1b, 0x2b, 0x9b, 0x9c, 0x51, 0x85, 0xac, 0x10, 0x2e,
+	0xa3, 0x27, 0x90, 0x0a, 0xb9, 0x9a, 0x83, 0xfc, 0xfa, 0xe5, 0x19, 0x69, 0xa5, 0xe7, 0x20, 0xa3,
+	0xbd, 0x6e, 0xe0, 0xbb, 0x76, 0x5e, 0x24, 0x1c, 0xf8, 0x47, 0xe4, 0xb1, 0x01, 0x34, 0x29, 0xcf,
+	0x94, 0x75, 0x68, 0x56, 0xdc, 0x80, 0x03, 0x5d, 0x89, 0x04, 0x83, 0x5a, 0xf2, 0x51, 0x8d, 0x17,
+	0x0d, 0x8d, 0x3a, 0xe8, 0x2f, 0xc8, 0xfd, 0xad, 0xa0, 0x81, 0x0b, 0x61, 0x92, 0xe0, 0xd6, 0xff,
+	0x3d, 0xd4, 0xbd, 0xae, 0x2f, 0xaa, 0xdb, 0x5e, 0xf5, 0x7f, 0x7e, 0x1e, 0x79, 0xb3, 0xd3, 0xcb,
+	0x75, 0xe8, 0x5d, 0xad, 0x43, 0xef, 0xc7, 0x3a, 0xf4, 0x3e, 0x6d, 0xc2, 0xde, 0xd5, 0x26, 0xec,
+	0x7d, 0xdb, 0x84, 0xbd, 0xf7, 0x47, 0xa9, 0x72, 0xd9, 0x79, 0x4c, 0x25, 0x16, 0xac, 0xbd, 0x3e,
+	0x99, 0x09, 0xa5, 0xbb, 0x82, 0x7d, 0xfc, 0xf7, 0x68, 0xdd, 0xaa, 0x04, 0x1b, 0x0f, 0xea, 0x2b,
+	0x7a, 0xf1, 0x7b, 0x00, 0x52, 0xcc, 0x4b, 0xc2, 0xdb, 0x02, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -123,6 +205,24 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.PruningKeepRecent != that1.PruningKeepRecent {
+		return false
+	}
+	if !this.ReporterBond.Equal(&that1.ReporterBond) {
+		return false
+	}
+	if this.ReporterConfirmationDepth != that1.ReporterConfirmationDepth {
+		return false
+	}
+	if !this.ReporterSlashingRate.Equal(that1.ReporterSlashingRate) {
+		return false
+	}
+	if this.ReorgHistoryRetention != that1.ReorgHistoryRetention {
+		return false
+	}
+	if !this.ReporterReward.Equal(&that1.ReporterReward) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -145,6 +245,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ReporterReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.ReorgHistoryRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReorgHistoryRetention))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.ReporterSlashingRate.Size()
+		i -= size
+		if _, err := m.ReporterSlashingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.ReporterConfirmationDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReporterConfirmationDepth))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.ReporterBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PruningKeepRecent != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PruningKeepRecent))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.InsertHeadersAllowList) > 0 {
 		for iNdEx := len(m.InsertHeadersAllowList) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.InsertHeadersAllowList[iNdEx])
@@ -180,6 +325,21 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.PruningKeepRecent != 0 {
+		n += 1 + sovParams(uint64(m.PruningKeepRecent))
+	}
+	l = m.ReporterBond.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ReporterConfirmationDepth != 0 {
+		n += 1 + sovParams(uint64(m.ReporterConfirmationDepth))
+	}
+	l = m.ReporterSlashingRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ReorgHistoryRetention != 0 {
+		n += 1 + sovParams(uint64(m.ReorgHistoryRetention))
+	}
+	l = m.ReporterReward.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -250,6 +410,163 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.InsertHeadersAllowList = append(m.InsertHeadersAllowList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningKeepRecent", wireType)
+			}
+			m.PruningKeepRecent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruningKeepRecent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReporterBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterConfirmationDepth", wireType)
+			}
+			m.ReporterConfirmationDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReporterConfirmationDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterSlashingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReporterSlashingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReorgHistoryRetention", wireType)
+			}
+			m.ReorgHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReorgHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReporterReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -33,6 +33,10 @@ func NewQueryMainChainRequest(req *query.PageRequest) *QueryMainChainRequest {
 	return &QueryMainChainRequest{Pagination: req}
 }
 
+func NewQueryReorgHistoryRequest(req *query.PageRequest) *QueryReorgHistoryRequest {
+	return &QueryReorgHistoryRequest{Pagination: req}
+}
+
 func NewQueryTipRequest() *QueryTipRequest {
 	return &QueryTipRequest{}
 }
@@ -212,6 +212,9 @@ func (m *QueryHashesResponse) GetPagination() *query.PageResponse {
 // It involves checking whether a hash is maintained by the module.
 type QueryContainsRequest struct {
 	Hash *github_com_babylonchain_babylon_types.BTCHeaderHashBytes `protobuf:"bytes,1,opt,name=hash,proto3,customtype=github.com/babylonchain/babylon/types.BTCHeaderHashBytes" json:"hash,omitempty"`
+	// mmr_proof is the optional proof of inclusion of the header in the MMR of
+	// pruned headers, in case the header is pruned from the storage
+	MmrProof *MMRProof `protobuf:"bytes,2,opt,name=mmr_proof,json=mmrProof,proto3" json:"mmr_proof,omitempty"`
 }
 
 func (m *QueryContainsRequest) Reset()         { *m = QueryContainsRequest{} }
@@ -247,6 +250,13 @@ func (m *QueryContainsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryContainsRequest proto.InternalMessageInfo
 
+func (m *QueryContainsRequest) GetMmrProof() *MMRProof {
+	if m != nil {
+		return m.MmrProof
+	}
+	return nil
+}
+
 // QueryContainsResponse is response type for the Query/Contains RPC method.
 type QueryContainsResponse struct {
 	Contains bool `protobuf:"varint,1,opt,name=contains,proto3" json:"contains,omitempty"`
@@ -653,6 +663,9 @@ func (m *QueryBaseHeaderResponse) GetHeader() *BTCHeaderInfoResponse {
 // it contains hex encoded hash of btc block header as parameter
 type QueryHeaderDepthRequest struct {
 	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// mmr_proof is the optional proof of inclusion of the header in the MMR of
+	// pruned headers, in case the header is pruned from the storage
+	MmrProof *MMRProof `protobuf:"bytes,2,opt,name=mmr_proof,json=mmrProof,proto3" json:"mmr_proof,omitempty"`
 }
 
 func (m *QueryHeaderDepthRequest) Reset()         { *m = QueryHeaderDepthRequest{} }
@@ -695,6 +708,13 @@ func (m *QueryHeaderDepthRequest) GetHash() string {
 	return ""
 }
 
+func (m *QueryHeaderDepthRequest) GetMmrProof() *MMRProof {
+	if m != nil {
+		return m.MmrProof
+	}
+	return nil
+}
+
 // QueryMainChainDepthResponse is the response type for the Query/MainChainDepth RPC
 // it contains depth of the block in main chain
 type QueryHeaderDepthResponse struct {
@@ -741,6 +761,282 @@ func (m *QueryHeaderDepthResponse) GetDepth() uint64 {
 	return 0
 }
 
+// QueryHeadersMMRRequest is the request type for the Query/HeadersMMR RPC
+// method.
+type QueryHeadersMMRRequest struct {
+}
+
+func (m *QueryHeadersMMRRequest) Reset()         { *m = QueryHeadersMMRRequest{} }
+func (m *QueryHeadersMMRRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeadersMMRRequest) ProtoMessage()    {}
+func (*QueryHeadersMMRRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{16}
+}
+func (m *QueryHeadersMMRRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeadersMMRRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeadersMMRRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeadersMMRRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeadersMMRRequest.Merge(m, src)
+}
+func (m *QueryHeadersMMRRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeadersMMRRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeadersMMRRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeadersMMRRequest proto.InternalMessageInfo
+
+// QueryHeadersMMRResponse is the response type for the Query/HeadersMMR RPC
+// method.
+type QueryHeadersMMRResponse struct {
+	HeadersMmr *HeadersMMR `protobuf:"bytes,1,opt,name=headers_mmr,json=headersMmr,proto3" json:"headers_mmr,omitempty"`
+}
+
+func (m *QueryHeadersMMRResponse) Reset()         { *m = QueryHeadersMMRResponse{} }
+func (m *QueryHeadersMMRResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHeadersMMRResponse) ProtoMessage()    {}
+func (*QueryHeadersMMRResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{17}
+}
+func (m *QueryHeadersMMRResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeadersMMRResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeadersMMRResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeadersMMRResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeadersMMRResponse.Merge(m, src)
+}
+func (m *QueryHeadersMMRResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeadersMMRResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeadersMMRResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeadersMMRResponse proto.InternalMessageInfo
+
+func (m *QueryHeadersMMRResponse) GetHeadersMmr() *HeadersMMR {
+	if m != nil {
+		return m.HeadersMmr
+	}
+	return nil
+}
+
+// QueryReorgHistoryRequest is the request type for the Query/ReorgHistory RPC
+// method.
+type QueryReorgHistoryRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReorgHistoryRequest) Reset()         { *m = QueryReorgHistoryRequest{} }
+func (m *QueryReorgHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReorgHistoryRequest) ProtoMessage()    {}
+func (*QueryReorgHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{18}
+}
+func (m *QueryReorgHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReorgHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReorgHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReorgHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReorgHistoryRequest.Merge(m, src)
+}
+func (m *QueryReorgHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReorgHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReorgHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReorgHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryReorgHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryReorgHistoryResponse is the response type for the Query/ReorgHistory
+// RPC method.
+type QueryReorgHistoryResponse struct {
+	Reorgs     []*BTCReorg         `protobuf:"bytes,1,rep,name=reorgs,proto3" json:"reorgs,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReorgHistoryResponse) Reset()         { *m = QueryReorgHistoryResponse{} }
+func (m *QueryReorgHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReorgHistoryResponse) ProtoMessage()    {}
+func (*QueryReorgHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{19}
+}
+func (m *QueryReorgHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReorgHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReorgHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReorgHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReorgHistoryResponse.Merge(m, src)
+}
+func (m *QueryReorgHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReorgHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReorgHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReorgHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryReorgHistoryResponse) GetReorgs() []*BTCReorg {
+	if m != nil {
+		return m.Reorgs
+	}
+	return nil
+}
+
+func (m *QueryReorgHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryReporterRequest is the request type for the Query/Reporter RPC method.
+type QueryReporterRequest struct {
+	// address is the address of the bonded reporter
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryReporterRequest) Reset()         { *m = QueryReporterRequest{} }
+func (m *QueryReporterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReporterRequest) ProtoMessage()    {}
+func (*QueryReporterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{20}
+}
+func (m *QueryReporterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReporterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReporterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReporterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReporterRequest.Merge(m, src)
+}
+func (m *QueryReporterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReporterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReporterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReporterRequest proto.InternalMessageInfo
+
+func (m *QueryReporterRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryReporterResponse is the response type for the Query/Reporter RPC
+// method.
+type QueryReporterResponse struct {
+	Reporter *ReporterInfo `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+}
+
+func (m *QueryReporterResponse) Reset()         { *m = QueryReporterResponse{} }
+func (m *QueryReporterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReporterResponse) ProtoMessage()    {}
+func (*QueryReporterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{21}
+}
+func (m *QueryReporterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReporterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReporterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReporterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReporterResponse.Merge(m, src)
+}
+func (m *QueryReporterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReporterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReporterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReporterResponse proto.InternalMessageInfo
+
+func (m *QueryReporterResponse) GetReporter() *ReporterInfo {
+	if m != nil {
+		return m.Reporter
+	}
+	return nil
+}
+
 // BTCHeaderInfoResponse is a structure that contains all relevant information about a
 // BTC header response
 //   - Full header as string hex.
@@ -761,7 +1057,7 @@ func (m *BTCHeaderInfoResponse) Reset()         { *m = BTCHeaderInfoResponse{} }
 func (m *BTCHeaderInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*BTCHeaderInfoResponse) ProtoMessage()    {}
 func (*BTCHeaderInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3961270631e52721, []int{16}
+	return fileDescriptor_3961270631e52721, []int{22}
 }
 func (m *BTCHeaderInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,6 +1124,12 @@ func init() {
 	proto.RegisterType((*QueryBaseHeaderResponse)(nil), "babylon.btclightclient.v1.QueryBaseHeaderResponse")
 	proto.RegisterType((*QueryHeaderDepthRequest)(nil), "babylon.btclightclient.v1.QueryHeaderDepthRequest")
 	proto.RegisterType((*QueryHeaderDepthResponse)(nil), "babylon.btclightclient.v1.QueryHeaderDepthResponse")
+	proto.RegisterType((*QueryHeadersMMRRequest)(nil), "babylon.btclightclient.v1.QueryHeadersMMRRequest")
+	proto.RegisterType((*QueryHeadersMMRResponse)(nil), "babylon.btclightclient.v1.QueryHeadersMMRResponse")
+	proto.RegisterType((*QueryReorgHistoryRequest)(nil), "babylon.btclightclient.v1.QueryReorgHistoryRequest")
+	proto.RegisterType((*QueryReorgHistoryResponse)(nil), "babylon.btclightclient.v1.QueryReorgHistoryResponse")
+	proto.RegisterType((*QueryReporterRequest)(nil), "babylon.btclightclient.v1.QueryReporterRequest")
+	proto.RegisterType((*QueryReporterResponse)(nil), "babylon.btclightclient.v1.QueryReporterResponse")
 	proto.RegisterType((*BTCHeaderInfoResponse)(nil), "babylon.btclightclient.v1.BTCHeaderInfoResponse")
 }
 
@@ -836,65 +1138,80 @@ func init() {
 }
 
 var fileDescriptor_3961270631e52721 = []byte{
-	// 920 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x89, 0xeb, 0x26, 0x2f, 0x20, 0x60, 0x48, 0x83, 0xb3, 0x02, 0x27, 0xdd, 0x92,
-	0x1f, 0x4d, 0xf1, 0x4e, 0x9c, 0x00, 0xea, 0x01, 0x09, 0xe1, 0x20, 0x30, 0x48, 0x48, 0xc6, 0x32,
-	0x1c, 0x50, 0xa5, 0x68, 0xec, 0x0c, 0xbb, 0xab, 0xc6, 0x3b, 0x5b, 0xef, 0x26, 0xc4, 0x42, 0x5c,
-	0x38, 0x70, 0x46, 0x70, 0xe3, 0xc0, 0x81, 0x0b, 0x17, 0xe0, 0xd4, 0x3f, 0xa2, 0xc7, 0x0a, 0x2e,
-	0xa8, 0x87, 0x08, 0x25, 0xfc, 0x11, 0x1c, 0xd1, 0xcc, 0xbc, 0xb5, 0xbd, 0x76, 0xea, 0xb5, 0xd5,
-	0x5c, 0xa2, 0xcc, 0xcc, 0x7b, 0xef, 0xfb, 0x79, 0xcf, 0xb3, 0xdf, 0x5d, 0x58, 0x6f, 0xf2, 0x66,
-	0xf7, 0x48, 0x06, 0xac, 0x19, 0xb7, 0x8e, 0x7c, 0xd7, 0x53, 0x7f, 0x45, 0x10, 0xb3, 0x93, 0x32,
-	0x7b, 0x70, 0x2c, 0x3a, 0x5d, 0x27, 0xec, 0xc8, 0x58, 0xd2, 0x15, 0x0c, 0x73, 0xd2, 0x61, 0xce,
-	0x49, 0xd9, 0x5a, 0x72, 0xa5, 0x2b, 0x75, 0x14, 0x53, 0xff, 0x99, 0x04, 0x6b, 0xa5, 0x25, 0xa3,
-	0xb6, 0x8c, 0x0e, 0xcc, 0x81, 0x59, 0xe0, 0xd1, 0xab, 0xae, 0x94, 0xee, 0x91, 0x60, 0x3c, 0xf4,
-	0x19, 0x0f, 0x02, 0x19, 0xf3, 0xd8, 0x97, 0x41, 0x72, 0xba, 0x6d, 0x62, 0x59, 0x93, 0x47, 0xc2,
-	0x20, 0xb0, 0x93, 0x72, 0x53, 0xc4, 0xbc, 0xcc, 0x42, 0xee, 0xfa, 0x81, 0x0e, 0xc6, 0xd8, 0x8d,
-	0xa7, 0xc3, 0x87, 0xbc, 0xc3, 0xdb, 0x58, 0xd3, 0x5e, 0x02, 0xfa, 0xa9, 0xaa, 0x54, 0xd3, 0x9b,
-	0x75, 0xf1, 0xe0, 0x58, 0x44, 0xb1, 0xfd, 0x39, 0xbc, 0x9c, 0xda, 0x8d, 0x42, 0x19, 0x44, 0x82,
-	0xbe, 0x0b, 0x79, 0x93, 0x5c, 0x20, 0x6b, 0x64, 0x6b, 0x71, 0xf7, 0xa6, 0xf3, 0xd4, 0xde, 0x1d,
-	0x93, 0x5a, 0xc9, 0x3d, 0x3a, 0x5b, 0x9d, 0xa9, 0x63, 0x9a, 0x7d, 0x0f, 0xd5, 0xaa, 0x3c, 0xf2,
-	0x44, 0xa2, 0x46, 0x3f, 0x00, 0xe8, 0xf3, 0x63, 0xe9, 0x0d, 0x07, 0x07, 0xa3, 0x9a, 0x75, 0xcc,
-	0xbc, 0xb1, 0x59, 0xa7, 0xc6, 0x5d, 0x81, 0xb9, 0xf5, 0x81, 0x4c, 0xfb, 0x21, 0x41, 0xec, 0xa4,
-	0x3c, 0x62, 0x37, 0x20, 0xef, 0xe9, 0x9d, 0x02, 0x59, 0x9b, 0xdb, 0x7a, 0xae, 0xf2, 0xce, 0x93,
-	0xb3, 0xd5, 0xbb, 0xae, 0x1f, 0x7b, 0xc7, 0x4d, 0xa7, 0x25, 0xdb, 0x0c, 0x9b, 0x68, 0x79, 0xdc,
-	0x0f, 0x92, 0x05, 0x8b, 0xbb, 0xa1, 0x88, 0x9c, 0x4a, 0x63, 0xbf, 0x2a, 0xf8, 0xa1, 0xe8, 0xa8,
-	0x92, 0x95, 0x6e, 0x2c, 0xa2, 0x3a, 0xd6, 0xa2, 0x1f, 0xa6, 0xa8, 0x67, 0x35, 0xf5, 0x66, 0x26,
-	0xb5, 0x41, 0x4a, 0x61, 0x7b, 0xb0, 0xa4, 0xa9, 0xf7, 0x65, 0x10, 0x73, 0x3f, 0xe8, 0x8d, 0xa5,
-	0x06, 0x39, 0x25, 0xa5, 0x07, 0xf2, 0xac, 0xd0, 0xba, 0x92, 0xbd, 0x07, 0x37, 0x86, 0x94, 0x70,
-	0x42, 0x16, 0xcc, 0xb7, 0x70, 0x4f, 0xcb, 0xcd, 0xd7, 0x7b, 0x6b, 0x9b, 0xc1, 0x4a, 0x2a, 0xc9,
-	0x14, 0x44, 0x46, 0x3a, 0xc8, 0x88, 0x2a, 0x77, 0xc1, 0xba, 0x2c, 0x61, 0x02, 0xa9, 0x03, 0xe4,
-	0xfb, 0x84, 0xfb, 0xc1, 0xbe, 0x6a, 0xec, 0xaa, 0x6f, 0xc8, 0xef, 0x04, 0x96, 0x87, 0x15, 0x90,
-	0xeb, 0x63, 0xb8, 0xee, 0xe9, 0xa1, 0x99, 0x5b, 0xb2, 0xb8, 0xbb, 0x33, 0xe6, 0x72, 0xf7, 0x26,
-	0xfc, 0x51, 0xf0, 0xa5, 0xec, 0xfd, 0xa8, 0x49, 0x81, 0xab, 0xbb, 0x1a, 0x2f, 0xc1, 0x0b, 0x1a,
-	0xb7, 0xe1, 0x87, 0xc9, 0xa3, 0x79, 0x0f, 0x5e, 0xec, 0x6f, 0x21, 0x7b, 0x15, 0xf2, 0x46, 0x1a,
-	0x47, 0x33, 0x3d, 0x3a, 0xe6, 0xdb, 0x05, 0x9c, 0x4f, 0x85, 0x47, 0xc2, 0x84, 0x25, 0xba, 0x2d,
-	0x78, 0x65, 0xe4, 0xe4, 0xca, 0xe5, 0x4b, 0x28, 0x62, 0x42, 0xde, 0x17, 0x61, 0xec, 0x5d, 0x76,
-	0xd3, 0x16, 0xf0, 0xa6, 0xed, 0x40, 0x61, 0x34, 0x1c, 0xa1, 0x96, 0xe0, 0xda, 0xa1, 0xda, 0xd0,
-	0x09, 0xb9, 0xba, 0x59, 0xd8, 0xbf, 0x11, 0xb8, 0x71, 0x29, 0x02, 0x7d, 0x0d, 0xc0, 0x40, 0x1c,
-	0x78, 0xe2, 0x14, 0x55, 0x16, 0xcc, 0x4e, 0x55, 0x9c, 0xd2, 0x15, 0x98, 0x57, 0x92, 0xfa, 0x70,
-	0x56, 0x1f, 0x5e, 0x57, 0x6b, 0x75, 0xb4, 0xac, 0xda, 0x57, 0x5d, 0x16, 0xe6, 0xb4, 0x14, 0xae,
-	0xe8, 0x7b, 0x90, 0xfb, 0x4a, 0x76, 0xee, 0x17, 0x72, 0x2a, 0xbc, 0x52, 0x52, 0x46, 0xf8, 0xe4,
-	0x6c, 0x75, 0xd9, 0x5c, 0x83, 0xe8, 0xf0, 0xbe, 0xe3, 0x4b, 0xd6, 0xe6, 0xb1, 0xe7, 0x7c, 0xe6,
-	0x07, 0xf1, 0x9f, 0x0f, 0x4b, 0x8b, 0x78, 0x41, 0xd4, 0xb2, 0xae, 0x53, 0x77, 0xff, 0x5b, 0x80,
-	0x6b, 0xba, 0x43, 0xfa, 0x03, 0x81, 0xbc, 0xb1, 0x54, 0x5a, 0x1a, 0x33, 0xde, 0x51, 0x2f, 0xb7,
-	0x9c, 0x49, 0xc3, 0xcd, 0x20, 0xec, 0xdb, 0xdf, 0xfe, 0xf5, 0xef, 0x8f, 0xb3, 0xb7, 0xe8, 0x4d,
-	0x96, 0xf5, 0x0a, 0xd1, 0x50, 0xc6, 0x6b, 0xb3, 0xa1, 0x52, 0x96, 0x9f, 0x0d, 0x95, 0xb6, 0xf0,
-	0x89, 0xa0, 0xd0, 0x97, 0x7f, 0x22, 0x30, 0x9f, 0x58, 0x0f, 0x65, 0x59, 0x3a, 0x43, 0xa6, 0x6b,
-	0xed, 0x4c, 0x9e, 0x80, 0x68, 0x77, 0x34, 0xda, 0x3a, 0xbd, 0x35, 0x06, 0x2d, 0x71, 0x38, 0xfa,
-	0x07, 0x81, 0xe7, 0x53, 0xbe, 0x48, 0xdf, 0x9c, 0x54, 0x70, 0xd0, 0x77, 0xad, 0xb7, 0xa6, 0xcc,
-	0x42, 0xd6, 0x1d, 0xcd, 0xba, 0x4d, 0xb7, 0x26, 0x60, 0x35, 0x78, 0x3f, 0x13, 0x58, 0xe8, 0x99,
-	0x25, 0xcd, 0x9c, 0xce, 0xb0, 0x73, 0x5b, 0xe5, 0x29, 0x32, 0x10, 0xf2, 0x0d, 0x0d, 0xb9, 0x41,
-	0x5f, 0x1f, 0x03, 0xd9, 0xe6, 0xbe, 0x79, 0xf5, 0xd1, 0xef, 0x08, 0xcc, 0x35, 0xfc, 0x90, 0x6e,
-	0x67, 0x09, 0xf5, 0x3d, 0xd4, 0xba, 0x33, 0x51, 0x2c, 0xe2, 0x6c, 0x68, 0x9c, 0x35, 0x5a, 0x1c,
-	0x83, 0x13, 0xfb, 0x21, 0xfd, 0x85, 0x00, 0xf4, 0xcd, 0x91, 0x66, 0x36, 0x3e, 0x62, 0xb1, 0xd6,
-	0xee, 0x34, 0x29, 0x48, 0x57, 0xd2, 0x74, 0x9b, 0x74, 0x7d, 0x0c, 0x9d, 0x7a, 0xe3, 0x18, 0x27,
-	0xa3, 0xbf, 0x12, 0x58, 0x1c, 0x70, 0x4b, 0x9a, 0x29, 0x39, 0xea, 0xc4, 0xd6, 0xde, 0x54, 0x39,
-	0xc8, 0xc9, 0x34, 0xe7, 0x6d, 0xba, 0x39, 0x86, 0x53, 0x5b, 0x34, 0xfb, 0x5a, 0x3d, 0xc7, 0xdf,
-	0x54, 0x6a, 0x8f, 0xce, 0x8b, 0xe4, 0xf1, 0x79, 0x91, 0xfc, 0x73, 0x5e, 0x24, 0xdf, 0x5f, 0x14,
-	0x67, 0x1e, 0x5f, 0x14, 0x67, 0xfe, 0xbe, 0x28, 0xce, 0x7c, 0xf1, 0x76, 0xd6, 0x57, 0xd0, 0xe9,
-	0x70, 0x6d, 0xfd, 0x59, 0xd4, 0xcc, 0xeb, 0x2f, 0xde, 0xbd, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x93, 0xd5, 0xc3, 0x8e, 0xd8, 0x0b, 0x00, 0x00,
+	// 1168 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0x33, 0x49, 0xba, 0xdd, 0xbc, 0xb4, 0x02, 0xa6, 0x49, 0xba, 0xb1, 0x60, 0x93, 0x3a,
+	0xe4, 0x47, 0x53, 0x62, 0x6f, 0x92, 0x52, 0x55, 0x02, 0x09, 0xd8, 0xa0, 0x12, 0x90, 0x56, 0x0a,
+	0x56, 0xe0, 0x80, 0x22, 0x45, 0xde, 0xcd, 0x74, 0x6d, 0x35, 0xf6, 0xb8, 0xb6, 0x13, 0x12, 0x55,
+	0xbd, 0x70, 0xe0, 0x8c, 0xe0, 0xc6, 0x01, 0x09, 0x10, 0x42, 0xe2, 0xd7, 0xa9, 0x7f, 0x44, 0x8f,
+	0x15, 0x5c, 0x50, 0x0f, 0x11, 0x4a, 0xf8, 0x13, 0xf8, 0x03, 0xd0, 0xcc, 0x3c, 0xef, 0xcf, 0xc4,
+	0xde, 0x55, 0xf7, 0xb2, 0xda, 0x99, 0x79, 0xdf, 0x79, 0x9f, 0x79, 0x7e, 0xe3, 0xf7, 0x0c, 0xf3,
+	0x55, 0xbb, 0x7a, 0xbc, 0xcf, 0x7d, 0xb3, 0x1a, 0xd7, 0xf6, 0xdd, 0xba, 0x23, 0x7e, 0x99, 0x1f,
+	0x9b, 0x87, 0xab, 0xe6, 0xc3, 0x03, 0x16, 0x1e, 0x1b, 0x41, 0xc8, 0x63, 0x4e, 0xa7, 0xd1, 0xcc,
+	0x68, 0x37, 0x33, 0x0e, 0x57, 0xb5, 0x89, 0x3a, 0xaf, 0x73, 0x69, 0x65, 0x8a, 0x7f, 0x4a, 0xa0,
+	0x4d, 0xd7, 0x78, 0xe4, 0xf1, 0x68, 0x57, 0x2d, 0xa8, 0x01, 0x2e, 0xbd, 0x5a, 0xe7, 0xbc, 0xbe,
+	0xcf, 0x4c, 0x3b, 0x70, 0x4d, 0xdb, 0xf7, 0x79, 0x6c, 0xc7, 0x2e, 0xf7, 0x93, 0xd5, 0x65, 0x65,
+	0x6b, 0x56, 0xed, 0x88, 0x29, 0x04, 0xf3, 0x70, 0xb5, 0xca, 0x62, 0x7b, 0xd5, 0x0c, 0xec, 0xba,
+	0xeb, 0x4b, 0x63, 0xb4, 0x5d, 0xb8, 0x18, 0x3e, 0xb0, 0x43, 0xdb, 0x4b, 0xf6, 0x34, 0x2e, 0xb6,
+	0xeb, 0x38, 0x8f, 0xb4, 0xd7, 0x27, 0x80, 0x7e, 0x2c, 0x3c, 0x6f, 0xc9, 0x4d, 0x2c, 0xf6, 0xf0,
+	0x80, 0x45, 0xb1, 0xfe, 0x29, 0x5c, 0x6b, 0x9b, 0x8d, 0x02, 0xee, 0x47, 0x8c, 0xbe, 0x03, 0x39,
+	0xe5, 0xac, 0x40, 0x66, 0xc9, 0xd2, 0xf8, 0xda, 0x0d, 0xe3, 0xc2, 0x58, 0x19, 0x4a, 0x5a, 0x1e,
+	0x7d, 0x7a, 0x32, 0x33, 0x64, 0xa1, 0x4c, 0xdf, 0x41, 0x6f, 0x9b, 0x76, 0xe4, 0xb0, 0xc4, 0x1b,
+	0xbd, 0x07, 0xd0, 0x3c, 0x2f, 0x6e, 0xbd, 0x60, 0x60, 0x20, 0x45, 0x70, 0x0c, 0xf5, 0x7c, 0x30,
+	0x38, 0xc6, 0x96, 0x5d, 0x67, 0xa8, 0xb5, 0x5a, 0x94, 0xfa, 0x13, 0x02, 0xd7, 0xda, 0xb6, 0x47,
+	0xec, 0x6d, 0xc8, 0x39, 0x72, 0xa6, 0x40, 0x66, 0x47, 0x96, 0xae, 0x94, 0xdf, 0x7e, 0x7e, 0x32,
+	0x73, 0xb7, 0xee, 0xc6, 0xce, 0x41, 0xd5, 0xa8, 0x71, 0xcf, 0xc4, 0x43, 0xd4, 0x1c, 0xdb, 0xf5,
+	0x93, 0x81, 0x19, 0x1f, 0x07, 0x2c, 0x32, 0xca, 0xdb, 0x1b, 0x9b, 0xcc, 0xde, 0x63, 0xa1, 0xd8,
+	0xb2, 0x7c, 0x1c, 0xb3, 0xc8, 0xc2, 0xbd, 0xe8, 0x07, 0x6d, 0xd4, 0xc3, 0x92, 0x7a, 0x31, 0x93,
+	0x5a, 0x21, 0xb5, 0x61, 0xff, 0x42, 0x60, 0x42, 0x62, 0x6f, 0x70, 0x3f, 0xb6, 0x5d, 0xbf, 0x11,
+	0x97, 0x2d, 0x18, 0x15, 0xbe, 0x64, 0x44, 0x5e, 0x94, 0x5a, 0xee, 0x44, 0xdf, 0x85, 0x31, 0xcf,
+	0x0b, 0x45, 0xa6, 0xf2, 0xfb, 0x88, 0x3c, 0x97, 0xf2, 0x0c, 0x2b, 0x15, 0x6b, 0x4b, 0x98, 0x5a,
+	0x79, 0xcf, 0x0b, 0xe5, 0x3f, 0x7d, 0x1d, 0x26, 0x3b, 0x58, 0x31, 0xc8, 0x1a, 0xe4, 0x6b, 0x38,
+	0x27, 0x81, 0xf3, 0x56, 0x63, 0xac, 0x9b, 0x30, 0xdd, 0x26, 0x52, 0x48, 0x78, 0x4a, 0xda, 0x7a,
+	0x4a, 0xc5, 0xa9, 0xdf, 0x05, 0xed, 0x3c, 0x41, 0x0f, 0xae, 0x76, 0x91, 0xaf, 0x62, 0xbb, 0xfe,
+	0x86, 0x08, 0xcd, 0xa0, 0x93, 0xec, 0x77, 0x02, 0x53, 0x9d, 0x1e, 0x90, 0xeb, 0x23, 0xb8, 0xec,
+	0xc8, 0xb0, 0xab, 0x44, 0x1b, 0x5f, 0x2b, 0xa5, 0xc4, 0xb6, 0xf1, 0x8c, 0x3e, 0xf4, 0xef, 0xf3,
+	0x46, 0x5e, 0x24, 0x1b, 0x0c, 0x2e, 0xbb, 0x5e, 0x81, 0x97, 0x24, 0xee, 0xb6, 0x1b, 0x24, 0xb7,
+	0x7b, 0x07, 0x5e, 0x6e, 0x4e, 0x21, 0xfb, 0x26, 0xe4, 0x94, 0x6b, 0x0c, 0x4d, 0xff, 0xe8, 0xa8,
+	0xd7, 0x0b, 0x18, 0x9f, 0xb2, 0x1d, 0x31, 0x65, 0x96, 0xf8, 0xad, 0xc1, 0xf5, 0xae, 0x95, 0x81,
+	0xbb, 0xe7, 0xe8, 0x44, 0x99, 0xbc, 0xcf, 0x82, 0xd8, 0x39, 0x2f, 0xd3, 0xc6, 0x06, 0x76, 0x23,
+	0x4a, 0x50, 0xe8, 0x76, 0x88, 0xc7, 0x9a, 0x80, 0x4b, 0x7b, 0x62, 0x42, 0xba, 0x1c, 0xb5, 0xd4,
+	0xa0, 0x11, 0x21, 0xa5, 0x88, 0x2a, 0x15, 0x2b, 0x89, 0x90, 0x0d, 0xd7, 0xbb, 0x56, 0x70, 0xab,
+	0x7b, 0x30, 0x8e, 0xb9, 0xb1, 0xeb, 0x79, 0x49, 0x98, 0xe6, 0x53, 0x50, 0x5b, 0xf6, 0x00, 0x54,
+	0x56, 0xbc, 0x50, 0xaf, 0x22, 0xae, 0xc5, 0x78, 0x58, 0xdf, 0x74, 0xa3, 0x98, 0x87, 0xc7, 0xe8,
+	0x7e, 0x60, 0x77, 0xe4, 0x7b, 0x02, 0xd3, 0xe7, 0x38, 0xc1, 0x93, 0xbc, 0x05, 0xb9, 0x50, 0xcc,
+	0x27, 0xb7, 0x64, 0x2e, 0xfd, 0x59, 0xcb, 0x3d, 0x2c, 0x94, 0x0c, 0xee, 0x5e, 0x94, 0xf0, 0xa5,
+	0x6b, 0xb1, 0x80, 0x87, 0x71, 0x23, 0x49, 0x69, 0x01, 0x2e, 0xdb, 0x7b, 0x7b, 0x21, 0x8b, 0x22,
+	0xcc, 0x93, 0x64, 0xa8, 0xef, 0xc0, 0x64, 0x87, 0x02, 0x0f, 0xb4, 0x01, 0xf9, 0x10, 0xe7, 0x30,
+	0x68, 0x8b, 0x29, 0x47, 0x4a, 0xe4, 0x32, 0x7b, 0x1b, 0x42, 0xfd, 0x37, 0x02, 0x93, 0xe7, 0x66,
+	0x36, 0x7d, 0x0d, 0xf0, 0xf9, 0xed, 0x3a, 0xec, 0x08, 0xa1, 0xc6, 0xd4, 0xcc, 0x26, 0x3b, 0xa2,
+	0xd3, 0x90, 0x17, 0x99, 0x2c, 0x17, 0x87, 0x15, 0xb1, 0x18, 0x8b, 0xa5, 0x29, 0x71, 0xab, 0x84,
+	0xf7, 0xc2, 0x88, 0xcc, 0x3f, 0x1c, 0xd1, 0xf7, 0x60, 0xf4, 0x73, 0x1e, 0x3e, 0x28, 0x8c, 0x0a,
+	0xf3, 0xf2, 0x8a, 0x28, 0xd1, 0xcf, 0x4f, 0x66, 0xa6, 0x54, 0x14, 0xa3, 0xbd, 0x07, 0x86, 0xcb,
+	0x4d, 0xcf, 0x8e, 0x1d, 0xe3, 0x13, 0xd7, 0x8f, 0xff, 0x7c, 0xb2, 0x32, 0x8e, 0xf1, 0x15, 0x43,
+	0x4b, 0x4a, 0xd7, 0xfe, 0xbb, 0x0a, 0x97, 0x64, 0x34, 0xe8, 0xd7, 0x04, 0x72, 0xaa, 0xd8, 0xd3,
+	0x95, 0x94, 0x63, 0x77, 0x77, 0x19, 0x9a, 0xd1, 0xab, 0xb9, 0x0a, 0x84, 0x7e, 0xf3, 0x8b, 0xbf,
+	0xfe, 0xfd, 0x66, 0x78, 0x8e, 0xde, 0x30, 0xb3, 0x9a, 0x21, 0x09, 0xa5, 0xba, 0x80, 0x6c, 0xa8,
+	0xb6, 0x66, 0x44, 0x33, 0x7a, 0x35, 0xef, 0x03, 0x0a, 0x3b, 0x86, 0x6f, 0x09, 0xe4, 0x93, 0x8a,
+	0x46, 0xcd, 0x2c, 0x3f, 0x1d, 0xdd, 0x80, 0x56, 0xea, 0x5d, 0x80, 0x68, 0xb7, 0x24, 0xda, 0x3c,
+	0x9d, 0x4b, 0x41, 0x4b, 0x0a, 0x27, 0xfd, 0x83, 0xc0, 0xd5, 0xb6, 0x72, 0x4b, 0x6f, 0xf7, 0xea,
+	0xb0, 0xb5, 0x9c, 0x6b, 0x6f, 0xf6, 0xa9, 0x42, 0xd6, 0x92, 0x64, 0x5d, 0xa6, 0x4b, 0x3d, 0xb0,
+	0x2a, 0xbc, 0xef, 0x08, 0x8c, 0x35, 0x6a, 0x30, 0xcd, 0x8c, 0x4e, 0x67, 0x43, 0xa0, 0xad, 0xf6,
+	0xa1, 0x40, 0xc8, 0x37, 0x24, 0xe4, 0x02, 0x7d, 0x3d, 0x05, 0xd2, 0xb3, 0x5d, 0xd5, 0x93, 0xd1,
+	0x2f, 0x09, 0x8c, 0x6c, 0xbb, 0x01, 0x5d, 0xce, 0x72, 0xd4, 0x2c, 0xcd, 0xda, 0xad, 0x9e, 0x6c,
+	0x11, 0x67, 0x41, 0xe2, 0xcc, 0xd2, 0x62, 0x0a, 0x4e, 0xec, 0x06, 0xf4, 0x07, 0x02, 0xd0, 0xac,
+	0xb9, 0x34, 0xf3, 0xe0, 0x5d, 0x95, 0x5b, 0x5b, 0xeb, 0x47, 0x82, 0x74, 0x2b, 0x92, 0x6e, 0x91,
+	0xce, 0xa7, 0xd0, 0x89, 0x17, 0xb6, 0x7a, 0x93, 0xd1, 0x9f, 0x09, 0x8c, 0xb7, 0x94, 0x50, 0x9a,
+	0xe9, 0xb2, 0xbb, 0xc0, 0x6b, 0xeb, 0x7d, 0x69, 0x90, 0xd3, 0x94, 0x9c, 0x37, 0xe9, 0x62, 0x0a,
+	0xa7, 0xac, 0xdb, 0xe6, 0x23, 0x71, 0x8f, 0x1f, 0xd3, 0x1f, 0x09, 0x40, 0xb3, 0xb8, 0x66, 0x87,
+	0xb3, 0xab, 0xcc, 0x6b, 0x6b, 0xfd, 0x48, 0x10, 0xd3, 0x90, 0x98, 0x4b, 0x74, 0x21, 0xed, 0x3d,
+	0xd3, 0x6c, 0x10, 0xe8, 0xaf, 0x04, 0xae, 0xb4, 0x96, 0x5f, 0x9a, 0x19, 0x9c, 0x73, 0x3a, 0x02,
+	0xed, 0x76, 0x7f, 0xa2, 0x3e, 0x2e, 0xb3, 0xac, 0xe7, 0xbb, 0x0e, 0xc2, 0xfd, 0x44, 0x20, 0x9f,
+	0x14, 0xc6, 0xec, 0x57, 0x63, 0x47, 0xcd, 0xd6, 0x4a, 0xbd, 0x0b, 0x90, 0xf0, 0x8e, 0x24, 0x2c,
+	0x51, 0x23, 0x95, 0x50, 0x89, 0x22, 0xf3, 0x11, 0xb6, 0x00, 0x8f, 0xcb, 0x5b, 0x4f, 0x4f, 0x8b,
+	0xe4, 0xd9, 0x69, 0x91, 0xfc, 0x73, 0x5a, 0x24, 0x5f, 0x9d, 0x15, 0x87, 0x9e, 0x9d, 0x15, 0x87,
+	0xfe, 0x3e, 0x2b, 0x0e, 0x7d, 0x76, 0x27, 0xeb, 0xd3, 0xec, 0xa8, 0xd3, 0x85, 0xfc, 0x56, 0xab,
+	0xe6, 0xe4, 0x77, 0xf8, 0xfa, 0xff, 0x03, 0x00, 0x4c, 0xbe, 0xd2, 0xe8, 0x9e, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -930,6 +1247,14 @@ type QueryClient interface {
 	// HeaderDepth returns the depth of the header in main chain or error if the
 	// block is not found or it exists on fork
 	HeaderDepth(ctx context.Context, in *QueryHeaderDepthRequest, opts ...grpc.CallOption) (*QueryHeaderDepthResponse, error)
+	// HeadersMMR returns the MMR accumulator of the BTC headers pruned from the
+	// storage
+	HeadersMMR(ctx context.Context, in *QueryHeadersMMRRequest, opts ...grpc.CallOption) (*QueryHeadersMMRResponse, error)
+	// ReorgHistory returns the reorgs of the BTC chain observed by the BTC light
+	// client, from the oldest to the newest
+	ReorgHistory(ctx context.Context, in *QueryReorgHistoryRequest, opts ...grpc.CallOption) (*QueryReorgHistoryResponse, error)
+	// Reporter returns the information of a bonded reporter of BTC headers
+	Reporter(ctx context.Context, in *QueryReporterRequest, opts ...grpc.CallOption) (*QueryReporterResponse, error)
 }
 
 type queryClient struct {
@@ -1012,6 +1337,33 @@ func (c *queryClient) HeaderDepth(ctx context.Context, in *QueryHeaderDepthReque
 	return out, nil
 }
 
+func (c *queryClient) HeadersMMR(ctx context.Context, in *QueryHeadersMMRRequest, opts ...grpc.CallOption) (*QueryHeadersMMRResponse, error) {
+	out := new(QueryHeadersMMRResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/HeadersMMR", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReorgHistory(ctx context.Context, in *QueryReorgHistoryRequest, opts ...grpc.CallOption) (*QueryReorgHistoryResponse, error) {
+	out := new(QueryReorgHistoryResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/ReorgHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Reporter(ctx context.Context, in *QueryReporterRequest, opts ...grpc.CallOption) (*QueryReporterResponse, error) {
+	out := new(QueryReporterResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/Reporter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the module.
@@ -1035,6 +1387,14 @@ type QueryServer interface {
 	// HeaderDepth returns the depth of the header in main chain or error if the
 	// block is not found or it exists on fork
 	HeaderDepth(context.Context, *QueryHeaderDepthRequest) (*QueryHeaderDepthResponse, error)
+	// HeadersMMR returns the MMR accumulator of the BTC headers pruned from the
+	// storage
+	HeadersMMR(context.Context, *QueryHeadersMMRRequest) (*QueryHeadersMMRResponse, error)
+	// ReorgHistory returns the reorgs of the BTC chain observed by the BTC light
+	// client, from the oldest to the newest
+	ReorgHistory(context.Context, *QueryReorgHistoryRequest) (*QueryReorgHistoryResponse, error)
+	// Reporter returns the information of a bonded reporter of BTC headers
+	Reporter(context.Context, *QueryReporterRequest) (*QueryReporterResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1065,6 +1425,15 @@ func (*UnimplementedQueryServer) BaseHeader(ctx context.Context, req *QueryBaseH
 func (*UnimplementedQueryServer) HeaderDepth(ctx context.Context, req *QueryHeaderDepthRequest) (*QueryHeaderDepthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HeaderDepth not implemented")
 }
+func (*UnimplementedQueryServer) HeadersMMR(ctx context.Context, req *QueryHeadersMMRRequest) (*QueryHeadersMMRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadersMMR not implemented")
+}
+func (*UnimplementedQueryServer) ReorgHistory(ctx context.Context, req *QueryReorgHistoryRequest) (*QueryReorgHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorgHistory not implemented")
+}
+func (*UnimplementedQueryServer) Reporter(ctx context.Context, req *QueryReporterRequest) (*QueryReporterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reporter not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1214,6 +1583,60 @@ func _Query_HeaderDepth_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HeadersMMR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadersMMRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeadersMMR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/HeadersMMR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadersMMR(ctx, req.(*QueryHeadersMMRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReorgHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReorgHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReorgHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/ReorgHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReorgHistory(ctx, req.(*QueryReorgHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Reporter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReporterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Reporter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/Reporter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Reporter(ctx, req.(*QueryReporterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.btclightclient.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1250,6 +1673,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HeaderDepth",
 			Handler:    _Query_HeaderDepth_Handler,
 		},
+		{
+			MethodName: "HeadersMMR",
+			Handler:    _Query_HeadersMMR_Handler,
+		},
+		{
+			MethodName: "ReorgHistory",
+			Handler:    _Query_ReorgHistory_Handler,
+		},
+		{
+			MethodName: "Reporter",
+			Handler:    _Query_Reporter_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/btclightclient/v1/query.proto",
@@ -1415,6 +1850,18 @@ func (m *QueryContainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MmrProof != nil {
+		{
+			size, err := m.MmrProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Hash != nil {
 		{
 			size := m.Hash.Size()
@@ -1746,10 +2193,22 @@ func (m *QueryHeaderDepthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+	if m.MmrProof != nil {
+		{
+			size, err := m.MmrProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1784,6 +2243,213 @@ func (m *QueryHeaderDepthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryHeadersMMRRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadersMMRRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadersMMRRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeadersMMRResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadersMMRResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadersMMRResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HeadersMmr != nil {
+		{
+			size, err := m.HeadersMmr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReorgHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReorgHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReorgHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReorgHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReorgHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReorgHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reorgs) > 0 {
+		for iNdEx := len(m.Reorgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reorgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReporterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReporterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReporterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReporterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReporterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReporterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reporter != nil {
+		{
+			size, err := m.Reporter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BTCHeaderInfoResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1909,6 +2575,10 @@ func (m *QueryContainsRequest) Size() (n int) {
 		l = m.Hash.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.MmrProof != nil {
+		l = m.MmrProof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2035,6 +2705,10 @@ func (m *QueryHeaderDepthRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.MmrProof != nil {
+		l = m.MmrProof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2050,35 +2724,576 @@ func (m *QueryHeaderDepthResponse) Size() (n int) {
 	return n
 }
 
-func (m *BTCHeaderInfoResponse) Size() (n int) {
+func (m *QueryHeadersMMRRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.HeaderHex)
-	if l > 0 {
+	return n
+}
+
+func (m *QueryHeadersMMRResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HeadersMmr != nil {
+		l = m.HeadersMmr.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.HashHex)
+	return n
+}
+
+func (m *QueryReorgHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReorgHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reorgs) > 0 {
+		for _, e := range m.Reorgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReporterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	return n
+}
+
+func (m *QueryReporterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reporter != nil {
+		l = m.Reporter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCHeaderInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HeaderHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.HashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.Work.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHashesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHashesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BTCHeaderHashBytes
+			m.Hashes = append(m.Hashes, v)
+			if err := m.Hashes[len(m.Hashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContainsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContainsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BTCHeaderHashBytes
+			m.Hash = &v
+			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MmrProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MmrProof == nil {
+				m.MmrProof = &MMRProof{}
+			}
+			if err := m.MmrProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Work.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContainsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2101,12 +3316,32 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContainsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contains", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Contains = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2128,7 +3363,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContainsBytesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2151,17 +3386,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContainsBytesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContainsBytesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2171,23 +3406,24 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2211,7 +3447,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHashesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContainsBytesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2234,17 +3470,17 @@ func (m *QueryHashesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHashesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContainsBytesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContainsBytesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contains", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2254,28 +3490,12 @@ func (m *QueryHashesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Contains = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2297,7 +3517,7 @@ func (m *QueryHashesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHashesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMainChainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2320,48 +3540,13 @@ func (m *QueryHashesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHashesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMainChainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMainChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonchain_babylon_types.BTCHeaderHashBytes
-			m.Hashes = append(m.Hashes, v)
-			if err := m.Hashes[len(m.Hashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2391,7 +3576,7 @@ func (m *QueryHashesResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2418,7 +3603,7 @@ func (m *QueryHashesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContainsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMainChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2441,17 +3626,17 @@ func (m *QueryContainsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContainsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMainChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMainChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2461,24 +3646,59 @@ func (m *QueryContainsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BTCHeaderHashBytes
-			m.Hash = &v
-			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Headers = append(m.Headers, &BTCHeaderInfoResponse{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2503,7 +3723,7 @@ func (m *QueryContainsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContainsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2526,32 +3746,12 @@ func (m *QueryContainsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContainsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contains", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Contains = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2573,7 +3773,7 @@ func (m *QueryContainsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContainsBytesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2596,17 +3796,17 @@ func (m *QueryContainsBytesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContainsBytesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContainsBytesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2616,24 +3816,26 @@ func (m *QueryContainsBytesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			if m.Header == nil {
+				m.Header = &BTCHeaderInfoResponse{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2657,7 +3859,7 @@ func (m *QueryContainsBytesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContainsBytesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBaseHeaderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2680,32 +3882,12 @@ func (m *QueryContainsBytesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContainsBytesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBaseHeaderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContainsBytesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBaseHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contains", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Contains = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2727,7 +3909,7 @@ func (m *QueryContainsBytesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMainChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBaseHeaderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2750,15 +3932,15 @@ func (m *QueryMainChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMainChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBaseHeaderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMainChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBaseHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2785,10 +3967,10 @@ func (m *QueryMainChainRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Header == nil {
+				m.Header = &BTCHeaderInfoResponse{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2813,7 +3995,7 @@ func (m *QueryMainChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMainChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeaderDepthRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2836,17 +4018,17 @@ func (m *QueryMainChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMainChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeaderDepthRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMainChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeaderDepthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2856,29 +4038,27 @@ func (m *QueryMainChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headers = append(m.Headers, &BTCHeaderInfoResponse{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MmrProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2905,10 +4085,10 @@ func (m *QueryMainChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.MmrProof == nil {
+				m.MmrProof = &MMRProof{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MmrProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2933,7 +4113,7 @@ func (m *QueryMainChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTipRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHeaderDepthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2956,12 +4136,31 @@ func (m *QueryTipRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTipRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeaderDepthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeaderDepthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2983,7 +4182,7 @@ func (m *QueryTipRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTipResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadersMMRRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3006,15 +4205,65 @@ func (m *QueryTipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadersMMRRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadersMMRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadersMMRResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadersMMRResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadersMMRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadersMmr", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3041,10 +4290,10 @@ func (m *QueryTipResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &BTCHeaderInfoResponse{}
+			if m.HeadersMmr == nil {
+				m.HeadersMmr = &HeadersMMR{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HeadersMmr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3069,7 +4318,7 @@ func (m *QueryTipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseHeaderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReorgHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3092,12 +4341,48 @@ func (m *QueryBaseHeaderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseHeaderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReorgHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReorgHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3119,7 +4404,7 @@ func (m *QueryBaseHeaderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseHeaderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReorgHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3142,15 +4427,15 @@ func (m *QueryBaseHeaderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseHeaderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReorgHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReorgHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reorgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3177,10 +4462,44 @@ func (m *QueryBaseHeaderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &BTCHeaderInfoResponse{}
+			m.Reorgs = append(m.Reorgs, &BTCReorg{})
+			if err := m.Reorgs[len(m.Reorgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3205,7 +4524,7 @@ func (m *QueryBaseHeaderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeaderDepthRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReporterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3228,15 +4547,15 @@ func (m *QueryHeaderDepthRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeaderDepthRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReporterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeaderDepthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReporterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3264,7 +4583,7 @@ func (m *QueryHeaderDepthRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3287,7 +4606,7 @@ func (m *QueryHeaderDepthRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeaderDepthResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReporterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3310,17 +4629,17 @@ func (m *QueryHeaderDepthResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeaderDepthResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReporterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeaderDepthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReporterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
-			m.Depth = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3330,11 +4649,28 @@ func (m *QueryHeaderDepthResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Depth |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reporter == nil {
+				m.Reporter = &ReporterInfo{}
+			}
+			if err := m.Reporter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	bbn "github.com/babylonchain/babylon/types"
+)
+
+// NewBTCReorg creates a reorg record from the fork point, the headers of the
+// old chain after the fork point in any order of heights, and the new tip
+func NewBTCReorg(babylonHeight uint64, forkPoint *BTCHeaderInfo, orphanedHeaders []*BTCHeaderInfo, newTip *BTCHeaderInfo) *BTCReorg {
+	// the orphaned header at height forkPoint.Height+1+i is at index i
+	orphanedHashes := make([]bbn.BTCHeaderHashBytes, len(orphanedHeaders))
+	for _, header := range orphanedHeaders {
+		orphanedHashes[header.Height-forkPoint.Height-1] = *header.Hash
+	}
+
+	return &BTCReorg{
+		BabylonHeight:        babylonHeight,
+		ForkPoint:            forkPoint,
+		OrphanedHeaderHashes: orphanedHashes,
+		NewTip:               newTip,
+	}
+}
+
+// Validate performs basic validation of the reorg record
+func (r *BTCReorg) Validate() error {
+	if r.ForkPoint == nil || r.NewTip == nil {
+		return errors.New("fork point or new tip is nil")
+	}
+	if err := r.ForkPoint.Validate(); err != nil {
+		return fmt.Errorf("invalid fork point: %w", err)
+	}
+	if err := r.NewTip.Validate(); err != nil {
+		return fmt.Errorf("invalid new tip: %w", err)
+	}
+	if len(r.OrphanedHeaderHashes) == 0 {
+		return errors.New("a reorg must orphan at least one header")
+	}
+	if r.NewTip.Height <= r.ForkPoint.Height {
+		return fmt.Errorf("new tip height %d is not above fork point height %d", r.NewTip.Height, r.ForkPoint.Height)
+	}
+	for _, hash := range r.OrphanedHeaderHashes {
+		if hash.Size() != bbn.BTCHeaderHashLen {
+			return fmt.Errorf("invalid orphaned header hash length %d", hash.Size())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs basic validation of the bonded reporter
+func (r *ReporterInfo) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return fmt.Errorf("invalid reporter address %s: %w", r.Address, err)
+	}
+	if err := r.Bond.Validate(); err != nil {
+		return fmt.Errorf("invalid bond of reporter %s: %w", r.Address, err)
+	}
+	return nil
+}
+
+// Validate performs basic validation of the reported header
+func (h *ReportedHeader) Validate() error {
+	if h.Hash == nil {
+		return errors.New("reported header hash is nil")
+	}
+	if _, err := sdk.AccAddressFromBech32(h.Reporter); err != nil {
+		return fmt.Errorf("invalid reporter address %s: %w", h.Reporter, err)
+	}
+	return nil
+}
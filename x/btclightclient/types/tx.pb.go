@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -211,11 +212,192 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgBondReporter defines a message for locking a bond, such that the signer
+// can insert headers as a bonded reporter. The bond is added to the existing
+// bond of the signer, if any.
+type MsgBondReporter struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// amount is the amount to be added to the bond
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgBondReporter) Reset()         { *m = MsgBondReporter{} }
+func (m *MsgBondReporter) String() string { return proto.CompactTextString(m) }
+func (*MsgBondReporter) ProtoMessage()    {}
+func (*MsgBondReporter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f638eee60234021, []int{4}
+}
+func (m *MsgBondReporter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBondReporter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBondReporter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBondReporter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBondReporter.Merge(m, src)
+}
+func (m *MsgBondReporter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBondReporter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBondReporter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBondReporter proto.InternalMessageInfo
+
+func (m *MsgBondReporter) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgBondReporter) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgBondReporterResponse is the response to the MsgBondReporter message.
+type MsgBondReporterResponse struct {
+}
+
+func (m *MsgBondReporterResponse) Reset()         { *m = MsgBondReporterResponse{} }
+func (m *MsgBondReporterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBondReporterResponse) ProtoMessage()    {}
+func (*MsgBondReporterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f638eee60234021, []int{5}
+}
+func (m *MsgBondReporterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBondReporterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBondReporterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBondReporterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBondReporterResponse.Merge(m, src)
+}
+func (m *MsgBondReporterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBondReporterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBondReporterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBondReporterResponse proto.InternalMessageInfo
+
+// MsgUnbondReporter defines a message for unlocking the whole bond of a
+// bonded reporter. It fails if any header inserted by the reporter is not
+// settled yet.
+type MsgUnbondReporter struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgUnbondReporter) Reset()         { *m = MsgUnbondReporter{} }
+func (m *MsgUnbondReporter) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondReporter) ProtoMessage()    {}
+func (*MsgUnbondReporter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f638eee60234021, []int{6}
+}
+func (m *MsgUnbondReporter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondReporter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondReporter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnbondReporter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondReporter.Merge(m, src)
+}
+func (m *MsgUnbondReporter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondReporter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondReporter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbondReporter proto.InternalMessageInfo
+
+func (m *MsgUnbondReporter) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// MsgUnbondReporterResponse is the response to the MsgUnbondReporter message.
+type MsgUnbondReporterResponse struct {
+}
+
+func (m *MsgUnbondReporterResponse) Reset()         { *m = MsgUnbondReporterResponse{} }
+func (m *MsgUnbondReporterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondReporterResponse) ProtoMessage()    {}
+func (*MsgUnbondReporterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f638eee60234021, []int{7}
+}
+func (m *MsgUnbondReporterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondReporterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondReporterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnbondReporterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondReporterResponse.Merge(m, src)
+}
+func (m *MsgUnbondReporterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondReporterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondReporterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbondReporterResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgInsertHeaders)(nil), "babylon.btclightclient.v1.MsgInsertHeaders")
 	proto.RegisterType((*MsgInsertHeadersResponse)(nil), "babylon.btclightclient.v1.MsgInsertHeadersResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.btclightclient.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.btclightclient.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgBondReporter)(nil), "babylon.btclightclient.v1.MsgBondReporter")
+	proto.RegisterType((*MsgBondReporterResponse)(nil), "babylon.btclightclient.v1.MsgBondReporterResponse")
+	proto.RegisterType((*MsgUnbondReporter)(nil), "babylon.btclightclient.v1.MsgUnbondReporter")
+	proto.RegisterType((*MsgUnbondReporterResponse)(nil), "babylon.btclightclient.v1.MsgUnbondReporterResponse")
 }
 
 func init() {
@@ -223,35 +405,42 @@ func init() {
 }
 
 var fileDescriptor_5f638eee60234021 = []byte{
-	// 443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
-	0xfa, 0x65, 0x86, 0xfa, 0x25, 0x15, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x92, 0x50, 0x35,
-	0x7a, 0xa8, 0x6a, 0xf4, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xaa, 0xf4, 0x41,
-	0x2c, 0x88, 0x06, 0x29, 0xf1, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0xfd, 0xdc, 0xe2, 0x74, 0x90,
-	0x41, 0xb9, 0xc5, 0xe9, 0x50, 0x09, 0x35, 0xdc, 0xb6, 0x15, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x43,
-	0xd5, 0x49, 0x42, 0x0c, 0x88, 0x87, 0x98, 0x0c, 0xe1, 0x40, 0xa4, 0x94, 0xba, 0x19, 0xb9, 0x04,
-	0x7c, 0x8b, 0xd3, 0x3d, 0xf3, 0x8a, 0x53, 0x8b, 0x4a, 0x3c, 0x52, 0x13, 0x53, 0x52, 0x8b, 0x8a,
-	0x85, 0xc4, 0xb8, 0xd8, 0x8a, 0x33, 0xd3, 0xf3, 0x52, 0x8b, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38,
-	0x83, 0xa0, 0x3c, 0xa1, 0x20, 0x2e, 0xf6, 0x0c, 0x88, 0x12, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x1e,
-	0x27, 0x8b, 0x5b, 0xf7, 0xe4, 0x4d, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73,
-	0xf5, 0xa1, 0xee, 0x49, 0xce, 0x48, 0xcc, 0xcc, 0x83, 0x71, 0xf4, 0x4b, 0x2a, 0x0b, 0x52, 0x8b,
-	0xf5, 0x9c, 0x42, 0x9c, 0x21, 0xc6, 0x3b, 0x55, 0x96, 0xa4, 0x16, 0x07, 0xc1, 0x0c, 0xb2, 0xe2,
-	0x6e, 0x7a, 0xbe, 0x41, 0x0b, 0x6a, 0x81, 0x92, 0x14, 0x97, 0x04, 0xba, 0x63, 0x82, 0x52, 0x8b,
-	0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x95, 0x66, 0x31, 0x72, 0xf1, 0xfb, 0x16, 0xa7, 0x87, 0x16, 0xa4,
-	0x24, 0x96, 0xa4, 0x06, 0x80, 0xbd, 0x27, 0x64, 0xc6, 0xc5, 0x99, 0x58, 0x5a, 0x92, 0x91, 0x5f,
-	0x94, 0x59, 0x52, 0x09, 0x71, 0xab, 0x93, 0xc4, 0xa5, 0x2d, 0xba, 0x22, 0x50, 0x2f, 0x3a, 0xa6,
-	0xa4, 0x14, 0xa5, 0x16, 0x17, 0x07, 0x97, 0x14, 0x65, 0xe6, 0xa5, 0x07, 0x21, 0x94, 0x0a, 0xd9,
-	0x73, 0xb1, 0x41, 0x02, 0x48, 0x82, 0x49, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x51, 0x0f, 0x67, 0x9c,
-	0xe8, 0x41, 0xac, 0x72, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0xcd, 0x8a, 0x0f, 0xe4,
-	0x6a, 0x84, 0x81, 0x4a, 0x92, 0x5c, 0xe2, 0x68, 0x6e, 0x83, 0xb9, 0xdb, 0xe8, 0x23, 0x23, 0x17,
-	0xb3, 0x6f, 0x71, 0xba, 0x50, 0x31, 0x17, 0x2f, 0x6a, 0x28, 0x6b, 0xe3, 0xb1, 0x14, 0x3d, 0x14,
-	0xa4, 0x8c, 0x49, 0x50, 0x0c, 0x0f, 0x32, 0x06, 0xa1, 0x3c, 0x2e, 0x1e, 0x94, 0x00, 0xd3, 0xc2,
-	0x6f, 0x0c, 0xb2, 0x5a, 0x29, 0x23, 0xe2, 0xd5, 0xc2, 0x6c, 0x94, 0x62, 0x6d, 0x78, 0xbe, 0x41,
-	0x8b, 0xd1, 0x29, 0xe0, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63,
-	0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xcc, 0x08,
-	0xa5, 0x96, 0x0a, 0xf4, 0xc4, 0x0c, 0x4e, 0x3e, 0x49, 0x6c, 0xe0, 0xe4, 0x6a, 0x0c, 0x08, 0x00,
-	0x00, 0xff, 0xff, 0x64, 0x60, 0x2a, 0x32, 0x61, 0x03, 0x00, 0x00,
+	// 554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x86, 0x9b, 0x15, 0x8a, 0xe6, 0x8d, 0x01, 0xd1, 0xc4, 0xda, 0x20, 0x65, 0xa5, 0x07, 0x54,
+	0x15, 0x70, 0xd4, 0x6e, 0x1a, 0xd3, 0x2e, 0x88, 0xec, 0x02, 0x87, 0x4a, 0x53, 0x80, 0x0b, 0x17,
+	0xe4, 0xb4, 0x96, 0x13, 0x69, 0xb1, 0x23, 0xdb, 0xad, 0xd6, 0x1b, 0xe2, 0xca, 0x85, 0x33, 0xbf,
+	0x62, 0x07, 0x7e, 0xc4, 0x8e, 0x13, 0x17, 0x10, 0x87, 0x09, 0xb5, 0x87, 0xfd, 0x0d, 0x94, 0xd8,
+	0xe9, 0x9a, 0x4c, 0x5d, 0xbb, 0x4b, 0x55, 0xcb, 0xaf, 0xdf, 0xf7, 0xf1, 0xf7, 0x39, 0x1f, 0x68,
+	0xf8, 0xc8, 0x1f, 0x1d, 0x33, 0xea, 0xf8, 0xb2, 0x77, 0x1c, 0x92, 0x20, 0xf9, 0xc5, 0x54, 0x3a,
+	0xc3, 0xb6, 0x23, 0x4f, 0x60, 0xcc, 0x99, 0x64, 0x66, 0x4d, 0x6b, 0x60, 0x5e, 0x03, 0x87, 0x6d,
+	0x6b, 0x93, 0x30, 0xc2, 0x52, 0x95, 0x93, 0xfc, 0x53, 0x07, 0xac, 0xad, 0x1e, 0x13, 0x11, 0x13,
+	0x4e, 0x24, 0x48, 0x62, 0x14, 0x09, 0xa2, 0x37, 0x9e, 0xcd, 0x4f, 0x8b, 0x11, 0x47, 0x91, 0xd0,
+	0xba, 0x9a, 0x32, 0xf8, 0xac, 0x9c, 0xd5, 0x42, 0x6f, 0xd9, 0xda, 0xdb, 0x47, 0x02, 0x3b, 0xc3,
+	0xb6, 0x8f, 0x25, 0x6a, 0x3b, 0x3d, 0x16, 0x52, 0xb5, 0xdf, 0xf8, 0x66, 0x80, 0x87, 0x5d, 0x41,
+	0xde, 0x51, 0x81, 0xb9, 0x7c, 0x8b, 0x51, 0x1f, 0x73, 0x61, 0x3e, 0x06, 0x15, 0x11, 0x12, 0x8a,
+	0x79, 0xd5, 0xa8, 0x1b, 0xcd, 0x55, 0x4f, 0xaf, 0x4c, 0x0f, 0xdc, 0x0b, 0x94, 0xa4, 0xba, 0x52,
+	0x2f, 0x37, 0xd7, 0xdd, 0xfd, 0xbf, 0x17, 0xdb, 0xbb, 0x24, 0x94, 0xc1, 0xc0, 0x87, 0x3d, 0x16,
+	0x39, 0x9a, 0xb7, 0x17, 0xa0, 0x90, 0x66, 0x0b, 0x47, 0x8e, 0x62, 0x2c, 0xa0, 0xfb, 0xe1, 0x50,
+	0xd9, 0xbb, 0x23, 0x89, 0x85, 0x97, 0x19, 0x1d, 0xac, 0x7d, 0xbd, 0x3c, 0x6d, 0xe9, 0x80, 0x86,
+	0x05, 0xaa, 0x45, 0x18, 0x0f, 0x8b, 0x98, 0x51, 0x81, 0x1b, 0x3f, 0x0c, 0xf0, 0xa0, 0x2b, 0xc8,
+	0xc7, 0xb8, 0x8f, 0x24, 0x3e, 0x4a, 0xaf, 0x6f, 0xee, 0x81, 0x55, 0x34, 0x90, 0x01, 0xe3, 0xa1,
+	0x1c, 0x29, 0x56, 0xb7, 0xfa, 0xeb, 0xe7, 0xcb, 0x4d, 0x5d, 0x82, 0x37, 0xfd, 0x3e, 0xc7, 0x42,
+	0xbc, 0x97, 0x3c, 0xa4, 0xc4, 0xbb, 0x92, 0x9a, 0xaf, 0x41, 0x45, 0x15, 0xb0, 0xba, 0x52, 0x37,
+	0x9a, 0x6b, 0x9d, 0xa7, 0x70, 0x6e, 0xcf, 0xa0, 0x8a, 0x72, 0xef, 0x9c, 0x5d, 0x6c, 0x97, 0x3c,
+	0x7d, 0xec, 0x60, 0x23, 0xa1, 0xbe, 0x32, 0x6c, 0xd4, 0xc0, 0x56, 0x81, 0x6d, 0xca, 0xcd, 0x52,
+	0x6c, 0x97, 0xd1, 0xbe, 0x87, 0x63, 0xc6, 0x25, 0xe6, 0x73, 0xeb, 0xfb, 0x0a, 0x54, 0x50, 0xc4,
+	0x06, 0x54, 0x6a, 0xac, 0x1a, 0xd4, 0x17, 0x49, 0xba, 0x07, 0x75, 0xf7, 0xe0, 0x21, 0x0b, 0x69,
+	0x86, 0xa3, 0xe4, 0xf9, 0x22, 0x2a, 0x96, 0xd9, 0xc0, 0x29, 0xcb, 0x3e, 0x78, 0x94, 0x60, 0x52,
+	0x7f, 0x09, 0x9a, 0xbc, 0xe9, 0x13, 0x50, 0xbb, 0x76, 0x32, 0xb3, 0xed, 0xfc, 0x2e, 0x83, 0x72,
+	0x57, 0x10, 0x53, 0x80, 0xfb, 0xf9, 0x87, 0xf4, 0xfc, 0x86, 0xba, 0x16, 0x1b, 0x6d, 0xed, 0xdc,
+	0x42, 0x3c, 0xbd, 0x51, 0xc9, 0xa4, 0x60, 0x3d, 0xf7, 0x26, 0x5a, 0x37, 0xdb, 0xcc, 0x6a, 0xad,
+	0xce, 0xf2, 0xda, 0x2c, 0x31, 0xc9, 0xcb, 0x35, 0x73, 0x41, 0xde, 0xac, 0xd6, 0xea, 0x2c, 0xaf,
+	0x9d, 0xe6, 0x49, 0xb0, 0x51, 0x68, 0xd8, 0x8b, 0x05, 0xd4, 0x39, 0xb5, 0xb5, 0x7b, 0x1b, 0x75,
+	0x96, 0x6a, 0xdd, 0xfd, 0x72, 0x79, 0xda, 0x32, 0xdc, 0xa3, 0xb3, 0xb1, 0x6d, 0x9c, 0x8f, 0x6d,
+	0xe3, 0xdf, 0xd8, 0x36, 0xbe, 0x4f, 0xec, 0xd2, 0xf9, 0xc4, 0x2e, 0xfd, 0x99, 0xd8, 0xa5, 0x4f,
+	0x7b, 0x8b, 0x3e, 0xfb, 0x93, 0xe2, 0xd4, 0x4a, 0xe7, 0x80, 0x5f, 0x49, 0xe7, 0xce, 0xce, 0xff,
+	0x01, 0x00, 0xda, 0x97, 0x07, 0x45, 0x4a, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -270,6 +459,10 @@ type MsgClient interface {
 	InsertHeaders(ctx context.Context, in *MsgInsertHeaders, opts ...grpc.CallOption) (*MsgInsertHeadersResponse, error)
 	// UpdateParams defines a method for updating btc light client module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// BondReporter locks a bond for inserting headers as a bonded reporter
+	BondReporter(ctx context.Context, in *MsgBondReporter, opts ...grpc.CallOption) (*MsgBondReporterResponse, error)
+	// UnbondReporter unlocks the whole bond of a bonded reporter
+	UnbondReporter(ctx context.Context, in *MsgUnbondReporter, opts ...grpc.CallOption) (*MsgUnbondReporterResponse, error)
 }
 
 type msgClient struct {
@@ -298,12 +491,34 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) BondReporter(ctx context.Context, in *MsgBondReporter, opts ...grpc.CallOption) (*MsgBondReporterResponse, error) {
+	out := new(MsgBondReporterResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Msg/BondReporter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnbondReporter(ctx context.Context, in *MsgUnbondReporter, opts ...grpc.CallOption) (*MsgUnbondReporterResponse, error) {
+	out := new(MsgUnbondReporterResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Msg/UnbondReporter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// InsertHeaders adds a batch of headers to the BTC light client chain
 	InsertHeaders(context.Context, *MsgInsertHeaders) (*MsgInsertHeadersResponse, error)
 	// UpdateParams defines a method for updating btc light client module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// BondReporter locks a bond for inserting headers as a bonded reporter
+	BondReporter(context.Context, *MsgBondReporter) (*MsgBondReporterResponse, error)
+	// UnbondReporter unlocks the whole bond of a bonded reporter
+	UnbondReporter(context.Context, *MsgUnbondReporter) (*MsgUnbondReporterResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -316,6 +531,12 @@ func (*UnimplementedMsgServer) InsertHeaders(ctx context.Context, req *MsgInsert
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) BondReporter(ctx context.Context, req *MsgBondReporter) (*MsgBondReporterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BondReporter not implemented")
+}
+func (*UnimplementedMsgServer) UnbondReporter(ctx context.Context, req *MsgUnbondReporter) (*MsgUnbondReporterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondReporter not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -357,6 +578,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BondReporter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBondReporter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BondReporter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Msg/BondReporter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BondReporter(ctx, req.(*MsgBondReporter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnbondReporter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbondReporter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnbondReporter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Msg/UnbondReporter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnbondReporter(ctx, req.(*MsgUnbondReporter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.btclightclient.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -369,6 +626,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "BondReporter",
+			Handler:    _Msg_BondReporter_Handler,
+		},
+		{
+			MethodName: "UnbondReporter",
+			Handler:    _Msg_UnbondReporter_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/btclightclient/v1/tx.proto",
@@ -504,6 +769,122 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgBondReporter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondReporter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondReporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBondReporterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondReporterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondReporterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondReporter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondReporter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondReporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondReporterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondReporterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondReporterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -567,15 +948,61 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgInsertHeaders) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+func (m *MsgBondReporter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBondReporterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnbondReporter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnbondReporterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgInsertHeaders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -905,6 +1332,303 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgBondReporter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBondReporter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBondReporter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBondReporterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBondReporterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBondReporterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbondReporter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbondReporter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbondReporter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbondReporterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbondReporterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbondReporterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
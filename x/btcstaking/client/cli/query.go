@@ -11,6 +11,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagExpiringWithin = "expiring-within"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string) *cobra.Command {
 	// Group btcstaking queries under a subcommand
@@ -30,7 +34,10 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdFinalityProviderPowerAtHeight())
 	cmd.AddCommand(CmdActivatedHeight())
 	cmd.AddCommand(CmdFinalityProviderDelegations())
+	cmd.AddCommand(CmdDelegatorDelegations())
 	cmd.AddCommand(CmdDelegation())
+	cmd.AddCommand(CmdCovenantCommitteeRotation())
+	cmd.AddCommand(CmdCovenantMemberDelegations())
 
 	return cmd
 }
@@ -142,9 +149,15 @@ func CmdBTCDelegations() *cobra.Command {
 				return err
 			}
 
+			expiringWithin, err := cmd.Flags().GetUint64(FlagExpiringWithin)
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.BTCDelegations(cmd.Context(), &types.QueryBTCDelegationsRequest{
-				Status:     status,
-				Pagination: pageReq,
+				Status:                  status,
+				Pagination:              pageReq,
+				ExpiringWithinBtcBlocks: expiringWithin,
 			})
 			if err != nil {
 				return err
@@ -154,6 +167,7 @@ func CmdBTCDelegations() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagExpiringWithin, 0, "only retrieve the BTC delegations expiring within the given number of BTC blocks")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "btc-delegations")
 
@@ -285,3 +299,93 @@ func CmdFinalityProviderDelegations() *cobra.Command {
 
 	return cmd
 }
+
+func CmdDelegatorDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegator-delegations [del_pk_hex]",
+		Short: "retrieve all delegations of a given BTC delegator across all finality providers",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorDelegations(cmd.Context(), &types.QueryDelegatorDelegationsRequest{
+				DelBtcPkHex: args[0],
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegator-delegations")
+
+	return cmd
+}
+
+func CmdCovenantCommitteeRotation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "covenant-committee-rotation",
+		Short: "retrieve the scheduled covenant committee rotation and the retiring covenant members",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CovenantCommitteeRotation(cmd.Context(), &types.QueryCovenantCommitteeRotationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCovenantMemberDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "covenant-member-delegations [covenant_pk_hex]",
+		Short: "retrieve all pending or active delegations that depend on a given covenant member",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CovenantMemberDelegations(cmd.Context(), &types.QueryCovenantMemberDelegationsRequest{
+				CovenantPkHex: args[0],
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "covenant-member-delegations")
+
+	return cmd
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"encoding/hex"
 	"fmt"
+	"strconv"
 	"strings"
 
 	sdkmath "cosmossdk.io/math"
@@ -19,12 +20,16 @@ import (
 )
 
 const (
-	FlagMoniker         = "moniker"
-	FlagIdentity        = "identity"
-	FlagWebsite         = "website"
-	FlagSecurityContact = "security-contact"
-	FlagDetails         = "details"
-	FlagCommissionRate  = "commission-rate"
+	FlagMoniker                 = "moniker"
+	FlagIdentity                = "identity"
+	FlagWebsite                 = "website"
+	FlagSecurityContact         = "security-contact"
+	FlagDetails                 = "details"
+	FlagCommissionRate          = "commission-rate"
+	FlagCommissionMaxRate       = "commission-max-rate"
+	FlagCommissionMaxChangeRate = "commission-max-change-rate"
+	FlagConsumerID              = "consumer-id"
+	FlagStakeSpendingTxSig      = "stake-spending-tx-sig"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -41,9 +46,13 @@ func GetTxCmd() *cobra.Command {
 		NewCreateFinalityProviderCmd(),
 		NewEditFinalityProviderCmd(),
 		NewCreateBTCDelegationCmd(),
+		NewExpandBTCDelegationCmd(),
+		NewBTCRedelegateCmd(),
+		NewAddBTCDelegationInclusionProofCmd(),
 		NewAddCovenantSigsCmd(),
 		NewBTCUndelegateCmd(),
 		NewSelectiveSlashingEvidenceCmd(),
+		NewRotateFinalityProviderKeyCmd(),
 	)
 
 	return cmd
@@ -84,6 +93,18 @@ func NewCreateFinalityProviderCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			maxRateStr, _ := fs.GetString(FlagCommissionMaxRate)
+			maxRate, err := sdkmath.LegacyNewDecFromStr(maxRateStr)
+			if err != nil {
+				return err
+			}
+			maxChangeRateStr, _ := fs.GetString(FlagCommissionMaxChangeRate)
+			maxChangeRate, err := sdkmath.LegacyNewDecFromStr(maxChangeRateStr)
+			if err != nil {
+				return err
+			}
+
+			consumerID, _ := fs.GetString(FlagConsumerID)
 
 			// get BTC PK
 			btcPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
@@ -98,11 +119,14 @@ func NewCreateFinalityProviderCmd() *cobra.Command {
 			}
 
 			msg := types.MsgCreateFinalityProvider{
-				Addr:        clientCtx.FromAddress.String(),
-				Description: &description,
-				Commission:  &rate,
-				BtcPk:       btcPK,
-				Pop:         pop,
+				Addr:          clientCtx.FromAddress.String(),
+				Description:   &description,
+				Commission:    &rate,
+				BtcPk:         btcPK,
+				Pop:           pop,
+				MaxRate:       &maxRate,
+				MaxChangeRate: &maxChangeRate,
+				ConsumerId:    consumerID,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
@@ -116,6 +140,9 @@ func NewCreateFinalityProviderCmd() *cobra.Command {
 	fs.String(FlagDetails, "", "The finality provider's (optional) details")
 	fs.String(FlagIdentity, "", "The (optional) identity signature (ex. UPort or Keybase)")
 	fs.String(FlagCommissionRate, "0", "The initial commission rate percentage")
+	fs.String(FlagCommissionMaxRate, "1", "The maximum commission rate percentage")
+	fs.String(FlagCommissionMaxChangeRate, "0.01", "The maximum commission change rate percentage (per day)")
+	fs.String(FlagConsumerID, "", "The (optional) chain ID of the registered consumer chain that the finality provider provides finality to")
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -306,13 +333,267 @@ func NewCreateBTCDelegationCmd() *cobra.Command {
 	return cmd
 }
 
+func NewExpandBTCDelegationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expand-btc-delegation [previous_staking_tx_hash] [staking_tx] [staking_time] [staking_value] [slashing_tx] [delegator_slashing_sig] [unbonding_tx] [unbonding_slashing_tx] [unbonding_time] [unbonding_value] [delegator_unbonding_slashing_sig] [funding_tx1],[funding_tx2],...",
+		Args:  cobra.ExactArgs(12),
+		Short: "Expand an active BTC delegation with a staking tx spending its staking output",
+		Long: strings.TrimSpace(
+			`Expand an active BTC delegation with a new staking tx that spends the previous staking output together with extra funding inputs.
+The BTC staker and finality providers are inherited from the previous BTC delegation. The funding txs are the BTC txs whose outputs are spent by the extra funding inputs.
+The staking tx can only be included on Bitcoin after the covenant committee co-signs the spending of the previous staking output. Its inclusion is then proven via add-btc-delegation-inclusion-proof.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get staking tx
+			_, stakingTxBytes, err := bbn.NewBTCTxFromHex(args[1])
+			if err != nil {
+				return err
+			}
+
+			// get staking time
+			stakingTime, err := parseLockTime(args[2])
+			if err != nil {
+				return err
+			}
+
+			stakingValue, err := parseBtcAmount(args[3])
+			if err != nil {
+				return err
+			}
+
+			// get slashing tx
+			slashingTx, err := types.NewBTCSlashingTxFromHex(args[4])
+			if err != nil {
+				return err
+			}
+
+			// get delegator sig on slashing tx
+			delegatorSlashingSig, err := bbn.NewBIP340SignatureFromHex(args[5])
+			if err != nil {
+				return err
+			}
+
+			// get unbonding tx
+			_, unbondingTxBytes, err := bbn.NewBTCTxFromHex(args[6])
+			if err != nil {
+				return err
+			}
+
+			// get unbonding slashing tx
+			unbondingSlashingTx, err := types.NewBTCSlashingTxFromHex(args[7])
+			if err != nil {
+				return err
+			}
+
+			// get unbonding time
+			unbondingTime, err := parseLockTime(args[8])
+			if err != nil {
+				return err
+			}
+
+			unbondingValue, err := parseBtcAmount(args[9])
+			if err != nil {
+				return err
+			}
+
+			// get delegator sig on unbonding slashing tx
+			delegatorUnbondingSlashingSig, err := bbn.NewBIP340SignatureFromHex(args[10])
+			if err != nil {
+				return err
+			}
+
+			// parse funding txs
+			fundingTxs := [][]byte{}
+			for _, txHex := range strings.Split(args[11], ",") {
+				_, fundingTxBytes, err := bbn.NewBTCTxFromHex(txHex)
+				if err != nil {
+					return fmt.Errorf("invalid funding tx: %w", err)
+				}
+				fundingTxs = append(fundingTxs, fundingTxBytes)
+			}
+
+			msg := types.MsgExpandBTCDelegation{
+				StakerAddr:                    clientCtx.FromAddress.String(),
+				PreviousStakingTxHash:         args[0],
+				StakingTime:                   uint32(stakingTime),
+				StakingValue:                  int64(stakingValue),
+				StakingTx:                     stakingTxBytes,
+				SlashingTx:                    slashingTx,
+				DelegatorSlashingSig:          delegatorSlashingSig,
+				UnbondingTx:                   unbondingTxBytes,
+				UnbondingTime:                 uint32(unbondingTime),
+				UnbondingValue:                int64(unbondingValue),
+				UnbondingSlashingTx:           unbondingSlashingTx,
+				DelegatorUnbondingSlashingSig: delegatorUnbondingSlashingSig,
+				FundingTxs:                    fundingTxs,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewBTCRedelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-redelegate [previous_staking_tx_hash] [fp_pk] [staking_tx] [staking_time] [staking_value] [slashing_tx] [delegator_slashing_sig] [unbonding_tx] [unbonding_slashing_tx] [unbonding_time] [unbonding_value] [delegator_unbonding_slashing_sig]",
+		Args:  cobra.ExactArgs(12),
+		Short: "Redelegate an active BTC delegation to a different finality provider",
+		Long: strings.TrimSpace(
+			`Redelegate an active BTC delegation to a different finality provider with a new staking tx that only spends the previous staking output.
+The BTC staker is inherited from the previous BTC delegation.
+The staking tx can only be included on Bitcoin after the covenant committee co-signs the spending of the previous staking output. Its inclusion is then proven via add-btc-delegation-inclusion-proof.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get finality provider PK
+			fpPK, err := bbn.NewBIP340PubKeyFromHex(args[1])
+			if err != nil {
+				return err
+			}
+
+			// get staking tx
+			_, stakingTxBytes, err := bbn.NewBTCTxFromHex(args[2])
+			if err != nil {
+				return err
+			}
+
+			// get staking time
+			stakingTime, err := parseLockTime(args[3])
+			if err != nil {
+				return err
+			}
+
+			stakingValue, err := parseBtcAmount(args[4])
+			if err != nil {
+				return err
+			}
+
+			// get slashing tx
+			slashingTx, err := types.NewBTCSlashingTxFromHex(args[5])
+			if err != nil {
+				return err
+			}
+
+			// get delegator sig on slashing tx
+			delegatorSlashingSig, err := bbn.NewBIP340SignatureFromHex(args[6])
+			if err != nil {
+				return err
+			}
+
+			// get unbonding tx
+			_, unbondingTxBytes, err := bbn.NewBTCTxFromHex(args[7])
+			if err != nil {
+				return err
+			}
+
+			// get unbonding slashing tx
+			unbondingSlashingTx, err := types.NewBTCSlashingTxFromHex(args[8])
+			if err != nil {
+				return err
+			}
+
+			// get unbonding time
+			unbondingTime, err := parseLockTime(args[9])
+			if err != nil {
+				return err
+			}
+
+			unbondingValue, err := parseBtcAmount(args[10])
+			if err != nil {
+				return err
+			}
+
+			// get delegator sig on unbonding slashing tx
+			delegatorUnbondingSlashingSig, err := bbn.NewBIP340SignatureFromHex(args[11])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgBTCRedelegate{
+				StakerAddr:                    clientCtx.FromAddress.String(),
+				PreviousStakingTxHash:         args[0],
+				FpBtcPkList:                   []bbn.BIP340PubKey{*fpPK},
+				StakingTime:                   uint32(stakingTime),
+				StakingValue:                  int64(stakingValue),
+				StakingTx:                     stakingTxBytes,
+				SlashingTx:                    slashingTx,
+				DelegatorSlashingSig:          delegatorSlashingSig,
+				UnbondingTx:                   unbondingTxBytes,
+				UnbondingTime:                 uint32(unbondingTime),
+				UnbondingValue:                int64(unbondingValue),
+				UnbondingSlashingTx:           unbondingSlashingTx,
+				DelegatorUnbondingSlashingSig: delegatorUnbondingSlashingSig,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewAddBTCDelegationInclusionProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-btc-delegation-inclusion-proof [staking_tx_hash] [staking_tx_info]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Prove the inclusion of the staking tx of a BTC delegation created upon stake expansion or redelegation",
+		Long: strings.TrimSpace(
+			`Prove the inclusion of the staking tx of a BTC delegation created upon stake expansion or redelegation, once the covenant committee has co-signed the spending of the previous staking output. The BTC delegation then becomes active, and the previous one becomes unbonded.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get staking tx hash
+			stakingTxHash := args[0]
+
+			// get staking tx info
+			stakingTxInfo, err := btcctypes.NewTransactionInfoFromHex(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgAddBTCDelegationInclusionProof{
+				Signer:         clientCtx.FromAddress.String(),
+				StakingTxHash:  stakingTxHash,
+				StakingTxKey:   stakingTxInfo.Key,
+				StakingTxProof: stakingTxInfo.Proof,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewAddCovenantSigsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add-covenant-sigs [covenant_pk] [staking_tx_hash] [slashing_tx_sig1],[slashing_tx_sig2],... [unbonding_tx_sig] [slashing_unbonding_tx_sig1],[slashing_unbonding_tx_sig2],...",
 		Args:  cobra.ExactArgs(5),
 		Short: "Add a covenant signature",
 		Long: strings.TrimSpace(
-			`Add a covenant signature.`, // TODO: example
+			`Add a covenant signature.
+For a BTC delegation created upon stake expansion or redelegation, the covenant signature on the staking tx spending the previous staking output has to be provided via --stake-spending-tx-sig.`, // TODO: example
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -363,10 +644,21 @@ func NewAddCovenantSigsCmd() *cobra.Command {
 				SlashingUnbondingTxSigs: unbondingSlashingSigs,
 			}
 
+			// get covenant signature for staking tx spending the previous
+			// staking output, if any
+			stakeSpendingTxSigHex, _ := cmd.Flags().GetString(FlagStakeSpendingTxSig)
+			if stakeSpendingTxSigHex != "" {
+				msg.StakeSpendingTxSig, err = bbn.NewBIP340SignatureFromHex(stakeSpendingTxSigHex)
+				if err != nil {
+					return err
+				}
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
+	cmd.Flags().String(FlagStakeSpendingTxSig, "", "The covenant signature on the staking tx spending the previous staking output, upon stake expansion or redelegation")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -447,3 +739,63 @@ func NewSelectiveSlashingEvidenceCmd() *cobra.Command {
 
 	return cmd
 }
+
+func NewRotateFinalityProviderKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-finality-provider-key [old_btc_pk] [new_btc_pk] [new_pop] [rotation_height] [handover_sig]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Schedule the rotation of a finality provider's BTC PK to a new BTC PK",
+		Long: strings.TrimSpace(
+			`Schedule the rotation of a finality provider's BTC PK to a new BTC PK at the given Babylon height. The handover signature is a BIP-340 signature of the old BTC SK over the old BTC PK, the new BTC PK and the rotation height. BTC delegations staked to the old BTC PK lose their voting power at the rotation height unless they are redelegated to the new BTC PK.`, // TODO: example
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get old and new BTC PKs
+			oldBtcPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
+			if err != nil {
+				return err
+			}
+			newBtcPK, err := bbn.NewBIP340PubKeyFromHex(args[1])
+			if err != nil {
+				return err
+			}
+
+			// get PoP of the new BTC PK
+			newPop, err := types.NewPoPBTCFromHex(args[2])
+			if err != nil {
+				return err
+			}
+
+			// get rotation height
+			rotationHeight, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// get handover signature of the old BTC PK
+			handoverSig, err := bbn.NewBIP340SignatureFromHex(args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRotateFinalityProviderKey{
+				Addr:           clientCtx.FromAddress.String(),
+				OldBtcPk:       oldBtcPK,
+				NewBtcPk:       newBtcPK,
+				NewPop:         newPop,
+				RotationHeight: rotationHeight,
+				HandoverSig:    handoverSig,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
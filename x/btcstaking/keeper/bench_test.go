@@ -36,11 +36,13 @@ func benchBeginBlock(b *testing.B, numFPs int, numDelsUnderFP int) {
 		fp, err := datagen.GenRandomFinalityProvider(r)
 		h.NoError(err)
 		msg := &types.MsgCreateFinalityProvider{
-			Addr:        fp.Addr,
-			Description: fp.Description,
-			Commission:  fp.Commission,
-			BtcPk:       fp.BtcPk,
-			Pop:         fp.Pop,
+			Addr:          fp.Addr,
+			Description:   fp.Description,
+			Commission:    fp.Commission,
+			MaxRate:       fp.MaxRate,
+			MaxChangeRate: fp.MaxChangeRate,
+			BtcPk:         fp.BtcPk,
+			Pop:           fp.Pop,
 		}
 		_, err = h.MsgServer.CreateFinalityProvider(h.Ctx, msg)
 		h.NoError(err)
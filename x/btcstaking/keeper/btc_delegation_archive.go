@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"sync"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+var _ types.BTCDelegationArchive = (*DBArchive)(nil)
+
+// DBArchive is an archive of pruned BTC delegations backed by a database local
+// to the node. It is not part of the consensus state, and only contains the
+// BTC delegations pruned while the node has it enabled. The BTC delegations
+// pruned during block execution are kept in memory, and are only written to
+// the database upon `Commit`.
+type DBArchive struct {
+	db  dbm.DB
+	cdc codec.BinaryCodec
+
+	mu sync.Mutex
+	// staged is the BTC delegations pruned in the current block, by staking
+	// tx hash
+	staged map[chainhash.Hash][]byte
+}
+
+func NewDBArchive(db dbm.DB, cdc codec.BinaryCodec) *DBArchive {
+	return &DBArchive{
+		db:     db,
+		cdc:    cdc,
+		staged: map[chainhash.Hash][]byte{},
+	}
+}
+
+// ArchiveBTCDelegation stages the given BTC delegation under its staking tx
+// hash, to be written to the database upon `Commit`
+func (a *DBArchive) ArchiveBTCDelegation(btcDel *types.BTCDelegation) error {
+	stakingTxHash, err := btcDel.GetStakingTxHash()
+	if err != nil {
+		return err
+	}
+	btcDelBytes, err := a.cdc.Marshal(btcDel)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.staged[stakingTxHash] = btcDelBytes
+	return nil
+}
+
+// Commit writes all staged BTC delegations to the database in a batch
+func (a *DBArchive) Commit() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if len(a.staged) == 0 {
+		return nil
+	}
+	batch := a.db.NewBatch()
+	defer batch.Close()
+	for stakingTxHash, btcDelBytes := range a.staged {
+		stakingTxHash := stakingTxHash // remove when update to go1.22
+		if err := batch.Set(stakingTxHash[:], btcDelBytes); err != nil {
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	a.staged = map[chainhash.Hash][]byte{}
+	return nil
+}
+
+// GetArchivedBTCDelegation gets the archived BTC delegation with the given
+// staking tx hash
+func (a *DBArchive) GetArchivedBTCDelegation(stakingTxHash chainhash.Hash) (*types.BTCDelegation, error) {
+	btcDelBytes, err := a.db.Get(stakingTxHash[:])
+	if err != nil {
+		return nil, err
+	}
+	if len(btcDelBytes) == 0 {
+		return nil, types.ErrBTCDelegationNotFound
+	}
+	var btcDel types.BTCDelegation
+	if err := a.cdc.Unmarshal(btcDelBytes, &btcDel); err != nil {
+		return nil, err
+	}
+	return &btcDel, nil
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+/* pruning of unbonded BTC delegations */
+
+// schedulePruningOfUnbondedBTCDelegations schedules the pruning of all BTC
+// delegations that become unbonded in the given power distribution update
+// events. An unbonded BTC delegation is kept until the timelocks of both its
+// staking and unbonding outputs have expired, such that its staking tx cannot
+// be registered again and it remains slashable, and then for another
+// `UnbondedDelegationRetentionBlocks` BTC blocks.
+func (k Keeper) schedulePruningOfUnbondedBTCDelegations(ctx context.Context, events []*types.EventPowerDistUpdate, btcTipHeight uint64) {
+	retention := k.GetParams(ctx).UnbondedDelegationRetentionBlocks
+	if retention == 0 {
+		// pruning is disabled
+		return
+	}
+
+	for _, event := range events {
+		delEvent := event.GetBtcDelStateUpdate()
+		if delEvent == nil || delEvent.NewState != types.BTCDelegationStatus_UNBONDED {
+			continue
+		}
+		stakingTxHash, err := chainhash.NewHashFromStr(delEvent.StakingTxHash)
+		if err != nil {
+			panic(err) // only programming error
+		}
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil {
+			// the BTC delegation is already pruned
+			continue
+		}
+		pruneHeight := max(btcTipHeight+uint64(btcDel.UnbondingTime), btcDel.EndHeight) + uint64(retention)
+		k.setBTCDelegationPruneEntry(ctx, pruneHeight, *stakingTxHash)
+	}
+}
+
+// PruneBTCDelegations prunes all unbonded BTC delegations whose pruning is
+// scheduled at or before the current BTC tip. Each pruned BTC delegation is
+// removed from the BTC delegation store and the BTC delegator indexes, and is
+// kept in the archive if this node has one. The pruning of a BTC delegation
+// spent by a pending stake expansion or redelegation is postponed to the next
+// BTC height, as the pending BTC delegation still refers to it.
+// This is triggered upon each `BeginBlock`, after updating the voting power
+// distribution.
+func (k Keeper) PruneBTCDelegations(ctx context.Context) {
+	btcTipHeight := k.GetCurrentBTCHeight(ctx)
+
+	dueEntries := []*types.BTCDelegationPruneEntry{}
+	k.iterateBTCDelegationPruneEntries(ctx, btcTipHeight, func(entry *types.BTCDelegationPruneEntry) bool {
+		dueEntries = append(dueEntries, entry)
+		return true
+	})
+
+	for _, entry := range dueEntries {
+		stakingTxHash, err := chainhash.NewHashFromStr(entry.StakingTxHashHex)
+		if err != nil {
+			panic(err) // only programming error
+		}
+		k.deleteBTCDelegationPruneEntry(ctx, entry.BtcHeight, *stakingTxHash)
+		if k.hasPendingStakeSpendingDelegation(ctx, *stakingTxHash, btcTipHeight) {
+			k.setBTCDelegationPruneEntry(ctx, btcTipHeight+1, *stakingTxHash)
+			continue
+		}
+		k.pruneBTCDelegation(ctx, *stakingTxHash)
+	}
+}
+
+// pruneBTCDelegation removes the BTC delegation with the given staking tx hash
+// from the state, and archives it if this node has an archive
+func (k Keeper) pruneBTCDelegation(ctx context.Context, stakingTxHash chainhash.Hash) {
+	btcDel := k.getBTCDelegation(ctx, stakingTxHash)
+	if btcDel == nil {
+		// the BTC delegation is already pruned upon an earlier schedule
+		return
+	}
+
+	for i := range btcDel.FpBtcPkList {
+		k.removeFromBTCDelegatorDelegationIndex(ctx, &btcDel.FpBtcPkList[i], btcDel.BtcPk, stakingTxHash)
+	}
+	k.removeDelegatorDelegation(ctx, btcDel)
+	k.removeCovenantDelegation(ctx, btcDel)
+	k.removeStakeSpendingDelegation(ctx, btcDel)
+	k.deleteBTCDelegationStatus(ctx, btcDel)
+	k.btcDelegationStore(ctx).Delete(stakingTxHash[:])
+
+	// the archive is an off-chain index local to this node, thus failing to
+	// archive does not affect the consensus. The archive only writes the BTC
+	// delegation to its database upon `Commit`, outside of block execution
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if k.archive != nil {
+		if err := k.archive.ArchiveBTCDelegation(btcDel); err != nil {
+			k.Logger(sdkCtx).Error("failed to archive the pruned BTC delegation", "staking_tx_hash", stakingTxHash.String(), "err", err)
+		}
+	}
+
+	event := &types.EventBTCDelegationPruned{StakingTxHash: stakingTxHash.String()}
+	if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventBTCDelegationPruned event: %w", err))
+	}
+}
+
+/* BTC delegation prune queue storage */
+
+func (k Keeper) setBTCDelegationPruneEntry(ctx context.Context, btcHeight uint64, stakingTxHash chainhash.Hash) {
+	store := k.btcDelegationPruneStore(ctx)
+	store.Set(btcDelegationPruneKey(btcHeight, stakingTxHash), []byte{})
+}
+
+func (k Keeper) deleteBTCDelegationPruneEntry(ctx context.Context, btcHeight uint64, stakingTxHash chainhash.Hash) {
+	store := k.btcDelegationPruneStore(ctx)
+	store.Delete(btcDelegationPruneKey(btcHeight, stakingTxHash))
+}
+
+// iterateBTCDelegationPruneEntries iterates over the prune entries scheduled
+// at or before the given BTC height, in the ascending order of BTC heights
+func (k Keeper) iterateBTCDelegationPruneEntries(ctx context.Context, maxBTCHeight uint64, handler func(entry *types.BTCDelegationPruneEntry) bool) {
+	store := k.btcDelegationPruneStore(ctx)
+	var end []byte
+	if maxBTCHeight < math.MaxUint64 {
+		end = sdk.Uint64ToBigEndian(maxBTCHeight + 1)
+	}
+	iter := store.Iterator(nil, end)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		stakingTxHash, err := chainhash.NewHash(key[8:])
+		if err != nil {
+			panic(err) // only programming error
+		}
+		entry := &types.BTCDelegationPruneEntry{
+			BtcHeight:        sdk.BigEndianToUint64(key[:8]),
+			StakingTxHashHex: stakingTxHash.String(),
+		}
+		if !handler(entry) {
+			break
+		}
+	}
+}
+
+func btcDelegationPruneKey(btcHeight uint64, stakingTxHash chainhash.Hash) []byte {
+	return append(sdk.Uint64ToBigEndian(btcHeight), stakingTxHash[:]...)
+}
+
+// btcDelegationPruneStore returns the KVStore of the queue of unbonded BTC
+// delegations to be pruned
+// prefix: BTCDelegationPruneKey
+// key: (BTC height from which the BTC delegation is pruned || staking tx hash)
+// value: empty
+func (k Keeper) btcDelegationPruneStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BTCDelegationPruneKey)
+}
@@ -0,0 +1,265 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/babylonchain/babylon/x/btcstaking/keeper"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func FuzzPruneBTCDelegations(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters, with pruning enabled
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		retention := uint32(datagen.RandomInt(r, 10)) + 1
+		bsParams.UnbondedDelegationRetentionBlocks = retention
+		err := h.BTCStakingKeeper.SetParams(h.Ctx, bsParams)
+		h.NoError(err)
+
+		// keep the pruned BTC delegations in an archive
+		archive := keeper.NewDBArchive(dbm.NewMemDB(), codec.NewProtoCodec(codectypes.NewInterfaceRegistry()))
+		h.BTCStakingKeeper.SetArchive(archive)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// generate and insert 2 active BTC delegations
+		stakingValue := int64(2 * 10e8)
+		stakingTxHash1, delSK1, _, msgCreateBTCDel1, actualDel1 := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel1, actualDel1)
+		stakingTxHash2, _, _, msgCreateBTCDel2, actualDel2 := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel2, actualDel2)
+
+		// unbond the 1st BTC delegation early
+		actualDel1, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash1)
+		h.NoError(err)
+		delUnbondingSig, err := actualDel1.SignUnbondingTx(&bsParams, h.Net, delSK1)
+		h.NoError(err)
+		_, err = h.MsgServer.BTCUndelegate(h.Ctx, &types.MsgBTCUndelegate{
+			Signer:         datagen.GenRandomAccount().Address,
+			StakingTxHash:  stakingTxHash1,
+			UnbondingTxSig: bbn.NewBIP340SignatureFromBTCSig(delUnbondingSig),
+		})
+		h.NoError(err)
+
+		// the unbonding is processed upon `BeginBlock`, which schedules the
+		// pruning of the 1st BTC delegation
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+
+		pruneHeight := max(btcTip.Height+uint64(actualDel1.UnbondingTime), actualDel1.EndHeight) + uint64(retention)
+		gs, err := h.BTCStakingKeeper.ExportGenesis(h.Ctx)
+		h.NoError(err)
+		require.Equal(t, []*types.BTCDelegationPruneEntry{{BtcHeight: pruneHeight, StakingTxHashHex: stakingTxHash1}}, gs.PruneQueue)
+
+		// the 1st BTC delegation is kept until the scheduled BTC height
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: pruneHeight - 1}).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		_, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash1)
+		h.NoError(err)
+
+		// the 1st BTC delegation is pruned at the scheduled BTC height
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: pruneHeight}).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		_, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash1)
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+		_, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash2)
+		h.NoError(err)
+
+		// the BTC delegator index only contains the 2nd BTC delegation
+		fpDelsResp, err := h.BTCStakingKeeper.FinalityProviderDelegations(h.Ctx, &types.QueryFinalityProviderDelegationsRequest{FpBtcPkHex: fp.BtcPk.MarshalHex()})
+		h.NoError(err)
+		require.Len(t, fpDelsResp.BtcDelegatorDelegations, 1)
+		require.Len(t, fpDelsResp.BtcDelegatorDelegations[0].Dels, 1)
+		require.Equal(t, actualDel2.BtcPk, fpDelsResp.BtcDelegatorDelegations[0].Dels[0].BtcPk)
+
+		// the pruned BTC delegation is only written to the archive upon commit
+		_, err = h.BTCStakingKeeper.BTCDelegation(h.Ctx, &types.QueryBTCDelegationRequest{StakingTxHashHex: stakingTxHash1})
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+		err = h.BTCStakingKeeper.CommitArchive(h.Ctx)
+		h.NoError(err)
+
+		// the pruned BTC delegation can still be queried from the archive
+		delResp, err := h.BTCStakingKeeper.BTCDelegation(h.Ctx, &types.QueryBTCDelegationRequest{StakingTxHashHex: stakingTxHash1})
+		h.NoError(err)
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED.String(), delResp.BtcDelegation.StatusDesc)
+		require.Equal(t, actualDel1.BtcPk, delResp.BtcDelegation.BtcPk)
+
+		// eventually, the 2nd BTC delegation expires and is pruned as well
+		expiredHeight := max(pruneHeight, actualDel2.EndHeight)
+		prune2Height := expiredHeight + uint64(actualDel2.UnbondingTime) + actualDel2.EndHeight + uint64(retention)
+		for _, btcHeight := range []uint64{expiredHeight, prune2Height} {
+			babylonHeight += 1
+			h.SetCtxHeight(babylonHeight)
+			h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: btcHeight}).AnyTimes()
+			err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+			h.NoError(err)
+		}
+		_, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash2)
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+		fpDelsResp, err = h.BTCStakingKeeper.FinalityProviderDelegations(h.Ctx, &types.QueryFinalityProviderDelegationsRequest{FpBtcPkHex: fp.BtcPk.MarshalHex()})
+		h.NoError(err)
+		require.Empty(t, fpDelsResp.BtcDelegatorDelegations)
+		gs, err = h.BTCStakingKeeper.ExportGenesis(h.Ctx)
+		h.NoError(err)
+		require.Empty(t, gs.BtcDelegations)
+		require.Empty(t, gs.BtcDelegators)
+		require.Empty(t, gs.PruneQueue)
+	})
+}
+
+func FuzzPruneBTCDelegationSpentByExpiredDelegation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters, with pruning enabled
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		retention := uint32(datagen.RandomInt(r, 10)) + 1
+		bsParams.UnbondedDelegationRetentionBlocks = retention
+		err := h.BTCStakingKeeper.SetParams(h.Ctx, bsParams)
+		h.NoError(err)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert an active BTC delegation
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+		stakingValue := int64(2 * 10e8)
+		prevStakingTxHash, delSK, _, msgCreateBTCDel, prevDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, prevDel)
+		prevDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, prevStakingTxHash)
+		h.NoError(err)
+
+		// expand the BTC delegation, which is pending without covenant signatures
+		stakingTxHash, _, err := h.ExpandDelegation(r, delSK, fpPK, prevDel, 2*stakingValue, 1000)
+		h.NoError(err)
+
+		// unbond the previous BTC delegation early, which schedules its pruning
+		delUnbondingSig, err := prevDel.SignUnbondingTx(&bsParams, h.Net, delSK)
+		h.NoError(err)
+		_, err = h.MsgServer.BTCUndelegate(h.Ctx, &types.MsgBTCUndelegate{
+			Signer:         datagen.GenRandomAccount().Address,
+			StakingTxHash:  prevStakingTxHash,
+			UnbondingTxSig: bbn.NewBIP340SignatureFromBTCSig(delUnbondingSig),
+		})
+		h.NoError(err)
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		babylonHeight := datagen.RandomInt(r, 10) + 2
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		pruneHeight := max(btcTip.Height+uint64(prevDel.UnbondingTime), prevDel.EndHeight) + uint64(retention)
+
+		// the BTC delegation awaiting its inclusion proof expires with the
+		// previous BTC delegation, and leaves the indexes
+		newDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.True(t, newDel.IsExpiredWithPreviousDelegation())
+		delResp, err := h.BTCStakingKeeper.BTCDelegation(h.Ctx, &types.QueryBTCDelegationRequest{StakingTxHashHex: stakingTxHash})
+		h.NoError(err)
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED.String(), delResp.BtcDelegation.StatusDesc)
+		for _, covSK := range covenantSKs {
+			covPK := bbn.NewBIP340PubKeyFromBTCPK(covSK.PubKey())
+			delsResp, err := h.BTCStakingKeeper.CovenantMemberDelegations(h.Ctx, &types.QueryCovenantMemberDelegationsRequest{CovenantPkHex: covPK.MarshalHex()})
+			h.NoError(err)
+			require.Empty(t, delsResp.BtcDelegations)
+		}
+		err = h.AddBTCDelegationInclusionProof(r, stakingTxHash)
+		require.ErrorIs(t, err, types.ErrInvalidDelegationState)
+
+		// the unbonding of the expired BTC delegation is processed at the next
+		// BTC height, which schedules its pruning
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: btcTip.Height + 1}).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		newPruneHeight := max(btcTip.Height+1+uint64(newDel.UnbondingTime), newDel.EndHeight) + uint64(retention)
+		gs, err := h.BTCStakingKeeper.ExportGenesis(h.Ctx)
+		h.NoError(err)
+		require.Contains(t, gs.PruneQueue, &types.BTCDelegationPruneEntry{BtcHeight: pruneHeight, StakingTxHashHex: prevStakingTxHash})
+		require.Contains(t, gs.PruneQueue, &types.BTCDelegationPruneEntry{BtcHeight: newPruneHeight, StakingTxHashHex: stakingTxHash})
+
+		// both BTC delegations are pruned once due, without postponing the
+		// pruning of the previous BTC delegation
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: max(pruneHeight, newPruneHeight)}).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		_, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, prevStakingTxHash)
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+		_, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+		gs, err = h.BTCStakingKeeper.ExportGenesis(h.Ctx)
+		h.NoError(err)
+		require.Empty(t, gs.BtcDelegations)
+	})
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// indexedBTCDelegationStatuses are the statuses maintained in the BTC
+// delegation status index
+var indexedBTCDelegationStatuses = []types.BTCDelegationStatus{
+	types.BTCDelegationStatus_PENDING,
+	types.BTCDelegationStatus_ACTIVE,
+	types.BTCDelegationStatus_UNBONDED,
+}
+
+// setBTCDelegationStatus moves the given BTC delegation to the given status in
+// the BTC delegation status index. The index follows the BTC delegation state
+// updates that affect the voting power distribution, thus unbonded is a final
+// status that is never left.
+func (k Keeper) setBTCDelegationStatus(ctx context.Context, btcDel *types.BTCDelegation, status types.BTCDelegationStatus) {
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	key := btcDelegationStatusKey(btcDel.EndHeight, stakingTxHash)
+
+	unbondedStore := k.btcDelegationStatusStore(ctx, types.BTCDelegationStatus_UNBONDED)
+	if unbondedStore.Has(key) {
+		return
+	}
+	for _, s := range indexedBTCDelegationStatuses {
+		if s != status {
+			k.btcDelegationStatusStore(ctx, s).Delete(key)
+		}
+	}
+	k.btcDelegationStatusStore(ctx, status).Set(key, []byte{})
+}
+
+// getBTCDelegationIndexedStatus returns the status of the given BTC delegation
+// in the BTC delegation status index, and whether it is indexed
+func (k Keeper) getBTCDelegationIndexedStatus(ctx context.Context, btcDel *types.BTCDelegation) (types.BTCDelegationStatus, bool) {
+	key := btcDelegationStatusKey(btcDel.EndHeight, btcDel.MustGetStakingTxHash())
+	for _, s := range indexedBTCDelegationStatuses {
+		if k.btcDelegationStatusStore(ctx, s).Has(key) {
+			return s, true
+		}
+	}
+	return types.BTCDelegationStatus_ANY, false
+}
+
+// deleteBTCDelegationStatus removes the given BTC delegation from the BTC
+// delegation status index
+func (k Keeper) deleteBTCDelegationStatus(ctx context.Context, btcDel *types.BTCDelegation) {
+	key := btcDelegationStatusKey(btcDel.EndHeight, btcDel.MustGetStakingTxHash())
+	for _, s := range indexedBTCDelegationStatuses {
+		k.btcDelegationStatusStore(ctx, s).Delete(key)
+	}
+}
+
+// updateBTCDelegationStatusIndex applies a BTC delegation state update to the
+// BTC delegation status index
+func (k Keeper) updateBTCDelegationStatusIndex(ctx context.Context, delEvent *types.EventBTCDelegationStateUpdate) {
+	stakingTxHash, err := chainhash.NewHashFromStr(delEvent.StakingTxHash)
+	if err != nil {
+		panic(err) // only programming error
+	}
+	btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+	if btcDel == nil {
+		// the BTC delegation is already pruned
+		return
+	}
+	k.setBTCDelegationStatus(ctx, btcDel, delEvent.NewState)
+}
+
+// RebuildBTCDelegationStatusIndex rebuilds the BTC delegation status index
+// from all BTC delegations in the state, w.r.t. the current BTC tip. This
+// migrates a state that was created before the index existed.
+func (k Keeper) RebuildBTCDelegationStatusIndex(ctx context.Context) {
+	iter := k.btcDelegationStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	if !iter.Valid() {
+		// no BTC delegation to index
+		return
+	}
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	for ; iter.Valid(); iter.Next() {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(iter.Value(), &btcDel)
+		k.setBTCDelegationStatus(ctx, &btcDel, k.getBTCDelegationStatus(ctx, &btcDel, btcTipHeight, wValue))
+	}
+}
+
+func btcDelegationStatusKey(endHeight uint64, stakingTxHash chainhash.Hash) []byte {
+	return append(sdk.Uint64ToBigEndian(endHeight), stakingTxHash[:]...)
+}
+
+func parseBTCDelegationStatusKey(key []byte) (uint64, *chainhash.Hash) {
+	stakingTxHash, err := chainhash.NewHash(key[8:])
+	if err != nil {
+		panic(err) // only programming error
+	}
+	return sdk.BigEndianToUint64(key[:8]), stakingTxHash
+}
+
+// btcDelegationStatusStore returns the KVStore of the BTC delegations under
+// the given status, ordered by their end heights
+// prefix: BTCDelegationStatusKey || status
+// key: (end height of the BTC delegation || staking tx hash)
+// value: empty
+func (k Keeper) btcDelegationStatusStore(ctx context.Context, status types.BTCDelegationStatus) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	statusStore := prefix.NewStore(storeAdapter, types.BTCDelegationStatusKey)
+	return prefix.NewStore(statusStore, []byte{byte(status)})
+}
@@ -15,7 +15,8 @@ import (
 
 // AddBTCDelegation adds a BTC delegation post verification to the system, including
 // - indexing the given BTC delegation in the BTC delegator store,
-// - saving it under BTC delegation store, and
+// - saving it under BTC delegation store,
+// - indexing it under its delegator and covenant members, and
 // - emit events about this BTC delegation.
 func (k Keeper) AddBTCDelegation(ctx sdk.Context, btcDel *types.BTCDelegation) error {
 	if err := btcDel.ValidateBasic(); err != nil {
@@ -44,8 +45,17 @@ func (k Keeper) AddBTCDelegation(ctx sdk.Context, btcDel *types.BTCDelegation) e
 		k.setBTCDelegatorDelegationIndex(ctx, &fpBTCPK, btcDel.BtcPk, btcDelIndex)
 	}
 
-	// save this BTC delegation
+	// save this BTC delegation and index it under its delegator
 	k.setBTCDelegation(ctx, btcDel)
+	k.indexDelegatorDelegation(ctx, btcDel)
+	k.indexCovenantDelegation(ctx, btcDel)
+	k.indexStakeSpendingDelegation(ctx, btcDel)
+
+	// index the status of this BTC delegation, which is pending unless it
+	// already carries a quorum of covenant signatures
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	k.setBTCDelegationStatus(ctx, btcDel, k.getBTCDelegationStatus(ctx, btcDel, btcTipHeight, wValue))
 
 	// notify subscriber
 	event := &types.EventBTCDelegationStateUpdate{
@@ -59,15 +69,23 @@ func (k Keeper) AddBTCDelegation(ctx sdk.Context, btcDel *types.BTCDelegation) e
 	// NOTE: we don't need to record events for pending BTC delegations since these
 	// do not affect voting power distribution
 
-	// record event that the BTC delegation will become unbonded at endHeight-w
+	// record event that the BTC delegation will become unbonded at endHeight-w,
+	// unless its timelock is only known upon its inclusion proof
+	if btcDel.HasInclusionProof() {
+		k.addBTCDelegationExpiryEvent(ctx, btcDel, wValue)
+	}
+
+	return nil
+}
+
+// addBTCDelegationExpiryEvent records the event that the given BTC delegation
+// will become unbonded at endHeight-w
+func (k Keeper) addBTCDelegationExpiryEvent(ctx context.Context, btcDel *types.BTCDelegation, wValue uint64) {
 	unbondedEvent := types.NewEventPowerDistUpdateWithBTCDel(&types.EventBTCDelegationStateUpdate{
-		StakingTxHash: stakingTxHash.String(),
+		StakingTxHash: btcDel.MustGetStakingTxHash().String(),
 		NewState:      types.BTCDelegationStatus_UNBONDED,
 	})
-	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
 	k.addPowerDistUpdateEvent(ctx, btcDel.EndHeight-wValue, unbondedEvent)
-
-	return nil
 }
 
 // addCovenantSigsToBTCDelegation adds signatures from a given covenant member
@@ -79,35 +97,125 @@ func (k Keeper) addCovenantSigsToBTCDelegation(
 	parsedSlashingAdaptorSignatures []asig.AdaptorSignature,
 	unbondingTxSig *bbn.BIP340Signature,
 	parsedUnbondingSlashingAdaptorSignatures []asig.AdaptorSignature,
+	stakeSpendingTxSig *bbn.BIP340Signature,
 	params *types.Params,
-) {
+) error {
 	// All is fine add received signatures to the BTC delegation and BtcUndelegation
 	btcDel.AddCovenantSigs(
 		covPK,
 		parsedSlashingAdaptorSignatures,
 		unbondingTxSig,
 		parsedUnbondingSlashingAdaptorSignatures,
+		stakeSpendingTxSig,
 	)
 
 	k.setBTCDelegation(ctx, btcDel)
 
 	// If reaching the covenant quorum after this msg, the BTC delegation becomes
-	// active. Then, record and emit this event
-	if len(btcDel.CovenantSigs) == int(params.CovenantQuorum) {
-		// notify subscriber
-		event := &types.EventBTCDelegationStateUpdate{
-			StakingTxHash: btcDel.MustGetStakingTxHash().String(),
-			NewState:      types.BTCDelegationStatus_ACTIVE,
-		}
-		if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
-			panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the new active BTC delegation: %w", err))
-		}
+	// active, unless its staking tx still needs to be proven included, which
+	// is only possible after the covenant quorum co-signs the spending of the
+	// previous staking output upon stake expansion or redelegation
+	if len(btcDel.CovenantSigs) == int(params.CovenantQuorum) && btcDel.HasInclusionProof() {
+		return k.activateBTCDelegation(ctx, btcDel)
+	}
+
+	return nil
+}
+
+// setBTCDelegationInclusionProof sets the timelock of the given BTC delegation
+// whose staking tx is proven to be included on Bitcoin upon stake expansion
+// or redelegation, and activates it as it already has a covenant quorum
+func (k Keeper) setBTCDelegationInclusionProof(ctx sdk.Context, btcDel *types.BTCDelegation, startHeight uint64, endHeight uint64) error {
+	// the BTC delegation status index is keyed by the end height, thus the
+	// BTC delegation is re-indexed under its actual end height
+	k.deleteBTCDelegationStatus(ctx, btcDel)
+	btcDel.StartHeight = startHeight
+	btcDel.EndHeight = endHeight
+	btcDel.AwaitingInclusionProof = false
+	k.setBTCDelegation(ctx, btcDel)
+	k.setBTCDelegationStatus(ctx, btcDel, types.BTCDelegationStatus_PENDING)
+	k.removeStakeSpendingDelegation(ctx, btcDel)
+
+	// record event that the BTC delegation will become unbonded at endHeight-w
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	k.addBTCDelegationExpiryEvent(ctx, btcDel, wValue)
+
+	return k.activateBTCDelegation(ctx, btcDel)
+}
+
+// activateBTCDelegation records and emits the event that the given BTC
+// delegation becomes active at the current BTC height
+func (k Keeper) activateBTCDelegation(ctx sdk.Context, btcDel *types.BTCDelegation) error {
+	// the BTC delegation no longer depends on the covenant committee
+	k.removeCovenantDelegation(ctx, btcDel)
+
+	// notify subscriber
+	event := &types.EventBTCDelegationStateUpdate{
+		StakingTxHash: btcDel.MustGetStakingTxHash().String(),
+		NewState:      types.BTCDelegationStatus_ACTIVE,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the new active BTC delegation: %w", err))
+	}
+
+	// record event that the BTC delegation becomes active at this height
+	activeEvent := types.NewEventPowerDistUpdateWithBTCDel(event)
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	k.addPowerDistUpdateEvent(ctx, btcTip.Height, activeEvent)
+
+	// upon stake expansion or redelegation, the staking tx has spent the
+	// previous staking output. The previous BTC delegation thus becomes
+	// unbonded at the same height, such that its voting power is carried over
+	// without a gap
+	if btcDel.SpendsPreviousStakingOutput() {
+		return k.unbondSpentBTCDelegation(ctx, btcDel, btcTip.Height)
+	}
+
+	return nil
+}
+
+// unbondSpentBTCDelegation marks the BTC delegation whose staking output is
+// spent by the given BTC delegation as unbonded, and records the corresponding
+// event at the given BTC height
+func (k Keeper) unbondSpentBTCDelegation(ctx sdk.Context, btcDel *types.BTCDelegation, btcHeight uint64) error {
+	prevStakingTxHash, err := chainhash.NewHash(btcDel.PreviousStakingTxHash)
+	if err != nil {
+		return types.ErrInvalidDelegationState.Wrapf("invalid previous staking tx hash: %v", err)
+	}
+	prevDel := k.getBTCDelegation(ctx, *prevStakingTxHash)
+	if prevDel == nil {
+		return types.ErrBTCDelegationNotFound.Wrapf("the previous BTC delegation %s is not found", prevStakingTxHash.String())
+	}
+	prevParams := k.GetParamsByVersion(ctx, prevDel.ParamsVersion)
+	if prevParams == nil {
+		panic("params version in BTC delegation is not found")
+	}
+
+	// the previous BTC delegation might have become unbonded in the meantime,
+	// in which case there is no voting power to carry over
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	if prevDel.GetStatus(btcHeight, wValue, prevParams.CovenantQuorum) != types.BTCDelegationStatus_ACTIVE {
+		return nil
+	}
+
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	prevDel.SpentByStakingTxHash = stakingTxHash[:]
+	k.setBTCDelegation(ctx, prevDel)
 
-		// record event that the BTC delegation becomes active at this height
-		activeEvent := types.NewEventPowerDistUpdateWithBTCDel(event)
-		btcTip := k.btclcKeeper.GetTipInfo(ctx)
-		k.addPowerDistUpdateEvent(ctx, btcTip.Height, activeEvent)
+	// notify subscriber about the spent BTC delegation
+	event := &types.EventBTCDelegationStateUpdate{
+		StakingTxHash: prevStakingTxHash.String(),
+		NewState:      types.BTCDelegationStatus_UNBONDED,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the spent BTC delegation: %w", err))
 	}
+
+	// record event that the spent BTC delegation becomes unbonded at this height
+	unbondedEvent := types.NewEventPowerDistUpdateWithBTCDel(event)
+	k.addPowerDistUpdateEvent(ctx, btcHeight, unbondedEvent)
+
+	return nil
 }
 
 // btcUndelegate adds the signature of the unbonding tx signed by the staker
@@ -136,6 +244,17 @@ func (k Keeper) btcUndelegate(
 	k.addPowerDistUpdateEvent(ctx, btcTip.Height, unbondedEvent)
 }
 
+// getBTCDelegationStatus returns the status of the given BTC delegation w.r.t.
+// the covenant quorum in the parameters of its version, which might differ
+// from the latest one after a covenant committee rotation
+func (k Keeper) getBTCDelegationStatus(ctx context.Context, btcDel *types.BTCDelegation, btcTipHeight uint64, wValue uint64) types.BTCDelegationStatus {
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		panic("params version in BTC delegation is not found")
+	}
+	return btcDel.GetStatus(btcTipHeight, wValue, params.CovenantQuorum)
+}
+
 func (k Keeper) setBTCDelegation(ctx context.Context, btcDel *types.BTCDelegation) {
 	store := k.btcDelegationStore(ctx)
 	stakingTxHash := btcDel.MustGetStakingTxHash()
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// indexDelegatorDelegation adds the given BTC delegation to the index of BTC
+// delegations by delegator, regardless of the finality providers it restakes to
+func (k Keeper) indexDelegatorDelegation(ctx context.Context, btcDel *types.BTCDelegation) {
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	k.delegatorDelegationStore(ctx, btcDel.BtcPk).Set(stakingTxHash[:], []byte{})
+}
+
+// removeDelegatorDelegation removes the given BTC delegation from the index of
+// BTC delegations by delegator
+func (k Keeper) removeDelegatorDelegation(ctx context.Context, btcDel *types.BTCDelegation) {
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	k.delegatorDelegationStore(ctx, btcDel.BtcPk).Delete(stakingTxHash[:])
+}
+
+// RebuildDelegatorDelegationIndex rebuilds the index of BTC delegations by
+// delegator from all BTC delegations in the state. This migrates a state
+// that was created before the index existed.
+func (k Keeper) RebuildDelegatorDelegationIndex(ctx context.Context) {
+	iter := k.btcDelegationStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(iter.Value(), &btcDel)
+		k.indexDelegatorDelegation(ctx, &btcDel)
+	}
+}
+
+// delegatorDelegationStore returns the KVStore of the staking tx hashes of
+// all BTC delegations made by the given delegator
+// prefix: DelegatorDelegationKey || delegator's Bitcoin secp256k1 PK
+// key: staking tx hash
+// value: empty
+func (k Keeper) delegatorDelegationStore(ctx context.Context, delBTCPK *bbn.BIP340PubKey) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	delegatorStore := prefix.NewStore(storeAdapter, types.DelegatorDelegationKey)
+	return prefix.NewStore(delegatorStore, delBTCPK.MustMarshal())
+}
@@ -40,6 +40,29 @@ func (k Keeper) setBTCDelegatorDelegationIndex(ctx context.Context, fpBTCPK, del
 	store.Set(*delBTCPK, btcDelIndexBytes)
 }
 
+// removeFromBTCDelegatorDelegationIndex removes the given staking tx hash from
+// the BTC delegation index with a given BTC PK under a given finality provider,
+// and deletes the index once it becomes empty. Unlike
+// getBTCDelegatorDelegationIndex, the finality provider does not need to be
+// stored under the given BTC PK, e.g., after its key rotation
+func (k Keeper) removeFromBTCDelegatorDelegationIndex(ctx context.Context, fpBTCPK, delBTCPK *bbn.BIP340PubKey, stakingTxHash chainhash.Hash) {
+	store := k.btcDelegatorFpStore(ctx, fpBTCPK)
+	btcDelIndexBytes := store.Get(*delBTCPK)
+	if len(btcDelIndexBytes) == 0 {
+		return
+	}
+	var btcDelIndex types.BTCDelegatorDelegationIndex
+	k.cdc.MustUnmarshal(btcDelIndexBytes, &btcDelIndex)
+	if !btcDelIndex.Remove(stakingTxHash) {
+		return
+	}
+	if len(btcDelIndex.StakingTxHashList) == 0 {
+		store.Delete(*delBTCPK)
+		return
+	}
+	k.setBTCDelegatorDelegationIndex(ctx, fpBTCPK, delBTCPK, &btcDelIndex)
+}
+
 // getBTCDelegatorDelegations gets the BTC delegations with a given BTC PK under a given finality provider
 func (k Keeper) getBTCDelegatorDelegations(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, delBTCPK *bbn.BIP340PubKey) *types.BTCDelegatorDelegations {
 	btcDelIndex := k.getBTCDelegatorDelegationIndex(ctx, fpBTCPK, delBTCPK)
@@ -60,6 +83,27 @@ func (k Keeper) getBTCDelegatorDelegations(ctx context.Context, fpBTCPK *bbn.BIP
 	return &types.BTCDelegatorDelegations{Dels: btcDels}
 }
 
+// iterateFpBTCDelegationStakingTxHashes iterates over the staking tx hashes of
+// all BTC delegations staked to the given finality provider BTC PK
+func (k Keeper) iterateFpBTCDelegationStakingTxHashes(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, handler func(stakingTxHash chainhash.Hash) bool) {
+	iter := k.btcDelegatorFpStore(ctx, fpBTCPK).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var btcDelIndex types.BTCDelegatorDelegationIndex
+		k.cdc.MustUnmarshal(iter.Value(), &btcDelIndex)
+		for _, stakingTxHashBytes := range btcDelIndex.StakingTxHashList {
+			stakingTxHash, err := chainhash.NewHash(stakingTxHashBytes)
+			if err != nil {
+				// failing to unmarshal hash bytes in DB's BTC delegation index is a programming error
+				panic(err)
+			}
+			if !handler(*stakingTxHash) {
+				return
+			}
+		}
+	}
+}
+
 // btcDelegatorFpStore returns the KVStore of the BTC delegators
 // prefix: BTCDelegatorKey || finality provider's Bitcoin secp256k1 PK
 // key: delegator's Bitcoin secp256k1 PK
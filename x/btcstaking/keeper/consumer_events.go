@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+/* BTC staking events of consumer chains */
+
+// notifyConsumersOfBTCDelegation queues the given BTC staking event to each
+// consumer chain that the given BTC delegation restakes to
+func (k Keeper) notifyConsumersOfBTCDelegation(ctx context.Context, btcDel *types.BTCDelegation, event *types.BTCStakingConsumerEvent) {
+	for _, consumerID := range k.restakedConsumerIDs(ctx, btcDel) {
+		k.addConsumerEvent(ctx, consumerID, event)
+	}
+}
+
+// notifyConsumersOfBTCDelegationStateUpdates queues the BTC delegation state
+// updates among the given power distribution update events to the consumer
+// chains that the BTC delegations restake to
+func (k Keeper) notifyConsumersOfBTCDelegationStateUpdates(ctx context.Context, events []*types.EventPowerDistUpdate) {
+	for _, event := range events {
+		delEvent := event.GetBtcDelStateUpdate()
+		if delEvent == nil {
+			continue
+		}
+		stakingTxHash, err := chainhash.NewHashFromStr(delEvent.StakingTxHash)
+		if err != nil {
+			panic(err) // only programming error
+		}
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil {
+			// the BTC delegation is already pruned
+			continue
+		}
+		k.notifyConsumersOfBTCDelegation(ctx, btcDel, types.NewConsumerEventWithBTCDel(delEvent))
+	}
+}
+
+// restakedConsumerIDs returns the IDs of the consumer chains that the given
+// BTC delegation restakes to, in the order of its finality providers
+func (k Keeper) restakedConsumerIDs(ctx context.Context, btcDel *types.BTCDelegation) []string {
+	consumerIDs := []string{}
+	seen := map[string]struct{}{}
+	for _, fpBTCPK := range btcDel.FpBtcPkList {
+		fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+		if err != nil {
+			panic(err) // only programming error
+		}
+		if fp.SecuresBabylon() {
+			continue
+		}
+		if _, ok := seen[fp.ConsumerId]; ok {
+			continue
+		}
+		seen[fp.ConsumerId] = struct{}{}
+		consumerIDs = append(consumerIDs, fp.ConsumerId)
+	}
+	return consumerIDs
+}
+
+// GetConsumerEvents returns the first `maxEvents` BTC staking events pending
+// to be sent to the consumer chain with the given chain ID, in the order they
+// happened
+func (k Keeper) GetConsumerEvents(ctx context.Context, consumerID string, maxEvents uint64) []*types.BTCStakingConsumerEvent {
+	events := []*types.BTCStakingConsumerEvent{}
+	iter := k.consumerEventStore(ctx, consumerID).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid() && uint64(len(events)) < maxEvents; iter.Next() {
+		var event types.BTCStakingConsumerEvent
+		k.cdc.MustUnmarshal(iter.Value(), &event)
+		events = append(events, &event)
+	}
+	return events
+}
+
+// RemoveConsumerEvents removes the first `numEvents` BTC staking events
+// pending to be sent to the consumer chain with the given chain ID. This is
+// called once the consumer chain acknowledges these events.
+func (k Keeper) RemoveConsumerEvents(ctx context.Context, consumerID string, numEvents uint64) {
+	store := k.consumerEventStore(ctx, consumerID)
+	keys := [][]byte{}
+
+	// using an enclosure to ensure iterator is closed right after
+	// the function is done
+	func() {
+		iter := store.Iterator(nil, nil)
+		defer iter.Close()
+		for ; iter.Valid() && uint64(len(keys)) < numEvents; iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+	}()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// addConsumerEvent appends the given BTC staking event to the queue of events
+// pending to be sent to the consumer chain with the given chain ID
+func (k Keeper) addConsumerEvent(ctx context.Context, consumerID string, event *types.BTCStakingConsumerEvent) {
+	store := k.consumerEventStore(ctx, consumerID)
+
+	// get event index
+	eventIdx := uint64(0) // event index starts from 0
+	iter := store.ReverseIterator(nil, nil)
+	defer iter.Close()
+	if iter.Valid() {
+		// if there exists events already, event index will be the subsequent one
+		eventIdx = sdk.BigEndianToUint64(iter.Key()) + 1
+	}
+
+	// key is event index, and value is the event bytes
+	store.Set(sdk.Uint64ToBigEndian(eventIdx), k.cdc.MustMarshal(event))
+}
+
+func (k Keeper) consumerEvents(ctx context.Context) []*types.ConsumerEventEntry {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iter := prefix.NewStore(storeAdapter, types.ConsumerEventKey).Iterator(nil, nil)
+	defer iter.Close()
+
+	entries := make([]*types.ConsumerEventEntry, 0)
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		consumerIDLen := int(key[0])
+		if len(key) != 1+consumerIDLen+8 {
+			panic(fmt.Errorf("invalid key of consumer event: %x", key)) // only programming error
+		}
+		var event types.BTCStakingConsumerEvent
+		k.cdc.MustUnmarshal(iter.Value(), &event)
+		entries = append(entries, &types.ConsumerEventEntry{
+			ConsumerId: string(key[1 : 1+consumerIDLen]),
+			Event:      &event,
+		})
+	}
+	return entries
+}
+
+// consumerEventStore returns the KVStore of the BTC staking events pending to
+// be sent to the consumer chain with the given chain ID
+// prefix: ConsumerEventKey || len(chain ID) || chain ID
+// key: event index
+// value: BTCStakingConsumerEvent
+func (k Keeper) consumerEventStore(ctx context.Context, consumerID string) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	eventStore := prefix.NewStore(storeAdapter, types.ConsumerEventKey)
+	return prefix.NewStore(eventStore, append([]byte{byte(len(consumerID))}, []byte(consumerID)...))
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func FuzzConsumerEvents(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// the consumer chain is notified of its new finality provider
+		consumerID := datagen.GenRandomHexStr(r, 10)
+		h.BTCStkConsumerKeeper.EXPECT().IsConsumerRegistered(gomock.Any(), consumerID).Return(true).Times(1)
+		h.BTCStkConsumerKeeper.EXPECT().AddConsumerFinalityProvider(gomock.Any(), consumerID, gomock.Any()).Return(nil).Times(1)
+		_, consumerFpPK, consumerFp := h.CreateConsumerFinalityProvider(r, consumerID)
+		events := h.BTCStakingKeeper.GetConsumerEvents(h.Ctx, consumerID, math.MaxUint64)
+		require.Len(t, events, 1)
+		require.Equal(t, consumerFp.BtcPk, events[0].GetNewFp().Fp.BtcPk)
+
+		// the consumer chain is not notified of Babylon finality providers
+		_, babylonFpPK, _ := h.CreateFinalityProvider(r)
+		require.Len(t, h.BTCStakingKeeper.GetConsumerEvents(h.Ctx, consumerID, math.MaxUint64), 1)
+
+		// the consumer chain is notified of the BTC delegation restaked to
+		// its finality provider becoming active
+		stakingValue := int64(2 * 10e8)
+		stakingTxHash, delSK, _, msgCreateBTCDel, actualDel := h.CreateRestakedDelegation(
+			r,
+			[]*btcec.PublicKey{babylonFpPK, consumerFpPK},
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		events = h.BTCStakingKeeper.GetConsumerEvents(h.Ctx, consumerID, math.MaxUint64)
+		require.Len(t, events, 2)
+		require.Equal(t, stakingTxHash, events[1].GetBtcDelStateUpdate().StakingTxHash)
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, events[1].GetBtcDelStateUpdate().NewState)
+
+		// the consumer chain is notified of the BTC delegation being unbonded
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		delUnbondingSig, err := actualDel.SignUnbondingTx(&bsParams, h.Net, delSK)
+		h.NoError(err)
+		_, err = h.MsgServer.BTCUndelegate(h.Ctx, &types.MsgBTCUndelegate{
+			Signer:         datagen.GenRandomAccount().Address,
+			StakingTxHash:  stakingTxHash,
+			UnbondingTxSig: bbn.NewBIP340SignatureFromBTCSig(delUnbondingSig),
+		})
+		h.NoError(err)
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		events = h.BTCStakingKeeper.GetConsumerEvents(h.Ctx, consumerID, math.MaxUint64)
+		require.Len(t, events, 3)
+		require.Equal(t, stakingTxHash, events[2].GetBtcDelStateUpdate().StakingTxHash)
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED, events[2].GetBtcDelStateUpdate().NewState)
+
+		// the pending events are exported in the genesis state
+		gs, err := h.BTCStakingKeeper.ExportGenesis(h.Ctx)
+		h.NoError(err)
+		require.Len(t, gs.ConsumerEvents, 3)
+		for i, entry := range gs.ConsumerEvents {
+			require.Equal(t, consumerID, entry.ConsumerId)
+			require.Equal(t, events[i], entry.Event)
+		}
+
+		// the pending events are capped at the given number
+		require.Equal(t, events[:2], h.BTCStakingKeeper.GetConsumerEvents(h.Ctx, consumerID, 2))
+
+		// removing acknowledged events keeps the subsequent ones
+		h.BTCStakingKeeper.RemoveConsumerEvents(h.Ctx, consumerID, 2)
+		require.Equal(t, events[2:], h.BTCStakingKeeper.GetConsumerEvents(h.Ctx, consumerID, math.MaxUint64))
+		h.BTCStakingKeeper.RemoveConsumerEvents(h.Ctx, consumerID, 2)
+		require.Empty(t, h.BTCStakingKeeper.GetConsumerEvents(h.Ctx, consumerID, math.MaxUint64))
+	})
+}
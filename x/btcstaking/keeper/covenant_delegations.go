@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// indexCovenantDelegation adds the given pending BTC delegation to the index
+// of pending BTC delegations by covenant member, under each covenant member in
+// the parameters of its version
+func (k Keeper) indexCovenantDelegation(ctx context.Context, btcDel *types.BTCDelegation) {
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		panic("params version in BTC delegation is not found")
+	}
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	for i := range params.CovenantPks {
+		k.covenantDelegationStore(ctx, &params.CovenantPks[i]).Set(stakingTxHash[:], []byte{})
+	}
+}
+
+// removeCovenantDelegation removes the given BTC delegation from the index of
+// pending BTC delegations by covenant member, once it is no longer pending
+func (k Keeper) removeCovenantDelegation(ctx context.Context, btcDel *types.BTCDelegation) {
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		panic("params version in BTC delegation is not found")
+	}
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	for i := range params.CovenantPks {
+		k.covenantDelegationStore(ctx, &params.CovenantPks[i]).Delete(stakingTxHash[:])
+	}
+}
+
+// RebuildCovenantDelegationIndex rebuilds the index of pending BTC
+// delegations by covenant member from all BTC delegations in the state. This
+// migrates a state that was created before the index existed.
+func (k Keeper) RebuildCovenantDelegationIndex(ctx context.Context) {
+	iter := k.btcDelegationStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	if !iter.Valid() {
+		// no BTC delegation to index
+		return
+	}
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	for ; iter.Valid(); iter.Next() {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(iter.Value(), &btcDel)
+		if k.getBTCDelegationStatus(ctx, &btcDel, btcTipHeight, wValue) == types.BTCDelegationStatus_PENDING {
+			k.indexCovenantDelegation(ctx, &btcDel)
+		}
+	}
+}
+
+// covenantDelegationStore returns the KVStore of the staking tx hashes of
+// the pending BTC delegations staked with the given covenant member
+// prefix: CovenantDelegationKey || covenant member's Bitcoin secp256k1 PK
+// key: staking tx hash
+// value: empty
+func (k Keeper) covenantDelegationStore(ctx context.Context, covPK *bbn.BIP340PubKey) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	covenantStore := prefix.NewStore(storeAdapter, types.CovenantDelegationKey)
+	return prefix.NewStore(covenantStore, covPK.MustMarshal())
+}
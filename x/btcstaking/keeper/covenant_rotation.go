@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+/* covenant committee rotation */
+
+// ProcessCovenantCommitteeRotation activates the new covenant committee if the
+// scheduled covenant committee rotation is due. This is triggered upon each
+// `BeginBlock`.
+//
+// The new covenant committee is recorded as a new version of the parameters,
+// such that only the BTC delegations created from the activation height on are
+// staked with the new covenant committee. Existing BTC delegations keep the
+// covenant committee in the parameters of their version, whose signatures
+// remain valid. Between the scheduling and the activation, the retiring
+// covenant members hand over by pre-signing the pending BTC delegations staked
+// with them. Upon the rotation, a pending BTC delegation remains pending if the
+// pre-signatures together with the continuing covenant members can still form
+// its covenant quorum, and otherwise expires.
+func (k Keeper) ProcessCovenantCommitteeRotation(ctx context.Context) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	rotation := k.GetCovenantCommitteeRotation(ctx)
+	if rotation == nil || rotation.ActivationHeight > uint64(sdkCtx.HeaderInfo().Height) {
+		return
+	}
+
+	// the rotation is consumed regardless of its outcome
+	k.deleteCovenantCommitteeRotation(ctx)
+
+	params := k.GetParams(ctx)
+	retiringPks := rotation.RetiringCovenantPks(&params)
+	expiredStakingTxHashes := k.expirePendingBTCDelegations(ctx, retiringPks)
+	if err := k.SetParams(ctx, rotation.Apply(params)); err != nil {
+		panic(fmt.Errorf("failed to apply a validated covenant committee rotation: %w", err)) // only programming error
+	}
+
+	event := &types.EventCovenantCommitteeRotated{
+		Rotation:               rotation,
+		ParamsVersion:          k.GetParamsWithVersion(ctx).Version,
+		RetiringCovenantPks:    retiringPks,
+		ExpiredStakingTxHashes: expiredStakingTxHashes,
+	}
+	if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventCovenantCommitteeRotated event: %w", err))
+	}
+}
+
+// expirePendingBTCDelegations marks the pending BTC delegations staked with
+// any of the given retiring covenant members that cannot reach their covenant
+// quorum without new signatures from the retiring covenant members as expired,
+// and returns the hashes of their staking txs
+func (k Keeper) expirePendingBTCDelegations(ctx context.Context, retiringPks []bbn.BIP340PubKey) []string {
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	retiring := make(map[string]struct{}, len(retiringPks))
+	for i := range retiringPks {
+		retiring[retiringPks[i].MarshalHex()] = struct{}{}
+	}
+
+	// collect the BTC delegations first, as a BTC delegation might be staked
+	// with several retiring covenant members. The index only contains pending
+	// BTC delegations, except for those whose timelocks expired while pending
+	pendingDels := []*types.BTCDelegation{}
+	staleDels := []*types.BTCDelegation{}
+	seen := map[chainhash.Hash]struct{}{}
+	for i := range retiringPks {
+		iter := k.covenantDelegationStore(ctx, &retiringPks[i]).Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			stakingTxHash, err := chainhash.NewHash(iter.Key())
+			if err != nil {
+				panic(err) // only programming error
+			}
+			if _, ok := seen[*stakingTxHash]; ok {
+				continue
+			}
+			seen[*stakingTxHash] = struct{}{}
+			btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+			if btcDel == nil {
+				panic(fmt.Errorf("the BTC delegation indexed under a covenant member is not found")) // only programming error
+			}
+			if k.getBTCDelegationStatus(ctx, btcDel, btcTip.Height, wValue) != types.BTCDelegationStatus_PENDING {
+				staleDels = append(staleDels, btcDel)
+				continue
+			}
+			if !k.canReachCovenantQuorumWithout(ctx, btcDel, retiring) {
+				pendingDels = append(pendingDels, btcDel)
+			}
+		}
+		iter.Close()
+	}
+
+	for _, btcDel := range staleDels {
+		k.removeCovenantDelegation(ctx, btcDel)
+	}
+
+	expiredStakingTxHashes := make([]string, 0, len(pendingDels))
+	for _, btcDel := range pendingDels {
+		btcDel.ExpiredByCovenantRotation = true
+		k.setBTCDelegation(ctx, btcDel)
+		k.removeCovenantDelegation(ctx, btcDel)
+
+		// notify subscriber about the expired BTC delegation
+		event := &types.EventBTCDelegationStateUpdate{
+			StakingTxHash: btcDel.MustGetStakingTxHash().String(),
+			NewState:      types.BTCDelegationStatus_UNBONDED,
+		}
+		if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+			panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the expired BTC delegation: %w", err))
+		}
+
+		// record event that the BTC delegation becomes unbonded at this height
+		unbondedEvent := types.NewEventPowerDistUpdateWithBTCDel(event)
+		k.addPowerDistUpdateEvent(ctx, btcTip.Height, unbondedEvent)
+
+		expiredStakingTxHashes = append(expiredStakingTxHashes, event.StakingTxHash)
+	}
+	return expiredStakingTxHashes
+}
+
+// canReachCovenantQuorumWithout returns whether the given pending BTC
+// delegation can still reach the covenant quorum of its parameters from the
+// covenant members that already signed it and the covenant members that are
+// not in the given set of retiring covenant members
+func (k Keeper) canReachCovenantQuorumWithout(ctx context.Context, btcDel *types.BTCDelegation, retiring map[string]struct{}) bool {
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		panic("params version in BTC delegation is not found")
+	}
+	numSigners := uint32(0)
+	for i := range params.CovenantPks {
+		covPK := &params.CovenantPks[i]
+		if _, ok := retiring[covPK.MarshalHex()]; !ok || btcDel.IsSignedByCovMember(covPK) {
+			numSigners++
+		}
+	}
+	return numSigners >= params.CovenantQuorum
+}
+
+/* covenant committee rotation storage */
+
+func (k Keeper) setCovenantCommitteeRotation(ctx context.Context, rotation *types.CovenantCommitteeRotation) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.CovenantRotationKey, k.cdc.MustMarshal(rotation))
+}
+
+// GetCovenantCommitteeRotation returns the scheduled covenant committee
+// rotation, or nil if there is none
+func (k Keeper) GetCovenantCommitteeRotation(ctx context.Context) *types.CovenantCommitteeRotation {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	rotationBytes := store.Get(types.CovenantRotationKey)
+	if len(rotationBytes) == 0 {
+		return nil
+	}
+	var rotation types.CovenantCommitteeRotation
+	k.cdc.MustUnmarshal(rotationBytes, &rotation)
+	return &rotation
+}
+
+func (k Keeper) deleteCovenantCommitteeRotation(ctx context.Context) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.CovenantRotationKey)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/store/prefix"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -19,18 +20,22 @@ func (k Keeper) SetFinalityProvider(ctx context.Context, fp *types.FinalityProvi
 }
 
 // HasFinalityProvider checks if the finality provider exists
+// The given BTC PK can also be the new BTC PK of a scheduled key rotation, or
+// a BTC PK that the finality provider has rotated from
 func (k Keeper) HasFinalityProvider(ctx context.Context, fpBTCPK []byte) bool {
 	store := k.finalityProviderStore(ctx)
-	return store.Has(fpBTCPK)
+	return store.Has(k.resolveFinalityProviderBTCPK(ctx, fpBTCPK))
 }
 
 // GetFinalityProvider gets the finality provider with the given finality provider Bitcoin PK
+// The given BTC PK can also be the new BTC PK of a scheduled key rotation, or
+// a BTC PK that the finality provider has rotated from
 func (k Keeper) GetFinalityProvider(ctx context.Context, fpBTCPK []byte) (*types.FinalityProvider, error) {
 	store := k.finalityProviderStore(ctx)
 	if !k.HasFinalityProvider(ctx, fpBTCPK) {
 		return nil, types.ErrFpNotFound
 	}
-	fpBytes := store.Get(fpBTCPK)
+	fpBytes := store.Get(k.resolveFinalityProviderBTCPK(ctx, fpBTCPK))
 	var fp types.FinalityProvider
 	k.cdc.MustUnmarshal(fpBytes, &fp)
 	return &fp, nil
@@ -67,6 +72,75 @@ func (k Keeper) SlashFinalityProvider(ctx context.Context, fpBTCPK []byte) error
 	return nil
 }
 
+// JailFinalityProvider jails a finality provider with the given PK until the
+// given time. A jailed finality provider will not have voting power until it
+// is unjailed
+func (k Keeper) JailFinalityProvider(ctx context.Context, fpBTCPK []byte, jailedUntil time.Time) error {
+	// ensure finality provider exists
+	fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+	if err != nil {
+		return err
+	}
+
+	// ensure finality provider is not slashed or jailed yet
+	if fp.IsSlashed() {
+		return types.ErrFpAlreadySlashed
+	}
+	if fp.IsJailed() {
+		return types.ErrFpAlreadyJailed
+	}
+
+	// set finality provider to be jailed
+	fp.JailedUntil = &jailedUntil
+	k.SetFinalityProvider(ctx, fp)
+
+	// record jailed event. The next `BeginBlock` will consume this
+	// event for updating the finality provider set
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if btcTip == nil {
+		return fmt.Errorf("failed to get current BTC tip")
+	}
+	powerUpdateEvent := types.NewEventPowerDistUpdateWithJailedFP(fp.BtcPk)
+	k.addPowerDistUpdateEvent(ctx, btcTip.Height, powerUpdateEvent)
+
+	return nil
+}
+
+// UnjailFinalityProvider unjails a jailed finality provider with the given PK,
+// such that it can receive voting power again. It is up to the caller to
+// ensure the jail period has passed
+func (k Keeper) UnjailFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+	// ensure finality provider exists
+	fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+	if err != nil {
+		return err
+	}
+
+	// ensure finality provider is jailed and not slashed
+	if fp.IsSlashed() {
+		return types.ErrFpAlreadySlashed
+	}
+	if !fp.IsJailed() {
+		return types.ErrFpNotJailed
+	}
+
+	// unjail the finality provider, which is no longer considered sluggish
+	fp.JailedUntil = nil
+	fp.Sluggish = false
+	k.SetFinalityProvider(ctx, fp)
+
+	// record unjailed event. The next `BeginBlock` will consume this
+	// event for updating the finality provider set
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if btcTip == nil {
+		return fmt.Errorf("failed to get current BTC tip")
+	}
+	powerUpdateEvent := types.NewEventPowerDistUpdateWithUnjailedFP(fp.BtcPk)
+	k.addPowerDistUpdateEvent(ctx, btcTip.Height, powerUpdateEvent)
+
+	return nil
+}
+
 // RevertSluggishFinalityProvider sets the Sluggish flag of the given finality provider
 // to false
 func (k Keeper) RevertSluggishFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
@@ -0,0 +1,292 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+/* finality provider key rotation */
+
+// ProcessFinalityProviderKeyRotations re-keys all finality providers whose
+// key rotations are scheduled at or before the current height.
+// This is triggered upon each `BeginBlock`, before updating the voting power
+// distribution, such that the new BTC PK takes effect at the rotation height.
+func (k Keeper) ProcessFinalityProviderKeyRotations(ctx context.Context) {
+	height := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+
+	dueRotations := []*types.FinalityProviderKeyRotation{}
+	k.iterateFinalityProviderKeyRotations(ctx, height, func(rotation *types.FinalityProviderKeyRotation) bool {
+		dueRotations = append(dueRotations, rotation)
+		return true
+	})
+
+	for _, rotation := range dueRotations {
+		k.rotateFinalityProviderKey(ctx, rotation)
+	}
+}
+
+// rotateFinalityProviderKey re-keys the finality provider from the old BTC PK
+// to the new BTC PK of the given key rotation
+func (k Keeper) rotateFinalityProviderKey(ctx context.Context, rotation *types.FinalityProviderKeyRotation) {
+	oldBTCPK, newBTCPK := rotation.OldBtcPk, rotation.NewBtcPk
+
+	fp, err := k.GetFinalityProvider(ctx, *oldBTCPK)
+	if err != nil {
+		panic(fmt.Errorf("failed to get the finality provider with scheduled key rotation: %w", err)) // only programming error
+	}
+
+	// the key rotation is consumed regardless of its outcome
+	k.deleteFinalityProviderKeyRotation(ctx, rotation.RotationHeight, oldBTCPK)
+	k.deleteFinalityProviderKeyAlias(ctx, newBTCPK)
+
+	// a slashed finality provider does not have voting power anymore, and
+	// there is nothing to re-key
+	if fp.IsSlashed() {
+		return
+	}
+
+	// re-key the finality provider. BTC delegations staked to the old BTC PK
+	// cannot be slashed via the new BTC PK, and thus lose their voting power
+	// from this height on, until they are migrated to the new BTC PK via
+	// `MsgBTCRedelegate`
+	k.finalityProviderStore(ctx).Delete(*oldBTCPK)
+	fp.BtcPk = newBTCPK
+	fp.Pop = rotation.NewPop
+	k.SetFinalityProvider(ctx, fp)
+
+	// the old BTC PK and all BTC PKs rotated before it become aliases of the
+	// new BTC PK, such that the finality provider remains accountable, e.g.,
+	// can be slashed, for the signatures made by its previous BTC PKs
+	staleAliases := []*types.FinalityProviderKeyAlias{}
+	k.iterateFinalityProviderKeyAliases(ctx, func(alias *types.FinalityProviderKeyAlias) bool {
+		if alias.FpBtcPk.Equals(oldBTCPK) {
+			staleAliases = append(staleAliases, alias)
+		}
+		return true
+	})
+	for _, alias := range staleAliases {
+		k.setFinalityProviderKeyAlias(ctx, alias.BtcPk, newBTCPK)
+	}
+	k.setFinalityProviderKeyAlias(ctx, oldBTCPK, newBTCPK)
+
+	// record key rotation event. The voting power distribution update in the
+	// same `BeginBlock` will consume this event, such that the voting power
+	// is recorded under the new BTC PK from this height on
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if btcTip == nil {
+		panic(fmt.Errorf("failed to get current BTC tip"))
+	}
+	powerUpdateEvent := types.NewEventPowerDistUpdateWithFPKeyRotation(oldBTCPK, newBTCPK)
+	k.addPowerDistUpdateEvent(ctx, btcTip.Height, powerUpdateEvent)
+
+	// re-key the states of other modules, e.g., signing info in x/finality
+	if err := k.hooks.AfterFinalityProviderKeyRotated(ctx, oldBTCPK, newBTCPK); err != nil {
+		panic(fmt.Errorf("failed to execute after finality provider %s is re-keyed to %s: %w", oldBTCPK.MarshalHex(), newBTCPK.MarshalHex(), err))
+	}
+
+	event := &types.EventFinalityProviderKeyRotated{Rotation: rotation}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventFinalityProviderKeyRotated event: %w", err))
+	}
+}
+
+// votingPowerFpBTCPK returns the BTC PK under which the voting power of a BTC
+// delegation staked to the given BTC PK is recorded, and whether the BTC
+// delegation has voting power at all. A BTC delegation staked to
+//   - the BTC PK of a finality provider is counted under this BTC PK,
+//   - the new BTC PK of a scheduled key rotation is counted under the finality
+//     provider's current BTC PK, and
+//   - a rotated BTC PK does not have voting power, as the new BTC PK cannot
+//     be used for slashing it
+func (k Keeper) votingPowerFpBTCPK(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) (*bbn.BIP340PubKey, bool) {
+	aliasedBTCPK := k.getFinalityProviderKeyAlias(ctx, fpBTCPK)
+	if aliasedBTCPK == nil {
+		return fpBTCPK, true
+	}
+	rotation := k.getFinalityProviderKeyRotation(ctx, aliasedBTCPK)
+	if rotation != nil && rotation.NewBtcPk.Equals(fpBTCPK) {
+		return aliasedBTCPK, true
+	}
+	return nil, false
+}
+
+// validateFpBTCPKForDelegation ensures a new BTC delegation is staked to the
+// BTC PK that the given finality provider uses after its scheduled key
+// rotation, if any
+func (k Keeper) validateFpBTCPKForDelegation(ctx context.Context, fp *types.FinalityProvider, fpBTCPK *bbn.BIP340PubKey) error {
+	rotation := k.getFinalityProviderKeyRotation(ctx, fp.BtcPk)
+	if rotation != nil {
+		if !rotation.NewBtcPk.Equals(fpBTCPK) {
+			return types.ErrFpKeyRotated.Wrapf("the finality provider is rotating its BTC PK to %s", rotation.NewBtcPk.MarshalHex())
+		}
+		return nil
+	}
+	if !fp.BtcPk.Equals(fpBTCPK) {
+		return types.ErrFpKeyRotated.Wrapf("the finality provider has rotated its BTC PK to %s", fp.BtcPk.MarshalHex())
+	}
+	return nil
+}
+
+// isStakedToFpBTCPK returns whether the BTC delegation with the given staking
+// tx hash is staked to the given finality provider BTC PK
+func (k Keeper) isStakedToFpBTCPK(ctx context.Context, stakingTxHash string, fpBTCPK *bbn.BIP340PubKey) bool {
+	btcDel, err := k.GetBTCDelegation(ctx, stakingTxHash)
+	if err != nil {
+		panic(err) // only programming error
+	}
+	return btcDel.GetFpIdx(fpBTCPK) != -1
+}
+
+/* finality provider key rotation storage */
+
+func (k Keeper) setFinalityProviderKeyRotation(ctx context.Context, rotation *types.FinalityProviderKeyRotation) {
+	store := k.fpKeyRotationStore(ctx)
+	store.Set(fpKeyRotationKey(rotation.RotationHeight, rotation.OldBtcPk), k.cdc.MustMarshal(rotation))
+	heightStore := k.fpKeyRotationHeightStore(ctx)
+	heightStore.Set(rotation.OldBtcPk.MustMarshal(), sdk.Uint64ToBigEndian(rotation.RotationHeight))
+}
+
+// HasFinalityProviderKeyRotation checks if the finality provider with the
+// given BTC PK has a scheduled key rotation
+func (k Keeper) HasFinalityProviderKeyRotation(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) bool {
+	heightStore := k.fpKeyRotationHeightStore(ctx)
+	return heightStore.Has(fpBTCPK.MustMarshal())
+}
+
+func (k Keeper) getFinalityProviderKeyRotation(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) *types.FinalityProviderKeyRotation {
+	heightStore := k.fpKeyRotationHeightStore(ctx)
+	heightBytes := heightStore.Get(fpBTCPK.MustMarshal())
+	if len(heightBytes) == 0 {
+		return nil
+	}
+	store := k.fpKeyRotationStore(ctx)
+	rotationBytes := store.Get(fpKeyRotationKey(sdk.BigEndianToUint64(heightBytes), fpBTCPK))
+	if len(rotationBytes) == 0 {
+		panic(fmt.Errorf("the key rotation of finality provider %s is indexed but not found", fpBTCPK.MarshalHex())) // only programming error
+	}
+	var rotation types.FinalityProviderKeyRotation
+	k.cdc.MustUnmarshal(rotationBytes, &rotation)
+	return &rotation
+}
+
+func (k Keeper) deleteFinalityProviderKeyRotation(ctx context.Context, rotationHeight uint64, fpBTCPK *bbn.BIP340PubKey) {
+	store := k.fpKeyRotationStore(ctx)
+	store.Delete(fpKeyRotationKey(rotationHeight, fpBTCPK))
+	heightStore := k.fpKeyRotationHeightStore(ctx)
+	heightStore.Delete(fpBTCPK.MustMarshal())
+}
+
+// iterateFinalityProviderKeyRotations iterates over the key rotations
+// scheduled at or before the given height, in the ascending order of rotation
+// heights
+func (k Keeper) iterateFinalityProviderKeyRotations(ctx context.Context, maxHeight uint64, handler func(rotation *types.FinalityProviderKeyRotation) bool) {
+	store := k.fpKeyRotationStore(ctx)
+	var end []byte
+	if maxHeight < math.MaxUint64 {
+		end = sdk.Uint64ToBigEndian(maxHeight + 1)
+	}
+	iter := store.Iterator(nil, end)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rotation types.FinalityProviderKeyRotation
+		k.cdc.MustUnmarshal(iter.Value(), &rotation)
+		if !handler(&rotation) {
+			break
+		}
+	}
+}
+
+func fpKeyRotationKey(rotationHeight uint64, fpBTCPK *bbn.BIP340PubKey) []byte {
+	return append(sdk.Uint64ToBigEndian(rotationHeight), fpBTCPK.MustMarshal()...)
+}
+
+// fpKeyRotationStore returns the KVStore of the scheduled finality provider
+// key rotations
+// prefix: FpKeyRotationKey
+// key: (rotation height || old BTC PK of the finality provider)
+// value: FinalityProviderKeyRotation
+func (k Keeper) fpKeyRotationStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.FpKeyRotationKey)
+}
+
+// fpKeyRotationHeightStore returns the KVStore of the rotation heights of the
+// scheduled finality provider key rotations, which indexes them by finality
+// provider
+// prefix: FpKeyRotationHeightKey
+// key: old BTC PK of the finality provider
+// value: rotation height
+func (k Keeper) fpKeyRotationHeightStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.FpKeyRotationHeightKey)
+}
+
+// resolveFinalityProviderBTCPK returns the BTC PK under which the finality
+// provider with the given BTC PK is stored
+func (k Keeper) resolveFinalityProviderBTCPK(ctx context.Context, fpBTCPK []byte) []byte {
+	aliasedBTCPK := k.fpKeyAliasStore(ctx).Get(fpBTCPK)
+	if len(aliasedBTCPK) == 0 {
+		return fpBTCPK
+	}
+	return aliasedBTCPK
+}
+
+func (k Keeper) setFinalityProviderKeyAlias(ctx context.Context, btcPK *bbn.BIP340PubKey, fpBTCPK *bbn.BIP340PubKey) {
+	store := k.fpKeyAliasStore(ctx)
+	store.Set(btcPK.MustMarshal(), fpBTCPK.MustMarshal())
+}
+
+func (k Keeper) getFinalityProviderKeyAlias(ctx context.Context, btcPK *bbn.BIP340PubKey) *bbn.BIP340PubKey {
+	store := k.fpKeyAliasStore(ctx)
+	fpBTCPKBytes := store.Get(btcPK.MustMarshal())
+	if len(fpBTCPKBytes) == 0 {
+		return nil
+	}
+	fpBTCPK, err := bbn.NewBIP340PubKey(fpBTCPKBytes)
+	if err != nil {
+		panic(err) // only programming error
+	}
+	return fpBTCPK
+}
+
+func (k Keeper) deleteFinalityProviderKeyAlias(ctx context.Context, btcPK *bbn.BIP340PubKey) {
+	store := k.fpKeyAliasStore(ctx)
+	store.Delete(btcPK.MustMarshal())
+}
+
+func (k Keeper) iterateFinalityProviderKeyAliases(ctx context.Context, handler func(alias *types.FinalityProviderKeyAlias) bool) {
+	store := k.fpKeyAliasStore(ctx)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		btcPK, err := bbn.NewBIP340PubKey(iter.Key())
+		if err != nil {
+			panic(err) // only programming error
+		}
+		fpBTCPK, err := bbn.NewBIP340PubKey(iter.Value())
+		if err != nil {
+			panic(err) // only programming error
+		}
+		if !handler(&types.FinalityProviderKeyAlias{BtcPk: btcPK, FpBtcPk: fpBTCPK}) {
+			break
+		}
+	}
+}
+
+// fpKeyAliasStore returns the KVStore of the finality provider BTC PK aliases,
+// including the new BTC PKs of scheduled key rotations and the rotated BTC PKs
+// prefix: FpKeyAliasKey
+// key: aliased BTC PK
+// value: BTC PK under which the finality provider is stored
+func (k Keeper) fpKeyAliasStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.FpKeyAliasKey)
+}
@@ -3,10 +3,12 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"math"
 
 	btcstk "github.com/babylonchain/babylon/btcstaking"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -21,6 +23,9 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 	}
 
 	for _, fp := range gs.FinalityProviders {
+		// finality providers exported before the max commission rates were
+		// introduced get the default ones
+		fp.SetDefaultMaxRates()
 		k.SetFinalityProvider(ctx, fp)
 	}
 
@@ -55,6 +60,39 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 		k.setVotingPowerDistCache(ctx, vpCache.BlockHeight, vpCache.VpDistribution)
 	}
 
+	for _, rotation := range gs.FpKeyRotations {
+		k.setFinalityProviderKeyRotation(ctx, rotation)
+	}
+
+	for _, alias := range gs.FpKeyAliases {
+		k.setFinalityProviderKeyAlias(ctx, alias.BtcPk, alias.FpBtcPk)
+	}
+
+	if gs.CovenantRotation != nil {
+		k.setCovenantCommitteeRotation(ctx, gs.CovenantRotation)
+	}
+
+	for _, entry := range gs.PruneQueue {
+		stakingTxHash, err := chainhash.NewHashFromStr(entry.StakingTxHashHex)
+		if err != nil {
+			return err
+		}
+		k.setBTCDelegationPruneEntry(ctx, entry.BtcHeight, *stakingTxHash)
+	}
+
+	// the indexes of BTC delegations by delegator, by covenant member, by
+	// status and by the spent BTC delegation are not part of the genesis
+	// state, and are rebuilt from the BTC delegations such that genesis states
+	// exported before the indexes existed are migrated
+	k.RebuildDelegatorDelegationIndex(ctx)
+	k.RebuildCovenantDelegationIndex(ctx)
+	k.RebuildBTCDelegationStatusIndex(ctx)
+	k.RebuildStakeSpendingDelegationIndex(ctx)
+
+	for _, entry := range gs.ConsumerEvents {
+		k.addConsumerEvent(ctx, entry.ConsumerId, entry.Event)
+	}
+
 	return nil
 }
 
@@ -99,6 +137,11 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		BtcDelegators:     btcDels,
 		Events:            evts,
 		VpDstCache:        vpsCache,
+		FpKeyRotations:    k.fpKeyRotations(ctx),
+		FpKeyAliases:      k.fpKeyAliases(ctx),
+		CovenantRotation:  k.GetCovenantCommitteeRotation(ctx),
+		PruneQueue:        k.btcDelegationPruneQueue(ctx),
+		ConsumerEvents:    k.consumerEvents(ctx),
 	}, nil
 }
 
@@ -112,6 +155,10 @@ func (k Keeper) finalityProviders(ctx context.Context) ([]*types.FinalityProvide
 		if err := fp.Unmarshal(iter.Value()); err != nil {
 			return nil, err
 		}
+		// finality providers registered before the max commission rates were
+		// introduced are exported with the default ones, such that the
+		// exported genesis passes validation
+		fp.SetDefaultMaxRates()
 		fps = append(fps, &fp)
 	}
 
@@ -246,6 +293,33 @@ func (k Keeper) votingPowersDistCacheBlkHeight(ctx context.Context) ([]*types.Vo
 	return vps, nil
 }
 
+func (k Keeper) fpKeyRotations(ctx context.Context) []*types.FinalityProviderKeyRotation {
+	rotations := make([]*types.FinalityProviderKeyRotation, 0)
+	k.iterateFinalityProviderKeyRotations(ctx, math.MaxUint64, func(rotation *types.FinalityProviderKeyRotation) bool {
+		rotations = append(rotations, rotation)
+		return true
+	})
+	return rotations
+}
+
+func (k Keeper) fpKeyAliases(ctx context.Context) []*types.FinalityProviderKeyAlias {
+	aliases := make([]*types.FinalityProviderKeyAlias, 0)
+	k.iterateFinalityProviderKeyAliases(ctx, func(alias *types.FinalityProviderKeyAlias) bool {
+		aliases = append(aliases, alias)
+		return true
+	})
+	return aliases
+}
+
+func (k Keeper) btcDelegationPruneQueue(ctx context.Context) []*types.BTCDelegationPruneEntry {
+	entries := make([]*types.BTCDelegationPruneEntry, 0)
+	k.iterateBTCDelegationPruneEntries(ctx, math.MaxUint64, func(entry *types.BTCDelegationPruneEntry) bool {
+		entries = append(entries, entry)
+		return true
+	})
+	return entries
+}
+
 func (k Keeper) setBlockHeightChains(ctx context.Context, blocks *types.BlockHeightBbnToBtc) {
 	store := k.btcHeightStore(ctx)
 	store.Set(sdk.Uint64ToBigEndian(blocks.BlockHeightBbn), sdk.Uint64ToBigEndian(blocks.BlockHeightBtc))
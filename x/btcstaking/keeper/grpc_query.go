@@ -4,6 +4,7 @@ import (
 	"context"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -13,6 +14,7 @@ import (
 
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
+	itypes "github.com/babylonchain/babylon/x/incentive/types"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -79,36 +81,71 @@ func (k Keeper) FinalityProvider(c context.Context, req *types.QueryFinalityProv
 	return &types.QueryFinalityProviderResponse{FinalityProvider: fpResp}, nil
 }
 
-// BTCDelegations returns all BTC delegations under a given status
+// BTCDelegations returns all BTC delegations under a given status. BTC
+// delegations under a specific status are retrieved from the BTC delegation
+// status index, such that the query does not scan all BTC delegations
 func (k Keeper) BTCDelegations(ctx context.Context, req *types.QueryBTCDelegationsRequest) (*types.QueryBTCDelegationsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	covenantQuorum := k.GetParams(ctx).CovenantQuorum
-
 	// get current BTC height
 	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+
+	if req.Status == types.BTCDelegationStatus_ANY {
+		if req.ExpiringWithinBtcBlocks != 0 {
+			return nil, status.Error(codes.InvalidArgument, "querying expiring BTC delegations requires a status other than ANY")
+		}
+		return k.allBTCDelegations(ctx, req.Pagination, btcTipHeight)
+	}
+
+	var btcDels []*types.BTCDelegationResponse
+	onResult := func(key []byte, _ []byte) error {
+		_, stakingTxHash := parseBTCDelegationStatusKey(key)
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil {
+			return status.Errorf(codes.Internal, "indexed BTC delegation %s is not found", stakingTxHash.String())
+		}
+		btcDels = append(btcDels, types.NewBTCDelegationResponse(btcDel, req.Status))
+		return nil
+	}
+
+	store := k.btcDelegationStatusStore(ctx, req.Status)
+	var (
+		pageRes *query.PageResponse
+		err     error
+	)
+	if req.ExpiringWithinBtcBlocks != 0 {
+		// the index is ordered by end heights, thus the expiring BTC
+		// delegations are at the beginning of the index
+		maxEndHeight := btcTipHeight + req.ExpiringWithinBtcBlocks
+		pageRes, err = paginateUntil(store, sdk.Uint64ToBigEndian(maxEndHeight+1), req.Pagination, onResult)
+	} else {
+		pageRes, err = query.Paginate(store, req.Pagination, onResult)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBTCDelegationsResponse{
+		BtcDelegations: btcDels,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// allBTCDelegations returns all BTC delegations regardless of their status
+func (k Keeper) allBTCDelegations(ctx context.Context, pageReq *query.PageRequest, btcTipHeight uint64) (*types.QueryBTCDelegationsResponse, error) {
 	// get value of w
 	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
 
 	store := k.btcDelegationStore(ctx)
 	var btcDels []*types.BTCDelegationResponse
-	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+	pageRes, err := query.Paginate(store, pageReq, func(_ []byte, value []byte) error {
 		var btcDel types.BTCDelegation
 		k.cdc.MustUnmarshal(value, &btcDel)
-
-		// hit if the queried status is ANY or matches the BTC delegation status
-		status := btcDel.GetStatus(btcTipHeight, wValue, covenantQuorum)
-		if req.Status == types.BTCDelegationStatus_ANY || status == req.Status {
-			if accumulate {
-				resp := types.NewBTCDelegationResponse(&btcDel, status)
-				btcDels = append(btcDels, resp)
-			}
-			return true, nil
-		}
-
-		return false, nil
+		status := k.getBTCDelegationStatus(ctx, &btcDel, btcTipHeight, wValue)
+		btcDels = append(btcDels, types.NewBTCDelegationResponse(&btcDel, status))
+		return nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -120,6 +157,37 @@ func (k Keeper) BTCDelegations(ctx context.Context, req *types.QueryBTCDelegatio
 	}, nil
 }
 
+// paginateUntil paginates over the entries of the given store with keys lower
+// than the given end key. Unlike query.Paginate, it stops at the end key, but
+// only supports key-based pagination
+func paginateUntil(store prefix.Store, end []byte, pageReq *query.PageRequest, onResult func(key, value []byte) error) (*query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 || pageReq.CountTotal || pageReq.Reverse {
+		return nil, status.Error(codes.InvalidArgument, "only key-based pagination is supported")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	iter := store.Iterator(pageReq.Key, end)
+	defer iter.Close()
+
+	count := uint64(0)
+	for ; iter.Valid(); iter.Next() {
+		if count == limit {
+			return &query.PageResponse{NextKey: iter.Key()}, nil
+		}
+		if err := onResult(iter.Key(), iter.Value()); err != nil {
+			return nil, err
+		}
+		count++
+	}
+	return &query.PageResponse{}, nil
+}
+
 // FinalityProviderPowerAtHeight returns the voting power of the specified finality provider
 // at the provided Babylon height
 func (k Keeper) FinalityProviderPowerAtHeight(ctx context.Context, req *types.QueryFinalityProviderPowerAtHeightRequest) (*types.QueryFinalityProviderPowerAtHeightResponse, error) {
@@ -240,7 +308,6 @@ func (k Keeper) FinalityProviderDelegations(ctx context.Context, req *types.Quer
 
 	currentWValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
 	btcHeight := k.btclcKeeper.GetTipInfo(ctx).Height
-	covenantQuorum := k.GetParams(ctx).CovenantQuorum
 
 	btcDels := []*types.BTCDelegatorDelegationsResponse{}
 	pageRes, err := query.Paginate(btcDelStore, req.Pagination, func(key, value []byte) error {
@@ -253,11 +320,7 @@ func (k Keeper) FinalityProviderDelegations(ctx context.Context, req *types.Quer
 
 		btcDelsResp := make([]*types.BTCDelegationResponse, len(curBTCDels.Dels))
 		for i, btcDel := range curBTCDels.Dels {
-			status := btcDel.GetStatus(
-				btcHeight,
-				currentWValue,
-				covenantQuorum,
-			)
+			status := k.getBTCDelegationStatus(ctx, btcDel, btcHeight, currentWValue)
 			btcDelsResp[i] = types.NewBTCDelegationResponse(btcDel, status)
 		}
 
@@ -273,6 +336,76 @@ func (k Keeper) FinalityProviderDelegations(ctx context.Context, req *types.Quer
 	return &types.QueryFinalityProviderDelegationsResponse{BtcDelegatorDelegations: btcDels, Pagination: pageRes}, nil
 }
 
+// DelegatorDelegations returns all BTC delegations of the given BTC delegator
+// across all finality providers, together with their voting power and the
+// rewards of their staker addresses
+func (k Keeper) DelegatorDelegations(ctx context.Context, req *types.QueryDelegatorDelegationsRequest) (*types.QueryDelegatorDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.DelBtcPkHex) == 0 {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "BTC delegator public key cannot be empty")
+	}
+
+	delBTCPK, err := bbn.NewBIP340PubKeyFromHex(req.DelBtcPkHex)
+	if err != nil {
+		return nil, err
+	}
+
+	currentWValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	btcHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+
+	store := k.delegatorDelegationStore(ctx, delBTCPK)
+	dels := []*types.DelegatorDelegationResponse{}
+	rewards := []*types.StakerRewardResponse{}
+	stakerAddrs := map[string]bool{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		stakingTxHash, err := chainhash.NewHash(key)
+		if err != nil {
+			return err
+		}
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil {
+			return types.ErrBTCDelegationNotFound.Wrapf("staking tx hash: %s", stakingTxHash.String())
+		}
+
+		status := k.getBTCDelegationStatus(ctx, btcDel, btcHeight, currentWValue)
+		votingPower := uint64(0)
+		if status == types.BTCDelegationStatus_ACTIVE {
+			votingPower = btcDel.TotalSat
+		}
+		dels = append(dels, &types.DelegatorDelegationResponse{
+			BtcDelegation: types.NewBTCDelegationResponse(btcDel, status),
+			VotingPower:   votingPower,
+		})
+
+		// BTC delegations of the same delegator may share a staker address
+		if !stakerAddrs[btcDel.StakerAddr] {
+			stakerAddrs[btcDel.StakerAddr] = true
+			stakerAddr, err := sdk.AccAddressFromBech32(btcDel.StakerAddr)
+			if err != nil {
+				return err
+			}
+			rewards = append(rewards, &types.StakerRewardResponse{
+				StakerAddr:  btcDel.StakerAddr,
+				RewardGauge: k.iKeeper.GetRewardGauge(ctx, itypes.BTCDelegationType, stakerAddr),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegatorDelegationsResponse{
+		Delegations: dels,
+		Rewards:     rewards,
+		Pagination:  pageRes,
+	}, nil
+}
+
 // BTCDelegation returns existing btc delegation by staking tx hash
 func (k Keeper) BTCDelegation(ctx context.Context, req *types.QueryBTCDelegationRequest) (*types.QueryBTCDelegationResponse, error) {
 	if req == nil {
@@ -288,17 +421,100 @@ func (k Keeper) BTCDelegation(ctx context.Context, req *types.QueryBTCDelegation
 	// find BTC delegation
 	btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
 	if btcDel == nil {
+		// a pruned BTC delegation is unbonded
+		if k.archive != nil {
+			if archivedDel, err := k.archive.GetArchivedBTCDelegation(*stakingTxHash); err == nil {
+				return &types.QueryBTCDelegationResponse{
+					BtcDelegation: types.NewBTCDelegationResponse(archivedDel, types.BTCDelegationStatus_UNBONDED),
+				}, nil
+			}
+		}
 		return nil, types.ErrBTCDelegationNotFound
 	}
 
 	currentWValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
-	status := btcDel.GetStatus(
-		k.btclcKeeper.GetTipInfo(ctx).Height,
-		currentWValue,
-		k.GetParams(ctx).CovenantQuorum,
-	)
+	status := k.getBTCDelegationStatus(ctx, btcDel, k.btclcKeeper.GetTipInfo(ctx).Height, currentWValue)
 
 	return &types.QueryBTCDelegationResponse{
 		BtcDelegation: types.NewBTCDelegationResponse(btcDel, status),
 	}, nil
 }
+
+// CovenantCommitteeRotation returns the scheduled covenant committee rotation
+func (k Keeper) CovenantCommitteeRotation(ctx context.Context, req *types.QueryCovenantCommitteeRotationRequest) (*types.QueryCovenantCommitteeRotationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	rotation := k.GetCovenantCommitteeRotation(ctx)
+	if rotation == nil {
+		return nil, status.Error(codes.NotFound, "there is no scheduled covenant committee rotation")
+	}
+	params := k.GetParams(ctx)
+
+	return &types.QueryCovenantCommitteeRotationResponse{
+		Rotation:            rotation,
+		RetiringCovenantPks: rotation.RetiringCovenantPks(&params),
+	}, nil
+}
+
+// CovenantMemberDelegations returns all pending BTC delegations whose covenant
+// committee includes the given covenant member, i.e., the BTC delegations that
+// still depend on the given covenant member for forming their covenant quorums
+func (k Keeper) CovenantMemberDelegations(ctx context.Context, req *types.QueryCovenantMemberDelegationsRequest) (*types.QueryCovenantMemberDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	covPK, err := bbn.NewBIP340PubKeyFromHex(req.CovenantPkHex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	// BTC delegations share a few versions of parameters, thus cache them
+	paramsByVersion := map[uint32]*types.Params{}
+
+	store := k.covenantDelegationStore(ctx, covPK)
+	var btcDels []*types.BTCDelegationResponse
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		stakingTxHash, err := chainhash.NewHash(key)
+		if err != nil {
+			return false, err
+		}
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil {
+			return false, types.ErrBTCDelegationNotFound.Wrapf("staking tx hash: %s", stakingTxHash.String())
+		}
+
+		params, ok := paramsByVersion[btcDel.ParamsVersion]
+		if !ok {
+			params = k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+			if params == nil {
+				return false, types.ErrParamsNotFound.Wrapf("version: %d", btcDel.ParamsVersion)
+			}
+			paramsByVersion[btcDel.ParamsVersion] = params
+		}
+
+		// only pending BTC delegations still depend on the covenant committee
+		status := btcDel.GetStatus(btcTipHeight, wValue, params.CovenantQuorum)
+		if status != types.BTCDelegationStatus_PENDING {
+			return false, nil
+		}
+
+		if accumulate {
+			btcDels = append(btcDels, types.NewBTCDelegationResponse(btcDel, status))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCovenantMemberDelegationsResponse{
+		BtcDelegations: btcDels,
+		Pagination:     pageRes,
+	}, nil
+}
@@ -18,6 +18,7 @@ import (
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
+	itypes "github.com/babylonchain/babylon/x/incentive/types"
 )
 
 func FuzzActivatedHeight(f *testing.F) {
@@ -26,7 +27,7 @@ func FuzzActivatedHeight(f *testing.F) {
 		r := rand.New(rand.NewSource(seed))
 
 		// Setup keeper and context
-		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil, nil, nil)
 		ctx = sdk.UnwrapSDKContext(ctx)
 
 		// not activated yet
@@ -51,7 +52,7 @@ func FuzzFinalityProviders(f *testing.F) {
 		r := rand.New(rand.NewSource(seed))
 
 		// Setup keeper and context
-		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil, nil, nil)
 		ctx = sdk.UnwrapSDKContext(ctx)
 
 		// Generate random finality providers and add them to kv store
@@ -116,7 +117,7 @@ func FuzzFinalityProvider(f *testing.F) {
 	f.Fuzz(func(t *testing.T, seed int64) {
 		r := rand.New(rand.NewSource(seed))
 		// Setup keeper and context
-		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil, nil, nil)
 		ctx = sdk.UnwrapSDKContext(ctx)
 
 		// Generate random finality providers and add them to kv store
@@ -173,7 +174,7 @@ func FuzzPendingBTCDelegations(f *testing.F) {
 		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
 		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
 		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
-		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper, nil, nil)
 
 		// covenant and slashing addr
 		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
@@ -269,13 +270,148 @@ func FuzzPendingBTCDelegations(f *testing.F) {
 	})
 }
 
+func FuzzBTCDelegationStatusIndex(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate a random number of BTC delegations with random staking
+		// time, where a random subset of them become active
+		numDels := int(datagen.RandomInt(r, 10)) + 1
+		delsByStatus := map[types.BTCDelegationStatus]map[string]*types.BTCDelegation{
+			types.BTCDelegationStatus_PENDING: {},
+			types.BTCDelegationStatus_ACTIVE:  {},
+		}
+		maxEndHeight := uint64(0)
+		for i := 0; i < numDels; i++ {
+			stakingTime := uint16(datagen.RandomInt(r, 1000)) + 1000
+			stakingTxHash, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+				r,
+				fpPK,
+				changeAddress.EncodeAddress(),
+				int64(2*10e8),
+				stakingTime,
+			)
+			if datagen.RandomInt(r, 2) == 1 {
+				h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+				delsByStatus[types.BTCDelegationStatus_ACTIVE][stakingTxHash] = actualDel
+			} else {
+				delsByStatus[types.BTCDelegationStatus_PENDING][stakingTxHash] = actualDel
+			}
+			maxEndHeight = max(maxEndHeight, actualDel.EndHeight)
+		}
+
+		// the status index is updated upon `BeginBlock`
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+
+		// queryAll retrieves all BTC delegations page by page
+		queryAll := func(status types.BTCDelegationStatus, expiringWithin uint64) map[string]*types.BTCDelegationResponse {
+			resps := map[string]*types.BTCDelegationResponse{}
+			pagination := constructRequestWithLimit(r, datagen.RandomInt(r, 3)+1)
+			for {
+				resp, err := h.BTCStakingKeeper.BTCDelegations(h.Ctx, &types.QueryBTCDelegationsRequest{
+					Status:                  status,
+					Pagination:              pagination,
+					ExpiringWithinBtcBlocks: expiringWithin,
+				})
+				h.NoError(err)
+				require.LessOrEqual(t, uint64(len(resp.BtcDelegations)), pagination.Limit)
+				for _, btcDel := range resp.BtcDelegations {
+					require.Equal(t, status.String(), btcDel.StatusDesc)
+					stakingTx, _, err := bbn.NewBTCTxFromHex(btcDel.StakingTxHex)
+					h.NoError(err)
+					resps[stakingTx.TxHash().String()] = btcDel
+				}
+				if len(resp.Pagination.NextKey) == 0 {
+					return resps
+				}
+				pagination.Key = resp.Pagination.NextKey
+			}
+		}
+
+		// BTC delegations are retrieved under their status, as well as among
+		// the ones expiring within a random number of BTC blocks
+		expiringWithin := datagen.RandomInt(r, 1000) + 1000
+		for status, dels := range delsByStatus {
+			resps := queryAll(status, 0)
+			require.Len(t, resps, len(dels))
+			for stakingTxHash := range dels {
+				require.Contains(t, resps, stakingTxHash)
+			}
+
+			expiringResps := queryAll(status, expiringWithin)
+			numExpiring := 0
+			for stakingTxHash, btcDel := range dels {
+				if btcDel.EndHeight <= btcTip.Height+expiringWithin {
+					require.Contains(t, expiringResps, stakingTxHash)
+					numExpiring++
+				}
+			}
+			require.Len(t, expiringResps, numExpiring)
+		}
+
+		// expiring BTC delegations can only be queried under a specific status
+		_, err = h.BTCStakingKeeper.BTCDelegations(h.Ctx, &types.QueryBTCDelegationsRequest{
+			Status:                  types.BTCDelegationStatus_ANY,
+			ExpiringWithinBtcBlocks: expiringWithin,
+		})
+		require.Error(t, err)
+
+		// the index is rebuilt from the BTC delegations upon genesis w.r.t.
+		// the current BTC tip
+		gs, err := h.BTCStakingKeeper.ExportGenesis(h.Ctx)
+		h.NoError(err)
+		genesisBtclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		genesisBtclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(btcTip).AnyTimes()
+		genesisKeeper, genesisCtx := testkeeper.BTCStakingKeeper(t, genesisBtclcKeeper, btccKeeper, ckptKeeper, nil, nil)
+		err = genesisKeeper.InitGenesis(genesisCtx, *gs)
+		h.NoError(err)
+		for status, dels := range delsByStatus {
+			resp, err := genesisKeeper.BTCDelegations(genesisCtx, &types.QueryBTCDelegationsRequest{Status: status})
+			h.NoError(err)
+			require.Len(t, resp.BtcDelegations, len(dels))
+		}
+
+		// once all BTC delegations expire, they are all unbonded
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: maxEndHeight}).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		require.Empty(t, queryAll(types.BTCDelegationStatus_PENDING, 0))
+		require.Empty(t, queryAll(types.BTCDelegationStatus_ACTIVE, 0))
+		require.Len(t, queryAll(types.BTCDelegationStatus_UNBONDED, 0), numDels)
+	})
+}
+
 func FuzzFinalityProviderPowerAtHeight(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 	f.Fuzz(func(t *testing.T, seed int64) {
 		r := rand.New(rand.NewSource(seed))
 
 		// Setup keeper and context
-		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil, nil, nil)
 
 		// random finality provider
 		fp, err := datagen.GenRandomFinalityProvider(r)
@@ -324,7 +460,7 @@ func FuzzFinalityProviderCurrentVotingPower(f *testing.F) {
 		r := rand.New(rand.NewSource(seed))
 
 		// Setup keeper and context
-		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil, nil, nil)
 
 		// random finality provider
 		fp, err := datagen.GenRandomFinalityProvider(r)
@@ -377,7 +513,7 @@ func FuzzActiveFinalityProvidersAtHeight(f *testing.F) {
 		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
 		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
 		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
-		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper, nil, nil)
 
 		// covenant and slashing addr
 		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
@@ -497,7 +633,7 @@ func FuzzFinalityProviderDelegations(f *testing.F) {
 		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
 		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
 		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
-		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper, nil, nil)
 
 		// covenant and slashing addr
 		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
@@ -599,6 +735,135 @@ func FuzzFinalityProviderDelegations(f *testing.F) {
 	})
 }
 
+func FuzzDelegatorDelegations(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper, iKeeper, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingChangeLockTime := uint16(101)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		// Generate a random number of finality providers
+		numFps := datagen.RandomInt(r, 5) + 1
+		fps := []*types.FinalityProvider{}
+		for i := uint64(0); i < numFps; i++ {
+			fp, err := datagen.GenRandomFinalityProvider(r)
+			require.NoError(t, err)
+			keeper.SetFinalityProvider(ctx, fp)
+			fps = append(fps, fp)
+		}
+
+		startHeight := datagen.RandomInt(r, 100) + 1
+		endHeight := datagen.RandomInt(r, 1000) + startHeight + btcctypes.DefaultParams().CheckpointFinalizationTimeout + 1
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: startHeight}).AnyTimes()
+
+		// the BTC delegator delegates to random finality providers under the
+		// same staker address, while other BTC delegators delegate to the same
+		// finality providers
+		delSK, delPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		stakerAddr := datagen.GenRandomAccount().Address
+		numBTCDels := datagen.RandomInt(r, 10) + 1
+		expectedBtcDels := map[string]*types.BTCDelegation{}
+		for i := uint64(0); i < 2*numBTCDels; i++ {
+			isDelegator := i < numBTCDels
+			curDelSK := delSK
+			if !isDelegator {
+				curDelSK, _, err = datagen.GenRandomBTCKeyPair(r)
+				require.NoError(t, err)
+			}
+			fp := fps[datagen.RandomInt(r, len(fps))]
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				curDelSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingAddress.EncodeAddress(),
+				startHeight, endHeight, 10000,
+				slashingRate,
+				slashingChangeLockTime,
+			)
+			require.NoError(t, err)
+			if isDelegator {
+				btcDel.StakerAddr = stakerAddr
+				expectedBtcDels[btcDel.MustGetStakingTxHash().String()] = btcDel
+			}
+			err = keeper.AddBTCDelegation(ctx, btcDel)
+			require.NoError(t, err)
+		}
+
+		// the staker address has accrued some rewards
+		rg := &itypes.RewardGauge{Coins: datagen.GenRandomCoins(r)}
+		iKeeper.EXPECT().GetRewardGauge(gomock.Any(), itypes.BTCDelegationType, sdk.MustAccAddressFromBech32(stakerAddr)).Return(rg).AnyTimes()
+
+		// queryAll retrieves all BTC delegations of the BTC delegator page by page
+		queryAll := func() map[string]*types.DelegatorDelegationResponse {
+			resps := map[string]*types.DelegatorDelegationResponse{}
+			pagination := constructRequestWithLimit(r, datagen.RandomInt(r, 3)+1)
+			for {
+				resp, err := keeper.DelegatorDelegations(ctx, &types.QueryDelegatorDelegationsRequest{
+					DelBtcPkHex: bbn.NewBIP340PubKeyFromBTCPK(delPK).MarshalHex(),
+					Pagination:  pagination,
+				})
+				require.NoError(t, err)
+				require.LessOrEqual(t, uint64(len(resp.Delegations)), pagination.Limit)
+				require.Equal(t, []*types.StakerRewardResponse{{StakerAddr: stakerAddr, RewardGauge: rg}}, resp.Rewards)
+				for _, del := range resp.Delegations {
+					stakingTx, _, err := bbn.NewBTCTxFromHex(del.BtcDelegation.StakingTxHex)
+					require.NoError(t, err)
+					resps[stakingTx.TxHash().String()] = del
+				}
+				if len(resp.Pagination.NextKey) == 0 {
+					return resps
+				}
+				pagination.Key = resp.Pagination.NextKey
+			}
+		}
+
+		// all BTC delegations of the BTC delegator are retrieved with their
+		// voting power, regardless of their finality providers
+		resps := queryAll()
+		require.Len(t, resps, len(expectedBtcDels))
+		for stakingTxHash, btcDel := range expectedBtcDels {
+			require.Contains(t, resps, stakingTxHash)
+			resp := resps[stakingTxHash]
+			require.Equal(t, btcDel.FpBtcPkList, resp.BtcDelegation.FpBtcPkList)
+			if resp.BtcDelegation.Active {
+				require.Equal(t, btcDel.TotalSat, resp.VotingPower)
+			} else {
+				require.Zero(t, resp.VotingPower)
+			}
+		}
+
+		// the index is rebuilt from the BTC delegations upon genesis, thus
+		// genesis states exported before the index existed are migrated
+		gs, err := keeper.ExportGenesis(ctx)
+		require.NoError(t, err)
+		keeper, ctx = testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper, iKeeper, nil)
+		err = keeper.InitGenesis(ctx, *gs)
+		require.NoError(t, err)
+		require.Equal(t, resps, queryAll())
+	})
+}
+
 // Constructors for PageRequest objects
 func constructRequestWithKeyAndLimit(r *rand.Rand, key []byte, limit uint64) *query.PageRequest {
 	// If limit is 0, set one randomly
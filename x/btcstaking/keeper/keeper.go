@@ -22,8 +22,12 @@ type (
 		btclcKeeper types.BTCLightClientKeeper
 		btccKeeper  types.BtcCheckpointKeeper
 		ckptKeeper  types.CheckpointingKeeper
+		iKeeper     types.IncentiveKeeper
+		bscKeeper   types.BTCStkConsumerKeeper
 
 		hooks types.BtcStakingHooks
+		// archive is the optional off-chain index of pruned BTC delegations
+		archive types.BTCDelegationArchive
 
 		btcNet *chaincfg.Params
 		// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -39,6 +43,8 @@ func NewKeeper(
 	btclcKeeper types.BTCLightClientKeeper,
 	btccKeeper types.BtcCheckpointKeeper,
 	ckptKeeper types.CheckpointingKeeper,
+	iKeeper types.IncentiveKeeper,
+	bscKeeper types.BTCStkConsumerKeeper,
 
 	btcNet *chaincfg.Params,
 	authority string,
@@ -50,6 +56,8 @@ func NewKeeper(
 		btclcKeeper: btclcKeeper,
 		btccKeeper:  btccKeeper,
 		ckptKeeper:  ckptKeeper,
+		iKeeper:     iKeeper,
+		bscKeeper:   bscKeeper,
 
 		hooks: nil,
 
@@ -69,6 +77,17 @@ func (k *Keeper) SetHooks(sh types.BtcStakingHooks) *Keeper {
 	return k
 }
 
+// SetArchive sets the archive of pruned BTC delegations
+func (k *Keeper) SetArchive(archive types.BTCDelegationArchive) *Keeper {
+	if k.archive != nil {
+		panic("cannot set BTC delegation archive twice")
+	}
+
+	k.archive = archive
+
+	return k
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -81,12 +100,34 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 func (k Keeper) BeginBlocker(ctx context.Context) error {
 	// index BTC height at the current height
 	k.IndexBTCHeight(ctx)
+	// activate the new covenant committee if its rotation is due
+	k.ProcessCovenantCommitteeRotation(ctx)
+	// re-key finality providers whose key rotations are due
+	k.ProcessFinalityProviderKeyRotations(ctx)
 	// update voting power distribution
 	k.UpdatePowerDist(ctx)
+	// prune BTC delegations that have been unbonded for long enough
+	k.PruneBTCDelegations(ctx)
 
 	return nil
 }
 
+// CommitArchive persists the BTC delegations pruned in the current block to
+// the archive, if this node has one. This is triggered upon `Commit` via the
+// module's `Precommit`, such that the archive is not written during block
+// execution.
+func (k Keeper) CommitArchive(ctx context.Context) error {
+	if k.archive == nil {
+		return nil
+	}
+	// the archive is an off-chain index local to this node, thus failing to
+	// persist it does not affect the consensus
+	if err := k.archive.Commit(); err != nil {
+		k.Logger(sdk.UnwrapSDKContext(ctx)).Error("failed to persist the archive of pruned BTC delegations", "err", err)
+	}
+	return nil
+}
+
 func (k Keeper) GetLastFinalizedEpoch(ctx context.Context) uint64 {
 	return k.ckptKeeper.GetLastFinalizedEpoch(ctx)
 }
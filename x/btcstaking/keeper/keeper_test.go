@@ -7,7 +7,9 @@ import (
 	"cosmossdk.io/core/header"
 	sdkmath "cosmossdk.io/math"
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/golang/mock/gomock"
@@ -34,19 +36,25 @@ type Helper struct {
 	BTCLightClientKeeper *types.MockBTCLightClientKeeper
 	BTCCheckpointKeeper  *types.MockBtcCheckpointKeeper
 	CheckpointingKeeper  *types.MockCheckpointingKeeper
+	IncentiveKeeper      *types.MockIncentiveKeeper
+	BTCStkConsumerKeeper *types.MockBTCStkConsumerKeeper
 	BTCStakingHooks      *types.MockBtcStakingHooks
 	MsgServer            types.MsgServer
 	Net                  *chaincfg.Params
 }
 
 func NewHelper(t testing.TB, btclcKeeper *types.MockBTCLightClientKeeper, btccKeeper *types.MockBtcCheckpointKeeper, ckptKeeper *types.MockCheckpointingKeeper) *Helper {
-	k, ctx := keepertest.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper)
+	ctrl := gomock.NewController(t)
+	iKeeper := types.NewMockIncentiveKeeper(ctrl)
+	bscKeeper := types.NewMockBTCStkConsumerKeeper(ctrl)
+
+	k, ctx := keepertest.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper, iKeeper, bscKeeper)
 	ctx = ctx.WithHeaderInfo(header.Info{Height: 1})
 	msgSrvr := keeper.NewMsgServerImpl(*k)
 
-	ctrl := gomock.NewController(t)
 	mockedHooks := types.NewMockBtcStakingHooks(ctrl)
 	mockedHooks.EXPECT().AfterFinalityProviderActivated(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockedHooks.EXPECT().AfterFinalityProviderKeyRotated(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	k.SetHooks(mockedHooks)
 
 	return &Helper{
@@ -56,6 +64,8 @@ func NewHelper(t testing.TB, btclcKeeper *types.MockBTCLightClientKeeper, btccKe
 		BTCLightClientKeeper: btclcKeeper,
 		BTCCheckpointKeeper:  btccKeeper,
 		CheckpointingKeeper:  ckptKeeper,
+		IncentiveKeeper:      iKeeper,
+		BTCStkConsumerKeeper: bscKeeper,
 		MsgServer:            msgSrvr,
 		Net:                  &chaincfg.SimNetParams,
 	}
@@ -121,25 +131,38 @@ func CreateFinalityProvider(r *rand.Rand, t *testing.T) *types.FinalityProvider
 	require.NoError(t, err)
 
 	return &types.FinalityProvider{
-		Description: fp.Description,
-		Commission:  fp.Commission,
-		Addr:        fp.Addr,
-		BtcPk:       fp.BtcPk,
-		Pop:         fp.Pop,
+		Description:   fp.Description,
+		Commission:    fp.Commission,
+		Addr:          fp.Addr,
+		BtcPk:         fp.BtcPk,
+		Pop:           fp.Pop,
+		MaxRate:       fp.MaxRate,
+		MaxChangeRate: fp.MaxChangeRate,
 	}
 }
 
 func (h *Helper) CreateFinalityProvider(r *rand.Rand) (*btcec.PrivateKey, *btcec.PublicKey, *types.FinalityProvider) {
+	return h.CreateConsumerFinalityProvider(r, "")
+}
+
+// CreateConsumerFinalityProvider creates a finality provider that provides
+// finality to the consumer chain with the given chain ID, or to Babylon if
+// the chain ID is empty
+func (h *Helper) CreateConsumerFinalityProvider(r *rand.Rand, consumerID string) (*btcec.PrivateKey, *btcec.PublicKey, *types.FinalityProvider) {
 	fpSK, fpPK, err := datagen.GenRandomBTCKeyPair(r)
 	h.NoError(err)
 	fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, fpSK)
 	h.NoError(err)
+	fp.ConsumerId = consumerID
 	msgNewFp := types.MsgCreateFinalityProvider{
-		Addr:        fp.Addr,
-		Description: fp.Description,
-		Commission:  fp.Commission,
-		BtcPk:       fp.BtcPk,
-		Pop:         fp.Pop,
+		Addr:          fp.Addr,
+		Description:   fp.Description,
+		Commission:    fp.Commission,
+		MaxRate:       fp.MaxRate,
+		MaxChangeRate: fp.MaxChangeRate,
+		BtcPk:         fp.BtcPk,
+		Pop:           fp.Pop,
+		ConsumerId:    consumerID,
 	}
 
 	_, err = h.MsgServer.CreateFinalityProvider(h.Ctx, &msgNewFp)
@@ -155,6 +178,28 @@ func (h *Helper) CreateDelegationCustom(
 	stakingTime uint16,
 	unbondingValue int64,
 	unbondingTime uint16,
+) (string, *btcec.PrivateKey, *btcec.PublicKey, *types.MsgCreateBTCDelegation, error) {
+	return h.CreateRestakedDelegationCustom(
+		r,
+		[]*btcec.PublicKey{fpPK},
+		changeAddress,
+		stakingValue,
+		stakingTime,
+		unbondingValue,
+		unbondingTime,
+	)
+}
+
+// CreateRestakedDelegationCustom creates a BTC delegation whose staking output
+// is restaked to all the given finality providers
+func (h *Helper) CreateRestakedDelegationCustom(
+	r *rand.Rand,
+	fpPKs []*btcec.PublicKey,
+	changeAddress string,
+	stakingValue int64,
+	stakingTime uint16,
+	unbondingValue int64,
+	unbondingTime uint16,
 ) (string, *btcec.PrivateKey, *btcec.PublicKey, *types.MsgCreateBTCDelegation, error) {
 	delSK, delPK, err := datagen.GenRandomBTCKeyPair(r)
 	h.NoError(err)
@@ -168,7 +213,7 @@ func (h *Helper) CreateDelegationCustom(
 		h.t,
 		h.Net,
 		delSK,
-		[]*btcec.PublicKey{fpPK},
+		fpPKs,
 		covPKs,
 		bsParams.CovenantQuorum,
 		stakingTimeBlocks,
@@ -225,7 +270,7 @@ func (h *Helper) CreateDelegationCustom(
 		h.t,
 		h.Net,
 		delSK,
-		[]*btcec.PublicKey{fpPK},
+		fpPKs,
 		covPKs,
 		bsParams.CovenantQuorum,
 		wire.NewOutPoint(&stkTxHash, stkOutputIdx),
@@ -244,11 +289,14 @@ func (h *Helper) CreateDelegationCustom(
 	h.NoError(err)
 
 	// all good, construct and send MsgCreateBTCDelegation message
-	fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(fpPK)
+	fpBTCPKs := make([]bbn.BIP340PubKey, 0, len(fpPKs))
+	for _, fpPK := range fpPKs {
+		fpBTCPKs = append(fpBTCPKs, *bbn.NewBIP340PubKeyFromBTCPK(fpPK))
+	}
 	msgCreateBTCDel := &types.MsgCreateBTCDelegation{
 		StakerAddr:                    staker.String(),
 		BtcPk:                         stPk,
-		FpBtcPkList:                   []bbn.BIP340PubKey{*fpBTCPK},
+		FpBtcPkList:                   fpBTCPKs,
 		Pop:                           pop,
 		StakingTime:                   uint32(stakingTimeBlocks),
 		StakingValue:                  stakingValue,
@@ -276,6 +324,18 @@ func (h *Helper) CreateDelegation(
 	changeAddress string,
 	stakingValue int64,
 	stakingTime uint16,
+) (string, *btcec.PrivateKey, *btcec.PublicKey, *types.MsgCreateBTCDelegation, *types.BTCDelegation) {
+	return h.CreateRestakedDelegation(r, []*btcec.PublicKey{fpPK}, changeAddress, stakingValue, stakingTime)
+}
+
+// CreateRestakedDelegation creates a BTC delegation whose staking output is
+// restaked to all the given finality providers
+func (h *Helper) CreateRestakedDelegation(
+	r *rand.Rand,
+	fpPKs []*btcec.PublicKey,
+	changeAddress string,
+	stakingValue int64,
+	stakingTime uint16,
 ) (string, *btcec.PrivateKey, *btcec.PublicKey, *types.MsgCreateBTCDelegation, *types.BTCDelegation) {
 	bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
 	bcParams := h.BTCCheckpointKeeper.GetParams(h.Ctx)
@@ -285,9 +345,9 @@ func (h *Helper) CreateDelegation(
 		bcParams,
 	)
 
-	stakingTxHash, delSK, delPK, msgCreateBTCDel, err := h.CreateDelegationCustom(
+	stakingTxHash, delSK, delPK, msgCreateBTCDel, err := h.CreateRestakedDelegationCustom(
 		r,
-		fpPK,
+		fpPKs,
 		changeAddress,
 		stakingValue,
 		stakingTime,
@@ -305,6 +365,203 @@ func (h *Helper) CreateDelegation(
 	return stakingTxHash, delSK, delPK, msgCreateBTCDel, btcDel
 }
 
+// genDelegationSpendingPrevious generates the staking, slashing and unbonding
+// txs of a BTC delegation whose staking tx spends the staking output of the
+// given BTC delegation, plus an extra funding input if withFunding is set. It
+// also returns the funding txs whose outputs are spent by the funding inputs
+func (h *Helper) genDelegationSpendingPrevious(
+	r *rand.Rand,
+	delSK *btcec.PrivateKey,
+	fpPK *btcec.PublicKey,
+	prevDel *types.BTCDelegation,
+	withFunding bool,
+	stakingValue int64,
+	stakingTime uint16,
+) (string, *types.MsgCreateBTCDelegation, [][]byte) {
+	bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+	bcParams := h.BTCCheckpointKeeper.GetParams(h.Ctx)
+	covPKs, err := bbn.NewBTCPKsFromBIP340PKs(bsParams.CovenantPks)
+	h.NoError(err)
+	unbondingTime := uint16(types.MinimumUnbondingTime(bsParams, bcParams)) + 1
+	unbondingValue := stakingValue - 1000
+
+	// the new staking tx spends the previous staking output and optionally
+	// a funding input
+	prevStakingTxHash := prevDel.MustGetStakingTxHash()
+	outPoints := []*wire.OutPoint{
+		wire.NewOutPoint(&prevStakingTxHash, prevDel.StakingOutputIdx),
+	}
+	fundingTxs := [][]byte{}
+	if withFunding {
+		fundingTx := datagen.GenRandomTx(r)
+		fundingTxHash := fundingTx.TxHash()
+		outPoints = append(outPoints, wire.NewOutPoint(&fundingTxHash, 0))
+		fundingTxBytes, err := bbn.SerializeBTCTx(fundingTx)
+		h.NoError(err)
+		fundingTxs = append(fundingTxs, fundingTxBytes)
+	}
+	testStakingInfo := datagen.GenBTCStakingSlashingInfoWithOutPoints(
+		r,
+		h.t,
+		h.Net,
+		outPoints,
+		delSK,
+		[]*btcec.PublicKey{fpPK},
+		covPKs,
+		bsParams.CovenantQuorum,
+		stakingTime,
+		stakingValue,
+		bsParams.SlashingAddress,
+		bsParams.SlashingRate,
+		unbondingTime,
+	)
+	stakingTxHash := testStakingInfo.StakingTx.TxHash()
+
+	// generate staking tx info
+	prevBlock, _ := datagen.GenRandomBtcdBlock(r, 0, nil)
+	btcHeaderWithProof := datagen.CreateBlockWithTransaction(r, &prevBlock.Header, testStakingInfo.StakingTx)
+	btcHeader := btcHeaderWithProof.HeaderBytes
+	serializedStakingTx, err := bbn.SerializeBTCTx(testStakingInfo.StakingTx)
+	h.NoError(err)
+	txInfo := btcctypes.NewTransactionInfo(&btcctypes.TransactionKey{Index: 1, Hash: btcHeader.Hash()}, serializedStakingTx, btcHeaderWithProof.SpvProof.MerkleNodes)
+	h.BTCLightClientKeeper.EXPECT().GetHeaderByHash(gomock.Eq(h.Ctx), gomock.Eq(btcHeader.Hash())).Return(&btclctypes.BTCHeaderInfo{Header: &btcHeader, Height: 10}).AnyTimes()
+
+	// generate proper delegator sig
+	slashingSpendInfo, err := testStakingInfo.StakingInfo.SlashingPathSpendInfo()
+	h.NoError(err)
+	delegatorSig, err := testStakingInfo.SlashingTx.Sign(
+		testStakingInfo.StakingTx,
+		0,
+		slashingSpendInfo.GetPkScriptPath(),
+		delSK,
+	)
+	h.NoError(err)
+
+	// generate unbonding tx of the new staking tx
+	testUnbondingInfo := datagen.GenBTCUnbondingSlashingInfo(
+		r,
+		h.t,
+		h.Net,
+		delSK,
+		[]*btcec.PublicKey{fpPK},
+		covPKs,
+		bsParams.CovenantQuorum,
+		wire.NewOutPoint(&stakingTxHash, 0),
+		unbondingTime,
+		unbondingValue,
+		bsParams.SlashingAddress,
+		bsParams.SlashingRate,
+		unbondingTime,
+	)
+	delSlashingTxSig, err := testUnbondingInfo.GenDelSlashingTxSig(delSK)
+	h.NoError(err)
+	serializedUnbondingTx, err := bbn.SerializeBTCTx(testUnbondingInfo.UnbondingTx)
+	h.NoError(err)
+
+	return stakingTxHash.String(), &types.MsgCreateBTCDelegation{
+		StakerAddr:                    prevDel.StakerAddr,
+		StakingTime:                   uint32(stakingTime),
+		StakingValue:                  stakingValue,
+		StakingTx:                     txInfo,
+		SlashingTx:                    testStakingInfo.SlashingTx,
+		DelegatorSlashingSig:          delegatorSig,
+		UnbondingTime:                 uint32(unbondingTime),
+		UnbondingTx:                   serializedUnbondingTx,
+		UnbondingValue:                unbondingValue,
+		UnbondingSlashingTx:           testUnbondingInfo.SlashingTx,
+		DelegatorUnbondingSlashingSig: delSlashingTxSig,
+	}, fundingTxs
+}
+
+// ExpandDelegation expands the given active BTC delegation with a new staking
+// tx that spends the previous staking output and a random funding input
+func (h *Helper) ExpandDelegation(
+	r *rand.Rand,
+	delSK *btcec.PrivateKey,
+	fpPK *btcec.PublicKey,
+	prevDel *types.BTCDelegation,
+	stakingValue int64,
+	stakingTime uint16,
+) (string, *types.MsgExpandBTCDelegation, error) {
+	stakingTxHash, msg, fundingTxs := h.genDelegationSpendingPrevious(r, delSK, fpPK, prevDel, true, stakingValue, stakingTime)
+	msgExpandBTCDel := &types.MsgExpandBTCDelegation{
+		StakerAddr:                    msg.StakerAddr,
+		PreviousStakingTxHash:         prevDel.MustGetStakingTxHash().String(),
+		StakingTime:                   msg.StakingTime,
+		StakingValue:                  msg.StakingValue,
+		StakingTx:                     msg.StakingTx.Transaction,
+		SlashingTx:                    msg.SlashingTx,
+		DelegatorSlashingSig:          msg.DelegatorSlashingSig,
+		UnbondingTime:                 msg.UnbondingTime,
+		UnbondingTx:                   msg.UnbondingTx,
+		UnbondingValue:                msg.UnbondingValue,
+		UnbondingSlashingTx:           msg.UnbondingSlashingTx,
+		DelegatorUnbondingSlashingSig: msg.DelegatorUnbondingSlashingSig,
+		FundingTxs:                    fundingTxs,
+	}
+	_, err := h.MsgServer.ExpandBTCDelegation(h.Ctx, msgExpandBTCDel)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return stakingTxHash, msgExpandBTCDel, nil
+}
+
+// AddBTCDelegationInclusionProof proves the inclusion of the staking tx of the
+// given BTC delegation created upon stake expansion or redelegation in a new
+// BTC block
+func (h *Helper) AddBTCDelegationInclusionProof(r *rand.Rand, stakingTxHash string) error {
+	btcDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+	h.NoError(err)
+	stakingTx, err := bbn.NewBTCTxFromBytes(btcDel.StakingTx)
+	h.NoError(err)
+
+	prevBlock, _ := datagen.GenRandomBtcdBlock(r, 0, nil)
+	btcHeaderWithProof := datagen.CreateBlockWithTransaction(r, &prevBlock.Header, stakingTx)
+	btcHeader := btcHeaderWithProof.HeaderBytes
+	h.BTCLightClientKeeper.EXPECT().GetHeaderByHash(gomock.Eq(h.Ctx), gomock.Eq(btcHeader.Hash())).Return(&btclctypes.BTCHeaderInfo{Header: &btcHeader, Height: 10}).AnyTimes()
+
+	_, err = h.MsgServer.AddBTCDelegationInclusionProof(h.Ctx, &types.MsgAddBTCDelegationInclusionProof{
+		Signer:         btcDel.StakerAddr,
+		StakingTxHash:  stakingTxHash,
+		StakingTxKey:   &btcctypes.TransactionKey{Index: 1, Hash: btcHeader.Hash()},
+		StakingTxProof: btcHeaderWithProof.SpvProof.MerkleNodes,
+	})
+	return err
+}
+
+func (h *Helper) RedelegateDelegation(
+	r *rand.Rand,
+	delSK *btcec.PrivateKey,
+	fpPK *btcec.PublicKey,
+	prevDel *types.BTCDelegation,
+	stakingValue int64,
+	stakingTime uint16,
+) (string, *types.MsgBTCRedelegate, error) {
+	stakingTxHash, msg, _ := h.genDelegationSpendingPrevious(r, delSK, fpPK, prevDel, false, stakingValue, stakingTime)
+	msgBTCRedelegate := &types.MsgBTCRedelegate{
+		StakerAddr:                    msg.StakerAddr,
+		PreviousStakingTxHash:         prevDel.MustGetStakingTxHash().String(),
+		FpBtcPkList:                   []bbn.BIP340PubKey{*bbn.NewBIP340PubKeyFromBTCPK(fpPK)},
+		StakingTime:                   msg.StakingTime,
+		StakingValue:                  msg.StakingValue,
+		StakingTx:                     msg.StakingTx.Transaction,
+		SlashingTx:                    msg.SlashingTx,
+		DelegatorSlashingSig:          msg.DelegatorSlashingSig,
+		UnbondingTime:                 msg.UnbondingTime,
+		UnbondingTx:                   msg.UnbondingTx,
+		UnbondingValue:                msg.UnbondingValue,
+		UnbondingSlashingTx:           msg.UnbondingSlashingTx,
+		DelegatorUnbondingSlashingSig: msg.DelegatorUnbondingSlashingSig,
+	}
+	_, err := h.MsgServer.BTCRedelegate(h.Ctx, msgBTCRedelegate)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return stakingTxHash, msgBTCRedelegate, nil
+}
+
 func (h *Helper) GenerateCovenantSignaturesMessages(
 	r *rand.Rand,
 	covenantSKs []*btcec.PrivateKey,
@@ -364,6 +621,16 @@ func (h *Helper) GenerateCovenantSignaturesMessages(
 	covUnbondingSigs, err := datagen.GenCovenantUnbondingSigs(covenantSKs, stakingTx, del.StakingOutputIdx, unbondingPathInfo.GetPkScriptPath(), unbondingTx)
 	h.NoError(err)
 
+	/*
+		Logics about spending the previous staking output upon stake
+		expansion or redelegation
+	*/
+
+	var covStakeSpendingSigs []*schnorr.Signature
+	if del.SpendsPreviousStakingOutput() {
+		covStakeSpendingSigs = h.genCovenantStakeSpendingSigs(covenantSKs, del, stakingTx)
+	}
+
 	msgs := make([]*types.MsgAddCovenantSigs, len(bsParams.CovenantPks))
 
 	for i := 0; i < len(bsParams.CovenantPks); i++ {
@@ -375,11 +642,47 @@ func (h *Helper) GenerateCovenantSignaturesMessages(
 			UnbondingTxSig:          bbn.NewBIP340SignatureFromBTCSig(covUnbondingSigs[i]),
 			SlashingUnbondingTxSigs: covenantUnbondingSlashingTxSigs[i].AdaptorSigs,
 		}
+		if covStakeSpendingSigs != nil {
+			msgAddCovenantSig.StakeSpendingTxSig = bbn.NewBIP340SignatureFromBTCSig(covStakeSpendingSigs[i])
+		}
 		msgs[i] = msgAddCovenantSig
 	}
 	return msgs
 }
 
+// genCovenantStakeSpendingSigs generates the signatures of all covenant members
+// on the staking tx of the given BTC delegation, spending the previous staking
+// output via its unbonding path
+func (h *Helper) genCovenantStakeSpendingSigs(
+	covenantSKs []*btcec.PrivateKey,
+	del *types.BTCDelegation,
+	stakingTx *wire.MsgTx,
+) []*schnorr.Signature {
+	prevStakingTxHash, err := chainhash.NewHash(del.PreviousStakingTxHash)
+	h.NoError(err)
+	prevDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, prevStakingTxHash.String())
+	h.NoError(err)
+	prevParams := h.BTCStakingKeeper.GetParamsByVersion(h.Ctx, prevDel.ParamsVersion)
+	prevStakingInfo, err := prevDel.GetStakingInfo(prevParams, h.Net)
+	h.NoError(err)
+	prevUnbondingPathInfo, err := prevStakingInfo.UnbondingPathSpendInfo()
+	h.NoError(err)
+
+	inputIdx := -1
+	for i, txIn := range stakingTx.TxIn {
+		if txIn.PreviousOutPoint == *wire.NewOutPoint(prevStakingTxHash, prevDel.StakingOutputIdx) {
+			inputIdx = i
+		}
+	}
+	require.GreaterOrEqual(h.t, inputIdx, 0)
+	spentOutputs, err := del.ParseSpentOutputs()
+	h.NoError(err)
+
+	sigs, err := datagen.GenCovenantStakeSpendingSigs(covenantSKs, stakingTx, spentOutputs, inputIdx, prevUnbondingPathInfo.GetPkScriptPath())
+	h.NoError(err)
+	return sigs
+}
+
 func (h *Helper) CreateCovenantSigs(
 	r *rand.Rand,
 	covenantSKs []*btcec.PrivateKey,
@@ -411,7 +714,7 @@ func (h *Helper) CreateCovenantSigs(
 	require.NotNil(h.t, actualDelWithCovenantSigs.BtcUndelegation.CovenantUnbondingSigList)
 	require.Len(h.t, actualDelWithCovenantSigs.BtcUndelegation.CovenantUnbondingSigList, int(bsParams.CovenantQuorum))
 	require.Len(h.t, actualDelWithCovenantSigs.BtcUndelegation.CovenantSlashingSigs, int(bsParams.CovenantQuorum))
-	require.Len(h.t, actualDelWithCovenantSigs.BtcUndelegation.CovenantSlashingSigs[0].AdaptorSigs, 1)
+	require.Len(h.t, actualDelWithCovenantSigs.BtcUndelegation.CovenantSlashingSigs[0].AdaptorSigs, len(del.FpBtcPkList))
 
 }
 
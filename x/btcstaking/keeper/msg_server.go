@@ -10,9 +10,11 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/babylonchain/babylon/btcstaking"
 	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -50,6 +52,38 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// RotateCovenantCommittee schedules the rotation of the covenant committee
+func (ms msgServer) RotateCovenantCommittee(goCtx context.Context, req *types.MsgRotateCovenantCommittee) (*types.MsgRotateCovenantCommitteeResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+	rotation := req.ToRotation()
+	if err := rotation.ValidateBasic(); err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid covenant committee rotation: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the new covenant committee is announced ahead of time, such that the
+	// retiring covenant members can pre-sign the pending BTC delegations
+	if rotation.ActivationHeight <= uint64(ctx.HeaderInfo().Height) {
+		return nil, types.ErrInvalidCovenantRotation.Wrapf(
+			"activation height %d is not higher than the current height %d", rotation.ActivationHeight, ctx.HeaderInfo().Height)
+	}
+	if ms.GetCovenantCommitteeRotation(ctx) != nil {
+		return nil, types.ErrInvalidCovenantRotation.Wrap("there is already a scheduled covenant committee rotation")
+	}
+
+	ms.setCovenantCommitteeRotation(ctx, rotation)
+
+	event := &types.EventCovenantCommitteeRotationScheduled{Rotation: rotation}
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventCovenantCommitteeRotationScheduled event: %w", err))
+	}
+
+	return &types.MsgRotateCovenantCommitteeResponse{}, nil
+}
+
 // CreateFinalityProvider creates a finality provider
 func (ms msgServer) CreateFinalityProvider(goCtx context.Context, req *types.MsgCreateFinalityProvider) (*types.MsgCreateFinalityProviderResponse, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyCreateFinalityProvider)
@@ -86,26 +120,48 @@ func (ms msgServer) CreateFinalityProvider(goCtx context.Context, req *types.Msg
 		return nil, types.ErrFpRegistered
 	}
 
+	// ensure the consumer chain that the finality provider provides finality
+	// to, if any, is registered
+	if req.ConsumerId != "" && !ms.bscKeeper.IsConsumerRegistered(ctx, req.ConsumerId) {
+		return nil, types.ErrConsumerNotRegistered.Wrapf("chain ID: %s", req.ConsumerId)
+	}
+
 	// all good, add this finality provider
 	fp := types.FinalityProvider{
-		Description: req.Description,
-		Commission:  req.Commission,
-		Addr:        fpAddr.String(),
-		BtcPk:       req.BtcPk,
-		Pop:         req.Pop,
+		Description:          req.Description,
+		Commission:           req.Commission,
+		Addr:                 fpAddr.String(),
+		BtcPk:                req.BtcPk,
+		Pop:                  req.Pop,
+		MaxRate:              req.MaxRate,
+		MaxChangeRate:        req.MaxChangeRate,
+		CommissionUpdateTime: ctx.HeaderInfo().Time,
+		ConsumerId:           req.ConsumerId,
 	}
 	ms.SetFinalityProvider(ctx, &fp)
+	if !fp.SecuresBabylon() {
+		if err := ms.bscKeeper.AddConsumerFinalityProvider(ctx, fp.ConsumerId, fp.BtcPk); err != nil {
+			return nil, err
+		}
+	}
 
 	// notify subscriber
-	if err := ctx.EventManager().EmitTypedEvent(&types.EventNewFinalityProvider{Fp: &fp}); err != nil {
+	event := &types.EventNewFinalityProvider{Fp: &fp}
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
 		return nil, err
 	}
+	// notify the consumer chain of its new finality provider
+	if !fp.SecuresBabylon() {
+		ms.addConsumerEvent(ctx, fp.ConsumerId, types.NewConsumerEventWithNewFP(event))
+	}
 
 	return &types.MsgCreateFinalityProviderResponse{}, nil
 }
 
 // EditFinalityProvider edits an existing finality provider
-func (ms msgServer) EditFinalityProvider(ctx context.Context, req *types.MsgEditFinalityProvider) (*types.MsgEditFinalityProviderResponse, error) {
+func (ms msgServer) EditFinalityProvider(goCtx context.Context, req *types.MsgEditFinalityProvider) (*types.MsgEditFinalityProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
 	// basic stateless checks
 	// NOTE: after this, description is guaranteed to be valid
 	if err := req.ValidateBasic(); err != nil {
@@ -139,9 +195,34 @@ func (ms msgServer) EditFinalityProvider(ctx context.Context, req *types.MsgEdit
 		return nil, status.Errorf(codes.PermissionDenied, "the signer does not correspond to the finality provider's Babylon address")
 	}
 
-	// all good, update the finality provider and set back
+	// ensure the commission change respects the finality provider's max rate,
+	// max change rate and the rate limit of one change per 24h
+	commissionChanged := fp.Commission == nil || !fp.Commission.Equal(*req.Commission)
+	if commissionChanged {
+		if err := fp.ValidateNewCommission(*req.Commission, ctx.HeaderInfo().Time); err != nil {
+			return nil, err
+		}
+	}
+
+	// all good, update the finality provider and set back. A finality provider
+	// registered before the max rates were introduced gets the default ones
+	// that it has been subject to
 	fp.Description = req.Description
-	fp.Commission = req.Commission
+	fp.SetDefaultMaxRates()
+	if commissionChanged {
+		fp.Commission = req.Commission
+		fp.CommissionUpdateTime = ctx.HeaderInfo().Time
+
+		// record commission update event, so that the next `BeginBlock`
+		// applies the new commission to the voting power distribution
+		// cache and thus to the rewards of subsequent heights
+		btcTip := ms.btclcKeeper.GetTipInfo(ctx)
+		if btcTip == nil {
+			return nil, fmt.Errorf("failed to get current BTC tip")
+		}
+		powerUpdateEvent := types.NewEventPowerDistUpdateWithFPCommission(fp.BtcPk, fp.Commission)
+		ms.addPowerDistUpdateEvent(ctx, btcTip.Height, powerUpdateEvent)
+	}
 	ms.SetFinalityProvider(ctx, fp)
 
 	return &types.MsgEditFinalityProviderResponse{}, nil
@@ -161,7 +242,6 @@ func caluculateMinimumUnbondingValue(
 }
 
 // CreateBTCDelegation creates a BTC delegation
-// TODO: refactor this handler. It's now too convoluted
 func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCreateBTCDelegation) (*types.MsgCreateBTCDelegationResponse, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyCreateBTCDelegation)
 
@@ -171,9 +251,438 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
+	newBTCDel, err := ms.verifyBTCDelegation(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	newBTCDel.StartHeight, newBTCDel.EndHeight, err = ms.verifyStakingTxInclusion(ctx, req.StakingTx, req.StakingTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// add this BTC delegation, and emit corresponding events
+	if err := ms.AddBTCDelegation(ctx, newBTCDel); err != nil {
+		panic(fmt.Errorf("failed to add BTC delegation that has passed verification: %w", err))
+	}
+
+	return &types.MsgCreateBTCDelegationResponse{}, nil
+}
+
+// ExpandBTCDelegation expands an active BTC delegation with a new staking tx
+// that spends the previous staking output together with extra funding inputs.
+// The previous staking output can only be spent via its unbonding path, which
+// the covenant committee co-signs via `MsgAddCovenantSigs`. The new staking tx
+// can thus only be included on Bitcoin afterwards, so that the new BTC
+// delegation is registered without an inclusion proof. It remains pending
+// while the previous one remains active, until its inclusion is proven via
+// `MsgAddBTCDelegationInclusionProof`, or it expires once the previous one
+// becomes unbonded
+func (ms msgServer) ExpandBTCDelegation(goCtx context.Context, req *types.MsgExpandBTCDelegation) (*types.MsgExpandBTCDelegationResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyExpandBTCDelegation)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// basic stateless checks
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	prevDel, stakingMsgTx, err := ms.getSpentBTCDelegation(ctx, req.StakerAddr, req.PreviousStakingTxHash, req.StakingTx, types.ErrInvalidStakeExpansion)
+	if err != nil {
+		return nil, err
+	}
+	prevStakingTxHash := prevDel.MustGetStakingTxHash()
+
+	// ensure the new staking output locks more than the previous one
+	if req.StakingValue <= 0 || uint64(req.StakingValue) <= prevDel.TotalSat {
+		return nil, types.ErrInvalidStakeExpansion.Wrapf(
+			"staking value %d must be larger than the one of the previous BTC delegation %d",
+			req.StakingValue, prevDel.TotalSat)
+	}
+
+	// ensure the new staking tx has extra funding inputs
+	if len(stakingMsgTx.TxIn) < 2 {
+		return nil, types.ErrInvalidStakeExpansion.Wrap("staking tx does not have any funding input besides the previous staking output")
+	}
+	spentOutputs, err := getSpentOutputs(prevDel, stakingMsgTx, req.FundingTxs, types.ErrInvalidStakeExpansion)
+	if err != nil {
+		return nil, err
+	}
+
+	// the BTC staker, its PoP and the finality providers are inherited from
+	// the previous BTC delegation. The rest goes through the same verification
+	// as creating a new BTC delegation, apart from the inclusion proof
+	createReq := req.ToMsgCreateBTCDelegation(prevDel)
+	if err := createReq.ValidateBasicWithoutInclusionProof(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	newBTCDel, err := ms.verifyBTCDelegation(ctx, createReq)
+	if err != nil {
+		return nil, err
+	}
+	// the timelock is unknown until the staking tx is included, thus only
+	// the staking time is recorded for now
+	newBTCDel.EndHeight = uint64(createReq.StakingTime)
+	newBTCDel.AwaitingInclusionProof = true
+	newBTCDel.PreviousStakingTxHash = prevStakingTxHash[:]
+	newBTCDel.SpentOutputs = spentOutputs
+
+	// add this BTC delegation, and emit corresponding events
+	if err := ms.AddBTCDelegation(ctx, newBTCDel); err != nil {
+		panic(fmt.Errorf("failed to add BTC delegation that has passed verification: %w", err))
+	}
+
+	return &types.MsgExpandBTCDelegationResponse{}, nil
+}
+
+// BTCRedelegate redelegates an active BTC delegation to a different set of
+// finality providers with a new staking tx that spends the previous staking
+// output, without waiting for the unbonding time. As upon stake expansion, the
+// covenant committee co-signs the spending of the previous staking output via
+// `MsgAddCovenantSigs`, and the new BTC delegation is registered without an
+// inclusion proof. It remains pending while the previous one remains active,
+// until its inclusion is proven via `MsgAddBTCDelegationInclusionProof`, or it
+// expires once the previous one becomes unbonded
+func (ms msgServer) BTCRedelegate(goCtx context.Context, req *types.MsgBTCRedelegate) (*types.MsgBTCRedelegateResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyBTCRedelegate)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// basic stateless checks
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	prevDel, stakingMsgTx, err := ms.getSpentBTCDelegation(ctx, req.StakerAddr, req.PreviousStakingTxHash, req.StakingTx, types.ErrInvalidRedelegation)
+	if err != nil {
+		return nil, err
+	}
+	prevStakingTxHash := prevDel.MustGetStakingTxHash()
+
+	// ensure the new staking tx only spends the previous staking output, so
+	// that the redelegated stake does not change apart from the tx fee
+	if len(stakingMsgTx.TxIn) != 1 {
+		return nil, types.ErrInvalidRedelegation.Wrap("staking tx must only spend the previous staking output")
+	}
+	spentOutputs, err := getSpentOutputs(prevDel, stakingMsgTx, nil, types.ErrInvalidRedelegation)
+	if err != nil {
+		return nil, err
+	}
+
+	// ensure the BTC delegation is redelegated to a different set of
+	// finality providers
+	if sameFpSet(prevDel.FpBtcPkList, req.FpBtcPkList) {
+		return nil, types.ErrInvalidRedelegation.Wrap("the BTC delegation is already delegated to the given finality providers")
+	}
+
+	// the BTC staker and its PoP are inherited from the previous BTC delegation.
+	// The rest goes through the same verification as creating a new BTC
+	// delegation, apart from the inclusion proof
+	createReq := req.ToMsgCreateBTCDelegation(prevDel)
+	if err := createReq.ValidateBasicWithoutInclusionProof(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	newBTCDel, err := ms.verifyBTCDelegation(ctx, createReq)
+	if err != nil {
+		return nil, err
+	}
+	// the timelock is unknown until the staking tx is included, thus only
+	// the staking time is recorded for now
+	newBTCDel.EndHeight = uint64(createReq.StakingTime)
+	newBTCDel.AwaitingInclusionProof = true
+	newBTCDel.PreviousStakingTxHash = prevStakingTxHash[:]
+	newBTCDel.SpentOutputs = spentOutputs
+
+	// add this BTC delegation, and emit corresponding events
+	if err := ms.AddBTCDelegation(ctx, newBTCDel); err != nil {
+		panic(fmt.Errorf("failed to add BTC delegation that has passed verification: %w", err))
+	}
+
+	// notify subscriber about the redelegation
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBTCRedelegation{
+		PreviousStakingTxHash: req.PreviousStakingTxHash,
+		StakingTxHash:         newBTCDel.MustGetStakingTxHash().String(),
+		SrcFpBtcPkList:        prevDel.FpBtcPkList,
+		DstFpBtcPkList:        newBTCDel.FpBtcPkList,
+		TotalSat:              newBTCDel.TotalSat,
+	}); err != nil {
+		panic(fmt.Errorf("failed to emit EventBTCRedelegation: %w", err))
+	}
+
+	return &types.MsgBTCRedelegateResponse{}, nil
+}
+
+// AddBTCDelegationInclusionProof proves the inclusion of the staking tx of a
+// BTC delegation that is registered without an inclusion proof upon stake
+// expansion or redelegation. Once the covenant quorum has signed the BTC delegation, including
+// the spending of the previous staking output, the staking tx can be included
+// on Bitcoin. The BTC delegation then becomes active and the previous one
+// becomes unbonded
+func (ms msgServer) AddBTCDelegationInclusionProof(goCtx context.Context, req *types.MsgAddBTCDelegationInclusionProof) (*types.MsgAddBTCDelegationInclusionProofResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyAddBTCDelegationInclusionProof)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// basic stateless checks
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	btcDel, params, err := ms.getBTCDelWithParams(ctx, req.StakingTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// ensure the BTC delegation is waiting for its inclusion proof
+	if btcDel.HasInclusionProof() {
+		return nil, types.ErrInvalidDelegationState.Wrap("the BTC delegation already has an inclusion proof")
+	}
+	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
+	wValue := ms.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	if btcDel.GetStatus(btcTip.Height, wValue, params.CovenantQuorum) != types.BTCDelegationStatus_PENDING {
+		return nil, types.ErrInvalidDelegationState.Wrap("the BTC delegation is not pending")
+	}
+
+	// ensure the covenant quorum has signed the BTC delegation. Otherwise,
+	// the staking tx cannot spend the previous staking output on Bitcoin
+	if !btcDel.HasCovenantQuorums(params.CovenantQuorum) {
+		return nil, types.ErrInvalidDelegationState.Wrap("the BTC delegation does not have a covenant quorum yet")
+	}
+
+	// ensure the previous BTC delegation is still active, i.e., its staking
+	// output is not spent by another BTC delegation or unbonded in the meantime
+	prevStakingTxHash, err := chainhash.NewHash(btcDel.PreviousStakingTxHash)
+	if err != nil {
+		return nil, types.ErrInvalidDelegationState.Wrapf("invalid previous staking tx hash: %v", err)
+	}
+	prevDel := ms.getBTCDelegation(ctx, *prevStakingTxHash)
+	if prevDel == nil {
+		return nil, types.ErrBTCDelegationNotFound.Wrap("the previous BTC delegation is not found")
+	}
+	if ms.getBTCDelegationStatus(ctx, prevDel, btcTip.Height, wValue) != types.BTCDelegationStatus_ACTIVE {
+		return nil, types.ErrInvalidDelegationState.Wrap("the previous BTC delegation is not active")
+	}
+
+	// verify the inclusion of the staking tx, which determines its timelock
+	stakingTxInfo := req.ToTransactionInfo(btcDel.StakingTx)
+	startHeight, endHeight, err := ms.verifyStakingTxInclusion(ctx, stakingTxInfo, uint32(btcDel.GetStakingTime()))
+	if err != nil {
+		return nil, err
+	}
+
+	// all good, set the timelock of the BTC delegation and activate it
+	if err := ms.setBTCDelegationInclusionProof(ctx, btcDel, startHeight, endHeight); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddBTCDelegationInclusionProofResponse{}, nil
+}
+
+// RotateFinalityProviderKey schedules the rotation of a finality provider's
+// BTC PK to a new BTC PK, which is authorised by the old BTC PK
+func (ms msgServer) RotateFinalityProviderKey(goCtx context.Context, req *types.MsgRotateFinalityProviderKey) (*types.MsgRotateFinalityProviderKeyResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyRotateFinalityProviderKey)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// basic stateless checks
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// ensure the finality provider exists and the old BTC PK is its current BTC PK
+	fp, err := ms.GetFinalityProvider(ctx, *req.OldBtcPk)
+	if err != nil {
+		return nil, err
+	}
+	if !fp.BtcPk.Equals(req.OldBtcPk) {
+		return nil, types.ErrFpKeyRotated.Wrapf("the current BTC PK of the finality provider is %s", fp.BtcPk.MarshalHex())
+	}
+
+	// ensure the signer corresponds to the finality provider's Babylon address
+	fpAddr, err := sdk.AccAddressFromBech32(req.Addr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %v", req.Addr, err)
+	}
+	if !strings.EqualFold(fpAddr.String(), fp.Addr) {
+		return nil, status.Errorf(codes.PermissionDenied, "the signer does not correspond to the finality provider's Babylon address")
+	}
+
+	// ensure the finality provider is not slashed and does not have a
+	// scheduled key rotation yet
+	if fp.IsSlashed() {
+		return nil, types.ErrFpAlreadySlashed
+	}
+	if ms.HasFinalityProviderKeyRotation(ctx, fp.BtcPk) {
+		return nil, types.ErrInvalidFpKeyRotation.Wrap("the finality provider already has a scheduled key rotation")
+	}
+
+	// ensure the new BTC PK is not used by any finality provider
+	if ms.HasFinalityProvider(ctx, *req.NewBtcPk) {
+		return nil, types.ErrFpRegistered.Wrap("the new BTC PK is already used by a finality provider")
+	}
+
+	// ensure the rotation is scheduled in the future
+	curHeight := uint64(ctx.HeaderInfo().Height)
+	if req.RotationHeight <= curHeight {
+		return nil, types.ErrInvalidFpKeyRotation.Wrapf("the rotation height (%d) is not higher than the current height (%d)", req.RotationHeight, curHeight)
+	}
+
+	// verify proof of possession of the new BTC PK
+	if err := req.NewPop.Verify(fpAddr, req.NewBtcPk, ms.btcNet); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid proof of possession: %v", err)
+	}
+
+	// verify the handover signature of the old BTC PK
+	if err := req.VerifyHandoverSig(); err != nil {
+		return nil, types.ErrInvalidFpKeyRotation.Wrapf("invalid handover signature: %v", err)
+	}
+
+	// all good, schedule the key rotation. Until the rotation height, the new
+	// BTC PK is an alias of the finality provider, such that BTC delegations
+	// can be migrated to it and it can commit public randomness in advance
+	rotation := &types.FinalityProviderKeyRotation{
+		OldBtcPk:       req.OldBtcPk,
+		NewBtcPk:       req.NewBtcPk,
+		NewPop:         req.NewPop,
+		RotationHeight: req.RotationHeight,
+	}
+	ms.setFinalityProviderKeyRotation(ctx, rotation)
+	ms.setFinalityProviderKeyAlias(ctx, req.NewBtcPk, req.OldBtcPk)
+
+	// notify subscriber, e.g., BTC stakers that need to migrate their BTC delegations
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventFinalityProviderKeyRotationScheduled{Rotation: rotation}); err != nil {
+		panic(fmt.Errorf("failed to emit EventFinalityProviderKeyRotationScheduled: %w", err))
+	}
+
+	return &types.MsgRotateFinalityProviderKeyResponse{}, nil
+}
+
+// getSpentBTCDelegation retrieves the BTC delegation whose staking output is
+// spent by the given staking tx upon stake expansion or redelegation, and
+// ensures that it is active, belongs to the given staker and is staked with
+// the current covenant committee. It returns the previous BTC delegation and
+// the parsed staking tx
+func (ms msgServer) getSpentBTCDelegation(
+	ctx sdk.Context,
+	stakerAddr string,
+	prevStakingTxHashStr string,
+	stakingTxBytes []byte,
+	errType *errorsmod.Error,
+) (*types.BTCDelegation, *wire.MsgTx, error) {
+	prevDel, prevParams, err := ms.getBTCDelWithParams(ctx, prevStakingTxHashStr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// ensure the previous BTC delegation is active
+	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
+	wValue := ms.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	if prevDel.GetStatus(btcTip.Height, wValue, prevParams.CovenantQuorum) != types.BTCDelegationStatus_ACTIVE {
+		return nil, nil, errType.Wrap("the previous BTC delegation is not active")
+	}
+
+	// ensure the signer is the staker of the previous BTC delegation
+	if stakerAddr != prevDel.StakerAddr {
+		return nil, nil, errType.Wrap("the signer does not correspond to the staker of the previous BTC delegation")
+	}
+
+	// ensure the previous BTC delegation is staked with the current covenant
+	// committee, which co-signs both the spending of the previous staking
+	// output and the new BTC delegation
+	if !ms.GetParams(ctx).HasSameCovenantCommittee(prevParams) {
+		return nil, nil, errType.Wrap("the previous BTC delegation is staked with a different covenant committee")
+	}
+
+	// ensure the new staking tx spends the previous staking output
+	stakingMsgTx, err := bbn.NewBTCTxFromBytes(stakingTxBytes)
+	if err != nil {
+		return nil, nil, types.ErrInvalidStakingTx.Wrapf("cannot be parsed: %v", err)
+	}
+	prevStakingTxHash := prevDel.MustGetStakingTxHash()
+	prevStakingOutPoint := wire.NewOutPoint(&prevStakingTxHash, prevDel.StakingOutputIdx)
+	if !spendsOutPoint(stakingMsgTx, prevStakingOutPoint) {
+		return nil, nil, errType.Wrap("staking tx does not spend the previous staking output")
+	}
+
+	return prevDel, stakingMsgTx, nil
+}
+
+// getSpentOutputs returns the serialized outputs spent by the inputs of the
+// given staking tx, in the order of the inputs. The previous staking output is
+// taken from the previous BTC delegation, and the other ones from the given
+// funding txs
+func getSpentOutputs(
+	prevDel *types.BTCDelegation,
+	stakingMsgTx *wire.MsgTx,
+	fundingTxs [][]byte,
+	errType *errorsmod.Error,
+) ([][]byte, error) {
+	prevStakingMsgTx, err := bbn.NewBTCTxFromBytes(prevDel.StakingTx)
+	if err != nil {
+		panic(fmt.Errorf("failed to parse staking tx of a verified BTC delegation: %w", err))
+	}
+	txs := map[chainhash.Hash]*wire.MsgTx{prevStakingMsgTx.TxHash(): prevStakingMsgTx}
+	for _, fundingTxBytes := range fundingTxs {
+		fundingTx, err := bbn.NewBTCTxFromBytes(fundingTxBytes)
+		if err != nil {
+			return nil, errType.Wrapf("cannot parse funding tx: %v", err)
+		}
+		txs[fundingTx.TxHash()] = fundingTx
+	}
+
+	spentOutputs := make([][]byte, 0, len(stakingMsgTx.TxIn))
+	for i, txIn := range stakingMsgTx.TxIn {
+		tx, ok := txs[txIn.PreviousOutPoint.Hash]
+		if !ok || txIn.PreviousOutPoint.Index >= uint32(len(tx.TxOut)) {
+			return nil, errType.Wrapf("the output spent by input %d of the staking tx is not found in the funding txs", i)
+		}
+		spentOutput, err := bbn.SerializeBTCTxOut(tx.TxOut[txIn.PreviousOutPoint.Index])
+		if err != nil {
+			panic(fmt.Errorf("failed to serialize a parsed tx output: %w", err))
+		}
+		spentOutputs = append(spentOutputs, spentOutput)
+	}
+	return spentOutputs, nil
+}
+
+// spendsOutPoint returns whether any input of the given tx spends the given outpoint
+func spendsOutPoint(tx *wire.MsgTx, outPoint *wire.OutPoint) bool {
+	return getSpendingInputIdx(tx, outPoint) >= 0
+}
+
+// getSpendingInputIdx returns the index of the input of the given tx that
+// spends the given outpoint, or -1 if there is no such input
+func getSpendingInputIdx(tx *wire.MsgTx, outPoint *wire.OutPoint) int {
+	for i, txIn := range tx.TxIn {
+		if txIn.PreviousOutPoint == *outPoint {
+			return i
+		}
+	}
+	return -1
+}
+
+// sameFpSet returns whether the two given lists of finality provider BTC PKs
+// contain the same set of finality providers
+func sameFpSet(a, b []bbn.BIP340PubKey) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	aSet := make(map[string]struct{}, len(a))
+	for _, pk := range a {
+		aSet[pk.MarshalHex()] = struct{}{}
+	}
+	for _, pk := range b {
+		if _, ok := aSet[pk.MarshalHex()]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// verifyBTCDelegation verifies the given request of creating a BTC delegation
+// against the current parameters and the BTC light client, and constructs the
+// BTC delegation to be added
+// TODO: refactor this function. It's now too convoluted
+func (ms msgServer) verifyBTCDelegation(ctx sdk.Context, req *types.MsgCreateBTCDelegation) (*types.BTCDelegation, error) {
 	vp := ms.GetParamsWithVersion(ctx)
 	btccParams := ms.btccKeeper.GetParams(ctx)
-	kValue, wValue := btccParams.BtcConfirmationDepth, btccParams.CheckpointFinalizationTimeout
 
 	minUnbondingTime := types.MinimumUnbondingTime(vp.Params, btccParams)
 
@@ -202,16 +711,28 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 
 	// Ensure all finality providers are known to Babylon, are not slashed,
 	// and their registered epochs are finalised
+	securesBabylon := false
 	for _, fpBTCPK := range req.FpBtcPkList {
 		// get this finality provider
 		fp, err := ms.GetFinalityProvider(ctx, fpBTCPK)
 		if err != nil {
 			return nil, err
 		}
+		securesBabylon = securesBabylon || fp.SecuresBabylon()
 		// ensure the finality provider is not slashed
 		if fp.IsSlashed() {
 			return nil, types.ErrFpAlreadySlashed
 		}
+		// ensure the BTC delegation is staked to the BTC PK that the finality
+		// provider will use, i.e., the new BTC PK of a scheduled key rotation
+		if err := ms.validateFpBTCPKForDelegation(ctx, fp, &fpBTCPK); err != nil {
+			return nil, err
+		}
+	}
+	// the BTC delegation can restake to finality providers of consumer chains,
+	// but has to secure Babylon as well
+	if !securesBabylon {
+		return nil, types.ErrNoBabylonFpRestaked
 	}
 
 	// Parse staking tx
@@ -257,31 +778,6 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		return nil, types.ErrInvalidStakingTx.Wrap("staking tx does not contain expected staking output")
 	}
 
-	// Check staking tx timelock has correct values
-	// get startheight and endheight of the timelock
-	stakingTxHeader := ms.btclcKeeper.GetHeaderByHash(ctx, req.StakingTx.Key.Hash)
-	if stakingTxHeader == nil {
-		return nil, fmt.Errorf("header that includes the staking tx is not found")
-	}
-	startHeight := stakingTxHeader.Height
-	endHeight := stakingTxHeader.Height + uint64(req.StakingTime)
-
-	// ensure staking tx is k-deep
-	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
-	stakingTxDepth := btcTip.Height - stakingTxHeader.Height
-	if stakingTxDepth < kValue {
-		return nil, types.ErrInvalidStakingTx.Wrapf("not k-deep: k=%d; depth=%d", kValue, stakingTxDepth)
-	}
-	// ensure staking tx's timelock has more than w BTC blocks left
-	if btcTip.Height+wValue >= endHeight {
-		return nil, types.ErrInvalidStakingTx.Wrapf("staking tx's timelock has no more than w(=%d) blocks left", wValue)
-	}
-
-	// verify staking tx info, i.e., inclusion proof
-	if err := req.StakingTx.VerifyInclusion(stakingTxHeader.Header, ms.btccKeeper.GetPowLimit()); err != nil {
-		return nil, types.ErrInvalidStakingTx.Wrapf("not included in the Bitcoin chain: %v", err)
-	}
-
 	// check slashing tx and its consistency with staking tx
 	slashingMsgTx, err := req.SlashingTx.ToMsgTx()
 	if err != nil {
@@ -330,13 +826,13 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 	// NOTE: the BTC delegation does not have voting power yet. It will
 	// have voting power only when 1) its corresponding staking tx is k-deep,
 	// and 2) it receives a covenant signature
+	// NOTE: the timelock of the BTC delegation is set by the caller upon
+	// verifying the inclusion of its staking tx
 	newBTCDel := &types.BTCDelegation{
 		StakerAddr:       stakerAddr.String(),
 		BtcPk:            req.BtcPk,
 		Pop:              req.Pop,
 		FpBtcPkList:      req.FpBtcPkList,
-		StartHeight:      startHeight,
-		EndHeight:        endHeight,
 		TotalSat:         uint64(stakingInfo.StakingOutput.Value),
 		StakingTx:        req.StakingTx.Transaction,
 		StakingOutputIdx: stakingOutputIdx,
@@ -452,12 +948,49 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		CovenantUnbondingSigList: nil,
 	}
 
-	// add this BTC delegation, and emit corresponding events
-	if err := ms.AddBTCDelegation(ctx, newBTCDel); err != nil {
-		panic(fmt.Errorf("failed to add BTC delegation that has passed verification: %w", err))
+	return newBTCDel, nil
+}
+
+// verifyStakingTxInclusion verifies that the given staking tx is included in
+// a BTC block that is k-deep, and that the staking tx's timelock has more than
+// w BTC blocks left. It returns the start and end heights of the timelock
+func (ms msgServer) verifyStakingTxInclusion(
+	ctx context.Context,
+	stakingTxInfo *btcctypes.TransactionInfo,
+	stakingTime uint32,
+) (uint64, uint64, error) {
+	btccParams := ms.btccKeeper.GetParams(ctx)
+	kValue, wValue := btccParams.BtcConfirmationDepth, btccParams.CheckpointFinalizationTimeout
+
+	// Check staking tx timelock has correct values
+	// get startheight and endheight of the timelock
+	stakingTxHeader := ms.btclcKeeper.GetHeaderByHash(ctx, stakingTxInfo.Key.Hash)
+	if stakingTxHeader == nil {
+		// the header is unknown, or has been pruned from the BTC light client as
+		// it is deeper than the headers that the BTC light client keeps, which
+		// are at least the maximum staking time, thus the timelock has expired
+		return 0, 0, fmt.Errorf("header that includes the staking tx is not found, or has been pruned")
 	}
+	startHeight := stakingTxHeader.Height
+	endHeight := stakingTxHeader.Height + uint64(stakingTime)
 
-	return &types.MsgCreateBTCDelegationResponse{}, nil
+	// ensure staking tx is k-deep
+	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
+	stakingTxDepth := btcTip.Height - stakingTxHeader.Height
+	if stakingTxDepth < kValue {
+		return 0, 0, types.ErrInvalidStakingTx.Wrapf("not k-deep: k=%d; depth=%d", kValue, stakingTxDepth)
+	}
+	// ensure staking tx's timelock has more than w BTC blocks left
+	if btcTip.Height+wValue >= endHeight {
+		return 0, 0, types.ErrInvalidStakingTx.Wrapf("staking tx's timelock has no more than w(=%d) blocks left", wValue)
+	}
+
+	// verify staking tx info, i.e., inclusion proof
+	if err := stakingTxInfo.VerifyInclusion(stakingTxHeader.Header, ms.btccKeeper.GetPowLimit()); err != nil {
+		return 0, 0, types.ErrInvalidStakingTx.Wrapf("not included in the Bitcoin chain: %v", err)
+	}
+
+	return startHeight, endHeight, nil
 }
 
 func (ms msgServer) getBTCDelWithParams(
@@ -476,6 +1009,63 @@ func (ms msgServer) getBTCDelWithParams(
 	return btcDel, bsParams, nil
 }
 
+// verifyStakeSpendingSig verifies the given covenant signature on the staking
+// tx of the given BTC delegation, which spends the staking output of the
+// previous BTC delegation via its unbonding path
+func (ms msgServer) verifyStakeSpendingSig(
+	ctx context.Context,
+	btcDel *types.BTCDelegation,
+	covPK *bbn.BIP340PubKey,
+	sig *bbn.BIP340Signature,
+) error {
+	prevStakingTxHash, err := chainhash.NewHash(btcDel.PreviousStakingTxHash)
+	if err != nil {
+		return fmt.Errorf("invalid previous staking tx hash: %w", err)
+	}
+	// the previous BTC delegation is kept while the BTC delegation is pending,
+	// but might have been pruned after the BTC delegation became active
+	prevDel := ms.getBTCDelegation(ctx, *prevStakingTxHash)
+	if prevDel == nil {
+		return types.ErrBTCDelegationNotFound.Wrapf("the previous BTC delegation %s is not found", prevStakingTxHash.String())
+	}
+	prevParams := ms.GetParamsByVersion(ctx, prevDel.ParamsVersion)
+	if prevParams == nil {
+		panic("params version in BTC delegation is not found")
+	}
+	prevStakingInfo, err := prevDel.GetStakingInfo(prevParams, ms.btcNet)
+	if err != nil {
+		panic(fmt.Errorf("failed to get staking info from a verified delegation: %w", err))
+	}
+	prevUnbondingSpendInfo, err := prevStakingInfo.UnbondingPathSpendInfo()
+	if err != nil {
+		// our staking info was constructed by using BuildStakingInfo constructor, so if
+		// this fails, it is a programming error
+		panic(err)
+	}
+
+	stakingMsgTx, err := bbn.NewBTCTxFromBytes(btcDel.StakingTx)
+	if err != nil {
+		panic(fmt.Errorf("failed to parse staking tx of a verified BTC delegation: %w", err))
+	}
+	inputIdx := getSpendingInputIdx(stakingMsgTx, wire.NewOutPoint(prevStakingTxHash, prevDel.StakingOutputIdx))
+	if inputIdx < 0 {
+		panic(fmt.Errorf("the staking tx of a verified BTC delegation does not spend the previous staking output"))
+	}
+	spentOutputs, err := btcDel.ParseSpentOutputs()
+	if err != nil {
+		panic(fmt.Errorf("failed to parse spent outputs of a verified BTC delegation: %w", err))
+	}
+
+	return btcstaking.VerifyTransactionSigWithPrevOutputs(
+		stakingMsgTx,
+		spentOutputs,
+		inputIdx,
+		prevUnbondingSpendInfo.GetPkScriptPath(),
+		covPK.MustToBTCPK(),
+		*sig,
+	)
+}
+
 // AddCovenantSig adds signatures from covenants to a BTC delegation
 // TODO: refactor this handler. Now it's too convoluted
 func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCovenantSigs) (*types.MsgAddCovenantSigsResponse, error) {
@@ -605,17 +1195,35 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 		return nil, types.ErrInvalidCovenantSig.Wrapf("err: %v", err)
 	}
 
+	/*
+		Verify Schnorr signature over staking tx spending the previous staking
+		output, upon stake expansion or redelegation
+	*/
+	if btcDel.SpendsPreviousStakingOutput() {
+		if req.StakeSpendingTxSig == nil {
+			return nil, types.ErrInvalidCovenantSig.Wrap("empty covenant signature on the staking tx spending the previous staking output")
+		}
+		if err := ms.verifyStakeSpendingSig(ctx, btcDel, req.Pk, req.StakeSpendingTxSig); err != nil {
+			return nil, types.ErrInvalidCovenantSig.Wrap(err.Error())
+		}
+	} else if req.StakeSpendingTxSig != nil {
+		return nil, types.ErrInvalidCovenantSig.Wrap("the BTC delegation does not spend a previous staking output")
+	}
+
 	// All is fine add received signatures to the BTC delegation and BtcUndelegation
 	// and emit corresponding events
-	ms.addCovenantSigsToBTCDelegation(
+	if err := ms.addCovenantSigsToBTCDelegation(
 		ctx,
 		btcDel,
 		req.Pk,
 		parsedSlashingAdaptorSignatures,
 		req.UnbondingTxSig,
 		parsedUnbondingSlashingAdaptorSignatures,
+		req.StakeSpendingTxSig,
 		params,
-	)
+	); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgAddCovenantSigsResponse{}, nil
 }
@@ -737,6 +1345,8 @@ func (ms msgServer) SelectiveSlashingEvidence(goCtx context.Context, req *types.
 	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
 		panic(fmt.Errorf("failed to emit EventSelectiveSlashing event: %w", err))
 	}
+	// notify the consumer chains that the BTC delegation restakes to
+	ms.notifyConsumersOfBTCDelegation(ctx, btcDel, types.NewConsumerEventWithSelectiveSlashing(event))
 
 	return &types.MsgSelectiveSlashingEvidenceResponse{}, nil
 }
@@ -8,10 +8,13 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/core/header"
 	sdkmath "cosmossdk.io/math"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/wire"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -22,6 +25,7 @@ import (
 	testhelper "github.com/babylonchain/babylon/testutil/helper"
 	bbn "github.com/babylonchain/babylon/types"
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	"github.com/babylonchain/babylon/x/btcstaking/keeper"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 )
@@ -49,11 +53,13 @@ func FuzzMsgCreateFinalityProvider(f *testing.F) {
 			fp, err := datagen.GenRandomFinalityProvider(r)
 			require.NoError(t, err)
 			msg := &types.MsgCreateFinalityProvider{
-				Addr:        fp.Addr,
-				Description: fp.Description,
-				Commission:  fp.Commission,
-				BtcPk:       fp.BtcPk,
-				Pop:         fp.Pop,
+				Addr:          fp.Addr,
+				Description:   fp.Description,
+				Commission:    fp.Commission,
+				MaxRate:       fp.MaxRate,
+				MaxChangeRate: fp.MaxChangeRate,
+				BtcPk:         fp.BtcPk,
+				Pop:           fp.Pop,
 			}
 			_, err = h.MsgServer.CreateFinalityProvider(h.Ctx, msg)
 			require.NoError(t, err)
@@ -69,11 +75,13 @@ func FuzzMsgCreateFinalityProvider(f *testing.F) {
 		// duplicated finality providers should not pass
 		for _, fp2 := range fps {
 			msg := &types.MsgCreateFinalityProvider{
-				Addr:        fp2.Addr,
-				Description: fp2.Description,
-				Commission:  fp2.Commission,
-				BtcPk:       fp2.BtcPk,
-				Pop:         fp2.Pop,
+				Addr:          fp2.Addr,
+				Description:   fp2.Description,
+				Commission:    fp2.Commission,
+				MaxRate:       fp2.MaxRate,
+				MaxChangeRate: fp2.MaxChangeRate,
+				BtcPk:         fp2.BtcPk,
+				Pop:           fp2.Pop,
 			}
 			_, err := h.MsgServer.CreateFinalityProvider(h.Ctx, msg)
 			require.Error(t, err)
@@ -98,8 +106,12 @@ func FuzzMsgEditFinalityProvider(f *testing.F) {
 		// assert the finality providers exist in KVStore
 		require.True(t, bsKeeper.HasFinalityProvider(h.Ctx, *fp.BtcPk))
 
-		// updated commission and description
-		newCommission := datagen.GenRandomCommission(r)
+		blockTime := time.Now().UTC()
+		h.Ctx = h.Ctx.WithHeaderInfo(header.Info{Height: 1, Time: blockTime})
+
+		// updated commission and description, where the commission increase
+		// respects both the max rate and the max change rate
+		newCommission := sdkmath.LegacyMinDec(fp.Commission.Add(*fp.MaxChangeRate), *fp.MaxRate)
 		newDescription := datagen.GenRandomDescription(r)
 
 		// scenario 1: editing finality provider should succeed
@@ -115,8 +127,45 @@ func FuzzMsgEditFinalityProvider(f *testing.F) {
 		h.NoError(err)
 		require.Equal(t, newCommission, *editedFp.Commission)
 		require.Equal(t, newDescription, editedFp.Description)
+		require.Equal(t, blockTime, editedFp.CommissionUpdateTime)
+
+		// scenario 2: changing the commission again within 24h should fail,
+		// while editing the description only should succeed
+		h.Ctx = h.Ctx.WithHeaderInfo(header.Info{Height: 1, Time: blockTime.Add(types.CommissionUpdateInterval - time.Second)})
+		lowerCommission := newCommission.Sub(sdkmath.LegacyNewDecWithPrec(1, 2))
+		msg.Commission = &lowerCommission
+		_, err = msgSrvr.EditFinalityProvider(h.Ctx, msg)
+		require.ErrorIs(t, err, types.ErrCommissionUpdateTime)
+		newDescription = datagen.GenRandomDescription(r)
+		msg.Description = newDescription
+		msg.Commission = &newCommission
+		_, err = msgSrvr.EditFinalityProvider(h.Ctx, msg)
+		h.NoError(err)
+		editedFp, err = bsKeeper.GetFinalityProvider(h.Ctx, *fp.BtcPk)
+		h.NoError(err)
+		require.Equal(t, newDescription, editedFp.Description)
+		require.Equal(t, blockTime, editedFp.CommissionUpdateTime)
 
-		// scenario 2: message from an unauthorised signer should fail
+		// scenario 3: after 24h, the commission cannot exceed the max rate
+		// or be increased by more than the max change rate
+		h.Ctx = h.Ctx.WithHeaderInfo(header.Info{Height: 1, Time: blockTime.Add(types.CommissionUpdateInterval)})
+		aboveMaxRate := fp.MaxRate.Add(sdkmath.LegacyNewDecWithPrec(1, 2))
+		msg.Commission = &aboveMaxRate
+		_, err = msgSrvr.EditFinalityProvider(h.Ctx, msg)
+		require.ErrorIs(t, err, types.ErrCommissionGTFpMaxRate)
+		aboveMaxChangeRate := newCommission.Add(*fp.MaxChangeRate).Add(sdkmath.LegacyNewDecWithPrec(1, 2))
+		if aboveMaxChangeRate.LTE(*fp.MaxRate) {
+			msg.Commission = &aboveMaxChangeRate
+			_, err = msgSrvr.EditFinalityProvider(h.Ctx, msg)
+			require.ErrorIs(t, err, types.ErrCommissionGTMaxChangeRate)
+		}
+		// decreasing the commission is not bounded by the max change rate
+		zeroCommission := sdkmath.LegacyZeroDec()
+		msg.Commission = &zeroCommission
+		_, err = msgSrvr.EditFinalityProvider(h.Ctx, msg)
+		h.NoError(err)
+
+		// scenario 4: message from an unauthorised signer should fail
 		newCommission = datagen.GenRandomCommission(r)
 		newDescription = datagen.GenRandomDescription(r)
 		invalidAddr := datagen.GenRandomAccount().Address
@@ -130,6 +179,131 @@ func FuzzMsgEditFinalityProvider(f *testing.F) {
 		h.EqualError(err, status.Errorf(codes.PermissionDenied, "the signer does not correspond to the finality provider's Babylon address"))
 		errStatus := status.Convert(err)
 		require.Equal(t, codes.PermissionDenied, errStatus.Code())
+
+		// scenario 5: a finality provider registered before the max rates were
+		// introduced can be edited, and gets the default max rates
+		legacyFp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		legacyFp.MaxRate = nil
+		legacyFp.MaxChangeRate = nil
+		h.AddFinalityProvider(legacyFp)
+		msg = &types.MsgEditFinalityProvider{
+			Addr:        legacyFp.Addr,
+			BtcPk:       *legacyFp.BtcPk,
+			Description: newDescription,
+			Commission:  &newCommission,
+		}
+		_, err = msgSrvr.EditFinalityProvider(h.Ctx, msg)
+		h.NoError(err)
+		editedFp, err = bsKeeper.GetFinalityProvider(h.Ctx, *legacyFp.BtcPk)
+		h.NoError(err)
+		require.Equal(t, newCommission, *editedFp.Commission)
+		require.Equal(t, sdkmath.LegacyOneDec(), *editedFp.MaxRate)
+		require.Equal(t, sdkmath.LegacyOneDec(), *editedFp.MaxChangeRate)
+	})
+}
+
+func FuzzConsumerFinalityProvider(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// a finality provider cannot be bound to an unregistered consumer chain
+		consumerID := datagen.GenRandomHexStr(r, 10)
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		h.NoError(err)
+		h.BTCStkConsumerKeeper.EXPECT().IsConsumerRegistered(gomock.Any(), consumerID).Return(false).Times(1)
+		_, err = h.MsgServer.CreateFinalityProvider(h.Ctx, &types.MsgCreateFinalityProvider{
+			Addr:          fp.Addr,
+			Description:   fp.Description,
+			Commission:    fp.Commission,
+			MaxRate:       fp.MaxRate,
+			MaxChangeRate: fp.MaxChangeRate,
+			BtcPk:         fp.BtcPk,
+			Pop:           fp.Pop,
+			ConsumerId:    consumerID,
+		})
+		require.ErrorIs(t, err, types.ErrConsumerNotRegistered)
+		require.False(t, h.BTCStakingKeeper.HasFinalityProvider(h.Ctx, *fp.BtcPk))
+
+		// bind a finality provider to the registered consumer chain
+		h.BTCStkConsumerKeeper.EXPECT().IsConsumerRegistered(gomock.Any(), consumerID).Return(true).Times(1)
+		h.BTCStkConsumerKeeper.EXPECT().AddConsumerFinalityProvider(gomock.Any(), consumerID, gomock.Any()).Return(nil).Times(1)
+		_, consumerFpPK, consumerFp := h.CreateConsumerFinalityProvider(r, consumerID)
+		actualFp, err := h.BTCStakingKeeper.GetFinalityProvider(h.Ctx, *consumerFp.BtcPk)
+		h.NoError(err)
+		require.Equal(t, consumerID, actualFp.ConsumerId)
+		require.False(t, actualFp.SecuresBabylon())
+
+		// a BTC delegation cannot be staked to consumer finality providers only
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		minUnbondingTime := types.MinimumUnbondingTime(bsParams, h.BTCCheckpointKeeper.GetParams(h.Ctx))
+		stakingValue := int64(2 * 10e8)
+		_, _, _, _, err = h.CreateRestakedDelegationCustom(
+			r,
+			[]*btcec.PublicKey{consumerFpPK},
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+			stakingValue-1000,
+			uint16(minUnbondingTime)+1,
+		)
+		require.ErrorIs(t, err, types.ErrNoBabylonFpRestaked)
+
+		// a BTC delegation restaked to a Babylon finality provider and the
+		// consumer finality provider only adds voting power on Babylon to the
+		// Babylon finality provider
+		_, babylonFpPK, babylonFp := h.CreateFinalityProvider(r)
+		_, _, _, msgCreateBTCDel, actualDel := h.CreateRestakedDelegation(
+			r,
+			[]*btcec.PublicKey{babylonFpPK, consumerFpPK},
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetVotingPower(h.Ctx, *babylonFp.BtcPk, babylonHeight))
+		require.Zero(t, h.BTCStakingKeeper.GetVotingPower(h.Ctx, *consumerFp.BtcPk, babylonHeight))
+		dc, err := h.BTCStakingKeeper.GetVotingPowerDistCache(h.Ctx, babylonHeight)
+		h.NoError(err)
+		require.Len(t, dc.FinalityProviders, 1)
+		require.Equal(t, babylonFp.BtcPk, dc.FinalityProviders[0].BtcPk)
+
+		// slashing the consumer finality provider upon a double-signing
+		// evidence from its consumer chain slashes the shared staking output,
+		// thus the restaked BTC delegation loses its voting power on Babylon
+		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, consumerFp.BtcPk.MustMarshal())
+		h.NoError(err)
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		require.Zero(t, h.BTCStakingKeeper.GetVotingPower(h.Ctx, *babylonFp.BtcPk, babylonHeight))
+		_, err = h.BTCStakingKeeper.GetVotingPowerDistCache(h.Ctx, babylonHeight)
+		require.ErrorIs(t, err, types.ErrVotingPowerDistCacheNotFound)
 	})
 }
 
@@ -384,6 +558,736 @@ func FuzzBTCUndelegate(f *testing.F) {
 	})
 }
 
+func FuzzExpandBTCDelegation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		wValue := h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// generate and insert new BTC delegation
+		stakingValue := int64(2 * 10e8)
+		prevStakingTxHash, delSK, _, msgCreateBTCDel, prevDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+
+		// expanding a pending BTC delegation should fail
+		_, _, err = h.ExpandDelegation(r, delSK, fpPK, prevDel, 2*stakingValue, 1000)
+		require.ErrorIs(t, err, types.ErrInvalidStakeExpansion)
+
+		// add covenant signatures to this BTC delegation
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, prevDel)
+		prevDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, prevStakingTxHash)
+		h.NoError(err)
+		btcTip := h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, prevDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum))
+
+		// expanding with no more value than the previous staking output should fail
+		_, _, err = h.ExpandDelegation(r, delSK, fpPK, prevDel, stakingValue, 1000)
+		require.ErrorIs(t, err, types.ErrInvalidStakeExpansion)
+
+		// expand the BTC delegation
+		newStakingValue := stakingValue + int64(datagen.RandomInt(r, 10e8)) + 1
+		stakingTxHash, msgExpandBTCDel, err := h.ExpandDelegation(r, delSK, fpPK, prevDel, newStakingValue, 1000)
+		h.NoError(err)
+
+		// the new BTC delegation is pending while the previous one is still active
+		newDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.True(t, newDel.SpendsPreviousStakingOutput())
+		require.Equal(t, prevDel.MustGetStakingTxHash().String(), msgExpandBTCDel.PreviousStakingTxHash)
+		require.Equal(t, prevDel.BtcPk, newDel.BtcPk)
+		require.Equal(t, prevDel.FpBtcPkList, newDel.FpBtcPkList)
+		require.Equal(t, uint64(newStakingValue), newDel.TotalSat)
+		require.Len(t, newDel.SpentOutputs, 2)
+		require.Equal(t, types.BTCDelegationStatus_PENDING, newDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum))
+		prevDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, prevStakingTxHash)
+		h.NoError(err)
+		require.False(t, prevDel.IsSpentByStakingTx())
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, prevDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum))
+
+		// covenant signatures without a valid signature on the spending of
+		// the previous staking output should fail
+		msgCreateBTCDelForSigs := &types.MsgCreateBTCDelegation{
+			StakerAddr: msgExpandBTCDel.StakerAddr,
+			SlashingTx: msgExpandBTCDel.SlashingTx,
+		}
+		covMsgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDelForSigs, newDel)
+		missingSigMsg := *covMsgs[0]
+		missingSigMsg.StakeSpendingTxSig = nil
+		_, err = h.MsgServer.AddCovenantSigs(h.Ctx, &missingSigMsg)
+		require.ErrorIs(t, err, types.ErrInvalidCovenantSig)
+		bogusSigMsg := *covMsgs[0]
+		bogusSigMsg.StakeSpendingTxSig = covMsgs[0].UnbondingTxSig
+		_, err = h.MsgServer.AddCovenantSigs(h.Ctx, &bogusSigMsg)
+		require.ErrorIs(t, err, types.ErrInvalidCovenantSig)
+
+		// the new staking tx cannot be included before the covenant quorum
+		// co-signs the spending of the previous staking output
+		err = h.AddBTCDelegationInclusionProof(r, stakingTxHash)
+		require.ErrorIs(t, err, types.ErrInvalidDelegationState)
+
+		// add covenant signatures to the new BTC delegation
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDelForSigs, newDel)
+
+		// the new BTC delegation is still pending until the inclusion of its
+		// staking tx is proven, and the previous one is still active
+		newDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.Len(t, newDel.CovenantStakeSpendingSigs, int(bsParams.CovenantQuorum))
+		require.False(t, newDel.HasInclusionProof())
+		require.Equal(t, types.BTCDelegationStatus_PENDING, newDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum))
+		prevDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, prevStakingTxHash)
+		h.NoError(err)
+		require.False(t, prevDel.IsSpentByStakingTx())
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, prevDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum))
+		for _, event := range h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, btcTip, btcTip) {
+			require.NotEqual(t, stakingTxHash, event.GetBtcDelStateUpdate().GetStakingTxHash())
+		}
+
+		// prove the inclusion of the new staking tx
+		err = h.AddBTCDelegationInclusionProof(r, stakingTxHash)
+		h.NoError(err)
+
+		// the new BTC delegation is active and the previous one is unbonded
+		newDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.True(t, newDel.HasInclusionProof())
+		require.Equal(t, uint64(10), newDel.StartHeight)
+		require.Equal(t, uint64(10+1000), newDel.EndHeight)
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, newDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum))
+		prevDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, prevStakingTxHash)
+		h.NoError(err)
+		require.True(t, prevDel.IsSpentByStakingTx())
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED, prevDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum))
+
+		// proving the inclusion again should fail
+		err = h.AddBTCDelegationInclusionProof(r, stakingTxHash)
+		require.ErrorIs(t, err, types.ErrInvalidDelegationState)
+
+		// expanding the unbonded BTC delegation again should fail
+		_, _, err = h.ExpandDelegation(r, delSK, fpPK, prevDel, newStakingValue, 1000)
+		require.ErrorIs(t, err, types.ErrInvalidStakeExpansion)
+
+		// the voting power is carried over from the previous BTC delegation
+		// to the new one in the same batch of events
+		dc := types.NewVotingPowerDistCache()
+		fpDistInfo := types.NewFinalityProviderDistInfo(fp)
+		fpDistInfo.AddBTCDel(prevDel)
+		dc.AddFinalityProviderDistInfo(fpDistInfo)
+		events := h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, btcTip, btcTip)
+		newDc := h.BTCStakingKeeper.ProcessAllPowerDistUpdateEvents(h.Ctx, dc, events, bsParams.MaxActiveFinalityProviders)
+		require.Len(t, newDc.FinalityProviders, 1)
+		require.Equal(t, uint64(newStakingValue), newDc.FinalityProviders[0].TotalVotingPower)
+		require.Len(t, newDc.FinalityProviders[0].BtcDels, 1)
+		require.Equal(t, stakingTxHash, newDc.FinalityProviders[0].BtcDels[0].StakingTxHash)
+	})
+}
+
+func FuzzBTCRedelegate(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		wValue := h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert two finality providers
+		_, srcFpPK, srcFp := h.CreateFinalityProvider(r)
+		_, dstFpPK, dstFp := h.CreateFinalityProvider(r)
+
+		// generate and insert new BTC delegation to the source finality provider
+		stakingValue := int64(2 * 10e8)
+		prevStakingTxHash, delSK, _, msgCreateBTCDel, prevDel := h.CreateDelegation(
+			r,
+			srcFpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+
+		// redelegating a pending BTC delegation should fail
+		_, _, err = h.RedelegateDelegation(r, delSK, dstFpPK, prevDel, stakingValue-1000, 1000)
+		require.ErrorIs(t, err, types.ErrInvalidRedelegation)
+
+		// add covenant signatures to this BTC delegation
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, prevDel)
+		prevDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, prevStakingTxHash)
+		h.NoError(err)
+		btcTip := h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, prevDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum))
+
+		// redelegating to the same finality provider should fail
+		_, _, err = h.RedelegateDelegation(r, delSK, srcFpPK, prevDel, stakingValue-1000, 1000)
+		require.ErrorIs(t, err, types.ErrInvalidRedelegation)
+
+		// redelegate the BTC delegation, where the new staking output pays
+		// the tx fee out of the previous one
+		newStakingValue := stakingValue - 1000
+		stakingTxHash, msgBTCRedelegate, err := h.RedelegateDelegation(r, delSK, dstFpPK, prevDel, newStakingValue, 1000)
+		h.NoError(err)
+
+		// the new BTC delegation is pending while the previous one is still active
+		newDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.True(t, newDel.SpendsPreviousStakingOutput())
+		require.Equal(t, prevDel.BtcPk, newDel.BtcPk)
+		require.Equal(t, msgBTCRedelegate.FpBtcPkList, newDel.FpBtcPkList)
+		require.Len(t, newDel.SpentOutputs, 1)
+		require.Equal(t, types.BTCDelegationStatus_PENDING, newDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum))
+		prevDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, prevStakingTxHash)
+		h.NoError(err)
+		require.False(t, prevDel.IsSpentByStakingTx())
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, prevDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum))
+
+		// add covenant signatures to the new BTC delegation
+		msgCreateBTCDelForSigs := &types.MsgCreateBTCDelegation{
+			StakerAddr: msgBTCRedelegate.StakerAddr,
+			SlashingTx: msgBTCRedelegate.SlashingTx,
+		}
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDelForSigs, newDel)
+
+		// the new BTC delegation is still pending until the inclusion of its
+		// staking tx is proven, and the previous one is still active
+		newDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.False(t, newDel.HasInclusionProof())
+		require.Equal(t, types.BTCDelegationStatus_PENDING, newDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum))
+		prevDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, prevStakingTxHash)
+		h.NoError(err)
+		require.False(t, prevDel.IsSpentByStakingTx())
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, prevDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum))
+		for _, event := range h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, btcTip, btcTip) {
+			require.NotEqual(t, stakingTxHash, event.GetBtcDelStateUpdate().GetStakingTxHash())
+		}
+
+		// prove the inclusion of the new staking tx
+		err = h.AddBTCDelegationInclusionProof(r, stakingTxHash)
+		h.NoError(err)
+
+		// the new BTC delegation is active and the previous one is unbonded
+		newDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.True(t, newDel.HasInclusionProof())
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, newDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum))
+		prevDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, prevStakingTxHash)
+		h.NoError(err)
+		require.True(t, prevDel.IsSpentByStakingTx())
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED, prevDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum))
+
+		// redelegating the unbonded BTC delegation again should fail
+		_, _, err = h.RedelegateDelegation(r, delSK, srcFpPK, prevDel, newStakingValue-1000, 1000)
+		require.ErrorIs(t, err, types.ErrInvalidRedelegation)
+
+		// the voting power moves from the source finality provider to the
+		// destination one in the same batch of events
+		dc := types.NewVotingPowerDistCache()
+		srcFpDistInfo := types.NewFinalityProviderDistInfo(srcFp)
+		srcFpDistInfo.AddBTCDel(prevDel)
+		dc.AddFinalityProviderDistInfo(srcFpDistInfo)
+		events := h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, btcTip, btcTip)
+		newDc := h.BTCStakingKeeper.ProcessAllPowerDistUpdateEvents(h.Ctx, dc, events, bsParams.MaxActiveFinalityProviders)
+		require.Len(t, newDc.FinalityProviders, 1)
+		require.Equal(t, dstFp.BtcPk, newDc.FinalityProviders[0].BtcPk)
+		require.Equal(t, uint64(newStakingValue), newDc.FinalityProviders[0].TotalVotingPower)
+		require.Len(t, newDc.FinalityProviders[0].BtcDels, 1)
+		require.Equal(t, stakingTxHash, newDc.FinalityProviders[0].BtcDels[0].StakingTxHash)
+	})
+}
+
+func FuzzBTCRedelegationExpiresWithPreviousDelegation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		wValue := h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert two finality providers
+		_, srcFpPK, _ := h.CreateFinalityProvider(r)
+		_, dstFpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate and insert an active BTC delegation to the source finality
+		// provider
+		stakingValue := int64(2 * 10e8)
+		prevStakingTxHash, delSK, _, msgCreateBTCDel, prevDel := h.CreateDelegation(
+			r,
+			srcFpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, prevDel)
+		prevDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, prevStakingTxHash)
+		h.NoError(err)
+
+		// redelegate the BTC delegation and add covenant signatures to the new
+		// one, whose staking tx is never included on Bitcoin
+		stakingTxHash, msgBTCRedelegate, err := h.RedelegateDelegation(r, delSK, dstFpPK, prevDel, stakingValue-1000, 1000)
+		h.NoError(err)
+		newDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		msgCreateBTCDelForSigs := &types.MsgCreateBTCDelegation{
+			StakerAddr: msgBTCRedelegate.StakerAddr,
+			SlashingTx: msgBTCRedelegate.SlashingTx,
+		}
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDelForSigs, newDel)
+
+		// the timelock of the previous BTC delegation expires, which makes it
+		// unbonded
+		btcTip := &btclctypes.BTCHeaderInfo{Height: prevDel.EndHeight - wValue}
+		h.SetCtxHeight(datagen.RandomInt(r, 10) + 2)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+
+		// the redelegation awaiting its inclusion proof expires with the
+		// previous BTC delegation
+		newDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.True(t, newDel.IsExpiredWithPreviousDelegation())
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED, newDel.GetStatus(btcTip.Height, wValue, bsParams.CovenantQuorum))
+		events := h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, btcTip.Height+1, btcTip.Height+1)
+		require.Len(t, events, 1)
+		require.Equal(t, stakingTxHash, events[0].GetBtcDelStateUpdate().GetStakingTxHash())
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED, events[0].GetBtcDelStateUpdate().GetNewState())
+
+		// proving the inclusion of its staking tx should fail
+		err = h.AddBTCDelegationInclusionProof(r, stakingTxHash)
+		require.ErrorIs(t, err, types.ErrInvalidDelegationState)
+	})
+}
+
+func FuzzRotateFinalityProviderKey(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert a finality provider with two active BTC delegations
+		fpSK, fpPK, fp := h.CreateFinalityProvider(r)
+		stakingValue := int64(2 * 10e8)
+		prevStakingTxHash, delSK, _, msgCreateBTCDel, prevDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, prevDel)
+		prevDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, prevStakingTxHash)
+		h.NoError(err)
+		unmigratedStakingTxHash, unmigratedDelSK, _, msgCreateBTCDel, unmigratedDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, unmigratedDel)
+		unmigratedDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, unmigratedStakingTxHash)
+		h.NoError(err)
+
+		fpAddr := sdk.MustAccAddressFromBech32(fp.Addr)
+		newFpSK, newFpPK, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		curHeight := uint64(h.Ctx.HeaderInfo().Height)
+		rotationHeight := curHeight + datagen.RandomInt(r, 100) + 1
+
+		// rotating at the current height should fail
+		msg, err := datagen.NewMsgRotateFinalityProviderKey(fpAddr, fpSK, newFpSK, curHeight)
+		h.NoError(err)
+		_, err = h.MsgServer.RotateFinalityProviderKey(h.Ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidFpKeyRotation)
+
+		// rotating to the BTC PK of another finality provider should fail
+		otherFpSK, _, _ := h.CreateFinalityProvider(r)
+		msg, err = datagen.NewMsgRotateFinalityProviderKey(fpAddr, fpSK, otherFpSK, rotationHeight)
+		h.NoError(err)
+		_, err = h.MsgServer.RotateFinalityProviderKey(h.Ctx, msg)
+		require.ErrorIs(t, err, types.ErrFpRegistered)
+
+		// rotating with a handover signature over another rotation height should fail
+		msg, err = datagen.NewMsgRotateFinalityProviderKey(fpAddr, fpSK, newFpSK, rotationHeight)
+		h.NoError(err)
+		msg.RotationHeight++
+		_, err = h.MsgServer.RotateFinalityProviderKey(h.Ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidFpKeyRotation)
+
+		// rotating from a different signer should fail
+		msg, err = datagen.NewMsgRotateFinalityProviderKey(fpAddr, fpSK, newFpSK, rotationHeight)
+		h.NoError(err)
+		msg.Addr = datagen.GenRandomAccount().Address
+		_, err = h.MsgServer.RotateFinalityProviderKey(h.Ctx, msg)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// schedule the key rotation
+		msg, err = datagen.NewMsgRotateFinalityProviderKey(fpAddr, fpSK, newFpSK, rotationHeight)
+		h.NoError(err)
+		_, err = h.MsgServer.RotateFinalityProviderKey(h.Ctx, msg)
+		h.NoError(err)
+		require.True(t, h.BTCStakingKeeper.HasFinalityProviderKeyRotation(h.Ctx, fp.BtcPk))
+
+		// the new BTC PK resolves to the finality provider before the rotation height
+		aliasedFp, err := h.BTCStakingKeeper.GetFinalityProvider(h.Ctx, *msg.NewBtcPk)
+		h.NoError(err)
+		require.Equal(t, fp.BtcPk, aliasedFp.BtcPk)
+
+		// scheduling another key rotation should fail
+		_, err = h.MsgServer.RotateFinalityProviderKey(h.Ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidFpKeyRotation)
+
+		// new BTC delegations to the old BTC PK should fail
+		_, _, _, _, err = h.CreateDelegationCustom(r, fpPK, changeAddress.EncodeAddress(), stakingValue, 1000, stakingValue-1000, 1000)
+		require.ErrorIs(t, err, types.ErrFpKeyRotated)
+
+		// migrate one BTC delegation to the new BTC PK
+		stakingTxHash, msgBTCRedelegate, err := h.RedelegateDelegation(r, delSK, newFpPK, prevDel, stakingValue-1000, 1000)
+		h.NoError(err)
+		newDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		msgCreateBTCDelForSigs := &types.MsgCreateBTCDelegation{
+			StakerAddr: msgBTCRedelegate.StakerAddr,
+			SlashingTx: msgBTCRedelegate.SlashingTx,
+		}
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDelForSigs, newDel)
+		err = h.AddBTCDelegationInclusionProof(r, stakingTxHash)
+		h.NoError(err)
+
+		// before the rotation height, the voting power of the migrated BTC
+		// delegation is counted under the old BTC PK
+		btcTip := h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height
+		dc := types.NewVotingPowerDistCache()
+		events := h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, btcTip, btcTip)
+		dc = h.BTCStakingKeeper.ProcessAllPowerDistUpdateEvents(h.Ctx, dc, events, bsParams.MaxActiveFinalityProviders)
+		require.Len(t, dc.FinalityProviders, 1)
+		require.Equal(t, fp.BtcPk, dc.FinalityProviders[0].BtcPk)
+		require.Equal(t, uint64(unmigratedDel.TotalSat+newDel.TotalSat), dc.FinalityProviders[0].TotalVotingPower)
+		h.BTCStakingKeeper.ClearPowerDistUpdateEvents(h.Ctx, btcTip)
+
+		// the key rotation is not processed before the rotation height
+		h.SetCtxHeight(rotationHeight - 1)
+		h.BTCStakingKeeper.ProcessFinalityProviderKeyRotations(h.Ctx)
+		require.True(t, h.BTCStakingKeeper.HasFinalityProviderKeyRotation(h.Ctx, fp.BtcPk))
+
+		// re-key the finality provider at the rotation height
+		h.SetCtxHeight(rotationHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: btcTip}).AnyTimes()
+		h.BTCStakingKeeper.ProcessFinalityProviderKeyRotations(h.Ctx)
+		require.False(t, h.BTCStakingKeeper.HasFinalityProviderKeyRotation(h.Ctx, fp.BtcPk))
+		for _, btcPK := range []*bbn.BIP340PubKey{fp.BtcPk, msg.NewBtcPk} {
+			rotatedFp, err := h.BTCStakingKeeper.GetFinalityProvider(h.Ctx, *btcPK)
+			h.NoError(err)
+			require.Equal(t, msg.NewBtcPk, rotatedFp.BtcPk)
+			require.Equal(t, msg.NewPop, rotatedFp.Pop)
+		}
+
+		// from the rotation on, the finality provider is recorded under the
+		// new BTC PK, and the BTC delegation that is still staked to the old
+		// BTC PK loses its voting power
+		events = h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, btcTip, btcTip)
+		dc = h.BTCStakingKeeper.ProcessAllPowerDistUpdateEvents(h.Ctx, dc, events, bsParams.MaxActiveFinalityProviders)
+		require.Len(t, dc.FinalityProviders, 1)
+		require.Equal(t, msg.NewBtcPk, dc.FinalityProviders[0].BtcPk)
+		require.Equal(t, newDel.TotalSat, dc.FinalityProviders[0].TotalVotingPower)
+		require.Len(t, dc.FinalityProviders[0].BtcDels, 1)
+		h.BTCStakingKeeper.ClearPowerDistUpdateEvents(h.Ctx, btcTip)
+
+		// migrating the other BTC delegation after the rotation restores its
+		// voting power under the new BTC PK
+		lateStakingTxHash, msgBTCRedelegate, err := h.RedelegateDelegation(r, unmigratedDelSK, newFpPK, unmigratedDel, stakingValue-1000, 1000)
+		h.NoError(err)
+		lateDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, lateStakingTxHash)
+		h.NoError(err)
+		msgCreateBTCDelForSigs = &types.MsgCreateBTCDelegation{
+			StakerAddr: msgBTCRedelegate.StakerAddr,
+			SlashingTx: msgBTCRedelegate.SlashingTx,
+		}
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDelForSigs, lateDel)
+		err = h.AddBTCDelegationInclusionProof(r, lateStakingTxHash)
+		h.NoError(err)
+
+		h.SetCtxHeight(rotationHeight + 1)
+		events = h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, btcTip, btcTip)
+		dc = h.BTCStakingKeeper.ProcessAllPowerDistUpdateEvents(h.Ctx, dc, events, bsParams.MaxActiveFinalityProviders)
+		require.Len(t, dc.FinalityProviders, 1)
+		require.Equal(t, msg.NewBtcPk, dc.FinalityProviders[0].BtcPk)
+		require.Equal(t, newDel.TotalSat+lateDel.TotalSat, dc.FinalityProviders[0].TotalVotingPower)
+		require.Len(t, dc.FinalityProviders[0].BtcDels, 2)
+	})
+}
+
+func FuzzRotateCovenantCommittee(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, covenantPKs := h.GenAndApplyParams(r)
+		oldParams := h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate three pending BTC delegations under the old covenant committee
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+		stakingValue := int64(2 * 10e8)
+		stakingTxHash, _, _, msgCreateBTCDel, pendingDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		covMsgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, pendingDel)
+		expiringStakingTxHash, _, _, msgCreateExpiringDel, expiringDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		expiringCovMsgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateExpiringDel, expiringDel)
+		handedOverStakingTxHash, _, _, msgCreateHandedOverDel, handedOverDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		handedOverCovMsgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateHandedOverDel, handedOverDel)
+
+		// the new covenant committee keeps 2 old covenant members and adds 5
+		// new ones, such that the last 3 old covenant members retire
+		_, newCovenantPKs, err := datagen.GenRandomBTCKeyPairs(r, 5)
+		h.NoError(err)
+		newCovenantPKs = append(newCovenantPKs, covenantPKs[:2]...)
+		retiringPKs := bbn.NewBIP340PKsFromBTCPKs(covenantPKs[2:])
+		curHeight := uint64(h.Ctx.HeaderInfo().Height)
+		activationHeight := curHeight + datagen.RandomInt(r, 100) + 1
+		msg := &types.MsgRotateCovenantCommittee{
+			Authority:         authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			NewCovenantPks:    bbn.NewBIP340PKsFromBTCPKs(newCovenantPKs),
+			NewCovenantQuorum: 5,
+			ActivationHeight:  activationHeight,
+		}
+
+		// rotating from a non-governance account should fail
+		invalidMsg := *msg
+		invalidMsg.Authority = datagen.GenRandomAccount().Address
+		_, err = h.MsgServer.RotateCovenantCommittee(h.Ctx, &invalidMsg)
+		require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+		// rotating with a covenant quorum no more than 1/2 should fail
+		invalidMsg = *msg
+		invalidMsg.NewCovenantQuorum = 3
+		_, err = h.MsgServer.RotateCovenantCommittee(h.Ctx, &invalidMsg)
+		require.ErrorIs(t, err, govtypes.ErrInvalidProposalMsg)
+
+		// rotating at the current height should fail
+		invalidMsg = *msg
+		invalidMsg.ActivationHeight = curHeight
+		_, err = h.MsgServer.RotateCovenantCommittee(h.Ctx, &invalidMsg)
+		require.ErrorIs(t, err, types.ErrInvalidCovenantRotation)
+
+		// schedule the covenant committee rotation
+		_, err = h.MsgServer.RotateCovenantCommittee(h.Ctx, msg)
+		h.NoError(err)
+		rotationResp, err := h.BTCStakingKeeper.CovenantCommitteeRotation(h.Ctx, &types.QueryCovenantCommitteeRotationRequest{})
+		h.NoError(err)
+		require.Equal(t, msg.ToRotation(), rotationResp.Rotation)
+		require.Equal(t, retiringPKs, rotationResp.RetiringCovenantPks)
+
+		// scheduling another rotation should fail
+		_, err = h.MsgServer.RotateCovenantCommittee(h.Ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidCovenantRotation)
+
+		// the pending BTC delegations depend on the retiring covenant members
+		// rather than the new ones
+		for _, covPK := range retiringPKs {
+			delsResp, err := h.BTCStakingKeeper.CovenantMemberDelegations(h.Ctx, &types.QueryCovenantMemberDelegationsRequest{CovenantPkHex: covPK.MarshalHex()})
+			h.NoError(err)
+			require.Len(t, delsResp.BtcDelegations, 3)
+			for _, delResp := range delsResp.BtcDelegations {
+				require.Equal(t, types.BTCDelegationStatus_PENDING.String(), delResp.StatusDesc)
+			}
+		}
+		newCovPK := bbn.NewBIP340PubKeyFromBTCPK(newCovenantPKs[0])
+		delsResp, err := h.BTCStakingKeeper.CovenantMemberDelegations(h.Ctx, &types.QueryCovenantMemberDelegationsRequest{CovenantPkHex: newCovPK.MarshalHex()})
+		h.NoError(err)
+		require.Empty(t, delsResp.BtcDelegations)
+
+		// the rotation does not take effect before the activation height
+		h.BTCStakingKeeper.ProcessCovenantCommitteeRotation(h.Ctx)
+		require.NotNil(t, h.BTCStakingKeeper.GetCovenantCommitteeRotation(h.Ctx))
+		require.Equal(t, oldParams, h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx))
+
+		// the old covenant members pre-sign one of the pending BTC delegations
+		// before the activation height, which becomes active w.r.t. the old
+		// covenant quorum
+		for _, covMsg := range covMsgs {
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, covMsg)
+			h.NoError(err)
+		}
+		delResp, err := h.BTCStakingKeeper.BTCDelegation(h.Ctx, &types.QueryBTCDelegationRequest{StakingTxHashHex: stakingTxHash})
+		h.NoError(err)
+		require.True(t, delResp.BtcDelegation.Active)
+		require.Equal(t, oldParams.Version, delResp.BtcDelegation.ParamsVersion)
+
+		// a retiring covenant member pre-signs another pending BTC delegation,
+		// whose covenant quorum can then be formed with the 2 continuing ones
+		_, err = h.MsgServer.AddCovenantSigs(h.Ctx, handedOverCovMsgs[2])
+		h.NoError(err)
+
+		// activate the new covenant committee at the activation height
+		btcTip := h.BTCLightClientKeeper.GetTipInfo(h.Ctx)
+		h.SetCtxHeight(activationHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(btcTip).AnyTimes()
+		h.BTCStakingKeeper.ProcessCovenantCommitteeRotation(h.Ctx)
+		require.Nil(t, h.BTCStakingKeeper.GetCovenantCommitteeRotation(h.Ctx))
+		newParams := h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx)
+		require.Equal(t, oldParams.Version+1, newParams.Version)
+		require.Equal(t, msg.NewCovenantPks, newParams.Params.CovenantPks)
+		require.Equal(t, msg.NewCovenantQuorum, newParams.Params.CovenantQuorum)
+		require.Equal(t, oldParams.Params.SlashingAddress, newParams.Params.SlashingAddress)
+
+		// the pending BTC delegation that cannot reach its covenant quorum
+		// without the retiring covenant members expires upon the rotation,
+		// while the pre-signed one remains active and the handed over one
+		// remains pending
+		delResp, err = h.BTCStakingKeeper.BTCDelegation(h.Ctx, &types.QueryBTCDelegationRequest{StakingTxHashHex: expiringStakingTxHash})
+		h.NoError(err)
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED.String(), delResp.BtcDelegation.StatusDesc)
+		delResp, err = h.BTCStakingKeeper.BTCDelegation(h.Ctx, &types.QueryBTCDelegationRequest{StakingTxHashHex: stakingTxHash})
+		h.NoError(err)
+		require.True(t, delResp.BtcDelegation.Active)
+		delResp, err = h.BTCStakingKeeper.BTCDelegation(h.Ctx, &types.QueryBTCDelegationRequest{StakingTxHashHex: handedOverStakingTxHash})
+		h.NoError(err)
+		require.Equal(t, types.BTCDelegationStatus_PENDING.String(), delResp.BtcDelegation.StatusDesc)
+
+		// the retiring covenant members only remain indexed for the handed
+		// over BTC delegation, as the others are no longer pending
+		for _, covPK := range retiringPKs {
+			delsResp, err := h.BTCStakingKeeper.CovenantMemberDelegations(h.Ctx, &types.QueryCovenantMemberDelegationsRequest{CovenantPkHex: covPK.MarshalHex()})
+			h.NoError(err)
+			require.Len(t, delsResp.BtcDelegations, 1)
+			stakingTx, _, err := bbn.NewBTCTxFromHex(delsResp.BtcDelegations[0].StakingTxHex)
+			h.NoError(err)
+			require.Equal(t, handedOverStakingTxHash, stakingTx.TxHash().String())
+		}
+
+		// the continuing covenant members complete the covenant quorum of the
+		// handed over BTC delegation, which leaves the index upon activation
+		for _, covMsg := range handedOverCovMsgs[:2] {
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, covMsg)
+			h.NoError(err)
+		}
+		delResp, err = h.BTCStakingKeeper.BTCDelegation(h.Ctx, &types.QueryBTCDelegationRequest{StakingTxHashHex: handedOverStakingTxHash})
+		h.NoError(err)
+		require.True(t, delResp.BtcDelegation.Active)
+		for _, covPK := range retiringPKs {
+			delsResp, err := h.BTCStakingKeeper.CovenantMemberDelegations(h.Ctx, &types.QueryCovenantMemberDelegationsRequest{CovenantPkHex: covPK.MarshalHex()})
+			h.NoError(err)
+			require.Empty(t, delsResp.BtcDelegations)
+		}
+
+		// a new covenant member cannot sign the expired BTC delegation
+		newCovMsg := *expiringCovMsgs[0]
+		newCovMsg.Pk = newCovPK
+		_, err = h.MsgServer.AddCovenantSigs(h.Ctx, &newCovMsg)
+		require.ErrorIs(t, err, types.ErrInvalidCovenantPK)
+
+		// signatures of the old covenant members on the expired BTC delegation
+		// are ignored
+		for _, covMsg := range expiringCovMsgs {
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, covMsg)
+			h.NoError(err)
+		}
+		expiredDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, expiringStakingTxHash)
+		h.NoError(err)
+		require.True(t, expiredDel.IsExpiredByCovenantRotation())
+		require.Empty(t, expiredDel.CovenantSigs)
+	})
+}
+
 func FuzzSelectiveSlashing(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
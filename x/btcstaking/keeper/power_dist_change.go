@@ -2,11 +2,14 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"sort"
 
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -56,6 +59,17 @@ func (k Keeper) UpdatePowerDist(ctx context.Context) {
 	// to construct the new distribution
 	newDc := k.ProcessAllPowerDistUpdateEvents(ctx, dc, events, maxActiveFps)
 
+	// expire the stake expansions and redelegations awaiting their inclusion
+	// proofs that spend newly unbonded BTC delegations
+	k.expireStakeSpendingDelegations(ctx, events, btcTipHeight)
+
+	// schedule the pruning of newly unbonded BTC delegations
+	k.schedulePruningOfUnbondedBTCDelegations(ctx, events, btcTipHeight)
+
+	// notify consumer chains of the state updates of BTC delegations
+	// restaked to their finality providers
+	k.notifyConsumersOfBTCDelegationStateUpdates(ctx, events)
+
 	// find newly bonded finality providers and execute the hooks
 	newBondedFinalityProviders := newDc.FindNewActiveFinalityProviders(dc, maxActiveFps)
 	for _, fp := range newBondedFinalityProviders {
@@ -106,7 +120,10 @@ func (k Keeper) recordMetrics(dc *types.VotingPowerDistCache, maxActiveFps uint3
 // The following events will affect the voting power distribution:
 // - newly active BTC delegations
 // - newly unbonded BTC delegations
-// - slashed finality providers
+// - slashed finality providers, along with the BTC delegations restaked to them
+// - jailed and unjailed finality providers
+// - commission updates of finality providers
+// - key rotations of finality providers
 func (k Keeper) ProcessAllPowerDistUpdateEvents(
 	ctx context.Context,
 	dc *types.VotingPowerDistCache,
@@ -120,6 +137,16 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 	unbondedBTCDels := map[string]struct{}{}
 	// a map where key is slashed finality providers' BTC PK
 	slashedFPs := map[string]struct{}{}
+	// a map where key is jailed finality providers' BTC PK
+	jailedFPs := map[string]struct{}{}
+	// a map where key is unjailed finality providers' BTC PK
+	unjailedFPs := map[string]struct{}{}
+	// a map where key is finality providers' BTC PK and value is the updated
+	// commission rate
+	fpCommissions := map[string]*sdkmath.LegacyDec{}
+	// a map where key is re-keyed finality providers' old BTC PK and value is
+	// the new BTC PK
+	rotatedFPs := map[string]*bbn.BIP340PubKey{}
 
 	/*
 		filter and classify all events into new/expired BTC delegations and slashed FPs
@@ -128,14 +155,29 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 		switch typedEvent := event.Ev.(type) {
 		case *types.EventPowerDistUpdate_BtcDelStateUpdate:
 			delEvent := typedEvent.BtcDelStateUpdate
+			// keep the BTC delegation status index in line with the voting
+			// power distribution
+			k.updateBTCDelegationStatusIndex(ctx, delEvent)
 			if delEvent.NewState == types.BTCDelegationStatus_ACTIVE {
 				// newly active BTC delegation
 				btcDel, err := k.GetBTCDelegation(ctx, delEvent.StakingTxHash)
 				if err != nil {
 					panic(err) // only programming error
 				}
+				// a BTC delegation restaked to a slashed finality provider has
+				// its staking output slashed, thus has no voting power under
+				// any finality provider
+				if k.isRestakedToSlashedFp(ctx, btcDel) {
+					continue
+				}
 				// add the BTC delegation to each restaked finality provider
-				for _, fpBTCPK := range btcDel.FpBtcPkList {
+				for i := range btcDel.FpBtcPkList {
+					// resolve the BTC PK that the voting power is recorded under,
+					// in case the finality provider is rotating or has rotated its key
+					fpBTCPK, ok := k.votingPowerFpBTCPK(ctx, &btcDel.FpBtcPkList[i])
+					if !ok {
+						continue
+					}
 					fpBTCPKHex := fpBTCPK.MarshalHex()
 					activeBTCDels[fpBTCPKHex] = append(activeBTCDels[fpBTCPKHex], btcDel)
 				}
@@ -145,7 +187,24 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 			}
 		case *types.EventPowerDistUpdate_SlashedFp:
 			// slashed finality providers
-			slashedFPs[typedEvent.SlashedFp.Pk.MarshalHex()] = struct{}{}
+			slashedFPs[k.currentFpBTCPKHex(ctx, typedEvent.SlashedFp.Pk)] = struct{}{}
+		case *types.EventPowerDistUpdate_JailedFp:
+			// jailed finality providers
+			fpBTCPKHex := k.currentFpBTCPKHex(ctx, typedEvent.JailedFp.Pk)
+			jailedFPs[fpBTCPKHex] = struct{}{}
+			delete(unjailedFPs, fpBTCPKHex)
+		case *types.EventPowerDistUpdate_UnjailedFp:
+			// unjailed finality providers
+			fpBTCPKHex := k.currentFpBTCPKHex(ctx, typedEvent.UnjailedFp.Pk)
+			unjailedFPs[fpBTCPKHex] = struct{}{}
+			delete(jailedFPs, fpBTCPKHex)
+		case *types.EventPowerDistUpdate_FpCommissionUpdate:
+			// finality providers with updated commission
+			fpBTCPKHex := k.currentFpBTCPKHex(ctx, typedEvent.FpCommissionUpdate.Pk)
+			fpCommissions[fpBTCPKHex] = typedEvent.FpCommissionUpdate.Commission
+		case *types.EventPowerDistUpdate_FpKeyRotation:
+			// re-keyed finality providers
+			rotatedFPs[typedEvent.FpKeyRotation.OldPk.MarshalHex()] = typedEvent.FpKeyRotation.NewPk
 		}
 	}
 
@@ -160,6 +219,15 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 	// not keyed in the cache. Need to find a way to optimise this.
 	newDc := types.NewVotingPowerDistCache()
 
+	// a BTC delegation shares a single staking output among all its restaked
+	// finality providers. Slashing any of them slashes the staking output, thus
+	// the BTC delegations staked to the slashed finality providers lose voting
+	// power under all the other finality providers as well. They are collected
+	// from the BTC delegation index of the slashed finality providers, which
+	// are not necessarily in the cache, e.g., if they have no voting power or
+	// provide finality to a consumer chain
+	slashedBTCDels := k.getSlashedBTCDelegations(ctx, slashedFPs)
+
 	// iterate over all finality providers and apply all events
 	for i := range dc.FinalityProviders {
 		// create a copy of the finality provider
@@ -169,24 +237,55 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 
 		fpBTCPKHex := fp.BtcPk.MarshalHex()
 
+		// if this finality provider is re-keyed, it is recorded under the new
+		// BTC PK, and only keeps the BTC delegations staked to the new BTC PK
+		newBTCPK, rotated := rotatedFPs[fpBTCPKHex]
+		if rotated {
+			fp.BtcPk = newBTCPK
+			fpBTCPKHex = newBTCPK.MarshalHex()
+		}
+
 		// if this finality provider is slashed, continue to avoid recording it
 		if _, ok := slashedFPs[fpBTCPKHex]; ok {
 			continue
 		}
 
+		// a jailed finality provider keeps its BTC delegations, but is not
+		// counted as active until it is unjailed
+		if _, ok := jailedFPs[fpBTCPKHex]; ok {
+			fp.IsJailed = true
+		}
+		if _, ok := unjailedFPs[fpBTCPKHex]; ok {
+			fp.IsJailed = false
+		}
+
+		// the updated commission applies from this height on
+		if commission, ok := fpCommissions[fpBTCPKHex]; ok {
+			fp.Commission = commission
+		}
+
 		// add all BTC delegations that are not unbonded to the new finality provider
 		for j := range dc.FinalityProviders[i].BtcDels {
 			btcDel := *dc.FinalityProviders[i].BtcDels[j]
-			if _, ok := unbondedBTCDels[btcDel.StakingTxHash]; !ok {
-				fp.AddBTCDelDistInfo(&btcDel)
+			if _, ok := unbondedBTCDels[btcDel.StakingTxHash]; ok {
+				continue
+			}
+			if _, ok := slashedBTCDels[btcDel.StakingTxHash]; ok {
+				continue
+			}
+			if rotated && !k.isStakedToFpBTCPK(ctx, btcDel.StakingTxHash, newBTCPK) {
+				continue
 			}
+			fp.AddBTCDelDistInfo(&btcDel)
 		}
 
 		// process all new BTC delegations under this finality provider
 		if fpActiveBTCDels, ok := activeBTCDels[fpBTCPKHex]; ok {
 			// handle new BTC delegations for this finality provider
 			for _, d := range fpActiveBTCDels {
-				fp.AddBTCDel(d)
+				if !isUnbonded(d, unbondedBTCDels) {
+					fp.AddBTCDel(d)
+				}
 			}
 			// remove the finality provider entry in activeBTCDels map, so that
 			// after the for loop the rest entries in activeBTCDels belongs to new
@@ -222,12 +321,19 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 		if err != nil {
 			panic(err) // only programming error
 		}
+		// a finality provider of a consumer chain has no voting power on
+		// Babylon, even though BTC delegations are restaked to it
+		if !newFP.SecuresBabylon() {
+			continue
+		}
 		fpDistInfo := types.NewFinalityProviderDistInfo(newFP)
 
 		// add each BTC delegation
 		fpActiveBTCDels := activeBTCDels[fpBTCPKHex]
 		for _, d := range fpActiveBTCDels {
-			fpDistInfo.AddBTCDel(d)
+			if !isUnbonded(d, unbondedBTCDels) {
+				fpDistInfo.AddBTCDel(d)
+			}
 		}
 
 		// add this finality provider to the new cache if it has voting power
@@ -243,6 +349,60 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 	return newDc
 }
 
+// currentFpBTCPKHex returns the hex of the BTC PK under which the finality
+// provider with the given BTC PK is currently stored. Events emitted before
+// a key rotation refer to the old BTC PK of the finality provider
+func (k Keeper) currentFpBTCPKHex(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) string {
+	return hex.EncodeToString(k.resolveFinalityProviderBTCPK(ctx, *fpBTCPK))
+}
+
+// getSlashedBTCDelegations returns the staking tx hashes of all BTC delegations
+// staked to the given slashed finality providers
+func (k Keeper) getSlashedBTCDelegations(ctx context.Context, slashedFPs map[string]struct{}) map[string]struct{} {
+	// sort the slashed finality providers to ensure determinism
+	fpBTCPKHexList := make([]string, 0, len(slashedFPs))
+	for fpBTCPKHex := range slashedFPs {
+		fpBTCPKHexList = append(fpBTCPKHexList, fpBTCPKHex)
+	}
+	sort.Strings(fpBTCPKHexList)
+
+	slashedBTCDels := map[string]struct{}{}
+	for _, fpBTCPKHex := range fpBTCPKHexList {
+		fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(fpBTCPKHex)
+		if err != nil {
+			panic(err) // only programming error
+		}
+		k.iterateFpBTCDelegationStakingTxHashes(ctx, fpBTCPK, func(stakingTxHash chainhash.Hash) bool {
+			slashedBTCDels[stakingTxHash.String()] = struct{}{}
+			return true
+		})
+	}
+	return slashedBTCDels
+}
+
+// isRestakedToSlashedFp returns whether any of the finality providers that the
+// given BTC delegation restakes to is slashed
+func (k Keeper) isRestakedToSlashedFp(ctx context.Context, btcDel *types.BTCDelegation) bool {
+	for _, fpBTCPK := range btcDel.FpBtcPkList {
+		fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+		if err != nil {
+			panic(err) // only programming error
+		}
+		if fp.IsSlashed() {
+			return true
+		}
+	}
+	return false
+}
+
+// isUnbonded returns whether the given newly active BTC delegation also
+// becomes unbonded among the same batch of events, e.g., a BTC delegation
+// that is expanded or redelegated right after becoming active
+func isUnbonded(btcDel *types.BTCDelegation, unbondedBTCDels map[string]struct{}) bool {
+	_, ok := unbondedBTCDels[btcDel.MustGetStakingTxHash().String()]
+	return ok
+}
+
 /* voting power distribution update event store */
 
 // addPowerDistUpdateEvent appends an event that affect voting power distribution
@@ -3,7 +3,11 @@ package keeper_test
 import (
 	"math/rand"
 	"testing"
+	"time"
 
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	btctest "github.com/babylonchain/babylon/testutil/bitcoin"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
@@ -142,6 +146,354 @@ func FuzzFinalityProviderEvents(f *testing.F) {
 	})
 }
 
+func FuzzRestakedFinalityProviderEvents(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert a random number of new finality providers, each
+		// with a BTC delegation of its own
+		numFps := int(datagen.RandomInt(r, 3)) + 2
+		fpSKs := []*btcec.PrivateKey{}
+		fpPKs := []*btcec.PublicKey{}
+		fps := []*types.FinalityProvider{}
+		stakingValue := int64(2 * 10e8)
+		for i := 0; i < numFps; i++ {
+			fpSK, fpPK, fp := h.CreateFinalityProvider(r)
+			fpSKs = append(fpSKs, fpSK)
+			fpPKs = append(fpPKs, fpPK)
+			fps = append(fps, fp)
+			_, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(r, fpPK, changeAddress.EncodeAddress(), stakingValue, 1000)
+			h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+		}
+
+		// insert a BTC delegation restaked to all finality providers, and
+		// give it covenant quorum
+		restakedValue := int64(3 * 10e8)
+		restakedTxHash, _, _, msgCreateBTCDel, restakedDel := h.CreateRestakedDelegation(r, fpPKs, changeAddress.EncodeAddress(), restakedValue, 1000)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, restakedDel)
+		// insert another restaked BTC delegation without covenant quorum yet
+		_, _, _, msgCreatePendingBTCDel, pendingDel := h.CreateRestakedDelegation(r, fpPKs, changeAddress.EncodeAddress(), restakedValue, 1000)
+
+		// execute BeginBlock
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		// the voting power of the restaked BTC delegation is attributed to
+		// each finality provider
+		for _, fp := range fps {
+			require.Equal(t, uint64(stakingValue+restakedValue), h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+		}
+
+		/*
+			Slash a random finality provider and execute BeginBlock
+			Then, ensure the restaked BTC delegation no longer has voting power
+			under any finality provider
+		*/
+		slashedIdx := int(datagen.RandomInt(r, numFps))
+		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, fps[slashedIdx].BtcPk.MustMarshal())
+		h.NoError(err)
+		// the pending restaked BTC delegation becomes active after the slashing
+		h.CreateCovenantSigs(r, covenantSKs, msgCreatePendingBTCDel, pendingDel)
+
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		for i, fp := range fps {
+			if i == slashedIdx {
+				require.Zero(t, h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+			} else {
+				require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+			}
+		}
+
+		// the slashed finality provider's SK decrypts the covenant adaptor
+		// signatures and slashes the staking output shared by all finality
+		// providers
+		restakedDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, restakedTxHash)
+		h.NoError(err)
+		stakingInfo, err := restakedDel.GetStakingInfo(&bsParams, h.Net)
+		h.NoError(err)
+		slashingTxWithWitness, err := restakedDel.BuildSlashingTxWithWitness(&bsParams, h.Net, fpSKs[slashedIdx])
+		h.NoError(err)
+		btctest.AssertSlashingTxExecution(t, stakingInfo.StakingOutput, slashingTxWithWitness)
+	})
+}
+
+func FuzzCrossChainRestakedFinalityProviderEvents(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert a Babylon finality provider with a BTC
+		// delegation of its own, and a finality provider of a consumer chain
+		stakingValue := int64(2 * 10e8)
+		_, babylonFpPK, babylonFp := h.CreateFinalityProvider(r)
+		_, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(r, babylonFpPK, changeAddress.EncodeAddress(), stakingValue, 1000)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+		consumerID := datagen.GenRandomHexStr(r, 10)
+		h.BTCStkConsumerKeeper.EXPECT().IsConsumerRegistered(gomock.Any(), consumerID).Return(true).AnyTimes()
+		h.BTCStkConsumerKeeper.EXPECT().AddConsumerFinalityProvider(gomock.Any(), consumerID, gomock.Any()).Return(nil).AnyTimes()
+		_, consumerFpPK, consumerFp := h.CreateConsumerFinalityProvider(r, consumerID)
+
+		// insert a BTC delegation restaked to both finality providers, and
+		// give it covenant quorum
+		restakedValue := int64(3 * 10e8)
+		_, _, _, msgCreateBTCDel, restakedDel := h.CreateRestakedDelegation(
+			r,
+			[]*btcec.PublicKey{babylonFpPK, consumerFpPK},
+			changeAddress.EncodeAddress(),
+			restakedValue,
+			1000,
+		)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, restakedDel)
+
+		// execute BeginBlock
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		// the voting power of the restaked BTC delegation is attributed to
+		// the Babylon finality provider only, as the consumer finality
+		// provider is not in the distribution cache
+		require.Equal(t, uint64(stakingValue+restakedValue), h.BTCStakingKeeper.GetVotingPower(h.Ctx, *babylonFp.BtcPk, babylonHeight))
+		dc, err := h.BTCStakingKeeper.GetVotingPowerDistCache(h.Ctx, babylonHeight)
+		h.NoError(err)
+		require.Len(t, dc.FinalityProviders, 1)
+
+		/*
+			Slash the consumer finality provider and execute BeginBlock
+			Then, ensure the restaked BTC delegation no longer has voting power
+			under the Babylon finality provider
+		*/
+		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, consumerFp.BtcPk.MustMarshal())
+		h.NoError(err)
+
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetVotingPower(h.Ctx, *babylonFp.BtcPk, babylonHeight))
+		dc, err = h.BTCStakingKeeper.GetVotingPowerDistCache(h.Ctx, babylonHeight)
+		h.NoError(err)
+		require.Len(t, dc.FinalityProviders, 1)
+		require.Len(t, dc.FinalityProviders[0].BtcDels, 1)
+		require.Equal(t, actualDel.MustGetStakingTxHash().String(), dc.FinalityProviders[0].BtcDels[0].StakingTxHash)
+	})
+}
+
+func FuzzJailFinalityProviderEvents(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		/*
+			insert new BTC delegation and give it covenant quorum
+			ensure that it has voting power
+		*/
+		stakingValue := int64(2 * 10e8)
+		_, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+
+		// execute BeginBlock
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		// ensure the finality provider has voting power at this height
+		require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+
+		/*
+			Jail the finality provider and execute BeginBlock
+			Then, ensure the finality provider does not have voting power anymore
+		*/
+		jailedUntil := h.Ctx.HeaderInfo().Time.Add(time.Hour)
+		err = h.BTCStakingKeeper.JailFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal(), jailedUntil)
+		h.NoError(err)
+		// jailing a jailed finality provider should fail
+		err = h.BTCStakingKeeper.JailFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal(), jailedUntil)
+		require.ErrorIs(t, err, types.ErrFpAlreadyJailed)
+
+		// execute BeginBlock
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		// ensure the finality provider does not have voting power anymore,
+		// while its BTC delegations are kept in the distribution cache
+		require.Zero(t, h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+		dc, err := h.BTCStakingKeeper.GetVotingPowerDistCache(h.Ctx, babylonHeight)
+		h.NoError(err)
+		require.Len(t, dc.FinalityProviders, 1)
+		require.True(t, dc.FinalityProviders[0].IsJailed)
+		require.Zero(t, dc.TotalVotingPower)
+
+		/*
+			Unjail the finality provider and execute BeginBlock
+			Then, ensure the finality provider has voting power again
+		*/
+		err = h.BTCStakingKeeper.UnjailFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal())
+		h.NoError(err)
+		// unjailing an unjailed finality provider should fail
+		err = h.BTCStakingKeeper.UnjailFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal())
+		require.ErrorIs(t, err, types.ErrFpNotJailed)
+
+		// execute BeginBlock
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		// ensure the finality provider has voting power again
+		require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+	})
+}
+
+func FuzzFinalityProviderCommissionEvents(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// insert new BTC delegation and give it covenant quorum
+		stakingValue := int64(2 * 10e8)
+		_, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+
+		// execute BeginBlock
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		// ensure the distribution cache carries the initial commission
+		dc, err := h.BTCStakingKeeper.GetVotingPowerDistCache(h.Ctx, babylonHeight)
+		h.NoError(err)
+		require.Len(t, dc.FinalityProviders, 1)
+		require.Equal(t, *fp.Commission, *dc.FinalityProviders[0].Commission)
+
+		/*
+			Change the commission after the rate limit period passes
+		*/
+		h.Ctx = h.Ctx.WithHeaderInfo(header.Info{
+			Height: int64(babylonHeight),
+			Time:   h.Ctx.HeaderInfo().Time.Add(types.CommissionUpdateInterval),
+		})
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		newCommission := sdkmath.LegacyMinDec(fp.Commission.Add(*fp.MaxChangeRate), *fp.MaxRate)
+		_, err = h.MsgServer.EditFinalityProvider(h.Ctx, &types.MsgEditFinalityProvider{
+			Addr:        fp.Addr,
+			BtcPk:       *fp.BtcPk,
+			Description: fp.Description,
+			Commission:  &newCommission,
+		})
+		h.NoError(err)
+		// the distribution cache at the current height keeps the old commission
+		dc, err = h.BTCStakingKeeper.GetVotingPowerDistCache(h.Ctx, babylonHeight)
+		h.NoError(err)
+		require.Equal(t, *fp.Commission, *dc.FinalityProviders[0].Commission)
+
+		// execute BeginBlock
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		// ensure the new commission applies from the next height on
+		dc, err = h.BTCStakingKeeper.GetVotingPowerDistCache(h.Ctx, babylonHeight)
+		h.NoError(err)
+		require.Len(t, dc.FinalityProviders, 1)
+		require.Equal(t, newCommission, *dc.FinalityProviders[0].Commission)
+		require.Equal(t, uint64(stakingValue), dc.TotalVotingPower)
+	})
+}
+
 func FuzzBTCDelegationEvents(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// indexStakeSpendingDelegation adds the given BTC delegation to the index of
+// BTC delegations awaiting their inclusion proofs by the previous BTC
+// delegation they spend, if it is created by a stake expansion or redelegation
+func (k Keeper) indexStakeSpendingDelegation(ctx context.Context, btcDel *types.BTCDelegation) {
+	if !btcDel.SpendsPreviousStakingOutput() || btcDel.HasInclusionProof() {
+		return
+	}
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	k.stakeSpendingDelegationStore(ctx, btcDel.PreviousStakingTxHash).Set(stakingTxHash[:], []byte{})
+}
+
+// removeStakeSpendingDelegation removes the given BTC delegation from the
+// index of BTC delegations awaiting their inclusion proofs
+func (k Keeper) removeStakeSpendingDelegation(ctx context.Context, btcDel *types.BTCDelegation) {
+	if !btcDel.SpendsPreviousStakingOutput() {
+		return
+	}
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	k.stakeSpendingDelegationStore(ctx, btcDel.PreviousStakingTxHash).Delete(stakingTxHash[:])
+}
+
+// hasPendingStakeSpendingDelegation returns whether a pending BTC delegation
+// awaiting its inclusion proof spends the BTC delegation with the given
+// staking tx hash at the given BTC height. Such a BTC delegation needs the
+// spent BTC delegation for verifying covenant signatures and its inclusion
+// proof.
+func (k Keeper) hasPendingStakeSpendingDelegation(ctx context.Context, prevStakingTxHash chainhash.Hash, btcTipHeight uint64) bool {
+	return len(k.getPendingStakeSpendingDelegations(ctx, prevStakingTxHash, btcTipHeight)) > 0
+}
+
+// getPendingStakeSpendingDelegations returns the pending BTC delegations
+// awaiting their inclusion proofs that spend the BTC delegation with the given
+// staking tx hash at the given BTC height
+func (k Keeper) getPendingStakeSpendingDelegations(ctx context.Context, prevStakingTxHash chainhash.Hash, btcTipHeight uint64) []*types.BTCDelegation {
+	iter := k.stakeSpendingDelegationStore(ctx, prevStakingTxHash[:]).Iterator(nil, nil)
+	defer iter.Close()
+
+	pendingDels := []*types.BTCDelegation{}
+	if !iter.Valid() {
+		// no BTC delegation spends the given one
+		return pendingDels
+	}
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	for ; iter.Valid(); iter.Next() {
+		stakingTxHash, err := chainhash.NewHash(iter.Key())
+		if err != nil {
+			panic(err) // only programming error
+		}
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil {
+			continue
+		}
+		if k.getBTCDelegationStatus(ctx, btcDel, btcTipHeight, wValue) == types.BTCDelegationStatus_PENDING {
+			pendingDels = append(pendingDels, btcDel)
+		}
+	}
+	return pendingDels
+}
+
+// expireStakeSpendingDelegations expires the pending BTC delegations awaiting
+// their inclusion proofs that spend the BTC delegations becoming unbonded in
+// the given power distribution update events. Once the previous staking output
+// is spent by the unbonding tx or another staking tx, or its timelock has less
+// than w BTC blocks left, the staking tx of such a BTC delegation can no longer
+// carry over the voting power of the previous BTC delegation. An expansion or
+// redelegation abandoned by its staker thus expires with the previous BTC
+// delegation at the latest. Each expired BTC delegation leaves the covenant,
+// stake spending and status indexes, and its unbonding is recorded at the next
+// BTC height, such that it is scheduled to be pruned.
+func (k Keeper) expireStakeSpendingDelegations(ctx context.Context, events []*types.EventPowerDistUpdate, btcTipHeight uint64) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	for _, event := range events {
+		delEvent := event.GetBtcDelStateUpdate()
+		if delEvent == nil || delEvent.NewState != types.BTCDelegationStatus_UNBONDED {
+			continue
+		}
+		prevStakingTxHash, err := chainhash.NewHashFromStr(delEvent.StakingTxHash)
+		if err != nil {
+			panic(err) // only programming error
+		}
+
+		for _, btcDel := range k.getPendingStakeSpendingDelegations(ctx, *prevStakingTxHash, btcTipHeight) {
+			btcDel.ExpiredWithPreviousDelegation = true
+			k.setBTCDelegation(ctx, btcDel)
+			k.removeCovenantDelegation(ctx, btcDel)
+			k.removeStakeSpendingDelegation(ctx, btcDel)
+			k.setBTCDelegationStatus(ctx, btcDel, types.BTCDelegationStatus_UNBONDED)
+
+			// notify subscriber about the expired BTC delegation
+			expiredEvent := &types.EventBTCDelegationStateUpdate{
+				StakingTxHash: btcDel.MustGetStakingTxHash().String(),
+				NewState:      types.BTCDelegationStatus_UNBONDED,
+			}
+			if err := sdkCtx.EventManager().EmitTypedEvent(expiredEvent); err != nil {
+				panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the expired BTC delegation: %w", err))
+			}
+
+			// record event that the BTC delegation becomes unbonded. The events
+			// up to the current BTC height are being consumed, thus it is
+			// recorded at the next BTC height
+			unbondedEvent := types.NewEventPowerDistUpdateWithBTCDel(expiredEvent)
+			k.addPowerDistUpdateEvent(ctx, btcTipHeight+1, unbondedEvent)
+		}
+	}
+}
+
+// RebuildStakeSpendingDelegationIndex rebuilds the index of BTC delegations
+// awaiting their inclusion proofs from all BTC delegations in the state. This
+// migrates a state that was created before the index existed.
+func (k Keeper) RebuildStakeSpendingDelegationIndex(ctx context.Context) {
+	iter := k.btcDelegationStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(iter.Value(), &btcDel)
+		k.indexStakeSpendingDelegation(ctx, &btcDel)
+	}
+}
+
+// stakeSpendingDelegationStore returns the KVStore of the staking tx hashes of
+// all BTC delegations that spend the given previous BTC delegation upon stake
+// expansion or redelegation, and are awaiting their inclusion proofs
+// prefix: StakeSpendingDelegationKey || previous staking tx hash
+// key: staking tx hash
+// value: empty
+func (k Keeper) stakeSpendingDelegationStore(ctx context.Context, prevStakingTxHash []byte) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	spendingStore := prefix.NewStore(storeAdapter, types.StakeSpendingDelegationKey)
+	return prefix.NewStore(spendingStore, prevStakingTxHash)
+}
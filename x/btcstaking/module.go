@@ -24,6 +24,7 @@ import (
 var (
 	_ appmodule.AppModule       = AppModule{}
 	_ appmodule.HasBeginBlocker = AppModule{}
+	_ appmodule.HasPrecommit    = AppModule{}
 	_ module.HasABCIEndBlock    = AppModule{}
 	_ module.AppModuleBasic     = AppModuleBasic{}
 )
@@ -141,6 +142,12 @@ func (am AppModule) EndBlock(ctx context.Context) ([]abci.ValidatorUpdate, error
 	return EndBlocker(ctx, am.keeper)
 }
 
+// Precommit persists the BTC delegations pruned in the block to the local
+// archive, if any
+func (am AppModule) Precommit(ctx context.Context) error {
+	return am.keeper.CommitArchive(ctx)
+}
+
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (am AppModule) IsOnePerModuleType() { // marker
 }
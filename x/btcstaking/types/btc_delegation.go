@@ -83,15 +83,66 @@ func (d *BTCDelegation) IsUnbondedEarly() bool {
 	return d.BtcUndelegation.DelegatorUnbondingSig != nil
 }
 
+// SpendsPreviousStakingOutput returns whether the BTC delegation's staking tx
+// spends the staking output of a previous BTC delegation, i.e., whether it
+// is created by a stake expansion or a redelegation
+func (d *BTCDelegation) SpendsPreviousStakingOutput() bool {
+	return len(d.PreviousStakingTxHash) > 0
+}
+
+// HasInclusionProof returns whether the BTC delegation's staking tx is proven
+// to be included on Bitcoin, which determines its timelock. A BTC delegation
+// created upon stake expansion or redelegation is registered before its staking
+// tx is included, as the staking tx needs the covenant signatures on spending
+// the previous staking output first
+func (d *BTCDelegation) HasInclusionProof() bool {
+	return !d.AwaitingInclusionProof
+}
+
+// IsSpentByStakingTx returns whether the BTC delegation's staking output has
+// been spent by the staking tx of another BTC delegation that has become active,
+// upon stake expansion or redelegation. Babylon will consider such a BTC
+// delegation unbonded, as its voting power is carried over by the new one
+func (d *BTCDelegation) IsSpentByStakingTx() bool {
+	return len(d.SpentByStakingTxHash) > 0
+}
+
+// IsExpiredByCovenantRotation returns whether the BTC delegation was still
+// pending when some of its covenant members retired upon a covenant committee
+// rotation. Babylon will consider such a BTC delegation unbonded, as it cannot
+// expect a covenant quorum anymore
+func (d *BTCDelegation) IsExpiredByCovenantRotation() bool {
+	return d.ExpiredByCovenantRotation
+}
+
+// IsExpiredWithPreviousDelegation returns whether the BTC delegation was still
+// awaiting its inclusion proof when the previous BTC delegation it spends upon
+// stake expansion or redelegation became unbonded. Babylon will consider such
+// a BTC delegation unbonded, as its staking tx can no longer carry over the
+// voting power of the previous BTC delegation
+func (d *BTCDelegation) IsExpiredWithPreviousDelegation() bool {
+	return d.ExpiredWithPreviousDelegation
+}
+
 // GetStatus returns the status of the BTC Delegation based on BTC height, w value, and covenant quorum
-// Pending: the BTC height is in the range of d's [startHeight, endHeight-w] and the delegation does not have covenant signatures
+// Pending: the BTC height is in the range of d's [startHeight, endHeight-w] and the delegation does not have covenant signatures,
+// or the delegation's staking tx is not proven to be included on Bitcoin yet
 // Active: the BTC height is in the range of d's [startHeight, endHeight-w] and the delegation has quorum number of signatures over slashing tx, unbonding tx, and slashing unbonding tx from covenant committee
-// Unbonded: the BTC height is larger than `endHeight-w` or the BTC delegation has received a signature on unbonding tx from the delegator
+// Unbonded: the BTC height is larger than `endHeight-w`, the BTC delegation has received a signature on unbonding tx from the delegator,
+// the BTC delegation's staking output has been spent by the staking tx of an active BTC delegation,
+// the BTC delegation has expired upon a covenant committee rotation, or the BTC delegation has expired
+// while awaiting its inclusion proof as the previous BTC delegation it spends became unbonded
 func (d *BTCDelegation) GetStatus(btcHeight uint64, w uint64, covenantQuorum uint32) BTCDelegationStatus {
-	if d.IsUnbondedEarly() {
+	if d.IsUnbondedEarly() || d.IsSpentByStakingTx() || d.IsExpiredByCovenantRotation() || d.IsExpiredWithPreviousDelegation() {
 		return BTCDelegationStatus_UNBONDED
 	}
 
+	if !d.HasInclusionProof() {
+		// staking tx's timelock is unknown until the staking tx is proven to
+		// be included on Bitcoin
+		return BTCDelegationStatus_PENDING
+	}
+
 	if btcHeight < d.StartHeight || btcHeight+w > d.EndHeight {
 		// staking tx's timelock has not begun, or is less than w BTC
 		// blocks left, or is expired
@@ -170,6 +221,16 @@ func (d *BTCDelegation) ValidateBasic() error {
 	if _, err := bbn.NewBTCTxFromBytes(d.StakingTx); err != nil {
 		return err
 	}
+	// ensure the hash of the previous staking tx and the outputs spent by
+	// the staking tx are correctly formatted
+	if d.SpendsPreviousStakingOutput() {
+		if _, err := chainhash.NewHash(d.PreviousStakingTxHash); err != nil {
+			return fmt.Errorf("invalid previous staking tx hash: %w", err)
+		}
+		if _, err := d.ParseSpentOutputs(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -179,8 +240,26 @@ func (d *BTCDelegation) ValidateBasic() error {
 // - adaptor signatures on slashing tx
 // - Schnorr signatures on unbonding tx
 // - adaptor signatrues on unbonding slashing tx
+// - Schnorr signatures on staking tx spending the previous staking output, if
+// the BTC delegation is created upon stake expansion or redelegation
 func (d *BTCDelegation) HasCovenantQuorums(quorum uint32) bool {
-	return uint32(len(d.CovenantSigs)) >= quorum && d.BtcUndelegation.HasCovenantQuorums(quorum)
+	return uint32(len(d.CovenantSigs)) >= quorum &&
+		d.BtcUndelegation.HasCovenantQuorums(quorum) &&
+		(!d.SpendsPreviousStakingOutput() || uint32(len(d.CovenantStakeSpendingSigs)) >= quorum)
+}
+
+// ParseSpentOutputs returns the BTC outputs spent by the inputs of the staking
+// tx, in the order of the inputs, upon stake expansion or redelegation
+func (d *BTCDelegation) ParseSpentOutputs() ([]*wire.TxOut, error) {
+	spentOutputs := make([]*wire.TxOut, 0, len(d.SpentOutputs))
+	for i, spentOutputBytes := range d.SpentOutputs {
+		spentOutput, err := bbn.NewBTCTxOutFromBytes(spentOutputBytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid output spent by input %d of the staking tx: %w", i, err)
+		}
+		spentOutputs = append(spentOutputs, spentOutput)
+	}
+	return spentOutputs, nil
 }
 
 // IsSignedByCovMember checks whether the given covenant PK has signed the delegation
@@ -197,6 +276,8 @@ func (d *BTCDelegation) IsSignedByCovMember(covPk *bbn.BIP340PubKey) bool {
 // AddCovenantSigs adds signatures on the slashing tx from the given
 // covenant, where each signature is an adaptor signature encrypted by
 // each finality provider's PK this BTC delegation restakes to
+// If the BTC delegation is created upon stake expansion or redelegation, it
+// also adds the signature on the staking tx spending the previous staking output
 // It is up to the caller to ensure that given adaptor signatures are valid or
 // that they were not added before
 func (d *BTCDelegation) AddCovenantSigs(
@@ -204,6 +285,7 @@ func (d *BTCDelegation) AddCovenantSigs(
 	stakingSlashingSigs []asig.AdaptorSignature,
 	unbondingSig *bbn.BIP340Signature,
 	unbondingSlashingSigs []asig.AdaptorSignature,
+	stakeSpendingSig *bbn.BIP340Signature,
 ) {
 	adaptorSigs := make([][]byte, 0, len(stakingSlashingSigs))
 	for _, s := range stakingSlashingSigs {
@@ -214,6 +296,10 @@ func (d *BTCDelegation) AddCovenantSigs(
 	d.CovenantSigs = append(d.CovenantSigs, covSigs)
 	// add unbonding sig and unbonding slashing adaptor sig
 	d.BtcUndelegation.addCovenantSigs(covPk, unbondingSig, unbondingSlashingSigs)
+	// add sig on the staking tx spending the previous staking output
+	if stakeSpendingSig != nil {
+		d.CovenantStakeSpendingSigs = append(d.CovenantStakeSpendingSigs, &SignatureInfo{Pk: covPk, Sig: stakeSpendingSig})
+	}
 }
 
 // GetStakingInfo returns the staking info of the BTC delegation
@@ -308,23 +394,14 @@ func (d *BTCDelegation) GetUnbondingInfo(bsParams *Params, btcNet *chaincfg.Para
 	return unbondingInfo, nil
 }
 
-// TODO: verify to remove, not used in babylon, only for tests
-// findFPIdx returns the index of the given finality provider
-// among all restaked finality providers
-func (d *BTCDelegation) findFPIdx(fpBTCPK *bbn.BIP340PubKey) (int, error) {
-	for i, pk := range d.FpBtcPkList {
-		if pk.Equals(fpBTCPK) {
-			return i, nil
-		}
-	}
-	return 0, fmt.Errorf("the given finality provider's PK is not found in the BTC delegation")
-}
-
 // BuildSlashingTxWithWitness uses the given finality provider's SK to complete
 // the signatures on the slashing tx, such that the slashing tx obtains full
 // witness and can be submitted to Bitcoin.
 // This happens after the finality provider is slashed and its SK is extracted.
-// TODO: verify not used
+// The BTC delegation may restake to multiple finality providers, in which case
+// the SK of any of them decrypts the covenant adaptor signatures encrypted by
+// its PK and slashes the staking output shared by all of them.
+// This is used by the slashing processes outside of Babylon.
 func (d *BTCDelegation) BuildSlashingTxWithWitness(bsParams *Params, btcNet *chaincfg.Params, fpSK *btcec.PrivateKey) (*wire.MsgTx, error) {
 	stakingMsgTx, err := bbn.NewBTCTxFromBytes(d.StakingTx)
 	if err != nil {
@@ -343,9 +420,9 @@ func (d *BTCDelegation) BuildSlashingTxWithWitness(bsParams *Params, btcNet *cha
 
 	// get the list of covenant signatures encrypted by the given finality provider's PK
 	fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(fpSK.PubKey())
-	fpIdx, err := d.findFPIdx(fpBTCPK)
-	if err != nil {
-		return nil, err
+	fpIdx := d.GetFpIdx(fpBTCPK)
+	if fpIdx == -1 {
+		return nil, fmt.Errorf("the given finality provider's PK is not found in the BTC delegation")
 	}
 	covAdaptorSigs, err := GetOrderedCovenantSignatures(fpIdx, d.CovenantSigs, bsParams)
 	if err != nil {
@@ -374,7 +451,9 @@ func (d *BTCDelegation) BuildSlashingTxWithWitness(bsParams *Params, btcNet *cha
 	return slashingMsgTxWithWitness, nil
 }
 
-// TODO: verify to remove, func not used by babylon, used in side car processes.
+// BuildUnbondingSlashingTxWithWitness is the counterpart of
+// BuildSlashingTxWithWitness for the slashing tx spending the unbonding tx.
+// This is used by the slashing processes outside of Babylon.
 func (d *BTCDelegation) BuildUnbondingSlashingTxWithWitness(bsParams *Params, btcNet *chaincfg.Params, fpSK *btcec.PrivateKey) (*wire.MsgTx, error) {
 	unbondingMsgTx, err := bbn.NewBTCTxFromBytes(d.BtcUndelegation.UnbondingTx)
 	if err != nil {
@@ -394,9 +473,9 @@ func (d *BTCDelegation) BuildUnbondingSlashingTxWithWitness(bsParams *Params, bt
 	// get the list of covenant signatures encrypted by the given finality provider's PK
 	fpPK := fpSK.PubKey()
 	fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(fpPK)
-	fpIdx, err := d.findFPIdx(fpBTCPK)
-	if err != nil {
-		return nil, err
+	fpIdx := d.GetFpIdx(fpBTCPK)
+	if fpIdx == -1 {
+		return nil, fmt.Errorf("the given finality provider's PK is not found in the BTC delegation")
 	}
 	covAdaptorSigs, err := GetOrderedCovenantSignatures(fpIdx, d.BtcUndelegation.CovenantSlashingSigs, bsParams)
 	if err != nil {
@@ -453,6 +532,18 @@ func (i *BTCDelegatorDelegationIndex) Add(stakingTxHash chainhash.Hash) error {
 	return nil
 }
 
+// Remove removes the given staking tx hash from the index, and returns whether
+// it was indexed
+func (i *BTCDelegatorDelegationIndex) Remove(stakingTxHash chainhash.Hash) bool {
+	for j, hash := range i.StakingTxHashList {
+		if bytes.Equal(stakingTxHash[:], hash) {
+			i.StakingTxHashList = append(i.StakingTxHashList[:j], i.StakingTxHashList[j+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
 // VotingPower calculates the total voting power of all BTC delegations
 func (dels *BTCDelegatorDelegations) VotingPower(btcHeight uint64, w uint64, covenantQuorum uint32) uint64 {
 	power := uint64(0)
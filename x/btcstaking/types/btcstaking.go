@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"sort"
+	"time"
 
 	"cosmossdk.io/math"
 
@@ -21,6 +22,16 @@ func (fp *FinalityProvider) IsSluggish() bool {
 	return fp.Sluggish
 }
 
+func (fp *FinalityProvider) IsJailed() bool {
+	return fp.JailedUntil != nil
+}
+
+// SecuresBabylon returns whether the finality provider provides finality to
+// Babylon rather than to a consumer chain
+func (fp *FinalityProvider) SecuresBabylon() bool {
+	return fp.ConsumerId == ""
+}
+
 func (fp *FinalityProvider) ValidateBasic() error {
 	// ensure fields are non-empty and well-formatted
 	if _, err := sdk.AccAddressFromBech32(fp.Addr); err != nil {
@@ -38,14 +49,107 @@ func (fp *FinalityProvider) ValidateBasic() error {
 	if err := fp.Pop.ValidateBasic(); err != nil {
 		return fmt.Errorf("PoP is not valid: %w", err)
 	}
+	if fp.MaxRate == nil {
+		return fmt.Errorf("empty max commission rate")
+	}
+	if fp.MaxChangeRate == nil {
+		return fmt.Errorf("empty max commission change rate")
+	}
+	if fp.Commission != nil {
+		if err := ValidateCommissionRates(*fp.Commission, *fp.MaxRate, *fp.MaxChangeRate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CommissionUpdateInterval is the minimum interval between two changes of
+// a finality provider's commission rate
+const CommissionUpdateInterval = 24 * time.Hour
+
+// ValidateCommissionRates ensures that
+// - all rates are within [0, 1],
+// - the commission rate is at most the max rate, and
+// - the max change rate is at most the max rate
+func ValidateCommissionRates(rate, maxRate, maxChangeRate math.LegacyDec) error {
+	switch {
+	case maxRate.IsNegative():
+		return ErrInvalidCommissionRates.Wrap("max rate cannot be negative")
+	case maxRate.GT(math.LegacyOneDec()):
+		return ErrInvalidCommissionRates.Wrap("max rate cannot be more than one")
+	case rate.IsNegative():
+		return ErrInvalidCommissionRates.Wrap("commission rate cannot be negative")
+	case rate.GT(maxRate):
+		return ErrInvalidCommissionRates.Wrap("commission rate cannot be more than the max rate")
+	case maxChangeRate.IsNegative():
+		return ErrInvalidCommissionRates.Wrap("max change rate cannot be negative")
+	case maxChangeRate.GT(maxRate):
+		return ErrInvalidCommissionRates.Wrap("max change rate cannot be more than the max rate")
+	}
+
+	return nil
+}
+
+// GetMaxRateOrDefault returns the finality provider's max commission rate.
+// Finality providers registered before the max rates were introduced do not
+// have one, in which case their commission rate is only bounded by one
+func (fp *FinalityProvider) GetMaxRateOrDefault() math.LegacyDec {
+	if fp.MaxRate == nil {
+		return math.LegacyOneDec()
+	}
+	return *fp.MaxRate
+}
+
+// GetMaxChangeRateOrDefault returns the finality provider's max commission
+// change rate, which defaults to its max rate if it is not set
+func (fp *FinalityProvider) GetMaxChangeRateOrDefault() math.LegacyDec {
+	if fp.MaxChangeRate == nil {
+		return fp.GetMaxRateOrDefault()
+	}
+	return *fp.MaxChangeRate
+}
+
+// SetDefaultMaxRates sets the max commission rate and the max commission
+// change rate of the finality provider to the default ones if they are not set
+func (fp *FinalityProvider) SetDefaultMaxRates() {
+	maxRate, maxChangeRate := fp.GetMaxRateOrDefault(), fp.GetMaxChangeRateOrDefault()
+	fp.MaxRate, fp.MaxChangeRate = &maxRate, &maxChangeRate
+}
+
+// ValidateNewCommission ensures that the finality provider can change its
+// commission rate to the given one at the given block time, i.e.,
+// - the new rate is at most the finality provider's max rate,
+// - the new rate is not increased by more than the max change rate, and
+// - the last change happened at least CommissionUpdateInterval ago
+func (fp *FinalityProvider) ValidateNewCommission(newRate math.LegacyDec, blockTime time.Time) error {
+	maxRate := fp.GetMaxRateOrDefault()
+	maxChangeRate := fp.GetMaxChangeRateOrDefault()
+	currentRate := math.LegacyZeroDec()
+	if fp.Commission != nil {
+		currentRate = *fp.Commission
+	}
+
+	switch {
+	case blockTime.Sub(fp.CommissionUpdateTime) < CommissionUpdateInterval:
+		return ErrCommissionUpdateTime.Wrapf("last update at %s", fp.CommissionUpdateTime)
+	case newRate.GT(maxRate):
+		return ErrCommissionGTFpMaxRate.Wrapf("max rate is %s", maxRate)
+	case newRate.Sub(currentRate).GT(maxChangeRate):
+		return ErrCommissionGTMaxChangeRate.Wrapf("max change rate is %s", maxChangeRate)
+	}
 
 	return nil
 }
 
 // SortFinalityProviders sorts the finality providers slice,
-// from higher to lower voting power
+// from higher to lower voting power, where jailed finality providers
+// are placed after all unjailed ones
 func SortFinalityProviders(fps []*FinalityProviderDistInfo) {
 	sort.SliceStable(fps, func(i, j int) bool {
+		if fps[i].IsJailed != fps[j].IsJailed {
+			return !fps[i].IsJailed
+		}
 		return fps[i].TotalVotingPower > fps[j].TotalVotingPower
 	})
 }
@@ -11,15 +11,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -92,6 +96,23 @@ type FinalityProvider struct {
 	SlashedBtcHeight uint64 `protobuf:"varint,7,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
 	// sluggish defines whether the finality provider is detected sluggish
 	Sluggish bool `protobuf:"varint,8,opt,name=sluggish,proto3" json:"sluggish,omitempty"`
+	// jailed_until is the time until which the finality provider is jailed
+	// due to being sluggish. A jailed finality provider does not have voting
+	// power until it is unjailed via `MsgUnjailFinalityProvider`.
+	// if it's nil then the finality provider is not jailed
+	JailedUntil *time.Time `protobuf:"bytes,9,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until,omitempty"`
+	// max_rate defines the maximum commission rate which the finality provider
+	// can ever charge.
+	MaxRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=max_rate,json=maxRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_rate,omitempty"`
+	// max_change_rate defines the maximum daily increase of the finality
+	// provider's commission rate.
+	MaxChangeRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_rate,omitempty"`
+	// commission_update_time is the last time the commission rate was changed.
+	CommissionUpdateTime time.Time `protobuf:"bytes,12,opt,name=commission_update_time,json=commissionUpdateTime,proto3,stdtime" json:"commission_update_time"`
+	// consumer_id is the chain ID of the consumer chain that this finality
+	// provider provides finality to. If it's empty then the finality provider
+	// provides finality to Babylon
+	ConsumerId string `protobuf:"bytes,13,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
 func (m *FinalityProvider) Reset()         { *m = FinalityProvider{} }
@@ -169,6 +190,27 @@ func (m *FinalityProvider) GetSluggish() bool {
 	return false
 }
 
+func (m *FinalityProvider) GetJailedUntil() *time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return nil
+}
+
+func (m *FinalityProvider) GetCommissionUpdateTime() time.Time {
+	if m != nil {
+		return m.CommissionUpdateTime
+	}
+	return time.Time{}
+}
+
+func (m *FinalityProvider) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
 // FinalityProviderWithMeta wraps the FinalityProvider with metadata.
 type FinalityProviderWithMeta struct {
 	// btc_pk is the Bitcoin secp256k1 PK of thisfinality provider
@@ -258,6 +300,68 @@ func (m *FinalityProviderWithMeta) GetSluggish() bool {
 	return false
 }
 
+// FinalityProviderKeyRotation is a scheduled rotation of a finality provider's
+// BTC PK, authorised by a BIP-340 signature of the old BTC PK
+type FinalityProviderKeyRotation struct {
+	// old_btc_pk is the BTC PK that the finality provider rotates from
+	OldBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=old_btc_pk,json=oldBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"old_btc_pk,omitempty"`
+	// new_btc_pk is the BTC PK that the finality provider rotates to
+	NewBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=new_btc_pk,json=newBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"new_btc_pk,omitempty"`
+	// new_pop is the proof of possession of new_btc_pk over the finality
+	// provider's address
+	NewPop *ProofOfPossessionBTC `protobuf:"bytes,3,opt,name=new_pop,json=newPop,proto3" json:"new_pop,omitempty"`
+	// rotation_height is the Babylon height at which the finality provider
+	// is re-keyed to new_btc_pk
+	RotationHeight uint64 `protobuf:"varint,4,opt,name=rotation_height,json=rotationHeight,proto3" json:"rotation_height,omitempty"`
+}
+
+func (m *FinalityProviderKeyRotation) Reset()         { *m = FinalityProviderKeyRotation{} }
+func (m *FinalityProviderKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderKeyRotation) ProtoMessage()    {}
+func (*FinalityProviderKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{2}
+}
+func (m *FinalityProviderKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderKeyRotation.Merge(m, src)
+}
+func (m *FinalityProviderKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderKeyRotation proto.InternalMessageInfo
+
+func (m *FinalityProviderKeyRotation) GetNewPop() *ProofOfPossessionBTC {
+	if m != nil {
+		return m.NewPop
+	}
+	return nil
+}
+
+func (m *FinalityProviderKeyRotation) GetRotationHeight() uint64 {
+	if m != nil {
+		return m.RotationHeight
+	}
+	return 0
+}
+
 // BTCDelegation defines a BTC delegation
 type BTCDelegation struct {
 	// staker_addr is the address to receive rewards from BTC delegation.
@@ -304,13 +408,45 @@ type BTCDelegation struct {
 	BtcUndelegation *BTCUndelegation `protobuf:"bytes,14,opt,name=btc_undelegation,json=btcUndelegation,proto3" json:"btc_undelegation,omitempty"`
 	// version of the params used to validate the delegation
 	ParamsVersion uint32 `protobuf:"varint,15,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// previous_staking_tx_hash is the hash of the staking tx of the BTC delegation
+	// whose staking output is spent by this BTC delegation's staking tx, upon
+	// stake expansion or redelegation. It is empty otherwise.
+	PreviousStakingTxHash []byte `protobuf:"bytes,16,opt,name=previous_staking_tx_hash,json=previousStakingTxHash,proto3" json:"previous_staking_tx_hash,omitempty"`
+	// spent_by_staking_tx_hash is the hash of the staking tx of the BTC
+	// delegation that spends the staking output of this BTC delegation upon
+	// stake expansion or redelegation. Such a BTC delegation is unbonded.
+	SpentByStakingTxHash []byte `protobuf:"bytes,17,opt,name=spent_by_staking_tx_hash,json=spentByStakingTxHash,proto3" json:"spent_by_staking_tx_hash,omitempty"`
+	// expired_by_covenant_rotation is whether the BTC delegation was still
+	// pending upon the activation of a covenant committee rotation, and could
+	// not form its covenant quorum without the retiring covenant members. Such
+	// a BTC delegation is unbonded.
+	ExpiredByCovenantRotation bool `protobuf:"varint,18,opt,name=expired_by_covenant_rotation,json=expiredByCovenantRotation,proto3" json:"expired_by_covenant_rotation,omitempty"`
+	// spent_outputs are the serialized BTC outputs spent by the inputs of the
+	// staking tx, in the order of the inputs, upon stake expansion or
+	// redelegation. It is empty otherwise.
+	SpentOutputs [][]byte `protobuf:"bytes,19,rep,name=spent_outputs,json=spentOutputs,proto3" json:"spent_outputs,omitempty"`
+	// covenant_stake_spending_sigs is a list of signatures on the staking tx
+	// by each covenant member, spending the previous staking output via its
+	// unbonding path upon stake expansion or redelegation
+	CovenantStakeSpendingSigs []*SignatureInfo `protobuf:"bytes,20,rep,name=covenant_stake_spending_sigs,json=covenantStakeSpendingSigs,proto3" json:"covenant_stake_spending_sigs,omitempty"`
+	// awaiting_inclusion_proof is whether the staking tx is not proven to be
+	// included on Bitcoin yet, upon stake expansion or redelegation. Until
+	// then, start_height is 0 and end_height is the staking time, as the
+	// staking tx can only be included once the covenant committee co-signs
+	// spending the previous staking output.
+	AwaitingInclusionProof bool `protobuf:"varint,21,opt,name=awaiting_inclusion_proof,json=awaitingInclusionProof,proto3" json:"awaiting_inclusion_proof,omitempty"`
+	// expired_with_previous_delegation is whether the BTC delegation was still
+	// awaiting its inclusion proof when the previous BTC delegation it spends
+	// became unbonded, upon stake expansion or redelegation. Such a BTC
+	// delegation is unbonded.
+	ExpiredWithPreviousDelegation bool `protobuf:"varint,22,opt,name=expired_with_previous_delegation,json=expiredWithPreviousDelegation,proto3" json:"expired_with_previous_delegation,omitempty"`
 }
 
 func (m *BTCDelegation) Reset()         { *m = BTCDelegation{} }
 func (m *BTCDelegation) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegation) ProtoMessage()    {}
 func (*BTCDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{2}
+	return fileDescriptor_3851ae95ccfaf7db, []int{3}
 }
 func (m *BTCDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -416,6 +552,55 @@ func (m *BTCDelegation) GetParamsVersion() uint32 {
 	return 0
 }
 
+func (m *BTCDelegation) GetPreviousStakingTxHash() []byte {
+	if m != nil {
+		return m.PreviousStakingTxHash
+	}
+	return nil
+}
+
+func (m *BTCDelegation) GetSpentByStakingTxHash() []byte {
+	if m != nil {
+		return m.SpentByStakingTxHash
+	}
+	return nil
+}
+
+func (m *BTCDelegation) GetExpiredByCovenantRotation() bool {
+	if m != nil {
+		return m.ExpiredByCovenantRotation
+	}
+	return false
+}
+
+func (m *BTCDelegation) GetSpentOutputs() [][]byte {
+	if m != nil {
+		return m.SpentOutputs
+	}
+	return nil
+}
+
+func (m *BTCDelegation) GetCovenantStakeSpendingSigs() []*SignatureInfo {
+	if m != nil {
+		return m.CovenantStakeSpendingSigs
+	}
+	return nil
+}
+
+func (m *BTCDelegation) GetAwaitingInclusionProof() bool {
+	if m != nil {
+		return m.AwaitingInclusionProof
+	}
+	return false
+}
+
+func (m *BTCDelegation) GetExpiredWithPreviousDelegation() bool {
+	if m != nil {
+		return m.ExpiredWithPreviousDelegation
+	}
+	return false
+}
+
 // BTCUndelegation contains the information about the early unbonding path of the BTC delegation
 type BTCUndelegation struct {
 	// unbonding_tx is the transaction which will transfer the funds from staking
@@ -450,7 +635,7 @@ func (m *BTCUndelegation) Reset()         { *m = BTCUndelegation{} }
 func (m *BTCUndelegation) String() string { return proto.CompactTextString(m) }
 func (*BTCUndelegation) ProtoMessage()    {}
 func (*BTCUndelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{3}
+	return fileDescriptor_3851ae95ccfaf7db, []int{4}
 }
 func (m *BTCUndelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -509,7 +694,7 @@ func (m *BTCDelegatorDelegations) Reset()         { *m = BTCDelegatorDelegations
 func (m *BTCDelegatorDelegations) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegatorDelegations) ProtoMessage()    {}
 func (*BTCDelegatorDelegations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{4}
+	return fileDescriptor_3851ae95ccfaf7db, []int{5}
 }
 func (m *BTCDelegatorDelegations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -554,7 +739,7 @@ func (m *BTCDelegatorDelegationIndex) Reset()         { *m = BTCDelegatorDelegat
 func (m *BTCDelegatorDelegationIndex) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegatorDelegationIndex) ProtoMessage()    {}
 func (*BTCDelegatorDelegationIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{5}
+	return fileDescriptor_3851ae95ccfaf7db, []int{6}
 }
 func (m *BTCDelegatorDelegationIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -600,7 +785,7 @@ func (m *SignatureInfo) Reset()         { *m = SignatureInfo{} }
 func (m *SignatureInfo) String() string { return proto.CompactTextString(m) }
 func (*SignatureInfo) ProtoMessage()    {}
 func (*SignatureInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{6}
+	return fileDescriptor_3851ae95ccfaf7db, []int{7}
 }
 func (m *SignatureInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -642,7 +827,7 @@ func (m *CovenantAdaptorSignatures) Reset()         { *m = CovenantAdaptorSignat
 func (m *CovenantAdaptorSignatures) String() string { return proto.CompactTextString(m) }
 func (*CovenantAdaptorSignatures) ProtoMessage()    {}
 func (*CovenantAdaptorSignatures) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{7}
+	return fileDescriptor_3851ae95ccfaf7db, []int{8}
 }
 func (m *CovenantAdaptorSignatures) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -701,7 +886,7 @@ func (m *SelectiveSlashingEvidence) Reset()         { *m = SelectiveSlashingEvid
 func (m *SelectiveSlashingEvidence) String() string { return proto.CompactTextString(m) }
 func (*SelectiveSlashingEvidence) ProtoMessage()    {}
 func (*SelectiveSlashingEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{8}
+	return fileDescriptor_3851ae95ccfaf7db, []int{9}
 }
 func (m *SelectiveSlashingEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -748,6 +933,7 @@ func init() {
 	proto.RegisterEnum("babylon.btcstaking.v1.BTCDelegationStatus", BTCDelegationStatus_name, BTCDelegationStatus_value)
 	proto.RegisterType((*FinalityProvider)(nil), "babylon.btcstaking.v1.FinalityProvider")
 	proto.RegisterType((*FinalityProviderWithMeta)(nil), "babylon.btcstaking.v1.FinalityProviderWithMeta")
+	proto.RegisterType((*FinalityProviderKeyRotation)(nil), "babylon.btcstaking.v1.FinalityProviderKeyRotation")
 	proto.RegisterType((*BTCDelegation)(nil), "babylon.btcstaking.v1.BTCDelegation")
 	proto.RegisterType((*BTCUndelegation)(nil), "babylon.btcstaking.v1.BTCUndelegation")
 	proto.RegisterType((*BTCDelegatorDelegations)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegations")
@@ -762,83 +948,108 @@ func init() {
 }
 
 var fileDescriptor_3851ae95ccfaf7db = []byte{
-	// 1210 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x13, 0xc7,
-	0x1b, 0xce, 0xda, 0x8e, 0x93, 0xbc, 0xb6, 0x89, 0x19, 0x42, 0x58, 0x12, 0xfd, 0x12, 0xff, 0x5c,
-	0x8a, 0xac, 0x96, 0xd8, 0x10, 0x68, 0x55, 0x0e, 0x3d, 0xc4, 0x71, 0x28, 0x11, 0x10, 0xdc, 0xb5,
-	0x43, 0xd5, 0x56, 0xea, 0x6a, 0xbc, 0x3b, 0x59, 0x8f, 0x6c, 0xef, 0x6c, 0x77, 0xc6, 0xae, 0xf3,
-	0x21, 0x2a, 0xf5, 0xda, 0x3b, 0x1f, 0x81, 0xcf, 0x50, 0xf5, 0x88, 0x38, 0x55, 0x39, 0x44, 0x15,
-	0xf4, 0xda, 0xef, 0x50, 0xcd, 0xec, 0x7a, 0x77, 0x9d, 0x12, 0x0a, 0x98, 0x9b, 0xe7, 0xfd, 0xf7,
-	0xbc, 0xf3, 0x3e, 0x8f, 0xdf, 0x59, 0xb8, 0xde, 0xc1, 0x9d, 0xe3, 0x3e, 0x73, 0x6b, 0x1d, 0x61,
-	0x71, 0x81, 0x7b, 0xd4, 0x75, 0x6a, 0xa3, 0x5b, 0x89, 0x53, 0xd5, 0xf3, 0x99, 0x60, 0xe8, 0x72,
-	0x18, 0x57, 0x4d, 0x78, 0x46, 0xb7, 0xd6, 0x56, 0x1c, 0xe6, 0x30, 0x15, 0x51, 0x93, 0xbf, 0x82,
-	0xe0, 0xb5, 0xab, 0x16, 0xe3, 0x03, 0xc6, 0xcd, 0xc0, 0x11, 0x1c, 0x42, 0xd7, 0xb5, 0xe0, 0x54,
-	0x8b, 0xb1, 0x3a, 0x44, 0xe0, 0x5b, 0xb5, 0x29, 0xb4, 0xb5, 0xcd, 0xd7, 0x77, 0xe5, 0x31, 0x2f,
-	0x08, 0x28, 0xff, 0x95, 0x86, 0xe2, 0x3d, 0xea, 0xe2, 0x3e, 0x15, 0xc7, 0x4d, 0x9f, 0x8d, 0xa8,
-	0x4d, 0x7c, 0x74, 0x03, 0x32, 0xd8, 0xb6, 0x7d, 0x5d, 0x2b, 0x69, 0x95, 0xa5, 0xba, 0xfe, 0xe2,
-	0xd9, 0xd6, 0x4a, 0x88, 0xbd, 0x63, 0xdb, 0x3e, 0xe1, 0xbc, 0x25, 0x7c, 0xea, 0x3a, 0x86, 0x8a,
-	0x42, 0x7b, 0x90, 0xb3, 0x09, 0xb7, 0x7c, 0xea, 0x09, 0xca, 0x5c, 0x3d, 0x55, 0xd2, 0x2a, 0xb9,
-	0xed, 0x8f, 0xaa, 0x61, 0x46, 0x7c, 0x47, 0xd5, 0x5f, 0xb5, 0x11, 0x87, 0x1a, 0xc9, 0x3c, 0xf4,
-	0x08, 0xc0, 0x62, 0x83, 0x01, 0xe5, 0x5c, 0x56, 0x49, 0x2b, 0xe8, 0xad, 0x93, 0xd3, 0xcd, 0xf5,
-	0xa0, 0x10, 0xb7, 0x7b, 0x55, 0xca, 0x6a, 0x03, 0x2c, 0xba, 0xd5, 0x87, 0xc4, 0xc1, 0xd6, 0x71,
-	0x83, 0x58, 0x2f, 0x9e, 0x6d, 0x41, 0x88, 0xd3, 0x20, 0x96, 0x91, 0x28, 0x80, 0x1e, 0x41, 0xb6,
-	0x23, 0x2c, 0xd3, 0xeb, 0xe9, 0x99, 0x92, 0x56, 0xc9, 0xd7, 0x3f, 0x3f, 0x39, 0xdd, 0xdc, 0x76,
-	0xa8, 0xe8, 0x0e, 0x3b, 0x55, 0x8b, 0x0d, 0x6a, 0xe1, 0x60, 0xac, 0x2e, 0xa6, 0xee, 0xe4, 0x50,
-	0x13, 0xc7, 0x1e, 0xe1, 0xd5, 0xfa, 0x7e, 0xf3, 0xf6, 0x9d, 0x9b, 0xcd, 0x61, 0xe7, 0x01, 0x39,
-	0x36, 0xe6, 0x3b, 0xc2, 0x6a, 0xf6, 0xd0, 0x97, 0x90, 0xf6, 0x98, 0xa7, 0xcf, 0xab, 0xcb, 0x7d,
-	0x5a, 0x7d, 0x2d, 0x89, 0xd5, 0xa6, 0xcf, 0xd8, 0xd1, 0xe3, 0xa3, 0x26, 0xe3, 0x9c, 0xa8, 0x2e,
-	0xea, 0xed, 0x5d, 0x43, 0xe6, 0xa1, 0x3b, 0xb0, 0xca, 0xfb, 0x98, 0x77, 0x89, 0x6d, 0x86, 0xa9,
-	0x66, 0x97, 0x50, 0xa7, 0x2b, 0xf4, 0x6c, 0x49, 0xab, 0x64, 0x8c, 0x95, 0xd0, 0x5b, 0x0f, 0x9c,
-	0xf7, 0x95, 0x0f, 0xdd, 0x00, 0x14, 0x65, 0x09, 0x6b, 0x92, 0xb1, 0xa0, 0x32, 0x8a, 0x93, 0x0c,
-	0x61, 0x85, 0xd1, 0x6b, 0xb0, 0xc8, 0xfb, 0x43, 0xc7, 0xa1, 0xbc, 0xab, 0x2f, 0x96, 0xb4, 0xca,
-	0xa2, 0x11, 0x9d, 0xcb, 0x4f, 0x53, 0xa0, 0x9f, 0xa5, 0xf9, 0x1b, 0x2a, 0xba, 0x8f, 0x88, 0xc0,
-	0x89, 0x51, 0x69, 0x1f, 0x62, 0x54, 0xab, 0x90, 0x0d, 0x3b, 0x4d, 0xa9, 0x4e, 0xc3, 0x13, 0xfa,
-	0x3f, 0xe4, 0x47, 0x4c, 0x50, 0xd7, 0x31, 0x3d, 0xf6, 0x13, 0xf1, 0x15, 0xc5, 0x19, 0x23, 0x17,
-	0xd8, 0x9a, 0xd2, 0xf4, 0x86, 0x31, 0x65, 0xde, 0x79, 0x4c, 0xf3, 0x6f, 0x31, 0xa6, 0xec, 0x99,
-	0x31, 0xfd, 0x9d, 0x85, 0x42, 0xbd, 0xbd, 0xdb, 0x20, 0x7d, 0xe2, 0x60, 0xa5, 0xca, 0xbb, 0x90,
-	0x93, 0x04, 0x13, 0xdf, 0x7c, 0xab, 0x7f, 0x04, 0x04, 0xc1, 0xd2, 0x98, 0x18, 0x6b, 0xea, 0x03,
-	0x2a, 0x30, 0xfd, 0x9e, 0x0a, 0xfc, 0x1e, 0x2e, 0x1c, 0x79, 0x66, 0xd0, 0x90, 0xd9, 0xa7, 0x5c,
-	0x8e, 0x34, 0x3d, 0x43, 0x57, 0xb9, 0x23, 0xaf, 0x2e, 0xfb, 0x7a, 0x48, 0xb9, 0xa2, 0x96, 0x0b,
-	0xec, 0x8b, 0xe9, 0xd9, 0xe7, 0x94, 0x2d, 0x1c, 0xfb, 0xff, 0x00, 0x88, 0x6b, 0x4f, 0xab, 0x7e,
-	0x89, 0xb8, 0x76, 0xe8, 0x5e, 0x87, 0x25, 0xc1, 0x04, 0xee, 0x9b, 0x1c, 0x4f, 0x14, 0xbe, 0xa8,
-	0x0c, 0x2d, 0xac, 0x72, 0xc3, 0x3b, 0x9a, 0x62, 0xac, 0xb4, 0x9d, 0x37, 0x96, 0x42, 0x4b, 0x7b,
-	0xac, 0xf8, 0x0f, 0xdd, 0x6c, 0x28, 0xbc, 0xa1, 0x30, 0xa9, 0x3d, 0xd6, 0x97, 0x4a, 0x5a, 0xa5,
-	0x60, 0x14, 0x43, 0xcf, 0x63, 0xe5, 0xd8, 0xb7, 0xc7, 0x68, 0x1b, 0x72, 0x4a, 0x13, 0x61, 0x35,
-	0x50, 0xdc, 0x5c, 0x3c, 0x39, 0xdd, 0x94, 0xcc, 0xb7, 0x42, 0x4f, 0x7b, 0x6c, 0x00, 0x8f, 0x7e,
-	0xa3, 0x1f, 0xa0, 0x60, 0x07, 0x9a, 0x60, 0xbe, 0xc9, 0xa9, 0xa3, 0xe7, 0x54, 0xd6, 0xdd, 0x93,
-	0xd3, 0xcd, 0xcf, 0xde, 0x65, 0x76, 0x2d, 0xea, 0xb8, 0x58, 0x0c, 0x7d, 0x62, 0xe4, 0xa3, 0x7a,
-	0x2d, 0xea, 0xa0, 0x43, 0x28, 0x58, 0x6c, 0x44, 0x5c, 0xec, 0x0a, 0x59, 0x9e, 0xeb, 0xf9, 0x52,
-	0xba, 0x92, 0xdb, 0xbe, 0x79, 0x0e, 0xcb, 0xbb, 0x61, 0xec, 0x8e, 0x8d, 0xbd, 0xa0, 0x42, 0x50,
-	0x95, 0x1b, 0xf9, 0x49, 0x99, 0x16, 0x75, 0x38, 0xfa, 0x18, 0x2e, 0x0c, 0xdd, 0x0e, 0x73, 0x6d,
-	0x75, 0x57, 0x3a, 0x20, 0x7a, 0x41, 0x0d, 0xa5, 0x10, 0x59, 0xdb, 0x74, 0x40, 0xd0, 0xd7, 0x50,
-	0x94, 0xba, 0x18, 0xba, 0x76, 0xa4, 0x7b, 0xfd, 0x82, 0x92, 0xd9, 0xf5, 0x73, 0x1a, 0xa8, 0xb7,
-	0x77, 0x0f, 0x13, 0xd1, 0xc6, 0x72, 0x47, 0x58, 0x49, 0x83, 0x44, 0xf6, 0xb0, 0x8f, 0x07, 0xdc,
-	0x1c, 0x11, 0x5f, 0x2d, 0xf4, 0xe5, 0x00, 0x39, 0xb0, 0x3e, 0x09, 0x8c, 0xe5, 0x5f, 0x33, 0xb0,
-	0x7c, 0xa6, 0x96, 0xd4, 0x52, 0xa2, 0xe9, 0x71, 0xb0, 0x93, 0x8c, 0x5c, 0xdc, 0xf2, 0xbf, 0x28,
-	0x4c, 0xbd, 0x0d, 0x85, 0x3f, 0xc2, 0x95, 0x98, 0xc2, 0x18, 0x40, 0x92, 0x99, 0x9e, 0x95, 0xcc,
-	0xcb, 0x51, 0xe5, 0xc3, 0x49, 0x61, 0xc9, 0x2a, 0x83, 0xd5, 0x84, 0x6a, 0x26, 0x0d, 0x4b, 0xc4,
-	0xcc, 0xac, 0x88, 0x2b, 0xb1, 0x7c, 0xc2, 0xba, 0x12, 0xf0, 0x08, 0x56, 0x63, 0x19, 0x25, 0xf0,
-	0xb8, 0x3e, 0xff, 0x9e, 0x7a, 0x5a, 0x89, 0xf4, 0x14, 0xc3, 0x70, 0x64, 0xc1, 0x7a, 0x84, 0x33,
-	0x35, 0xca, 0x60, 0xb1, 0x64, 0x15, 0xd8, 0xb5, 0x73, 0xc0, 0xa2, 0xea, 0xfb, 0xee, 0x11, 0x33,
-	0xf4, 0x49, 0xa1, 0xe4, 0xe4, 0xe4, 0x4e, 0x29, 0xb7, 0xe0, 0x4a, 0xbc, 0x8a, 0x99, 0x1f, 0xef,
-	0x64, 0x8e, 0xbe, 0x80, 0x8c, 0x4d, 0xfa, 0x5c, 0xd7, 0xde, 0x08, 0x34, 0xb5, 0xc8, 0x0d, 0x95,
-	0x51, 0x3e, 0x80, 0xf5, 0xd7, 0x17, 0xdd, 0x77, 0x6d, 0x32, 0x46, 0x35, 0x58, 0x89, 0x17, 0x8d,
-	0xd9, 0xc5, 0xbc, 0x1b, 0xdc, 0x48, 0x02, 0xe5, 0x8d, 0x8b, 0xd1, 0xca, 0xb9, 0x8f, 0x79, 0x57,
-	0x35, 0xf9, 0x54, 0x83, 0xc2, 0xd4, 0x85, 0xd0, 0x3d, 0x48, 0xcd, 0xfc, 0x90, 0xa6, 0xbc, 0x1e,
-	0x7a, 0x00, 0x69, 0xa9, 0x94, 0xd4, 0xac, 0x4a, 0x91, 0x55, 0xca, 0x3f, 0x6b, 0x70, 0xf5, 0x5c,
-	0x92, 0xe5, 0x43, 0x65, 0xb1, 0xd1, 0x07, 0x78, 0xff, 0x2d, 0x36, 0x6a, 0xf6, 0xe4, 0x1f, 0x18,
-	0x07, 0x18, 0x81, 0xf6, 0x52, 0x6a, 0x78, 0x39, 0x1c, 0xe1, 0xf2, 0xf2, 0x6f, 0x1a, 0x5c, 0x6d,
-	0x91, 0x3e, 0xb1, 0x04, 0x1d, 0x91, 0x89, 0xb4, 0xf6, 0xe4, 0x57, 0x89, 0x6b, 0x11, 0x74, 0x1d,
-	0x96, 0xcf, 0xb0, 0x10, 0xbc, 0xbb, 0x46, 0x61, 0x8a, 0x00, 0x64, 0xc0, 0x52, 0xf4, 0xa4, 0xcd,
-	0xf8, 0xc6, 0x2e, 0x84, 0xaf, 0x19, 0xda, 0x82, 0x4b, 0x3e, 0x91, 0x9a, 0xf4, 0x89, 0x6d, 0x86,
-	0xd5, 0x79, 0x2f, 0x58, 0x11, 0x46, 0x31, 0x72, 0xdd, 0x93, 0xe1, 0xad, 0xde, 0x27, 0x7b, 0x70,
-	0x69, 0x4a, 0x66, 0x2d, 0x81, 0xc5, 0x90, 0xa3, 0x1c, 0x2c, 0x34, 0xf7, 0x0e, 0x1a, 0xfb, 0x07,
-	0x5f, 0x15, 0xe7, 0x10, 0x40, 0x76, 0x67, 0xb7, 0xbd, 0xff, 0x64, 0xaf, 0xa8, 0xa1, 0x3c, 0x2c,
-	0x1e, 0x1e, 0xd4, 0x1f, 0x1f, 0x34, 0xf6, 0x1a, 0xc5, 0x14, 0x5a, 0x80, 0xf4, 0xce, 0xc1, 0xb7,
-	0xc5, 0x74, 0xfd, 0xe1, 0xef, 0x2f, 0x37, 0xb4, 0xe7, 0x2f, 0x37, 0xb4, 0x3f, 0x5f, 0x6e, 0x68,
-	0xbf, 0xbc, 0xda, 0x98, 0x7b, 0xfe, 0x6a, 0x63, 0xee, 0x8f, 0x57, 0x1b, 0x73, 0xdf, 0xfd, 0xe7,
-	0x65, 0xc6, 0xc9, 0x4f, 0x7b, 0x75, 0xb3, 0x4e, 0x56, 0x7d, 0xda, 0xdf, 0xfe, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0xbf, 0xab, 0xbe, 0x1d, 0x93, 0x0c, 0x00, 0x00,
+	// 1605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x73, 0x22, 0xc7,
+	0x15, 0xd7, 0x00, 0x42, 0xe8, 0x01, 0x12, 0xdb, 0x8b, 0xe4, 0x91, 0x64, 0x0b, 0x05, 0x3b, 0x1b,
+	0x55, 0x62, 0x81, 0x57, 0x76, 0x9c, 0xf8, 0x90, 0x4a, 0x09, 0xa1, 0xf5, 0x52, 0xde, 0xd5, 0x92,
+	0x01, 0x39, 0x15, 0xa7, 0x2a, 0x53, 0xcd, 0x4c, 0x6b, 0xe8, 0x00, 0xd3, 0x93, 0xe9, 0x06, 0xc1,
+	0x87, 0x48, 0x95, 0xaf, 0xb9, 0xfb, 0x9c, 0x93, 0x0f, 0xf9, 0x04, 0x89, 0x8f, 0xae, 0x3d, 0xa5,
+	0x74, 0x50, 0x52, 0xbb, 0x5f, 0xc4, 0xd5, 0x3d, 0x7f, 0x00, 0xed, 0x6a, 0x77, 0xf5, 0xe7, 0xc6,
+	0xf4, 0x7b, 0xef, 0xf7, 0xba, 0xdf, 0xfb, 0xbd, 0x5f, 0xb7, 0x04, 0x0f, 0x3a, 0xb8, 0x33, 0xe9,
+	0x33, 0xb7, 0xda, 0x11, 0x16, 0x17, 0xb8, 0x47, 0x5d, 0xa7, 0x3a, 0x7a, 0x38, 0xf3, 0x55, 0xf1,
+	0x7c, 0x26, 0x18, 0x5a, 0x0b, 0xfd, 0x2a, 0x33, 0x96, 0xd1, 0xc3, 0xcd, 0xa2, 0xc3, 0x1c, 0xa6,
+	0x3c, 0xaa, 0xf2, 0x57, 0xe0, 0xbc, 0x59, 0x72, 0x18, 0x73, 0xfa, 0xa4, 0xaa, 0xbe, 0x3a, 0xc3,
+	0xd3, 0xaa, 0xa0, 0x03, 0xc2, 0x05, 0x1e, 0x78, 0xa1, 0xc3, 0x86, 0xc5, 0xf8, 0x80, 0x71, 0x33,
+	0x88, 0x0c, 0x3e, 0x42, 0xd3, 0x47, 0xc1, 0x57, 0x75, 0xba, 0x99, 0x0e, 0x11, 0xf8, 0x61, 0x75,
+	0x6e, 0x3b, 0x9b, 0xa5, 0xd7, 0x6f, 0xdb, 0x63, 0x61, 0x86, 0xf2, 0x7f, 0xd2, 0x50, 0x78, 0x44,
+	0x5d, 0xdc, 0xa7, 0x62, 0xd2, 0xf4, 0xd9, 0x88, 0xda, 0xc4, 0x47, 0x1f, 0x43, 0x0a, 0xdb, 0xb6,
+	0xaf, 0x6b, 0x3b, 0xda, 0xee, 0x72, 0x4d, 0x7f, 0xfe, 0xfd, 0x5e, 0x31, 0xcc, 0x7d, 0x60, 0xdb,
+	0x3e, 0xe1, 0xbc, 0x25, 0x7c, 0xea, 0x3a, 0x86, 0xf2, 0x42, 0x47, 0x90, 0xb5, 0x09, 0xb7, 0x7c,
+	0xea, 0x09, 0xca, 0x5c, 0x3d, 0xb1, 0xa3, 0xed, 0x66, 0xf7, 0x3f, 0xac, 0x84, 0x11, 0xd3, 0x22,
+	0xa8, 0xfd, 0x55, 0xea, 0x53, 0x57, 0x63, 0x36, 0x0e, 0x3d, 0x05, 0xb0, 0xd8, 0x60, 0x40, 0x39,
+	0x97, 0x28, 0x49, 0x95, 0x7a, 0xef, 0xfc, 0xa2, 0xb4, 0x15, 0x00, 0x71, 0xbb, 0x57, 0xa1, 0xac,
+	0x3a, 0xc0, 0xa2, 0x5b, 0x79, 0x42, 0x1c, 0x6c, 0x4d, 0xea, 0xc4, 0x7a, 0xfe, 0xfd, 0x1e, 0x84,
+	0x79, 0xea, 0xc4, 0x32, 0x66, 0x00, 0xd0, 0x53, 0x48, 0x77, 0x84, 0x65, 0x7a, 0x3d, 0x3d, 0xb5,
+	0xa3, 0xed, 0xe6, 0x6a, 0x9f, 0x9f, 0x5f, 0x94, 0xf6, 0x1d, 0x2a, 0xba, 0xc3, 0x4e, 0xc5, 0x62,
+	0x83, 0x6a, 0x58, 0x18, 0xab, 0x8b, 0xa9, 0x1b, 0x7d, 0x54, 0xc5, 0xc4, 0x23, 0xbc, 0x52, 0x6b,
+	0x34, 0x3f, 0xfd, 0xec, 0x93, 0xe6, 0xb0, 0xf3, 0x15, 0x99, 0x18, 0x8b, 0x1d, 0x61, 0x35, 0x7b,
+	0xe8, 0x77, 0x90, 0xf4, 0x98, 0xa7, 0x2f, 0xaa, 0xc3, 0xfd, 0xaa, 0xf2, 0xda, 0x2e, 0x57, 0x9a,
+	0x3e, 0x63, 0xa7, 0xcf, 0x4e, 0x9b, 0x8c, 0x73, 0xa2, 0x76, 0x51, 0x6b, 0x1f, 0x1a, 0x32, 0x0e,
+	0x7d, 0x06, 0xeb, 0xbc, 0x8f, 0x79, 0x97, 0xd8, 0x66, 0x18, 0x6a, 0x76, 0x09, 0x75, 0xba, 0x42,
+	0x4f, 0xef, 0x68, 0xbb, 0x29, 0xa3, 0x18, 0x5a, 0x6b, 0x81, 0xf1, 0xb1, 0xb2, 0xa1, 0x8f, 0x01,
+	0xc5, 0x51, 0xc2, 0x8a, 0x22, 0x96, 0x54, 0x44, 0x21, 0x8a, 0x10, 0x56, 0xe8, 0xbd, 0x09, 0x19,
+	0xde, 0x1f, 0x3a, 0x0e, 0xe5, 0x5d, 0x3d, 0xb3, 0xa3, 0xed, 0x66, 0x8c, 0xf8, 0x1b, 0x1d, 0x42,
+	0xee, 0xaf, 0x98, 0xf6, 0x89, 0x6d, 0x0e, 0x5d, 0x41, 0xfb, 0xfa, 0xb2, 0x3a, 0xc7, 0x66, 0x25,
+	0x20, 0x60, 0x25, 0x22, 0x60, 0xa5, 0x1d, 0x11, 0xb0, 0x96, 0xfa, 0xf6, 0x7f, 0x25, 0xcd, 0xc8,
+	0x06, 0x51, 0x27, 0x32, 0x08, 0x3d, 0x86, 0xcc, 0x00, 0x8f, 0x4d, 0x1f, 0x0b, 0xa2, 0xc3, 0x4d,
+	0xfa, 0xb3, 0x34, 0xc0, 0x63, 0x03, 0x0b, 0x82, 0x4e, 0x60, 0x55, 0x22, 0x59, 0x5d, 0xec, 0x3a,
+	0x24, 0x00, 0xcc, 0xde, 0x04, 0x30, 0x3f, 0xc0, 0xe3, 0x43, 0x05, 0xa2, 0x60, 0xbf, 0x81, 0xf5,
+	0x29, 0x03, 0xcc, 0xa1, 0x67, 0x63, 0x41, 0x4c, 0x39, 0x53, 0x7a, 0xee, 0xad, 0xe7, 0xcd, 0xfc,
+	0x70, 0x51, 0x5a, 0x50, 0x67, 0x2e, 0x4e, 0x31, 0x4e, 0x14, 0x84, 0x74, 0x42, 0x25, 0xc8, 0x5a,
+	0xcc, 0xe5, 0xc3, 0x01, 0xf1, 0x4d, 0x6a, 0xeb, 0x79, 0xb9, 0x5d, 0x03, 0xa2, 0xa5, 0x86, 0x5d,
+	0xfe, 0x2e, 0x01, 0xfa, 0xe5, 0x49, 0xfa, 0x23, 0x15, 0xdd, 0xa7, 0x44, 0xe0, 0x19, 0x36, 0x6a,
+	0x77, 0xc1, 0xc6, 0x75, 0x48, 0x87, 0x64, 0x48, 0x28, 0x32, 0x84, 0x5f, 0xe8, 0x67, 0x90, 0x1b,
+	0x31, 0x41, 0x5d, 0xc7, 0xf4, 0xd8, 0x19, 0xf1, 0xd5, 0x14, 0xa5, 0x8c, 0x6c, 0xb0, 0xd6, 0x94,
+	0x4b, 0x6f, 0x60, 0x62, 0xea, 0xda, 0x4c, 0x5c, 0x7c, 0x07, 0x26, 0xa6, 0xe7, 0x99, 0x58, 0xfe,
+	0x57, 0x02, 0xb6, 0x2e, 0x97, 0x49, 0x9e, 0x8c, 0x09, 0xac, 0x64, 0xa0, 0x0d, 0xc0, 0xfa, 0xb6,
+	0x79, 0x27, 0xd5, 0xca, 0xb0, 0xbe, 0xdc, 0x55, 0xb3, 0x27, 0x51, 0x5d, 0x72, 0x16, 0xa1, 0x26,
+	0x6e, 0x87, 0xea, 0x92, 0xb3, 0x00, 0xb5, 0x0e, 0x4b, 0x12, 0x55, 0x0a, 0x43, 0xf2, 0xfa, 0xc2,
+	0x90, 0x76, 0xc9, 0x59, 0x93, 0x79, 0xe8, 0x17, 0xb0, 0xea, 0x87, 0xa7, 0x9f, 0x6f, 0xc5, 0x4a,
+	0xb4, 0x1c, 0x94, 0xb5, 0xfc, 0x4f, 0x80, 0x7c, 0xad, 0x7d, 0x58, 0x27, 0x7d, 0xe2, 0x04, 0xc5,
+	0xfa, 0x02, 0xb2, 0x32, 0x0b, 0xf1, 0xcd, 0x77, 0xd2, 0x6b, 0x08, 0x9c, 0xe5, 0xe2, 0x0c, 0x23,
+	0x13, 0x77, 0xa8, 0x8f, 0xc9, 0x1b, 0xea, 0xe3, 0x9f, 0x61, 0xe5, 0xd4, 0x0b, 0xdb, 0x63, 0xf6,
+	0x29, 0x97, 0x25, 0x48, 0xde, 0x62, 0x57, 0xd9, 0x53, 0x4f, 0xb5, 0xe8, 0x09, 0xe5, 0x6a, 0x2a,
+	0xb8, 0xc0, 0xbe, 0x98, 0xa7, 0x6d, 0x56, 0xad, 0x85, 0x8c, 0xfd, 0x00, 0x80, 0xb8, 0xf6, 0xbc,
+	0x26, 0x2f, 0x13, 0xd7, 0x0e, 0xcd, 0x5b, 0xb0, 0x2c, 0x98, 0xc0, 0x7d, 0x93, 0xe3, 0x48, 0x7f,
+	0x33, 0x6a, 0xa1, 0x85, 0x55, 0x6c, 0x78, 0x46, 0x53, 0x8c, 0x95, 0xf2, 0xe6, 0x8c, 0xe5, 0x70,
+	0xa5, 0x3d, 0x56, 0xa3, 0x13, 0x9a, 0xd9, 0x50, 0x78, 0x43, 0x61, 0x52, 0x7b, 0xac, 0x04, 0x38,
+	0x6f, 0x14, 0x42, 0xcb, 0x33, 0x65, 0x68, 0xd8, 0x63, 0xb4, 0x0f, 0x59, 0x35, 0x4e, 0x21, 0x1a,
+	0xa8, 0xde, 0xdc, 0x3b, 0xbf, 0x28, 0xc9, 0xce, 0xb7, 0x42, 0x4b, 0x7b, 0x6c, 0x00, 0x8f, 0x7f,
+	0xa3, 0xbf, 0x40, 0xde, 0x0e, 0x38, 0xc1, 0x7c, 0x93, 0x53, 0x47, 0x69, 0x69, 0xae, 0xf6, 0xc5,
+	0xf9, 0x45, 0xe9, 0xd7, 0xd7, 0xa9, 0x5d, 0x8b, 0x3a, 0x2e, 0x16, 0x43, 0x9f, 0x18, 0xb9, 0x18,
+	0xaf, 0x45, 0x1d, 0x74, 0x02, 0x79, 0x8b, 0x8d, 0x88, 0x8b, 0x5d, 0x21, 0xe1, 0xb9, 0x9e, 0xdb,
+	0x49, 0xee, 0x66, 0xf7, 0x3f, 0xb9, 0xa2, 0xcb, 0x87, 0xa1, 0xef, 0x81, 0x8d, 0xbd, 0x00, 0x21,
+	0x40, 0xe5, 0x46, 0x2e, 0x82, 0x69, 0x51, 0x87, 0xa3, 0x9f, 0xc3, 0xca, 0xd0, 0xed, 0x30, 0xd7,
+	0x56, 0x67, 0x95, 0x2a, 0x9d, 0x57, 0x45, 0xc9, 0xc7, 0xab, 0x4a, 0x78, 0xff, 0x00, 0x05, 0xc9,
+	0x8b, 0xa1, 0x6b, 0xc7, 0xbc, 0xd7, 0x57, 0x14, 0xcd, 0x1e, 0x5c, 0xb1, 0x81, 0x5a, 0xfb, 0xf0,
+	0x64, 0xc6, 0xdb, 0x58, 0xed, 0x08, 0x6b, 0x76, 0x41, 0x66, 0xf6, 0xb0, 0x8f, 0x07, 0xdc, 0x1c,
+	0x11, 0x5f, 0x3d, 0x37, 0x56, 0x83, 0xcc, 0xc1, 0xea, 0xd7, 0xc1, 0x22, 0xfa, 0x0d, 0xe8, 0x9e,
+	0x4f, 0x46, 0x94, 0x0d, 0xb9, 0x39, 0xed, 0xb0, 0xd9, 0xc5, 0xbc, 0xab, 0x17, 0x54, 0x9b, 0xd7,
+	0x22, 0x7b, 0x2b, 0x6a, 0xf7, 0x63, 0xcc, 0xbb, 0xe8, 0x73, 0xd0, 0xb9, 0x47, 0x5c, 0x61, 0x76,
+	0x26, 0xaf, 0x04, 0xde, 0x53, 0x81, 0x45, 0x65, 0xaf, 0x4d, 0xe6, 0xe3, 0x7e, 0x0f, 0xef, 0x93,
+	0xb1, 0x47, 0x7d, 0xa9, 0xb2, 0x13, 0x33, 0xae, 0x79, 0x24, 0x03, 0x3a, 0x52, 0x5a, 0xba, 0x11,
+	0xfa, 0xd4, 0x26, 0x51, 0xa5, 0x63, 0xf1, 0xfc, 0x10, 0xf2, 0x41, 0xe2, 0x80, 0x69, 0x5c, 0xbf,
+	0x2f, 0xa7, 0xc8, 0xc8, 0xa9, 0xc5, 0x80, 0x64, 0x1c, 0x11, 0x78, 0x7f, 0xda, 0x4e, 0x29, 0x08,
+	0xa6, 0x34, 0xab, 0x2e, 0xa8, 0xee, 0x16, 0x55, 0x77, 0x3f, 0xba, 0xa2, 0xb8, 0x71, 0x3b, 0x1b,
+	0xee, 0x29, 0x33, 0x36, 0xe2, 0x8e, 0x4a, 0xa0, 0x56, 0x88, 0xa3, 0xda, 0xfb, 0x5b, 0xd0, 0xf1,
+	0x19, 0xa6, 0xea, 0x36, 0xa2, 0xae, 0xd5, 0x1f, 0xaa, 0x4b, 0xd9, 0x93, 0x12, 0xa0, 0xaf, 0xa9,
+	0x83, 0xac, 0x47, 0xf6, 0x46, 0x64, 0x56, 0x02, 0x81, 0xbe, 0x84, 0x9d, 0xa8, 0x0c, 0x67, 0x54,
+	0x74, 0xcd, 0xb8, 0x09, 0x33, 0x0c, 0x58, 0x57, 0x08, 0x1f, 0x84, 0x7e, 0xf2, 0x9e, 0x6d, 0x86,
+	0x5e, 0x53, 0x79, 0x2c, 0xff, 0x23, 0x05, 0xab, 0x97, 0xc8, 0x20, 0xc5, 0x60, 0x86, 0x75, 0xe3,
+	0xe0, 0x86, 0x31, 0xb2, 0x53, 0xce, 0xbd, 0x32, 0x83, 0x89, 0x77, 0x99, 0xc1, 0xbf, 0xc1, 0x7b,
+	0xd3, 0x19, 0x9c, 0x26, 0x90, 0xd3, 0x98, 0xbc, 0xed, 0x34, 0xae, 0xc5, 0xc8, 0x27, 0x11, 0xb0,
+	0x1c, 0x4b, 0x06, 0xeb, 0x33, 0x63, 0x1f, 0x6d, 0x58, 0x66, 0x4c, 0xdd, 0x36, 0x63, 0x71, 0x3a,
+	0xff, 0x21, 0xae, 0x4c, 0x78, 0x0a, 0xeb, 0x51, 0xbb, 0xe7, 0xf2, 0x71, 0x7d, 0xf1, 0x86, 0x82,
+	0x50, 0x8c, 0xe9, 0x33, 0x4d, 0xc3, 0x91, 0x05, 0x5b, 0x71, 0x9e, 0xb9, 0x52, 0x06, 0x37, 0x43,
+	0xfa, 0x1a, 0xfc, 0xd4, 0x23, 0xa0, 0xd9, 0xca, 0xc9, 0x4b, 0xa1, 0xdc, 0x82, 0xf7, 0xa6, 0x77,
+	0x29, 0xf3, 0xa7, 0xac, 0x91, 0xcc, 0x4d, 0xd9, 0xa4, 0xcf, 0x75, 0xed, 0x8d, 0x89, 0xe6, 0x6e,
+	0x62, 0x43, 0x45, 0x94, 0x8f, 0x61, 0xeb, 0xf5, 0xa0, 0x0d, 0xd7, 0x26, 0x63, 0x54, 0x85, 0xe2,
+	0x25, 0x39, 0x08, 0x4e, 0xa4, 0xa9, 0x29, 0xbd, 0xc7, 0x67, 0xc5, 0x40, 0x6d, 0xf2, 0x3b, 0x0d,
+	0xf2, 0x73, 0x07, 0x42, 0x8f, 0x20, 0x71, 0xeb, 0x67, 0x51, 0xc2, 0xeb, 0xa1, 0xaf, 0x20, 0x29,
+	0x99, 0x92, 0xb8, 0x2d, 0x53, 0x24, 0x4a, 0xf9, 0xef, 0x1a, 0x6c, 0x5c, 0xd9, 0x64, 0xf9, 0xd2,
+	0xb0, 0xd8, 0xe8, 0x0e, 0xde, 0xbe, 0x16, 0x1b, 0x35, 0x7b, 0x72, 0x80, 0x71, 0x90, 0x23, 0xe0,
+	0x5e, 0x42, 0x15, 0x2f, 0x8b, 0xe3, 0xbc, 0xbc, 0xfc, 0x6f, 0x0d, 0x36, 0x5a, 0xa4, 0x4f, 0x2c,
+	0x41, 0x47, 0x24, 0xa2, 0xd6, 0x91, 0x7c, 0x6a, 0xba, 0x16, 0x41, 0x0f, 0x60, 0xf5, 0xb2, 0x28,
+	0xab, 0x87, 0x93, 0x91, 0x9f, 0x6b, 0x00, 0x32, 0x60, 0x39, 0x7e, 0x93, 0xdc, 0xf2, 0x91, 0xb4,
+	0x14, 0x3e, 0x47, 0xd0, 0x1e, 0xdc, 0xf7, 0x89, 0xe4, 0xa4, 0x14, 0xb7, 0x10, 0x9d, 0xf7, 0x02,
+	0x89, 0x30, 0x0a, 0xb1, 0xe9, 0x91, 0x74, 0x6f, 0xf5, 0x7e, 0x79, 0x04, 0xf7, 0xe7, 0x68, 0xd6,
+	0x12, 0x58, 0x0c, 0x39, 0xca, 0xc2, 0x52, 0xf3, 0xe8, 0xb8, 0xde, 0x38, 0xfe, 0xb2, 0xb0, 0x80,
+	0x00, 0xd2, 0x07, 0x87, 0xed, 0xc6, 0xd7, 0x47, 0x05, 0x0d, 0xe5, 0x20, 0x73, 0x72, 0x5c, 0x7b,
+	0x76, 0x5c, 0x3f, 0xaa, 0x17, 0x12, 0x68, 0x09, 0x92, 0x07, 0xc7, 0x7f, 0x2a, 0x24, 0x6b, 0x4f,
+	0x7e, 0x78, 0xb1, 0xad, 0xfd, 0xf8, 0x62, 0x5b, 0xfb, 0xff, 0x8b, 0x6d, 0xed, 0xdb, 0x97, 0xdb,
+	0x0b, 0x3f, 0xbe, 0xdc, 0x5e, 0xf8, 0xef, 0xcb, 0xed, 0x85, 0x6f, 0xde, 0x7a, 0x98, 0xf1, 0xec,
+	0x7f, 0x0e, 0xd4, 0xc9, 0x3a, 0x69, 0xf5, 0xd7, 0xd3, 0xa7, 0x3f, 0x0d, 0x00, 0x39, 0xcd, 0x32,
+	0x7b, 0x13, 0x11, 0x00, 0x00,
 }
 
 func (m *FinalityProvider) Marshal() (dAtA []byte, err error) {
@@ -861,6 +1072,55 @@ func (m *FinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CommissionUpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CommissionUpdateTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintBtcstaking(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x62
+	if m.MaxChangeRate != nil {
+		{
+			size := m.MaxChangeRate.Size()
+			i -= size
+			if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.MaxRate != nil {
+		{
+			size := m.MaxRate.Size()
+			i -= size
+			if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.JailedUntil != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.JailedUntil):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintBtcstaking(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.Sluggish {
 		i--
 		if m.Sluggish {
@@ -1004,6 +1264,70 @@ func (m *FinalityProviderWithMeta) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *FinalityProviderKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RotationHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.RotationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewPop != nil {
+		{
+			size, err := m.NewPop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NewBtcPk != nil {
+		{
+			size := m.NewBtcPk.Size()
+			i -= size
+			if _, err := m.NewBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OldBtcPk != nil {
+		{
+			size := m.OldBtcPk.Size()
+			i -= size
+			if _, err := m.OldBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BTCDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1024,6 +1348,87 @@ func (m *BTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiredWithPreviousDelegation {
+		i--
+		if m.ExpiredWithPreviousDelegation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.AwaitingInclusionProof {
+		i--
+		if m.AwaitingInclusionProof {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.CovenantStakeSpendingSigs) > 0 {
+		for iNdEx := len(m.CovenantStakeSpendingSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantStakeSpendingSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.SpentOutputs) > 0 {
+		for iNdEx := len(m.SpentOutputs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SpentOutputs[iNdEx])
+			copy(dAtA[i:], m.SpentOutputs[iNdEx])
+			i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.SpentOutputs[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.ExpiredByCovenantRotation {
+		i--
+		if m.ExpiredByCovenantRotation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.SpentByStakingTxHash) > 0 {
+		i -= len(m.SpentByStakingTxHash)
+		copy(dAtA[i:], m.SpentByStakingTxHash)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.SpentByStakingTxHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.PreviousStakingTxHash) > 0 {
+		i -= len(m.PreviousStakingTxHash)
+		copy(dAtA[i:], m.PreviousStakingTxHash)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.PreviousStakingTxHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.ParamsVersion != 0 {
 		i = encodeVarintBtcstaking(dAtA, i, uint64(m.ParamsVersion))
 		i--
@@ -1508,6 +1913,24 @@ func (m *FinalityProvider) Size() (n int) {
 	if m.Sluggish {
 		n += 2
 	}
+	if m.JailedUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.JailedUntil)
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.MaxRate != nil {
+		l = m.MaxRate.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.MaxChangeRate != nil {
+		l = m.MaxChangeRate.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CommissionUpdateTime)
+	n += 1 + l + sovBtcstaking(uint64(l))
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
 	return n
 }
 
@@ -1539,6 +1962,30 @@ func (m *FinalityProviderWithMeta) Size() (n int) {
 	return n
 }
 
+func (m *FinalityProviderKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldBtcPk != nil {
+		l = m.OldBtcPk.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.NewBtcPk != nil {
+		l = m.NewBtcPk.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.NewPop != nil {
+		l = m.NewPop.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.RotationHeight != 0 {
+		n += 1 + sovBtcstaking(uint64(m.RotationHeight))
+	}
+	return n
+}
+
 func (m *BTCDelegation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1603,6 +2050,35 @@ func (m *BTCDelegation) Size() (n int) {
 	if m.ParamsVersion != 0 {
 		n += 1 + sovBtcstaking(uint64(m.ParamsVersion))
 	}
+	l = len(m.PreviousStakingTxHash)
+	if l > 0 {
+		n += 2 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.SpentByStakingTxHash)
+	if l > 0 {
+		n += 2 + l + sovBtcstaking(uint64(l))
+	}
+	if m.ExpiredByCovenantRotation {
+		n += 3
+	}
+	if len(m.SpentOutputs) > 0 {
+		for _, b := range m.SpentOutputs {
+			l = len(b)
+			n += 2 + l + sovBtcstaking(uint64(l))
+		}
+	}
+	if len(m.CovenantStakeSpendingSigs) > 0 {
+		for _, e := range m.CovenantStakeSpendingSigs {
+			l = e.Size()
+			n += 2 + l + sovBtcstaking(uint64(l))
+		}
+	}
+	if m.AwaitingInclusionProof {
+		n += 3
+	}
+	if m.ExpiredWithPreviousDelegation {
+		n += 3
+	}
 	return n
 }
 
@@ -1998,11 +2474,184 @@ func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Sluggish = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBtcstaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JailedUntil == nil {
+				m.JailedUntil = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxRate = &v
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxChangeRate = &v
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CommissionUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthBtcstaking
@@ -2200,6 +2849,181 @@ func (m *FinalityProviderWithMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FinalityProviderKeyRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderKeyRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.OldBtcPk = &v
+			if err := m.OldBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.NewBtcPk = &v
+			if err := m.NewBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPop == nil {
+				m.NewPop = &ProofOfPossessionBTC{}
+			}
+			if err := m.NewPop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotationHeight", wireType)
+			}
+			m.RotationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RotationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2655,6 +3479,200 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStakingTxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousStakingTxHash = append(m.PreviousStakingTxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousStakingTxHash == nil {
+				m.PreviousStakingTxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentByStakingTxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpentByStakingTxHash = append(m.SpentByStakingTxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SpentByStakingTxHash == nil {
+				m.SpentByStakingTxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredByCovenantRotation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExpiredByCovenantRotation = bool(v != 0)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentOutputs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpentOutputs = append(m.SpentOutputs, make([]byte, postIndex-iNdEx))
+			copy(m.SpentOutputs[len(m.SpentOutputs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantStakeSpendingSigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantStakeSpendingSigs = append(m.CovenantStakeSpendingSigs, &SignatureInfo{})
+			if err := m.CovenantStakeSpendingSigs[len(m.CovenantStakeSpendingSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwaitingInclusionProof", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AwaitingInclusionProof = bool(v != 0)
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredWithPreviousDelegation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExpiredWithPreviousDelegation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
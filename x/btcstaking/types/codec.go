@@ -11,9 +11,14 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateFinalityProvider{}, "btcstaking/MsgCreateFinalityProvider", nil)
 	cdc.RegisterConcrete(&MsgEditFinalityProvider{}, "btcstaking/MsgEditFinalityProvider", nil)
 	cdc.RegisterConcrete(&MsgCreateBTCDelegation{}, "btcstaking/MsgCreateBTCDelegation", nil)
+	cdc.RegisterConcrete(&MsgExpandBTCDelegation{}, "btcstaking/MsgExpandBTCDelegation", nil)
+	cdc.RegisterConcrete(&MsgBTCRedelegate{}, "btcstaking/MsgBTCRedelegate", nil)
+	cdc.RegisterConcrete(&MsgAddBTCDelegationInclusionProof{}, "btcstaking/MsgAddBTCDelegationInclusionProof", nil)
+	cdc.RegisterConcrete(&MsgRotateFinalityProviderKey{}, "btcstaking/MsgRotateFinalityProviderKey", nil)
 	cdc.RegisterConcrete(&MsgAddCovenantSigs{}, "btcstaking/MsgAddCovenantSigs", nil)
 	cdc.RegisterConcrete(&MsgBTCUndelegate{}, "btcstaking/MsgBTCUndelegate", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "btcstaking/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgRotateCovenantCommittee{}, "btcstaking/MsgRotateCovenantCommittee", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -23,9 +28,14 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateFinalityProvider{},
 		&MsgEditFinalityProvider{},
 		&MsgCreateBTCDelegation{},
+		&MsgExpandBTCDelegation{},
+		&MsgBTCRedelegate{},
+		&MsgAddBTCDelegationInclusionProof{},
+		&MsgRotateFinalityProviderKey{},
 		&MsgAddCovenantSigs{},
 		&MsgBTCUndelegate{},
 		&MsgUpdateParams{},
+		&MsgRotateCovenantCommittee{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
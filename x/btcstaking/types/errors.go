@@ -31,4 +31,17 @@ var (
 	ErrVotingPowerTableNotUpdated   = errorsmod.Register(ModuleName, 1122, "voting power table has not been updated")
 	ErrVotingPowerDistCacheNotFound = errorsmod.Register(ModuleName, 1123, "the voting power distribution cache is not found")
 	ErrParamsNotFound               = errorsmod.Register(ModuleName, 1124, "the parameters are not found")
+	ErrInvalidStakeExpansion        = errorsmod.Register(ModuleName, 1125, "the stake expansion is not valid")
+	ErrInvalidRedelegation          = errorsmod.Register(ModuleName, 1126, "the BTC redelegation is not valid")
+	ErrFpAlreadyJailed              = errorsmod.Register(ModuleName, 1127, "the finality provider has already been jailed")
+	ErrFpNotJailed                  = errorsmod.Register(ModuleName, 1128, "the finality provider is not jailed")
+	ErrInvalidCommissionRates       = errorsmod.Register(ModuleName, 1129, "the commission rates are not valid")
+	ErrCommissionGTFpMaxRate        = errorsmod.Register(ModuleName, 1130, "commission cannot be more than the finality provider's max rate")
+	ErrCommissionGTMaxChangeRate    = errorsmod.Register(ModuleName, 1131, "commission cannot be changed more than the max change rate")
+	ErrCommissionUpdateTime         = errorsmod.Register(ModuleName, 1132, "commission cannot be changed more than once in 24h")
+	ErrInvalidFpKeyRotation         = errorsmod.Register(ModuleName, 1133, "the finality provider key rotation is not valid")
+	ErrFpKeyRotated                 = errorsmod.Register(ModuleName, 1134, "the finality provider BTC PK is rotated or being rotated")
+	ErrInvalidCovenantRotation      = errorsmod.Register(ModuleName, 1135, "the covenant committee rotation is not valid")
+	ErrConsumerNotRegistered        = errorsmod.Register(ModuleName, 1136, "the consumer chain is not registered")
+	ErrNoBabylonFpRestaked          = errorsmod.Register(ModuleName, 1137, "the BTC delegation has to be staked to at least one Babylon finality provider")
 )
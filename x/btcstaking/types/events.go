@@ -1,6 +1,8 @@
 package types
 
 import (
+	sdkmath "cosmossdk.io/math"
+
 	bbn "github.com/babylonchain/babylon/types"
 )
 
@@ -21,3 +23,69 @@ func NewEventPowerDistUpdateWithSlashedFP(fpBTCPK *bbn.BIP340PubKey) *EventPower
 		},
 	}
 }
+
+func NewEventPowerDistUpdateWithJailedFP(fpBTCPK *bbn.BIP340PubKey) *EventPowerDistUpdate {
+	return &EventPowerDistUpdate{
+		Ev: &EventPowerDistUpdate_JailedFp{
+			JailedFp: &EventPowerDistUpdate_EventJailedFinalityProvider{
+				Pk: fpBTCPK,
+			},
+		},
+	}
+}
+
+func NewEventPowerDistUpdateWithUnjailedFP(fpBTCPK *bbn.BIP340PubKey) *EventPowerDistUpdate {
+	return &EventPowerDistUpdate{
+		Ev: &EventPowerDistUpdate_UnjailedFp{
+			UnjailedFp: &EventPowerDistUpdate_EventUnjailedFinalityProvider{
+				Pk: fpBTCPK,
+			},
+		},
+	}
+}
+
+func NewEventPowerDistUpdateWithFPCommission(fpBTCPK *bbn.BIP340PubKey, commission *sdkmath.LegacyDec) *EventPowerDistUpdate {
+	return &EventPowerDistUpdate{
+		Ev: &EventPowerDistUpdate_FpCommissionUpdate{
+			FpCommissionUpdate: &EventPowerDistUpdate_EventFinalityProviderCommissionUpdate{
+				Pk:         fpBTCPK,
+				Commission: commission,
+			},
+		},
+	}
+}
+
+func NewEventPowerDistUpdateWithFPKeyRotation(oldBTCPK, newBTCPK *bbn.BIP340PubKey) *EventPowerDistUpdate {
+	return &EventPowerDistUpdate{
+		Ev: &EventPowerDistUpdate_FpKeyRotation{
+			FpKeyRotation: &EventPowerDistUpdate_EventFinalityProviderKeyRotation{
+				OldPk: oldBTCPK,
+				NewPk: newBTCPK,
+			},
+		},
+	}
+}
+
+func NewConsumerEventWithNewFP(ev *EventNewFinalityProvider) *BTCStakingConsumerEvent {
+	return &BTCStakingConsumerEvent{
+		Ev: &BTCStakingConsumerEvent_NewFp{
+			NewFp: ev,
+		},
+	}
+}
+
+func NewConsumerEventWithBTCDel(ev *EventBTCDelegationStateUpdate) *BTCStakingConsumerEvent {
+	return &BTCStakingConsumerEvent{
+		Ev: &BTCStakingConsumerEvent_BtcDelStateUpdate{
+			BtcDelStateUpdate: ev,
+		},
+	}
+}
+
+func NewConsumerEventWithSelectiveSlashing(ev *EventSelectiveSlashing) *BTCStakingConsumerEvent {
+	return &BTCStakingConsumerEvent{
+		Ev: &BTCStakingConsumerEvent_SelectiveSlashing{
+			SelectiveSlashing: ev,
+		},
+	}
+}
@@ -4,8 +4,10 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -71,9 +73,11 @@ func (m *EventNewFinalityProvider) GetFp() *FinalityProvider {
 
 // EventBTCDelegationStateUpdate is the event emitted when a BTC delegation's state is
 // updated. There are the following possible state transitions:
-// - non-existing -> pending, which happens upon `MsgCreateBTCDelegation`
-// - pending -> active, which happens upon `MsgAddCovenantSigs`
-// - active -> unbonded, which happens upon `MsgBTCUndelegate` or upon staking tx timelock expires
+//   - non-existing -> pending, which happens upon `MsgCreateBTCDelegation`
+//   - pending -> active, which happens upon `MsgAddCovenantSigs`
+//   - active -> unbonded, which happens upon `MsgBTCUndelegate`, upon staking tx timelock expires,
+//     or upon the BTC delegation spending its staking output via `MsgExpandBTCDelegation`
+//     or `MsgBTCRedelegate` becoming active
 type EventBTCDelegationStateUpdate struct {
 	// staking_tx_hash is the hash of the staking tx.
 	// It uniquely identifies a BTC delegation
@@ -177,29 +181,29 @@ func (m *EventSelectiveSlashing) GetEvidence() *SelectiveSlashingEvidence {
 	return nil
 }
 
-// EventPowerDistUpdate is an event that affects voting power distirbution
-// of BTC staking protocol
-type EventPowerDistUpdate struct {
-	// ev is the event that affects voting power distribution
-	//
+// BTCStakingConsumerEvent is a BTC staking event that concerns the finality
+// providers of a consumer chain. Such events are queued per consumer chain and
+// relayed to it over IBC by the zoneconcierge module.
+type BTCStakingConsumerEvent struct {
 	// Types that are valid to be assigned to Ev:
-	//	*EventPowerDistUpdate_SlashedFp
-	//	*EventPowerDistUpdate_BtcDelStateUpdate
-	Ev isEventPowerDistUpdate_Ev `protobuf_oneof:"ev"`
+	//	*BTCStakingConsumerEvent_NewFp
+	//	*BTCStakingConsumerEvent_BtcDelStateUpdate
+	//	*BTCStakingConsumerEvent_SelectiveSlashing
+	Ev isBTCStakingConsumerEvent_Ev `protobuf_oneof:"ev"`
 }
 
-func (m *EventPowerDistUpdate) Reset()         { *m = EventPowerDistUpdate{} }
-func (m *EventPowerDistUpdate) String() string { return proto.CompactTextString(m) }
-func (*EventPowerDistUpdate) ProtoMessage()    {}
-func (*EventPowerDistUpdate) Descriptor() ([]byte, []int) {
+func (m *BTCStakingConsumerEvent) Reset()         { *m = BTCStakingConsumerEvent{} }
+func (m *BTCStakingConsumerEvent) String() string { return proto.CompactTextString(m) }
+func (*BTCStakingConsumerEvent) ProtoMessage()    {}
+func (*BTCStakingConsumerEvent) Descriptor() ([]byte, []int) {
 	return fileDescriptor_74118427820fff75, []int{3}
 }
-func (m *EventPowerDistUpdate) XXX_Unmarshal(b []byte) error {
+func (m *BTCStakingConsumerEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPowerDistUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BTCStakingConsumerEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPowerDistUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BTCStakingConsumerEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -209,86 +213,107 @@ func (m *EventPowerDistUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *EventPowerDistUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPowerDistUpdate.Merge(m, src)
+func (m *BTCStakingConsumerEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCStakingConsumerEvent.Merge(m, src)
 }
-func (m *EventPowerDistUpdate) XXX_Size() int {
+func (m *BTCStakingConsumerEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPowerDistUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPowerDistUpdate.DiscardUnknown(m)
+func (m *BTCStakingConsumerEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCStakingConsumerEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventPowerDistUpdate proto.InternalMessageInfo
+var xxx_messageInfo_BTCStakingConsumerEvent proto.InternalMessageInfo
 
-type isEventPowerDistUpdate_Ev interface {
-	isEventPowerDistUpdate_Ev()
+type isBTCStakingConsumerEvent_Ev interface {
+	isBTCStakingConsumerEvent_Ev()
 	MarshalTo([]byte) (int, error)
 	Size() int
 }
 
-type EventPowerDistUpdate_SlashedFp struct {
-	SlashedFp *EventPowerDistUpdate_EventSlashedFinalityProvider `protobuf:"bytes,1,opt,name=slashed_fp,json=slashedFp,proto3,oneof" json:"slashed_fp,omitempty"`
+type BTCStakingConsumerEvent_NewFp struct {
+	NewFp *EventNewFinalityProvider `protobuf:"bytes,1,opt,name=new_fp,json=newFp,proto3,oneof" json:"new_fp,omitempty"`
 }
-type EventPowerDistUpdate_BtcDelStateUpdate struct {
+type BTCStakingConsumerEvent_BtcDelStateUpdate struct {
 	BtcDelStateUpdate *EventBTCDelegationStateUpdate `protobuf:"bytes,2,opt,name=btc_del_state_update,json=btcDelStateUpdate,proto3,oneof" json:"btc_del_state_update,omitempty"`
 }
+type BTCStakingConsumerEvent_SelectiveSlashing struct {
+	SelectiveSlashing *EventSelectiveSlashing `protobuf:"bytes,3,opt,name=selective_slashing,json=selectiveSlashing,proto3,oneof" json:"selective_slashing,omitempty"`
+}
 
-func (*EventPowerDistUpdate_SlashedFp) isEventPowerDistUpdate_Ev()         {}
-func (*EventPowerDistUpdate_BtcDelStateUpdate) isEventPowerDistUpdate_Ev() {}
+func (*BTCStakingConsumerEvent_NewFp) isBTCStakingConsumerEvent_Ev()             {}
+func (*BTCStakingConsumerEvent_BtcDelStateUpdate) isBTCStakingConsumerEvent_Ev() {}
+func (*BTCStakingConsumerEvent_SelectiveSlashing) isBTCStakingConsumerEvent_Ev() {}
 
-func (m *EventPowerDistUpdate) GetEv() isEventPowerDistUpdate_Ev {
+func (m *BTCStakingConsumerEvent) GetEv() isBTCStakingConsumerEvent_Ev {
 	if m != nil {
 		return m.Ev
 	}
 	return nil
 }
 
-func (m *EventPowerDistUpdate) GetSlashedFp() *EventPowerDistUpdate_EventSlashedFinalityProvider {
-	if x, ok := m.GetEv().(*EventPowerDistUpdate_SlashedFp); ok {
-		return x.SlashedFp
+func (m *BTCStakingConsumerEvent) GetNewFp() *EventNewFinalityProvider {
+	if x, ok := m.GetEv().(*BTCStakingConsumerEvent_NewFp); ok {
+		return x.NewFp
 	}
 	return nil
 }
 
-func (m *EventPowerDistUpdate) GetBtcDelStateUpdate() *EventBTCDelegationStateUpdate {
-	if x, ok := m.GetEv().(*EventPowerDistUpdate_BtcDelStateUpdate); ok {
+func (m *BTCStakingConsumerEvent) GetBtcDelStateUpdate() *EventBTCDelegationStateUpdate {
+	if x, ok := m.GetEv().(*BTCStakingConsumerEvent_BtcDelStateUpdate); ok {
 		return x.BtcDelStateUpdate
 	}
 	return nil
 }
 
+func (m *BTCStakingConsumerEvent) GetSelectiveSlashing() *EventSelectiveSlashing {
+	if x, ok := m.GetEv().(*BTCStakingConsumerEvent_SelectiveSlashing); ok {
+		return x.SelectiveSlashing
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
-func (*EventPowerDistUpdate) XXX_OneofWrappers() []interface{} {
+func (*BTCStakingConsumerEvent) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
-		(*EventPowerDistUpdate_SlashedFp)(nil),
-		(*EventPowerDistUpdate_BtcDelStateUpdate)(nil),
+		(*BTCStakingConsumerEvent_NewFp)(nil),
+		(*BTCStakingConsumerEvent_BtcDelStateUpdate)(nil),
+		(*BTCStakingConsumerEvent_SelectiveSlashing)(nil),
 	}
 }
 
-// EventSlashedFinalityProvider defines an event that a finality provider
-// is slashed
-// TODO: unify with existing slashing events
-type EventPowerDistUpdate_EventSlashedFinalityProvider struct {
-	Pk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=pk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"pk,omitempty"`
+// EventBTCRedelegation is the event emitted when a BTC delegation is
+// redelegated to a different set of finality providers via `MsgBTCRedelegate`.
+// The voting power moves from the source to the destination finality providers
+// once the new BTC delegation becomes active.
+type EventBTCRedelegation struct {
+	// previous_staking_tx_hash is the hash of the staking tx of the redelegated
+	// BTC delegation
+	PreviousStakingTxHash string `protobuf:"bytes,1,opt,name=previous_staking_tx_hash,json=previousStakingTxHash,proto3" json:"previous_staking_tx_hash,omitempty"`
+	// staking_tx_hash is the hash of the new staking tx
+	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// src_fp_btc_pk_list is the list of BIP-340 PKs of the finality providers
+	// that the BTC delegation is redelegated from
+	SrcFpBtcPkList []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,3,rep,name=src_fp_btc_pk_list,json=srcFpBtcPkList,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"src_fp_btc_pk_list,omitempty"`
+	// dst_fp_btc_pk_list is the list of BIP-340 PKs of the finality providers
+	// that the BTC delegation is redelegated to
+	DstFpBtcPkList []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,4,rep,name=dst_fp_btc_pk_list,json=dstFpBtcPkList,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"dst_fp_btc_pk_list,omitempty"`
+	// total_sat is the amount of satoshis locked in the new staking output
+	TotalSat uint64 `protobuf:"varint,5,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
 }
 
-func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Reset() {
-	*m = EventPowerDistUpdate_EventSlashedFinalityProvider{}
-}
-func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) String() string {
-	return proto.CompactTextString(m)
-}
-func (*EventPowerDistUpdate_EventSlashedFinalityProvider) ProtoMessage() {}
-func (*EventPowerDistUpdate_EventSlashedFinalityProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{3, 0}
+func (m *EventBTCRedelegation) Reset()         { *m = EventBTCRedelegation{} }
+func (m *EventBTCRedelegation) String() string { return proto.CompactTextString(m) }
+func (*EventBTCRedelegation) ProtoMessage()    {}
+func (*EventBTCRedelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{4}
 }
-func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) XXX_Unmarshal(b []byte) error {
+func (m *EventBTCRedelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventBTCRedelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPowerDistUpdate_EventSlashedFinalityProvider.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventBTCRedelegation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -298,386 +323,3357 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) XXX_Marshal(b []byte
 		return b[:n], nil
 	}
 }
-func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPowerDistUpdate_EventSlashedFinalityProvider.Merge(m, src)
+func (m *EventBTCRedelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBTCRedelegation.Merge(m, src)
 }
-func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) XXX_Size() int {
+func (m *EventBTCRedelegation) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPowerDistUpdate_EventSlashedFinalityProvider.DiscardUnknown(m)
+func (m *EventBTCRedelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBTCRedelegation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventPowerDistUpdate_EventSlashedFinalityProvider proto.InternalMessageInfo
-
-func init() {
-	proto.RegisterType((*EventNewFinalityProvider)(nil), "babylon.btcstaking.v1.EventNewFinalityProvider")
-	proto.RegisterType((*EventBTCDelegationStateUpdate)(nil), "babylon.btcstaking.v1.EventBTCDelegationStateUpdate")
-	proto.RegisterType((*EventSelectiveSlashing)(nil), "babylon.btcstaking.v1.EventSelectiveSlashing")
-	proto.RegisterType((*EventPowerDistUpdate)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate")
-	proto.RegisterType((*EventPowerDistUpdate_EventSlashedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventSlashedFinalityProvider")
-}
+var xxx_messageInfo_EventBTCRedelegation proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterFile("babylon/btcstaking/v1/events.proto", fileDescriptor_74118427820fff75)
+func (m *EventBTCRedelegation) GetPreviousStakingTxHash() string {
+	if m != nil {
+		return m.PreviousStakingTxHash
+	}
+	return ""
 }
 
-var fileDescriptor_74118427820fff75 = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4f, 0x6f, 0xd3, 0x40,
-	0x10, 0xc5, 0x6d, 0x0b, 0xa1, 0x66, 0xcb, 0x1f, 0x61, 0x05, 0x14, 0x45, 0x60, 0x2a, 0x1f, 0x4a,
-	0xc5, 0xc1, 0x6e, 0xd3, 0x0a, 0xee, 0x26, 0x0d, 0x41, 0x54, 0x28, 0xb2, 0xcb, 0x85, 0x8b, 0xb5,
-	0x76, 0x26, 0xf6, 0x2a, 0x66, 0xd7, 0xca, 0x4e, 0x9c, 0xe4, 0x5b, 0xf4, 0x63, 0x71, 0xec, 0xb1,
-	0xe2, 0x80, 0x50, 0xf2, 0x45, 0x90, 0x37, 0xa6, 0x44, 0x6d, 0x1c, 0x6e, 0xc9, 0xe8, 0xbd, 0xf7,
-	0x7b, 0x33, 0xb6, 0x89, 0x1d, 0xd1, 0x68, 0x91, 0x09, 0xee, 0x46, 0x18, 0x4b, 0xa4, 0x63, 0xc6,
-	0x13, 0xb7, 0x38, 0x71, 0xa1, 0x00, 0x8e, 0xd2, 0xc9, 0x27, 0x02, 0x85, 0xf9, 0xbc, 0xd2, 0x38,
-	0xff, 0x34, 0x4e, 0x71, 0xd2, 0x6e, 0x26, 0x22, 0x11, 0x4a, 0xe1, 0x96, 0xbf, 0xd6, 0xe2, 0xf6,
-	0xe1, 0xf6, 0xc0, 0x0d, 0xab, 0xd2, 0xd9, 0x01, 0x69, 0x9d, 0x97, 0x90, 0x2f, 0x30, 0xeb, 0x31,
-	0x4e, 0x33, 0x86, 0x8b, 0xc1, 0x44, 0x14, 0x6c, 0x08, 0x13, 0xf3, 0x3d, 0x31, 0x46, 0x79, 0x4b,
-	0x3f, 0xd0, 0x8f, 0xf6, 0x3b, 0x6f, 0x9c, 0xad, 0x74, 0xe7, 0xae, 0xc9, 0x37, 0x46, 0xb9, 0x7d,
-	0xa5, 0x93, 0x57, 0x2a, 0xd5, 0xbb, 0xfc, 0xd0, 0x85, 0x0c, 0x12, 0x8a, 0x4c, 0xf0, 0x00, 0x29,
-	0xc2, 0xd7, 0x7c, 0x48, 0x11, 0xcc, 0x43, 0xf2, 0xb4, 0x0a, 0x09, 0x71, 0x1e, 0xa6, 0x54, 0xa6,
-	0x8a, 0xd3, 0xf0, 0x1f, 0x57, 0xe3, 0xcb, 0x79, 0x9f, 0xca, 0xd4, 0xfc, 0x48, 0x1a, 0x1c, 0x66,
-	0xa1, 0x2c, 0xad, 0x2d, 0xe3, 0x40, 0x3f, 0x7a, 0xd2, 0x79, 0x5b, 0xd3, 0xe4, 0x1e, 0x6b, 0x2a,
-	0xfd, 0x3d, 0x0e, 0x33, 0x85, 0xb5, 0x47, 0xe4, 0x85, 0x6a, 0x14, 0x40, 0x06, 0x31, 0xb2, 0x02,
-	0x82, 0x8c, 0xca, 0x94, 0xf1, 0xc4, 0xbc, 0x20, 0x7b, 0x50, 0x56, 0xe7, 0x31, 0x54, 0xbb, 0x1e,
-	0xd7, 0x10, 0xee, 0x79, 0xcf, 0x2b, 0x9f, 0x7f, 0x9b, 0x60, 0xdf, 0x18, 0xa4, 0xa9, 0x40, 0x03,
-	0x31, 0x83, 0x49, 0x97, 0x49, 0xac, 0x36, 0x66, 0x84, 0xc8, 0xd2, 0x06, 0xc3, 0xf0, 0xf6, 0xa8,
-	0xfd, 0x1a, 0xd0, 0xb6, 0x80, 0xf5, 0x30, 0x58, 0x47, 0xdc, 0xbd, 0x7a, 0x5f, 0xf3, 0x1b, 0x55,
-	0x7a, 0x2f, 0x37, 0x13, 0xd2, 0x8c, 0x30, 0x0e, 0x87, 0x90, 0xad, 0x0f, 0x17, 0x4e, 0x55, 0x82,
-	0xba, 0xdf, 0x7e, 0xe7, 0x6c, 0x17, 0xb4, 0xee, 0x81, 0xf5, 0x35, 0xff, 0x59, 0x84, 0x71, 0x17,
-	0xb2, 0x8d, 0x61, 0x7b, 0x44, 0x5e, 0xee, 0x6a, 0x65, 0xf6, 0x88, 0x91, 0x8f, 0xd5, 0xae, 0x8f,
-	0xbc, 0x77, 0x3f, 0x7f, 0xbd, 0xee, 0x24, 0x0c, 0xd3, 0x69, 0xe4, 0xc4, 0xe2, 0xbb, 0x5b, 0x95,
-	0x88, 0x53, 0xca, 0xf8, 0xdf, 0x3f, 0x2e, 0x2e, 0x72, 0x90, 0x8e, 0xf7, 0x69, 0x70, 0x7a, 0x76,
-	0x3c, 0x98, 0x46, 0x9f, 0x61, 0xe1, 0x1b, 0xf9, 0xd8, 0x7b, 0x40, 0x0c, 0x28, 0xbc, 0x8b, 0x1f,
-	0x4b, 0x4b, 0xbf, 0x5e, 0x5a, 0xfa, 0xef, 0xa5, 0xa5, 0x5f, 0xad, 0x2c, 0xed, 0x7a, 0x65, 0x69,
-	0x37, 0x2b, 0x4b, 0xfb, 0xf6, 0xdf, 0xdc, 0xf9, 0xe6, 0x67, 0xa0, 0x20, 0xd1, 0x43, 0xf5, 0xfe,
-	0x9f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0xc4, 0xc4, 0x7d, 0x08, 0x7a, 0x03, 0x00, 0x00,
+func (m *EventBTCRedelegation) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
 }
 
-func (m *EventNewFinalityProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventBTCRedelegation) GetTotalSat() uint64 {
+	if m != nil {
+		return m.TotalSat
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventNewFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventFinalityProviderKeyRotationScheduled is the event emitted when a
+// finality provider schedules the rotation of its BTC PK
+type EventFinalityProviderKeyRotationScheduled struct {
+	Rotation *FinalityProviderKeyRotation `protobuf:"bytes,1,opt,name=rotation,proto3" json:"rotation,omitempty"`
 }
 
-func (m *EventNewFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Fp != nil {
-		{
-			size, err := m.Fp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *EventFinalityProviderKeyRotationScheduled) Reset() {
+	*m = EventFinalityProviderKeyRotationScheduled{}
+}
+func (m *EventFinalityProviderKeyRotationScheduled) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventFinalityProviderKeyRotationScheduled) ProtoMessage() {}
+func (*EventFinalityProviderKeyRotationScheduled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{5}
+}
+func (m *EventFinalityProviderKeyRotationScheduled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFinalityProviderKeyRotationScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFinalityProviderKeyRotationScheduled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventFinalityProviderKeyRotationScheduled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFinalityProviderKeyRotationScheduled.Merge(m, src)
+}
+func (m *EventFinalityProviderKeyRotationScheduled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFinalityProviderKeyRotationScheduled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFinalityProviderKeyRotationScheduled.DiscardUnknown(m)
 }
 
-func (m *EventBTCDelegationStateUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventFinalityProviderKeyRotationScheduled proto.InternalMessageInfo
+
+func (m *EventFinalityProviderKeyRotationScheduled) GetRotation() *FinalityProviderKeyRotation {
+	if m != nil {
+		return m.Rotation
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventBTCDelegationStateUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventFinalityProviderKeyRotated is the event emitted when a finality
+// provider is re-keyed to its new BTC PK at the scheduled height
+type EventFinalityProviderKeyRotated struct {
+	Rotation *FinalityProviderKeyRotation `protobuf:"bytes,1,opt,name=rotation,proto3" json:"rotation,omitempty"`
 }
 
-func (m *EventBTCDelegationStateUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NewState != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.NewState))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.StakingTxHash) > 0 {
-		i -= len(m.StakingTxHash)
-		copy(dAtA[i:], m.StakingTxHash)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakingTxHash)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventFinalityProviderKeyRotated) Reset()         { *m = EventFinalityProviderKeyRotated{} }
+func (m *EventFinalityProviderKeyRotated) String() string { return proto.CompactTextString(m) }
+func (*EventFinalityProviderKeyRotated) ProtoMessage()    {}
+func (*EventFinalityProviderKeyRotated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{6}
+}
+func (m *EventFinalityProviderKeyRotated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFinalityProviderKeyRotated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFinalityProviderKeyRotated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventFinalityProviderKeyRotated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFinalityProviderKeyRotated.Merge(m, src)
+}
+func (m *EventFinalityProviderKeyRotated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFinalityProviderKeyRotated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFinalityProviderKeyRotated.DiscardUnknown(m)
 }
 
-func (m *EventSelectiveSlashing) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventFinalityProviderKeyRotated proto.InternalMessageInfo
+
+func (m *EventFinalityProviderKeyRotated) GetRotation() *FinalityProviderKeyRotation {
+	if m != nil {
+		return m.Rotation
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventSelectiveSlashing) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventCovenantCommitteeRotationScheduled is the event emitted when the
+// rotation of the covenant committee is scheduled
+type EventCovenantCommitteeRotationScheduled struct {
+	Rotation *CovenantCommitteeRotation `protobuf:"bytes,1,opt,name=rotation,proto3" json:"rotation,omitempty"`
 }
 
-func (m *EventSelectiveSlashing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Evidence != nil {
-		{
-			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *EventCovenantCommitteeRotationScheduled) Reset() {
+	*m = EventCovenantCommitteeRotationScheduled{}
+}
+func (m *EventCovenantCommitteeRotationScheduled) String() string { return proto.CompactTextString(m) }
+func (*EventCovenantCommitteeRotationScheduled) ProtoMessage()    {}
+func (*EventCovenantCommitteeRotationScheduled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{7}
+}
+func (m *EventCovenantCommitteeRotationScheduled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCovenantCommitteeRotationScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCovenantCommitteeRotationScheduled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventCovenantCommitteeRotationScheduled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCovenantCommitteeRotationScheduled.Merge(m, src)
+}
+func (m *EventCovenantCommitteeRotationScheduled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCovenantCommitteeRotationScheduled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCovenantCommitteeRotationScheduled.DiscardUnknown(m)
 }
 
-func (m *EventPowerDistUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventCovenantCommitteeRotationScheduled proto.InternalMessageInfo
+
+func (m *EventCovenantCommitteeRotationScheduled) GetRotation() *CovenantCommitteeRotation {
+	if m != nil {
+		return m.Rotation
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventPowerDistUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventCovenantCommitteeRotated is the event emitted when the new covenant
+// committee is activated at the scheduled height
+type EventCovenantCommitteeRotated struct {
+	Rotation *CovenantCommitteeRotation `protobuf:"bytes,1,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	// params_version is the version of the parameters with the new covenant
+	// committee
+	ParamsVersion uint32 `protobuf:"varint,2,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// retiring_covenant_pks are the covenant members that are not in the new
+	// covenant committee
+	RetiringCovenantPks []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,3,rep,name=retiring_covenant_pks,json=retiringCovenantPks,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"retiring_covenant_pks,omitempty"`
+	// expired_staking_tx_hashes are the hashes of the staking txs of the
+	// pending BTC delegations that cannot form their covenant quorums without
+	// the retiring covenant members and thus expire upon the rotation
+	ExpiredStakingTxHashes []string `protobuf:"bytes,4,rep,name=expired_staking_tx_hashes,json=expiredStakingTxHashes,proto3" json:"expired_staking_tx_hashes,omitempty"`
 }
 
-func (m *EventPowerDistUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Ev != nil {
-		{
-			size := m.Ev.Size()
-			i -= size
-			if _, err := m.Ev.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *EventCovenantCommitteeRotated) Reset()         { *m = EventCovenantCommitteeRotated{} }
+func (m *EventCovenantCommitteeRotated) String() string { return proto.CompactTextString(m) }
+func (*EventCovenantCommitteeRotated) ProtoMessage()    {}
+func (*EventCovenantCommitteeRotated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{8}
+}
+func (m *EventCovenantCommitteeRotated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCovenantCommitteeRotated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCovenantCommitteeRotated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *EventPowerDistUpdate_SlashedFp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventCovenantCommitteeRotated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCovenantCommitteeRotated.Merge(m, src)
+}
+func (m *EventCovenantCommitteeRotated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCovenantCommitteeRotated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCovenantCommitteeRotated.DiscardUnknown(m)
 }
 
-func (m *EventPowerDistUpdate_SlashedFp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.SlashedFp != nil {
-		{
-			size, err := m.SlashedFp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventCovenantCommitteeRotated proto.InternalMessageInfo
+
+func (m *EventCovenantCommitteeRotated) GetRotation() *CovenantCommitteeRotation {
+	if m != nil {
+		return m.Rotation
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *EventPowerDistUpdate_BtcDelStateUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *EventCovenantCommitteeRotated) GetParamsVersion() uint32 {
+	if m != nil {
+		return m.ParamsVersion
+	}
+	return 0
 }
 
-func (m *EventPowerDistUpdate_BtcDelStateUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.BtcDelStateUpdate != nil {
-		{
-			size, err := m.BtcDelStateUpdate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *EventCovenantCommitteeRotated) GetExpiredStakingTxHashes() []string {
+	if m != nil {
+		return m.ExpiredStakingTxHashes
+	}
+	return nil
+}
+
+// EventBTCDelegationPruned is the event emitted when an unbonded BTC
+// delegation is pruned from the state
+type EventBTCDelegationPruned struct {
+	// staking_tx_hash is the hash of the staking tx of the pruned BTC delegation
+	StakingTxHash string `protobuf:"bytes,1,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+}
+
+func (m *EventBTCDelegationPruned) Reset()         { *m = EventBTCDelegationPruned{} }
+func (m *EventBTCDelegationPruned) String() string { return proto.CompactTextString(m) }
+func (*EventBTCDelegationPruned) ProtoMessage()    {}
+func (*EventBTCDelegationPruned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{9}
+}
+func (m *EventBTCDelegationPruned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBTCDelegationPruned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBTCDelegationPruned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventBTCDelegationPruned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBTCDelegationPruned.Merge(m, src)
+}
+func (m *EventBTCDelegationPruned) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBTCDelegationPruned) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBTCDelegationPruned.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBTCDelegationPruned proto.InternalMessageInfo
+
+func (m *EventBTCDelegationPruned) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventPowerDistUpdate is an event that affects voting power distirbution
+// of BTC staking protocol
+type EventPowerDistUpdate struct {
+	// ev is the event that affects voting power distribution
+	//
+	// Types that are valid to be assigned to Ev:
+	//	*EventPowerDistUpdate_SlashedFp
+	//	*EventPowerDistUpdate_BtcDelStateUpdate
+	//	*EventPowerDistUpdate_JailedFp
+	//	*EventPowerDistUpdate_UnjailedFp
+	//	*EventPowerDistUpdate_FpCommissionUpdate
+	//	*EventPowerDistUpdate_FpKeyRotation
+	Ev isEventPowerDistUpdate_Ev `protobuf_oneof:"ev"`
 }
 
-func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pk != nil {
-		{
-			size := m.Pk.Size()
-			i -= size
-			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *EventPowerDistUpdate) Reset()         { *m = EventPowerDistUpdate{} }
+func (m *EventPowerDistUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventPowerDistUpdate) ProtoMessage()    {}
+func (*EventPowerDistUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{10}
+}
+func (m *EventPowerDistUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPowerDistUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPowerDistUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventPowerDistUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPowerDistUpdate.Merge(m, src)
+}
+func (m *EventPowerDistUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPowerDistUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPowerDistUpdate.DiscardUnknown(m)
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_EventPowerDistUpdate proto.InternalMessageInfo
+
+type isEventPowerDistUpdate_Ev interface {
+	isEventPowerDistUpdate_Ev()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type EventPowerDistUpdate_SlashedFp struct {
+	SlashedFp *EventPowerDistUpdate_EventSlashedFinalityProvider `protobuf:"bytes,1,opt,name=slashed_fp,json=slashedFp,proto3,oneof" json:"slashed_fp,omitempty"`
+}
+type EventPowerDistUpdate_BtcDelStateUpdate struct {
+	BtcDelStateUpdate *EventBTCDelegationStateUpdate `protobuf:"bytes,2,opt,name=btc_del_state_update,json=btcDelStateUpdate,proto3,oneof" json:"btc_del_state_update,omitempty"`
+}
+type EventPowerDistUpdate_JailedFp struct {
+	JailedFp *EventPowerDistUpdate_EventJailedFinalityProvider `protobuf:"bytes,3,opt,name=jailed_fp,json=jailedFp,proto3,oneof" json:"jailed_fp,omitempty"`
+}
+type EventPowerDistUpdate_UnjailedFp struct {
+	UnjailedFp *EventPowerDistUpdate_EventUnjailedFinalityProvider `protobuf:"bytes,4,opt,name=unjailed_fp,json=unjailedFp,proto3,oneof" json:"unjailed_fp,omitempty"`
+}
+type EventPowerDistUpdate_FpCommissionUpdate struct {
+	FpCommissionUpdate *EventPowerDistUpdate_EventFinalityProviderCommissionUpdate `protobuf:"bytes,5,opt,name=fp_commission_update,json=fpCommissionUpdate,proto3,oneof" json:"fp_commission_update,omitempty"`
+}
+type EventPowerDistUpdate_FpKeyRotation struct {
+	FpKeyRotation *EventPowerDistUpdate_EventFinalityProviderKeyRotation `protobuf:"bytes,6,opt,name=fp_key_rotation,json=fpKeyRotation,proto3,oneof" json:"fp_key_rotation,omitempty"`
+}
+
+func (*EventPowerDistUpdate_SlashedFp) isEventPowerDistUpdate_Ev()          {}
+func (*EventPowerDistUpdate_BtcDelStateUpdate) isEventPowerDistUpdate_Ev()  {}
+func (*EventPowerDistUpdate_JailedFp) isEventPowerDistUpdate_Ev()           {}
+func (*EventPowerDistUpdate_UnjailedFp) isEventPowerDistUpdate_Ev()         {}
+func (*EventPowerDistUpdate_FpCommissionUpdate) isEventPowerDistUpdate_Ev() {}
+func (*EventPowerDistUpdate_FpKeyRotation) isEventPowerDistUpdate_Ev()      {}
+
+func (m *EventPowerDistUpdate) GetEv() isEventPowerDistUpdate_Ev {
+	if m != nil {
+		return m.Ev
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *EventNewFinalityProvider) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventPowerDistUpdate) GetSlashedFp() *EventPowerDistUpdate_EventSlashedFinalityProvider {
+	if x, ok := m.GetEv().(*EventPowerDistUpdate_SlashedFp); ok {
+		return x.SlashedFp
 	}
-	var l int
-	_ = l
-	if m.Fp != nil {
-		l = m.Fp.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	return nil
+}
+
+func (m *EventPowerDistUpdate) GetBtcDelStateUpdate() *EventBTCDelegationStateUpdate {
+	if x, ok := m.GetEv().(*EventPowerDistUpdate_BtcDelStateUpdate); ok {
+		return x.BtcDelStateUpdate
 	}
-	return n
+	return nil
 }
 
-func (m *EventBTCDelegationStateUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventPowerDistUpdate) GetJailedFp() *EventPowerDistUpdate_EventJailedFinalityProvider {
+	if x, ok := m.GetEv().(*EventPowerDistUpdate_JailedFp); ok {
+		return x.JailedFp
 	}
-	var l int
-	_ = l
-	l = len(m.StakingTxHash)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return nil
+}
+
+func (m *EventPowerDistUpdate) GetUnjailedFp() *EventPowerDistUpdate_EventUnjailedFinalityProvider {
+	if x, ok := m.GetEv().(*EventPowerDistUpdate_UnjailedFp); ok {
+		return x.UnjailedFp
 	}
-	if m.NewState != 0 {
-		n += 1 + sovEvents(uint64(m.NewState))
+	return nil
+}
+
+func (m *EventPowerDistUpdate) GetFpCommissionUpdate() *EventPowerDistUpdate_EventFinalityProviderCommissionUpdate {
+	if x, ok := m.GetEv().(*EventPowerDistUpdate_FpCommissionUpdate); ok {
+		return x.FpCommissionUpdate
 	}
-	return n
+	return nil
 }
 
-func (m *EventSelectiveSlashing) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventPowerDistUpdate) GetFpKeyRotation() *EventPowerDistUpdate_EventFinalityProviderKeyRotation {
+	if x, ok := m.GetEv().(*EventPowerDistUpdate_FpKeyRotation); ok {
+		return x.FpKeyRotation
 	}
-	var l int
-	_ = l
-	if m.Evidence != nil {
-		l = m.Evidence.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*EventPowerDistUpdate) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*EventPowerDistUpdate_SlashedFp)(nil),
+		(*EventPowerDistUpdate_BtcDelStateUpdate)(nil),
+		(*EventPowerDistUpdate_JailedFp)(nil),
+		(*EventPowerDistUpdate_UnjailedFp)(nil),
+		(*EventPowerDistUpdate_FpCommissionUpdate)(nil),
+		(*EventPowerDistUpdate_FpKeyRotation)(nil),
 	}
-	return n
 }
 
-func (m *EventPowerDistUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+// EventSlashedFinalityProvider defines an event that a finality provider
+// is slashed
+// TODO: unify with existing slashing events
+type EventPowerDistUpdate_EventSlashedFinalityProvider struct {
+	Pk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=pk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"pk,omitempty"`
+}
+
+func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Reset() {
+	*m = EventPowerDistUpdate_EventSlashedFinalityProvider{}
+}
+func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventPowerDistUpdate_EventSlashedFinalityProvider) ProtoMessage() {}
+func (*EventPowerDistUpdate_EventSlashedFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{10, 0}
+}
+func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPowerDistUpdate_EventSlashedFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Ev != nil {
-		n += m.Ev.Size()
+}
+func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPowerDistUpdate_EventSlashedFinalityProvider.Merge(m, src)
+}
+func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPowerDistUpdate_EventSlashedFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPowerDistUpdate_EventSlashedFinalityProvider proto.InternalMessageInfo
+
+// EventJailedFinalityProvider defines an event that a finality provider
+// is jailed
+type EventPowerDistUpdate_EventJailedFinalityProvider struct {
+	Pk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=pk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"pk,omitempty"`
+}
+
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) Reset() {
+	*m = EventPowerDistUpdate_EventJailedFinalityProvider{}
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventPowerDistUpdate_EventJailedFinalityProvider) ProtoMessage() {}
+func (*EventPowerDistUpdate_EventJailedFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{10, 1}
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPowerDistUpdate_EventJailedFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPowerDistUpdate_EventJailedFinalityProvider.Merge(m, src)
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPowerDistUpdate_EventJailedFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPowerDistUpdate_EventJailedFinalityProvider proto.InternalMessageInfo
+
+// EventUnjailedFinalityProvider defines an event that a jailed finality
+// provider is unjailed
+type EventPowerDistUpdate_EventUnjailedFinalityProvider struct {
+	Pk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=pk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"pk,omitempty"`
+}
+
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Reset() {
+	*m = EventPowerDistUpdate_EventUnjailedFinalityProvider{}
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventPowerDistUpdate_EventUnjailedFinalityProvider) ProtoMessage() {}
+func (*EventPowerDistUpdate_EventUnjailedFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{10, 2}
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPowerDistUpdate_EventUnjailedFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPowerDistUpdate_EventUnjailedFinalityProvider.Merge(m, src)
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPowerDistUpdate_EventUnjailedFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPowerDistUpdate_EventUnjailedFinalityProvider proto.InternalMessageInfo
+
+// EventFinalityProviderCommissionUpdate defines an event that a finality
+// provider changes its commission rate
+type EventPowerDistUpdate_EventFinalityProviderCommissionUpdate struct {
+	Pk         *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=pk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"pk,omitempty"`
+	Commission *cosmossdk_io_math.LegacyDec                        `protobuf:"bytes,2,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission,omitempty"`
+}
+
+func (m *EventPowerDistUpdate_EventFinalityProviderCommissionUpdate) Reset() {
+	*m = EventPowerDistUpdate_EventFinalityProviderCommissionUpdate{}
+}
+func (m *EventPowerDistUpdate_EventFinalityProviderCommissionUpdate) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventPowerDistUpdate_EventFinalityProviderCommissionUpdate) ProtoMessage() {}
+func (*EventPowerDistUpdate_EventFinalityProviderCommissionUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{10, 3}
+}
+func (m *EventPowerDistUpdate_EventFinalityProviderCommissionUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPowerDistUpdate_EventFinalityProviderCommissionUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPowerDistUpdate_EventFinalityProviderCommissionUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPowerDistUpdate_EventFinalityProviderCommissionUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPowerDistUpdate_EventFinalityProviderCommissionUpdate.Merge(m, src)
+}
+func (m *EventPowerDistUpdate_EventFinalityProviderCommissionUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPowerDistUpdate_EventFinalityProviderCommissionUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPowerDistUpdate_EventFinalityProviderCommissionUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPowerDistUpdate_EventFinalityProviderCommissionUpdate proto.InternalMessageInfo
+
+// EventFinalityProviderKeyRotation defines an event that a finality
+// provider is re-keyed from old_pk to new_pk
+type EventPowerDistUpdate_EventFinalityProviderKeyRotation struct {
+	OldPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=old_pk,json=oldPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"old_pk,omitempty"`
+	NewPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=new_pk,json=newPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"new_pk,omitempty"`
+}
+
+func (m *EventPowerDistUpdate_EventFinalityProviderKeyRotation) Reset() {
+	*m = EventPowerDistUpdate_EventFinalityProviderKeyRotation{}
+}
+func (m *EventPowerDistUpdate_EventFinalityProviderKeyRotation) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventPowerDistUpdate_EventFinalityProviderKeyRotation) ProtoMessage() {}
+func (*EventPowerDistUpdate_EventFinalityProviderKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{10, 4}
+}
+func (m *EventPowerDistUpdate_EventFinalityProviderKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPowerDistUpdate_EventFinalityProviderKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPowerDistUpdate_EventFinalityProviderKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPowerDistUpdate_EventFinalityProviderKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPowerDistUpdate_EventFinalityProviderKeyRotation.Merge(m, src)
+}
+func (m *EventPowerDistUpdate_EventFinalityProviderKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPowerDistUpdate_EventFinalityProviderKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPowerDistUpdate_EventFinalityProviderKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPowerDistUpdate_EventFinalityProviderKeyRotation proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*EventNewFinalityProvider)(nil), "babylon.btcstaking.v1.EventNewFinalityProvider")
+	proto.RegisterType((*EventBTCDelegationStateUpdate)(nil), "babylon.btcstaking.v1.EventBTCDelegationStateUpdate")
+	proto.RegisterType((*EventSelectiveSlashing)(nil), "babylon.btcstaking.v1.EventSelectiveSlashing")
+	proto.RegisterType((*BTCStakingConsumerEvent)(nil), "babylon.btcstaking.v1.BTCStakingConsumerEvent")
+	proto.RegisterType((*EventBTCRedelegation)(nil), "babylon.btcstaking.v1.EventBTCRedelegation")
+	proto.RegisterType((*EventFinalityProviderKeyRotationScheduled)(nil), "babylon.btcstaking.v1.EventFinalityProviderKeyRotationScheduled")
+	proto.RegisterType((*EventFinalityProviderKeyRotated)(nil), "babylon.btcstaking.v1.EventFinalityProviderKeyRotated")
+	proto.RegisterType((*EventCovenantCommitteeRotationScheduled)(nil), "babylon.btcstaking.v1.EventCovenantCommitteeRotationScheduled")
+	proto.RegisterType((*EventCovenantCommitteeRotated)(nil), "babylon.btcstaking.v1.EventCovenantCommitteeRotated")
+	proto.RegisterType((*EventBTCDelegationPruned)(nil), "babylon.btcstaking.v1.EventBTCDelegationPruned")
+	proto.RegisterType((*EventPowerDistUpdate)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate")
+	proto.RegisterType((*EventPowerDistUpdate_EventSlashedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventSlashedFinalityProvider")
+	proto.RegisterType((*EventPowerDistUpdate_EventJailedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventJailedFinalityProvider")
+	proto.RegisterType((*EventPowerDistUpdate_EventUnjailedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventUnjailedFinalityProvider")
+	proto.RegisterType((*EventPowerDistUpdate_EventFinalityProviderCommissionUpdate)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventFinalityProviderCommissionUpdate")
+	proto.RegisterType((*EventPowerDistUpdate_EventFinalityProviderKeyRotation)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventFinalityProviderKeyRotation")
+}
+
+func init() {
+	proto.RegisterFile("babylon/btcstaking/v1/events.proto", fileDescriptor_74118427820fff75)
+}
+
+var fileDescriptor_74118427820fff75 = []byte{
+	// 1041 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x97, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0xc7, 0x45, 0xfa, 0x05, 0xd6, 0xf8, 0xb1, 0x83, 0x67, 0x6b, 0xa7, 0x8a, 0xdd, 0xc8, 0x06,
+	0x81, 0x24, 0x6e, 0x01, 0x53, 0x89, 0x13, 0x34, 0xe8, 0x55, 0x76, 0x14, 0xa5, 0x55, 0x02, 0x95,
+	0x72, 0x7a, 0xe8, 0xa1, 0x04, 0x45, 0x0e, 0x25, 0x9a, 0x14, 0xb9, 0xe5, 0xae, 0x28, 0x0b, 0xbd,
+	0x15, 0xe8, 0x3d, 0x9f, 0xa5, 0xe8, 0xad, 0x40, 0xd1, 0x63, 0x8f, 0x41, 0x4f, 0x45, 0x50, 0x04,
+	0x85, 0xfd, 0x45, 0x0a, 0x2e, 0x49, 0x59, 0xd1, 0x5b, 0xec, 0xca, 0xed, 0xc9, 0xd6, 0x72, 0xf6,
+	0xff, 0x9b, 0x99, 0xfd, 0xef, 0x88, 0x02, 0xa5, 0x69, 0x34, 0xfb, 0x5e, 0xe0, 0x97, 0x9a, 0xdc,
+	0x64, 0xdc, 0x70, 0x1d, 0xbf, 0x55, 0x8a, 0x1e, 0x94, 0x30, 0x42, 0x9f, 0x33, 0x95, 0x86, 0x01,
+	0x0f, 0xc8, 0x66, 0x1a, 0xa3, 0x5e, 0xc4, 0xa8, 0xd1, 0x83, 0xad, 0x8d, 0x56, 0xd0, 0x0a, 0x44,
+	0x44, 0x29, 0xfe, 0x2f, 0x09, 0xde, 0xba, 0x65, 0x06, 0xac, 0x13, 0x30, 0x3d, 0x79, 0x90, 0x7c,
+	0x48, 0x1f, 0x4d, 0x61, 0x51, 0x23, 0x34, 0x3a, 0x59, 0xcc, 0xdd, 0xc9, 0x31, 0x43, 0x64, 0x11,
+	0xa7, 0x34, 0xa0, 0xf0, 0x24, 0xce, 0xf1, 0x05, 0xf6, 0x2a, 0x8e, 0x6f, 0x78, 0x0e, 0xef, 0xd7,
+	0xc3, 0x20, 0x72, 0x2c, 0x0c, 0xc9, 0x63, 0x90, 0x6d, 0x5a, 0x90, 0x76, 0xa5, 0xbd, 0xd5, 0x83,
+	0x7b, 0xea, 0xc4, 0xe4, 0xd5, 0xd1, 0x4d, 0x9a, 0x6c, 0x53, 0xe5, 0x95, 0x04, 0xb7, 0x85, 0x6a,
+	0xf9, 0xf8, 0xf0, 0x08, 0x3d, 0x6c, 0x19, 0xdc, 0x09, 0xfc, 0x06, 0x37, 0x38, 0xbe, 0xa4, 0x96,
+	0xc1, 0x91, 0xdc, 0x85, 0x1b, 0xa9, 0x88, 0xce, 0x4f, 0xf5, 0xb6, 0xc1, 0xda, 0x82, 0x93, 0xd7,
+	0xd6, 0xd2, 0xe5, 0xe3, 0xd3, 0xaa, 0xc1, 0xda, 0xe4, 0x29, 0xe4, 0x7d, 0xec, 0xe9, 0x2c, 0xde,
+	0x5a, 0x90, 0x77, 0xa5, 0xbd, 0xf5, 0x83, 0x4f, 0xa6, 0x64, 0x32, 0xc6, 0xea, 0x32, 0x6d, 0xc5,
+	0xc7, 0x9e, 0xc0, 0x2a, 0x36, 0xdc, 0x14, 0x19, 0x35, 0xd0, 0x43, 0x93, 0x3b, 0x11, 0x36, 0x3c,
+	0x83, 0xb5, 0x1d, 0xbf, 0x45, 0x6a, 0xb0, 0x82, 0x71, 0xea, 0xbe, 0x89, 0x69, 0xad, 0xf7, 0xa7,
+	0x10, 0xc6, 0xf6, 0x3e, 0x49, 0xf7, 0x69, 0x03, 0x05, 0xe5, 0x47, 0x19, 0x3e, 0x2c, 0x1f, 0x1f,
+	0x36, 0x92, 0x5d, 0x87, 0x81, 0xcf, 0xba, 0x1d, 0x0c, 0x05, 0x9a, 0x54, 0x61, 0x39, 0x2e, 0x66,
+	0xd0, 0xd3, 0xd2, 0x14, 0xce, 0xb4, 0x03, 0xa9, 0xe6, 0xb4, 0x25, 0x1f, 0x7b, 0x15, 0x4a, 0x5a,
+	0xb0, 0xd1, 0xe4, 0xa6, 0x6e, 0xa1, 0x97, 0xb4, 0x46, 0xef, 0x52, 0x2b, 0xeb, 0xd0, 0xea, 0xc1,
+	0xa3, 0x59, 0xba, 0xd3, 0x8e, 0xa4, 0x9a, 0xd3, 0xfe, 0xdf, 0xe4, 0xe6, 0x11, 0x7a, 0xc3, 0xe7,
+	0xf4, 0x0d, 0x10, 0x96, 0x55, 0xad, 0xb3, 0xb4, 0xec, 0xc2, 0x82, 0xc0, 0xec, 0xcf, 0xc2, 0x8c,
+	0xf5, 0x2a, 0xd6, 0x67, 0xa3, 0x8b, 0xe5, 0x45, 0x90, 0x31, 0x52, 0xfe, 0x94, 0x61, 0x23, 0x4b,
+	0x4e, 0x43, 0x6b, 0x90, 0x1e, 0x79, 0x0c, 0x05, 0x1a, 0x62, 0xe4, 0x04, 0x5d, 0xa6, 0x4f, 0xf6,
+	0xcb, 0x66, 0xf6, 0xbc, 0xf1, 0x8e, 0x6f, 0x26, 0xf8, 0x4b, 0x9e, 0xe4, 0xaf, 0x26, 0x10, 0x16,
+	0x9a, 0xba, 0x4d, 0xf5, 0xb8, 0x9f, 0xd4, 0xd5, 0x3d, 0x87, 0xf1, 0xc2, 0xc2, 0xee, 0xc2, 0xde,
+	0xff, 0xca, 0x9f, 0xbe, 0x79, 0xbb, 0x73, 0xd0, 0x72, 0x78, 0xbb, 0xdb, 0x54, 0xcd, 0xa0, 0x53,
+	0x4a, 0xab, 0x35, 0xdb, 0x86, 0xe3, 0x67, 0x1f, 0x4a, 0xbc, 0x4f, 0x91, 0xa9, 0xe5, 0x67, 0xf5,
+	0x87, 0x8f, 0xee, 0xd7, 0xbb, 0xcd, 0x2f, 0xb0, 0xaf, 0xad, 0xb3, 0xd0, 0xac, 0xd0, 0x32, 0x37,
+	0xeb, 0x6e, 0xcd, 0x61, 0x3c, 0x66, 0x58, 0x8c, 0x8f, 0x32, 0x16, 0xe7, 0x63, 0x58, 0x8c, 0x0f,
+	0x33, 0xb6, 0x21, 0xcf, 0x03, 0x6e, 0x78, 0x3a, 0x33, 0x78, 0x61, 0x69, 0x57, 0xda, 0x5b, 0xd4,
+	0x56, 0xc4, 0x42, 0xc3, 0xe0, 0xca, 0x77, 0xf0, 0xb1, 0xe8, 0xee, 0xa8, 0x9f, 0x62, 0xa1, 0x80,
+	0x27, 0x46, 0x30, 0xdb, 0x68, 0x75, 0x3d, 0xb4, 0xc8, 0x0b, 0x58, 0x09, 0xd3, 0xc5, 0xd4, 0xa6,
+	0x07, 0x97, 0xbc, 0xfa, 0x43, 0x72, 0xda, 0x40, 0x43, 0xf9, 0x16, 0x76, 0x66, 0xc2, 0xff, 0x05,
+	0x64, 0x0f, 0xee, 0x09, 0xe4, 0x61, 0x10, 0xa1, 0x6f, 0xc4, 0x7f, 0x3b, 0x1d, 0x87, 0x73, 0xc4,
+	0xf1, 0x6a, 0x6b, 0x63, 0xe8, 0x69, 0x97, 0x7f, 0xaa, 0xd8, 0x10, 0xf8, 0x67, 0x39, 0x9d, 0x7b,
+	0x93, 0x83, 0xaf, 0x9b, 0x47, 0xee, 0xc0, 0x7a, 0x32, 0xf4, 0xf5, 0x08, 0x43, 0x16, 0x6b, 0xc6,
+	0x26, 0x5f, 0xd3, 0xd6, 0x92, 0xd5, 0xaf, 0x92, 0x45, 0x72, 0x02, 0x9b, 0x21, 0x72, 0x27, 0x8c,
+	0x6f, 0x83, 0x99, 0xca, 0xea, 0xd4, 0x65, 0x73, 0xfa, 0xfc, 0x83, 0x4c, 0x34, 0x4b, 0xb5, 0xee,
+	0x32, 0xf2, 0x19, 0xdc, 0xc2, 0x53, 0xea, 0x84, 0x68, 0x8d, 0x5e, 0x58, 0x64, 0xc2, 0xf3, 0x79,
+	0xed, 0x66, 0x1a, 0xf0, 0xce, 0x8d, 0x45, 0xa6, 0x94, 0xa1, 0x30, 0x3e, 0xa1, 0xea, 0x61, 0xd7,
+	0x47, 0xeb, 0xb2, 0xdf, 0x17, 0xca, 0xf7, 0xab, 0xe9, 0x24, 0xa9, 0x07, 0x3d, 0x0c, 0x8f, 0x1c,
+	0xc6, 0xd3, 0x41, 0xe6, 0x00, 0x88, 0xf1, 0x85, 0xd6, 0xc5, 0xfc, 0xad, 0xce, 0x1a, 0x60, 0x23,
+	0x02, 0xe9, 0x54, 0x4b, 0x24, 0x26, 0x0c, 0xe6, 0x7c, 0xaa, 0xfe, 0x5f, 0x0e, 0x67, 0x1b, 0xf2,
+	0x27, 0x86, 0xe3, 0x25, 0x25, 0x25, 0x33, 0xf9, 0xe9, 0x95, 0x4b, 0xfa, 0x5c, 0x28, 0x4c, 0xa8,
+	0x68, 0x25, 0xd1, 0xae, 0x50, 0xe2, 0xc1, 0x6a, 0xd7, 0xbf, 0x20, 0x2d, 0x0a, 0xd2, 0xb3, 0x2b,
+	0x93, 0x5e, 0xfa, 0x27, 0xd3, 0x58, 0x90, 0xe9, 0x57, 0x28, 0xf9, 0x41, 0x82, 0x0d, 0x9b, 0xea,
+	0x66, 0xec, 0x7b, 0x16, 0xfb, 0x37, 0xeb, 0xdf, 0x92, 0xe0, 0x7e, 0x79, 0x65, 0xee, 0x28, 0xef,
+	0x70, 0xa0, 0x3c, 0x68, 0x2e, 0xb1, 0xe9, 0xe8, 0x2a, 0x89, 0xe0, 0x86, 0x4d, 0x75, 0x17, 0xfb,
+	0xfa, 0xe0, 0xc6, 0x2e, 0x8b, 0x0c, 0x6a, 0x73, 0x67, 0x30, 0x34, 0xb6, 0xaa, 0x39, 0x6d, 0xcd,
+	0xa6, 0x43, 0x0b, 0x5b, 0x36, 0x7c, 0x34, 0xcb, 0x6b, 0xa4, 0x02, 0x32, 0x75, 0x85, 0x83, 0xff,
+	0xf9, 0xd5, 0x95, 0xa9, 0xbb, 0x85, 0xb0, 0x3d, 0xc3, 0x00, 0xd7, 0x86, 0x69, 0xc1, 0xed, 0x99,
+	0xa7, 0x7f, 0x6d, 0xa0, 0x5f, 0x24, 0xb8, 0x73, 0xa9, 0xf3, 0xbe, 0x2e, 0x22, 0x79, 0x0e, 0x70,
+	0xe1, 0xd2, 0xe4, 0xfd, 0xa2, 0xbc, 0xff, 0xe6, 0xed, 0xce, 0x76, 0xf2, 0xb6, 0xce, 0x2c, 0x57,
+	0x75, 0x82, 0x52, 0xc7, 0xe0, 0x6d, 0xb5, 0x86, 0x2d, 0xc3, 0xec, 0x1f, 0xa1, 0xf9, 0xfb, 0x4f,
+	0xfb, 0x90, 0x3c, 0x56, 0x8f, 0xd0, 0xd4, 0x86, 0x04, 0xb6, 0x7e, 0x95, 0x60, 0xf7, 0x7d, 0x76,
+	0x21, 0xcf, 0x61, 0x39, 0xf0, 0x2c, 0x7d, 0xee, 0xfc, 0x97, 0x02, 0xcf, 0xaa, 0xc7, 0x25, 0x88,
+	0x57, 0x52, 0xea, 0x16, 0xe4, 0xf9, 0xe4, 0x7c, 0xec, 0xd5, 0xdd, 0xe4, 0x75, 0xae, 0x5c, 0xfb,
+	0xed, 0xac, 0x28, 0xbd, 0x3e, 0x2b, 0x4a, 0x7f, 0x9d, 0x15, 0xa5, 0x57, 0xe7, 0xc5, 0xdc, 0xeb,
+	0xf3, 0x62, 0xee, 0x8f, 0xf3, 0x62, 0xee, 0xeb, 0xf7, 0x4a, 0x9f, 0x0e, 0xff, 0x5e, 0x11, 0x9c,
+	0xe6, 0xb2, 0xf8, 0xa1, 0xf2, 0xf0, 0xef, 0x01, 0x00, 0xcd, 0x4e, 0x4b, 0x9d, 0x62, 0x0d, 0x00,
+	0x00,
+}
+
+func (m *EventNewFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNewFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNewFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fp != nil {
+		{
+			size, err := m.Fp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBTCDelegationStateUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBTCDelegationStateUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBTCDelegationStateUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewState != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewState))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSelectiveSlashing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSelectiveSlashing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSelectiveSlashing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		{
+			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCStakingConsumerEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCStakingConsumerEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCStakingConsumerEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ev != nil {
+		{
+			size := m.Ev.Size()
+			i -= size
+			if _, err := m.Ev.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCStakingConsumerEvent_NewFp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCStakingConsumerEvent_NewFp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NewFp != nil {
+		{
+			size, err := m.NewFp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BTCStakingConsumerEvent_BtcDelStateUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCStakingConsumerEvent_BtcDelStateUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BtcDelStateUpdate != nil {
+		{
+			size, err := m.BtcDelStateUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BTCStakingConsumerEvent_SelectiveSlashing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCStakingConsumerEvent_SelectiveSlashing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SelectiveSlashing != nil {
+		{
+			size, err := m.SelectiveSlashing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventBTCRedelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBTCRedelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBTCRedelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalSat != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DstFpBtcPkList) > 0 {
+		for iNdEx := len(m.DstFpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.DstFpBtcPkList[iNdEx].Size()
+				i -= size
+				if _, err := m.DstFpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SrcFpBtcPkList) > 0 {
+		for iNdEx := len(m.SrcFpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.SrcFpBtcPkList[iNdEx].Size()
+				i -= size
+				if _, err := m.SrcFpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PreviousStakingTxHash) > 0 {
+		i -= len(m.PreviousStakingTxHash)
+		copy(dAtA[i:], m.PreviousStakingTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousStakingTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFinalityProviderKeyRotationScheduled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFinalityProviderKeyRotationScheduled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFinalityProviderKeyRotationScheduled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rotation != nil {
+		{
+			size, err := m.Rotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFinalityProviderKeyRotated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFinalityProviderKeyRotated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFinalityProviderKeyRotated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rotation != nil {
+		{
+			size, err := m.Rotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCovenantCommitteeRotationScheduled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCovenantCommitteeRotationScheduled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCovenantCommitteeRotationScheduled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rotation != nil {
+		{
+			size, err := m.Rotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCovenantCommitteeRotated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCovenantCommitteeRotated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCovenantCommitteeRotated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpiredStakingTxHashes) > 0 {
+		for iNdEx := len(m.ExpiredStakingTxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExpiredStakingTxHashes[iNdEx])
+			copy(dAtA[i:], m.ExpiredStakingTxHashes[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.ExpiredStakingTxHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RetiringCovenantPks) > 0 {
+		for iNdEx := len(m.RetiringCovenantPks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.RetiringCovenantPks[iNdEx].Size()
+				i -= size
+				if _, err := m.RetiringCovenantPks[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ParamsVersion != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Rotation != nil {
+		{
+			size, err := m.Rotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBTCDelegationPruned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBTCDelegationPruned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBTCDelegationPruned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPowerDistUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPowerDistUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ev != nil {
+		{
+			size := m.Ev.Size()
+			i -= size
+			if _, err := m.Ev.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPowerDistUpdate_SlashedFp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_SlashedFp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SlashedFp != nil {
+		{
+			size, err := m.SlashedFp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventPowerDistUpdate_BtcDelStateUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_BtcDelStateUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BtcDelStateUpdate != nil {
+		{
+			size, err := m.BtcDelStateUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventPowerDistUpdate_JailedFp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_JailedFp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JailedFp != nil {
+		{
+			size, err := m.JailedFp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventPowerDistUpdate_UnjailedFp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_UnjailedFp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UnjailedFp != nil {
+		{
+			size, err := m.UnjailedFp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventPowerDistUpdate_FpCommissionUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_FpCommissionUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.FpCommissionUpdate != nil {
+		{
+			size, err := m.FpCommissionUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventPowerDistUpdate_FpKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_FpKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.FpKeyRotation != nil {
+		{
+			size, err := m.FpKeyRotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pk != nil {
+		{
+			size := m.Pk.Size()
+			i -= size
+			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pk != nil {
+		{
+			size := m.Pk.Size()
+			i -= size
+			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pk != nil {
+		{
+			size := m.Pk.Size()
+			i -= size
+			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPowerDistUpdate_EventFinalityProviderCommissionUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPowerDistUpdate_EventFinalityProviderCommissionUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_EventFinalityProviderCommissionUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Commission != nil {
+		{
+			size := m.Commission.Size()
+			i -= size
+			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pk != nil {
+		{
+			size := m.Pk.Size()
+			i -= size
+			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPowerDistUpdate_EventFinalityProviderKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPowerDistUpdate_EventFinalityProviderKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_EventFinalityProviderKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewPk != nil {
+		{
+			size := m.NewPk.Size()
+			i -= size
+			if _, err := m.NewPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OldPk != nil {
+		{
+			size := m.OldPk.Size()
+			i -= size
+			if _, err := m.OldPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventNewFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fp != nil {
+		l = m.Fp.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBTCDelegationStateUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.NewState != 0 {
+		n += 1 + sovEvents(uint64(m.NewState))
+	}
+	return n
+}
+
+func (m *EventSelectiveSlashing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCStakingConsumerEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ev != nil {
+		n += m.Ev.Size()
+	}
+	return n
+}
+
+func (m *BTCStakingConsumerEvent_NewFp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewFp != nil {
+		l = m.NewFp.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *BTCStakingConsumerEvent_BtcDelStateUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcDelStateUpdate != nil {
+		l = m.BtcDelStateUpdate.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *BTCStakingConsumerEvent_SelectiveSlashing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SelectiveSlashing != nil {
+		l = m.SelectiveSlashing.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventBTCRedelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousStakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.SrcFpBtcPkList) > 0 {
+		for _, e := range m.SrcFpBtcPkList {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.DstFpBtcPkList) > 0 {
+		for _, e := range m.DstFpBtcPkList {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.TotalSat != 0 {
+		n += 1 + sovEvents(uint64(m.TotalSat))
+	}
+	return n
+}
+
+func (m *EventFinalityProviderKeyRotationScheduled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rotation != nil {
+		l = m.Rotation.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFinalityProviderKeyRotated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rotation != nil {
+		l = m.Rotation.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCovenantCommitteeRotationScheduled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rotation != nil {
+		l = m.Rotation.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCovenantCommitteeRotated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rotation != nil {
+		l = m.Rotation.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ParamsVersion != 0 {
+		n += 1 + sovEvents(uint64(m.ParamsVersion))
+	}
+	if len(m.RetiringCovenantPks) > 0 {
+		for _, e := range m.RetiringCovenantPks {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.ExpiredStakingTxHashes) > 0 {
+		for _, s := range m.ExpiredStakingTxHashes {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventBTCDelegationPruned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPowerDistUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ev != nil {
+		n += m.Ev.Size()
+	}
+	return n
+}
+
+func (m *EventPowerDistUpdate_SlashedFp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashedFp != nil {
+		l = m.SlashedFp.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventPowerDistUpdate_BtcDelStateUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcDelStateUpdate != nil {
+		l = m.BtcDelStateUpdate.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventPowerDistUpdate_JailedFp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JailedFp != nil {
+		l = m.JailedFp.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventPowerDistUpdate_UnjailedFp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnjailedFp != nil {
+		l = m.UnjailedFp.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventPowerDistUpdate_FpCommissionUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpCommissionUpdate != nil {
+		l = m.FpCommissionUpdate.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventPowerDistUpdate_FpKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpKeyRotation != nil {
+		l = m.FpKeyRotation.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pk != nil {
+		l = m.Pk.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pk != nil {
+		l = m.Pk.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pk != nil {
+		l = m.Pk.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPowerDistUpdate_EventFinalityProviderCommissionUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pk != nil {
+		l = m.Pk.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPowerDistUpdate_EventFinalityProviderKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldPk != nil {
+		l = m.OldPk.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.NewPk != nil {
+		l = m.NewPk.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventNewFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNewFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNewFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fp == nil {
+				m.Fp = &FinalityProvider{}
+			}
+			if err := m.Fp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBTCDelegationStateUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBTCDelegationStateUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewState", wireType)
+			}
+			m.NewState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewState |= BTCDelegationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSelectiveSlashing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSelectiveSlashing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSelectiveSlashing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &SelectiveSlashingEvidence{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCStakingConsumerEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCStakingConsumerEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCStakingConsumerEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventNewFinalityProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &BTCStakingConsumerEvent_NewFp{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelStateUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventBTCDelegationStateUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &BTCStakingConsumerEvent_BtcDelStateUpdate{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectiveSlashing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventSelectiveSlashing{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &BTCStakingConsumerEvent_SelectiveSlashing{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBTCRedelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBTCRedelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBTCRedelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousStakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcFpBtcPkList", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.SrcFpBtcPkList = append(m.SrcFpBtcPkList, v)
+			if err := m.SrcFpBtcPkList[len(m.SrcFpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstFpBtcPkList", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.DstFpBtcPkList = append(m.DstFpBtcPkList, v)
+			if err := m.DstFpBtcPkList[len(m.DstFpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
+			}
+			m.TotalSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFinalityProviderKeyRotationScheduled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFinalityProviderKeyRotationScheduled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFinalityProviderKeyRotationScheduled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rotation == nil {
+				m.Rotation = &FinalityProviderKeyRotation{}
+			}
+			if err := m.Rotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFinalityProviderKeyRotated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFinalityProviderKeyRotated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFinalityProviderKeyRotated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rotation == nil {
+				m.Rotation = &FinalityProviderKeyRotation{}
+			}
+			if err := m.Rotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCovenantCommitteeRotationScheduled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCovenantCommitteeRotationScheduled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCovenantCommitteeRotationScheduled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rotation == nil {
+				m.Rotation = &CovenantCommitteeRotation{}
+			}
+			if err := m.Rotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCovenantCommitteeRotated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCovenantCommitteeRotated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCovenantCommitteeRotated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rotation == nil {
+				m.Rotation = &CovenantCommitteeRotation{}
+			}
+			if err := m.Rotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiringCovenantPks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.RetiringCovenantPks = append(m.RetiringCovenantPks, v)
+			if err := m.RetiringCovenantPks[len(m.RetiringCovenantPks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredStakingTxHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiredStakingTxHashes = append(m.ExpiredStakingTxHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBTCDelegationPruned) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBTCDelegationPruned: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBTCDelegationPruned: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPowerDistUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPowerDistUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedFp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventPowerDistUpdate_EventSlashedFinalityProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &EventPowerDistUpdate_SlashedFp{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelStateUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventBTCDelegationStateUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &EventPowerDistUpdate_BtcDelStateUpdate{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedFp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventPowerDistUpdate_EventJailedFinalityProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &EventPowerDistUpdate_JailedFp{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailedFp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventPowerDistUpdate_EventUnjailedFinalityProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &EventPowerDistUpdate_UnjailedFp{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpCommissionUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventPowerDistUpdate_EventFinalityProviderCommissionUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &EventPowerDistUpdate_FpCommissionUpdate{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpKeyRotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventPowerDistUpdate_EventFinalityProviderKeyRotation{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &EventPowerDistUpdate_FpKeyRotation{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventPowerDistUpdate_SlashedFp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SlashedFp != nil {
-		l = m.SlashedFp.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
-}
-func (m *EventPowerDistUpdate_BtcDelStateUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BtcDelStateUpdate != nil {
-		l = m.BtcDelStateUpdate.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
-}
-func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pk != nil {
-		l = m.Pk.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventNewFinalityProvider) Unmarshal(dAtA []byte) error {
+func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -700,17 +3696,17 @@ func (m *EventNewFinalityProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNewFinalityProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSlashedFinalityProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNewFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSlashedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -720,25 +3716,24 @@ func (m *EventNewFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fp == nil {
-				m.Fp = &FinalityProvider{}
-			}
-			if err := m.Fp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -763,7 +3758,7 @@ func (m *EventNewFinalityProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -786,17 +3781,17 @@ func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBTCDelegationStateUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventJailedFinalityProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBTCDelegationStateUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventJailedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -806,43 +3801,27 @@ func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewState", wireType)
-			}
-			m.NewState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewState |= BTCDelegationStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -864,7 +3843,7 @@ func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSelectiveSlashing) Unmarshal(dAtA []byte) error {
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -887,17 +3866,17 @@ func (m *EventSelectiveSlashing) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSelectiveSlashing: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUnjailedFinalityProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSelectiveSlashing: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUnjailedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -907,25 +3886,24 @@ func (m *EventSelectiveSlashing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Evidence == nil {
-				m.Evidence = &SelectiveSlashingEvidence{}
-			}
-			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -950,7 +3928,7 @@ func (m *EventSelectiveSlashing) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventPowerDistUpdate_EventFinalityProviderCommissionUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -973,17 +3951,17 @@ func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPowerDistUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFinalityProviderCommissionUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPowerDistUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFinalityProviderCommissionUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedFp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -993,32 +3971,32 @@ func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventPowerDistUpdate_EventSlashedFinalityProvider{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Ev = &EventPowerDistUpdate_SlashedFp{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelStateUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1028,26 +4006,27 @@ func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventBTCDelegationStateUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v cosmossdk_io_math.LegacyDec
+			m.Commission = &v
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Ev = &EventPowerDistUpdate_BtcDelStateUpdate{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1070,7 +4049,7 @@ func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Unmarshal(dAtA []byte) error {
+func (m *EventPowerDistUpdate_EventFinalityProviderKeyRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1093,15 +4072,15 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Unmarshal(dAtA []byt
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSlashedFinalityProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFinalityProviderKeyRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSlashedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFinalityProviderKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1129,8 +4108,43 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Unmarshal(dAtA []byt
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.Pk = &v
-			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OldPk = &v
+			if err := m.OldPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.NewPk = &v
+			if err := m.NewPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
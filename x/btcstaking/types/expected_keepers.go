@@ -4,10 +4,14 @@ import (
 	"context"
 	"math/big"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	bbn "github.com/babylonchain/babylon/types"
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	etypes "github.com/babylonchain/babylon/x/epoching/types"
+	itypes "github.com/babylonchain/babylon/x/incentive/types"
 )
 
 type BTCLightClientKeeper interface {
@@ -26,6 +30,25 @@ type CheckpointingKeeper interface {
 	GetLastFinalizedEpoch(ctx context.Context) uint64
 }
 
+type IncentiveKeeper interface {
+	GetRewardGauge(ctx context.Context, sType itypes.StakeholderType, addr sdk.AccAddress) *itypes.RewardGauge
+}
+
+type BTCStkConsumerKeeper interface {
+	IsConsumerRegistered(ctx context.Context, chainID string) bool
+	AddConsumerFinalityProvider(ctx context.Context, chainID string, fpBTCPK *bbn.BIP340PubKey) error
+}
+
 type BtcStakingHooks interface {
 	AfterFinalityProviderActivated(ctx context.Context, fpPk *bbn.BIP340PubKey) error
+	AfterFinalityProviderKeyRotated(ctx context.Context, oldFpPk *bbn.BIP340PubKey, newFpPk *bbn.BIP340PubKey) error
+}
+
+// BTCDelegationArchive is an off-chain index of the BTC delegations pruned
+// from the state. BTC delegations archived during block execution are only
+// persisted upon Commit.
+type BTCDelegationArchive interface {
+	ArchiveBTCDelegation(btcDel *BTCDelegation) error
+	Commit() error
+	GetArchivedBTCDelegation(stakingTxHash chainhash.Hash) (*BTCDelegation, error)
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/cosmos/cosmos-sdk/codec"
 )
 
@@ -28,6 +29,33 @@ func (gs GenesisState) Validate() error {
 			return err
 		}
 	}
+	for _, fp := range gs.FinalityProviders {
+		// finality providers exported before the max commission rates were
+		// introduced do not have them, and are imported with the default ones
+		fpWithDefaults := *fp
+		fpWithDefaults.SetDefaultMaxRates()
+		if err := fpWithDefaults.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid finality provider: %w", err)
+		}
+	}
+	if gs.CovenantRotation != nil {
+		if err := gs.CovenantRotation.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	for _, entry := range gs.PruneQueue {
+		if _, err := chainhash.NewHashFromStr(entry.StakingTxHashHex); err != nil {
+			return fmt.Errorf("invalid staking tx hash in prune queue: %w", err)
+		}
+	}
+	for _, entry := range gs.ConsumerEvents {
+		if entry.ConsumerId == "" {
+			return fmt.Errorf("empty consumer ID in consumer events")
+		}
+		if entry.Event == nil || entry.Event.Ev == nil {
+			return fmt.Errorf("empty event in consumer events of consumer %s", entry.ConsumerId)
+		}
+	}
 	return nil
 }
 
@@ -43,6 +43,19 @@ type GenesisState struct {
 	// vp_dst_cache is the table of all providers voting power with the total at one specific block.
 	// TODO: remove this after not storing in the keeper store it anymore.
 	VpDstCache []*VotingPowerDistCacheBlkHeight `protobuf:"bytes,8,rep,name=vp_dst_cache,json=vpDstCache,proto3" json:"vp_dst_cache,omitempty"`
+	// fp_key_rotations are the scheduled key rotations of finality providers.
+	FpKeyRotations []*FinalityProviderKeyRotation `protobuf:"bytes,9,rep,name=fp_key_rotations,json=fpKeyRotations,proto3" json:"fp_key_rotations,omitempty"`
+	// fp_key_aliases are the aliases of finality provider BTC PKs, i.e., the
+	// new BTC PKs of scheduled key rotations and the rotated BTC PKs.
+	FpKeyAliases []*FinalityProviderKeyAlias `protobuf:"bytes,10,rep,name=fp_key_aliases,json=fpKeyAliases,proto3" json:"fp_key_aliases,omitempty"`
+	// covenant_rotation is the scheduled rotation of the covenant committee, if
+	// any.
+	CovenantRotation *CovenantCommitteeRotation `protobuf:"bytes,11,opt,name=covenant_rotation,json=covenantRotation,proto3" json:"covenant_rotation,omitempty"`
+	// prune_queue is the queue of unbonded BTC delegations to be pruned.
+	PruneQueue []*BTCDelegationPruneEntry `protobuf:"bytes,12,rep,name=prune_queue,json=pruneQueue,proto3" json:"prune_queue,omitempty"`
+	// consumer_events are the BTC staking events pending to be sent to
+	// consumer chains, in the order they are to be sent.
+	ConsumerEvents []*ConsumerEventEntry `protobuf:"bytes,13,rep,name=consumer_events,json=consumerEvents,proto3" json:"consumer_events,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -134,6 +147,195 @@ func (m *GenesisState) GetVpDstCache() []*VotingPowerDistCacheBlkHeight {
 	return nil
 }
 
+func (m *GenesisState) GetFpKeyRotations() []*FinalityProviderKeyRotation {
+	if m != nil {
+		return m.FpKeyRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFpKeyAliases() []*FinalityProviderKeyAlias {
+	if m != nil {
+		return m.FpKeyAliases
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCovenantRotation() *CovenantCommitteeRotation {
+	if m != nil {
+		return m.CovenantRotation
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPruneQueue() []*BTCDelegationPruneEntry {
+	if m != nil {
+		return m.PruneQueue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsumerEvents() []*ConsumerEventEntry {
+	if m != nil {
+		return m.ConsumerEvents
+	}
+	return nil
+}
+
+// ConsumerEventEntry is a BTC staking event pending to be sent to a consumer
+// chain.
+type ConsumerEventEntry struct {
+	// consumer_id is the chain ID of the consumer chain.
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// event is the BTC staking event.
+	Event *BTCStakingConsumerEvent `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (m *ConsumerEventEntry) Reset()         { *m = ConsumerEventEntry{} }
+func (m *ConsumerEventEntry) String() string { return proto.CompactTextString(m) }
+func (*ConsumerEventEntry) ProtoMessage()    {}
+func (*ConsumerEventEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85d7b95fa5620238, []int{1}
+}
+func (m *ConsumerEventEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerEventEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerEventEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerEventEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerEventEntry.Merge(m, src)
+}
+func (m *ConsumerEventEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerEventEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerEventEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerEventEntry proto.InternalMessageInfo
+
+func (m *ConsumerEventEntry) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *ConsumerEventEntry) GetEvent() *BTCStakingConsumerEvent {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+// BTCDelegationPruneEntry is an unbonded BTC delegation scheduled to be
+// pruned at a BTC height.
+type BTCDelegationPruneEntry struct {
+	// btc_height is the BTC height from which the BTC delegation is pruned.
+	BtcHeight uint64 `protobuf:"varint,1,opt,name=btc_height,json=btcHeight,proto3" json:"btc_height,omitempty"`
+	// staking_tx_hash_hex is the hash of the staking tx of the BTC delegation.
+	StakingTxHashHex string `protobuf:"bytes,2,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+}
+
+func (m *BTCDelegationPruneEntry) Reset()         { *m = BTCDelegationPruneEntry{} }
+func (m *BTCDelegationPruneEntry) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegationPruneEntry) ProtoMessage()    {}
+func (*BTCDelegationPruneEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85d7b95fa5620238, []int{2}
+}
+func (m *BTCDelegationPruneEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCDelegationPruneEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegationPruneEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCDelegationPruneEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegationPruneEntry.Merge(m, src)
+}
+func (m *BTCDelegationPruneEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegationPruneEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegationPruneEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCDelegationPruneEntry proto.InternalMessageInfo
+
+func (m *BTCDelegationPruneEntry) GetBtcHeight() uint64 {
+	if m != nil {
+		return m.BtcHeight
+	}
+	return 0
+}
+
+func (m *BTCDelegationPruneEntry) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
+}
+
+// FinalityProviderKeyAlias maps a BTC PK to the BTC PK under which its
+// finality provider is stored.
+type FinalityProviderKeyAlias struct {
+	// btc_pk is the aliased BTC PK
+	BtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
+	// fp_btc_pk is the BTC PK under which the finality provider is stored
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+}
+
+func (m *FinalityProviderKeyAlias) Reset()         { *m = FinalityProviderKeyAlias{} }
+func (m *FinalityProviderKeyAlias) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderKeyAlias) ProtoMessage()    {}
+func (*FinalityProviderKeyAlias) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85d7b95fa5620238, []int{3}
+}
+func (m *FinalityProviderKeyAlias) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderKeyAlias) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderKeyAlias.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderKeyAlias) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderKeyAlias.Merge(m, src)
+}
+func (m *FinalityProviderKeyAlias) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderKeyAlias) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderKeyAlias.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderKeyAlias proto.InternalMessageInfo
+
 // VotingPowerFP contains the information about the voting power
 // of an finality provider in a specific block height.
 type VotingPowerFP struct {
@@ -149,7 +351,7 @@ func (m *VotingPowerFP) Reset()         { *m = VotingPowerFP{} }
 func (m *VotingPowerFP) String() string { return proto.CompactTextString(m) }
 func (*VotingPowerFP) ProtoMessage()    {}
 func (*VotingPowerFP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85d7b95fa5620238, []int{1}
+	return fileDescriptor_85d7b95fa5620238, []int{4}
 }
 func (m *VotingPowerFP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,7 +406,7 @@ func (m *VotingPowerDistCacheBlkHeight) Reset()         { *m = VotingPowerDistCa
 func (m *VotingPowerDistCacheBlkHeight) String() string { return proto.CompactTextString(m) }
 func (*VotingPowerDistCacheBlkHeight) ProtoMessage()    {}
 func (*VotingPowerDistCacheBlkHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85d7b95fa5620238, []int{2}
+	return fileDescriptor_85d7b95fa5620238, []int{5}
 }
 func (m *VotingPowerDistCacheBlkHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -259,7 +461,7 @@ func (m *BlockHeightBbnToBtc) Reset()         { *m = BlockHeightBbnToBtc{} }
 func (m *BlockHeightBbnToBtc) String() string { return proto.CompactTextString(m) }
 func (*BlockHeightBbnToBtc) ProtoMessage()    {}
 func (*BlockHeightBbnToBtc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85d7b95fa5620238, []int{3}
+	return fileDescriptor_85d7b95fa5620238, []int{6}
 }
 func (m *BlockHeightBbnToBtc) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -316,7 +518,7 @@ func (m *BTCDelegator) Reset()         { *m = BTCDelegator{} }
 func (m *BTCDelegator) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegator) ProtoMessage()    {}
 func (*BTCDelegator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85d7b95fa5620238, []int{4}
+	return fileDescriptor_85d7b95fa5620238, []int{7}
 }
 func (m *BTCDelegator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,7 +568,7 @@ func (m *EventIndex) Reset()         { *m = EventIndex{} }
 func (m *EventIndex) String() string { return proto.CompactTextString(m) }
 func (*EventIndex) ProtoMessage()    {}
 func (*EventIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85d7b95fa5620238, []int{5}
+	return fileDescriptor_85d7b95fa5620238, []int{8}
 }
 func (m *EventIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -418,6 +620,9 @@ func (m *EventIndex) GetEvent() *EventPowerDistUpdate {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.btcstaking.v1.GenesisState")
+	proto.RegisterType((*ConsumerEventEntry)(nil), "babylon.btcstaking.v1.ConsumerEventEntry")
+	proto.RegisterType((*BTCDelegationPruneEntry)(nil), "babylon.btcstaking.v1.BTCDelegationPruneEntry")
+	proto.RegisterType((*FinalityProviderKeyAlias)(nil), "babylon.btcstaking.v1.FinalityProviderKeyAlias")
 	proto.RegisterType((*VotingPowerFP)(nil), "babylon.btcstaking.v1.VotingPowerFP")
 	proto.RegisterType((*VotingPowerDistCacheBlkHeight)(nil), "babylon.btcstaking.v1.VotingPowerDistCacheBlkHeight")
 	proto.RegisterType((*BlockHeightBbnToBtc)(nil), "babylon.btcstaking.v1.BlockHeightBbnToBtc")
@@ -430,51 +635,67 @@ func init() {
 }
 
 var fileDescriptor_85d7b95fa5620238 = []byte{
-	// 694 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xdd, 0x4e, 0xd4, 0x4e,
-	0x18, 0xc6, 0x29, 0xbb, 0x2c, 0xfc, 0x67, 0x97, 0x05, 0x86, 0xbf, 0x49, 0x43, 0xc2, 0x0a, 0xc5,
-	0x8f, 0x8d, 0x26, 0x5d, 0x59, 0xd0, 0xc4, 0x43, 0xcb, 0x8a, 0xe2, 0x47, 0xd2, 0xd4, 0x95, 0x03,
-	0x4e, 0x9a, 0x4e, 0x3b, 0xdb, 0x9d, 0x6c, 0x99, 0x69, 0x3a, 0x43, 0x65, 0xaf, 0xc1, 0x13, 0x0f,
-	0xbd, 0x05, 0xef, 0xc4, 0x43, 0x0e, 0x8d, 0x07, 0xc6, 0xc0, 0x1d, 0x78, 0x01, 0xc6, 0x74, 0x5a,
-	0x68, 0xc1, 0x5d, 0xc0, 0x18, 0xcf, 0x3a, 0x93, 0xe7, 0xfd, 0xcd, 0xfb, 0xbc, 0xf3, 0x4c, 0x0a,
-	0xd6, 0x90, 0x83, 0x86, 0x01, 0xa3, 0x2d, 0x24, 0x5c, 0x2e, 0x9c, 0x01, 0xa1, 0x7e, 0x2b, 0x5e,
-	0x6f, 0xf9, 0x98, 0x62, 0x4e, 0xb8, 0x1e, 0x46, 0x4c, 0x30, 0x78, 0x23, 0x13, 0xe9, 0xb9, 0x48,
-	0x8f, 0xd7, 0x97, 0xfe, 0xf7, 0x99, 0xcf, 0xa4, 0xa2, 0x95, 0x7c, 0xa5, 0xe2, 0x25, 0x6d, 0x34,
-	0x31, 0x74, 0x22, 0x67, 0x3f, 0x03, 0x2e, 0xdd, 0x19, 0xad, 0x29, 0xe0, 0x53, 0xdd, 0xed, 0xd1,
-	0x3a, 0x42, 0x5d, 0x4c, 0x05, 0x89, 0xf1, 0xe5, 0x47, 0xe2, 0x18, 0x53, 0x91, 0x1d, 0xa9, 0xfd,
-	0x28, 0x83, 0xda, 0xb3, 0xd4, 0xd5, 0x1b, 0xe1, 0x08, 0x0c, 0x1f, 0x82, 0x4a, 0xda, 0x93, 0xaa,
-	0xac, 0x94, 0x9a, 0xd5, 0xf6, 0xb2, 0x3e, 0xd2, 0xa5, 0x6e, 0x4a, 0x91, 0x95, 0x89, 0xe1, 0x2e,
-	0x80, 0x3d, 0x42, 0x9d, 0x80, 0x88, 0xa1, 0x1d, 0x46, 0x2c, 0x26, 0x1e, 0x8e, 0xb8, 0x3a, 0x29,
-	0x11, 0x77, 0xc7, 0x20, 0xb6, 0xb3, 0x02, 0x33, 0xd3, 0x5b, 0x0b, 0xbd, 0x0b, 0x3b, 0x1c, 0xbe,
-	0x06, 0x73, 0x48, 0xb8, 0xb6, 0x87, 0x03, 0xec, 0x3b, 0x82, 0x30, 0xca, 0xd5, 0x92, 0x84, 0xde,
-	0x1a, 0x03, 0x35, 0xba, 0x5b, 0x9d, 0x33, 0xb1, 0x55, 0x47, 0xc2, 0xcd, 0x97, 0x1c, 0xee, 0x80,
-	0xd9, 0x98, 0x09, 0x42, 0x7d, 0x3b, 0x64, 0xef, 0x92, 0x0e, 0xcb, 0x97, 0xc2, 0x76, 0xa5, 0xd6,
-	0x4c, 0xa4, 0xdb, 0xa6, 0x55, 0x8b, 0xf3, 0x25, 0x87, 0x7b, 0x60, 0x11, 0x05, 0xcc, 0x1d, 0xd8,
-	0x7d, 0x4c, 0xfc, 0xbe, 0xb0, 0xdd, 0xbe, 0x43, 0x28, 0x57, 0xa7, 0x24, 0xf0, 0xde, 0xb8, 0xee,
-	0x92, 0x8a, 0xe7, 0xb2, 0xc0, 0x40, 0xb4, 0xcb, 0x0c, 0xe1, 0x5a, 0x0b, 0x28, 0xdf, 0xdc, 0x92,
-	0x10, 0xf8, 0x02, 0xd4, 0x0b, 0xae, 0x59, 0xc4, 0xd5, 0x8a, 0xc4, 0xae, 0x5d, 0x69, 0x9a, 0x45,
-	0xd6, 0x6c, 0xee, 0x99, 0x45, 0x1c, 0x3e, 0x06, 0x95, 0xf4, 0xc6, 0xd5, 0x69, 0xc9, 0x58, 0x1d,
-	0xc3, 0x78, 0x9a, 0x88, 0x76, 0xa8, 0x87, 0x0f, 0xad, 0xac, 0x00, 0xee, 0x82, 0x5a, 0x1c, 0xda,
-	0x1e, 0x17, 0xb6, 0xeb, 0xb8, 0x7d, 0xac, 0xce, 0x48, 0xc0, 0xe6, 0xd5, 0xc3, 0xea, 0x10, 0x2e,
-	0xb6, 0x92, 0x12, 0x23, 0xc8, 0x8c, 0x59, 0x20, 0x0e, 0x3b, 0xd9, 0xa6, 0xf6, 0x49, 0x01, 0xb3,
-	0xe7, 0x46, 0x0b, 0x57, 0x41, 0xad, 0x38, 0x4c, 0x55, 0x59, 0x51, 0x9a, 0x65, 0xab, 0x5a, 0x98,
-	0x0c, 0xb4, 0xc0, 0x7f, 0xbd, 0xd0, 0x4e, 0xc6, 0x12, 0x0e, 0xd4, 0xc9, 0x15, 0xa5, 0x59, 0x33,
-	0x1e, 0x7d, 0xfd, 0x76, 0xb3, 0xed, 0x13, 0xd1, 0x3f, 0x40, 0xba, 0xcb, 0xf6, 0x5b, 0x59, 0x5f,
-	0xf2, 0x26, 0x4e, 0x17, 0x2d, 0x31, 0x0c, 0x31, 0xd7, 0x8d, 0x1d, 0x73, 0x63, 0xf3, 0x81, 0x79,
-	0x80, 0x5e, 0xe2, 0xa1, 0x35, 0xdd, 0x0b, 0x0d, 0xe1, 0x9a, 0x83, 0xe4, 0xd8, 0x62, 0x1c, 0xd4,
-	0x52, 0x7a, 0x6c, 0xe1, 0x9e, 0xb5, 0x8f, 0x0a, 0x58, 0xbe, 0xd4, 0xd9, 0x75, 0x7a, 0xef, 0x82,
-	0xb9, 0x64, 0x90, 0x84, 0x8b, 0x88, 0xa0, 0x83, 0x24, 0x8a, 0xd2, 0x41, 0xb5, 0x7d, 0xff, 0x0f,
-	0x66, 0x69, 0xd5, 0xe3, 0xb0, 0x53, 0x40, 0x68, 0x04, 0x2c, 0x8e, 0xc8, 0x13, 0x6c, 0x82, 0xf9,
-	0x73, 0xc1, 0x44, 0x88, 0x66, 0x3d, 0xd5, 0xd1, 0x39, 0xf9, 0xef, 0x4a, 0xe1, 0xca, 0xbe, 0x2e,
-	0x28, 0x85, 0xab, 0xfd, 0x54, 0x40, 0xad, 0x18, 0x32, 0xd8, 0x01, 0x25, 0xe2, 0x1d, 0x4a, 0x6e,
-	0xb5, 0xdd, 0xbe, 0x46, 0x2c, 0xf3, 0x57, 0x98, 0x66, 0x2c, 0x29, 0xff, 0x27, 0x77, 0xda, 0x05,
-	0xc0, 0xc3, 0xc1, 0x29, 0xb4, 0xf4, 0x57, 0xd0, 0x19, 0x0f, 0x07, 0x92, 0xaa, 0xbd, 0x57, 0x00,
-	0xc8, 0x5f, 0x08, 0x9c, 0xcf, 0xed, 0x97, 0x53, 0x2b, 0xd7, 0x9e, 0x25, 0x7c, 0x02, 0xa6, 0xe4,
-	0xfb, 0x92, 0xbd, 0x8d, 0x8f, 0x80, 0x3c, 0xed, 0x2c, 0x01, 0x6f, 0x43, 0xcf, 0x11, 0xd8, 0x4a,
-	0x2b, 0x8d, 0x57, 0x9f, 0x8f, 0x1b, 0xca, 0xd1, 0x71, 0x43, 0xf9, 0x7e, 0xdc, 0x50, 0x3e, 0x9c,
-	0x34, 0x26, 0x8e, 0x4e, 0x1a, 0x13, 0x5f, 0x4e, 0x1a, 0x13, 0x7b, 0x57, 0xba, 0x3c, 0x2c, 0xfe,
-	0x0d, 0xa4, 0x65, 0x54, 0x91, 0xbf, 0x82, 0x8d, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x75, 0xe9,
-	0x80, 0x92, 0xf5, 0x06, 0x00, 0x00,
+	// 949 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x71, 0x92, 0xc6, 0xc7, 0x8e, 0xe3, 0x4c, 0x40, 0xac, 0x22, 0xc5, 0x4d, 0x5d,
+	0x3e, 0x02, 0x08, 0xbb, 0x4d, 0x0b, 0x12, 0x97, 0xb5, 0xdd, 0x92, 0x00, 0x15, 0x66, 0xea, 0xe6,
+	0xa2, 0x02, 0xad, 0xf6, 0x63, 0xec, 0x1d, 0xd9, 0x9e, 0x59, 0x76, 0xc6, 0x8b, 0x2d, 0x1e, 0x81,
+	0x1b, 0x2e, 0x79, 0x05, 0xc4, 0x33, 0x70, 0xcf, 0x65, 0x2f, 0x11, 0x17, 0x08, 0x25, 0xef, 0x81,
+	0xd0, 0xce, 0x8e, 0xed, 0x0d, 0xf1, 0x3a, 0x46, 0x55, 0xef, 0x76, 0xc6, 0xff, 0xf3, 0x3b, 0xe7,
+	0xec, 0x9c, 0xf9, 0x7b, 0xe1, 0xae, 0x63, 0x3b, 0x93, 0x01, 0x67, 0x75, 0x47, 0xba, 0x42, 0xda,
+	0x7d, 0xca, 0x7a, 0xf5, 0xe8, 0x7e, 0xbd, 0x47, 0x18, 0x11, 0x54, 0xd4, 0x82, 0x90, 0x4b, 0x8e,
+	0xde, 0xd4, 0xa2, 0xda, 0x5c, 0x54, 0x8b, 0xee, 0x1f, 0xbc, 0xd1, 0xe3, 0x3d, 0xae, 0x14, 0xf5,
+	0xf8, 0x29, 0x11, 0x1f, 0x54, 0x17, 0x13, 0x03, 0x3b, 0xb4, 0x87, 0x1a, 0x78, 0xf0, 0xee, 0x62,
+	0x4d, 0x0a, 0x9f, 0xe8, 0xde, 0x59, 0xac, 0xa3, 0xcc, 0x25, 0x4c, 0xd2, 0x88, 0x2c, 0x4f, 0x49,
+	0x22, 0xc2, 0xa4, 0x4e, 0x59, 0xfd, 0x75, 0x1b, 0x8a, 0x9f, 0x25, 0x5d, 0x3d, 0x93, 0xb6, 0x24,
+	0xe8, 0x63, 0xd8, 0x4a, 0x6a, 0x32, 0x8d, 0xa3, 0xdc, 0x71, 0xe1, 0xe4, 0xb0, 0xb6, 0xb0, 0xcb,
+	0x5a, 0x5b, 0x89, 0xb0, 0x16, 0xa3, 0x73, 0x40, 0x5d, 0xca, 0xec, 0x01, 0x95, 0x13, 0x2b, 0x08,
+	0x79, 0x44, 0x3d, 0x12, 0x0a, 0x73, 0x5d, 0x21, 0xde, 0xcb, 0x40, 0x3c, 0xd1, 0x01, 0x6d, 0xad,
+	0xc7, 0x7b, 0xdd, 0xff, 0xec, 0x08, 0xf4, 0x14, 0x76, 0x1d, 0xe9, 0x5a, 0x1e, 0x19, 0x90, 0x9e,
+	0x2d, 0x29, 0x67, 0xc2, 0xcc, 0x29, 0xe8, 0xdb, 0x19, 0xd0, 0x46, 0xa7, 0xd9, 0x9a, 0x89, 0x71,
+	0xc9, 0x91, 0xee, 0x7c, 0x29, 0xd0, 0x19, 0xec, 0x44, 0x5c, 0x52, 0xd6, 0xb3, 0x02, 0xfe, 0x7d,
+	0x5c, 0xe1, 0xc6, 0x52, 0xd8, 0xb9, 0xd2, 0xb6, 0x63, 0xe9, 0x93, 0x36, 0x2e, 0x46, 0xf3, 0xa5,
+	0x40, 0x2f, 0x60, 0xdf, 0x19, 0x70, 0xb7, 0x6f, 0xf9, 0x84, 0xf6, 0x7c, 0x69, 0xb9, 0xbe, 0x4d,
+	0x99, 0x30, 0x37, 0x15, 0xf0, 0x83, 0xac, 0xea, 0xe2, 0x88, 0x53, 0x15, 0xd0, 0x70, 0x58, 0x87,
+	0x37, 0xa4, 0x8b, 0xf7, 0x9c, 0xf9, 0x66, 0x53, 0x41, 0xd0, 0xe7, 0x50, 0x4a, 0x75, 0xcd, 0x43,
+	0x61, 0x6e, 0x29, 0xec, 0xdd, 0x1b, 0x9b, 0xe6, 0x21, 0xde, 0x99, 0xf7, 0xcc, 0x43, 0x81, 0x3e,
+	0x85, 0xad, 0xe4, 0xc4, 0xcd, 0x5b, 0x8a, 0x71, 0x27, 0x83, 0xf1, 0x38, 0x16, 0x9d, 0x31, 0x8f,
+	0x8c, 0xb1, 0x0e, 0x40, 0xe7, 0x50, 0x8c, 0x02, 0xcb, 0x13, 0xd2, 0x72, 0x6d, 0xd7, 0x27, 0xe6,
+	0xb6, 0x02, 0x3c, 0xbc, 0xf9, 0x65, 0xb5, 0xa8, 0x90, 0xcd, 0x38, 0xa4, 0x31, 0xd0, 0x8d, 0x61,
+	0x88, 0x82, 0x96, 0xde, 0x44, 0xdf, 0x40, 0xb9, 0x1b, 0x58, 0x7d, 0x32, 0xb1, 0x42, 0x2e, 0xf5,
+	0xa9, 0xe6, 0x15, 0xfb, 0x64, 0xc5, 0x51, 0xf9, 0x82, 0x4c, 0xb0, 0x0e, 0xc5, 0xa5, 0x6e, 0x90,
+	0x5a, 0x0a, 0xf4, 0x1c, 0x4a, 0x9a, 0x6e, 0x0f, 0xa8, 0x2d, 0x88, 0x30, 0x41, 0xb1, 0xeb, 0xab,
+	0xb3, 0x1f, 0xc5, 0x81, 0xb8, 0xd8, 0x0d, 0xa6, 0xcf, 0x44, 0xa0, 0x6f, 0x61, 0xcf, 0xe5, 0x11,
+	0x61, 0x36, 0x93, 0xb3, 0xb2, 0xcd, 0xc2, 0x91, 0x71, 0x5c, 0x38, 0xb9, 0x97, 0x41, 0x6e, 0x6a,
+	0x7d, 0x93, 0x0f, 0x87, 0x54, 0x4a, 0x42, 0x66, 0x35, 0x97, 0xa7, 0xa8, 0xe9, 0x0e, 0xfa, 0x0a,
+	0x0a, 0x41, 0x38, 0x62, 0xc4, 0xfa, 0x6e, 0x44, 0x46, 0xc4, 0x2c, 0xaa, 0x92, 0x6b, 0xab, 0x0c,
+	0x79, 0x3b, 0x0e, 0x7b, 0xcc, 0x64, 0x38, 0xc1, 0xa0, 0x10, 0x5f, 0xc7, 0x04, 0x84, 0x61, 0xd7,
+	0xe5, 0x4c, 0x8c, 0x86, 0x24, 0xb4, 0xf4, 0x00, 0xec, 0x28, 0xe8, 0xfb, 0x99, 0xd5, 0x26, 0x6a,
+	0x35, 0x08, 0x09, 0xaf, 0xe4, 0xa6, 0xf7, 0x44, 0xf5, 0x07, 0x40, 0xd7, 0x55, 0xe8, 0x36, 0x14,
+	0x66, 0x99, 0xa8, 0x67, 0x1a, 0x47, 0xc6, 0x71, 0x1e, 0xc3, 0x74, 0xeb, 0xcc, 0x43, 0x2d, 0xd8,
+	0x54, 0x15, 0x98, 0xeb, 0x47, 0xc6, 0xf2, 0xae, 0x9e, 0x25, 0xab, 0x2b, 0x49, 0x70, 0x12, 0x5c,
+	0xed, 0xc1, 0x5b, 0x19, 0x7d, 0xa3, 0x43, 0x80, 0xf8, 0xbe, 0x24, 0x37, 0x51, 0x15, 0xb0, 0x81,
+	0xf3, 0x8e, 0x74, 0x93, 0xd9, 0x43, 0x1f, 0xc1, 0xbe, 0x4e, 0x63, 0xc9, 0xb1, 0xe5, 0xdb, 0xc2,
+	0xb7, 0x7c, 0x32, 0x56, 0xd5, 0xe4, 0x71, 0x59, 0xff, 0xd4, 0x19, 0x9f, 0xda, 0xc2, 0x3f, 0x25,
+	0xe3, 0xea, 0x6f, 0x06, 0x98, 0x59, 0x43, 0x81, 0x9e, 0xc2, 0x56, 0x9c, 0x2a, 0xe8, 0xab, 0x34,
+	0xc5, 0xc6, 0x27, 0x7f, 0xfe, 0x75, 0xfb, 0xa4, 0x47, 0xa5, 0x3f, 0x72, 0x6a, 0x2e, 0x1f, 0xd6,
+	0x75, 0x6b, 0xca, 0x0d, 0xa6, 0x8b, 0xba, 0x9c, 0x04, 0x44, 0xd4, 0x1a, 0x67, 0xed, 0x07, 0x0f,
+	0xef, 0xb5, 0x47, 0x4e, 0x3c, 0xae, 0x9b, 0x8e, 0x74, 0xdb, 0x7d, 0x84, 0x21, 0xdf, 0x0d, 0x2c,
+	0x4d, 0x5c, 0x7f, 0x25, 0xe2, 0xad, 0x6e, 0xd0, 0x88, 0x99, 0xd5, 0x5f, 0x0c, 0xd8, 0xb9, 0xe2,
+	0x5c, 0xe8, 0x0e, 0x14, 0xd3, 0x5e, 0xa5, 0xdf, 0x50, 0x21, 0x65, 0x3c, 0xaf, 0xa3, 0x90, 0x38,
+	0x6d, 0xda, 0x6d, 0xcd, 0x5c, 0x92, 0x36, 0x65, 0xa3, 0xd5, 0x9f, 0x0d, 0x38, 0x5c, 0x6a, 0x1c,
+	0xab, 0xd4, 0xde, 0x81, 0xdd, 0xd8, 0xa7, 0xa8, 0x90, 0x21, 0x75, 0x46, 0xea, 0x62, 0x26, 0x93,
+	0xf6, 0xe1, 0xff, 0xb0, 0x2a, 0x5c, 0x8a, 0x82, 0x56, 0x0a, 0x51, 0xa5, 0xb0, 0xbf, 0xc0, 0xae,
+	0xd1, 0x31, 0x94, 0xaf, 0xf8, 0xbe, 0xe3, 0x30, 0x5d, 0x53, 0xc9, 0xb9, 0x22, 0xbf, 0xae, 0x94,
+	0xae, 0xb9, 0x7e, 0x5d, 0x29, 0xdd, 0xea, 0x3f, 0x06, 0x14, 0xd3, 0x1e, 0x8e, 0x5a, 0x90, 0xa3,
+	0xde, 0x58, 0x71, 0xb3, 0x4d, 0x31, 0x1d, 0x31, 0xbf, 0x16, 0x89, 0x85, 0xc7, 0xe1, 0xaf, 0xe5,
+	0x4c, 0x3b, 0x00, 0x1e, 0x19, 0x4c, 0xa1, 0xb9, 0x57, 0x82, 0x6e, 0x7b, 0x64, 0x90, 0x8c, 0xec,
+	0x8f, 0x06, 0xc0, 0xfc, 0x0f, 0x08, 0x95, 0xe7, 0xed, 0x6f, 0x24, 0xad, 0xac, 0xfc, 0x2e, 0xd1,
+	0xa3, 0xa9, 0xd9, 0xe4, 0x96, 0x8e, 0x80, 0xca, 0x36, 0x9b, 0x80, 0xe7, 0x81, 0x67, 0x4b, 0xa2,
+	0x9d, 0xa6, 0xf1, 0xe5, 0xef, 0x17, 0x15, 0xe3, 0xe5, 0x45, 0xc5, 0xf8, 0xfb, 0xa2, 0x62, 0xfc,
+	0x74, 0x59, 0x59, 0x7b, 0x79, 0x59, 0x59, 0xfb, 0xe3, 0xb2, 0xb2, 0xf6, 0xe2, 0xc6, 0x2e, 0xc7,
+	0xe9, 0x8f, 0x2d, 0xd5, 0xb2, 0xb3, 0xa5, 0xbe, 0xb4, 0x1e, 0xfc, 0x3b, 0x00, 0xcd, 0x02, 0x29,
+	0xb1, 0x54, 0x0a, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -497,6 +718,74 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConsumerEvents) > 0 {
+		for iNdEx := len(m.ConsumerEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PruneQueue) > 0 {
+		for iNdEx := len(m.PruneQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PruneQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.CovenantRotation != nil {
+		{
+			size, err := m.CovenantRotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.FpKeyAliases) > 0 {
+		for iNdEx := len(m.FpKeyAliases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FpKeyAliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.FpKeyRotations) > 0 {
+		for iNdEx := len(m.FpKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FpKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.VpDstCache) > 0 {
 		for iNdEx := len(m.VpDstCache) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -612,7 +901,7 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *VotingPowerFP) Marshal() (dAtA []byte, err error) {
+func (m *ConsumerEventEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -622,42 +911,39 @@ func (m *VotingPowerFP) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VotingPowerFP) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsumerEventEntry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VotingPowerFP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsumerEventEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.VotingPower != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.VotingPower))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.FpBtcPk != nil {
+	if m.Event != nil {
 		{
-			size := m.FpBtcPk.Size()
-			i -= size
-			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConsumerId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *VotingPowerDistCacheBlkHeight) Marshal() (dAtA []byte, err error) {
+func (m *BTCDelegationPruneEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -667,37 +953,32 @@ func (m *VotingPowerDistCacheBlkHeight) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VotingPowerDistCacheBlkHeight) MarshalTo(dAtA []byte) (int, error) {
+func (m *BTCDelegationPruneEntry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VotingPowerDistCacheBlkHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BTCDelegationPruneEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.VpDistribution != nil {
-		{
-			size, err := m.VpDistribution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StakingTxHashHex)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+	if m.BtcHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BtcHeight))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockHeightBbnToBtc) Marshal() (dAtA []byte, err error) {
+func (m *FinalityProviderKeyAlias) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -707,30 +988,44 @@ func (m *BlockHeightBbnToBtc) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockHeightBbnToBtc) MarshalTo(dAtA []byte) (int, error) {
+func (m *FinalityProviderKeyAlias) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockHeightBbnToBtc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FinalityProviderKeyAlias) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeightBtc != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeightBtc))
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.BlockHeightBbn != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeightBbn))
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BTCDelegator) Marshal() (dAtA []byte, err error) {
+func (m *VotingPowerFP) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -740,27 +1035,20 @@ func (m *BTCDelegator) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BTCDelegator) MarshalTo(dAtA []byte) (int, error) {
+func (m *VotingPowerFP) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BTCDelegator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VotingPowerFP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DelBtcPk != nil {
-		{
-			size := m.DelBtcPk.Size()
-			i -= size
-			if _, err := m.DelBtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
+	if m.VotingPower != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VotingPower))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
 	if m.FpBtcPk != nil {
 		{
@@ -774,16 +1062,141 @@ func (m *BTCDelegator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Idx != nil {
-		{
-			size, err := m.Idx.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingPowerDistCacheBlkHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingPowerDistCacheBlkHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingPowerDistCacheBlkHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VpDistribution != nil {
+		{
+			size, err := m.VpDistribution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockHeightBbnToBtc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockHeightBbnToBtc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockHeightBbnToBtc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeightBtc != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeightBtc))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeightBbn != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeightBbn))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelBtcPk != nil {
+		{
+			size := m.DelBtcPk.Size()
+			i -= size
+			if _, err := m.DelBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Idx != nil {
+		{
+			size, err := m.Idx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
@@ -899,6 +1312,84 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FpKeyRotations) > 0 {
+		for _, e := range m.FpKeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FpKeyAliases) > 0 {
+		for _, e := range m.FpKeyAliases {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CovenantRotation != nil {
+		l = m.CovenantRotation.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PruneQueue) > 0 {
+		for _, e := range m.PruneQueue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsumerEvents) > 0 {
+		for _, e := range m.ConsumerEvents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerEventEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCDelegationPruneEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BtcHeight))
+	}
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *FinalityProviderKeyAlias) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -1299,6 +1790,517 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpKeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpKeyRotations = append(m.FpKeyRotations, &FinalityProviderKeyRotation{})
+			if err := m.FpKeyRotations[len(m.FpKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpKeyAliases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpKeyAliases = append(m.FpKeyAliases, &FinalityProviderKeyAlias{})
+			if err := m.FpKeyAliases[len(m.FpKeyAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantRotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CovenantRotation == nil {
+				m.CovenantRotation = &CovenantCommitteeRotation{}
+			}
+			if err := m.CovenantRotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PruneQueue = append(m.PruneQueue, &BTCDelegationPruneEntry{})
+			if err := m.PruneQueue[len(m.PruneQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerEvents = append(m.ConsumerEvents, &ConsumerEventEntry{})
+			if err := m.ConsumerEvents[len(m.ConsumerEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerEventEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerEventEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerEventEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &BTCStakingConsumerEvent{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCDelegationPruneEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCDelegationPruneEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCDelegationPruneEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeight", wireType)
+			}
+			m.BtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProviderKeyAlias) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderKeyAlias: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderKeyAlias: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
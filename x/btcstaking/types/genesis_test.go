@@ -1,15 +1,31 @@
 package types_test
 
 import (
+	"math/rand"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/babylonchain/babylon/testutil/datagen"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	fp, err := datagen.GenRandomFinalityProvider(r)
+	require.NoError(t, err)
+	// a finality provider exported before the max commission rates were
+	// introduced
+	preUpgradeFp := *fp
+	preUpgradeFp.MaxRate = nil
+	preUpgradeFp.MaxChangeRate = nil
+	// a finality provider whose commission rate exceeds the default max
+	// commission rate
+	fpWithInvalidCommission := preUpgradeFp
+	invalidCommission := sdkmath.LegacyMustNewDecFromStr("1.1")
+	fpWithInvalidCommission.Commission = &invalidCommission
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -68,6 +84,30 @@ func TestGenesisState_Validate(t *testing.T) {
 				}},
 			valid: false,
 		},
+		{
+			desc: "valid finality provider in genesis",
+			genState: &types.GenesisState{
+				Params:            types.DefaultGenesis().Params,
+				FinalityProviders: []*types.FinalityProvider{fp},
+			},
+			valid: true,
+		},
+		{
+			desc: "pre-upgrade finality provider without max rates in genesis",
+			genState: &types.GenesisState{
+				Params:            types.DefaultGenesis().Params,
+				FinalityProviders: []*types.FinalityProvider{&preUpgradeFp},
+			},
+			valid: true,
+		},
+		{
+			desc: "pre-upgrade finality provider with commission above the default max rate in genesis",
+			genState: &types.GenesisState{
+				Params:            types.DefaultGenesis().Params,
+				FinalityProviders: []*types.FinalityProvider{&fpWithInvalidCommission},
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -24,3 +24,13 @@ func (h MultiBtcStakingHooks) AfterFinalityProviderActivated(ctx context.Context
 
 	return nil
 }
+
+func (h MultiBtcStakingHooks) AfterFinalityProviderKeyRotated(ctx context.Context, oldBtcPk *types.BIP340PubKey, newBtcPk *types.BIP340PubKey) error {
+	for i := range h {
+		if err := h[i].AfterFinalityProviderKeyRotated(ctx, oldBtcPk, newBtcPk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
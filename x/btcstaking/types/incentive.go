@@ -49,8 +49,18 @@ func (dc *VotingPowerDistCache) ApplyActiveFinalityProviders(maxActiveFPs uint32
 	}
 }
 
+// GetNumActiveFPs returns the number of active finality providers, i.e., the
+// top N unjailed finality providers. It assumes the finality providers are
+// sorted, such that the jailed ones are placed after all unjailed ones
 func (dc *VotingPowerDistCache) GetNumActiveFPs(maxActiveFPs uint32) uint32 {
-	return min(maxActiveFPs, uint32(len(dc.FinalityProviders)))
+	numUnjailedFPs := uint32(0)
+	for _, fp := range dc.FinalityProviders {
+		if fp.IsJailed {
+			break
+		}
+		numUnjailedFPs++
+	}
+	return min(maxActiveFPs, numUnjailedFPs)
 }
 
 // GetActiveFinalityProviderSet returns a set of active finality providers
@@ -100,6 +110,7 @@ func NewFinalityProviderDistInfo(fp *FinalityProvider) *FinalityProviderDistInfo
 		Commission:       fp.Commission,
 		TotalVotingPower: 0,
 		BtcDels:          []*BTCDelDistInfo{},
+		IsJailed:         fp.IsJailed(),
 	}
 }
 
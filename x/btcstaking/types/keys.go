@@ -1,5 +1,11 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonchain/babylon/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "btcstaking"
@@ -15,12 +21,30 @@ const (
 )
 
 var (
-	ParamsKey               = []byte{0x01} // key prefix for the parameters
-	FinalityProviderKey     = []byte{0x02} // key prefix for the finality providers
-	BTCDelegatorKey         = []byte{0x03} // key prefix for the BTC delegators
-	BTCDelegationKey        = []byte{0x04} // key prefix for the BTC delegations
-	VotingPowerKey          = []byte{0x05} // key prefix for the voting power
-	BTCHeightKey            = []byte{0x06} // key prefix for the BTC heights
-	VotingPowerDistCacheKey = []byte{0x07} // key prefix for voting power distribution cache
-	PowerDistUpdateKey      = []byte{0x08} // key prefix for power distribution update events
+	ParamsKey                  = []byte{0x01} // key prefix for the parameters
+	FinalityProviderKey        = []byte{0x02} // key prefix for the finality providers
+	BTCDelegatorKey            = []byte{0x03} // key prefix for the BTC delegators
+	BTCDelegationKey           = []byte{0x04} // key prefix for the BTC delegations
+	VotingPowerKey             = []byte{0x05} // key prefix for the voting power
+	BTCHeightKey               = []byte{0x06} // key prefix for the BTC heights
+	VotingPowerDistCacheKey    = []byte{0x07} // key prefix for voting power distribution cache
+	PowerDistUpdateKey         = []byte{0x08} // key prefix for power distribution update events
+	FpKeyRotationKey           = []byte{0x09} // key prefix for the scheduled finality provider key rotations
+	FpKeyAliasKey              = []byte{0x0a} // key prefix for the finality provider BTC PK aliases
+	CovenantRotationKey        = []byte{0x0b} // key for the scheduled covenant committee rotation
+	BTCDelegationPruneKey      = []byte{0x0c} // key prefix for the queue of unbonded BTC delegations to be pruned
+	BTCDelegationStatusKey     = []byte{0x0d} // key prefix for the BTC delegation status index
+	DelegatorDelegationKey     = []byte{0x0e} // key prefix for the index of BTC delegations by delegator
+	ConsumerEventKey           = []byte{0x0f} // key prefix for the queues of BTC staking events pending to be sent to consumer chains
+	CovenantDelegationKey      = []byte{0x10} // key prefix for the index of BTC delegations by covenant member
+	StakeSpendingDelegationKey = []byte{0x11} // key prefix for the index of BTC delegations awaiting inclusion proofs by the BTC delegations they spend
+	FpKeyRotationHeightKey     = []byte{0x12} // key prefix for the index of the heights of scheduled finality provider key rotations
 )
+
+// GetVotingPowerKey returns the key of the finality provider's voting power
+// at the given Babylon height in the btcstaking store
+func GetVotingPowerKey(height uint64, fpBTCPK *bbn.BIP340PubKey) []byte {
+	key := append([]byte{}, VotingPowerKey...)
+	key = append(key, sdk.Uint64ToBigEndian(height)...)
+	return append(key, fpBTCPK.MustMarshal()...)
+}
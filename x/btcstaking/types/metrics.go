@@ -7,11 +7,15 @@ import (
 
 // performance oriented metrics measuring the execution time of each message
 const (
-	MetricsKeyCreateFinalityProvider    = "create_finality_provider"
-	MetricsKeyCreateBTCDelegation       = "create_btc_delegation"
-	MetricsKeyAddCovenantSigs           = "add_covenant_sigs"
-	MetricsKeyBTCUndelegate             = "btc_undelegate"
-	MetricsKeySelectiveSlashingEvidence = "selective_slashing_evidence"
+	MetricsKeyCreateFinalityProvider         = "create_finality_provider"
+	MetricsKeyCreateBTCDelegation            = "create_btc_delegation"
+	MetricsKeyExpandBTCDelegation            = "expand_btc_delegation"
+	MetricsKeyBTCRedelegate                  = "btc_redelegate"
+	MetricsKeyAddBTCDelegationInclusionProof = "add_btc_delegation_inclusion_proof"
+	MetricsKeyRotateFinalityProviderKey      = "rotate_finality_provider_key"
+	MetricsKeyAddCovenantSigs                = "add_covenant_sigs"
+	MetricsKeyBTCUndelegate                  = "btc_undelegate"
+	MetricsKeySelectiveSlashingEvidence      = "selective_slashing_evidence"
 )
 
 // Metrics for monitoring finality providers and BTC delegations
@@ -13,6 +13,9 @@ import (
 	types0 "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	types1 "github.com/babylonchain/babylon/x/btclightclient/types"
 	types2 "github.com/babylonchain/babylon/x/epoching/types"
+	types3 "github.com/babylonchain/babylon/x/incentive/types"
+	chainhash "github.com/btcsuite/btcd/chaincfg/chainhash"
+	types4 "github.com/cosmos/cosmos-sdk/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -183,6 +186,94 @@ func (mr *MockCheckpointingKeeperMockRecorder) GetLastFinalizedEpoch(ctx interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastFinalizedEpoch", reflect.TypeOf((*MockCheckpointingKeeper)(nil).GetLastFinalizedEpoch), ctx)
 }
 
+// MockIncentiveKeeper is a mock of IncentiveKeeper interface.
+type MockIncentiveKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockIncentiveKeeperMockRecorder
+}
+
+// MockIncentiveKeeperMockRecorder is the mock recorder for MockIncentiveKeeper.
+type MockIncentiveKeeperMockRecorder struct {
+	mock *MockIncentiveKeeper
+}
+
+// NewMockIncentiveKeeper creates a new mock instance.
+func NewMockIncentiveKeeper(ctrl *gomock.Controller) *MockIncentiveKeeper {
+	mock := &MockIncentiveKeeper{ctrl: ctrl}
+	mock.recorder = &MockIncentiveKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIncentiveKeeper) EXPECT() *MockIncentiveKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetRewardGauge mocks base method.
+func (m *MockIncentiveKeeper) GetRewardGauge(ctx context.Context, sType types3.StakeholderType, addr types4.AccAddress) *types3.RewardGauge {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRewardGauge", ctx, sType, addr)
+	ret0, _ := ret[0].(*types3.RewardGauge)
+	return ret0
+}
+
+// GetRewardGauge indicates an expected call of GetRewardGauge.
+func (mr *MockIncentiveKeeperMockRecorder) GetRewardGauge(ctx, sType, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardGauge", reflect.TypeOf((*MockIncentiveKeeper)(nil).GetRewardGauge), ctx, sType, addr)
+}
+
+// MockBTCStkConsumerKeeper is a mock of BTCStkConsumerKeeper interface.
+type MockBTCStkConsumerKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBTCStkConsumerKeeperMockRecorder
+}
+
+// MockBTCStkConsumerKeeperMockRecorder is the mock recorder for MockBTCStkConsumerKeeper.
+type MockBTCStkConsumerKeeperMockRecorder struct {
+	mock *MockBTCStkConsumerKeeper
+}
+
+// NewMockBTCStkConsumerKeeper creates a new mock instance.
+func NewMockBTCStkConsumerKeeper(ctrl *gomock.Controller) *MockBTCStkConsumerKeeper {
+	mock := &MockBTCStkConsumerKeeper{ctrl: ctrl}
+	mock.recorder = &MockBTCStkConsumerKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBTCStkConsumerKeeper) EXPECT() *MockBTCStkConsumerKeeperMockRecorder {
+	return m.recorder
+}
+
+// AddConsumerFinalityProvider mocks base method.
+func (m *MockBTCStkConsumerKeeper) AddConsumerFinalityProvider(ctx context.Context, chainID string, fpBTCPK *types.BIP340PubKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddConsumerFinalityProvider", ctx, chainID, fpBTCPK)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddConsumerFinalityProvider indicates an expected call of AddConsumerFinalityProvider.
+func (mr *MockBTCStkConsumerKeeperMockRecorder) AddConsumerFinalityProvider(ctx, chainID, fpBTCPK interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddConsumerFinalityProvider", reflect.TypeOf((*MockBTCStkConsumerKeeper)(nil).AddConsumerFinalityProvider), ctx, chainID, fpBTCPK)
+}
+
+// IsConsumerRegistered mocks base method.
+func (m *MockBTCStkConsumerKeeper) IsConsumerRegistered(ctx context.Context, chainID string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsConsumerRegistered", ctx, chainID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsConsumerRegistered indicates an expected call of IsConsumerRegistered.
+func (mr *MockBTCStkConsumerKeeperMockRecorder) IsConsumerRegistered(ctx, chainID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsConsumerRegistered", reflect.TypeOf((*MockBTCStkConsumerKeeper)(nil).IsConsumerRegistered), ctx, chainID)
+}
+
 // MockBtcStakingHooks is a mock of BtcStakingHooks interface.
 type MockBtcStakingHooks struct {
 	ctrl     *gomock.Controller
@@ -219,3 +310,83 @@ func (mr *MockBtcStakingHooksMockRecorder) AfterFinalityProviderActivated(ctx, f
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterFinalityProviderActivated", reflect.TypeOf((*MockBtcStakingHooks)(nil).AfterFinalityProviderActivated), ctx, fpPk)
 }
+
+// AfterFinalityProviderKeyRotated mocks base method.
+func (m *MockBtcStakingHooks) AfterFinalityProviderKeyRotated(ctx context.Context, oldFpPk, newFpPk *types.BIP340PubKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterFinalityProviderKeyRotated", ctx, oldFpPk, newFpPk)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterFinalityProviderKeyRotated indicates an expected call of AfterFinalityProviderKeyRotated.
+func (mr *MockBtcStakingHooksMockRecorder) AfterFinalityProviderKeyRotated(ctx, oldFpPk, newFpPk interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterFinalityProviderKeyRotated", reflect.TypeOf((*MockBtcStakingHooks)(nil).AfterFinalityProviderKeyRotated), ctx, oldFpPk, newFpPk)
+}
+
+// MockBTCDelegationArchive is a mock of BTCDelegationArchive interface.
+type MockBTCDelegationArchive struct {
+	ctrl     *gomock.Controller
+	recorder *MockBTCDelegationArchiveMockRecorder
+}
+
+// MockBTCDelegationArchiveMockRecorder is the mock recorder for MockBTCDelegationArchive.
+type MockBTCDelegationArchiveMockRecorder struct {
+	mock *MockBTCDelegationArchive
+}
+
+// NewMockBTCDelegationArchive creates a new mock instance.
+func NewMockBTCDelegationArchive(ctrl *gomock.Controller) *MockBTCDelegationArchive {
+	mock := &MockBTCDelegationArchive{ctrl: ctrl}
+	mock.recorder = &MockBTCDelegationArchiveMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBTCDelegationArchive) EXPECT() *MockBTCDelegationArchiveMockRecorder {
+	return m.recorder
+}
+
+// ArchiveBTCDelegation mocks base method.
+func (m *MockBTCDelegationArchive) ArchiveBTCDelegation(btcDel *BTCDelegation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveBTCDelegation", btcDel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveBTCDelegation indicates an expected call of ArchiveBTCDelegation.
+func (mr *MockBTCDelegationArchiveMockRecorder) ArchiveBTCDelegation(btcDel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveBTCDelegation", reflect.TypeOf((*MockBTCDelegationArchive)(nil).ArchiveBTCDelegation), btcDel)
+}
+
+// Commit mocks base method.
+func (m *MockBTCDelegationArchive) Commit() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Commit")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Commit indicates an expected call of Commit.
+func (mr *MockBTCDelegationArchiveMockRecorder) Commit() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockBTCDelegationArchive)(nil).Commit))
+}
+
+// GetArchivedBTCDelegation mocks base method.
+func (m *MockBTCDelegationArchive) GetArchivedBTCDelegation(stakingTxHash chainhash.Hash) (*BTCDelegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchivedBTCDelegation", stakingTxHash)
+	ret0, _ := ret[0].(*BTCDelegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchivedBTCDelegation indicates an expected call of GetArchivedBTCDelegation.
+func (mr *MockBTCDelegationArchiveMockRecorder) GetArchivedBTCDelegation(stakingTxHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivedBTCDelegation", reflect.TypeOf((*MockBTCDelegationArchive)(nil).GetArchivedBTCDelegation), stakingTxHash)
+}
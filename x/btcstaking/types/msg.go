@@ -6,7 +6,9 @@ import (
 
 	"github.com/babylonchain/babylon/btcstaking"
 	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -16,8 +18,13 @@ var (
 	_ sdk.Msg = &MsgCreateFinalityProvider{}
 	_ sdk.Msg = &MsgEditFinalityProvider{}
 	_ sdk.Msg = &MsgCreateBTCDelegation{}
+	_ sdk.Msg = &MsgExpandBTCDelegation{}
+	_ sdk.Msg = &MsgBTCRedelegate{}
+	_ sdk.Msg = &MsgAddBTCDelegationInclusionProof{}
+	_ sdk.Msg = &MsgRotateFinalityProviderKey{}
 	_ sdk.Msg = &MsgAddCovenantSigs{}
 	_ sdk.Msg = &MsgBTCUndelegate{}
+	_ sdk.Msg = &MsgRotateCovenantCommittee{}
 )
 
 func (m *MsgCreateFinalityProvider) ValidateBasic() error {
@@ -45,6 +52,15 @@ func (m *MsgCreateFinalityProvider) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Addr); err != nil {
 		return fmt.Errorf("invalid FP addr: %s - %v", m.Addr, err)
 	}
+	if m.MaxRate == nil {
+		return fmt.Errorf("empty max rate")
+	}
+	if m.MaxChangeRate == nil {
+		return fmt.Errorf("empty max change rate")
+	}
+	if err := ValidateCommissionRates(*m.Commission, *m.MaxRate, *m.MaxChangeRate); err != nil {
+		return err
+	}
 	return m.Pop.ValidateBasic()
 }
 
@@ -72,6 +88,21 @@ func (m *MsgEditFinalityProvider) ValidateBasic() error {
 }
 
 func (m *MsgCreateBTCDelegation) ValidateBasic() error {
+	if m.StakingTx == nil {
+		return fmt.Errorf("empty staking tx info")
+	}
+	// staking tx should be correctly formatted, along with its inclusion proof
+	if err := m.StakingTx.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return m.ValidateBasicWithoutInclusionProof()
+}
+
+// ValidateBasicWithoutInclusionProof validates the request of creating a BTC
+// delegation whose staking tx is not included on Bitcoin yet, i.e., the BTC
+// delegation created upon stake expansion
+func (m *MsgCreateBTCDelegation) ValidateBasicWithoutInclusionProof() error {
 	if _, err := sdk.AccAddressFromBech32(m.StakerAddr); err != nil {
 		return fmt.Errorf("invalid staker addr %s: %w", m.StakerAddr, err)
 	}
@@ -116,9 +147,8 @@ func (m *MsgCreateBTCDelegation) ValidateBasic() error {
 		return ErrDuplicatedFp
 	}
 
-	// staking tx should be correctly formatted
-	if err := m.StakingTx.ValidateBasic(); err != nil {
-		return err
+	if m.StakingTx.Transaction == nil {
+		return fmt.Errorf("empty staking tx")
 	}
 	if err := m.Pop.ValidateBasic(); err != nil {
 		return err
@@ -159,6 +189,235 @@ func (m *MsgCreateBTCDelegation) ValidateBasic() error {
 	return nil
 }
 
+func (m *MsgExpandBTCDelegation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.StakerAddr); err != nil {
+		return fmt.Errorf("invalid staker addr %s: %w", m.StakerAddr, err)
+	}
+	if len(m.PreviousStakingTxHash) != chainhash.MaxHashStringSize {
+		return fmt.Errorf("previous staking tx hash is not %d", chainhash.MaxHashStringSize)
+	}
+	if m.StakingTx == nil {
+		return fmt.Errorf("empty staking tx")
+	}
+	if m.SlashingTx == nil {
+		return fmt.Errorf("empty slashing tx")
+	}
+	if m.DelegatorSlashingSig == nil {
+		return fmt.Errorf("empty delegator signature")
+	}
+	if m.UnbondingTx == nil {
+		return fmt.Errorf("empty unbonding tx")
+	}
+	if m.UnbondingSlashingTx == nil {
+		return fmt.Errorf("empty slashing tx")
+	}
+	if m.DelegatorUnbondingSlashingSig == nil {
+		return fmt.Errorf("empty delegator signature")
+	}
+	if len(m.FundingTxs) == 0 {
+		return fmt.Errorf("empty funding txs")
+	}
+	for i, fundingTx := range m.FundingTxs {
+		if _, err := bbn.NewBTCTxFromBytes(fundingTx); err != nil {
+			return fmt.Errorf("invalid funding tx %d: %w", i, err)
+		}
+	}
+	// the rest of the fields are validated together with the fields
+	// inherited from the previous BTC delegation, upon constructing
+	// the corresponding MsgCreateBTCDelegation
+
+	return nil
+}
+
+// ToMsgCreateBTCDelegation constructs a MsgCreateBTCDelegation from the
+// stake expansion request and the BTC delegation to be expanded, where the
+// BTC staker, its proof of possession and the finality providers are inherited
+// from the previous BTC delegation
+func (m *MsgExpandBTCDelegation) ToMsgCreateBTCDelegation(prevDel *BTCDelegation) *MsgCreateBTCDelegation {
+	return &MsgCreateBTCDelegation{
+		StakerAddr:                    m.StakerAddr,
+		Pop:                           prevDel.Pop,
+		BtcPk:                         prevDel.BtcPk,
+		FpBtcPkList:                   prevDel.FpBtcPkList,
+		StakingTime:                   m.StakingTime,
+		StakingValue:                  m.StakingValue,
+		StakingTx:                     &btcctypes.TransactionInfo{Transaction: m.StakingTx},
+		SlashingTx:                    m.SlashingTx,
+		DelegatorSlashingSig:          m.DelegatorSlashingSig,
+		UnbondingTime:                 m.UnbondingTime,
+		UnbondingTx:                   m.UnbondingTx,
+		UnbondingValue:                m.UnbondingValue,
+		UnbondingSlashingTx:           m.UnbondingSlashingTx,
+		DelegatorUnbondingSlashingSig: m.DelegatorUnbondingSlashingSig,
+	}
+}
+
+func (m *MsgBTCRedelegate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.StakerAddr); err != nil {
+		return fmt.Errorf("invalid staker addr %s: %w", m.StakerAddr, err)
+	}
+	if len(m.PreviousStakingTxHash) != chainhash.MaxHashStringSize {
+		return fmt.Errorf("previous staking tx hash is not %d", chainhash.MaxHashStringSize)
+	}
+	// Ensure list of finality provider BTC PKs is not empty
+	if len(m.FpBtcPkList) == 0 {
+		return ErrEmptyFpList
+	}
+	// Ensure list of finality provider BTC PKs is not duplicated
+	if ExistsDup(m.FpBtcPkList) {
+		return ErrDuplicatedFp
+	}
+	if m.StakingTx == nil {
+		return fmt.Errorf("empty staking tx")
+	}
+	if m.SlashingTx == nil {
+		return fmt.Errorf("empty slashing tx")
+	}
+	if m.DelegatorSlashingSig == nil {
+		return fmt.Errorf("empty delegator signature")
+	}
+	if m.UnbondingTx == nil {
+		return fmt.Errorf("empty unbonding tx")
+	}
+	if m.UnbondingSlashingTx == nil {
+		return fmt.Errorf("empty slashing tx")
+	}
+	if m.DelegatorUnbondingSlashingSig == nil {
+		return fmt.Errorf("empty delegator signature")
+	}
+	// the rest of the fields are validated together with the fields
+	// inherited from the previous BTC delegation, upon constructing
+	// the corresponding MsgCreateBTCDelegation
+
+	return nil
+}
+
+// ToMsgCreateBTCDelegation constructs a MsgCreateBTCDelegation from the
+// redelegation request and the BTC delegation to be redelegated, where the
+// BTC staker and its proof of possession are inherited from the previous
+// BTC delegation
+func (m *MsgBTCRedelegate) ToMsgCreateBTCDelegation(prevDel *BTCDelegation) *MsgCreateBTCDelegation {
+	return &MsgCreateBTCDelegation{
+		StakerAddr:                    m.StakerAddr,
+		Pop:                           prevDel.Pop,
+		BtcPk:                         prevDel.BtcPk,
+		FpBtcPkList:                   m.FpBtcPkList,
+		StakingTime:                   m.StakingTime,
+		StakingValue:                  m.StakingValue,
+		StakingTx:                     &btcctypes.TransactionInfo{Transaction: m.StakingTx},
+		SlashingTx:                    m.SlashingTx,
+		DelegatorSlashingSig:          m.DelegatorSlashingSig,
+		UnbondingTime:                 m.UnbondingTime,
+		UnbondingTx:                   m.UnbondingTx,
+		UnbondingValue:                m.UnbondingValue,
+		UnbondingSlashingTx:           m.UnbondingSlashingTx,
+		DelegatorUnbondingSlashingSig: m.DelegatorUnbondingSlashingSig,
+	}
+}
+
+func (m *MsgAddBTCDelegationInclusionProof) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer addr %s: %w", m.Signer, err)
+	}
+	if len(m.StakingTxHash) != chainhash.MaxHashStringSize {
+		return fmt.Errorf("staking tx hash is not %d", chainhash.MaxHashStringSize)
+	}
+	if m.StakingTxKey == nil {
+		return fmt.Errorf("empty staking tx key")
+	}
+	if m.StakingTxProof == nil {
+		return fmt.Errorf("empty staking tx proof")
+	}
+
+	return nil
+}
+
+// ToTransactionInfo constructs the info of the given staking tx along with
+// the inclusion proof in the request
+func (m *MsgAddBTCDelegationInclusionProof) ToTransactionInfo(stakingTx []byte) *btcctypes.TransactionInfo {
+	return btcctypes.NewTransactionInfo(m.StakingTxKey, stakingTx, m.StakingTxProof)
+}
+
+func (m *MsgRotateFinalityProviderKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Addr); err != nil {
+		return fmt.Errorf("invalid FP addr: %s - %v", m.Addr, err)
+	}
+	if m.OldBtcPk == nil {
+		return fmt.Errorf("empty old BTC public key")
+	}
+	if _, err := m.OldBtcPk.ToBTCPK(); err != nil {
+		return fmt.Errorf("invalid old BTC public key: %v", err)
+	}
+	if m.NewBtcPk == nil {
+		return fmt.Errorf("empty new BTC public key")
+	}
+	if _, err := m.NewBtcPk.ToBTCPK(); err != nil {
+		return fmt.Errorf("invalid new BTC public key: %v", err)
+	}
+	if m.OldBtcPk.Equals(m.NewBtcPk) {
+		return fmt.Errorf("the new BTC public key is the same as the old one")
+	}
+	if m.HandoverSig == nil {
+		return fmt.Errorf("empty handover signature")
+	}
+	if m.NewPop == nil {
+		return fmt.Errorf("empty proof of possession")
+	}
+	return m.NewPop.ValidateBasic()
+}
+
+// HandoverHashToSign returns the hash of (old_btc_pk || new_btc_pk || rotation_height),
+// which is signed by the old BTC PK to authorise the new BTC PK
+func (m *MsgRotateFinalityProviderKey) HandoverHashToSign() ([]byte, error) {
+	hasher := tmhash.New()
+	if _, err := hasher.Write(m.OldBtcPk.MustMarshal()); err != nil {
+		return nil, err
+	}
+	if _, err := hasher.Write(m.NewBtcPk.MustMarshal()); err != nil {
+		return nil, err
+	}
+	if _, err := hasher.Write(sdk.Uint64ToBigEndian(m.RotationHeight)); err != nil {
+		return nil, err
+	}
+	return hasher.Sum(nil), nil
+}
+
+// VerifyHandoverSig verifies the handover signature w.r.t. the old BTC PK
+func (m *MsgRotateFinalityProviderKey) VerifyHandoverSig() error {
+	msgHash, err := m.HandoverHashToSign()
+	if err != nil {
+		return err
+	}
+	oldPK, err := m.OldBtcPk.ToBTCPK()
+	if err != nil {
+		return err
+	}
+	schnorrSig, err := m.HandoverSig.ToBTCSig()
+	if err != nil {
+		return err
+	}
+	if !schnorrSig.Verify(msgHash, oldPK) {
+		return fmt.Errorf("failed to verify handover signature")
+	}
+	return nil
+}
+
+func (m *MsgRotateCovenantCommittee) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %s - %v", m.Authority, err)
+	}
+	return m.ToRotation().ValidateBasic()
+}
+
+// ToRotation returns the covenant committee rotation scheduled by the message
+func (m *MsgRotateCovenantCommittee) ToRotation() *CovenantCommitteeRotation {
+	return &CovenantCommitteeRotation{
+		NewCovenantPks:    m.NewCovenantPks,
+		NewCovenantQuorum: m.NewCovenantQuorum,
+		ActivationHeight:  m.ActivationHeight,
+	}
+}
+
 func (m *MsgAddCovenantSigs) ValidateBasic() error {
 	if m.Pk == nil {
 		return fmt.Errorf("empty BTC covenant public key")
@@ -186,6 +445,14 @@ func (m *MsgAddCovenantSigs) ValidateBasic() error {
 		return fmt.Errorf("empty covenant signature")
 	}
 
+	// the signature on the staking tx spending the previous staking output
+	// is only needed upon stake expansion or redelegation
+	if m.StakeSpendingTxSig != nil {
+		if _, err := m.StakeSpendingTxSig.ToBTCSig(); err != nil {
+			return fmt.Errorf("invalid covenant stake spending signature: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	bbntypes "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
@@ -24,6 +25,7 @@ func TestMsgCreateFinalityProviderValidateBasic(t *testing.T) {
 	require.NoError(t, err)
 
 	invalidAddr := "bbnbadaddr"
+	aboveOne := sdkmath.LegacyNewDecWithPrec(101, 2)
 
 	tcs := []struct {
 		title  string
@@ -33,33 +35,39 @@ func TestMsgCreateFinalityProviderValidateBasic(t *testing.T) {
 		{
 			"valid: msg create fp",
 			&types.MsgCreateFinalityProvider{
-				Addr:        fp.Addr,
-				Description: fp.Description,
-				Commission:  fp.Commission,
-				BtcPk:       fp.BtcPk,
-				Pop:         fp.Pop,
+				Addr:          fp.Addr,
+				Description:   fp.Description,
+				Commission:    fp.Commission,
+				MaxRate:       fp.MaxRate,
+				MaxChangeRate: fp.MaxChangeRate,
+				BtcPk:         fp.BtcPk,
+				Pop:           fp.Pop,
 			},
 			nil,
 		},
 		{
 			"invalid: empty commission",
 			&types.MsgCreateFinalityProvider{
-				Addr:        fp.Addr,
-				Description: fp.Description,
-				Commission:  nil,
-				BtcPk:       fp.BtcPk,
-				Pop:         fp.Pop,
+				Addr:          fp.Addr,
+				Description:   fp.Description,
+				Commission:    nil,
+				MaxRate:       fp.MaxRate,
+				MaxChangeRate: fp.MaxChangeRate,
+				BtcPk:         fp.BtcPk,
+				Pop:           fp.Pop,
 			},
 			fmt.Errorf("empty commission"),
 		},
 		{
 			"invalid: empty description",
 			&types.MsgCreateFinalityProvider{
-				Addr:        fp.Addr,
-				Description: nil,
-				Commission:  fp.Commission,
-				BtcPk:       fp.BtcPk,
-				Pop:         fp.Pop,
+				Addr:          fp.Addr,
+				Description:   nil,
+				Commission:    fp.Commission,
+				MaxRate:       fp.MaxRate,
+				MaxChangeRate: fp.MaxChangeRate,
+				BtcPk:         fp.BtcPk,
+				Pop:           fp.Pop,
 			},
 			fmt.Errorf("empty description"),
 		},
@@ -74,9 +82,11 @@ func TestMsgCreateFinalityProviderValidateBasic(t *testing.T) {
 					SecurityContact: fp.Description.SecurityContact,
 					Details:         fp.Description.Details,
 				},
-				Commission: fp.Commission,
-				BtcPk:      fp.BtcPk,
-				Pop:        fp.Pop,
+				Commission:    fp.Commission,
+				MaxRate:       fp.MaxRate,
+				MaxChangeRate: fp.MaxChangeRate,
+				BtcPk:         fp.BtcPk,
+				Pop:           fp.Pop,
 			},
 			fmt.Errorf("empty moniker"),
 		},
@@ -91,74 +101,140 @@ func TestMsgCreateFinalityProviderValidateBasic(t *testing.T) {
 					SecurityContact: fp.Description.SecurityContact,
 					Details:         fp.Description.Details,
 				},
-				Commission: fp.Commission,
-				BtcPk:      fp.BtcPk,
-				Pop:        fp.Pop,
+				Commission:    fp.Commission,
+				MaxRate:       fp.MaxRate,
+				MaxChangeRate: fp.MaxChangeRate,
+				BtcPk:         fp.BtcPk,
+				Pop:           fp.Pop,
 			},
 			errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid moniker length; got: %d, max: %d", len(randBigMoniker), stktypes.MaxMonikerLength),
 		},
 		{
 			"invalid: empty BTC pk",
 			&types.MsgCreateFinalityProvider{
-				Addr:        fp.Addr,
-				Description: fp.Description,
-				Commission:  fp.Commission,
-				BtcPk:       nil,
-				Pop:         fp.Pop,
+				Addr:          fp.Addr,
+				Description:   fp.Description,
+				Commission:    fp.Commission,
+				MaxRate:       fp.MaxRate,
+				MaxChangeRate: fp.MaxChangeRate,
+				BtcPk:         nil,
+				Pop:           fp.Pop,
 			},
 			fmt.Errorf("empty BTC public key"),
 		},
 		{
 			"invalid: invalid BTC pk",
 			&types.MsgCreateFinalityProvider{
-				Addr:        fp.Addr,
-				Description: fp.Description,
-				Commission:  fp.Commission,
-				BtcPk:       (*bbntypes.BIP340PubKey)(&bigBtcPK),
-				Pop:         fp.Pop,
+				Addr:          fp.Addr,
+				Description:   fp.Description,
+				Commission:    fp.Commission,
+				MaxRate:       fp.MaxRate,
+				MaxChangeRate: fp.MaxChangeRate,
+				BtcPk:         (*bbntypes.BIP340PubKey)(&bigBtcPK),
+				Pop:           fp.Pop,
 			},
 			fmt.Errorf("invalid BTC public key: %v", fmt.Errorf("bad pubkey byte string size (want %v, have %v)", 32, len(bigBtcPK))),
 		},
 		{
 			"invalid: empty PoP",
 			&types.MsgCreateFinalityProvider{
-				Addr:        fp.Addr,
-				Description: fp.Description,
-				Commission:  fp.Commission,
-				BtcPk:       fp.BtcPk,
-				Pop:         nil,
+				Addr:          fp.Addr,
+				Description:   fp.Description,
+				Commission:    fp.Commission,
+				MaxRate:       fp.MaxRate,
+				MaxChangeRate: fp.MaxChangeRate,
+				BtcPk:         fp.BtcPk,
+				Pop:           nil,
 			},
 			fmt.Errorf("empty proof of possession"),
 		},
 		{
 			"invalid: empty PoP",
 			&types.MsgCreateFinalityProvider{
-				Addr:        fp.Addr,
-				Description: fp.Description,
-				Commission:  fp.Commission,
-				BtcPk:       fp.BtcPk,
-				Pop:         nil,
+				Addr:          fp.Addr,
+				Description:   fp.Description,
+				Commission:    fp.Commission,
+				MaxRate:       fp.MaxRate,
+				MaxChangeRate: fp.MaxChangeRate,
+				BtcPk:         fp.BtcPk,
+				Pop:           nil,
 			},
 			fmt.Errorf("empty proof of possession"),
 		},
 		{
 			"invalid: bad addr",
 			&types.MsgCreateFinalityProvider{
-				Addr:        invalidAddr,
-				Description: fp.Description,
-				Commission:  fp.Commission,
-				BtcPk:       fp.BtcPk,
-				Pop:         fp.Pop,
+				Addr:          invalidAddr,
+				Description:   fp.Description,
+				Commission:    fp.Commission,
+				MaxRate:       fp.MaxRate,
+				MaxChangeRate: fp.MaxChangeRate,
+				BtcPk:         fp.BtcPk,
+				Pop:           fp.Pop,
 			},
 			fmt.Errorf("invalid FP addr: %s - %v", invalidAddr, fmt.Errorf("decoding bech32 failed: invalid separator index -1")),
 		},
+		{
+			"invalid: empty max rate",
+			&types.MsgCreateFinalityProvider{
+				Addr:          fp.Addr,
+				Description:   fp.Description,
+				Commission:    fp.Commission,
+				MaxRate:       nil,
+				MaxChangeRate: fp.MaxChangeRate,
+				BtcPk:         fp.BtcPk,
+				Pop:           fp.Pop,
+			},
+			fmt.Errorf("empty max rate"),
+		},
+		{
+			"invalid: empty max change rate",
+			&types.MsgCreateFinalityProvider{
+				Addr:          fp.Addr,
+				Description:   fp.Description,
+				Commission:    fp.Commission,
+				MaxRate:       fp.MaxRate,
+				MaxChangeRate: nil,
+				BtcPk:         fp.BtcPk,
+				Pop:           fp.Pop,
+			},
+			fmt.Errorf("empty max change rate"),
+		},
+		{
+			"invalid: commission greater than max rate",
+			&types.MsgCreateFinalityProvider{
+				Addr:          fp.Addr,
+				Description:   fp.Description,
+				Commission:    fp.MaxRate,
+				MaxRate:       fp.Commission,
+				MaxChangeRate: fp.Commission,
+				BtcPk:         fp.BtcPk,
+				Pop:           fp.Pop,
+			},
+			types.ErrInvalidCommissionRates.Wrap("commission rate cannot be more than the max rate"),
+		},
+		{
+			"invalid: max change rate greater than max rate",
+			&types.MsgCreateFinalityProvider{
+				Addr:          fp.Addr,
+				Description:   fp.Description,
+				Commission:    fp.Commission,
+				MaxRate:       fp.MaxRate,
+				MaxChangeRate: &aboveOne,
+				BtcPk:         fp.BtcPk,
+				Pop:           fp.Pop,
+			},
+			types.ErrInvalidCommissionRates.Wrap("max change rate cannot be more than the max rate"),
+		},
 		{
 			"invalid: bad PoP empty sig",
 			&types.MsgCreateFinalityProvider{
-				Addr:        fp.Addr,
-				Description: fp.Description,
-				Commission:  fp.Commission,
-				BtcPk:       fp.BtcPk,
+				Addr:          fp.Addr,
+				Description:   fp.Description,
+				Commission:    fp.Commission,
+				MaxRate:       fp.MaxRate,
+				MaxChangeRate: fp.MaxChangeRate,
+				BtcPk:         fp.BtcPk,
 				Pop: &types.ProofOfPossessionBTC{
 					BtcSig: nil,
 				},
@@ -179,3 +255,36 @@ func TestMsgCreateFinalityProviderValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgRotateFinalityProviderKey(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+
+	fpAddr := datagen.GenRandomAccount().GetAddress()
+	oldSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	newSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	rotationHeight := datagen.RandomInt(r, 1000) + 1
+
+	msg, err := datagen.NewMsgRotateFinalityProviderKey(fpAddr, oldSK, newSK, rotationHeight)
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	require.NoError(t, msg.VerifyHandoverSig())
+
+	// rotating to the same BTC PK is invalid
+	sameKeyMsg := *msg
+	sameKeyMsg.NewBtcPk = msg.OldBtcPk
+	require.EqualError(t, sameKeyMsg.ValidateBasic(), "the new BTC public key is the same as the old one")
+
+	// the handover signature is bound to the rotation height
+	otherHeightMsg := *msg
+	otherHeightMsg.RotationHeight = rotationHeight + 1
+	require.NoError(t, otherHeightMsg.ValidateBasic())
+	require.Error(t, otherHeightMsg.VerifyHandoverSig())
+
+	// the handover signature has to be signed by the old BTC SK
+	newSignerMsg, err := datagen.NewMsgRotateFinalityProviderKey(fpAddr, newSK, oldSK, rotationHeight)
+	require.NoError(t, err)
+	newSignerMsg.OldBtcPk, newSignerMsg.NewBtcPk = msg.OldBtcPk, msg.NewBtcPk
+	require.Error(t, newSignerMsg.VerifyHandoverSig())
+}
@@ -113,6 +113,19 @@ func validateCovenantPks(covenantPks []bbn.BIP340PubKey) error {
 	return nil
 }
 
+// validateCovenantCommittee checks whether the covenant quorum is more than
+// 1/2 of the covenant committee, and the covenant committee does not contain
+// any duplicates
+func validateCovenantCommittee(covenantPks []bbn.BIP340PubKey, covenantQuorum uint32) error {
+	if covenantQuorum == 0 {
+		return fmt.Errorf("covenant quorum size has to be positive")
+	}
+	if covenantQuorum*2 <= uint32(len(covenantPks)) {
+		return fmt.Errorf("covenant quorum size has to be more than 1/2 of the covenant committee size")
+	}
+	return validateCovenantPks(covenantPks)
+}
+
 func validateMinUnbondingTime(minUnbondingTimeBlocks uint32) error {
 	if minUnbondingTimeBlocks > math.MaxUint16 {
 		return fmt.Errorf("minimum unbonding time blocks cannot be greater than %d", math.MaxUint16)
@@ -123,13 +136,7 @@ func validateMinUnbondingTime(minUnbondingTimeBlocks uint32) error {
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	if p.CovenantQuorum == 0 {
-		return fmt.Errorf("covenant quorum size has to be positive")
-	}
-	if p.CovenantQuorum*2 <= uint32(len(p.CovenantPks)) {
-		return fmt.Errorf("covenant quorum size has to be more than 1/2 of the covenant committee size")
-	}
-	if err := validateCovenantPks(p.CovenantPks); err != nil {
+	if err := validateCovenantCommittee(p.CovenantPks, p.CovenantQuorum); err != nil {
 		return err
 	}
 	if err := validateMinSlashingTxFeeSat(p.MinSlashingTxFeeSat); err != nil {
@@ -174,6 +181,21 @@ func (p Params) HasCovenantPK(pk *bbn.BIP340PubKey) bool {
 	return false
 }
 
+// HasSameCovenantCommittee returns whether the given parameters have the same
+// covenant committee, i.e., the same covenant members and covenant quorum
+func (p Params) HasSameCovenantCommittee(other *Params) bool {
+	if p.CovenantQuorum != other.CovenantQuorum || len(p.CovenantPks) != len(other.CovenantPks) {
+		return false
+	}
+	for _, pk := range other.CovenantPks {
+		pk := pk // remove when update to go1.22
+		if !p.HasCovenantPK(&pk) {
+			return false
+		}
+	}
+	return true
+}
+
 func (p Params) MustGetSlashingAddress(btcParams *chaincfg.Params) btcutil.Address {
 	slashingAddr, err := btcutil.DecodeAddress(p.SlashingAddress, btcParams)
 	if err != nil {
@@ -189,3 +211,39 @@ func (p Params) CovenantPksHex() []string {
 	}
 	return covPksHex
 }
+
+// ValidateBasic validates the new covenant committee of the rotation
+func (r *CovenantCommitteeRotation) ValidateBasic() error {
+	if len(r.NewCovenantPks) == 0 {
+		return fmt.Errorf("empty new covenant committee")
+	}
+	if r.NewCovenantQuorum > uint32(len(r.NewCovenantPks)) {
+		return fmt.Errorf("new covenant quorum size (%d) is larger than the new covenant committee size (%d)", r.NewCovenantQuorum, len(r.NewCovenantPks))
+	}
+	if r.ActivationHeight == 0 {
+		return fmt.Errorf("activation height has to be positive")
+	}
+	return validateCovenantCommittee(r.NewCovenantPks, r.NewCovenantQuorum)
+}
+
+// Apply returns a copy of the given parameters with the covenant committee
+// replaced by the new covenant committee of the rotation
+func (r *CovenantCommitteeRotation) Apply(p Params) Params {
+	p.CovenantPks = r.NewCovenantPks
+	p.CovenantQuorum = r.NewCovenantQuorum
+	return p
+}
+
+// RetiringCovenantPks returns the members of the covenant committee in the
+// given parameters that are not in the new covenant committee of the rotation
+func (r *CovenantCommitteeRotation) RetiringCovenantPks(p *Params) []bbn.BIP340PubKey {
+	newParams := r.Apply(*p)
+	retiringPks := []bbn.BIP340PubKey{}
+	for _, pk := range p.CovenantPks {
+		pk := pk // remove when update to go1.22
+		if !newParams.HasCovenantPK(&pk) {
+			retiringPks = append(retiringPks, pk)
+		}
+	}
+	return retiringPks
+}
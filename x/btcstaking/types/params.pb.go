@@ -56,6 +56,10 @@ type Params struct {
 	// must be at least 90% of staking output, for staking request to be considered
 	// valid
 	MinUnbondingRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=min_unbonding_rate,json=minUnbondingRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_unbonding_rate"`
+	// unbonded_delegation_retention_blocks is the number of BTC blocks that an
+	// unbonded BTC delegation is kept in the state before being pruned. Zero
+	// disables pruning
+	UnbondedDelegationRetentionBlocks uint32 `protobuf:"varint,10,opt,name=unbonded_delegation_retention_blocks,json=unbondedDelegationRetentionBlocks,proto3" json:"unbonded_delegation_retention_blocks,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -125,6 +129,13 @@ func (m *Params) GetMinUnbondingTime() uint32 {
 	return 0
 }
 
+func (m *Params) GetUnbondedDelegationRetentionBlocks() uint32 {
+	if m != nil {
+		return m.UnbondedDelegationRetentionBlocks
+	}
+	return 0
+}
+
 // StoredParams attach information about the version of stored parameters
 type StoredParams struct {
 	// version of the stored parameters. Each parameters update
@@ -181,9 +192,73 @@ func (m *StoredParams) GetParams() Params {
 	return Params{}
 }
 
+// CovenantCommitteeRotation is a scheduled rotation of the covenant committee.
+// From the activation height on, new BTC delegations are staked with the new
+// covenant committee, while existing BTC delegations keep the covenant
+// committee in the parameters of their version
+type CovenantCommitteeRotation struct {
+	// new_covenant_pks is the list of public keys held by the new covenant
+	// committee
+	NewCovenantPks []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,rep,name=new_covenant_pks,json=newCovenantPks,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"new_covenant_pks,omitempty"`
+	// new_covenant_quorum is the minimum number of signatures needed for the
+	// multisignature of the new covenant committee
+	NewCovenantQuorum uint32 `protobuf:"varint,2,opt,name=new_covenant_quorum,json=newCovenantQuorum,proto3" json:"new_covenant_quorum,omitempty"`
+	// activation_height is the Babylon height from which the new covenant
+	// committee is used for new BTC delegations
+	ActivationHeight uint64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *CovenantCommitteeRotation) Reset()         { *m = CovenantCommitteeRotation{} }
+func (m *CovenantCommitteeRotation) String() string { return proto.CompactTextString(m) }
+func (*CovenantCommitteeRotation) ProtoMessage()    {}
+func (*CovenantCommitteeRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d1392776a3e15b9, []int{2}
+}
+func (m *CovenantCommitteeRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CovenantCommitteeRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CovenantCommitteeRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CovenantCommitteeRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CovenantCommitteeRotation.Merge(m, src)
+}
+func (m *CovenantCommitteeRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CovenantCommitteeRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CovenantCommitteeRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CovenantCommitteeRotation proto.InternalMessageInfo
+
+func (m *CovenantCommitteeRotation) GetNewCovenantQuorum() uint32 {
+	if m != nil {
+		return m.NewCovenantQuorum
+	}
+	return 0
+}
+
+func (m *CovenantCommitteeRotation) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.btcstaking.v1.Params")
 	proto.RegisterType((*StoredParams)(nil), "babylon.btcstaking.v1.StoredParams")
+	proto.RegisterType((*CovenantCommitteeRotation)(nil), "babylon.btcstaking.v1.CovenantCommitteeRotation")
 }
 
 func init() {
@@ -191,42 +266,48 @@ func init() {
 }
 
 var fileDescriptor_8d1392776a3e15b9 = []byte{
-	// 547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0xbf, 0xe4, 0x4b, 0xe9, 0x34, 0xa5, 0x65, 0x00, 0x61, 0x82, 0xea, 0x44, 0x61, 0x41,
-	0x90, 0xc0, 0x26, 0x6d, 0xc5, 0x02, 0x56, 0x09, 0xa8, 0x12, 0xa2, 0x8b, 0xe0, 0x14, 0x24, 0xd8,
-	0x8c, 0xc6, 0xf6, 0xd4, 0x19, 0x25, 0x33, 0x13, 0x3c, 0x13, 0xcb, 0x7e, 0x0b, 0x96, 0x2c, 0x79,
-	0x08, 0x1e, 0xa2, 0xcb, 0x8a, 0x15, 0xea, 0x22, 0x42, 0xc9, 0x1b, 0xf0, 0x04, 0xc8, 0x63, 0x3b,
-	0xfc, 0x08, 0x09, 0xc4, 0xce, 0xf7, 0xdc, 0x73, 0xcf, 0xfd, 0xf1, 0x19, 0xd0, 0xf1, 0xb0, 0x97,
-	0x4e, 0x05, 0x77, 0x3c, 0xe5, 0x4b, 0x85, 0x27, 0x94, 0x87, 0x4e, 0xdc, 0x73, 0x66, 0x38, 0xc2,
-	0x4c, 0xda, 0xb3, 0x48, 0x28, 0x01, 0xaf, 0x17, 0x1c, 0xfb, 0x3b, 0xc7, 0x8e, 0x7b, 0xcd, 0x6b,
-	0xa1, 0x08, 0x85, 0x66, 0x38, 0xd9, 0x57, 0x4e, 0x6e, 0xde, 0xf4, 0x85, 0x64, 0x42, 0xa2, 0x3c,
-	0x91, 0x07, 0x79, 0xaa, 0xf3, 0xb5, 0x06, 0xea, 0x43, 0x2d, 0x0c, 0x5f, 0x83, 0x86, 0x2f, 0x62,
-	0xc2, 0x31, 0x57, 0x68, 0x36, 0x91, 0xa6, 0xd1, 0xae, 0x76, 0x1b, 0x83, 0x87, 0x17, 0x8b, 0xd6,
-	0x7e, 0x48, 0xd5, 0x78, 0xee, 0xd9, 0xbe, 0x60, 0x4e, 0xd1, 0xd7, 0x1f, 0x63, 0xca, 0xcb, 0xc0,
-	0x51, 0xe9, 0x8c, 0x48, 0x7b, 0xf0, 0x6c, 0x78, 0x70, 0xf8, 0x60, 0x38, 0xf7, 0x9e, 0x93, 0xd4,
-	0xdd, 0x2a, 0xb5, 0x86, 0x13, 0x09, 0xef, 0x80, 0x9d, 0xb5, 0xf4, 0xdb, 0xb9, 0x88, 0xe6, 0xcc,
-	0xfc, 0xaf, 0x6d, 0x74, 0xb7, 0xdd, 0xcb, 0x25, 0xfc, 0x42, 0xa3, 0xf0, 0x2e, 0xd8, 0x95, 0x53,
-	0x2c, 0xc7, 0x94, 0x87, 0x08, 0x07, 0x41, 0x44, 0xa4, 0x34, 0xab, 0x6d, 0xa3, 0xbb, 0xe9, 0xee,
-	0x94, 0x78, 0x3f, 0x87, 0xe1, 0x21, 0xb8, 0xc1, 0x28, 0x47, 0x6b, 0xba, 0x4a, 0xd0, 0x29, 0x21,
-	0x48, 0x62, 0x65, 0xd6, 0xda, 0x46, 0xb7, 0xea, 0x5e, 0x65, 0x94, 0x8f, 0x8a, 0xec, 0x49, 0x72,
-	0x44, 0xc8, 0x08, 0x2b, 0x38, 0x02, 0x19, 0x8c, 0x7c, 0xc1, 0x18, 0x95, 0x92, 0x0a, 0x8e, 0x22,
-	0xac, 0x88, 0xf9, 0x7f, 0xd6, 0x63, 0x70, 0xfb, 0x6c, 0xd1, 0xaa, 0x5c, 0x2c, 0x5a, 0xb7, 0xf2,
-	0x13, 0xc9, 0x60, 0x62, 0x53, 0xe1, 0x30, 0xac, 0xc6, 0xf6, 0x31, 0x09, 0xb1, 0x9f, 0x3e, 0x25,
-	0xbe, 0x7b, 0x85, 0x51, 0xfe, 0x64, 0x5d, 0xee, 0x62, 0x45, 0xe0, 0x2b, 0xb0, 0xbd, 0x1e, 0x43,
-	0xcb, 0xd5, 0xb5, 0x5c, 0xef, 0x2f, 0xe4, 0x3e, 0x7d, 0xbc, 0x0f, 0x8a, 0x1f, 0x92, 0x89, 0x37,
-	0x4a, 0x1d, 0xad, 0xdb, 0x07, 0x7b, 0x0c, 0x27, 0x08, 0xfb, 0x8a, 0xc6, 0x04, 0x9d, 0x52, 0x8e,
-	0xa7, 0x54, 0xa5, 0xd9, 0x6f, 0x8c, 0x69, 0x40, 0x22, 0x69, 0x6e, 0xe8, 0x23, 0x36, 0x19, 0x4e,
-	0xfa, 0x9a, 0x73, 0x54, 0x50, 0x86, 0x25, 0x03, 0xde, 0x03, 0x30, 0xdb, 0x77, 0xce, 0x3d, 0xc1,
-	0x03, 0x7d, 0x26, 0xca, 0x88, 0x79, 0x49, 0xd7, 0xed, 0x32, 0xca, 0x5f, 0x96, 0x89, 0x13, 0xca,
-	0x08, 0x44, 0xbf, 0xb2, 0xf5, 0x36, 0x9b, 0xff, 0xba, 0xcd, 0x4f, 0x0d, 0xb2, 0x8d, 0x1e, 0xd5,
-	0xde, 0x7f, 0x68, 0x55, 0x3a, 0x04, 0x34, 0x46, 0x4a, 0x44, 0x24, 0x28, 0x9c, 0x67, 0x82, 0x8d,
-	0x98, 0x44, 0xd9, 0x39, 0x4d, 0x43, 0x4f, 0x56, 0x86, 0xf0, 0x31, 0xa8, 0xe7, 0xb6, 0xd7, 0x7e,
-	0xd9, 0xda, 0xdf, 0xb3, 0x7f, 0xeb, 0x7b, 0x3b, 0x17, 0x1a, 0xd4, 0xb2, 0x19, 0xdd, 0xa2, 0x64,
-	0x70, 0x7c, 0xb6, 0xb4, 0x8c, 0xf3, 0xa5, 0x65, 0x7c, 0x59, 0x5a, 0xc6, 0xbb, 0x95, 0x55, 0x39,
-	0x5f, 0x59, 0x95, 0xcf, 0x2b, 0xab, 0xf2, 0xe6, 0x8f, 0x86, 0x4e, 0x7e, 0x7c, 0x7b, 0xda, 0xdd,
-	0x5e, 0x5d, 0x3f, 0x98, 0x83, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x91, 0xdb, 0xb2, 0x9e,
-	0x03, 0x00, 0x00,
+	// 644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x4f, 0x13, 0x41,
+	0x18, 0xee, 0x4a, 0x29, 0x32, 0x14, 0x28, 0x8b, 0xc6, 0x05, 0x43, 0x5b, 0xab, 0x89, 0x35, 0xea,
+	0xae, 0x7c, 0xc4, 0x83, 0x9e, 0x28, 0x84, 0x68, 0x24, 0xb1, 0x6e, 0xd1, 0x44, 0x2f, 0xe3, 0xec,
+	0xee, 0xcb, 0x76, 0xd2, 0xce, 0x4c, 0xdd, 0x99, 0x96, 0xf6, 0x5f, 0x78, 0xf4, 0xe8, 0x8f, 0xf0,
+	0x1f, 0x78, 0xe1, 0x48, 0x3c, 0x19, 0x0e, 0xc4, 0xc0, 0x1f, 0x31, 0x3b, 0xbb, 0x5b, 0xc0, 0x98,
+	0x68, 0x8c, 0xb7, 0x99, 0xf7, 0x7d, 0xe6, 0x79, 0x3f, 0x9f, 0x41, 0x35, 0x8f, 0x78, 0xa3, 0xae,
+	0xe0, 0x8e, 0xa7, 0x7c, 0xa9, 0x48, 0x87, 0xf2, 0xd0, 0x19, 0xac, 0x3a, 0x3d, 0x12, 0x11, 0x26,
+	0xed, 0x5e, 0x24, 0x94, 0x30, 0xaf, 0xa7, 0x18, 0xfb, 0x1c, 0x63, 0x0f, 0x56, 0x97, 0xaf, 0x85,
+	0x22, 0x14, 0x1a, 0xe1, 0xc4, 0xa7, 0x04, 0xbc, 0xbc, 0xe4, 0x0b, 0xc9, 0x84, 0xc4, 0x89, 0x23,
+	0xb9, 0x24, 0xae, 0xda, 0xd7, 0x49, 0x54, 0x68, 0x6a, 0x62, 0xf3, 0x2d, 0x2a, 0xfa, 0x62, 0x00,
+	0x9c, 0x70, 0x85, 0x7b, 0x1d, 0x69, 0x19, 0xd5, 0x89, 0x7a, 0xb1, 0xf1, 0xf8, 0xf8, 0xa4, 0xb2,
+	0x16, 0x52, 0xd5, 0xee, 0x7b, 0xb6, 0x2f, 0x98, 0x93, 0xc6, 0xf5, 0xdb, 0x84, 0xf2, 0xec, 0xe2,
+	0xa8, 0x51, 0x0f, 0xa4, 0xdd, 0x78, 0xde, 0x5c, 0xdf, 0x78, 0xd4, 0xec, 0x7b, 0x2f, 0x60, 0xe4,
+	0xce, 0x64, 0x5c, 0xcd, 0x8e, 0x34, 0xef, 0xa2, 0xf9, 0x31, 0xf5, 0x87, 0xbe, 0x88, 0xfa, 0xcc,
+	0xba, 0x52, 0x35, 0xea, 0xb3, 0xee, 0x5c, 0x66, 0x7e, 0xa5, 0xad, 0xe6, 0x3d, 0x54, 0x92, 0x5d,
+	0x22, 0xdb, 0x94, 0x87, 0x98, 0x04, 0x41, 0x04, 0x52, 0x5a, 0x13, 0x55, 0xa3, 0x3e, 0xed, 0xce,
+	0x67, 0xf6, 0xcd, 0xc4, 0x6c, 0x6e, 0xa0, 0x1b, 0x8c, 0x72, 0x3c, 0x86, 0xab, 0x21, 0xde, 0x07,
+	0xc0, 0x92, 0x28, 0x2b, 0x5f, 0x35, 0xea, 0x13, 0xee, 0x22, 0xa3, 0xbc, 0x95, 0x7a, 0xf7, 0x86,
+	0x3b, 0x00, 0x2d, 0xa2, 0xcc, 0x16, 0x8a, 0xcd, 0xd8, 0x17, 0x8c, 0x51, 0x29, 0xa9, 0xe0, 0x38,
+	0x22, 0x0a, 0xac, 0xc9, 0x38, 0x46, 0xe3, 0xf6, 0xe1, 0x49, 0x25, 0x77, 0x7c, 0x52, 0xb9, 0x99,
+	0xb4, 0x48, 0x06, 0x1d, 0x9b, 0x0a, 0x87, 0x11, 0xd5, 0xb6, 0x77, 0x21, 0x24, 0xfe, 0x68, 0x1b,
+	0x7c, 0x77, 0x81, 0x51, 0xbe, 0x35, 0x7e, 0xee, 0x12, 0x05, 0xe6, 0x1b, 0x34, 0x3b, 0x4e, 0x43,
+	0xd3, 0x15, 0x34, 0xdd, 0xea, 0x5f, 0xd0, 0x7d, 0xfb, 0xf2, 0x10, 0xa5, 0x03, 0x89, 0xc9, 0x8b,
+	0x19, 0x8f, 0xe6, 0xdd, 0x44, 0x2b, 0x8c, 0x0c, 0x31, 0xf1, 0x15, 0x1d, 0x00, 0xde, 0xa7, 0x9c,
+	0x74, 0xa9, 0x1a, 0xc5, 0x63, 0x1c, 0xd0, 0x00, 0x22, 0x69, 0x4d, 0xe9, 0x26, 0x2e, 0x33, 0x32,
+	0xdc, 0xd4, 0x98, 0x9d, 0x14, 0xd2, 0xcc, 0x10, 0xe6, 0x03, 0x64, 0xc6, 0xf5, 0xf6, 0xb9, 0x27,
+	0x78, 0xa0, 0xdb, 0x44, 0x19, 0x58, 0x57, 0xf5, 0xbb, 0x12, 0xa3, 0xfc, 0x75, 0xe6, 0xd8, 0xa3,
+	0x0c, 0x4c, 0xfc, 0x2b, 0x5a, 0x57, 0x33, 0xfd, 0xaf, 0xd5, 0x5c, 0x0a, 0xa0, 0x2b, 0x7a, 0x89,
+	0xee, 0x24, 0xe4, 0x10, 0xe0, 0x00, 0xba, 0x10, 0x12, 0xa5, 0x67, 0x00, 0x0a, 0xb8, 0x3e, 0x79,
+	0x5d, 0xe1, 0x77, 0xa4, 0x85, 0x74, 0x82, 0xb7, 0x32, 0xec, 0xf6, 0x18, 0xea, 0x66, 0xc8, 0x86,
+	0x06, 0x3e, 0xc9, 0x7f, 0xfa, 0x5c, 0xc9, 0xd5, 0x00, 0x15, 0x5b, 0x4a, 0x44, 0x10, 0xa4, 0xab,
+	0x6c, 0xa1, 0xa9, 0x01, 0x44, 0xf1, 0x7c, 0x2c, 0x43, 0x33, 0x65, 0x57, 0xf3, 0x29, 0x2a, 0x24,
+	0x3a, 0xd2, 0x0b, 0x38, 0xb3, 0xb6, 0x62, 0xff, 0x56, 0x48, 0x76, 0x42, 0xd4, 0xc8, 0xc7, 0x45,
+	0xbb, 0xe9, 0x93, 0xda, 0xb1, 0x81, 0x96, 0xb6, 0xd2, 0x85, 0xd5, 0x2b, 0xa0, 0x14, 0x80, 0x2b,
+	0x94, 0xce, 0xcc, 0x7c, 0x8f, 0x4a, 0x1c, 0x0e, 0xf0, 0x7f, 0xd4, 0xd0, 0x1c, 0x87, 0x83, 0xad,
+	0x0b, 0x32, 0xb2, 0xd1, 0xe2, 0xa5, 0x08, 0x97, 0xa4, 0xb4, 0x70, 0x01, 0x9c, 0xaa, 0xe9, 0x3e,
+	0x5a, 0xd0, 0xbb, 0x93, 0x34, 0xb9, 0x0d, 0x34, 0x6c, 0x2b, 0x2d, 0xa7, 0xbc, 0x5b, 0x3a, 0x77,
+	0x3c, 0xd3, 0xf6, 0xc6, 0xee, 0xe1, 0x69, 0xd9, 0x38, 0x3a, 0x2d, 0x1b, 0x3f, 0x4e, 0xcb, 0xc6,
+	0xc7, 0xb3, 0x72, 0xee, 0xe8, 0xac, 0x9c, 0xfb, 0x7e, 0x56, 0xce, 0xbd, 0xfb, 0x63, 0xea, 0xc3,
+	0x8b, 0x3f, 0x95, 0xae, 0xc3, 0x2b, 0xe8, 0xef, 0x65, 0xfd, 0xe7, 0x00, 0x39, 0xe4, 0x22, 0xf4,
+	0xcc, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -249,6 +330,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UnbondedDelegationRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UnbondedDelegationRetentionBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
 	{
 		size := m.MinUnbondingRate.Size()
 		i -= size
@@ -361,6 +447,53 @@ func (m *StoredParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CovenantCommitteeRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CovenantCommitteeRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CovenantCommitteeRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NewCovenantQuorum != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.NewCovenantQuorum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NewCovenantPks) > 0 {
+		for iNdEx := len(m.NewCovenantPks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.NewCovenantPks[iNdEx].Size()
+				i -= size
+				if _, err := m.NewCovenantPks[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -406,6 +539,9 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinUnbondingRate.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.UnbondedDelegationRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.UnbondedDelegationRetentionBlocks))
+	}
 	return n
 }
 
@@ -423,6 +559,27 @@ func (m *StoredParams) Size() (n int) {
 	return n
 }
 
+func (m *CovenantCommitteeRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NewCovenantPks) > 0 {
+		for _, e := range m.NewCovenantPks {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.NewCovenantQuorum != 0 {
+		n += 1 + sovParams(uint64(m.NewCovenantQuorum))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovParams(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
 func sovParams(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -703,6 +860,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondedDelegationRetentionBlocks", wireType)
+			}
+			m.UnbondedDelegationRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondedDelegationRetentionBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -826,6 +1002,129 @@ func (m *StoredParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CovenantCommitteeRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CovenantCommitteeRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CovenantCommitteeRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCovenantPks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.NewCovenantPks = append(m.NewCovenantPks, v)
+			if err := m.NewCovenantPks[len(m.NewCovenantPks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCovenantQuorum", wireType)
+			}
+			m.NewCovenantQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewCovenantQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -67,6 +67,11 @@ func NewFinalityProviderResponse(f *FinalityProvider, bbnBlockHeight, votingPowe
 		SlashedBabylonHeight: f.SlashedBabylonHeight,
 		SlashedBtcHeight:     f.SlashedBtcHeight,
 		Sluggish:             f.Sluggish,
+		JailedUntil:          f.JailedUntil,
+		MaxRate:              f.MaxRate,
+		MaxChangeRate:        f.MaxChangeRate,
+		CommissionUpdateTime: f.CommissionUpdateTime,
+		ConsumerId:           f.ConsumerId,
 		Height:               bbnBlockHeight,
 		VotingPower:          votingPower,
 	}
@@ -8,25 +8,30 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	types "github.com/babylonchain/babylon/x/incentive/types"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
-	types "github.com/cosmos/cosmos-sdk/x/staking/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -410,6 +415,11 @@ type QueryBTCDelegationsRequest struct {
 	Status BTCDelegationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=babylon.btcstaking.v1.BTCDelegationStatus" json:"status,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// expiring_within_btc_blocks, if non-zero, restricts the result to the BTC
+	// delegations whose staking timelocks expire within the given number of BTC
+	// blocks from the current BTC tip. It requires a status other than ANY, and
+	// only supports key-based pagination
+	ExpiringWithinBtcBlocks uint64 `protobuf:"varint,3,opt,name=expiring_within_btc_blocks,json=expiringWithinBtcBlocks,proto3" json:"expiring_within_btc_blocks,omitempty"`
 }
 
 func (m *QueryBTCDelegationsRequest) Reset()         { *m = QueryBTCDelegationsRequest{} }
@@ -459,6 +469,13 @@ func (m *QueryBTCDelegationsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryBTCDelegationsRequest) GetExpiringWithinBtcBlocks() uint64 {
+	if m != nil {
+		return m.ExpiringWithinBtcBlocks
+	}
+	return 0
+}
+
 // QueryBTCDelegationsResponse is the response type for the
 // Query/BTCDelegations RPC method.
 type QueryBTCDelegationsResponse struct {
@@ -1059,6 +1076,244 @@ func (m *QueryFinalityProviderDelegationsResponse) GetPagination() *query.PageRe
 	return nil
 }
 
+// QueryDelegatorDelegationsRequest is the request type for the
+// Query/DelegatorDelegations RPC method.
+type QueryDelegatorDelegationsRequest struct {
+	// del_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the BTC delegator
+	// the PK follows encoding in BIP-340 spec
+	DelBtcPkHex string `protobuf:"bytes,1,opt,name=del_btc_pk_hex,json=delBtcPkHex,proto3" json:"del_btc_pk_hex,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorDelegationsRequest) Reset()         { *m = QueryDelegatorDelegationsRequest{} }
+func (m *QueryDelegatorDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorDelegationsRequest) ProtoMessage()    {}
+func (*QueryDelegatorDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{20}
+}
+func (m *QueryDelegatorDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorDelegationsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorDelegationsRequest) GetDelBtcPkHex() string {
+	if m != nil {
+		return m.DelBtcPkHex
+	}
+	return ""
+}
+
+func (m *QueryDelegatorDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelegatorDelegationsResponse is the response type for the
+// Query/DelegatorDelegations RPC method.
+type QueryDelegatorDelegationsResponse struct {
+	// delegations contains all the queried BTC delegations of the BTC delegator
+	Delegations []*DelegatorDelegationResponse `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations,omitempty"`
+	// rewards contains the reward gauges of the staker addresses of the queried
+	// BTC delegations. Rewards are accrued per staker address rather than per
+	// BTC delegation.
+	Rewards []*StakerRewardResponse `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorDelegationsResponse) Reset()         { *m = QueryDelegatorDelegationsResponse{} }
+func (m *QueryDelegatorDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorDelegationsResponse) ProtoMessage()    {}
+func (*QueryDelegatorDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{21}
+}
+func (m *QueryDelegatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorDelegationsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorDelegationsResponse) GetDelegations() []*DelegatorDelegationResponse {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *QueryDelegatorDelegationsResponse) GetRewards() []*StakerRewardResponse {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *QueryDelegatorDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// DelegatorDelegationResponse is a BTC delegation of a BTC delegator with its
+// current voting power
+type DelegatorDelegationResponse struct {
+	// btc_delegation is the BTC delegation with its current status
+	BtcDelegation *BTCDelegationResponse `protobuf:"bytes,1,opt,name=btc_delegation,json=btcDelegation,proto3" json:"btc_delegation,omitempty"`
+	// voting_power is the voting power that the BTC delegation currently
+	// contributes to each of its finality providers, which is its total
+	// amount of BTC stakes if active, and 0 otherwise
+	VotingPower uint64 `protobuf:"varint,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+}
+
+func (m *DelegatorDelegationResponse) Reset()         { *m = DelegatorDelegationResponse{} }
+func (m *DelegatorDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*DelegatorDelegationResponse) ProtoMessage()    {}
+func (*DelegatorDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{22}
+}
+func (m *DelegatorDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorDelegationResponse.Merge(m, src)
+}
+func (m *DelegatorDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorDelegationResponse proto.InternalMessageInfo
+
+func (m *DelegatorDelegationResponse) GetBtcDelegation() *BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegation
+	}
+	return nil
+}
+
+func (m *DelegatorDelegationResponse) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+// StakerRewardResponse is the reward gauge of a staker address
+type StakerRewardResponse struct {
+	// staker_addr is the address receiving rewards from BTC delegations
+	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+	// reward_gauge is the rewards accrued and withdrawn by the staker address
+	// as a BTC delegation stakeholder. It is nil if no reward is accrued yet.
+	RewardGauge *types.RewardGauge `protobuf:"bytes,2,opt,name=reward_gauge,json=rewardGauge,proto3" json:"reward_gauge,omitempty"`
+}
+
+func (m *StakerRewardResponse) Reset()         { *m = StakerRewardResponse{} }
+func (m *StakerRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*StakerRewardResponse) ProtoMessage()    {}
+func (*StakerRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{23}
+}
+func (m *StakerRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakerRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakerRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakerRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakerRewardResponse.Merge(m, src)
+}
+func (m *StakerRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakerRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakerRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakerRewardResponse proto.InternalMessageInfo
+
+func (m *StakerRewardResponse) GetStakerAddr() string {
+	if m != nil {
+		return m.StakerAddr
+	}
+	return ""
+}
+
+func (m *StakerRewardResponse) GetRewardGauge() *types.RewardGauge {
+	if m != nil {
+		return m.RewardGauge
+	}
+	return nil
+}
+
 // QueryBTCDelegationRequest is the request type to retrieve a BTC delegation by
 // staking tx hash
 type QueryBTCDelegationRequest struct {
@@ -1070,7 +1325,7 @@ func (m *QueryBTCDelegationRequest) Reset()         { *m = QueryBTCDelegationReq
 func (m *QueryBTCDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBTCDelegationRequest) ProtoMessage()    {}
 func (*QueryBTCDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{20}
+	return fileDescriptor_74d49d26f7429697, []int{24}
 }
 func (m *QueryBTCDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1117,7 +1372,7 @@ func (m *QueryBTCDelegationResponse) Reset()         { *m = QueryBTCDelegationRe
 func (m *QueryBTCDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBTCDelegationResponse) ProtoMessage()    {}
 func (*QueryBTCDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{21}
+	return fileDescriptor_74d49d26f7429697, []int{25}
 }
 func (m *QueryBTCDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1153,64 +1408,23 @@ func (m *QueryBTCDelegationResponse) GetBtcDelegation() *BTCDelegationResponse {
 	return nil
 }
 
-// BTCDelegationResponse is the client needed information from a BTCDelegation with the current status based on parameters.
-type BTCDelegationResponse struct {
-	// staker_addr is the address to receive rewards from BTC delegation.
-	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
-	// btc_pk is the Bitcoin secp256k1 PK of this BTC delegation
-	// the PK follows encoding in BIP-340 spec
-	BtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
-	// fp_btc_pk_list is the list of BIP-340 PKs of the finality providers that
-	// this BTC delegation delegates to
-	FpBtcPkList []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,3,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk_list,omitempty"`
-	// start_height is the start BTC height of the BTC delegation
-	// it is the start BTC height of the timelock
-	StartHeight uint64 `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
-	// end_height is the end height of the BTC delegation
-	// it is the end BTC height of the timelock - w
-	EndHeight uint64 `protobuf:"varint,5,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
-	// total_sat is the total amount of BTC stakes in this delegation
-	// quantified in satoshi
-	TotalSat uint64 `protobuf:"varint,6,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
-	// staking_tx_hex is the hex string of staking tx
-	StakingTxHex string `protobuf:"bytes,7,opt,name=staking_tx_hex,json=stakingTxHex,proto3" json:"staking_tx_hex,omitempty"`
-	// slashing_tx_hex is the hex string of slashing tx
-	SlashingTxHex string `protobuf:"bytes,8,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
-	// delegator_slash_sig_hex is the signature on the slashing tx
-	// by the delegator (i.e., SK corresponding to btc_pk) as string hex.
-	// It will be a part of the witness for the staking tx output.
-	DelegatorSlashSigHex string `protobuf:"bytes,9,opt,name=delegator_slash_sig_hex,json=delegatorSlashSigHex,proto3" json:"delegator_slash_sig_hex,omitempty"`
-	// covenant_sigs is a list of adaptor signatures on the slashing tx
-	// by each covenant member
-	// It will be a part of the witness for the staking tx output.
-	CovenantSigs []*CovenantAdaptorSignatures `protobuf:"bytes,10,rep,name=covenant_sigs,json=covenantSigs,proto3" json:"covenant_sigs,omitempty"`
-	// staking_output_idx is the index of the staking output in the staking tx
-	StakingOutputIdx uint32 `protobuf:"varint,11,opt,name=staking_output_idx,json=stakingOutputIdx,proto3" json:"staking_output_idx,omitempty"`
-	// whether this delegation is active
-	Active bool `protobuf:"varint,12,opt,name=active,proto3" json:"active,omitempty"`
-	// descriptive status of current delegation.
-	StatusDesc string `protobuf:"bytes,13,opt,name=status_desc,json=statusDesc,proto3" json:"status_desc,omitempty"`
-	// unbonding_time used in unbonding output timelock path and in slashing transactions
-	// change outputs
-	UnbondingTime uint32 `protobuf:"varint,14,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
-	// undelegation_response is the undelegation info of this delegation.
-	UndelegationResponse *BTCUndelegationResponse `protobuf:"bytes,15,opt,name=undelegation_response,json=undelegationResponse,proto3" json:"undelegation_response,omitempty"`
-	// params version used to validate delegation
-	ParamsVersion uint32 `protobuf:"varint,16,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+// QueryCovenantCommitteeRotationRequest is the request type for the
+// Query/CovenantCommitteeRotation RPC method.
+type QueryCovenantCommitteeRotationRequest struct {
 }
 
-func (m *BTCDelegationResponse) Reset()         { *m = BTCDelegationResponse{} }
-func (m *BTCDelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*BTCDelegationResponse) ProtoMessage()    {}
-func (*BTCDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{22}
+func (m *QueryCovenantCommitteeRotationRequest) Reset()         { *m = QueryCovenantCommitteeRotationRequest{} }
+func (m *QueryCovenantCommitteeRotationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantCommitteeRotationRequest) ProtoMessage()    {}
+func (*QueryCovenantCommitteeRotationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{26}
 }
-func (m *BTCDelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCovenantCommitteeRotationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCovenantCommitteeRotationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BTCDelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCovenantCommitteeRotationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1220,54 +1434,301 @@ func (m *BTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *BTCDelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BTCDelegationResponse.Merge(m, src)
+func (m *QueryCovenantCommitteeRotationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantCommitteeRotationRequest.Merge(m, src)
 }
-func (m *BTCDelegationResponse) XXX_Size() int {
+func (m *QueryCovenantCommitteeRotationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BTCDelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BTCDelegationResponse.DiscardUnknown(m)
+func (m *QueryCovenantCommitteeRotationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantCommitteeRotationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BTCDelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCovenantCommitteeRotationRequest proto.InternalMessageInfo
 
-func (m *BTCDelegationResponse) GetStakerAddr() string {
-	if m != nil {
-		return m.StakerAddr
-	}
-	return ""
+// QueryCovenantCommitteeRotationResponse is the response type for the
+// Query/CovenantCommitteeRotation RPC method.
+type QueryCovenantCommitteeRotationResponse struct {
+	// rotation is the scheduled rotation of the covenant committee
+	Rotation *CovenantCommitteeRotation `protobuf:"bytes,1,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	// retiring_covenant_pks are the members of the current covenant committee
+	// that are not in the new covenant committee
+	RetiringCovenantPks []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,rep,name=retiring_covenant_pks,json=retiringCovenantPks,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"retiring_covenant_pks,omitempty"`
 }
 
-func (m *BTCDelegationResponse) GetStartHeight() uint64 {
-	if m != nil {
-		return m.StartHeight
-	}
-	return 0
+func (m *QueryCovenantCommitteeRotationResponse) Reset() {
+	*m = QueryCovenantCommitteeRotationResponse{}
 }
-
-func (m *BTCDelegationResponse) GetEndHeight() uint64 {
-	if m != nil {
-		return m.EndHeight
+func (m *QueryCovenantCommitteeRotationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantCommitteeRotationResponse) ProtoMessage()    {}
+func (*QueryCovenantCommitteeRotationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{27}
+}
+func (m *QueryCovenantCommitteeRotationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantCommitteeRotationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantCommitteeRotationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *QueryCovenantCommitteeRotationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantCommitteeRotationResponse.Merge(m, src)
+}
+func (m *QueryCovenantCommitteeRotationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantCommitteeRotationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantCommitteeRotationResponse.DiscardUnknown(m)
 }
 
-func (m *BTCDelegationResponse) GetTotalSat() uint64 {
+var xxx_messageInfo_QueryCovenantCommitteeRotationResponse proto.InternalMessageInfo
+
+func (m *QueryCovenantCommitteeRotationResponse) GetRotation() *CovenantCommitteeRotation {
 	if m != nil {
-		return m.TotalSat
+		return m.Rotation
 	}
-	return 0
+	return nil
 }
 
-func (m *BTCDelegationResponse) GetStakingTxHex() string {
-	if m != nil {
-		return m.StakingTxHex
-	}
-	return ""
+// QueryCovenantMemberDelegationsRequest is the request type for the
+// Query/CovenantMemberDelegations RPC method.
+type QueryCovenantMemberDelegationsRequest struct {
+	// covenant_pk_hex is the hex str of the BTC PK of the covenant member
+	// the PK follows encoding in BIP-340 spec
+	CovenantPkHex string `protobuf:"bytes,1,opt,name=covenant_pk_hex,json=covenantPkHex,proto3" json:"covenant_pk_hex,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *BTCDelegationResponse) GetSlashingTxHex() string {
+func (m *QueryCovenantMemberDelegationsRequest) Reset()         { *m = QueryCovenantMemberDelegationsRequest{} }
+func (m *QueryCovenantMemberDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantMemberDelegationsRequest) ProtoMessage()    {}
+func (*QueryCovenantMemberDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{28}
+}
+func (m *QueryCovenantMemberDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantMemberDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantMemberDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCovenantMemberDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantMemberDelegationsRequest.Merge(m, src)
+}
+func (m *QueryCovenantMemberDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantMemberDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantMemberDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCovenantMemberDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryCovenantMemberDelegationsRequest) GetCovenantPkHex() string {
+	if m != nil {
+		return m.CovenantPkHex
+	}
+	return ""
+}
+
+func (m *QueryCovenantMemberDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCovenantMemberDelegationsResponse is the response type for the
+// Query/CovenantMemberDelegations RPC method.
+type QueryCovenantMemberDelegationsResponse struct {
+	// btc_delegations contains all the pending BTC delegations whose covenant
+	// committee includes the queried covenant member
+	BtcDelegations []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCovenantMemberDelegationsResponse) Reset() {
+	*m = QueryCovenantMemberDelegationsResponse{}
+}
+func (m *QueryCovenantMemberDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantMemberDelegationsResponse) ProtoMessage()    {}
+func (*QueryCovenantMemberDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{29}
+}
+func (m *QueryCovenantMemberDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantMemberDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantMemberDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCovenantMemberDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantMemberDelegationsResponse.Merge(m, src)
+}
+func (m *QueryCovenantMemberDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantMemberDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantMemberDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCovenantMemberDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryCovenantMemberDelegationsResponse) GetBtcDelegations() []*BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegations
+	}
+	return nil
+}
+
+func (m *QueryCovenantMemberDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// BTCDelegationResponse is the client needed information from a BTCDelegation with the current status based on parameters.
+type BTCDelegationResponse struct {
+	// staker_addr is the address to receive rewards from BTC delegation.
+	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+	// btc_pk is the Bitcoin secp256k1 PK of this BTC delegation
+	// the PK follows encoding in BIP-340 spec
+	BtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
+	// fp_btc_pk_list is the list of BIP-340 PKs of the finality providers that
+	// this BTC delegation delegates to
+	FpBtcPkList []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,3,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk_list,omitempty"`
+	// start_height is the start BTC height of the BTC delegation
+	// it is the start BTC height of the timelock
+	StartHeight uint64 `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the end height of the BTC delegation
+	// it is the end BTC height of the timelock - w
+	EndHeight uint64 `protobuf:"varint,5,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// total_sat is the total amount of BTC stakes in this delegation
+	// quantified in satoshi
+	TotalSat uint64 `protobuf:"varint,6,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
+	// staking_tx_hex is the hex string of staking tx
+	StakingTxHex string `protobuf:"bytes,7,opt,name=staking_tx_hex,json=stakingTxHex,proto3" json:"staking_tx_hex,omitempty"`
+	// slashing_tx_hex is the hex string of slashing tx
+	SlashingTxHex string `protobuf:"bytes,8,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
+	// delegator_slash_sig_hex is the signature on the slashing tx
+	// by the delegator (i.e., SK corresponding to btc_pk) as string hex.
+	// It will be a part of the witness for the staking tx output.
+	DelegatorSlashSigHex string `protobuf:"bytes,9,opt,name=delegator_slash_sig_hex,json=delegatorSlashSigHex,proto3" json:"delegator_slash_sig_hex,omitempty"`
+	// covenant_sigs is a list of adaptor signatures on the slashing tx
+	// by each covenant member
+	// It will be a part of the witness for the staking tx output.
+	CovenantSigs []*CovenantAdaptorSignatures `protobuf:"bytes,10,rep,name=covenant_sigs,json=covenantSigs,proto3" json:"covenant_sigs,omitempty"`
+	// staking_output_idx is the index of the staking output in the staking tx
+	StakingOutputIdx uint32 `protobuf:"varint,11,opt,name=staking_output_idx,json=stakingOutputIdx,proto3" json:"staking_output_idx,omitempty"`
+	// whether this delegation is active
+	Active bool `protobuf:"varint,12,opt,name=active,proto3" json:"active,omitempty"`
+	// descriptive status of current delegation.
+	StatusDesc string `protobuf:"bytes,13,opt,name=status_desc,json=statusDesc,proto3" json:"status_desc,omitempty"`
+	// unbonding_time used in unbonding output timelock path and in slashing transactions
+	// change outputs
+	UnbondingTime uint32 `protobuf:"varint,14,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
+	// undelegation_response is the undelegation info of this delegation.
+	UndelegationResponse *BTCUndelegationResponse `protobuf:"bytes,15,opt,name=undelegation_response,json=undelegationResponse,proto3" json:"undelegation_response,omitempty"`
+	// params version used to validate delegation
+	ParamsVersion uint32 `protobuf:"varint,16,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+}
+
+func (m *BTCDelegationResponse) Reset()         { *m = BTCDelegationResponse{} }
+func (m *BTCDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegationResponse) ProtoMessage()    {}
+func (*BTCDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{30}
+}
+func (m *BTCDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegationResponse.Merge(m, src)
+}
+func (m *BTCDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCDelegationResponse proto.InternalMessageInfo
+
+func (m *BTCDelegationResponse) GetStakerAddr() string {
+	if m != nil {
+		return m.StakerAddr
+	}
+	return ""
+}
+
+func (m *BTCDelegationResponse) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *BTCDelegationResponse) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *BTCDelegationResponse) GetTotalSat() uint64 {
+	if m != nil {
+		return m.TotalSat
+	}
+	return 0
+}
+
+func (m *BTCDelegationResponse) GetStakingTxHex() string {
+	if m != nil {
+		return m.StakingTxHex
+	}
+	return ""
+}
+
+func (m *BTCDelegationResponse) GetSlashingTxHex() string {
 	if m != nil {
 		return m.SlashingTxHex
 	}
@@ -1362,7 +1823,7 @@ func (m *BTCUndelegationResponse) Reset()         { *m = BTCUndelegationResponse
 func (m *BTCUndelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*BTCUndelegationResponse) ProtoMessage()    {}
 func (*BTCUndelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{23}
+	return fileDescriptor_74d49d26f7429697, []int{31}
 }
 func (m *BTCUndelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1442,7 +1903,7 @@ func (m *BTCDelegatorDelegationsResponse) Reset()         { *m = BTCDelegatorDel
 func (m *BTCDelegatorDelegationsResponse) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegatorDelegationsResponse) ProtoMessage()    {}
 func (*BTCDelegatorDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{24}
+	return fileDescriptor_74d49d26f7429697, []int{32}
 }
 func (m *BTCDelegatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1481,7 +1942,7 @@ func (m *BTCDelegatorDelegationsResponse) GetDels() []*BTCDelegationResponse {
 // FinalityProviderResponse defines a finality provider with voting power information.
 type FinalityProviderResponse struct {
 	// description defines the description terms for the finality provider.
-	Description *types.Description `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Description *types1.Description `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
 	// commission defines the commission rate of the finality provider.
 	Commission *cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission,omitempty"`
 	// addr is the address to receive commission from delegations.
@@ -1506,13 +1967,28 @@ type FinalityProviderResponse struct {
 	VotingPower uint64 `protobuf:"varint,9,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
 	// sluggish defines whether the finality provider is detected sluggish
 	Sluggish bool `protobuf:"varint,10,opt,name=sluggish,proto3" json:"sluggish,omitempty"`
+	// jailed_until is the time until which the finality provider is jailed.
+	// if it's nil then the finality provider is not jailed
+	JailedUntil *time.Time `protobuf:"bytes,11,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until,omitempty"`
+	// max_rate defines the maximum commission rate which the finality provider
+	// can ever charge.
+	MaxRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=max_rate,json=maxRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_rate,omitempty"`
+	// max_change_rate defines the maximum daily increase of the finality
+	// provider's commission rate.
+	MaxChangeRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_rate,omitempty"`
+	// commission_update_time is the last time the commission rate was changed.
+	CommissionUpdateTime time.Time `protobuf:"bytes,14,opt,name=commission_update_time,json=commissionUpdateTime,proto3,stdtime" json:"commission_update_time"`
+	// consumer_id is the chain ID of the consumer chain that this finality
+	// provider provides finality to. If it's empty then the finality provider
+	// provides finality to Babylon
+	ConsumerId string `protobuf:"bytes,15,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
 func (m *FinalityProviderResponse) Reset()         { *m = FinalityProviderResponse{} }
 func (m *FinalityProviderResponse) String() string { return proto.CompactTextString(m) }
 func (*FinalityProviderResponse) ProtoMessage()    {}
 func (*FinalityProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{25}
+	return fileDescriptor_74d49d26f7429697, []int{33}
 }
 func (m *FinalityProviderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1541,7 +2017,7 @@ func (m *FinalityProviderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FinalityProviderResponse proto.InternalMessageInfo
 
-func (m *FinalityProviderResponse) GetDescription() *types.Description {
+func (m *FinalityProviderResponse) GetDescription() *types1.Description {
 	if m != nil {
 		return m.Description
 	}
@@ -1597,6 +2073,27 @@ func (m *FinalityProviderResponse) GetSluggish() bool {
 	return false
 }
 
+func (m *FinalityProviderResponse) GetJailedUntil() *time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return nil
+}
+
+func (m *FinalityProviderResponse) GetCommissionUpdateTime() time.Time {
+	if m != nil {
+		return m.CommissionUpdateTime
+	}
+	return time.Time{}
+}
+
+func (m *FinalityProviderResponse) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btcstaking.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btcstaking.v1.QueryParamsResponse")
@@ -1618,8 +2115,16 @@ func init() {
 	proto.RegisterType((*QueryActivatedHeightResponse)(nil), "babylon.btcstaking.v1.QueryActivatedHeightResponse")
 	proto.RegisterType((*QueryFinalityProviderDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsRequest")
 	proto.RegisterType((*QueryFinalityProviderDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsResponse")
+	proto.RegisterType((*QueryDelegatorDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryDelegatorDelegationsRequest")
+	proto.RegisterType((*QueryDelegatorDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryDelegatorDelegationsResponse")
+	proto.RegisterType((*DelegatorDelegationResponse)(nil), "babylon.btcstaking.v1.DelegatorDelegationResponse")
+	proto.RegisterType((*StakerRewardResponse)(nil), "babylon.btcstaking.v1.StakerRewardResponse")
 	proto.RegisterType((*QueryBTCDelegationRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationRequest")
 	proto.RegisterType((*QueryBTCDelegationResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationResponse")
+	proto.RegisterType((*QueryCovenantCommitteeRotationRequest)(nil), "babylon.btcstaking.v1.QueryCovenantCommitteeRotationRequest")
+	proto.RegisterType((*QueryCovenantCommitteeRotationResponse)(nil), "babylon.btcstaking.v1.QueryCovenantCommitteeRotationResponse")
+	proto.RegisterType((*QueryCovenantMemberDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryCovenantMemberDelegationsRequest")
+	proto.RegisterType((*QueryCovenantMemberDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryCovenantMemberDelegationsResponse")
 	proto.RegisterType((*BTCDelegationResponse)(nil), "babylon.btcstaking.v1.BTCDelegationResponse")
 	proto.RegisterType((*BTCUndelegationResponse)(nil), "babylon.btcstaking.v1.BTCUndelegationResponse")
 	proto.RegisterType((*BTCDelegatorDelegationsResponse)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegationsResponse")
@@ -1629,125 +2134,158 @@ func init() {
 func init() { proto.RegisterFile("babylon/btcstaking/v1/query.proto", fileDescriptor_74d49d26f7429697) }
 
 var fileDescriptor_74d49d26f7429697 = []byte{
-	// 1884 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4b, 0x6c, 0xdb, 0xc8,
-	0x19, 0x0e, 0x6d, 0x45, 0xb1, 0x7f, 0xd9, 0x8e, 0x33, 0xeb, 0x24, 0x8c, 0x1c, 0xdb, 0x09, 0x9b,
-	0x4d, 0x9c, 0x97, 0x18, 0x2b, 0xde, 0x14, 0xed, 0x76, 0x37, 0xb1, 0xec, 0xdd, 0x24, 0xbb, 0x31,
-	0xa2, 0xd2, 0x49, 0x0b, 0x74, 0x8b, 0x12, 0x14, 0x39, 0xa2, 0x88, 0xc8, 0x24, 0xc3, 0x19, 0xb9,
-	0x32, 0x02, 0x5f, 0x7a, 0xe8, 0xad, 0x40, 0x81, 0xf6, 0xda, 0xf3, 0x16, 0xe8, 0xb1, 0x39, 0x15,
-	0xe8, 0x7d, 0x7b, 0x5b, 0x64, 0x0f, 0x2d, 0xf6, 0x10, 0x14, 0x49, 0xd1, 0x02, 0x05, 0x7a, 0xed,
-	0xb9, 0xe0, 0xcc, 0x50, 0xa4, 0x24, 0x52, 0x0f, 0xc7, 0xbd, 0x89, 0x33, 0xff, 0xfb, 0xf1, 0xcd,
-	0xcc, 0x2f, 0xb8, 0x58, 0x33, 0x6a, 0xfb, 0x4d, 0xcf, 0x55, 0x6b, 0xd4, 0x24, 0xd4, 0x78, 0xe6,
-	0xb8, 0xb6, 0xba, 0xb7, 0xa6, 0x3e, 0x6f, 0xe1, 0x60, 0xbf, 0xe4, 0x07, 0x1e, 0xf5, 0xd0, 0x69,
-	0x41, 0x52, 0x8a, 0x49, 0x4a, 0x7b, 0x6b, 0xc5, 0x05, 0xdb, 0xb3, 0x3d, 0x46, 0xa1, 0x86, 0xbf,
-	0x38, 0x71, 0xf1, 0xbc, 0xed, 0x79, 0x76, 0x13, 0xab, 0x86, 0xef, 0xa8, 0x86, 0xeb, 0x7a, 0xd4,
-	0xa0, 0x8e, 0xe7, 0x12, 0xb1, 0x7b, 0xce, 0xf4, 0xc8, 0xae, 0x47, 0x74, 0xce, 0xc6, 0x3f, 0xc4,
-	0xd6, 0x25, 0xfe, 0xa5, 0xc6, 0x46, 0xd4, 0x30, 0x35, 0xd6, 0xa2, 0x6f, 0x41, 0x75, 0x4d, 0x50,
-	0xd5, 0x0c, 0x82, 0xb9, 0x91, 0x1d, 0x42, 0xdf, 0xb0, 0x1d, 0x97, 0x69, 0x13, 0xb4, 0x4a, 0xba,
-	0x6b, 0xbe, 0x11, 0x18, 0xbb, 0x91, 0xd6, 0xcb, 0xe9, 0x34, 0x09, 0x4f, 0x39, 0xdd, 0x4a, 0x86,
-	0x2c, 0xcf, 0xe7, 0x04, 0xca, 0x02, 0xa0, 0x1f, 0x86, 0xe6, 0x54, 0x99, 0x74, 0x0d, 0x3f, 0x6f,
-	0x61, 0x42, 0x15, 0x0d, 0xde, 0xeb, 0x5a, 0x25, 0xbe, 0xe7, 0x12, 0x8c, 0x3e, 0x84, 0x3c, 0xb7,
-	0x42, 0x96, 0x2e, 0x48, 0xab, 0x85, 0xf2, 0x52, 0x29, 0x35, 0xc4, 0x25, 0xce, 0x56, 0xc9, 0x7d,
-	0xf5, 0x7a, 0xe5, 0x98, 0x26, 0x58, 0x94, 0xef, 0xc2, 0x62, 0x42, 0x66, 0x65, 0xff, 0x47, 0x38,
-	0x20, 0x8e, 0xe7, 0x0a, 0x95, 0x48, 0x86, 0x13, 0x7b, 0x7c, 0x85, 0x09, 0x9f, 0xd5, 0xa2, 0x4f,
-	0xe5, 0x0b, 0x38, 0x9f, 0xce, 0x78, 0x14, 0x56, 0xd9, 0xb0, 0xc4, 0x84, 0x7f, 0xea, 0xb8, 0x46,
-	0xd3, 0xa1, 0xfb, 0xd5, 0xc0, 0xdb, 0x73, 0x2c, 0x1c, 0x44, 0xa1, 0x40, 0x9f, 0x02, 0xc4, 0x19,
-	0x12, 0x1a, 0x2e, 0x97, 0x44, 0x09, 0x84, 0xe9, 0x2c, 0xf1, 0x9a, 0x13, 0xe9, 0x2c, 0x55, 0x0d,
-	0x1b, 0x0b, 0x5e, 0x2d, 0xc1, 0xa9, 0xfc, 0x45, 0x82, 0xe5, 0x2c, 0x4d, 0xc2, 0x91, 0x9f, 0x01,
-	0xaa, 0x8b, 0xcd, 0xb0, 0xd2, 0xf8, 0xae, 0x2c, 0x5d, 0x98, 0x5c, 0x2d, 0x94, 0xd5, 0x0c, 0xa7,
-	0x7a, 0xa5, 0x45, 0xc2, 0xb4, 0x53, 0xf5, 0x5e, 0x3d, 0xe8, 0x7e, 0x97, 0x2b, 0x13, 0xcc, 0x95,
-	0x2b, 0x43, 0x5d, 0x11, 0xf2, 0x92, 0xbe, 0x6c, 0x88, 0x8c, 0xf4, 0x2b, 0xe7, 0x31, 0xbb, 0x08,
-	0xb3, 0x75, 0x5f, 0xaf, 0x51, 0x53, 0xf7, 0x9f, 0xe9, 0x0d, 0xdc, 0x66, 0x61, 0x9b, 0xd6, 0xa0,
-	0xee, 0x57, 0xa8, 0x59, 0x7d, 0xf6, 0x00, 0xb7, 0x95, 0x83, 0x8c, 0xb8, 0x77, 0x82, 0xf1, 0x53,
-	0x38, 0xd5, 0x17, 0x0c, 0x11, 0xfe, 0xb1, 0x63, 0x31, 0xdf, 0x1b, 0x0b, 0xe5, 0xf7, 0x12, 0x14,
-	0x99, 0xfe, 0xca, 0x93, 0xcd, 0x2d, 0xdc, 0xc4, 0x36, 0x6f, 0xf7, 0xc8, 0x81, 0x0a, 0xe4, 0x09,
-	0x35, 0x68, 0x8b, 0x97, 0xd4, 0x5c, 0xf9, 0x5a, 0x86, 0xc6, 0x2e, 0xee, 0x1d, 0xc6, 0xa1, 0x09,
-	0xce, 0x9e, 0xc2, 0x99, 0x38, 0x74, 0xe1, 0xfc, 0x59, 0x12, 0x8d, 0xd3, 0x6b, 0xaa, 0x08, 0xd4,
-	0x53, 0x38, 0x19, 0x46, 0xda, 0x8a, 0xb7, 0x44, 0xc9, 0xdc, 0x18, 0xc5, 0xe8, 0x4e, 0x8c, 0xe6,
-	0x6a, 0xd4, 0x4c, 0x88, 0x3f, 0xba, 0x62, 0xa9, 0xc3, 0xd5, 0xd4, 0x4c, 0x57, 0xbd, 0x9f, 0xe3,
-	0x60, 0x83, 0x3e, 0xc0, 0x8e, 0xdd, 0xa0, 0xa3, 0x57, 0x0e, 0x3a, 0x03, 0xf9, 0x06, 0xe3, 0x61,
-	0x46, 0xe5, 0x34, 0xf1, 0xa5, 0x3c, 0x86, 0x6b, 0xa3, 0xe8, 0x11, 0x51, 0xbb, 0x08, 0x33, 0x7b,
-	0x1e, 0x75, 0x5c, 0x5b, 0xf7, 0xc3, 0x7d, 0xa6, 0x27, 0xa7, 0x15, 0xf8, 0x1a, 0x63, 0x51, 0xb6,
-	0x61, 0x35, 0x55, 0xe0, 0x66, 0x2b, 0x08, 0xb0, 0x4b, 0x19, 0xd1, 0x18, 0x15, 0x9f, 0x15, 0x87,
-	0x6e, 0x71, 0xc2, 0xbc, 0xd8, 0x49, 0x29, 0xe9, 0x64, 0x9f, 0xd9, 0x13, 0xfd, 0x66, 0xff, 0x4a,
-	0x82, 0xeb, 0x4c, 0xd1, 0x86, 0x49, 0x9d, 0x3d, 0xdc, 0x07, 0x37, 0xbd, 0x21, 0xcf, 0x52, 0x75,
-	0x54, 0xf5, 0xfb, 0x57, 0x09, 0x6e, 0x8c, 0x66, 0xcf, 0x11, 0xc2, 0xe0, 0x8f, 0x1d, 0xda, 0xd8,
-	0xc6, 0xd4, 0xf8, 0xbf, 0xc2, 0xe0, 0x92, 0x68, 0x4c, 0xe6, 0x98, 0x41, 0xb1, 0xd5, 0x15, 0x58,
-	0xe5, 0x8e, 0x40, 0xc9, 0xbe, 0xed, 0xc1, 0x39, 0x56, 0x7e, 0x2b, 0xc1, 0x95, 0xd4, 0x4a, 0x49,
-	0x01, 0xaa, 0x11, 0xfa, 0xe5, 0xa8, 0xf2, 0xf8, 0x2f, 0x29, 0xa3, 0x1f, 0xd2, 0x40, 0x29, 0x80,
-	0x73, 0x09, 0x50, 0xf2, 0x82, 0x14, 0x78, 0xba, 0x33, 0x14, 0x9e, 0xbc, 0x34, 0xd1, 0xda, 0xd9,
-	0x18, 0xa8, 0xba, 0x08, 0x8e, 0x2e, 0xaf, 0x9f, 0xc1, 0xb9, 0x7e, 0xc0, 0x8d, 0x22, 0x7e, 0x13,
-	0xde, 0x13, 0xc6, 0xea, 0xb4, 0xad, 0x37, 0x0c, 0xd2, 0x48, 0xc4, 0x7d, 0x5e, 0x6c, 0x3d, 0x69,
-	0x3f, 0x30, 0x48, 0x23, 0xec, 0xfa, 0xe7, 0x69, 0xe7, 0x4c, 0x27, 0x4c, 0x3b, 0x30, 0xd7, 0x8d,
-	0xdd, 0xe2, 0x84, 0x1b, 0x0f, 0xba, 0x67, 0xbb, 0xa0, 0x5b, 0xf9, 0x26, 0x0f, 0xa7, 0xd3, 0xd5,
-	0x7d, 0x0f, 0x0a, 0xa1, 0x30, 0x1c, 0xe8, 0x86, 0x65, 0x71, 0xcc, 0x9b, 0xae, 0xc8, 0xaf, 0x5e,
-	0xde, 0x5c, 0x10, 0x51, 0xda, 0xb0, 0xac, 0x00, 0x13, 0xb2, 0x43, 0x03, 0xc7, 0xb5, 0x35, 0xe0,
-	0xc4, 0xe1, 0x22, 0xda, 0x86, 0x3c, 0xaf, 0x32, 0x16, 0xd8, 0x99, 0xca, 0x9d, 0x6f, 0x5f, 0xaf,
-	0x94, 0x6d, 0x87, 0x36, 0x5a, 0xb5, 0x92, 0xe9, 0xed, 0xaa, 0xc2, 0x5e, 0xb3, 0x61, 0x38, 0x6e,
-	0xf4, 0xa1, 0xd2, 0x7d, 0x1f, 0x93, 0x52, 0xe5, 0x61, 0xf5, 0xf6, 0xfa, 0xad, 0x6a, 0xab, 0xf6,
-	0x39, 0xde, 0xd7, 0x8e, 0xd7, 0xc2, 0xba, 0x44, 0x5f, 0xc0, 0x5c, 0x5c, 0xb7, 0x4d, 0x87, 0x50,
-	0x79, 0xf2, 0xc2, 0xe4, 0x3b, 0x88, 0x2d, 0x88, 0x82, 0x7f, 0xe4, 0xb0, 0xa6, 0x98, 0x21, 0xd4,
-	0x08, 0xa8, 0x2e, 0xda, 0x2b, 0xc7, 0x41, 0x92, 0xad, 0xf1, 0x1e, 0x44, 0x4b, 0x00, 0xd8, 0xb5,
-	0x22, 0x82, 0xe3, 0x8c, 0x60, 0x1a, 0xbb, 0xa2, 0x45, 0xd1, 0x22, 0x4c, 0x53, 0x8f, 0x1a, 0x4d,
-	0x9d, 0x18, 0x54, 0xce, 0xb3, 0xdd, 0x29, 0xb6, 0xb0, 0x63, 0x50, 0x74, 0x09, 0xe6, 0x92, 0x15,
-	0x80, 0xdb, 0xf2, 0x09, 0x96, 0xfc, 0x99, 0x38, 0xf9, 0xb8, 0x8d, 0x2e, 0xc3, 0x49, 0xd2, 0x34,
-	0x48, 0x23, 0x41, 0x36, 0xc5, 0xc8, 0x66, 0xa3, 0x65, 0x4e, 0xf7, 0x01, 0x9c, 0x8d, 0xbb, 0x84,
-	0x6d, 0xe9, 0xc4, 0xb1, 0x19, 0xfd, 0x34, 0xa3, 0x5f, 0xe8, 0x6c, 0xef, 0x84, 0xbb, 0x3b, 0x8e,
-	0x1d, 0xb2, 0x3d, 0x85, 0x59, 0xd3, 0xdb, 0xc3, 0xae, 0xe1, 0xd2, 0x90, 0x9e, 0xc8, 0xc0, 0x9a,
-	0xea, 0x56, 0x46, 0xe1, 0x6c, 0x0a, 0xda, 0x0d, 0xcb, 0xf0, 0x43, 0x49, 0x8e, 0xed, 0x1a, 0xb4,
-	0x15, 0x60, 0xa2, 0xcd, 0x44, 0x62, 0x76, 0x1c, 0x9b, 0xa0, 0x1b, 0x80, 0x22, 0xdf, 0xbc, 0x16,
-	0xf5, 0x5b, 0x54, 0x77, 0xac, 0xb6, 0x5c, 0x60, 0x17, 0xf2, 0xa8, 0xb8, 0x1f, 0xb3, 0x8d, 0x87,
-	0x16, 0x3b, 0x8a, 0x0d, 0x06, 0xea, 0xf2, 0xcc, 0x05, 0x69, 0x75, 0x4a, 0x13, 0x5f, 0x68, 0x85,
-	0xd5, 0x19, 0x6d, 0x11, 0xdd, 0xc2, 0xc4, 0x94, 0x67, 0x39, 0x26, 0xf1, 0xa5, 0x2d, 0x4c, 0x4c,
-	0xf4, 0x3e, 0xcc, 0xb5, 0xdc, 0x9a, 0xe7, 0x5a, 0x2c, 0x3a, 0xce, 0x2e, 0x96, 0xe7, 0x98, 0x8a,
-	0xd9, 0xce, 0xea, 0x13, 0x67, 0x17, 0x23, 0x13, 0x4e, 0xb7, 0xdc, 0xb8, 0x39, 0xf4, 0x40, 0x14,
-	0xb2, 0x7c, 0x92, 0x75, 0x49, 0x29, 0xbb, 0x4b, 0x9e, 0x26, 0xd8, 0x3a, 0x7d, 0xb2, 0xd0, 0x4a,
-	0x59, 0x0d, 0x6d, 0xe1, 0x6f, 0x01, 0x3d, 0x7a, 0x7f, 0xcc, 0x73, 0x5b, 0xf8, 0xaa, 0x78, 0x6d,
-	0x28, 0x2f, 0x27, 0xe1, 0x6c, 0x86, 0x60, 0xb4, 0x0a, 0xf3, 0x09, 0x77, 0xda, 0x09, 0x40, 0x88,
-	0xdd, 0xe4, 0xd9, 0xfe, 0x08, 0x16, 0xe3, 0x6c, 0xc7, 0x3c, 0x51, 0xc6, 0x27, 0x18, 0x93, 0xdc,
-	0x21, 0x79, 0x1a, 0x51, 0x88, 0xac, 0x9b, 0xb0, 0xd8, 0xc9, 0x7a, 0x37, 0x77, 0xa7, 0x87, 0x0a,
-	0xe5, 0x4b, 0x19, 0x61, 0xe9, 0x24, 0xfd, 0xa1, 0x5b, 0xf7, 0x34, 0x39, 0x12, 0x94, 0xd4, 0xc1,
-	0xda, 0x27, 0xa5, 0x72, 0x73, 0x69, 0x95, 0xfb, 0x21, 0x14, 0x7b, 0x2a, 0x37, 0xe9, 0xca, 0x71,
-	0xc6, 0x72, 0xb6, 0xbb, 0x78, 0x63, 0x4f, 0xea, 0x70, 0x26, 0xae, 0xdf, 0x04, 0x2f, 0x91, 0xf3,
-	0x87, 0x2c, 0xe4, 0x85, 0x4e, 0x21, 0xc7, 0x9a, 0x88, 0x62, 0xc2, 0xca, 0x90, 0x03, 0x05, 0xdd,
-	0x83, 0x9c, 0x85, 0x9b, 0x87, 0xbb, 0x35, 0x33, 0x4e, 0xe5, 0xcb, 0x1c, 0xc8, 0x99, 0x0f, 0x99,
-	0x4f, 0xa0, 0x10, 0x76, 0x41, 0xe0, 0xf8, 0x09, 0x80, 0xff, 0x4e, 0x74, 0x2e, 0xc5, 0x1a, 0xf8,
-	0xa1, 0xb4, 0x15, 0x93, 0x6a, 0x49, 0x3e, 0xb4, 0x0d, 0x60, 0x7a, 0xbb, 0xbb, 0x0e, 0x21, 0xd1,
-	0xe9, 0x36, 0x5d, 0xb9, 0xf9, 0xed, 0xeb, 0x95, 0x45, 0x2e, 0x88, 0x58, 0xcf, 0x4a, 0x8e, 0xa7,
-	0xee, 0x1a, 0xb4, 0x51, 0x7a, 0x84, 0x6d, 0xc3, 0xdc, 0xdf, 0xc2, 0xe6, 0xab, 0x97, 0x37, 0x41,
-	0xe8, 0xd9, 0xc2, 0xa6, 0x96, 0x10, 0x80, 0x6e, 0x40, 0x8e, 0x9d, 0x01, 0x93, 0x43, 0xce, 0x00,
-	0x46, 0x95, 0x40, 0xff, 0xdc, 0x51, 0xa0, 0xff, 0x47, 0x30, 0xe9, 0x7b, 0x3e, 0x2b, 0x91, 0x42,
-	0xf9, 0x7a, 0xd6, 0x73, 0x3d, 0xf0, 0xbc, 0xfa, 0xe3, 0x7a, 0xd5, 0x23, 0x04, 0x33, 0x9b, 0x2b,
-	0x4f, 0x36, 0xb5, 0x90, 0x0f, 0xad, 0xc3, 0x19, 0x56, 0x32, 0xd8, 0xd2, 0x05, 0x6b, 0x04, 0xe4,
-	0x1c, 0xaa, 0x17, 0xc4, 0x6e, 0x85, 0x6f, 0x0a, 0x4c, 0x0f, 0xa1, 0x2d, 0xe2, 0xa2, 0x66, 0xc4,
-	0x71, 0x82, 0x71, 0xcc, 0x47, 0x1c, 0xd4, 0x14, 0xd4, 0xf1, 0xe5, 0x6c, 0x6a, 0xe0, 0x05, 0x7c,
-	0xba, 0xef, 0x02, 0x8e, 0x8a, 0x30, 0x45, 0x9a, 0x2d, 0xdb, 0x76, 0x48, 0x43, 0x06, 0x86, 0x8b,
-	0x9d, 0xef, 0xf2, 0xef, 0x4e, 0xc1, 0x71, 0x76, 0x1f, 0x40, 0xbf, 0x94, 0x20, 0xcf, 0x27, 0x12,
-	0xe8, 0x6a, 0x46, 0x04, 0xfa, 0x07, 0x33, 0xc5, 0x6b, 0xa3, 0x90, 0xf2, 0xc2, 0x53, 0xde, 0xff,
-	0xc5, 0x37, 0xff, 0xf8, 0xcd, 0xc4, 0x0a, 0x5a, 0x52, 0x07, 0x0d, 0x94, 0xd0, 0x1f, 0x24, 0x38,
-	0xd9, 0x33, 0x5a, 0x41, 0xe5, 0xe1, 0x6a, 0x7a, 0x07, 0x38, 0xc5, 0xdb, 0x63, 0xf1, 0x08, 0x1b,
-	0x55, 0x66, 0xe3, 0x55, 0x74, 0x65, 0xa0, 0x8d, 0xea, 0x0b, 0x01, 0xcd, 0x07, 0xe8, 0x8f, 0x12,
-	0x9c, 0xea, 0x7b, 0x42, 0xa0, 0xf5, 0x41, 0xba, 0xb3, 0x46, 0x3b, 0xc5, 0x0f, 0xc6, 0xe4, 0x12,
-	0x36, 0xaf, 0x31, 0x9b, 0xaf, 0xa3, 0xab, 0x19, 0x36, 0xf7, 0x3f, 0x5e, 0xd0, 0x2b, 0x09, 0xe6,
-	0x7b, 0x05, 0xa2, 0xdb, 0xe3, 0xa8, 0x8f, 0x6c, 0x5e, 0x1f, 0x8f, 0x49, 0x98, 0xbc, 0xc3, 0x4c,
-	0xde, 0x46, 0x9f, 0x8f, 0x6c, 0xb2, 0xfa, 0xa2, 0xeb, 0x5d, 0x71, 0xd0, 0x4f, 0x82, 0xbe, 0x94,
-	0x60, 0xae, 0x7b, 0x26, 0x81, 0xd6, 0x06, 0x59, 0x97, 0x3a, 0x6a, 0x29, 0x96, 0xc7, 0x61, 0x11,
-	0xee, 0x94, 0x98, 0x3b, 0xab, 0xe8, 0xb2, 0x9a, 0x39, 0x06, 0x4d, 0x3e, 0x38, 0xd0, 0x3f, 0x25,
-	0x58, 0x19, 0xf2, 0xfa, 0x44, 0x95, 0x41, 0x76, 0x8c, 0xf6, 0x94, 0x2e, 0x6e, 0xbe, 0x93, 0x0c,
-	0xe1, 0xdc, 0xf7, 0x99, 0x73, 0xeb, 0xa8, 0x3c, 0x46, 0xae, 0x38, 0x38, 0x1d, 0xa0, 0xff, 0x4a,
-	0xb0, 0x34, 0x70, 0xfe, 0x81, 0xee, 0x8d, 0x53, 0x3f, 0x69, 0x23, 0x9a, 0xe2, 0xc6, 0x3b, 0x48,
-	0x10, 0x2e, 0x56, 0x99, 0x8b, 0x9f, 0xa1, 0x07, 0x87, 0x2f, 0x47, 0x86, 0xbe, 0xb1, 0xe3, 0xff,
-	0x96, 0xe0, 0xfc, 0xa0, 0xc1, 0x0a, 0xba, 0x3b, 0x8e, 0xd5, 0x29, 0x13, 0x9e, 0xe2, 0xbd, 0xc3,
-	0x0b, 0x10, 0x5e, 0xdf, 0x67, 0x5e, 0x6f, 0xa0, 0xbb, 0xef, 0xe8, 0x35, 0x43, 0xec, 0x9e, 0xa1,
-	0xc2, 0x60, 0xc4, 0x4e, 0x1f, 0x50, 0x0c, 0x46, 0xec, 0x8c, 0xa9, 0xc5, 0x50, 0xc4, 0x36, 0x22,
-	0x3e, 0x71, 0xc2, 0xa2, 0xff, 0x48, 0xb0, 0x38, 0x60, 0x64, 0x80, 0x3e, 0x1e, 0x27, 0xb0, 0x29,
-	0x00, 0x72, 0xf7, 0xd0, 0xfc, 0xc2, 0xa3, 0x6d, 0xe6, 0xd1, 0x7d, 0xf4, 0xc9, 0xe1, 0xf3, 0x92,
-	0x04, 0x9b, 0x3f, 0x49, 0x30, 0xdb, 0x85, 0x5b, 0xe8, 0xd6, 0xc8, 0x10, 0x17, 0xf9, 0xb4, 0x36,
-	0x06, 0x87, 0xf0, 0x62, 0x8b, 0x79, 0xf1, 0x31, 0xfa, 0xc1, 0x68, 0x98, 0xa8, 0xbe, 0x48, 0x99,
-	0x62, 0x1c, 0x54, 0x1e, 0x7d, 0xf5, 0x66, 0x59, 0xfa, 0xfa, 0xcd, 0xb2, 0xf4, 0xf7, 0x37, 0xcb,
-	0xd2, 0xaf, 0xdf, 0x2e, 0x1f, 0xfb, 0xfa, 0xed, 0xf2, 0xb1, 0xbf, 0xbd, 0x5d, 0x3e, 0xf6, 0x93,
-	0xa1, 0xd7, 0xbd, 0x76, 0x52, 0x21, 0xbb, 0xfb, 0xd5, 0xf2, 0xec, 0x3f, 0xa6, 0xdb, 0xff, 0x0b,
-	0x00, 0x00, 0xff, 0xff, 0x33, 0x28, 0x24, 0xee, 0xad, 0x1b, 0x00, 0x00,
+	// 2402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x0f, 0x6d, 0xc5, 0x1f, 0x4f, 0xb6, 0xe3, 0x9d, 0x38, 0x89, 0x22, 0xc7, 0x1f, 0xe1, 0xe6,
+	0xc3, 0xf9, 0x92, 0x62, 0x25, 0x9b, 0x6d, 0x9b, 0xcd, 0x26, 0x96, 0x9d, 0xcf, 0x8d, 0x11, 0x95,
+	0xb6, 0x5b, 0x60, 0xb7, 0x28, 0x31, 0x22, 0xc7, 0x14, 0xd7, 0x12, 0xa9, 0x70, 0x46, 0x8e, 0x8c,
+	0x20, 0x97, 0x1e, 0x0a, 0xb4, 0x40, 0xd1, 0x05, 0xba, 0x45, 0xff, 0x84, 0x1e, 0x7a, 0x6c, 0xd0,
+	0x43, 0x81, 0xde, 0xd3, 0xdb, 0x22, 0xdb, 0xa2, 0xc5, 0x1e, 0xd2, 0x22, 0x69, 0xbb, 0x40, 0x81,
+	0x5e, 0x7b, 0x2e, 0x38, 0x1c, 0x8a, 0x94, 0x44, 0xea, 0x2b, 0xde, 0x43, 0x6f, 0xe2, 0xcc, 0xfb,
+	0xfa, 0xbd, 0x79, 0xf3, 0xde, 0xd3, 0x1b, 0x38, 0x59, 0xc4, 0xc5, 0xbd, 0xb2, 0x6d, 0x65, 0x8b,
+	0x4c, 0xa3, 0x0c, 0xef, 0x98, 0x96, 0x91, 0xdd, 0x5d, 0xce, 0x3e, 0xae, 0x11, 0x67, 0x2f, 0x53,
+	0x75, 0x6c, 0x66, 0xa3, 0x23, 0x82, 0x24, 0x13, 0x90, 0x64, 0x76, 0x97, 0xd3, 0x33, 0x86, 0x6d,
+	0xd8, 0x9c, 0x22, 0xeb, 0xfe, 0xf2, 0x88, 0xd3, 0x27, 0x0c, 0xdb, 0x36, 0xca, 0x24, 0x8b, 0xab,
+	0x66, 0x16, 0x5b, 0x96, 0xcd, 0x30, 0x33, 0x6d, 0x8b, 0x8a, 0xdd, 0x05, 0xb1, 0xcb, 0xbf, 0x8a,
+	0xb5, 0xed, 0x2c, 0x33, 0x2b, 0x84, 0x32, 0x5c, 0xa9, 0x0a, 0x82, 0xe3, 0x9a, 0x4d, 0x2b, 0x36,
+	0x55, 0x3d, 0xb9, 0xde, 0x87, 0xd8, 0x3a, 0xe5, 0x7d, 0x65, 0x03, 0x2b, 0x8b, 0x84, 0xe1, 0x65,
+	0xff, 0x5b, 0x50, 0x9d, 0x17, 0x54, 0x45, 0x4c, 0x89, 0x87, 0xa2, 0x41, 0x58, 0xc5, 0x86, 0x69,
+	0x71, 0x73, 0x04, 0xad, 0x1c, 0x8d, 0xbd, 0x8a, 0x1d, 0x5c, 0xf1, 0xb5, 0x9e, 0x89, 0xa6, 0x09,
+	0xbe, 0x7c, 0x64, 0x31, 0xb2, 0x6c, 0x1f, 0x59, 0xc3, 0xd1, 0xa6, 0xa5, 0x11, 0x8b, 0x99, 0xbb,
+	0x24, 0xf8, 0xe5, 0x91, 0xc8, 0x33, 0x80, 0xbe, 0xeb, 0x5a, 0x5c, 0xe0, 0x06, 0x28, 0xe4, 0x71,
+	0x8d, 0x50, 0x26, 0x2b, 0x70, 0xb8, 0x69, 0x95, 0x56, 0x6d, 0x8b, 0x12, 0x74, 0x1d, 0x46, 0x3c,
+	0x43, 0x53, 0xd2, 0xa2, 0xb4, 0x94, 0xcc, 0xcd, 0x65, 0x22, 0x8f, 0x29, 0xe3, 0xb1, 0xe5, 0x13,
+	0x2f, 0x5e, 0x2d, 0x1c, 0x50, 0x04, 0x8b, 0xfc, 0x3e, 0xcc, 0x86, 0x64, 0xe6, 0xf7, 0xbe, 0x47,
+	0x1c, 0x6a, 0xda, 0x96, 0x50, 0x89, 0x52, 0x30, 0xba, 0xeb, 0xad, 0x70, 0xe1, 0x93, 0x8a, 0xff,
+	0x29, 0x7f, 0x02, 0x27, 0xa2, 0x19, 0xf7, 0xc3, 0x2a, 0x03, 0xe6, 0xb8, 0xf0, 0x3b, 0xa6, 0x85,
+	0xcb, 0x26, 0xdb, 0x2b, 0x38, 0xf6, 0xae, 0xa9, 0x13, 0xc7, 0x77, 0x05, 0xba, 0x03, 0x10, 0x1c,
+	0xa2, 0xd0, 0x70, 0x26, 0x23, 0xa2, 0xc4, 0x3d, 0xf1, 0x8c, 0x17, 0xb7, 0xe2, 0xc4, 0x33, 0x05,
+	0x6c, 0x10, 0xc1, 0xab, 0x84, 0x38, 0xe5, 0x3f, 0x4a, 0x30, 0x1f, 0xa7, 0x49, 0x00, 0xf9, 0x21,
+	0xa0, 0x6d, 0xb1, 0xa9, 0x56, 0xfd, 0xdd, 0x94, 0xb4, 0x38, 0xbc, 0x94, 0xcc, 0x65, 0x63, 0x40,
+	0xb5, 0x4a, 0xf3, 0x85, 0x29, 0xef, 0x6c, 0xb7, 0xea, 0x41, 0x77, 0x9b, 0xa0, 0x0c, 0x71, 0x28,
+	0x67, 0xbb, 0x42, 0x11, 0xf2, 0xc2, 0x58, 0x56, 0xc4, 0x89, 0xb4, 0x2b, 0xf7, 0x7c, 0x76, 0x12,
+	0x26, 0xb7, 0xab, 0x6a, 0x91, 0x69, 0x6a, 0x75, 0x47, 0x2d, 0x91, 0x3a, 0x77, 0xdb, 0xb8, 0x02,
+	0xdb, 0xd5, 0x3c, 0xd3, 0x0a, 0x3b, 0xf7, 0x48, 0x5d, 0x7e, 0x16, 0xe3, 0xf7, 0x86, 0x33, 0x7e,
+	0x00, 0xef, 0xb4, 0x39, 0x43, 0xb8, 0xbf, 0x6f, 0x5f, 0x4c, 0xb7, 0xfa, 0x42, 0xfe, 0xa7, 0x04,
+	0x69, 0xae, 0x3f, 0xbf, 0xb9, 0xba, 0x46, 0xca, 0xc4, 0xf0, 0x52, 0x86, 0x0f, 0x20, 0x0f, 0x23,
+	0x94, 0x61, 0x56, 0xf3, 0x42, 0x6a, 0x2a, 0x77, 0x3e, 0x46, 0x63, 0x13, 0xf7, 0x06, 0xe7, 0x50,
+	0x04, 0x27, 0xba, 0x13, 0xe1, 0xed, 0x01, 0x02, 0x07, 0x5d, 0x87, 0x34, 0xa9, 0x57, 0x4d, 0xc7,
+	0xb4, 0x0c, 0xf5, 0x89, 0xc9, 0x4a, 0xa6, 0xc5, 0x3d, 0x5b, 0x2c, 0xdb, 0xda, 0x0e, 0x4d, 0x0d,
+	0x2f, 0x4a, 0x4b, 0x09, 0xe5, 0x98, 0x4f, 0xf1, 0x7d, 0x4e, 0x90, 0x67, 0x5a, 0x9e, 0x6f, 0xcb,
+	0x7f, 0x90, 0xc4, 0xad, 0x6b, 0xc5, 0x29, 0xbc, 0xbc, 0x05, 0x87, 0x5c, 0x61, 0x7a, 0xb0, 0x25,
+	0xe2, 0xed, 0x62, 0x2f, 0x88, 0x1b, 0x0e, 0x9e, 0x2a, 0x32, 0x2d, 0x24, 0x7e, 0xff, 0x22, 0x6d,
+	0x1b, 0xce, 0x45, 0x86, 0x49, 0xc1, 0x7e, 0x42, 0x9c, 0x15, 0x76, 0x8f, 0x98, 0x46, 0x89, 0xf5,
+	0x1e, 0x76, 0xe8, 0x28, 0x8c, 0x94, 0x38, 0x0f, 0x37, 0x2a, 0xa1, 0x88, 0x2f, 0xf9, 0x11, 0x9c,
+	0xef, 0x45, 0x8f, 0xf0, 0xda, 0x49, 0x98, 0xd8, 0xb5, 0x99, 0x7b, 0x20, 0x55, 0x77, 0x9f, 0xeb,
+	0x49, 0x28, 0x49, 0x6f, 0x8d, 0xb3, 0xc8, 0xeb, 0xb0, 0x14, 0x29, 0x70, 0xb5, 0xe6, 0x38, 0xc4,
+	0x62, 0x9c, 0xa8, 0x8f, 0xeb, 0x12, 0xe7, 0x87, 0x66, 0x71, 0xc2, 0xbc, 0x00, 0xa4, 0x14, 0x06,
+	0xd9, 0x66, 0xf6, 0x50, 0xbb, 0xd9, 0x3f, 0x93, 0xe0, 0x02, 0x57, 0xb4, 0xa2, 0xb9, 0x45, 0xa2,
+	0x55, 0x1d, 0x6d, 0x75, 0x79, 0x9c, 0xaa, 0x7d, 0x0a, 0x7e, 0xf9, 0x2f, 0x12, 0x5c, 0xec, 0xcd,
+	0x9e, 0x7d, 0xcc, 0xa1, 0xee, 0x25, 0x5a, 0x27, 0x0c, 0x7f, 0xa3, 0x39, 0x74, 0x0e, 0x66, 0x03,
+	0x60, 0x98, 0x11, 0xbd, 0xc9, 0xb1, 0xf2, 0x35, 0x38, 0x11, 0xbd, 0xdd, 0xf9, 0x8c, 0xe5, 0xcf,
+	0x25, 0x38, 0x1b, 0x19, 0x29, 0x11, 0x59, 0xae, 0x87, 0xfb, 0xb2, 0x5f, 0xe7, 0xf8, 0xb5, 0x04,
+	0x4b, 0xdd, 0xcd, 0x12, 0xd8, 0x1c, 0x38, 0x1e, 0x4a, 0x4a, 0xb6, 0x13, 0x91, 0x9e, 0xae, 0x75,
+	0x4d, 0x4f, 0x76, 0x94, 0x68, 0xe5, 0x58, 0x90, 0xa8, 0x9a, 0x08, 0xf6, 0xef, 0x5c, 0x7f, 0x2e,
+	0xc1, 0x22, 0x47, 0x1a, 0x6d, 0x87, 0xe7, 0xf9, 0x77, 0x61, 0x4a, 0x27, 0xe5, 0x76, 0xd7, 0x27,
+	0x75, 0x52, 0xde, 0x77, 0xdf, 0xff, 0x64, 0x08, 0x4e, 0x76, 0xb0, 0x48, 0x38, 0x7d, 0x13, 0x92,
+	0xed, 0x6e, 0xce, 0xc5, 0xb8, 0x39, 0x42, 0x52, 0xc3, 0x19, 0x61, 0x31, 0xe8, 0x36, 0x8c, 0x3a,
+	0xe4, 0x09, 0x76, 0x74, 0x9a, 0x1a, 0xe2, 0x12, 0x2f, 0xc4, 0x48, 0xdc, 0x60, 0x78, 0xc7, 0x4d,
+	0x61, 0x2e, 0x6d, 0x43, 0x94, 0xcf, 0xdb, 0x72, 0x3a, 0xc3, 0x83, 0x9f, 0xce, 0x2f, 0x25, 0x98,
+	0xed, 0x60, 0x3c, 0xda, 0x80, 0xa9, 0xe6, 0x7a, 0x28, 0x5a, 0x8e, 0xfe, 0xca, 0xe1, 0x64, 0x53,
+	0x39, 0xec, 0x25, 0xef, 0x7e, 0x2e, 0xc1, 0x4c, 0x94, 0x0b, 0xd0, 0xb7, 0x21, 0x49, 0xf9, 0xba,
+	0x8a, 0x75, 0xdd, 0xab, 0x34, 0xe3, 0xf9, 0xd4, 0xcb, 0xe7, 0x97, 0x66, 0x04, 0xfa, 0x15, 0x5d,
+	0x77, 0x08, 0xa5, 0x1b, 0xcc, 0x2d, 0xff, 0x0a, 0x78, 0xc4, 0xee, 0x22, 0x5a, 0x81, 0x09, 0xcf,
+	0x7f, 0xaa, 0x81, 0x6b, 0x06, 0x11, 0x11, 0x34, 0xdf, 0x40, 0x12, 0xfc, 0x15, 0xf0, 0x74, 0xde,
+	0x75, 0xa9, 0x94, 0xa4, 0x13, 0x7c, 0xc8, 0x0f, 0xe0, 0x78, 0x7b, 0xf7, 0xe0, 0x07, 0xf1, 0x25,
+	0x38, 0x2c, 0x3c, 0xa1, 0xb2, 0xba, 0x5a, 0xc2, 0xb4, 0x14, 0x8a, 0xe4, 0x69, 0xb1, 0xb5, 0x59,
+	0xbf, 0x87, 0x69, 0xc9, 0x2d, 0x61, 0x8f, 0xa3, 0x3a, 0xae, 0x6f, 0xd4, 0xf1, 0xf2, 0x59, 0x38,
+	0xcd, 0x55, 0xae, 0xda, 0xbb, 0xc4, 0xc2, 0x16, 0x5b, 0xb5, 0x2b, 0x15, 0x93, 0x31, 0x42, 0x14,
+	0x9b, 0x85, 0xa1, 0xc8, 0xaf, 0x25, 0x38, 0xd3, 0x8d, 0x52, 0x18, 0xfa, 0x10, 0xc6, 0x1c, 0x9b,
+	0x85, 0x4d, 0xbc, 0x1c, 0x63, 0x62, 0xbc, 0xac, 0x86, 0x04, 0xf4, 0x29, 0x1c, 0x71, 0x08, 0xf3,
+	0x9a, 0x3b, 0x4d, 0xd0, 0xab, 0xd5, 0x1d, 0xef, 0xb6, 0x4c, 0xe4, 0xaf, 0x7d, 0xf5, 0x6a, 0x21,
+	0x67, 0x98, 0xac, 0x54, 0x2b, 0x66, 0x34, 0xbb, 0x92, 0x15, 0x8a, 0xb4, 0x12, 0x36, 0x2d, 0xff,
+	0x23, 0xcb, 0xf6, 0xaa, 0x84, 0x66, 0xf2, 0xf7, 0x0b, 0x57, 0xae, 0x5e, 0x2e, 0xd4, 0x8a, 0x1f,
+	0x91, 0x3d, 0xe5, 0xb0, 0x2f, 0xd4, 0xb7, 0xa1, 0xb0, 0x43, 0xe5, 0x5f, 0x49, 0x2d, 0xee, 0x58,
+	0x27, 0x95, 0x62, 0x64, 0x61, 0x38, 0x03, 0x87, 0x42, 0xc6, 0x84, 0x4e, 0x75, 0x52, 0x6b, 0xc8,
+	0xdb, 0xcf, 0x0c, 0xf5, 0xa2, 0xd5, 0xfd, 0x11, 0x96, 0xfd, 0x9f, 0x34, 0xac, 0x5f, 0x8e, 0xc0,
+	0x91, 0xe8, 0x08, 0x7f, 0x8b, 0x9b, 0xbc, 0x0e, 0x23, 0x5e, 0xa9, 0xe0, 0x96, 0x0d, 0x1e, 0x16,
+	0x07, 0x8b, 0x6e, 0x6d, 0x41, 0x9f, 0xc0, 0x54, 0x50, 0xf7, 0xcb, 0x26, 0x65, 0xa9, 0xe1, 0xb7,
+	0x8a, 0xb6, 0xa4, 0x68, 0x18, 0x1e, 0x9a, 0xbc, 0xa9, 0x98, 0xa0, 0x0c, 0x3b, 0x4c, 0x15, 0xed,
+	0x49, 0xc2, 0x4b, 0x76, 0x7c, 0xcd, 0xeb, 0x61, 0xd0, 0x1c, 0x00, 0xb1, 0x74, 0x9f, 0xe0, 0x20,
+	0x27, 0x18, 0x27, 0x96, 0x68, 0x71, 0xd0, 0x2c, 0x8c, 0x33, 0x9b, 0xe1, 0xb2, 0x4a, 0x31, 0x4b,
+	0x8d, 0xf0, 0xdd, 0x31, 0xbe, 0xb0, 0x81, 0x19, 0x3a, 0x05, 0x53, 0xe1, 0xa4, 0x43, 0xea, 0xa9,
+	0x51, 0x1e, 0x99, 0x13, 0x41, 0xbe, 0x21, 0x75, 0x37, 0x80, 0x69, 0x19, 0xd3, 0x52, 0x88, 0x6c,
+	0xcc, 0x0b, 0x60, 0x7f, 0xd9, 0xa3, 0x7b, 0x0f, 0x8e, 0x05, 0x5d, 0x06, 0xdf, 0x52, 0xa9, 0x69,
+	0x70, 0xfa, 0x71, 0x4e, 0x3f, 0xd3, 0xd8, 0xde, 0x70, 0x77, 0x37, 0x4c, 0xc3, 0x65, 0xdb, 0x82,
+	0xc6, 0x45, 0x70, 0xe9, 0x69, 0x0a, 0x16, 0x87, 0x7b, 0x48, 0x04, 0x2b, 0x3a, 0xae, 0xba, 0x92,
+	0x4c, 0xc3, 0xc2, 0xac, 0xe6, 0x10, 0xaa, 0x4c, 0xf8, 0x62, 0x36, 0x4c, 0x83, 0xa2, 0x8b, 0x80,
+	0x7c, 0x6c, 0x76, 0x8d, 0x55, 0x6b, 0x4c, 0x35, 0xf5, 0x7a, 0x2a, 0xc9, 0xa7, 0x21, 0x7e, 0x3e,
+	0x7d, 0xc4, 0x37, 0xee, 0xeb, 0xfc, 0xaf, 0x0c, 0xe6, 0x4d, 0x71, 0x6a, 0x62, 0x51, 0x5a, 0x1a,
+	0x53, 0xc4, 0x17, 0x5a, 0xe0, 0x71, 0xc6, 0x6a, 0x54, 0xd5, 0x09, 0xd5, 0x52, 0x93, 0x5e, 0x4f,
+	0xe7, 0x2d, 0xad, 0x11, 0xaa, 0xa1, 0xd3, 0x30, 0x55, 0xb3, 0x8a, 0xb6, 0xa5, 0x73, 0xef, 0x98,
+	0x15, 0x92, 0x9a, 0xe2, 0x2a, 0x26, 0x1b, 0xab, 0x9b, 0x66, 0x85, 0x20, 0x0d, 0x8e, 0xd4, 0xac,
+	0xe0, 0x9e, 0xa9, 0x8e, 0x08, 0xe4, 0xd4, 0x21, 0x7e, 0x3b, 0x32, 0xf1, 0xf7, 0x6d, 0xcb, 0xd2,
+	0xdb, 0xc2, 0x5f, 0x99, 0xa9, 0x45, 0xac, 0xba, 0xb6, 0x78, 0x83, 0x18, 0xd5, 0x1f, 0xfe, 0x4c,
+	0x7b, 0xb6, 0x78, 0xab, 0x62, 0xd4, 0x23, 0x3f, 0x1f, 0x86, 0x63, 0x31, 0x82, 0xd1, 0x12, 0x4c,
+	0x87, 0xe0, 0xd4, 0x43, 0xd9, 0x2a, 0x80, 0xe9, 0x9d, 0xf6, 0x0d, 0x98, 0x0d, 0x4e, 0x3b, 0xe0,
+	0xf1, 0x4f, 0x7c, 0x88, 0x33, 0xa5, 0x1a, 0x24, 0x5b, 0x3e, 0x85, 0x38, 0x75, 0x0d, 0x66, 0x1b,
+	0xa7, 0xde, 0xcc, 0xdd, 0xb8, 0x43, 0xc9, 0xdc, 0xa9, 0xb8, 0xfe, 0xc6, 0x3f, 0xf4, 0xfb, 0xd6,
+	0xb6, 0xad, 0xa4, 0x7c, 0x41, 0x61, 0x1d, 0xfc, 0xfa, 0x44, 0x44, 0x6e, 0x22, 0x2a, 0x72, 0xaf,
+	0x43, 0xba, 0x25, 0x72, 0xc3, 0x50, 0x0e, 0x72, 0x96, 0x63, 0xcd, 0xc1, 0x1b, 0x20, 0xd9, 0x86,
+	0xa3, 0x41, 0xfc, 0x86, 0x78, 0x69, 0x6a, 0x64, 0xc0, 0x40, 0x9e, 0x69, 0x04, 0x72, 0xa0, 0x89,
+	0xca, 0x1a, 0x2c, 0x74, 0x69, 0xc8, 0xd1, 0x2d, 0x48, 0xe8, 0xa4, 0x3c, 0x58, 0x12, 0xe7, 0x9c,
+	0xf2, 0x4f, 0x47, 0x21, 0x15, 0x3b, 0x45, 0xba, 0xed, 0x76, 0xb5, 0x54, 0x73, 0xcc, 0x6a, 0xa8,
+	0x60, 0xbf, 0xeb, 0x27, 0xf6, 0x40, 0x83, 0x97, 0xd5, 0xd7, 0x02, 0x52, 0x25, 0xcc, 0x87, 0xd6,
+	0x01, 0x34, 0xb7, 0x8a, 0x53, 0xea, 0x97, 0x87, 0xf1, 0xfc, 0xa5, 0xaf, 0x5e, 0x2d, 0xcc, 0x7a,
+	0x82, 0xa8, 0xbe, 0x93, 0x31, 0xed, 0x6c, 0x05, 0xb3, 0x52, 0xe6, 0x21, 0x31, 0xb0, 0xb6, 0xb7,
+	0x46, 0xb4, 0x97, 0xcf, 0x2f, 0x81, 0xd0, 0xb3, 0x46, 0x34, 0x25, 0x24, 0x00, 0x5d, 0x84, 0x04,
+	0xaf, 0x01, 0xc3, 0x5d, 0x6a, 0x40, 0x02, 0x37, 0x67, 0xff, 0xc4, 0x7e, 0x64, 0xff, 0x1b, 0x30,
+	0x5c, 0xb5, 0xab, 0x3c, 0x44, 0xe2, 0xdb, 0xf1, 0x82, 0x63, 0xdb, 0xdb, 0x8f, 0xb6, 0x0b, 0x36,
+	0xa5, 0x84, 0xdb, 0x9c, 0xdf, 0x5c, 0x55, 0x5c, 0x3e, 0x74, 0x15, 0x8e, 0xf2, 0x90, 0x21, 0xba,
+	0x2a, 0x58, 0xfd, 0x44, 0xee, 0xa5, 0xea, 0x19, 0xb1, 0x9b, 0xf7, 0x36, 0x45, 0x4e, 0x77, 0x53,
+	0x9b, 0xcf, 0xc5, 0x34, 0x9f, 0x63, 0x94, 0x73, 0x4c, 0xfb, 0x1c, 0x4c, 0x13, 0xd4, 0xc1, 0x9f,
+	0xdb, 0xb1, 0x8e, 0x03, 0x8c, 0xf1, 0xb6, 0x46, 0x1a, 0xa5, 0x61, 0x8c, 0x96, 0x6b, 0x86, 0x61,
+	0xd2, 0x52, 0x0a, 0x78, 0x5e, 0x6c, 0x7c, 0xa3, 0x55, 0x98, 0xf8, 0x14, 0x9b, 0x65, 0xa2, 0xab,
+	0x35, 0x8b, 0x99, 0x65, 0x9e, 0x59, 0x93, 0xb9, 0x74, 0xc6, 0x7b, 0x20, 0xc8, 0xf8, 0x0f, 0x04,
+	0x99, 0x4d, 0xff, 0x81, 0x20, 0x9f, 0xf8, 0xec, 0x6f, 0x0b, 0x92, 0x92, 0xf4, 0xb8, 0xb6, 0x5c,
+	0x26, 0x74, 0x0f, 0xc6, 0x2a, 0xb8, 0xae, 0x3a, 0x98, 0x79, 0x89, 0xb7, 0xef, 0x40, 0x18, 0xad,
+	0xe0, 0xba, 0x82, 0x19, 0x6f, 0x65, 0x5c, 0x49, 0x5a, 0x09, 0x5b, 0x06, 0xf1, 0x04, 0x4e, 0x0e,
+	0x22, 0x70, 0xb2, 0x82, 0xeb, 0xab, 0x5c, 0x08, 0x17, 0xfb, 0x31, 0x1c, 0x0d, 0x42, 0x4d, 0xad,
+	0x55, 0x75, 0xcc, 0x48, 0x90, 0xe6, 0x3b, 0xe3, 0x1d, 0x73, 0x67, 0xe3, 0x1c, 0xf3, 0x4c, 0x20,
+	0x63, 0x8b, 0x8b, 0xe0, 0x35, 0x61, 0x01, 0x92, 0x9a, 0x6d, 0xd1, 0x5a, 0x85, 0x38, 0xaa, 0xa9,
+	0xf3, 0x4a, 0x30, 0xae, 0x80, 0xbf, 0x74, 0x5f, 0xcf, 0xfd, 0xee, 0x28, 0x1c, 0xe4, 0x9d, 0x1c,
+	0xfa, 0xb1, 0x04, 0x23, 0xde, 0xc4, 0x1d, 0x9d, 0x8b, 0x09, 0xb2, 0xf6, 0x87, 0x87, 0xf4, 0xf9,
+	0x5e, 0x48, 0xbd, 0xbb, 0x2d, 0x9f, 0xfe, 0xd1, 0x97, 0xff, 0xf8, 0xc5, 0xd0, 0x02, 0x9a, 0xcb,
+	0x76, 0x7a, 0x53, 0x41, 0xbf, 0x91, 0xe0, 0x50, 0xcb, 0xd3, 0x01, 0xca, 0x75, 0x57, 0xd3, 0xfa,
+	0x40, 0x91, 0xbe, 0xd2, 0x17, 0x8f, 0xb0, 0x31, 0xcb, 0x6d, 0x3c, 0x87, 0xce, 0x76, 0xb4, 0x31,
+	0xfb, 0x54, 0x54, 0xbf, 0x67, 0xe8, 0xb7, 0x12, 0xbc, 0xd3, 0x36, 0xe5, 0x42, 0x57, 0x3b, 0xe9,
+	0x8e, 0x7b, 0xba, 0x48, 0xbf, 0xd7, 0x27, 0x97, 0xb0, 0x79, 0x99, 0xdb, 0x7c, 0x01, 0x9d, 0x8b,
+	0xb1, 0xb9, 0x7d, 0xbe, 0x86, 0x5e, 0x4a, 0x30, 0xdd, 0x2a, 0x10, 0x5d, 0xe9, 0x47, 0xbd, 0x6f,
+	0xf3, 0xd5, 0xfe, 0x98, 0x84, 0xc9, 0x1b, 0xdc, 0xe4, 0x75, 0xf4, 0x51, 0xcf, 0x26, 0x67, 0x9f,
+	0x36, 0x8d, 0xbe, 0x9e, 0xb5, 0x93, 0xa0, 0x5f, 0x4b, 0x30, 0xd5, 0x3c, 0x36, 0x47, 0xcb, 0x9d,
+	0xac, 0x8b, 0x7c, 0x4a, 0x48, 0xe7, 0xfa, 0x61, 0x11, 0x70, 0x32, 0x1c, 0xce, 0x12, 0x3a, 0x93,
+	0x8d, 0x7d, 0x09, 0x0c, 0xff, 0x03, 0x42, 0xff, 0x92, 0x60, 0xa1, 0xcb, 0x80, 0x14, 0xe5, 0x3b,
+	0xd9, 0xd1, 0xdb, 0xb4, 0x37, 0xbd, 0xfa, 0x56, 0x32, 0x04, 0xb8, 0xef, 0x70, 0x70, 0x57, 0x51,
+	0xae, 0x8f, 0xb3, 0xf2, 0xf2, 0xff, 0x33, 0xf4, 0x5f, 0x09, 0xe6, 0x3a, 0x8e, 0xe8, 0xd1, 0xad,
+	0x7e, 0xe2, 0x27, 0xea, 0x15, 0x21, 0xbd, 0xf2, 0x16, 0x12, 0x04, 0xc4, 0x02, 0x87, 0xf8, 0x00,
+	0xdd, 0x1b, 0x3c, 0x1c, 0x79, 0x81, 0x0b, 0x80, 0xff, 0x5b, 0x82, 0x13, 0x9d, 0x66, 0xff, 0xe8,
+	0x66, 0x3f, 0x56, 0x47, 0x3c, 0x42, 0xa4, 0x6f, 0x0d, 0x2e, 0x40, 0xa0, 0xbe, 0xcb, 0x51, 0xaf,
+	0xa0, 0x9b, 0x6f, 0x89, 0x9a, 0x67, 0xec, 0x96, 0xb9, 0x77, 0xe7, 0x8c, 0x1d, 0x3d, 0x43, 0x4f,
+	0x5f, 0xe9, 0x8b, 0xa7, 0xc7, 0x8c, 0x8d, 0x7d, 0x3e, 0xd1, 0xc4, 0xa0, 0xff, 0x48, 0x30, 0xdb,
+	0x61, 0xaa, 0x8d, 0x3e, 0xec, 0xc7, 0xb1, 0x11, 0x09, 0xe4, 0xe6, 0xc0, 0xfc, 0x02, 0xd1, 0x3a,
+	0x47, 0x74, 0x17, 0xdd, 0x1e, 0xfc, 0x5c, 0xc2, 0xc9, 0xe6, 0xcf, 0x12, 0xcc, 0x44, 0x8e, 0xd0,
+	0xdf, 0xef, 0x64, 0x68, 0x87, 0x69, 0x78, 0xfa, 0x5b, 0xfd, 0x33, 0x0a, 0x68, 0x0f, 0x38, 0xb4,
+	0x35, 0x94, 0xef, 0x9e, 0x28, 0x6d, 0x17, 0x56, 0xf3, 0xd0, 0xbd, 0x19, 0xd7, 0xef, 0x25, 0x98,
+	0x6c, 0xca, 0xc7, 0xe8, 0x72, 0xcf, 0xa9, 0xdb, 0x47, 0xb2, 0xdc, 0x07, 0x87, 0x80, 0xb0, 0xc6,
+	0x21, 0x7c, 0x88, 0x3e, 0xe8, 0x2d, 0xd7, 0x67, 0x9f, 0x46, 0xcc, 0x5c, 0x9f, 0xa1, 0x3f, 0x49,
+	0x70, 0x3c, 0x76, 0xde, 0x88, 0x3e, 0xe8, 0x64, 0x56, 0xb7, 0xe1, 0x68, 0xfa, 0xc6, 0x80, 0xdc,
+	0x3d, 0xe6, 0xfb, 0xc6, 0x3f, 0x51, 0xcd, 0x17, 0xa1, 0x36, 0xc6, 0xa3, 0x5f, 0x87, 0x60, 0xb5,
+	0xcd, 0x04, 0x7b, 0x83, 0x15, 0x37, 0xe4, 0x4c, 0xdf, 0x18, 0x90, 0xbb, 0xc7, 0x5b, 0xd5, 0x80,
+	0x55, 0xe1, 0x22, 0x68, 0xf6, 0x69, 0xcb, 0x48, 0xb5, 0x29, 0xfa, 0xf2, 0x0f, 0x5f, 0xbc, 0x9e,
+	0x97, 0xbe, 0x78, 0x3d, 0x2f, 0xfd, 0xfd, 0xf5, 0xbc, 0xf4, 0xd9, 0x9b, 0xf9, 0x03, 0x5f, 0xbc,
+	0x99, 0x3f, 0xf0, 0xd7, 0x37, 0xf3, 0x07, 0x3e, 0xee, 0xfa, 0x57, 0xaf, 0x1e, 0xd6, 0xcc, 0xff,
+	0xf7, 0x15, 0x47, 0x78, 0x6f, 0x7f, 0xe5, 0x7f, 0x03, 0x00, 0x1d, 0x18, 0xe7, 0x79, 0x6a, 0x25,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1783,8 +2321,15 @@ type QueryClient interface {
 	ActivatedHeight(ctx context.Context, in *QueryActivatedHeightRequest, opts ...grpc.CallOption) (*QueryActivatedHeightResponse, error)
 	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
 	FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error)
+	// DelegatorDelegations queries all BTC delegations of the given BTC delegator
+	// across all finality providers
+	DelegatorDelegations(ctx context.Context, in *QueryDelegatorDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorDelegationsResponse, error)
 	// BTCDelegation retrieves delegation by corresponding staking tx hash
 	BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error)
+	// CovenantCommitteeRotation queries the scheduled rotation of the covenant committee
+	CovenantCommitteeRotation(ctx context.Context, in *QueryCovenantCommitteeRotationRequest, opts ...grpc.CallOption) (*QueryCovenantCommitteeRotationResponse, error)
+	// CovenantMemberDelegations queries all pending BTC delegations that still depend on the given covenant member
+	CovenantMemberDelegations(ctx context.Context, in *QueryCovenantMemberDelegationsRequest, opts ...grpc.CallOption) (*QueryCovenantMemberDelegationsResponse, error)
 }
 
 type queryClient struct {
@@ -1885,6 +2430,15 @@ func (c *queryClient) FinalityProviderDelegations(ctx context.Context, in *Query
 	return out, nil
 }
 
+func (c *queryClient) DelegatorDelegations(ctx context.Context, in *QueryDelegatorDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorDelegationsResponse, error) {
+	out := new(QueryDelegatorDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/DelegatorDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error) {
 	out := new(QueryBTCDelegationResponse)
 	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegation", in, out, opts...)
@@ -1894,6 +2448,24 @@ func (c *queryClient) BTCDelegation(ctx context.Context, in *QueryBTCDelegationR
 	return out, nil
 }
 
+func (c *queryClient) CovenantCommitteeRotation(ctx context.Context, in *QueryCovenantCommitteeRotationRequest, opts ...grpc.CallOption) (*QueryCovenantCommitteeRotationResponse, error) {
+	out := new(QueryCovenantCommitteeRotationResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/CovenantCommitteeRotation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CovenantMemberDelegations(ctx context.Context, in *QueryCovenantMemberDelegationsRequest, opts ...grpc.CallOption) (*QueryCovenantMemberDelegationsResponse, error) {
+	out := new(QueryCovenantMemberDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/CovenantMemberDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -1917,8 +2489,15 @@ type QueryServer interface {
 	ActivatedHeight(context.Context, *QueryActivatedHeightRequest) (*QueryActivatedHeightResponse, error)
 	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
 	FinalityProviderDelegations(context.Context, *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error)
+	// DelegatorDelegations queries all BTC delegations of the given BTC delegator
+	// across all finality providers
+	DelegatorDelegations(context.Context, *QueryDelegatorDelegationsRequest) (*QueryDelegatorDelegationsResponse, error)
 	// BTCDelegation retrieves delegation by corresponding staking tx hash
 	BTCDelegation(context.Context, *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error)
+	// CovenantCommitteeRotation queries the scheduled rotation of the covenant committee
+	CovenantCommitteeRotation(context.Context, *QueryCovenantCommitteeRotationRequest) (*QueryCovenantCommitteeRotationResponse, error)
+	// CovenantMemberDelegations queries all pending BTC delegations that still depend on the given covenant member
+	CovenantMemberDelegations(context.Context, *QueryCovenantMemberDelegationsRequest) (*QueryCovenantMemberDelegationsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1955,9 +2534,18 @@ func (*UnimplementedQueryServer) ActivatedHeight(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) FinalityProviderDelegations(ctx context.Context, req *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderDelegations not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorDelegations(ctx context.Context, req *QueryDelegatorDelegationsRequest) (*QueryDelegatorDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorDelegations not implemented")
+}
 func (*UnimplementedQueryServer) BTCDelegation(ctx context.Context, req *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegation not implemented")
 }
+func (*UnimplementedQueryServer) CovenantCommitteeRotation(ctx context.Context, req *QueryCovenantCommitteeRotationRequest) (*QueryCovenantCommitteeRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CovenantCommitteeRotation not implemented")
+}
+func (*UnimplementedQueryServer) CovenantMemberDelegations(ctx context.Context, req *QueryCovenantMemberDelegationsRequest) (*QueryCovenantMemberDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CovenantMemberDelegations not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2143,6 +2731,24 @@ func _Query_FinalityProviderDelegations_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/DelegatorDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorDelegations(ctx, req.(*QueryDelegatorDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBTCDelegationRequest)
 	if err := dec(in); err != nil {
@@ -2161,30 +2767,66 @@ func _Query_BTCDelegation_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.btcstaking.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ParamsByVersion",
-			Handler:    _Query_ParamsByVersion_Handler,
-		},
-		{
-			MethodName: "FinalityProviders",
-			Handler:    _Query_FinalityProviders_Handler,
-		},
-		{
-			MethodName: "FinalityProvider",
-			Handler:    _Query_FinalityProvider_Handler,
-		},
-		{
-			MethodName: "BTCDelegations",
-			Handler:    _Query_BTCDelegations_Handler,
-		},
+func _Query_CovenantCommitteeRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCovenantCommitteeRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CovenantCommitteeRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/CovenantCommitteeRotation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CovenantCommitteeRotation(ctx, req.(*QueryCovenantCommitteeRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CovenantMemberDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCovenantMemberDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CovenantMemberDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/CovenantMemberDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CovenantMemberDelegations(ctx, req.(*QueryCovenantMemberDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.btcstaking.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ParamsByVersion",
+			Handler:    _Query_ParamsByVersion_Handler,
+		},
+		{
+			MethodName: "FinalityProviders",
+			Handler:    _Query_FinalityProviders_Handler,
+		},
+		{
+			MethodName: "FinalityProvider",
+			Handler:    _Query_FinalityProvider_Handler,
+		},
+		{
+			MethodName: "BTCDelegations",
+			Handler:    _Query_BTCDelegations_Handler,
+		},
 		{
 			MethodName: "ActiveFinalityProvidersAtHeight",
 			Handler:    _Query_ActiveFinalityProvidersAtHeight_Handler,
@@ -2205,10 +2847,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FinalityProviderDelegations",
 			Handler:    _Query_FinalityProviderDelegations_Handler,
 		},
+		{
+			MethodName: "DelegatorDelegations",
+			Handler:    _Query_DelegatorDelegations_Handler,
+		},
 		{
 			MethodName: "BTCDelegation",
 			Handler:    _Query_BTCDelegation_Handler,
 		},
+		{
+			MethodName: "CovenantCommitteeRotation",
+			Handler:    _Query_CovenantCommitteeRotation_Handler,
+		},
+		{
+			MethodName: "CovenantMemberDelegations",
+			Handler:    _Query_CovenantMemberDelegations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/btcstaking/v1/query.proto",
@@ -2500,6 +3154,11 @@ func (m *QueryBTCDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiringWithinBtcBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpiringWithinBtcBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -2926,7 +3585,7 @@ func (m *QueryFinalityProviderDelegationsResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBTCDelegationRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegatorDelegationsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2936,27 +3595,39 @@ func (m *QueryBTCDelegationRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBTCDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegatorDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBTCDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegatorDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.StakingTxHashHex) > 0 {
-		i -= len(m.StakingTxHashHex)
-		copy(dAtA[i:], m.StakingTxHashHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelBtcPkHex) > 0 {
+		i -= len(m.DelBtcPkHex)
+		copy(dAtA[i:], m.DelBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelBtcPkHex)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2966,19 +3637,19 @@ func (m *QueryBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BtcDelegation != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.BtcDelegation.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2986,12 +3657,40 @@ func (m *QueryBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *DelegatorDelegationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3001,26 +3700,24 @@ func (m *BTCDelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DelegatorDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DelegatorDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ParamsVersion != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
-		i--
-		dAtA[i] = 0x1
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
 		i--
-		dAtA[i] = 0x80
+		dAtA[i] = 0x10
 	}
-	if m.UndelegationResponse != nil {
+	if m.BtcDelegation != nil {
 		{
-			size, err := m.UndelegationResponse.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.BtcDelegation.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3028,106 +3725,38 @@ func (m *BTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x7a
-	}
-	if m.UnbondingTime != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingTime))
-		i--
-		dAtA[i] = 0x70
-	}
-	if len(m.StatusDesc) > 0 {
-		i -= len(m.StatusDesc)
-		copy(dAtA[i:], m.StatusDesc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusDesc)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.StakingOutputIdx != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StakingOutputIdx))
-		i--
-		dAtA[i] = 0x58
-	}
-	if len(m.CovenantSigs) > 0 {
-		for iNdEx := len(m.CovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if len(m.DelegatorSlashSigHex) > 0 {
-		i -= len(m.DelegatorSlashSigHex)
-		copy(dAtA[i:], m.DelegatorSlashSigHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashSigHex)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.SlashingTxHex) > 0 {
-		i -= len(m.SlashingTxHex)
-		copy(dAtA[i:], m.SlashingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.StakingTxHex) > 0 {
-		i -= len(m.StakingTxHex)
-		copy(dAtA[i:], m.StakingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHex)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.TotalSat != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.EndHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.StartHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0xa
 	}
-	if len(m.FpBtcPkList) > 0 {
-		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.FpBtcPkList[iNdEx].Size()
-				i -= size
-				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakerRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.BtcPk != nil {
+	return dAtA[:n], nil
+}
+
+func (m *StakerRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakerRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RewardGauge != nil {
 		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.RewardGauge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
@@ -3143,7 +3772,7 @@ func (m *BTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *BTCUndelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBTCDelegationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3153,76 +3782,62 @@ func (m *BTCUndelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BTCUndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBTCDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BTCUndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBTCDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CovenantSlashingSigs) > 0 {
-		for iNdEx := len(m.CovenantSlashingSigs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantSlashingSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.DelegatorSlashingSigHex) > 0 {
-		i -= len(m.DelegatorSlashingSigHex)
-		copy(dAtA[i:], m.DelegatorSlashingSigHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashingSigHex)))
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0xa
 	}
-	if len(m.SlashingTxHex) > 0 {
-		i -= len(m.SlashingTxHex)
-		copy(dAtA[i:], m.SlashingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
-		i--
-		dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CovenantUnbondingSigList) > 0 {
-		for iNdEx := len(m.CovenantUnbondingSigList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantUnbondingSigList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcDelegation != nil {
+		{
+			size, err := m.BtcDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x1a
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.DelegatorUnbondingSigHex) > 0 {
-		i -= len(m.DelegatorUnbondingSigHex)
-		copy(dAtA[i:], m.DelegatorUnbondingSigHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorUnbondingSigHex)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.UnbondingTxHex) > 0 {
-		i -= len(m.UnbondingTxHex)
-		copy(dAtA[i:], m.UnbondingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingTxHex)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BTCDelegatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCovenantCommitteeRotationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3232,34 +3847,20 @@ func (m *BTCDelegatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BTCDelegatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCovenantCommitteeRotationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BTCDelegatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCovenantCommitteeRotationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Dels) > 0 {
-		for iNdEx := len(m.Dels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Dels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCovenantCommitteeRotationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3269,49 +3870,33 @@ func (m *FinalityProviderResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCovenantCommitteeRotationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCovenantCommitteeRotationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Sluggish {
-		i--
-		if m.Sluggish {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.RetiringCovenantPks) > 0 {
+		for iNdEx := len(m.RetiringCovenantPks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.RetiringCovenantPks[iNdEx].Size()
+				i -= size
+				if _, err := m.RetiringCovenantPks[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.VotingPower != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.SlashedBtcHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBtcHeight))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.SlashedBabylonHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBabylonHeight))
-		i--
-		dAtA[i] = 0x30
 	}
-	if m.Pop != nil {
+	if m.Rotation != nil {
 		{
-			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Rotation.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3319,548 +3904,1964 @@ func (m *FinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0xa
 	}
-	if m.BtcPk != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantMemberDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantMemberDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantMemberDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
 		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x12
 	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+	if len(m.CovenantPkHex) > 0 {
+		i -= len(m.CovenantPkHex)
+		copy(dAtA[i:], m.CovenantPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CovenantPkHex)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
-	if m.Commission != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantMemberDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantMemberDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantMemberDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
 		{
-			size := m.Commission.Size()
-			i -= size
-			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Description != nil {
-		{
-			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *BTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryParamsByVersionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovQuery(uint64(m.Version))
-	}
-	return n
-}
-
-func (m *QueryParamsByVersionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryFinalityProvidersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.UndelegationResponse != nil {
+		{
+			size, err := m.UndelegationResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.UnbondingTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x70
 	}
-	return n
-}
-
-func (m *QueryFinalityProvidersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.StatusDesc) > 0 {
+		i -= len(m.StatusDesc)
+		copy(dAtA[i:], m.StatusDesc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusDesc)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	var l int
-	_ = l
-	if len(m.FinalityProviders) > 0 {
-		for _, e := range m.FinalityProviders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.StakingOutputIdx != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingOutputIdx))
+		i--
+		dAtA[i] = 0x58
 	}
-	return n
-}
-
-func (m *QueryFinalityProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.CovenantSigs) > 0 {
+		for iNdEx := len(m.CovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.DelegatorSlashSigHex) > 0 {
+		i -= len(m.DelegatorSlashSigHex)
+		copy(dAtA[i:], m.DelegatorSlashSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashSigHex)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	return n
-}
-
-func (m *QueryFinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.SlashingTxHex) > 0 {
+		i -= len(m.SlashingTxHex)
+		copy(dAtA[i:], m.SlashingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
+		i--
+		dAtA[i] = 0x42
 	}
-	var l int
-	_ = l
-	if m.FinalityProvider != nil {
-		l = m.FinalityProvider.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.StakingTxHex) > 0 {
+		i -= len(m.StakingTxHex)
+		copy(dAtA[i:], m.StakingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHex)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
-}
-
-func (m *QueryBTCDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.TotalSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-
-func (m *QueryBTCDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.FpBtcPkList) > 0 {
+		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.FpBtcPkList[iNdEx].Size()
+				i -= size
+				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	var l int
-	_ = l
-	if len(m.BtcDelegations) > 0 {
-		for _, e := range m.BtcDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFinalityProviderPowerAtHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+func (m *BTCUndelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFinalityProviderPowerAtHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
-	}
-	return n
+func (m *BTCUndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFinalityProviderCurrentPowerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BTCUndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryFinalityProviderCurrentPowerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
-	}
-	return n
-}
-
-func (m *QueryActiveFinalityProvidersAtHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if len(m.CovenantSlashingSigs) > 0 {
+		for iNdEx := len(m.CovenantSlashingSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantSlashingSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.DelegatorSlashingSigHex) > 0 {
+		i -= len(m.DelegatorSlashingSigHex)
+		copy(dAtA[i:], m.DelegatorSlashingSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashingSigHex)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *QueryActiveFinalityProvidersAtHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.SlashingTxHex) > 0 {
+		i -= len(m.SlashingTxHex)
+		copy(dAtA[i:], m.SlashingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if len(m.FinalityProviders) > 0 {
-		for _, e := range m.FinalityProviders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.CovenantUnbondingSigList) > 0 {
+		for iNdEx := len(m.CovenantUnbondingSigList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantUnbondingSigList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.DelegatorUnbondingSigHex) > 0 {
+		i -= len(m.DelegatorUnbondingSigHex)
+		copy(dAtA[i:], m.DelegatorUnbondingSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorUnbondingSigHex)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
-}
-
-func (m *QueryActivatedHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.UnbondingTxHex) > 0 {
+		i -= len(m.UnbondingTxHex)
+		copy(dAtA[i:], m.UnbondingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingTxHex)))
+		i--
+		dAtA[i] = 0xa
 	}
-	var l int
-	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryActivatedHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+func (m *BTCDelegatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *BTCDelegatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BTCDelegatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BtcDelegatorDelegations) > 0 {
-		for _, e := range m.BtcDelegatorDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Dels) > 0 {
+		for iNdEx := len(m.Dels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBTCDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StakingTxHashHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *FinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBTCDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BtcDelegation != nil {
-		l = m.BtcDelegation.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *FinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BTCDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *FinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.StakerAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CommissionUpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CommissionUpdateTime):])
+	if err21 != nil {
+		return 0, err21
 	}
-	if len(m.FpBtcPkList) > 0 {
-		for _, e := range m.FpBtcPkList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	i -= n21
+	i = encodeVarintQuery(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x72
+	if m.MaxChangeRate != nil {
+		{
+			size := m.MaxChangeRate.Size()
+			i -= size
+			if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	if m.StartHeight != 0 {
-		n += 1 + sovQuery(uint64(m.StartHeight))
+	if m.MaxRate != nil {
+		{
+			size := m.MaxRate.Size()
+			i -= size
+			if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.EndHeight != 0 {
-		n += 1 + sovQuery(uint64(m.EndHeight))
+	if m.JailedUntil != nil {
+		n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.JailedUntil):])
+		if err22 != nil {
+			return 0, err22
+		}
+		i -= n22
+		i = encodeVarintQuery(dAtA, i, uint64(n22))
+		i--
+		dAtA[i] = 0x5a
 	}
-	if m.TotalSat != 0 {
-		n += 1 + sovQuery(uint64(m.TotalSat))
+	if m.Sluggish {
+		i--
+		if m.Sluggish {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.StakingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.SlashingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.DelegatorSlashSigHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.SlashedBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBtcHeight))
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.CovenantSigs) > 0 {
-		for _, e := range m.CovenantSigs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.SlashedBabylonHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBabylonHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Pop != nil {
+		{
+			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.StakingOutputIdx != 0 {
-		n += 1 + sovQuery(uint64(m.StakingOutputIdx))
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Active {
-		n += 2
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.StatusDesc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Commission != nil {
+		{
+			size := m.Commission.Size()
+			i -= size
+			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.UnbondingTime != 0 {
-		n += 1 + sovQuery(uint64(m.UnbondingTime))
+	if m.Description != nil {
+		{
+			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.UndelegationResponse != nil {
-		l = m.UndelegationResponse.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	if m.ParamsVersion != 0 {
-		n += 2 + sovQuery(uint64(m.ParamsVersion))
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *BTCUndelegationResponse) Size() (n int) {
+func (m *QueryParamsByVersionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.UnbondingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
 	}
-	l = len(m.DelegatorUnbondingSigHex)
-	if l > 0 {
+	return n
+}
+
+func (m *QueryParamsByVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFinalityProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if len(m.CovenantUnbondingSigList) > 0 {
-		for _, e := range m.CovenantUnbondingSigList {
+	return n
+}
+
+func (m *QueryFinalityProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	l = len(m.SlashingTxHex)
-	if l > 0 {
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.DelegatorSlashingSigHex)
+	return n
+}
+
+func (m *QueryFinalityProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if len(m.CovenantSlashingSigs) > 0 {
-		for _, e := range m.CovenantSlashingSigs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *BTCDelegatorDelegationsResponse) Size() (n int) {
+func (m *QueryFinalityProviderResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Dels) > 0 {
-		for _, e := range m.Dels {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.FinalityProvider != nil {
+		l = m.FinalityProvider.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *FinalityProviderResponse) Size() (n int) {
+func (m *QueryBTCDelegationsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Description != nil {
-		l = m.Description.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
 	}
-	if m.Commission != nil {
-		l = m.Commission.Size()
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ExpiringWithinBtcBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.ExpiringWithinBtcBlocks))
 	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBTCDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.Pop != nil {
-		l = m.Pop.Size()
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.SlashedBabylonHeight != 0 {
-		n += 1 + sovQuery(uint64(m.SlashedBabylonHeight))
+	return n
+}
+
+func (m *QueryFinalityProviderPowerAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.SlashedBtcHeight != 0 {
-		n += 1 + sovQuery(uint64(m.SlashedBtcHeight))
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	if m.Height != 0 {
 		n += 1 + sovQuery(uint64(m.Height))
 	}
+	return n
+}
+
+func (m *QueryFinalityProviderPowerAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.VotingPower != 0 {
 		n += 1 + sovQuery(uint64(m.VotingPower))
 	}
-	if m.Sluggish {
-		n += 2
+	return n
+}
+
+func (m *QueryFinalityProviderCurrentPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderCurrentPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActivatedHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActivatedHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegatorDelegations) > 0 {
+		for _, e := range m.BtcDelegatorDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegatorDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcDelegation != nil {
+		l = m.BtcDelegation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func (m *StakerRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RewardGauge != nil {
+		l = m.RewardGauge.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcDelegation != nil {
+		l = m.BtcDelegation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantCommitteeRotationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCovenantCommitteeRotationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rotation != nil {
+		l = m.Rotation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RetiringCovenantPks) > 0 {
+		for _, e := range m.RetiringCovenantPks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCovenantMemberDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CovenantPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantMemberDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for _, e := range m.FpBtcPkList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.TotalSat != 0 {
+		n += 1 + sovQuery(uint64(m.TotalSat))
+	}
+	l = len(m.StakingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SlashingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorSlashSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantSigs) > 0 {
+		for _, e := range m.CovenantSigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StakingOutputIdx != 0 {
+		n += 1 + sovQuery(uint64(m.StakingOutputIdx))
+	}
+	if m.Active {
+		n += 2
+	}
+	l = len(m.StatusDesc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingTime))
+	}
+	if m.UndelegationResponse != nil {
+		l = m.UndelegationResponse.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ParamsVersion != 0 {
+		n += 2 + sovQuery(uint64(m.ParamsVersion))
+	}
+	return n
+}
+
+func (m *BTCUndelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UnbondingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorUnbondingSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantUnbondingSigList) > 0 {
+		for _, e := range m.CovenantUnbondingSigList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.SlashingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorSlashingSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantSlashingSigs) > 0 {
+		for _, e := range m.CovenantSlashingSigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BTCDelegatorDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dels) > 0 {
+		for _, e := range m.Dels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Description != nil {
+		l = m.Description.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SlashedBabylonHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SlashedBabylonHeight))
+	}
+	if m.SlashedBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SlashedBtcHeight))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	if m.Sluggish {
+		n += 2
+	}
+	if m.JailedUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.JailedUntil)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxRate != nil {
+		l = m.MaxRate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxChangeRate != nil {
+		l = m.MaxChangeRate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CommissionUpdateTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByVersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderResponse{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalityProvider == nil {
+				m.FinalityProvider = &FinalityProviderResponse{}
+			}
+			if err := m.FinalityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiringWithinBtcBlocks", wireType)
+			}
+			m.ExpiringWithinBtcBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiringWithinBtcBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3883,12 +5884,82 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBTCDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBTCDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3910,7 +5981,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderPowerAtHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3933,17 +6004,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3953,25 +6024,43 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3993,7 +6082,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderPowerAtHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4016,17 +6105,17 @@ func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByVersionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
-			m.Version = 0
+			m.VotingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4036,7 +6125,7 @@ func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				m.VotingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4062,7 +6151,7 @@ func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderCurrentPowerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4085,17 +6174,118 @@ func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByVersionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4105,25 +6295,11 @@ func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.VotingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4145,7 +6321,7 @@ func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4168,13 +6344,32 @@ func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProvidersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4231,7 +6426,7 @@ func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4254,10 +6449,10 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProvidersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4289,7 +6484,7 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderResponse{})
+			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderWithMeta{})
 			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -4351,7 +6546,7 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryActivatedHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4374,44 +6569,12 @@ func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActivatedHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActivatedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4433,7 +6596,7 @@ func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4456,17 +6619,17 @@ func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActivatedHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActivatedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProvider", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4476,28 +6639,11 @@ func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FinalityProvider == nil {
-				m.FinalityProvider = &FinalityProviderResponse{}
-			}
-			if err := m.FinalityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4519,7 +6665,7 @@ func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4542,17 +6688,17 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4562,11 +6708,24 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -4624,7 +6783,7 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4647,15 +6806,15 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegatorDelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4682,8 +6841,8 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
-			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BtcDelegatorDelegations = append(m.BtcDelegatorDelegations, &BTCDelegatorDelegationsResponse{})
+			if err := m.BtcDelegatorDelegations[len(m.BtcDelegatorDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4744,7 +6903,7 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderPowerAtHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4767,15 +6926,15 @@ func (m *QueryFinalityProviderPowerAtHeightRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelBtcPkHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4803,13 +6962,13 @@ func (m *QueryFinalityProviderPowerAtHeightRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			m.DelBtcPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4819,11 +6978,28 @@ func (m *QueryFinalityProviderPowerAtHeightRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4845,7 +7021,7 @@ func (m *QueryFinalityProviderPowerAtHeightRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryFinalityProviderPowerAtHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4868,17 +7044,17 @@ func (m *QueryFinalityProviderPowerAtHeightResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
-			m.VotingPower = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4888,66 +7064,65 @@ func (m *QueryFinalityProviderPowerAtHeightResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotingPower |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFinalityProviderCurrentPowerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Delegations = append(m.Delegations, &DelegatorDelegationResponse{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Rewards = append(m.Rewards, &StakerRewardResponse{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4957,23 +7132,27 @@ func (m *QueryFinalityProviderCurrentPowerRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4996,7 +7175,7 @@ func (m *QueryFinalityProviderCurrentPowerRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error {
+func (m *DelegatorDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5019,17 +7198,17 @@ func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegation", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5039,11 +7218,28 @@ func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BtcDelegation == nil {
+				m.BtcDelegation = &BTCDelegationResponse{}
+			}
+			if err := m.BtcDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
@@ -5084,7 +7280,7 @@ func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *StakerRewardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5107,17 +7303,17 @@ func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StakerRewardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StakerRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5127,14 +7323,27 @@ func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardGauge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5161,10 +7370,10 @@ func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.RewardGauge == nil {
+				m.RewardGauge = &types.RewardGauge{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardGauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5189,7 +7398,7 @@ func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5212,51 +7421,17 @@ func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBTCDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBTCDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderWithMeta{})
-			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5266,27 +7441,23 @@ func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5309,7 +7480,7 @@ func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryActivatedHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5332,12 +7503,48 @@ func (m *QueryActivatedHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivatedHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBTCDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivatedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BtcDelegation == nil {
+				m.BtcDelegation = &BTCDelegationResponse{}
+			}
+			if err := m.BtcDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5359,7 +7566,7 @@ func (m *QueryActivatedHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCovenantCommitteeRotationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5382,31 +7589,12 @@ func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivatedHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCovenantCommitteeRotationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivatedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCovenantCommitteeRotationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5428,7 +7616,7 @@ func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCovenantCommitteeRotationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5451,17 +7639,17 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCovenantCommitteeRotationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCovenantCommitteeRotationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5471,29 +7659,33 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			if m.Rotation == nil {
+				m.Rotation = &CovenantCommitteeRotation{}
+			}
+			if err := m.Rotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiringCovenantPks", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5503,25 +7695,24 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.RetiringCovenantPks = append(m.RetiringCovenantPks, v)
+			if err := m.RetiringCovenantPks[len(m.RetiringCovenantPks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5546,7 +7737,7 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCovenantMemberDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5569,17 +7760,17 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCovenantMemberDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCovenantMemberDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegatorDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantPkHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5589,25 +7780,23 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcDelegatorDelegations = append(m.BtcDelegatorDelegations, &BTCDelegatorDelegationsResponse{})
-			if err := m.BtcDelegatorDelegations[len(m.BtcDelegatorDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CovenantPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5639,7 +7828,7 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5666,7 +7855,7 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCovenantMemberDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5689,17 +7878,17 @@ func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCovenantMemberDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCovenantMemberDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5709,77 +7898,29 @@ func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5806,10 +7947,10 @@ func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BtcDelegation == nil {
-				m.BtcDelegation = &BTCDelegationResponse{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.BtcDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6707,7 +8848,7 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Description == nil {
-				m.Description = &types.Description{}
+				m.Description = &types1.Description{}
 			}
 			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6948,6 +9089,179 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Sluggish = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JailedUntil == nil {
+				m.JailedUntil = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxRate = &v
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxChangeRate = &v
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CommissionUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -48,6 +48,16 @@ type MsgCreateFinalityProvider struct {
 	BtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,4,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
 	// pop is the proof of possession of btc_pk over the FP signer address.
 	Pop *ProofOfPossessionBTC `protobuf:"bytes,5,opt,name=pop,proto3" json:"pop,omitempty"`
+	// max_rate defines the maximum commission rate which the finality provider
+	// can ever charge. It cannot be changed after creation.
+	MaxRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_rate,json=maxRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_rate,omitempty"`
+	// max_change_rate defines the maximum daily increase of the finality
+	// provider's commission rate. It cannot be changed after creation.
+	MaxChangeRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_rate,omitempty"`
+	// consumer_id is the chain ID of a registered consumer chain that the
+	// finality provider provides finality to. If it's empty then the finality
+	// provider provides finality to Babylon. It cannot be changed after creation.
+	ConsumerId string `protobuf:"bytes,8,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
 func (m *MsgCreateFinalityProvider) Reset()         { *m = MsgCreateFinalityProvider{} }
@@ -104,6 +114,13 @@ func (m *MsgCreateFinalityProvider) GetPop() *ProofOfPossessionBTC {
 	return nil
 }
 
+func (m *MsgCreateFinalityProvider) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
 // MsgCreateFinalityProviderResponse is the response for MsgCreateFinalityProvider
 type MsgCreateFinalityProviderResponse struct {
 }
@@ -415,40 +432,64 @@ func (m *MsgCreateBTCDelegationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateBTCDelegationResponse proto.InternalMessageInfo
 
-// MsgAddCovenantSigs is the message for handling signatures from a covenant member
-type MsgAddCovenantSigs struct {
-	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	// pk is the BTC public key of the covenant member
-	Pk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=pk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"pk,omitempty"`
-	// staking_tx_hash is the hash of the staking tx.
-	// It uniquely identifies a BTC delegation
-	StakingTxHash string `protobuf:"bytes,3,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
-	// sigs is a list of adaptor signatures of the covenant
-	// the order of sigs should respect the order of finality providers
-	// of the corresponding delegation
-	SlashingTxSigs [][]byte `protobuf:"bytes,4,rep,name=slashing_tx_sigs,json=slashingTxSigs,proto3" json:"slashing_tx_sigs,omitempty"`
-	// unbonding_tx_sig is the signature of the covenant on the unbonding tx submitted to babylon
-	// the signature follows encoding in BIP-340 spec
-	UnbondingTxSig *github_com_babylonchain_babylon_types.BIP340Signature `protobuf:"bytes,5,opt,name=unbonding_tx_sig,json=unbondingTxSig,proto3,customtype=github.com/babylonchain/babylon/types.BIP340Signature" json:"unbonding_tx_sig,omitempty"`
-	// slashing_unbonding_tx_sigs is a list of adaptor signatures of the covenant
-	// on slashing tx corresponding to unbonding tx submitted to babylon
-	// the order of sigs should respect the order of finality providers
-	// of the corresponding delegation
-	SlashingUnbondingTxSigs [][]byte `protobuf:"bytes,6,rep,name=slashing_unbonding_tx_sigs,json=slashingUnbondingTxSigs,proto3" json:"slashing_unbonding_tx_sigs,omitempty"`
-}
-
-func (m *MsgAddCovenantSigs) Reset()         { *m = MsgAddCovenantSigs{} }
-func (m *MsgAddCovenantSigs) String() string { return proto.CompactTextString(m) }
-func (*MsgAddCovenantSigs) ProtoMessage()    {}
-func (*MsgAddCovenantSigs) Descriptor() ([]byte, []int) {
+// MsgExpandBTCDelegation is the message for expanding an active BTC delegation.
+// The new staking tx spends the staking output of the previous BTC delegation
+// together with extra funding inputs, and locks the sum in a new staking output
+// under the same staker and finality providers. The BTC staker, its proof of
+// possession and the finality providers are inherited from the previous BTC delegation.
+type MsgExpandBTCDelegation struct {
+	// staker_addr is the address to receive rewards from BTC delegation.
+	// It has to be the same as the one of the previous BTC delegation.
+	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+	// previous_staking_tx_hash is the hash of the staking tx of the active BTC
+	// delegation to be expanded
+	PreviousStakingTxHash string `protobuf:"bytes,2,opt,name=previous_staking_tx_hash,json=previousStakingTxHash,proto3" json:"previous_staking_tx_hash,omitempty"`
+	// staking_time is the time lock used in the new staking transaction
+	StakingTime uint32 `protobuf:"varint,3,opt,name=staking_time,json=stakingTime,proto3" json:"staking_time,omitempty"`
+	// staking_value is the amount of satoshis locked in the new staking output.
+	// It has to be larger than the amount locked in the previous staking output
+	StakingValue int64 `protobuf:"varint,4,opt,name=staking_value,json=stakingValue,proto3" json:"staking_value,omitempty"`
+	// staking_tx is the new staking tx. It spends the previous staking output
+	// via its unbonding path, thus can only be included on Bitcoin after the
+	// covenant committee has co-signed it. Its inclusion is proven afterwards
+	// via MsgAddBTCDelegationInclusionProof
+	StakingTx []byte `protobuf:"bytes,5,opt,name=staking_tx,json=stakingTx,proto3" json:"staking_tx,omitempty"`
+	// slashing_tx is the slashing tx of the new staking tx
+	// Note that the tx itself does not contain signatures, which are off-chain.
+	SlashingTx *BTCSlashingTx `protobuf:"bytes,6,opt,name=slashing_tx,json=slashingTx,proto3,customtype=BTCSlashingTx" json:"slashing_tx,omitempty"`
+	// delegator_slashing_sig is the signature on the slashing tx by the delegator (i.e., SK corresponding to btc_pk).
+	DelegatorSlashingSig *github_com_babylonchain_babylon_types.BIP340Signature `protobuf:"bytes,7,opt,name=delegator_slashing_sig,json=delegatorSlashingSig,proto3,customtype=github.com/babylonchain/babylon/types.BIP340Signature" json:"delegator_slashing_sig,omitempty"`
+	// unbonding_time is the time lock used when funds are being unbonded
+	UnbondingTime uint32 `protobuf:"varint,8,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
+	// unbonding_tx is a bitcoin unbonding transaction i.e transaction that spends
+	// the new staking output and sends it to the unbonding output
+	UnbondingTx []byte `protobuf:"bytes,9,opt,name=unbonding_tx,json=unbondingTx,proto3" json:"unbonding_tx,omitempty"`
+	// unbonding_value is amount of satoshis locked in unbonding output.
+	UnbondingValue int64 `protobuf:"varint,10,opt,name=unbonding_value,json=unbondingValue,proto3" json:"unbonding_value,omitempty"`
+	// unbonding_slashing_tx is the slashing tx which slash unbonding contract
+	// Note that the tx itself does not contain signatures, which are off-chain.
+	UnbondingSlashingTx *BTCSlashingTx `protobuf:"bytes,11,opt,name=unbonding_slashing_tx,json=unbondingSlashingTx,proto3,customtype=BTCSlashingTx" json:"unbonding_slashing_tx,omitempty"`
+	// delegator_unbonding_slashing_sig is the signature on the slashing tx by the delegator (i.e., SK corresponding to btc_pk).
+	DelegatorUnbondingSlashingSig *github_com_babylonchain_babylon_types.BIP340Signature `protobuf:"bytes,12,opt,name=delegator_unbonding_slashing_sig,json=delegatorUnbondingSlashingSig,proto3,customtype=github.com/babylonchain/babylon/types.BIP340Signature" json:"delegator_unbonding_slashing_sig,omitempty"`
+	// funding_txs are the BTC txs whose outputs are spent by the extra funding
+	// inputs of the new staking tx. The covenant signatures on the new staking
+	// tx commit to all outputs it spends, so that they are needed for verifying
+	// these signatures
+	FundingTxs [][]byte `protobuf:"bytes,13,rep,name=funding_txs,json=fundingTxs,proto3" json:"funding_txs,omitempty"`
+}
+
+func (m *MsgExpandBTCDelegation) Reset()         { *m = MsgExpandBTCDelegation{} }
+func (m *MsgExpandBTCDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgExpandBTCDelegation) ProtoMessage()    {}
+func (*MsgExpandBTCDelegation) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4baddb53e97f38f2, []int{6}
 }
-func (m *MsgAddCovenantSigs) XXX_Unmarshal(b []byte) error {
+func (m *MsgExpandBTCDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddCovenantSigs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgExpandBTCDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddCovenantSigs.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgExpandBTCDelegation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -458,62 +499,97 @@ func (m *MsgAddCovenantSigs) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgAddCovenantSigs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddCovenantSigs.Merge(m, src)
+func (m *MsgExpandBTCDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExpandBTCDelegation.Merge(m, src)
 }
-func (m *MsgAddCovenantSigs) XXX_Size() int {
+func (m *MsgExpandBTCDelegation) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddCovenantSigs) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddCovenantSigs.DiscardUnknown(m)
+func (m *MsgExpandBTCDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExpandBTCDelegation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddCovenantSigs proto.InternalMessageInfo
+var xxx_messageInfo_MsgExpandBTCDelegation proto.InternalMessageInfo
 
-func (m *MsgAddCovenantSigs) GetSigner() string {
+func (m *MsgExpandBTCDelegation) GetStakerAddr() string {
 	if m != nil {
-		return m.Signer
+		return m.StakerAddr
 	}
 	return ""
 }
 
-func (m *MsgAddCovenantSigs) GetStakingTxHash() string {
+func (m *MsgExpandBTCDelegation) GetPreviousStakingTxHash() string {
 	if m != nil {
-		return m.StakingTxHash
+		return m.PreviousStakingTxHash
 	}
 	return ""
 }
 
-func (m *MsgAddCovenantSigs) GetSlashingTxSigs() [][]byte {
+func (m *MsgExpandBTCDelegation) GetStakingTime() uint32 {
 	if m != nil {
-		return m.SlashingTxSigs
+		return m.StakingTime
+	}
+	return 0
+}
+
+func (m *MsgExpandBTCDelegation) GetStakingValue() int64 {
+	if m != nil {
+		return m.StakingValue
+	}
+	return 0
+}
+
+func (m *MsgExpandBTCDelegation) GetStakingTx() []byte {
+	if m != nil {
+		return m.StakingTx
 	}
 	return nil
 }
 
-func (m *MsgAddCovenantSigs) GetSlashingUnbondingTxSigs() [][]byte {
+func (m *MsgExpandBTCDelegation) GetUnbondingTime() uint32 {
 	if m != nil {
-		return m.SlashingUnbondingTxSigs
+		return m.UnbondingTime
+	}
+	return 0
+}
+
+func (m *MsgExpandBTCDelegation) GetUnbondingTx() []byte {
+	if m != nil {
+		return m.UnbondingTx
 	}
 	return nil
 }
 
-// MsgAddCovenantSigsResponse is the response for MsgAddCovenantSigs
-type MsgAddCovenantSigsResponse struct {
+func (m *MsgExpandBTCDelegation) GetUnbondingValue() int64 {
+	if m != nil {
+		return m.UnbondingValue
+	}
+	return 0
 }
 
-func (m *MsgAddCovenantSigsResponse) Reset()         { *m = MsgAddCovenantSigsResponse{} }
-func (m *MsgAddCovenantSigsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddCovenantSigsResponse) ProtoMessage()    {}
-func (*MsgAddCovenantSigsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgExpandBTCDelegation) GetFundingTxs() [][]byte {
+	if m != nil {
+		return m.FundingTxs
+	}
+	return nil
+}
+
+// MsgExpandBTCDelegationResponse is the response for MsgExpandBTCDelegation
+type MsgExpandBTCDelegationResponse struct {
+}
+
+func (m *MsgExpandBTCDelegationResponse) Reset()         { *m = MsgExpandBTCDelegationResponse{} }
+func (m *MsgExpandBTCDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExpandBTCDelegationResponse) ProtoMessage()    {}
+func (*MsgExpandBTCDelegationResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4baddb53e97f38f2, []int{7}
 }
-func (m *MsgAddCovenantSigsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgExpandBTCDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddCovenantSigsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgExpandBTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddCovenantSigsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgExpandBTCDelegationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -523,43 +599,49 @@ func (m *MsgAddCovenantSigsResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgAddCovenantSigsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddCovenantSigsResponse.Merge(m, src)
+func (m *MsgExpandBTCDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExpandBTCDelegationResponse.Merge(m, src)
 }
-func (m *MsgAddCovenantSigsResponse) XXX_Size() int {
+func (m *MsgExpandBTCDelegationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddCovenantSigsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddCovenantSigsResponse.DiscardUnknown(m)
+func (m *MsgExpandBTCDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExpandBTCDelegationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddCovenantSigsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgExpandBTCDelegationResponse proto.InternalMessageInfo
 
-// MsgBTCUndelegate is the message for handling signature on unbonding tx
-// from its delegator. This signature effectively proves that the delegator
-// wants to unbond this BTC delegation
-type MsgBTCUndelegate struct {
+// MsgAddBTCDelegationInclusionProof is the message for proving the inclusion
+// of the staking tx of a BTC delegation created upon stake expansion or
+// redelegation. Such a BTC delegation is registered before its staking tx is
+// included on Bitcoin, and becomes active once the covenant quorum has signed
+// it and its staking tx is proven k-deep. The BTC delegation whose staking
+// output is spent becomes unbonded at the same time.
+type MsgAddBTCDelegationInclusionProof struct {
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	// staking_tx_hash is the hash of the staking tx.
 	// It uniquely identifies a BTC delegation
 	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
-	// unbonding_tx_sig is the signature of the staker on the unbonding tx submitted to babylon
-	// the signature follows encoding in BIP-340 spec
-	UnbondingTxSig *github_com_babylonchain_babylon_types.BIP340Signature `protobuf:"bytes,3,opt,name=unbonding_tx_sig,json=unbondingTxSig,proto3,customtype=github.com/babylonchain/babylon/types.BIP340Signature" json:"unbonding_tx_sig,omitempty"`
-}
-
-func (m *MsgBTCUndelegate) Reset()         { *m = MsgBTCUndelegate{} }
-func (m *MsgBTCUndelegate) String() string { return proto.CompactTextString(m) }
-func (*MsgBTCUndelegate) ProtoMessage()    {}
-func (*MsgBTCUndelegate) Descriptor() ([]byte, []int) {
+	// staking_tx_key is the position of the staking tx in the BTC block
+	// that includes it
+	StakingTxKey *types1.TransactionKey `protobuf:"bytes,3,opt,name=staking_tx_key,json=stakingTxKey,proto3" json:"staking_tx_key,omitempty"`
+	// staking_tx_proof is the merkle proof of inclusion of the staking tx in
+	// the BTC block
+	StakingTxProof []byte `protobuf:"bytes,4,opt,name=staking_tx_proof,json=stakingTxProof,proto3" json:"staking_tx_proof,omitempty"`
+}
+
+func (m *MsgAddBTCDelegationInclusionProof) Reset()         { *m = MsgAddBTCDelegationInclusionProof{} }
+func (m *MsgAddBTCDelegationInclusionProof) String() string { return proto.CompactTextString(m) }
+func (*MsgAddBTCDelegationInclusionProof) ProtoMessage()    {}
+func (*MsgAddBTCDelegationInclusionProof) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4baddb53e97f38f2, []int{8}
 }
-func (m *MsgBTCUndelegate) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddBTCDelegationInclusionProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBTCUndelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddBTCDelegationInclusionProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBTCUndelegate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddBTCDelegationInclusionProof.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -569,48 +651,66 @@ func (m *MsgBTCUndelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgBTCUndelegate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBTCUndelegate.Merge(m, src)
+func (m *MsgAddBTCDelegationInclusionProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddBTCDelegationInclusionProof.Merge(m, src)
 }
-func (m *MsgBTCUndelegate) XXX_Size() int {
+func (m *MsgAddBTCDelegationInclusionProof) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBTCUndelegate) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBTCUndelegate.DiscardUnknown(m)
+func (m *MsgAddBTCDelegationInclusionProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddBTCDelegationInclusionProof.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBTCUndelegate proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddBTCDelegationInclusionProof proto.InternalMessageInfo
 
-func (m *MsgBTCUndelegate) GetSigner() string {
+func (m *MsgAddBTCDelegationInclusionProof) GetSigner() string {
 	if m != nil {
 		return m.Signer
 	}
 	return ""
 }
 
-func (m *MsgBTCUndelegate) GetStakingTxHash() string {
+func (m *MsgAddBTCDelegationInclusionProof) GetStakingTxHash() string {
 	if m != nil {
 		return m.StakingTxHash
 	}
 	return ""
 }
 
-// MsgBTCUndelegateResponse is the response for MsgBTCUndelegate
-type MsgBTCUndelegateResponse struct {
+func (m *MsgAddBTCDelegationInclusionProof) GetStakingTxKey() *types1.TransactionKey {
+	if m != nil {
+		return m.StakingTxKey
+	}
+	return nil
 }
 
-func (m *MsgBTCUndelegateResponse) Reset()         { *m = MsgBTCUndelegateResponse{} }
-func (m *MsgBTCUndelegateResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgBTCUndelegateResponse) ProtoMessage()    {}
-func (*MsgBTCUndelegateResponse) Descriptor() ([]byte, []int) {
+func (m *MsgAddBTCDelegationInclusionProof) GetStakingTxProof() []byte {
+	if m != nil {
+		return m.StakingTxProof
+	}
+	return nil
+}
+
+// MsgAddBTCDelegationInclusionProofResponse is the response for MsgAddBTCDelegationInclusionProof
+type MsgAddBTCDelegationInclusionProofResponse struct {
+}
+
+func (m *MsgAddBTCDelegationInclusionProofResponse) Reset() {
+	*m = MsgAddBTCDelegationInclusionProofResponse{}
+}
+func (m *MsgAddBTCDelegationInclusionProofResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgAddBTCDelegationInclusionProofResponse) ProtoMessage() {}
+func (*MsgAddBTCDelegationInclusionProofResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4baddb53e97f38f2, []int{9}
 }
-func (m *MsgBTCUndelegateResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBTCUndelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBTCUndelegateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddBTCDelegationInclusionProofResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -620,44 +720,73 @@ func (m *MsgBTCUndelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgBTCUndelegateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBTCUndelegateResponse.Merge(m, src)
+func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddBTCDelegationInclusionProofResponse.Merge(m, src)
 }
-func (m *MsgBTCUndelegateResponse) XXX_Size() int {
+func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBTCUndelegateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBTCUndelegateResponse.DiscardUnknown(m)
+func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddBTCDelegationInclusionProofResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBTCUndelegateResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddBTCDelegationInclusionProofResponse proto.InternalMessageInfo
 
-// MsgSelectiveSlashingEvidence is the message for handling evidence of selective slashing
-// launched by a finality provider
-type MsgSelectiveSlashingEvidence struct {
-	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	// staking_tx_hash is the hash of the staking tx.
-	// It uniquely identifies a BTC delegation
-	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
-	// recovered_fp_btc_sk is the BTC SK of the finality provider who
-	// launches the selective slashing offence. The SK is recovered by
-	// using a covenant adaptor signature and the corresponding Schnorr
-	// signature
-	RecoveredFpBtcSk []byte `protobuf:"bytes,3,opt,name=recovered_fp_btc_sk,json=recoveredFpBtcSk,proto3" json:"recovered_fp_btc_sk,omitempty"`
+// MsgBTCRedelegate is the message for moving an active BTC delegation to a
+// different set of finality providers. The new staking tx is pre-signed by the
+// staker and spends the previous staking output as its only input, moving it to
+// a new staking output whose script commits to the new finality providers. The
+// BTC staker and its proof of possession are inherited from the previous BTC delegation.
+type MsgBTCRedelegate struct {
+	// staker_addr is the address to receive rewards from BTC delegation.
+	// It has to be the same as the one of the previous BTC delegation.
+	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+	// previous_staking_tx_hash is the hash of the staking tx of the active BTC
+	// delegation to be redelegated
+	PreviousStakingTxHash string `protobuf:"bytes,2,opt,name=previous_staking_tx_hash,json=previousStakingTxHash,proto3" json:"previous_staking_tx_hash,omitempty"`
+	// fp_btc_pk_list is the list of Bitcoin secp256k1 PKs of the finality providers
+	// to redelegate to
+	FpBtcPkList []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,3,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk_list,omitempty"`
+	// staking_time is the time lock used in the new staking transaction
+	StakingTime uint32 `protobuf:"varint,4,opt,name=staking_time,json=stakingTime,proto3" json:"staking_time,omitempty"`
+	// staking_value is the amount of satoshis locked in the new staking output
+	StakingValue int64 `protobuf:"varint,5,opt,name=staking_value,json=stakingValue,proto3" json:"staking_value,omitempty"`
+	// staking_tx is the new staking tx. As upon stake expansion, it spends the
+	// previous staking output via its unbonding path, thus can only be included
+	// on Bitcoin after the covenant committee has co-signed it. Its inclusion is
+	// proven afterwards via MsgAddBTCDelegationInclusionProof
+	StakingTx []byte `protobuf:"bytes,6,opt,name=staking_tx,json=stakingTx,proto3" json:"staking_tx,omitempty"`
+	// slashing_tx is the slashing tx of the new staking tx
+	// Note that the tx itself does not contain signatures, which are off-chain.
+	SlashingTx *BTCSlashingTx `protobuf:"bytes,7,opt,name=slashing_tx,json=slashingTx,proto3,customtype=BTCSlashingTx" json:"slashing_tx,omitempty"`
+	// delegator_slashing_sig is the signature on the slashing tx by the delegator (i.e., SK corresponding to btc_pk).
+	DelegatorSlashingSig *github_com_babylonchain_babylon_types.BIP340Signature `protobuf:"bytes,8,opt,name=delegator_slashing_sig,json=delegatorSlashingSig,proto3,customtype=github.com/babylonchain/babylon/types.BIP340Signature" json:"delegator_slashing_sig,omitempty"`
+	// unbonding_time is the time lock used when funds are being unbonded
+	UnbondingTime uint32 `protobuf:"varint,9,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
+	// unbonding_tx is a bitcoin unbonding transaction i.e transaction that spends
+	// the new staking output and sends it to the unbonding output
+	UnbondingTx []byte `protobuf:"bytes,10,opt,name=unbonding_tx,json=unbondingTx,proto3" json:"unbonding_tx,omitempty"`
+	// unbonding_value is amount of satoshis locked in unbonding output.
+	UnbondingValue int64 `protobuf:"varint,11,opt,name=unbonding_value,json=unbondingValue,proto3" json:"unbonding_value,omitempty"`
+	// unbonding_slashing_tx is the slashing tx which slash unbonding contract
+	// Note that the tx itself does not contain signatures, which are off-chain.
+	UnbondingSlashingTx *BTCSlashingTx `protobuf:"bytes,12,opt,name=unbonding_slashing_tx,json=unbondingSlashingTx,proto3,customtype=BTCSlashingTx" json:"unbonding_slashing_tx,omitempty"`
+	// delegator_unbonding_slashing_sig is the signature on the slashing tx by the delegator (i.e., SK corresponding to btc_pk).
+	DelegatorUnbondingSlashingSig *github_com_babylonchain_babylon_types.BIP340Signature `protobuf:"bytes,13,opt,name=delegator_unbonding_slashing_sig,json=delegatorUnbondingSlashingSig,proto3,customtype=github.com/babylonchain/babylon/types.BIP340Signature" json:"delegator_unbonding_slashing_sig,omitempty"`
 }
 
-func (m *MsgSelectiveSlashingEvidence) Reset()         { *m = MsgSelectiveSlashingEvidence{} }
-func (m *MsgSelectiveSlashingEvidence) String() string { return proto.CompactTextString(m) }
-func (*MsgSelectiveSlashingEvidence) ProtoMessage()    {}
-func (*MsgSelectiveSlashingEvidence) Descriptor() ([]byte, []int) {
+func (m *MsgBTCRedelegate) Reset()         { *m = MsgBTCRedelegate{} }
+func (m *MsgBTCRedelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgBTCRedelegate) ProtoMessage()    {}
+func (*MsgBTCRedelegate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4baddb53e97f38f2, []int{10}
 }
-func (m *MsgSelectiveSlashingEvidence) XXX_Unmarshal(b []byte) error {
+func (m *MsgBTCRedelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSelectiveSlashingEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBTCRedelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSelectiveSlashingEvidence.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBTCRedelegate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -667,55 +796,90 @@ func (m *MsgSelectiveSlashingEvidence) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgSelectiveSlashingEvidence) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSelectiveSlashingEvidence.Merge(m, src)
+func (m *MsgBTCRedelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBTCRedelegate.Merge(m, src)
 }
-func (m *MsgSelectiveSlashingEvidence) XXX_Size() int {
+func (m *MsgBTCRedelegate) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSelectiveSlashingEvidence) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSelectiveSlashingEvidence.DiscardUnknown(m)
+func (m *MsgBTCRedelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBTCRedelegate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSelectiveSlashingEvidence proto.InternalMessageInfo
+var xxx_messageInfo_MsgBTCRedelegate proto.InternalMessageInfo
 
-func (m *MsgSelectiveSlashingEvidence) GetSigner() string {
+func (m *MsgBTCRedelegate) GetStakerAddr() string {
 	if m != nil {
-		return m.Signer
+		return m.StakerAddr
 	}
 	return ""
 }
 
-func (m *MsgSelectiveSlashingEvidence) GetStakingTxHash() string {
+func (m *MsgBTCRedelegate) GetPreviousStakingTxHash() string {
 	if m != nil {
-		return m.StakingTxHash
+		return m.PreviousStakingTxHash
 	}
 	return ""
 }
 
-func (m *MsgSelectiveSlashingEvidence) GetRecoveredFpBtcSk() []byte {
+func (m *MsgBTCRedelegate) GetStakingTime() uint32 {
 	if m != nil {
-		return m.RecoveredFpBtcSk
+		return m.StakingTime
+	}
+	return 0
+}
+
+func (m *MsgBTCRedelegate) GetStakingValue() int64 {
+	if m != nil {
+		return m.StakingValue
+	}
+	return 0
+}
+
+func (m *MsgBTCRedelegate) GetStakingTx() []byte {
+	if m != nil {
+		return m.StakingTx
 	}
 	return nil
 }
 
-// MsgSelectiveSlashingEvidenceResponse is the response for MsgSelectiveSlashingEvidence
-type MsgSelectiveSlashingEvidenceResponse struct {
+func (m *MsgBTCRedelegate) GetUnbondingTime() uint32 {
+	if m != nil {
+		return m.UnbondingTime
+	}
+	return 0
 }
 
-func (m *MsgSelectiveSlashingEvidenceResponse) Reset()         { *m = MsgSelectiveSlashingEvidenceResponse{} }
-func (m *MsgSelectiveSlashingEvidenceResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSelectiveSlashingEvidenceResponse) ProtoMessage()    {}
-func (*MsgSelectiveSlashingEvidenceResponse) Descriptor() ([]byte, []int) {
+func (m *MsgBTCRedelegate) GetUnbondingTx() []byte {
+	if m != nil {
+		return m.UnbondingTx
+	}
+	return nil
+}
+
+func (m *MsgBTCRedelegate) GetUnbondingValue() int64 {
+	if m != nil {
+		return m.UnbondingValue
+	}
+	return 0
+}
+
+// MsgBTCRedelegateResponse is the response for MsgBTCRedelegate
+type MsgBTCRedelegateResponse struct {
+}
+
+func (m *MsgBTCRedelegateResponse) Reset()         { *m = MsgBTCRedelegateResponse{} }
+func (m *MsgBTCRedelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBTCRedelegateResponse) ProtoMessage()    {}
+func (*MsgBTCRedelegateResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4baddb53e97f38f2, []int{11}
 }
-func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBTCRedelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBTCRedelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSelectiveSlashingEvidenceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBTCRedelegateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -725,43 +889,53 @@ func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSelectiveSlashingEvidenceResponse.Merge(m, src)
+func (m *MsgBTCRedelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBTCRedelegateResponse.Merge(m, src)
 }
-func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Size() int {
+func (m *MsgBTCRedelegateResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSelectiveSlashingEvidenceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSelectiveSlashingEvidenceResponse.DiscardUnknown(m)
+func (m *MsgBTCRedelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBTCRedelegateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSelectiveSlashingEvidenceResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgBTCRedelegateResponse proto.InternalMessageInfo
 
-// MsgUpdateParams defines a message for updating btcstaking module parameters.
-type MsgUpdateParams struct {
-	// authority is the address of the governance account.
-	// just FYI: cosmos.AddressString marks that this field should use type alias
-	// for AddressString instead of string, but the functionality is not yet implemented
-	// in cosmos-proto
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the finality parameters to update.
-	//
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+// MsgRotateFinalityProviderKey is the message for scheduling the rotation of a
+// finality provider's BTC PK. BTC delegations to the old BTC PK are expected
+// to be migrated to the new BTC PK via `MsgBTCRedelegate`, as their staking
+// scripts commit to the old BTC PK. BTC delegations that are not migrated by
+// the rotation height lose their voting power until they are migrated.
+type MsgRotateFinalityProviderKey struct {
+	// addr is the address of the finality provider
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// old_btc_pk is the current BTC PK of the finality provider
+	OldBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=old_btc_pk,json=oldBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"old_btc_pk,omitempty"`
+	// new_btc_pk is the BTC PK that the finality provider rotates to
+	NewBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,3,opt,name=new_btc_pk,json=newBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"new_btc_pk,omitempty"`
+	// new_pop is the proof of possession of new_btc_pk over addr
+	NewPop *ProofOfPossessionBTC `protobuf:"bytes,4,opt,name=new_pop,json=newPop,proto3" json:"new_pop,omitempty"`
+	// rotation_height is the Babylon height at which the finality provider is
+	// re-keyed to new_btc_pk. It has to be higher than the current height
+	RotationHeight uint64 `protobuf:"varint,5,opt,name=rotation_height,json=rotationHeight,proto3" json:"rotation_height,omitempty"`
+	// handover_sig is the BIP-340 signature on
+	// (old_btc_pk || new_btc_pk || rotation_height) signed by the SK
+	// corresponding to old_btc_pk, which authorises the new BTC PK
+	HandoverSig *github_com_babylonchain_babylon_types.BIP340Signature `protobuf:"bytes,6,opt,name=handover_sig,json=handoverSig,proto3,customtype=github.com/babylonchain/babylon/types.BIP340Signature" json:"handover_sig,omitempty"`
+}
+
+func (m *MsgRotateFinalityProviderKey) Reset()         { *m = MsgRotateFinalityProviderKey{} }
+func (m *MsgRotateFinalityProviderKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateFinalityProviderKey) ProtoMessage()    {}
+func (*MsgRotateFinalityProviderKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{12}
 }
-
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{12}
-}
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgRotateFinalityProviderKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRotateFinalityProviderKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRotateFinalityProviderKey.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -771,48 +945,55 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgRotateFinalityProviderKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateFinalityProviderKey.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgRotateFinalityProviderKey) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgRotateFinalityProviderKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateFinalityProviderKey.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgRotateFinalityProviderKey proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgRotateFinalityProviderKey) GetAddr() string {
 	if m != nil {
-		return m.Authority
+		return m.Addr
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgRotateFinalityProviderKey) GetNewPop() *ProofOfPossessionBTC {
 	if m != nil {
-		return m.Params
+		return m.NewPop
 	}
-	return Params{}
+	return nil
 }
 
-// MsgUpdateParamsResponse is the response to the MsgUpdateParams message.
-type MsgUpdateParamsResponse struct {
+func (m *MsgRotateFinalityProviderKey) GetRotationHeight() uint64 {
+	if m != nil {
+		return m.RotationHeight
+	}
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+// MsgRotateFinalityProviderKeyResponse is the response for MsgRotateFinalityProviderKey
+type MsgRotateFinalityProviderKeyResponse struct {
+}
+
+func (m *MsgRotateFinalityProviderKeyResponse) Reset()         { *m = MsgRotateFinalityProviderKeyResponse{} }
+func (m *MsgRotateFinalityProviderKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateFinalityProviderKeyResponse) ProtoMessage()    {}
+func (*MsgRotateFinalityProviderKeyResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4baddb53e97f38f2, []int{13}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRotateFinalityProviderKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRotateFinalityProviderKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRotateFinalityProviderKeyResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -822,970 +1003,1216 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgRotateFinalityProviderKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateFinalityProviderKeyResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgRotateFinalityProviderKeyResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgRotateFinalityProviderKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateFinalityProviderKeyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRotateFinalityProviderKeyResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateFinalityProvider)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProvider")
-	proto.RegisterType((*MsgCreateFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProviderResponse")
-	proto.RegisterType((*MsgEditFinalityProvider)(nil), "babylon.btcstaking.v1.MsgEditFinalityProvider")
-	proto.RegisterType((*MsgEditFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgEditFinalityProviderResponse")
-	proto.RegisterType((*MsgCreateBTCDelegation)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegation")
-	proto.RegisterType((*MsgCreateBTCDelegationResponse)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegationResponse")
-	proto.RegisterType((*MsgAddCovenantSigs)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigs")
-	proto.RegisterType((*MsgAddCovenantSigsResponse)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigsResponse")
-	proto.RegisterType((*MsgBTCUndelegate)(nil), "babylon.btcstaking.v1.MsgBTCUndelegate")
-	proto.RegisterType((*MsgBTCUndelegateResponse)(nil), "babylon.btcstaking.v1.MsgBTCUndelegateResponse")
-	proto.RegisterType((*MsgSelectiveSlashingEvidence)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidence")
-	proto.RegisterType((*MsgSelectiveSlashingEvidenceResponse)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidenceResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.btcstaking.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.btcstaking.v1.MsgUpdateParamsResponse")
+// MsgAddCovenantSigs is the message for handling signatures from a covenant member
+type MsgAddCovenantSigs struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// pk is the BTC public key of the covenant member
+	Pk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=pk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"pk,omitempty"`
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,3,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// sigs is a list of adaptor signatures of the covenant
+	// the order of sigs should respect the order of finality providers
+	// of the corresponding delegation
+	SlashingTxSigs [][]byte `protobuf:"bytes,4,rep,name=slashing_tx_sigs,json=slashingTxSigs,proto3" json:"slashing_tx_sigs,omitempty"`
+	// unbonding_tx_sig is the signature of the covenant on the unbonding tx submitted to babylon
+	// the signature follows encoding in BIP-340 spec
+	UnbondingTxSig *github_com_babylonchain_babylon_types.BIP340Signature `protobuf:"bytes,5,opt,name=unbonding_tx_sig,json=unbondingTxSig,proto3,customtype=github.com/babylonchain/babylon/types.BIP340Signature" json:"unbonding_tx_sig,omitempty"`
+	// slashing_unbonding_tx_sigs is a list of adaptor signatures of the covenant
+	// on slashing tx corresponding to unbonding tx submitted to babylon
+	// the order of sigs should respect the order of finality providers
+	// of the corresponding delegation
+	SlashingUnbondingTxSigs [][]byte `protobuf:"bytes,6,rep,name=slashing_unbonding_tx_sigs,json=slashingUnbondingTxSigs,proto3" json:"slashing_unbonding_tx_sigs,omitempty"`
+	// stake_spending_tx_sig is the signature of the covenant on the staking tx
+	// of a BTC delegation created upon stake expansion or redelegation, which
+	// spends the previous staking output via its unbonding path. It has to be
+	// empty for other BTC delegations.
+	// the signature follows encoding in BIP-340 spec
+	StakeSpendingTxSig *github_com_babylonchain_babylon_types.BIP340Signature `protobuf:"bytes,7,opt,name=stake_spending_tx_sig,json=stakeSpendingTxSig,proto3,customtype=github.com/babylonchain/babylon/types.BIP340Signature" json:"stake_spending_tx_sig,omitempty"`
 }
 
-func init() { proto.RegisterFile("babylon/btcstaking/v1/tx.proto", fileDescriptor_4baddb53e97f38f2) }
-
-var fileDescriptor_4baddb53e97f38f2 = []byte{
-	// 1259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x13, 0x47,
-	0x14, 0xcf, 0xda, 0x89, 0x69, 0x9e, 0xe3, 0x24, 0x5d, 0x42, 0xd8, 0x6c, 0xc1, 0x76, 0x0c, 0x85,
-	0x40, 0xc9, 0x9a, 0x84, 0x82, 0x4a, 0x50, 0x0f, 0x38, 0x09, 0x02, 0x15, 0xab, 0xd6, 0xda, 0xe9,
-	0xa1, 0x3d, 0x58, 0xeb, 0xdd, 0xc9, 0x7a, 0x64, 0x7b, 0x67, 0xb5, 0x33, 0xb1, 0x1c, 0x55, 0xaa,
-	0x2a, 0xd4, 0x6b, 0xa5, 0x9e, 0x7a, 0xe8, 0xa7, 0xe0, 0xc0, 0x47, 0xa8, 0x2a, 0x8e, 0x88, 0x53,
-	0x95, 0x43, 0x54, 0x41, 0x25, 0x3e, 0x41, 0xa5, 0xde, 0x5a, 0xed, 0xec, 0x5f, 0xbb, 0xde, 0x92,
-	0x60, 0x6e, 0xde, 0x99, 0xdf, 0xfb, 0xbd, 0xf7, 0x7e, 0xef, 0xbd, 0x99, 0x31, 0xe4, 0x5b, 0x5a,
-	0xeb, 0xb0, 0x4b, 0xac, 0x72, 0x8b, 0xe9, 0x94, 0x69, 0x1d, 0x6c, 0x99, 0xe5, 0xfe, 0x46, 0x99,
-	0x0d, 0x14, 0xdb, 0x21, 0x8c, 0x88, 0xe7, 0xfc, 0x7d, 0x25, 0xda, 0x57, 0xfa, 0x1b, 0xf2, 0x92,
-	0x49, 0x4c, 0xc2, 0x11, 0x65, 0xf7, 0x97, 0x07, 0x96, 0x57, 0x74, 0x42, 0x7b, 0x84, 0x36, 0xbd,
-	0x0d, 0xef, 0xc3, 0xdf, 0x3a, 0xef, 0x7d, 0x95, 0x7b, 0x94, 0xf3, 0xf7, 0xa8, 0xe9, 0x6f, 0x94,
-	0xc6, 0x07, 0x60, 0x6b, 0x8e, 0xd6, 0x0b, 0x8c, 0x6f, 0xc4, 0x30, 0x7a, 0x1b, 0xe9, 0x1d, 0x9b,
-	0x60, 0x8b, 0xb9, 0xb0, 0xa1, 0x05, 0x1f, 0x7d, 0xd9, 0x77, 0x15, 0xb1, 0xb5, 0x10, 0xd3, 0x36,
-	0x82, 0x6f, 0x1f, 0x55, 0x48, 0xf0, 0x4b, 0x6c, 0x0f, 0x50, 0xfa, 0x3b, 0x05, 0x2b, 0x55, 0x6a,
-	0x6e, 0x3b, 0x48, 0x63, 0xe8, 0x01, 0xb6, 0xb4, 0x2e, 0x66, 0x87, 0x35, 0x87, 0xf4, 0xb1, 0x81,
-	0x1c, 0xf1, 0x06, 0x4c, 0x6b, 0x86, 0xe1, 0x48, 0x42, 0x51, 0x58, 0x9b, 0xad, 0x48, 0x2f, 0x9f,
-	0xad, 0x2f, 0xf9, 0xf9, 0xde, 0x37, 0x0c, 0x07, 0x51, 0x5a, 0x67, 0x0e, 0xb6, 0x4c, 0x95, 0xa3,
-	0xc4, 0x5d, 0xc8, 0x1a, 0x88, 0xea, 0x0e, 0xb6, 0x19, 0x26, 0x96, 0x94, 0x2a, 0x0a, 0x6b, 0xd9,
-	0xcd, 0x4b, 0x8a, 0x6f, 0x11, 0xe9, 0xca, 0x03, 0x55, 0x76, 0x22, 0xa8, 0x1a, 0xb7, 0x13, 0xab,
-	0x00, 0x3a, 0xe9, 0xf5, 0x30, 0xa5, 0x2e, 0x4b, 0x9a, 0xbb, 0x5e, 0x3f, 0x3a, 0x2e, 0x7c, 0xe4,
-	0x11, 0x51, 0xa3, 0xa3, 0x60, 0x52, 0xee, 0x69, 0xac, 0xad, 0x3c, 0x46, 0xa6, 0xa6, 0x1f, 0xee,
-	0x20, 0xfd, 0xe5, 0xb3, 0x75, 0xf0, 0xfd, 0xec, 0x20, 0x5d, 0x8d, 0x11, 0x88, 0x55, 0xc8, 0xb4,
-	0x98, 0xde, 0xb4, 0x3b, 0xd2, 0x74, 0x51, 0x58, 0x9b, 0xab, 0xdc, 0x39, 0x3a, 0x2e, 0x6c, 0x9a,
-	0x98, 0xb5, 0x0f, 0x5a, 0x8a, 0x4e, 0x7a, 0x65, 0x5f, 0x21, 0xbd, 0xad, 0x61, 0x2b, 0xf8, 0x28,
-	0xb3, 0x43, 0x1b, 0x51, 0xa5, 0xf2, 0xa8, 0x76, 0xeb, 0xd3, 0x9b, 0xb5, 0x83, 0xd6, 0x17, 0xe8,
-	0x50, 0x9d, 0x69, 0x31, 0xbd, 0xd6, 0x11, 0x3f, 0x87, 0xb4, 0x4d, 0x6c, 0x69, 0x86, 0x27, 0xf7,
-	0x89, 0x32, 0xb6, 0x71, 0x94, 0x9a, 0x43, 0xc8, 0xfe, 0x97, 0xfb, 0x35, 0x42, 0x29, 0xe2, 0x51,
-	0x54, 0x1a, 0xdb, 0xaa, 0x6b, 0xb7, 0x35, 0xfb, 0xe4, 0xcd, 0xd3, 0xeb, 0x5c, 0xae, 0xd2, 0x25,
-	0x58, 0x4d, 0x54, 0x5e, 0x45, 0xd4, 0x26, 0x16, 0x45, 0xa5, 0x7f, 0x04, 0x38, 0x5f, 0xa5, 0xe6,
-	0xae, 0x81, 0xd9, 0x84, 0xd5, 0x39, 0x17, 0xea, 0xe0, 0x16, 0x66, 0x2e, 0xc8, 0x67, 0xa4, 0x68,
-	0xe9, 0xf7, 0x52, 0xb4, 0xe9, 0x09, 0x8b, 0x16, 0x97, 0x69, 0x15, 0x0a, 0x09, 0x02, 0x84, 0x22,
-	0xfd, 0x76, 0x06, 0x96, 0x43, 0x29, 0x2b, 0x8d, 0xed, 0x1d, 0xd4, 0x45, 0xa6, 0xc6, 0xe3, 0xba,
-	0x0b, 0x59, 0x37, 0x07, 0xe4, 0x34, 0x4f, 0x24, 0x15, 0x78, 0x60, 0x77, 0x31, 0xa8, 0x74, 0xea,
-	0xdd, 0x2a, 0x1d, 0xeb, 0xbb, 0xf4, 0xfb, 0xe8, 0xbb, 0x6f, 0x60, 0x7e, 0xdf, 0x6e, 0x7a, 0x8c,
-	0xcd, 0x2e, 0xa6, 0x4c, 0x9a, 0x2e, 0xa6, 0x27, 0xa0, 0xcd, 0xee, 0xdb, 0x15, 0x97, 0xf8, 0x31,
-	0xa6, 0x4c, 0x5c, 0x85, 0x39, 0x3f, 0xa7, 0x26, 0xc3, 0x3d, 0xc4, 0xbb, 0x3b, 0xa7, 0x66, 0xfd,
-	0xb5, 0x06, 0xee, 0x21, 0xf1, 0x12, 0xe4, 0x02, 0x48, 0x5f, 0xeb, 0x1e, 0x20, 0x29, 0x53, 0x14,
-	0xd6, 0xd2, 0x6a, 0x60, 0xf7, 0x95, 0xbb, 0x26, 0x3e, 0x04, 0x08, 0x79, 0x06, 0xd2, 0x19, 0xae,
-	0xdc, 0xb5, 0xb8, 0x72, 0xb1, 0x63, 0xac, 0xbf, 0xa1, 0x34, 0x1c, 0xcd, 0xa2, 0x9a, 0xee, 0x16,
-	0xea, 0x91, 0xb5, 0x4f, 0xd4, 0xd9, 0xc0, 0xe1, 0x40, 0xdc, 0x84, 0x2c, 0xed, 0x6a, 0xb4, 0xed,
-	0x53, 0x7d, 0xc0, 0x25, 0xfc, 0xf0, 0xe8, 0xb8, 0x90, 0xab, 0x34, 0xb6, 0xeb, 0xfe, 0x4e, 0x63,
-	0xa0, 0x02, 0x0d, 0x7f, 0x8b, 0x04, 0x96, 0x0d, 0xaf, 0xf2, 0xc4, 0x69, 0x86, 0xd6, 0x14, 0x9b,
-	0xd2, 0x2c, 0x37, 0xbf, 0x7b, 0x74, 0x5c, 0xb8, 0x7d, 0x1a, 0xa9, 0xea, 0xd8, 0xb4, 0x34, 0x76,
-	0xe0, 0x20, 0x75, 0x29, 0x24, 0x0e, 0x7c, 0xd7, 0xb1, 0x29, 0x7e, 0x0c, 0xf3, 0x07, 0x56, 0x8b,
-	0x58, 0x46, 0x28, 0x1c, 0x70, 0xe1, 0x72, 0xe1, 0x2a, 0x97, 0x6e, 0x15, 0xe6, 0x62, 0xb0, 0x81,
-	0x94, 0xe5, 0xf3, 0x97, 0x8d, 0x40, 0x03, 0xf1, 0x2a, 0x2c, 0x44, 0x10, 0x4f, 0xdf, 0x39, 0xae,
-	0x6f, 0xe4, 0xc0, 0x53, 0x78, 0x17, 0xce, 0x45, 0xc0, 0xb8, 0x42, 0xb9, 0x24, 0x85, 0xce, 0x86,
-	0xf8, 0x68, 0x51, 0x7c, 0x22, 0x40, 0x31, 0xd2, 0x6a, 0x0c, 0xa3, 0xab, 0xda, 0xfc, 0xa4, 0xaa,
-	0x5d, 0x0c, 0x5d, 0xec, 0x8d, 0xc6, 0x50, 0xc7, 0xe6, 0xd6, 0xa2, 0x3b, 0xe4, 0xf1, 0xf1, 0x2c,
-	0x15, 0x21, 0x3f, 0x7e, 0x8e, 0xc3, 0x51, 0xff, 0x2b, 0x05, 0x62, 0x95, 0x9a, 0xf7, 0x0d, 0x63,
-	0x9b, 0xf4, 0x91, 0xa5, 0x59, 0xac, 0x8e, 0x4d, 0x2a, 0x2e, 0x43, 0x86, 0x62, 0xd3, 0x42, 0xfe,
-	0x84, 0xab, 0xfe, 0x97, 0xf8, 0x00, 0x52, 0xc1, 0x81, 0xf7, 0xce, 0x93, 0x92, 0xb2, 0x3b, 0xe2,
-	0x15, 0x58, 0x88, 0x1a, 0xbb, 0xd9, 0xd6, 0x68, 0xdb, 0xbb, 0x98, 0xd4, 0x5c, 0xd8, 0xb2, 0x0f,
-	0x35, 0xda, 0x16, 0xd7, 0x60, 0x31, 0x56, 0x14, 0x57, 0x45, 0xea, 0xcd, 0xa9, 0x3a, 0x1f, 0x35,
-	0x2a, 0x8f, 0x58, 0x87, 0xc5, 0x78, 0x53, 0x70, 0xc1, 0x67, 0x26, 0x15, 0x7c, 0x3e, 0xd6, 0x53,
-	0x6e, 0x83, 0xde, 0x03, 0x39, 0x0c, 0x67, 0xd4, 0x1b, 0x95, 0x32, 0x3c, 0xb0, 0xf3, 0x01, 0x62,
-	0x6f, 0xc8, 0x96, 0x6e, 0x65, 0xdd, 0xf2, 0xf8, 0x42, 0x96, 0x2e, 0x80, 0xfc, 0x5f, 0xd9, 0xc3,
-	0xaa, 0xfc, 0x2a, 0xc0, 0x62, 0x95, 0x9a, 0x95, 0xc6, 0xf6, 0x9e, 0xe5, 0xd7, 0x1c, 0x25, 0xd6,
-	0x64, 0x8c, 0x96, 0xa9, 0x71, 0x5a, 0x8e, 0x53, 0x28, 0xfd, 0x9e, 0x15, 0x1a, 0x4e, 0x52, 0x06,
-	0x69, 0x34, 0x8b, 0x30, 0xc5, 0x5f, 0x04, 0xb8, 0x50, 0xa5, 0x66, 0x1d, 0x75, 0x91, 0xce, 0x70,
-	0x1f, 0x05, 0x8d, 0xbc, 0xeb, 0x5e, 0x45, 0x96, 0x3e, 0x79, 0xba, 0xeb, 0x70, 0xd6, 0x41, 0x3a,
-	0xe9, 0x23, 0x07, 0x19, 0x4d, 0xff, 0xa8, 0xa7, 0xfe, 0xe5, 0xa1, 0x2e, 0x86, 0x5b, 0x0f, 0xdc,
-	0x63, 0xbb, 0xde, 0x19, 0x0e, 0xfc, 0x0a, 0x5c, 0xfe, 0xbf, 0xd8, 0xc2, 0x24, 0x7e, 0x16, 0x60,
-	0xa1, 0x4a, 0xcd, 0x3d, 0xdb, 0xd0, 0x18, 0xaa, 0xf1, 0xc7, 0xa7, 0x78, 0x07, 0x66, 0xb5, 0x03,
-	0xd6, 0x26, 0x0e, 0x66, 0x87, 0x6f, 0xbd, 0x1f, 0x23, 0xa8, 0x78, 0x0f, 0x32, 0xde, 0xf3, 0xd5,
-	0xbf, 0x21, 0x2f, 0x26, 0xdd, 0x90, 0x1c, 0x54, 0x99, 0x7e, 0x7e, 0x5c, 0x98, 0x52, 0x7d, 0x93,
-	0xad, 0x79, 0x37, 0xfa, 0x88, 0xac, 0xb4, 0xc2, 0x5f, 0x39, 0xf1, 0xb8, 0x82, 0x98, 0x37, 0xff,
-	0xcc, 0x40, 0xba, 0x4a, 0x4d, 0xf1, 0x07, 0x01, 0x96, 0x13, 0x9e, 0xa9, 0x37, 0x13, 0x5c, 0x27,
-	0x3e, 0xaf, 0xe4, 0xcf, 0x4e, 0x6b, 0x11, 0x84, 0x23, 0x7e, 0x07, 0x4b, 0x63, 0x1f, 0x63, 0x4a,
-	0x32, 0xe3, 0x38, 0xbc, 0x7c, 0xe7, 0x74, 0xf8, 0xd0, 0xff, 0xb7, 0x70, 0x76, 0xdc, 0x3b, 0x67,
-	0xfd, 0x6d, 0x09, 0x0d, 0xc1, 0xe5, 0xdb, 0xa7, 0x82, 0x87, 0xce, 0x09, 0x2c, 0x8c, 0x9e, 0xbc,
-	0xd7, 0x92, 0x99, 0x46, 0xa0, 0xf2, 0xc6, 0x89, 0xa1, 0xa1, 0x43, 0x0c, 0xb9, 0xe1, 0x43, 0xe5,
-	0x6a, 0x32, 0xc7, 0x10, 0x50, 0x2e, 0x9f, 0x10, 0x18, 0xba, 0xfa, 0x51, 0x80, 0x95, 0xe4, 0xe9,
-	0xbe, 0x95, 0x4c, 0x97, 0x68, 0x24, 0xdf, 0x7b, 0x07, 0xa3, 0x30, 0x9e, 0x7d, 0x98, 0x1b, 0x9a,
-	0xd3, 0x2b, 0xc9, 0x64, 0x71, 0x9c, 0xac, 0x9c, 0x0c, 0x17, 0xf8, 0x91, 0x67, 0xbe, 0x7f, 0xf3,
-	0xf4, 0xba, 0x50, 0x79, 0xfc, 0xfc, 0x55, 0x5e, 0x78, 0xf1, 0x2a, 0x2f, 0xfc, 0xf1, 0x2a, 0x2f,
-	0xfc, 0xf4, 0x3a, 0x3f, 0xf5, 0xe2, 0x75, 0x7e, 0xea, 0xf7, 0xd7, 0xf9, 0xa9, 0xaf, 0xdf, 0x7a,
-	0x67, 0x0e, 0xe2, 0xff, 0x2e, 0xf9, 0xb1, 0xdb, 0xca, 0xf0, 0x7f, 0x97, 0xb7, 0xfe, 0x0d, 0x00,
-	0x00, 0xff, 0xff, 0xf6, 0xe9, 0xb3, 0x62, 0x79, 0x0f, 0x00, 0x00,
+func (m *MsgAddCovenantSigs) Reset()         { *m = MsgAddCovenantSigs{} }
+func (m *MsgAddCovenantSigs) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCovenantSigs) ProtoMessage()    {}
+func (*MsgAddCovenantSigs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{14}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateFinalityProvider creates a new finality provider
-	CreateFinalityProvider(ctx context.Context, in *MsgCreateFinalityProvider, opts ...grpc.CallOption) (*MsgCreateFinalityProviderResponse, error)
-	// EditFinalityProvider edits an existing finality provider
-	EditFinalityProvider(ctx context.Context, in *MsgEditFinalityProvider, opts ...grpc.CallOption) (*MsgEditFinalityProviderResponse, error)
-	// CreateBTCDelegation creates a new BTC delegation
-	CreateBTCDelegation(ctx context.Context, in *MsgCreateBTCDelegation, opts ...grpc.CallOption) (*MsgCreateBTCDelegationResponse, error)
-	// AddCovenantSigs handles signatures from a covenant member
-	AddCovenantSigs(ctx context.Context, in *MsgAddCovenantSigs, opts ...grpc.CallOption) (*MsgAddCovenantSigsResponse, error)
-	// BTCUndelegate handles a signature on unbonding tx from its delegator
-	BTCUndelegate(ctx context.Context, in *MsgBTCUndelegate, opts ...grpc.CallOption) (*MsgBTCUndelegateResponse, error)
-	// SelectiveSlashingEvidence handles the evidence of selective slashing launched
-	// by a finality provider
-	SelectiveSlashingEvidence(ctx context.Context, in *MsgSelectiveSlashingEvidence, opts ...grpc.CallOption) (*MsgSelectiveSlashingEvidenceResponse, error)
-	// UpdateParams updates the btcstaking module parameters.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgAddCovenantSigs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgAddCovenantSigs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCovenantSigs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgAddCovenantSigs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCovenantSigs.Merge(m, src)
 }
-
-func (c *msgClient) CreateFinalityProvider(ctx context.Context, in *MsgCreateFinalityProvider, opts ...grpc.CallOption) (*MsgCreateFinalityProviderResponse, error) {
-	out := new(MsgCreateFinalityProviderResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/CreateFinalityProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgAddCovenantSigs) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) EditFinalityProvider(ctx context.Context, in *MsgEditFinalityProvider, opts ...grpc.CallOption) (*MsgEditFinalityProviderResponse, error) {
-	out := new(MsgEditFinalityProviderResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/EditFinalityProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgAddCovenantSigs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCovenantSigs.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateBTCDelegation(ctx context.Context, in *MsgCreateBTCDelegation, opts ...grpc.CallOption) (*MsgCreateBTCDelegationResponse, error) {
-	out := new(MsgCreateBTCDelegationResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/CreateBTCDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgAddCovenantSigs proto.InternalMessageInfo
 
-func (c *msgClient) AddCovenantSigs(ctx context.Context, in *MsgAddCovenantSigs, opts ...grpc.CallOption) (*MsgAddCovenantSigsResponse, error) {
-	out := new(MsgAddCovenantSigsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/AddCovenantSigs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddCovenantSigs) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) BTCUndelegate(ctx context.Context, in *MsgBTCUndelegate, opts ...grpc.CallOption) (*MsgBTCUndelegateResponse, error) {
-	out := new(MsgBTCUndelegateResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/BTCUndelegate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddCovenantSigs) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SelectiveSlashingEvidence(ctx context.Context, in *MsgSelectiveSlashingEvidence, opts ...grpc.CallOption) (*MsgSelectiveSlashingEvidenceResponse, error) {
-	out := new(MsgSelectiveSlashingEvidenceResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/SelectiveSlashingEvidence", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddCovenantSigs) GetSlashingTxSigs() [][]byte {
+	if m != nil {
+		return m.SlashingTxSigs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddCovenantSigs) GetSlashingUnbondingTxSigs() [][]byte {
+	if m != nil {
+		return m.SlashingUnbondingTxSigs
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateFinalityProvider creates a new finality provider
-	CreateFinalityProvider(context.Context, *MsgCreateFinalityProvider) (*MsgCreateFinalityProviderResponse, error)
-	// EditFinalityProvider edits an existing finality provider
-	EditFinalityProvider(context.Context, *MsgEditFinalityProvider) (*MsgEditFinalityProviderResponse, error)
-	// CreateBTCDelegation creates a new BTC delegation
-	CreateBTCDelegation(context.Context, *MsgCreateBTCDelegation) (*MsgCreateBTCDelegationResponse, error)
-	// AddCovenantSigs handles signatures from a covenant member
-	AddCovenantSigs(context.Context, *MsgAddCovenantSigs) (*MsgAddCovenantSigsResponse, error)
-	// BTCUndelegate handles a signature on unbonding tx from its delegator
-	BTCUndelegate(context.Context, *MsgBTCUndelegate) (*MsgBTCUndelegateResponse, error)
-	// SelectiveSlashingEvidence handles the evidence of selective slashing launched
-	// by a finality provider
-	SelectiveSlashingEvidence(context.Context, *MsgSelectiveSlashingEvidence) (*MsgSelectiveSlashingEvidenceResponse, error)
-	// UpdateParams updates the btcstaking module parameters.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+// MsgAddCovenantSigsResponse is the response for MsgAddCovenantSigs
+type MsgAddCovenantSigsResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgAddCovenantSigsResponse) Reset()         { *m = MsgAddCovenantSigsResponse{} }
+func (m *MsgAddCovenantSigsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCovenantSigsResponse) ProtoMessage()    {}
+func (*MsgAddCovenantSigsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{15}
 }
-
-func (*UnimplementedMsgServer) CreateFinalityProvider(ctx context.Context, req *MsgCreateFinalityProvider) (*MsgCreateFinalityProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateFinalityProvider not implemented")
+func (m *MsgAddCovenantSigsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) EditFinalityProvider(ctx context.Context, req *MsgEditFinalityProvider) (*MsgEditFinalityProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditFinalityProvider not implemented")
+func (m *MsgAddCovenantSigsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCovenantSigsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CreateBTCDelegation(ctx context.Context, req *MsgCreateBTCDelegation) (*MsgCreateBTCDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBTCDelegation not implemented")
+func (m *MsgAddCovenantSigsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCovenantSigsResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) AddCovenantSigs(ctx context.Context, req *MsgAddCovenantSigs) (*MsgAddCovenantSigsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddCovenantSigs not implemented")
+func (m *MsgAddCovenantSigsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) BTCUndelegate(ctx context.Context, req *MsgBTCUndelegate) (*MsgBTCUndelegateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BTCUndelegate not implemented")
-}
-func (*UnimplementedMsgServer) SelectiveSlashingEvidence(ctx context.Context, req *MsgSelectiveSlashingEvidence) (*MsgSelectiveSlashingEvidenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SelectiveSlashingEvidence not implemented")
-}
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgAddCovenantSigsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCovenantSigsResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgAddCovenantSigsResponse proto.InternalMessageInfo
+
+// MsgBTCUndelegate is the message for handling signature on unbonding tx
+// from its delegator. This signature effectively proves that the delegator
+// wants to unbond this BTC delegation
+type MsgBTCUndelegate struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// unbonding_tx_sig is the signature of the staker on the unbonding tx submitted to babylon
+	// the signature follows encoding in BIP-340 spec
+	UnbondingTxSig *github_com_babylonchain_babylon_types.BIP340Signature `protobuf:"bytes,3,opt,name=unbonding_tx_sig,json=unbondingTxSig,proto3,customtype=github.com/babylonchain/babylon/types.BIP340Signature" json:"unbonding_tx_sig,omitempty"`
 }
 
-func _Msg_CreateFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateFinalityProvider)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateFinalityProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/CreateFinalityProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateFinalityProvider(ctx, req.(*MsgCreateFinalityProvider))
+func (m *MsgBTCUndelegate) Reset()         { *m = MsgBTCUndelegate{} }
+func (m *MsgBTCUndelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgBTCUndelegate) ProtoMessage()    {}
+func (*MsgBTCUndelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{16}
+}
+func (m *MsgBTCUndelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBTCUndelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBTCUndelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgBTCUndelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBTCUndelegate.Merge(m, src)
+}
+func (m *MsgBTCUndelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBTCUndelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBTCUndelegate.DiscardUnknown(m)
 }
 
-func _Msg_EditFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEditFinalityProvider)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).EditFinalityProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/EditFinalityProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EditFinalityProvider(ctx, req.(*MsgEditFinalityProvider))
+var xxx_messageInfo_MsgBTCUndelegate proto.InternalMessageInfo
+
+func (m *MsgBTCUndelegate) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateBTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateBTCDelegation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateBTCDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/CreateBTCDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateBTCDelegation(ctx, req.(*MsgCreateBTCDelegation))
+func (m *MsgBTCUndelegate) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_AddCovenantSigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddCovenantSigs)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddCovenantSigs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/AddCovenantSigs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddCovenantSigs(ctx, req.(*MsgAddCovenantSigs))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgBTCUndelegateResponse is the response for MsgBTCUndelegate
+type MsgBTCUndelegateResponse struct {
 }
 
-func _Msg_BTCUndelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBTCUndelegate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BTCUndelegate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/BTCUndelegate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BTCUndelegate(ctx, req.(*MsgBTCUndelegate))
+func (m *MsgBTCUndelegateResponse) Reset()         { *m = MsgBTCUndelegateResponse{} }
+func (m *MsgBTCUndelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBTCUndelegateResponse) ProtoMessage()    {}
+func (*MsgBTCUndelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{17}
+}
+func (m *MsgBTCUndelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBTCUndelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBTCUndelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgBTCUndelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBTCUndelegateResponse.Merge(m, src)
+}
+func (m *MsgBTCUndelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBTCUndelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBTCUndelegateResponse.DiscardUnknown(m)
 }
 
-func _Msg_SelectiveSlashingEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSelectiveSlashingEvidence)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SelectiveSlashingEvidence(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/SelectiveSlashingEvidence",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SelectiveSlashingEvidence(ctx, req.(*MsgSelectiveSlashingEvidence))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgBTCUndelegateResponse proto.InternalMessageInfo
+
+// MsgSelectiveSlashingEvidence is the message for handling evidence of selective slashing
+// launched by a finality provider
+type MsgSelectiveSlashingEvidence struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// recovered_fp_btc_sk is the BTC SK of the finality provider who
+	// launches the selective slashing offence. The SK is recovered by
+	// using a covenant adaptor signature and the corresponding Schnorr
+	// signature
+	RecoveredFpBtcSk []byte `protobuf:"bytes,3,opt,name=recovered_fp_btc_sk,json=recoveredFpBtcSk,proto3" json:"recovered_fp_btc_sk,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSelectiveSlashingEvidence) Reset()         { *m = MsgSelectiveSlashingEvidence{} }
+func (m *MsgSelectiveSlashingEvidence) String() string { return proto.CompactTextString(m) }
+func (*MsgSelectiveSlashingEvidence) ProtoMessage()    {}
+func (*MsgSelectiveSlashingEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{18}
+}
+func (m *MsgSelectiveSlashingEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSelectiveSlashingEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSelectiveSlashingEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+}
+func (m *MsgSelectiveSlashingEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSelectiveSlashingEvidence.Merge(m, src)
+}
+func (m *MsgSelectiveSlashingEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSelectiveSlashingEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSelectiveSlashingEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSelectiveSlashingEvidence proto.InternalMessageInfo
+
+func (m *MsgSelectiveSlashingEvidence) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/UpdateParams",
+	return ""
+}
+
+func (m *MsgSelectiveSlashingEvidence) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	return ""
+}
+
+func (m *MsgSelectiveSlashingEvidence) GetRecoveredFpBtcSk() []byte {
+	if m != nil {
+		return m.RecoveredFpBtcSk
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.btcstaking.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateFinalityProvider",
-			Handler:    _Msg_CreateFinalityProvider_Handler,
-		},
-		{
-			MethodName: "EditFinalityProvider",
-			Handler:    _Msg_EditFinalityProvider_Handler,
-		},
-		{
-			MethodName: "CreateBTCDelegation",
-			Handler:    _Msg_CreateBTCDelegation_Handler,
-		},
-		{
-			MethodName: "AddCovenantSigs",
-			Handler:    _Msg_AddCovenantSigs_Handler,
-		},
-		{
-			MethodName: "BTCUndelegate",
-			Handler:    _Msg_BTCUndelegate_Handler,
-		},
-		{
-			MethodName: "SelectiveSlashingEvidence",
-			Handler:    _Msg_SelectiveSlashingEvidence_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylon/btcstaking/v1/tx.proto",
+// MsgSelectiveSlashingEvidenceResponse is the response for MsgSelectiveSlashingEvidence
+type MsgSelectiveSlashingEvidenceResponse struct {
 }
 
-func (m *MsgCreateFinalityProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSelectiveSlashingEvidenceResponse) Reset()         { *m = MsgSelectiveSlashingEvidenceResponse{} }
+func (m *MsgSelectiveSlashingEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSelectiveSlashingEvidenceResponse) ProtoMessage()    {}
+func (*MsgSelectiveSlashingEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{19}
+}
+func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSelectiveSlashingEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSelectiveSlashingEvidenceResponse.Merge(m, src)
+}
+func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSelectiveSlashingEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSelectiveSlashingEvidenceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSelectiveSlashingEvidenceResponse proto.InternalMessageInfo
+
+// MsgUpdateParams defines a message for updating btcstaking module parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	// just FYI: cosmos.AddressString marks that this field should use type alias
+	// for AddressString instead of string, but the functionality is not yet implemented
+	// in cosmos-proto
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the finality parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgCreateFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pop != nil {
-		{
-			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.BtcPk != nil {
-		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Commission != nil {
-		{
-			size := m.Commission.Size()
-			i -= size
-			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Description != nil {
-		{
-			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{20}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgCreateFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func (m *MsgCreateFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateParamsResponse is the response to the MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgEditFinalityProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{21}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgEditFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgRotateCovenantCommittee defines a message for scheduling the rotation of
+// the covenant committee. It can only be executed via a governance proposal.
+type MsgRotateCovenantCommittee struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// new_covenant_pks is the list of public keys held by the new covenant
+	// committee
+	NewCovenantPks []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,rep,name=new_covenant_pks,json=newCovenantPks,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"new_covenant_pks,omitempty"`
+	// new_covenant_quorum is the minimum number of signatures needed for the
+	// multisignature of the new covenant committee
+	NewCovenantQuorum uint32 `protobuf:"varint,3,opt,name=new_covenant_quorum,json=newCovenantQuorum,proto3" json:"new_covenant_quorum,omitempty"`
+	// activation_height is the Babylon height from which the new covenant
+	// committee is used for new BTC delegations. It has to be higher than the
+	// current height, such that the retiring covenant members can pre-sign the
+	// pending BTC delegations in the meantime
+	ActivationHeight uint64 `protobuf:"varint,4,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *MsgRotateCovenantCommittee) Reset()         { *m = MsgRotateCovenantCommittee{} }
+func (m *MsgRotateCovenantCommittee) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateCovenantCommittee) ProtoMessage()    {}
+func (*MsgRotateCovenantCommittee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{22}
+}
+func (m *MsgRotateCovenantCommittee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateCovenantCommittee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateCovenantCommittee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateCovenantCommittee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateCovenantCommittee.Merge(m, src)
+}
+func (m *MsgRotateCovenantCommittee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateCovenantCommittee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateCovenantCommittee.DiscardUnknown(m)
 }
 
-func (m *MsgEditFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Commission != nil {
-		{
-			size := m.Commission.Size()
-			i -= size
-			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+var xxx_messageInfo_MsgRotateCovenantCommittee proto.InternalMessageInfo
+
+func (m *MsgRotateCovenantCommittee) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if m.Description != nil {
-		{
-			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *MsgRotateCovenantCommittee) GetNewCovenantQuorum() uint32 {
+	if m != nil {
+		return m.NewCovenantQuorum
 	}
-	if len(m.BtcPk) > 0 {
-		i -= len(m.BtcPk)
-		copy(dAtA[i:], m.BtcPk)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BtcPk)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *MsgRotateCovenantCommittee) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
 	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+// MsgRotateCovenantCommitteeResponse is the response to the
+// MsgRotateCovenantCommittee message.
+type MsgRotateCovenantCommitteeResponse struct {
+}
+
+func (m *MsgRotateCovenantCommitteeResponse) Reset()         { *m = MsgRotateCovenantCommitteeResponse{} }
+func (m *MsgRotateCovenantCommitteeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateCovenantCommitteeResponse) ProtoMessage()    {}
+func (*MsgRotateCovenantCommitteeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{23}
+}
+func (m *MsgRotateCovenantCommitteeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateCovenantCommitteeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateCovenantCommitteeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRotateCovenantCommitteeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateCovenantCommitteeResponse.Merge(m, src)
+}
+func (m *MsgRotateCovenantCommitteeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateCovenantCommitteeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateCovenantCommitteeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgEditFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRotateCovenantCommitteeResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateFinalityProvider)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProvider")
+	proto.RegisterType((*MsgCreateFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProviderResponse")
+	proto.RegisterType((*MsgEditFinalityProvider)(nil), "babylon.btcstaking.v1.MsgEditFinalityProvider")
+	proto.RegisterType((*MsgEditFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgEditFinalityProviderResponse")
+	proto.RegisterType((*MsgCreateBTCDelegation)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegation")
+	proto.RegisterType((*MsgCreateBTCDelegationResponse)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegationResponse")
+	proto.RegisterType((*MsgExpandBTCDelegation)(nil), "babylon.btcstaking.v1.MsgExpandBTCDelegation")
+	proto.RegisterType((*MsgExpandBTCDelegationResponse)(nil), "babylon.btcstaking.v1.MsgExpandBTCDelegationResponse")
+	proto.RegisterType((*MsgAddBTCDelegationInclusionProof)(nil), "babylon.btcstaking.v1.MsgAddBTCDelegationInclusionProof")
+	proto.RegisterType((*MsgAddBTCDelegationInclusionProofResponse)(nil), "babylon.btcstaking.v1.MsgAddBTCDelegationInclusionProofResponse")
+	proto.RegisterType((*MsgBTCRedelegate)(nil), "babylon.btcstaking.v1.MsgBTCRedelegate")
+	proto.RegisterType((*MsgBTCRedelegateResponse)(nil), "babylon.btcstaking.v1.MsgBTCRedelegateResponse")
+	proto.RegisterType((*MsgRotateFinalityProviderKey)(nil), "babylon.btcstaking.v1.MsgRotateFinalityProviderKey")
+	proto.RegisterType((*MsgRotateFinalityProviderKeyResponse)(nil), "babylon.btcstaking.v1.MsgRotateFinalityProviderKeyResponse")
+	proto.RegisterType((*MsgAddCovenantSigs)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigs")
+	proto.RegisterType((*MsgAddCovenantSigsResponse)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigsResponse")
+	proto.RegisterType((*MsgBTCUndelegate)(nil), "babylon.btcstaking.v1.MsgBTCUndelegate")
+	proto.RegisterType((*MsgBTCUndelegateResponse)(nil), "babylon.btcstaking.v1.MsgBTCUndelegateResponse")
+	proto.RegisterType((*MsgSelectiveSlashingEvidence)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidence")
+	proto.RegisterType((*MsgSelectiveSlashingEvidenceResponse)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidenceResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.btcstaking.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.btcstaking.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRotateCovenantCommittee)(nil), "babylon.btcstaking.v1.MsgRotateCovenantCommittee")
+	proto.RegisterType((*MsgRotateCovenantCommitteeResponse)(nil), "babylon.btcstaking.v1.MsgRotateCovenantCommitteeResponse")
+}
+
+func init() { proto.RegisterFile("babylon/btcstaking/v1/tx.proto", fileDescriptor_4baddb53e97f38f2) }
+
+var fileDescriptor_4baddb53e97f38f2 = []byte{
+	// 1861 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x0f, 0x2d, 0x59, 0xb6, 0x9f, 0x3e, 0xec, 0x30, 0x71, 0xac, 0xb0, 0x1b, 0xd9, 0x71, 0xd2,
+	0xac, 0xb3, 0x59, 0x4b, 0x6b, 0xa7, 0x49, 0x9b, 0x04, 0x05, 0xba, 0xb2, 0x1d, 0x24, 0xd8, 0xb8,
+	0x55, 0x29, 0xbb, 0x87, 0xb6, 0x00, 0x4b, 0x91, 0x63, 0x8a, 0x90, 0xc4, 0x61, 0x39, 0x94, 0x22,
+	0xa3, 0x40, 0x51, 0x2c, 0x5a, 0x14, 0x28, 0x50, 0xa0, 0xa7, 0x1e, 0x8a, 0xde, 0xfa, 0x0f, 0xec,
+	0x61, 0x4f, 0x3d, 0xf5, 0x50, 0x14, 0x7b, 0x0c, 0xf6, 0x54, 0xe4, 0x60, 0x14, 0xc9, 0x61, 0x81,
+	0xfe, 0x13, 0x2d, 0x66, 0x48, 0x0e, 0x29, 0x9b, 0xb4, 0xbe, 0xbc, 0xd9, 0x9b, 0x38, 0xf3, 0x7b,
+	0x1f, 0xf3, 0xe6, 0xfd, 0xde, 0x9b, 0x19, 0x41, 0xa9, 0xa1, 0x36, 0x8e, 0xdb, 0xd8, 0xaa, 0x34,
+	0x5c, 0x8d, 0xb8, 0x6a, 0xcb, 0xb4, 0x8c, 0x4a, 0x6f, 0xab, 0xe2, 0xf6, 0xcb, 0xb6, 0x83, 0x5d,
+	0x2c, 0x2e, 0xfb, 0xf3, 0xe5, 0x70, 0xbe, 0xdc, 0xdb, 0x92, 0xae, 0x1a, 0xd8, 0xc0, 0x0c, 0x51,
+	0xa1, 0xbf, 0x3c, 0xb0, 0x74, 0x5d, 0xc3, 0xa4, 0x83, 0x89, 0xe2, 0x4d, 0x78, 0x1f, 0xfe, 0xd4,
+	0x8a, 0xf7, 0x55, 0xe9, 0x10, 0xa6, 0xbf, 0x43, 0x0c, 0x7f, 0x62, 0x3d, 0xde, 0x01, 0x5b, 0x75,
+	0xd4, 0x4e, 0x20, 0xfc, 0x61, 0x04, 0xa3, 0x35, 0x91, 0xd6, 0xb2, 0xb1, 0x69, 0xb9, 0x14, 0x36,
+	0x30, 0xe0, 0xa3, 0x6f, 0xfb, 0xa6, 0x42, 0x6d, 0x0d, 0xe4, 0xaa, 0x5b, 0xc1, 0xb7, 0x8f, 0x5a,
+	0x4d, 0xb0, 0x8b, 0x6d, 0x0f, 0xb0, 0xfe, 0xf7, 0x34, 0x5c, 0xdf, 0x27, 0xc6, 0x8e, 0x83, 0x54,
+	0x17, 0x3d, 0x35, 0x2d, 0xb5, 0x6d, 0xba, 0xc7, 0x35, 0x07, 0xf7, 0x4c, 0x1d, 0x39, 0xe2, 0x87,
+	0x90, 0x56, 0x75, 0xdd, 0x29, 0x0a, 0x6b, 0xc2, 0xc6, 0x42, 0xb5, 0xf8, 0xe5, 0xe7, 0x9b, 0x57,
+	0xfd, 0xf5, 0x7e, 0xac, 0xeb, 0x0e, 0x22, 0xa4, 0xee, 0x3a, 0xa6, 0x65, 0xc8, 0x0c, 0x25, 0xee,
+	0x41, 0x56, 0x47, 0x44, 0x73, 0x4c, 0xdb, 0x35, 0xb1, 0x55, 0x9c, 0x59, 0x13, 0x36, 0xb2, 0xdb,
+	0xb7, 0xca, 0xbe, 0x44, 0x18, 0x57, 0xe6, 0x68, 0x79, 0x37, 0x84, 0xca, 0x51, 0x39, 0x71, 0x1f,
+	0x40, 0xc3, 0x9d, 0x8e, 0x49, 0x08, 0xd5, 0x92, 0x62, 0xa6, 0x37, 0x5f, 0x9f, 0xac, 0x7e, 0xcb,
+	0x53, 0x44, 0xf4, 0x56, 0xd9, 0xc4, 0x95, 0x8e, 0xea, 0x36, 0xcb, 0x2f, 0x90, 0xa1, 0x6a, 0xc7,
+	0xbb, 0x48, 0xfb, 0xf2, 0xf3, 0x4d, 0xf0, 0xed, 0xec, 0x22, 0x4d, 0x8e, 0x28, 0x10, 0xf7, 0x21,
+	0xd3, 0x70, 0x35, 0xc5, 0x6e, 0x15, 0xd3, 0x6b, 0xc2, 0x46, 0xae, 0xfa, 0xf0, 0xf5, 0xc9, 0xea,
+	0xb6, 0x61, 0xba, 0xcd, 0x6e, 0xa3, 0xac, 0xe1, 0x4e, 0xc5, 0x8f, 0x90, 0xd6, 0x54, 0x4d, 0x2b,
+	0xf8, 0xa8, 0xb8, 0xc7, 0x36, 0x22, 0xe5, 0xea, 0xf3, 0xda, 0xfd, 0xef, 0x7c, 0x54, 0xeb, 0x36,
+	0x3e, 0x41, 0xc7, 0xf2, 0x6c, 0xc3, 0xd5, 0x6a, 0x2d, 0xf1, 0xfb, 0x90, 0xb2, 0xb1, 0x5d, 0x9c,
+	0x65, 0x8b, 0xbb, 0x57, 0x8e, 0x4d, 0x9c, 0x72, 0xcd, 0xc1, 0xf8, 0xe8, 0x47, 0x47, 0x35, 0x4c,
+	0x08, 0x62, 0x5e, 0x54, 0x0f, 0x76, 0x64, 0x2a, 0x27, 0x3e, 0x83, 0xf9, 0x8e, 0xda, 0x57, 0x1c,
+	0xd5, 0x45, 0xc5, 0xcc, 0x24, 0x4b, 0x9b, 0xeb, 0xa8, 0x7d, 0x59, 0x75, 0x91, 0x78, 0x08, 0x8b,
+	0x54, 0x93, 0xd6, 0x54, 0x2d, 0x03, 0x79, 0x0a, 0xe7, 0x26, 0x51, 0x98, 0xef, 0xa8, 0xfd, 0x1d,
+	0xa6, 0x84, 0xa9, 0x5d, 0x85, 0xac, 0x86, 0x2d, 0xd2, 0xed, 0x20, 0x47, 0x31, 0xf5, 0xe2, 0x3c,
+	0x55, 0x29, 0x43, 0x30, 0xf4, 0x5c, 0x7f, 0xbc, 0xf0, 0xe9, 0x57, 0x9f, 0x7d, 0xc0, 0x36, 0x7c,
+	0xfd, 0x16, 0xdc, 0x4c, 0xcc, 0x1d, 0x19, 0x11, 0x1b, 0x5b, 0x04, 0xad, 0xff, 0x4f, 0x80, 0x95,
+	0x7d, 0x62, 0xec, 0xe9, 0xa6, 0x3b, 0x65, 0x7e, 0x2d, 0xf3, 0x9d, 0xa4, 0xa9, 0x95, 0x0b, 0x76,
+	0xe4, 0x54, 0xda, 0xa5, 0x2e, 0x24, 0xed, 0xd2, 0x53, 0xa6, 0x5d, 0x34, 0x4c, 0x37, 0x61, 0x35,
+	0x21, 0x00, 0x3c, 0x48, 0xff, 0x9a, 0x83, 0x6b, 0x3c, 0x94, 0xd5, 0x83, 0x9d, 0x5d, 0xd4, 0x46,
+	0x86, 0xca, 0xfc, 0x7a, 0x04, 0x59, 0xba, 0x06, 0xe4, 0x28, 0x23, 0x85, 0x0a, 0x3c, 0x30, 0x1d,
+	0x0c, 0x72, 0x75, 0x66, 0xc2, 0x5c, 0x0d, 0x99, 0x93, 0xba, 0x08, 0xe6, 0xfc, 0x0c, 0x0a, 0x47,
+	0xb6, 0xe2, 0x69, 0x54, 0xda, 0x26, 0x71, 0x8b, 0xe9, 0xb5, 0xd4, 0x14, 0x6a, 0xb3, 0x47, 0x76,
+	0x95, 0x2a, 0x7e, 0x61, 0x12, 0x57, 0xbc, 0x09, 0x39, 0x7f, 0x4d, 0x8a, 0x6b, 0x76, 0x10, 0xe3,
+	0x67, 0x5e, 0xce, 0xfa, 0x63, 0x07, 0x66, 0x07, 0x89, 0xb7, 0x20, 0x1f, 0x40, 0x7a, 0x6a, 0xbb,
+	0xeb, 0xf1, 0x2f, 0x25, 0x07, 0x72, 0x3f, 0xa1, 0x63, 0xe2, 0x33, 0x00, 0xae, 0xa7, 0xcf, 0x08,
+	0x95, 0xdd, 0xbe, 0x1b, 0x8d, 0x5c, 0xa4, 0x10, 0xf7, 0xb6, 0xca, 0x07, 0x8e, 0x6a, 0x11, 0x55,
+	0xa3, 0x1b, 0xf5, 0xdc, 0x3a, 0xc2, 0xf2, 0x42, 0x60, 0xb0, 0x2f, 0x6e, 0x43, 0x96, 0xb4, 0x55,
+	0xd2, 0xf4, 0x55, 0xcd, 0xb3, 0x10, 0x5e, 0x7e, 0x7d, 0xb2, 0x9a, 0xaf, 0x1e, 0xec, 0xd4, 0xfd,
+	0x99, 0x83, 0xbe, 0x0c, 0x84, 0xff, 0x16, 0x31, 0x5c, 0xd3, 0xbd, 0x9d, 0xc7, 0x8e, 0xc2, 0xa5,
+	0x89, 0x69, 0x14, 0x17, 0x98, 0xf8, 0xa3, 0xd7, 0x27, 0xab, 0x0f, 0xc6, 0x09, 0x55, 0xdd, 0x34,
+	0x2c, 0xd5, 0xed, 0x3a, 0x48, 0xbe, 0xca, 0x15, 0x07, 0xb6, 0xeb, 0xa6, 0x21, 0x7e, 0x1b, 0x0a,
+	0x5d, 0xab, 0x81, 0x2d, 0x9d, 0x07, 0x0e, 0x58, 0xe0, 0xf2, 0x7c, 0x94, 0x85, 0xee, 0x26, 0xe4,
+	0x22, 0xb0, 0x7e, 0x31, 0xcb, 0xf8, 0x97, 0x0d, 0x41, 0x7d, 0xf1, 0x7d, 0x58, 0x0c, 0x21, 0x5e,
+	0x7c, 0x73, 0x2c, 0xbe, 0xa1, 0x01, 0x2f, 0xc2, 0x7b, 0xb0, 0x1c, 0x02, 0xa3, 0x11, 0xca, 0x27,
+	0x45, 0xe8, 0x0a, 0xc7, 0x87, 0x83, 0xe2, 0xa7, 0x02, 0xac, 0x85, 0xb1, 0x8a, 0xd1, 0x48, 0xa3,
+	0x56, 0x98, 0x36, 0x6a, 0x37, 0xb8, 0x89, 0xc3, 0xd3, 0x3e, 0xd4, 0x4d, 0xe3, 0xf1, 0x12, 0x25,
+	0x79, 0x94, 0x9e, 0xeb, 0x6b, 0x50, 0x8a, 0xe7, 0x31, 0xa7, 0xfa, 0x1f, 0x32, 0x8c, 0xea, 0x7b,
+	0x7d, 0x5b, 0xb5, 0xf4, 0x0b, 0xa3, 0xfa, 0x77, 0xa1, 0x68, 0x3b, 0xa8, 0x67, 0xe2, 0x2e, 0x51,
+	0xc2, 0x04, 0x56, 0x9a, 0x2a, 0x69, 0x32, 0xfe, 0x2f, 0xc8, 0xcb, 0xc1, 0x7c, 0x3d, 0x48, 0xd1,
+	0x67, 0x2a, 0x69, 0x9e, 0x21, 0x4e, 0x6a, 0x04, 0xe2, 0xa4, 0x63, 0x88, 0x73, 0x63, 0x80, 0x38,
+	0xb3, 0x2c, 0x41, 0x92, 0xd9, 0x90, 0x99, 0x8e, 0x0d, 0x73, 0xef, 0x8a, 0x0d, 0xf3, 0xa3, 0xb0,
+	0x61, 0x61, 0x24, 0x36, 0xc0, 0x78, 0x6c, 0xc8, 0x5e, 0x3c, 0x1b, 0x72, 0x5f, 0x2f, 0x1b, 0xe8,
+	0xd1, 0xe1, 0xa8, 0x1b, 0x44, 0x85, 0x14, 0xf3, 0xb4, 0xba, 0xcb, 0xe0, 0x0f, 0x1d, 0xf4, 0x49,
+	0x22, 0x5d, 0x62, 0xb8, 0xc0, 0xe9, 0xf2, 0x5f, 0x81, 0x1d, 0x32, 0x3e, 0xd6, 0x07, 0xe7, 0x9f,
+	0x5b, 0x5a, 0xbb, 0x4b, 0x4c, 0x6c, 0xb1, 0xf6, 0x25, 0x5e, 0x83, 0x0c, 0x31, 0x0d, 0x0b, 0xf9,
+	0xa4, 0x91, 0xfd, 0x2f, 0xf1, 0x0e, 0x2c, 0xc6, 0xb3, 0x21, 0x4f, 0x06, 0x58, 0xf0, 0x43, 0x28,
+	0x44, 0x70, 0x2d, 0x74, 0xec, 0x1f, 0x23, 0x36, 0x46, 0x2a, 0xfd, 0xb4, 0x1b, 0xe5, 0xb8, 0xc2,
+	0x4f, 0xd0, 0xb1, 0xb8, 0x01, 0x4b, 0x11, 0x7d, 0x36, 0xf5, 0xd1, 0x3b, 0x7e, 0xca, 0x05, 0x8e,
+	0x63, 0x9e, 0x3f, 0xce, 0xd2, 0x98, 0xf8, 0xee, 0xae, 0xdf, 0x83, 0xbb, 0x43, 0xd7, 0xca, 0x23,
+	0xf3, 0x8f, 0x0c, 0x2c, 0xed, 0x13, 0x83, 0xb6, 0x6b, 0xe4, 0x6f, 0x0c, 0xfa, 0x46, 0x4a, 0xc8,
+	0xd9, 0xc6, 0x9e, 0xfa, 0xfa, 0x1a, 0x7b, 0x7a, 0x84, 0xfa, 0x34, 0x3b, 0xb4, 0x3e, 0x65, 0x86,
+	0xd4, 0xa7, 0xb9, 0xe9, 0xea, 0xd3, 0xfc, 0xbb, 0xaa, 0x4f, 0x0b, 0xa3, 0xd4, 0x27, 0x18, 0xa9,
+	0x3e, 0x65, 0xc7, 0xab, 0x4f, 0xb9, 0x8b, 0xaf, 0x4f, 0xf9, 0x77, 0xde, 0xad, 0x25, 0x28, 0x9e,
+	0x66, 0x10, 0xa7, 0xd7, 0xab, 0x14, 0xbc, 0xb7, 0x4f, 0x0c, 0x19, 0xbb, 0x31, 0xb7, 0x1b, 0xca,
+	0xf1, 0xf1, 0x2e, 0x2f, 0x07, 0x00, 0xb8, 0xad, 0x2b, 0xd1, 0x0b, 0xcc, 0xc4, 0x04, 0x99, 0xc7,
+	0x6d, 0x9d, 0x31, 0x84, 0x6a, 0xb5, 0xd0, 0x4b, 0xe5, 0x42, 0x8e, 0xe9, 0xf3, 0x16, 0x7a, 0xe9,
+	0x69, 0xdd, 0x85, 0x39, 0xaa, 0x95, 0xde, 0x1d, 0xd2, 0xe3, 0xdf, 0x1d, 0x32, 0x16, 0x7a, 0x59,
+	0xc3, 0x36, 0xcd, 0x31, 0x87, 0x06, 0xcf, 0xc4, 0x96, 0xd2, 0x44, 0xa6, 0xd1, 0x74, 0x19, 0x31,
+	0xd3, 0x72, 0x21, 0x18, 0x7e, 0xc6, 0x46, 0xc5, 0x9f, 0x43, 0xae, 0xa9, 0x5a, 0x3a, 0xee, 0x21,
+	0x87, 0xe5, 0x41, 0x66, 0xda, 0x3c, 0xc8, 0x06, 0xea, 0xe8, 0xae, 0x47, 0x2e, 0x62, 0x77, 0xe0,
+	0xf6, 0x79, 0x3b, 0xca, 0xb7, 0xfe, 0x6d, 0x0a, 0x44, 0xaf, 0x0e, 0xef, 0xe0, 0x1e, 0xb2, 0x54,
+	0xcb, 0xad, 0x9b, 0x06, 0x49, 0x6c, 0x32, 0x4f, 0x61, 0x66, 0xea, 0x2d, 0x9d, 0xb1, 0x5b, 0x71,
+	0xcd, 0x2a, 0x15, 0xd7, 0xac, 0x68, 0x73, 0x09, 0x99, 0x48, 0x43, 0x46, 0xbc, 0xab, 0x94, 0x5c,
+	0x08, 0xab, 0x13, 0xf3, 0x58, 0x83, 0xa5, 0x68, 0x25, 0x60, 0xd1, 0x9d, 0x9d, 0x36, 0xba, 0x85,
+	0x48, 0x21, 0xa1, 0x55, 0xe9, 0x09, 0x48, 0xdc, 0x9d, 0xd3, 0xd6, 0x48, 0x31, 0xc3, 0x1c, 0x5b,
+	0x09, 0x10, 0x87, 0x03, 0xb2, 0x44, 0x6c, 0xc3, 0x32, 0x23, 0xa4, 0x42, 0x6c, 0x34, 0xe0, 0xe6,
+	0xd4, 0x47, 0x3c, 0x91, 0xe9, 0xad, 0xdb, 0x28, 0x34, 0x37, 0xd8, 0x6c, 0xdf, 0x03, 0xe9, 0xec,
+	0x26, 0xf3, 0x1c, 0xf8, 0xa7, 0x10, 0x74, 0xd7, 0x43, 0x8b, 0x77, 0xd7, 0x69, 0x8f, 0x19, 0x71,
+	0xfb, 0x91, 0xba, 0xe0, 0xfd, 0x18, 0x5c, 0x24, 0xaf, 0x70, 0xe1, 0x2a, 0xf8, 0x12, 0xff, 0x22,
+	0xb0, 0x0a, 0x57, 0x47, 0x6d, 0xa4, 0xb9, 0x66, 0x0f, 0x05, 0xb5, 0x72, 0x8f, 0x12, 0xc2, 0xd2,
+	0xa6, 0x5f, 0xee, 0x26, 0x5c, 0x71, 0x90, 0x46, 0x89, 0x88, 0x74, 0xc5, 0x3f, 0x22, 0x10, 0xbf,
+	0x4c, 0xc9, 0x4b, 0x7c, 0xea, 0x29, 0x6d, 0xf7, 0xf5, 0xd6, 0xa0, 0xe3, 0x1e, 0x57, 0x13, 0x7d,
+	0xe3, 0x8b, 0xf8, 0xb3, 0x00, 0x8b, 0xfb, 0xc4, 0x38, 0xb4, 0x75, 0xd5, 0x45, 0x35, 0xf6, 0x9e,
+	0x2a, 0x3e, 0x84, 0x05, 0xb5, 0xeb, 0x36, 0xb1, 0x63, 0xba, 0xc7, 0x43, 0xcb, 0x73, 0x08, 0x15,
+	0x9f, 0x40, 0xc6, 0x7b, 0x91, 0xf5, 0x9f, 0x4c, 0x6e, 0x24, 0x95, 0x3d, 0x06, 0xaa, 0xa6, 0xbf,
+	0x38, 0x59, 0xbd, 0x24, 0xfb, 0x22, 0x8f, 0x0b, 0xd4, 0xfb, 0x50, 0xd9, 0xfa, 0x75, 0x58, 0x39,
+	0xe5, 0x17, 0xf7, 0xf9, 0x6f, 0x33, 0x20, 0xf1, 0x42, 0x14, 0x64, 0xdf, 0x0e, 0x7d, 0x3b, 0x72,
+	0x5d, 0x84, 0x26, 0x76, 0xff, 0x17, 0xb0, 0x44, 0xcb, 0xb6, 0xe6, 0x2b, 0x54, 0xec, 0x16, 0x5d,
+	0xc8, 0x34, 0x27, 0xb1, 0x82, 0x85, 0x5e, 0x06, 0xfe, 0xd5, 0x5a, 0x44, 0x2c, 0xc3, 0x95, 0x01,
+	0x0b, 0xbf, 0xec, 0x62, 0xa7, 0xdb, 0xf1, 0xef, 0x8c, 0x97, 0x23, 0xe0, 0x1f, 0xb3, 0x09, 0xf1,
+	0x1e, 0x5c, 0xa6, 0x27, 0xe4, 0xde, 0x40, 0x13, 0x48, 0xb3, 0x26, 0xb0, 0x14, 0x4e, 0x78, 0x6d,
+	0xe0, 0x4c, 0x00, 0x6f, 0xc3, 0x7a, 0x72, 0x90, 0x82, 0x58, 0x6e, 0xff, 0x2e, 0x07, 0xa9, 0x7d,
+	0x62, 0x88, 0xbf, 0x15, 0xe0, 0x5a, 0xc2, 0x2b, 0xf6, 0x47, 0x09, 0xdb, 0x98, 0xf8, 0x76, 0x29,
+	0x7d, 0x6f, 0x5c, 0x89, 0xc0, 0x1d, 0xf1, 0xd7, 0x70, 0x35, 0xf6, 0xa5, 0xb3, 0x9c, 0xac, 0x31,
+	0x0e, 0x2f, 0x3d, 0x1c, 0x0f, 0xcf, 0xed, 0xff, 0x0a, 0xae, 0xc4, 0x3d, 0x22, 0x6e, 0x0e, 0x5b,
+	0xd0, 0x00, 0x5c, 0x7a, 0x30, 0x16, 0x3c, 0x6a, 0x3c, 0xee, 0x59, 0xe3, 0x1c, 0xe3, 0x31, 0x70,
+	0xe9, 0xc1, 0x58, 0x70, 0x6e, 0xdc, 0x84, 0xfc, 0xe0, 0x55, 0xe8, 0xfd, 0x64, 0x3d, 0x03, 0x40,
+	0xa9, 0x32, 0x22, 0x90, 0x9b, 0xfa, 0xab, 0x00, 0xa5, 0x21, 0x17, 0xd2, 0x73, 0x32, 0xe8, 0x7c,
+	0x49, 0xe9, 0x07, 0x93, 0x4a, 0x72, 0xf7, 0xfe, 0x28, 0xc0, 0xf5, 0xe4, 0x63, 0xeb, 0xfd, 0x64,
+	0xfd, 0x89, 0x42, 0xd2, 0x93, 0x09, 0x84, 0xb8, 0x3f, 0x18, 0x16, 0x4f, 0x1f, 0xa5, 0xee, 0x9e,
+	0xbb, 0xc8, 0x28, 0x54, 0xda, 0x1a, 0x19, 0x7a, 0x2a, 0x15, 0x0e, 0xad, 0x11, 0x53, 0xe1, 0xd0,
+	0x1a, 0x31, 0x15, 0xce, 0xf6, 0x50, 0x16, 0xeb, 0xe4, 0x06, 0x7a, 0x4e, 0xac, 0x13, 0x85, 0xa4,
+	0x27, 0x13, 0x08, 0x71, 0x7f, 0x8e, 0x20, 0x37, 0xd0, 0x0a, 0xef, 0x24, 0x2b, 0x8b, 0xe2, 0xa4,
+	0xf2, 0x68, 0x38, 0x6e, 0xe7, 0xf7, 0x02, 0xac, 0x24, 0xf5, 0xaf, 0xad, 0x61, 0xc9, 0x72, 0x46,
+	0x44, 0x7a, 0x34, 0xb6, 0x48, 0xe0, 0x89, 0x34, 0xfb, 0x9b, 0xaf, 0x3e, 0xfb, 0x40, 0xa8, 0xbe,
+	0xf8, 0xe2, 0x4d, 0x49, 0x78, 0xf5, 0xa6, 0x24, 0xfc, 0xe7, 0x4d, 0x49, 0xf8, 0xd3, 0xdb, 0xd2,
+	0xa5, 0x57, 0x6f, 0x4b, 0x97, 0xfe, 0xfd, 0xb6, 0x74, 0xe9, 0xa7, 0x43, 0x1b, 0x5f, 0x3f, 0xfa,
+	0xef, 0x28, 0xeb, 0x82, 0x8d, 0x0c, 0xfb, 0x77, 0xf4, 0xfe, 0xff, 0x07, 0x00, 0xca, 0xc7, 0x8f,
+	0xfe, 0x39, 0x1e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateFinalityProvider creates a new finality provider
+	CreateFinalityProvider(ctx context.Context, in *MsgCreateFinalityProvider, opts ...grpc.CallOption) (*MsgCreateFinalityProviderResponse, error)
+	// EditFinalityProvider edits an existing finality provider
+	EditFinalityProvider(ctx context.Context, in *MsgEditFinalityProvider, opts ...grpc.CallOption) (*MsgEditFinalityProviderResponse, error)
+	// CreateBTCDelegation creates a new BTC delegation
+	CreateBTCDelegation(ctx context.Context, in *MsgCreateBTCDelegation, opts ...grpc.CallOption) (*MsgCreateBTCDelegationResponse, error)
+	// ExpandBTCDelegation expands an active BTC delegation with a new staking tx
+	// that spends the previous staking output together with extra funding inputs
+	ExpandBTCDelegation(ctx context.Context, in *MsgExpandBTCDelegation, opts ...grpc.CallOption) (*MsgExpandBTCDelegationResponse, error)
+	// BTCRedelegate moves an active BTC delegation to a different set of finality
+	// providers with a new staking tx that spends the previous staking output
+	BTCRedelegate(ctx context.Context, in *MsgBTCRedelegate, opts ...grpc.CallOption) (*MsgBTCRedelegateResponse, error)
+	// AddBTCDelegationInclusionProof proves the inclusion of the staking tx of
+	// a BTC delegation created upon stake expansion or redelegation, which
+	// activates it
+	AddBTCDelegationInclusionProof(ctx context.Context, in *MsgAddBTCDelegationInclusionProof, opts ...grpc.CallOption) (*MsgAddBTCDelegationInclusionProofResponse, error)
+	// RotateFinalityProviderKey schedules the rotation of a finality provider's
+	// BTC PK to a new BTC PK at a given height
+	RotateFinalityProviderKey(ctx context.Context, in *MsgRotateFinalityProviderKey, opts ...grpc.CallOption) (*MsgRotateFinalityProviderKeyResponse, error)
+	// AddCovenantSigs handles signatures from a covenant member
+	AddCovenantSigs(ctx context.Context, in *MsgAddCovenantSigs, opts ...grpc.CallOption) (*MsgAddCovenantSigsResponse, error)
+	// BTCUndelegate handles a signature on unbonding tx from its delegator
+	BTCUndelegate(ctx context.Context, in *MsgBTCUndelegate, opts ...grpc.CallOption) (*MsgBTCUndelegateResponse, error)
+	// SelectiveSlashingEvidence handles the evidence of selective slashing launched
+	// by a finality provider
+	SelectiveSlashingEvidence(ctx context.Context, in *MsgSelectiveSlashingEvidence, opts ...grpc.CallOption) (*MsgSelectiveSlashingEvidenceResponse, error)
+	// UpdateParams updates the btcstaking module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RotateCovenantCommittee schedules the rotation of the covenant committee.
+	RotateCovenantCommittee(ctx context.Context, in *MsgRotateCovenantCommittee, opts ...grpc.CallOption) (*MsgRotateCovenantCommitteeResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateFinalityProvider(ctx context.Context, in *MsgCreateFinalityProvider, opts ...grpc.CallOption) (*MsgCreateFinalityProviderResponse, error) {
+	out := new(MsgCreateFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/CreateFinalityProvider", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgEditFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) EditFinalityProvider(ctx context.Context, in *MsgEditFinalityProvider, opts ...grpc.CallOption) (*MsgEditFinalityProviderResponse, error) {
+	out := new(MsgEditFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/EditFinalityProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgEditFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) CreateBTCDelegation(ctx context.Context, in *MsgCreateBTCDelegation, opts ...grpc.CallOption) (*MsgCreateBTCDelegationResponse, error) {
+	out := new(MsgCreateBTCDelegationResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/CreateBTCDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateBTCDelegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ExpandBTCDelegation(ctx context.Context, in *MsgExpandBTCDelegation, opts ...grpc.CallOption) (*MsgExpandBTCDelegationResponse, error) {
+	out := new(MsgExpandBTCDelegationResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/ExpandBTCDelegation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateBTCDelegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) BTCRedelegate(ctx context.Context, in *MsgBTCRedelegate, opts ...grpc.CallOption) (*MsgBTCRedelegateResponse, error) {
+	out := new(MsgBTCRedelegateResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/BTCRedelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateBTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DelegatorUnbondingSlashingSig != nil {
-		{
-			size := m.DelegatorUnbondingSlashingSig.Size()
-			i -= size
-			if _, err := m.DelegatorUnbondingSlashingSig.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
+func (c *msgClient) AddBTCDelegationInclusionProof(ctx context.Context, in *MsgAddBTCDelegationInclusionProof, opts ...grpc.CallOption) (*MsgAddBTCDelegationInclusionProofResponse, error) {
+	out := new(MsgAddBTCDelegationInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/AddBTCDelegationInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.UnbondingSlashingTx != nil {
-		{
-			size := m.UnbondingSlashingTx.Size()
-			i -= size
-			if _, err := m.UnbondingSlashingTx.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
+	return out, nil
+}
+
+func (c *msgClient) RotateFinalityProviderKey(ctx context.Context, in *MsgRotateFinalityProviderKey, opts ...grpc.CallOption) (*MsgRotateFinalityProviderKeyResponse, error) {
+	out := new(MsgRotateFinalityProviderKeyResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/RotateFinalityProviderKey", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.UnbondingValue != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingValue))
-		i--
-		dAtA[i] = 0x60
+	return out, nil
+}
+
+func (c *msgClient) AddCovenantSigs(ctx context.Context, in *MsgAddCovenantSigs, opts ...grpc.CallOption) (*MsgAddCovenantSigsResponse, error) {
+	out := new(MsgAddCovenantSigsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/AddCovenantSigs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.UnbondingTx) > 0 {
-		i -= len(m.UnbondingTx)
-		copy(dAtA[i:], m.UnbondingTx)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UnbondingTx)))
-		i--
-		dAtA[i] = 0x5a
+	return out, nil
+}
+
+func (c *msgClient) BTCUndelegate(ctx context.Context, in *MsgBTCUndelegate, opts ...grpc.CallOption) (*MsgBTCUndelegateResponse, error) {
+	out := new(MsgBTCUndelegateResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/BTCUndelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.UnbondingTime != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingTime))
-		i--
-		dAtA[i] = 0x50
+	return out, nil
+}
+
+func (c *msgClient) SelectiveSlashingEvidence(ctx context.Context, in *MsgSelectiveSlashingEvidence, opts ...grpc.CallOption) (*MsgSelectiveSlashingEvidenceResponse, error) {
+	out := new(MsgSelectiveSlashingEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/SelectiveSlashingEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.DelegatorSlashingSig != nil {
-		{
-			size := m.DelegatorSlashingSig.Size()
-			i -= size
-			if _, err := m.DelegatorSlashingSig.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.SlashingTx != nil {
-		{
-			size := m.SlashingTx.Size()
-			i -= size
-			if _, err := m.SlashingTx.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.StakingTx != nil {
-		{
-			size, err := m.StakingTx.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.StakingValue != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.StakingValue))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.StakingTime != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.StakingTime))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.FpBtcPkList) > 0 {
-		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.FpBtcPkList[iNdEx].Size()
-				i -= size
-				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.BtcPk != nil {
-		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Pop != nil {
-		{
-			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.StakerAddr) > 0 {
-		i -= len(m.StakerAddr)
-		copy(dAtA[i:], m.StakerAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerAddr)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreateBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RotateCovenantCommittee(ctx context.Context, in *MsgRotateCovenantCommittee, opts ...grpc.CallOption) (*MsgRotateCovenantCommitteeResponse, error) {
+	out := new(MsgRotateCovenantCommitteeResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/RotateCovenantCommittee", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateFinalityProvider creates a new finality provider
+	CreateFinalityProvider(context.Context, *MsgCreateFinalityProvider) (*MsgCreateFinalityProviderResponse, error)
+	// EditFinalityProvider edits an existing finality provider
+	EditFinalityProvider(context.Context, *MsgEditFinalityProvider) (*MsgEditFinalityProviderResponse, error)
+	// CreateBTCDelegation creates a new BTC delegation
+	CreateBTCDelegation(context.Context, *MsgCreateBTCDelegation) (*MsgCreateBTCDelegationResponse, error)
+	// ExpandBTCDelegation expands an active BTC delegation with a new staking tx
+	// that spends the previous staking output together with extra funding inputs
+	ExpandBTCDelegation(context.Context, *MsgExpandBTCDelegation) (*MsgExpandBTCDelegationResponse, error)
+	// BTCRedelegate moves an active BTC delegation to a different set of finality
+	// providers with a new staking tx that spends the previous staking output
+	BTCRedelegate(context.Context, *MsgBTCRedelegate) (*MsgBTCRedelegateResponse, error)
+	// AddBTCDelegationInclusionProof proves the inclusion of the staking tx of
+	// a BTC delegation created upon stake expansion or redelegation, which
+	// activates it
+	AddBTCDelegationInclusionProof(context.Context, *MsgAddBTCDelegationInclusionProof) (*MsgAddBTCDelegationInclusionProofResponse, error)
+	// RotateFinalityProviderKey schedules the rotation of a finality provider's
+	// BTC PK to a new BTC PK at a given height
+	RotateFinalityProviderKey(context.Context, *MsgRotateFinalityProviderKey) (*MsgRotateFinalityProviderKeyResponse, error)
+	// AddCovenantSigs handles signatures from a covenant member
+	AddCovenantSigs(context.Context, *MsgAddCovenantSigs) (*MsgAddCovenantSigsResponse, error)
+	// BTCUndelegate handles a signature on unbonding tx from its delegator
+	BTCUndelegate(context.Context, *MsgBTCUndelegate) (*MsgBTCUndelegateResponse, error)
+	// SelectiveSlashingEvidence handles the evidence of selective slashing launched
+	// by a finality provider
+	SelectiveSlashingEvidence(context.Context, *MsgSelectiveSlashingEvidence) (*MsgSelectiveSlashingEvidenceResponse, error)
+	// UpdateParams updates the btcstaking module parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RotateCovenantCommittee schedules the rotation of the covenant committee.
+	RotateCovenantCommittee(context.Context, *MsgRotateCovenantCommittee) (*MsgRotateCovenantCommitteeResponse, error)
 }
 
-func (m *MsgCreateBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgAddCovenantSigs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CreateFinalityProvider(ctx context.Context, req *MsgCreateFinalityProvider) (*MsgCreateFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFinalityProvider not implemented")
+}
+func (*UnimplementedMsgServer) EditFinalityProvider(ctx context.Context, req *MsgEditFinalityProvider) (*MsgEditFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditFinalityProvider not implemented")
+}
+func (*UnimplementedMsgServer) CreateBTCDelegation(ctx context.Context, req *MsgCreateBTCDelegation) (*MsgCreateBTCDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBTCDelegation not implemented")
+}
+func (*UnimplementedMsgServer) ExpandBTCDelegation(ctx context.Context, req *MsgExpandBTCDelegation) (*MsgExpandBTCDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpandBTCDelegation not implemented")
+}
+func (*UnimplementedMsgServer) BTCRedelegate(ctx context.Context, req *MsgBTCRedelegate) (*MsgBTCRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCRedelegate not implemented")
+}
+func (*UnimplementedMsgServer) AddBTCDelegationInclusionProof(ctx context.Context, req *MsgAddBTCDelegationInclusionProof) (*MsgAddBTCDelegationInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBTCDelegationInclusionProof not implemented")
+}
+func (*UnimplementedMsgServer) RotateFinalityProviderKey(ctx context.Context, req *MsgRotateFinalityProviderKey) (*MsgRotateFinalityProviderKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateFinalityProviderKey not implemented")
+}
+func (*UnimplementedMsgServer) AddCovenantSigs(ctx context.Context, req *MsgAddCovenantSigs) (*MsgAddCovenantSigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCovenantSigs not implemented")
+}
+func (*UnimplementedMsgServer) BTCUndelegate(ctx context.Context, req *MsgBTCUndelegate) (*MsgBTCUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCUndelegate not implemented")
+}
+func (*UnimplementedMsgServer) SelectiveSlashingEvidence(ctx context.Context, req *MsgSelectiveSlashingEvidence) (*MsgSelectiveSlashingEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectiveSlashingEvidence not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RotateCovenantCommittee(ctx context.Context, req *MsgRotateCovenantCommittee) (*MsgRotateCovenantCommitteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateCovenantCommittee not implemented")
 }
 
-func (m *MsgAddCovenantSigs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgAddCovenantSigs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SlashingUnbondingTxSigs) > 0 {
-		for iNdEx := len(m.SlashingUnbondingTxSigs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SlashingUnbondingTxSigs[iNdEx])
-			copy(dAtA[i:], m.SlashingUnbondingTxSigs[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.SlashingUnbondingTxSigs[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.UnbondingTxSig != nil {
-		{
-			size := m.UnbondingTxSig.Size()
-			i -= size
-			if _, err := m.UnbondingTxSig.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SlashingTxSigs) > 0 {
-		for iNdEx := len(m.SlashingTxSigs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SlashingTxSigs[iNdEx])
-			copy(dAtA[i:], m.SlashingTxSigs[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.SlashingTxSigs[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+func _Msg_CreateFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFinalityProvider)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.StakingTxHash) > 0 {
-		i -= len(m.StakingTxHash)
-		copy(dAtA[i:], m.StakingTxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFinalityProvider(ctx, in)
 	}
-	if m.Pk != nil {
-		{
-			size := m.Pk.Size()
-			i -= size
-			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/CreateFinalityProvider",
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFinalityProvider(ctx, req.(*MsgCreateFinalityProvider))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddCovenantSigsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_EditFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditFinalityProvider)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).EditFinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/EditFinalityProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditFinalityProvider(ctx, req.(*MsgEditFinalityProvider))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddCovenantSigsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_CreateBTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBTCDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBTCDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/CreateBTCDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBTCDelegation(ctx, req.(*MsgCreateBTCDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddCovenantSigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_ExpandBTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExpandBTCDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExpandBTCDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/ExpandBTCDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExpandBTCDelegation(ctx, req.(*MsgExpandBTCDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBTCUndelegate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_BTCRedelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBTCRedelegate)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).BTCRedelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/BTCRedelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BTCRedelegate(ctx, req.(*MsgBTCRedelegate))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBTCUndelegate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_AddBTCDelegationInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddBTCDelegationInclusionProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddBTCDelegationInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/AddBTCDelegationInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddBTCDelegationInclusionProof(ctx, req.(*MsgAddBTCDelegationInclusionProof))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBTCUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnbondingTxSig != nil {
-		{
-			size := m.UnbondingTxSig.Size()
-			i -= size
-			if _, err := m.UnbondingTxSig.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func _Msg_RotateFinalityProviderKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateFinalityProviderKey)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.StakingTxHash) > 0 {
-		i -= len(m.StakingTxHash)
-		copy(dAtA[i:], m.StakingTxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).RotateFinalityProviderKey(ctx, in)
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/RotateFinalityProviderKey",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateFinalityProviderKey(ctx, req.(*MsgRotateFinalityProviderKey))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBTCUndelegateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddCovenantSigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddCovenantSigs)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).AddCovenantSigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/AddCovenantSigs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddCovenantSigs(ctx, req.(*MsgAddCovenantSigs))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBTCUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_BTCUndelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBTCUndelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BTCUndelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/BTCUndelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BTCUndelegate(ctx, req.(*MsgBTCUndelegate))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBTCUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_SelectiveSlashingEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSelectiveSlashingEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SelectiveSlashingEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/SelectiveSlashingEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SelectiveSlashingEvidence(ctx, req.(*MsgSelectiveSlashingEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSelectiveSlashingEvidence) Marshal() (dAtA []byte, err error) {
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateCovenantCommittee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateCovenantCommittee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateCovenantCommittee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/RotateCovenantCommittee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateCovenantCommittee(ctx, req.(*MsgRotateCovenantCommittee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.btcstaking.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFinalityProvider",
+			Handler:    _Msg_CreateFinalityProvider_Handler,
+		},
+		{
+			MethodName: "EditFinalityProvider",
+			Handler:    _Msg_EditFinalityProvider_Handler,
+		},
+		{
+			MethodName: "CreateBTCDelegation",
+			Handler:    _Msg_CreateBTCDelegation_Handler,
+		},
+		{
+			MethodName: "ExpandBTCDelegation",
+			Handler:    _Msg_ExpandBTCDelegation_Handler,
+		},
+		{
+			MethodName: "BTCRedelegate",
+			Handler:    _Msg_BTCRedelegate_Handler,
+		},
+		{
+			MethodName: "AddBTCDelegationInclusionProof",
+			Handler:    _Msg_AddBTCDelegationInclusionProof_Handler,
+		},
+		{
+			MethodName: "RotateFinalityProviderKey",
+			Handler:    _Msg_RotateFinalityProviderKey_Handler,
+		},
+		{
+			MethodName: "AddCovenantSigs",
+			Handler:    _Msg_AddCovenantSigs_Handler,
+		},
+		{
+			MethodName: "BTCUndelegate",
+			Handler:    _Msg_BTCUndelegate_Handler,
+		},
+		{
+			MethodName: "SelectiveSlashingEvidence",
+			Handler:    _Msg_SelectiveSlashingEvidence_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RotateCovenantCommittee",
+			Handler:    _Msg_RotateCovenantCommittee_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/btcstaking/v1/tx.proto",
+}
+
+func (m *MsgCreateFinalityProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1795,41 +2222,106 @@ func (m *MsgSelectiveSlashingEvidence) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSelectiveSlashingEvidence) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSelectiveSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RecoveredFpBtcSk) > 0 {
-		i -= len(m.RecoveredFpBtcSk)
-		copy(dAtA[i:], m.RecoveredFpBtcSk)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecoveredFpBtcSk)))
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerId)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x42
 	}
-	if len(m.StakingTxHash) > 0 {
-		i -= len(m.StakingTxHash)
-		copy(dAtA[i:], m.StakingTxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
-		i--
-		dAtA[i] = 0x12
+	if m.MaxChangeRate != nil {
+		{
+			size := m.MaxChangeRate.Size()
+			i -= size
+			if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+	if m.MaxRate != nil {
+		{
+			size := m.MaxRate.Size()
+			i -= size
+			if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Pop != nil {
+		{
+			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Commission != nil {
+		{
+			size := m.Commission.Size()
+			i -= size
+			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Description != nil {
+		{
+			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSelectiveSlashingEvidenceResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1839,12 +2331,12 @@ func (m *MsgSelectiveSlashingEvidenceResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgSelectiveSlashingEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSelectiveSlashingEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1852,7 +2344,7 @@ func (m *MsgSelectiveSlashingEvidenceResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgEditFinalityProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1862,37 +2354,58 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgEditFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgEditFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Commission != nil {
+		{
+			size := m.Commission.Size()
+			i -= size
+			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x22
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if m.Description != nil {
+		{
+			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BtcPk) > 0 {
+		i -= len(m.BtcPk)
+		copy(dAtA[i:], m.BtcPk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BtcPk)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgEditFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1902,12 +2415,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgEditFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgEditFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1915,298 +2428,2763 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgCreateFinalityProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Description != nil {
-		l = m.Description.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Commission != nil {
-		l = m.Commission.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Pop != nil {
-		l = m.Pop.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateBTCDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateFinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgCreateBTCDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEditFinalityProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateBTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.DelegatorUnbondingSlashingSig != nil {
+		{
+			size := m.DelegatorUnbondingSlashingSig.Size()
+			i -= size
+			if _, err := m.DelegatorUnbondingSlashingSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
 	}
-	l = len(m.BtcPk)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.UnbondingSlashingTx != nil {
+		{
+			size := m.UnbondingSlashingTx.Size()
+			i -= size
+			if _, err := m.UnbondingSlashingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	if m.Description != nil {
-		l = m.Description.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.UnbondingValue != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingValue))
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.Commission != nil {
-		l = m.Commission.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.UnbondingTx) > 0 {
+		i -= len(m.UnbondingTx)
+		copy(dAtA[i:], m.UnbondingTx)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UnbondingTx)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	return n
-}
-
-func (m *MsgEditFinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.UnbondingTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x50
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgCreateBTCDelegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StakerAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Pop != nil {
-		l = m.Pop.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.FpBtcPkList) > 0 {
-		for _, e := range m.FpBtcPkList {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.DelegatorSlashingSig != nil {
+		{
+			size := m.DelegatorSlashingSig.Size()
+			i -= size
+			if _, err := m.DelegatorSlashingSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.StakingTime != 0 {
-		n += 1 + sovTx(uint64(m.StakingTime))
-	}
-	if m.StakingValue != 0 {
-		n += 1 + sovTx(uint64(m.StakingValue))
+	if m.SlashingTx != nil {
+		{
+			size := m.SlashingTx.Size()
+			i -= size
+			if _, err := m.SlashingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
 	if m.StakingTx != nil {
-		l = m.StakingTx.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.SlashingTx != nil {
-		l = m.SlashingTx.Size()
-		n += 1 + l + sovTx(uint64(l))
+		{
+			size, err := m.StakingTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.DelegatorSlashingSig != nil {
-		l = m.DelegatorSlashingSig.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.StakingValue != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StakingValue))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.UnbondingTime != 0 {
-		n += 1 + sovTx(uint64(m.UnbondingTime))
+	if m.StakingTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StakingTime))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.UnbondingTx)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.FpBtcPkList) > 0 {
+		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.FpBtcPkList[iNdEx].Size()
+				i -= size
+				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.UnbondingValue != 0 {
-		n += 1 + sovTx(uint64(m.UnbondingValue))
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.UnbondingSlashingTx != nil {
-		l = m.UnbondingSlashingTx.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.Pop != nil {
+		{
+			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.DelegatorUnbondingSlashingSig != nil {
-		l = m.DelegatorUnbondingSlashingSig.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateBTCDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddCovenantSigs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Pk != nil {
-		l = m.Pk.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.StakingTxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.SlashingTxSigs) > 0 {
-		for _, b := range m.SlashingTxSigs {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.UnbondingTxSig != nil {
-		l = m.UnbondingTxSig.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.SlashingUnbondingTxSigs) > 0 {
-		for _, b := range m.SlashingUnbondingTxSigs {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgCreateBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddCovenantSigsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBTCUndelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.StakingTxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.UnbondingTxSig != nil {
-		l = m.UnbondingTxSig.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgExpandBTCDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgBTCUndelegateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgExpandBTCDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSelectiveSlashingEvidence) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgExpandBTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.StakingTxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.FundingTxs) > 0 {
+		for iNdEx := len(m.FundingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FundingTxs[iNdEx])
+			copy(dAtA[i:], m.FundingTxs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.FundingTxs[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
 	}
-	l = len(m.RecoveredFpBtcSk)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.DelegatorUnbondingSlashingSig != nil {
+		{
+			size := m.DelegatorUnbondingSlashingSig.Size()
+			i -= size
+			if _, err := m.DelegatorUnbondingSlashingSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	return n
-}
-
-func (m *MsgSelectiveSlashingEvidenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.UnbondingSlashingTx != nil {
+		{
+			size := m.UnbondingSlashingTx.Size()
+			i -= size
+			if _, err := m.UnbondingSlashingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.UnbondingValue != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingValue))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.UnbondingTx) > 0 {
+		i -= len(m.UnbondingTx)
+		copy(dAtA[i:], m.UnbondingTx)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UnbondingTx)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.UnbondingTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DelegatorSlashingSig != nil {
+		{
+			size := m.DelegatorSlashingSig.Size()
+			i -= size
+			if _, err := m.DelegatorSlashingSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SlashingTx != nil {
+		{
+			size := m.SlashingTx.Size()
+			i -= size
+			if _, err := m.SlashingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StakingTx) > 0 {
+		i -= len(m.StakingTx)
+		copy(dAtA[i:], m.StakingTx)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTx)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StakingValue != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StakingValue))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StakingTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StakingTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PreviousStakingTxHash) > 0 {
+		i -= len(m.PreviousStakingTxHash)
+		copy(dAtA[i:], m.PreviousStakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PreviousStakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExpandBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExpandBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExpandBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddBTCDelegationInclusionProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddBTCDelegationInclusionProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddBTCDelegationInclusionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.StakingTxProof) > 0 {
+		i -= len(m.StakingTxProof)
+		copy(dAtA[i:], m.StakingTxProof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxProof)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StakingTxKey != nil {
+		{
+			size, err := m.StakingTxKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddBTCDelegationInclusionProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddBTCDelegationInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddBTCDelegationInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBTCRedelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBTCRedelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBTCRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelegatorUnbondingSlashingSig != nil {
+		{
+			size := m.DelegatorUnbondingSlashingSig.Size()
+			i -= size
+			if _, err := m.DelegatorUnbondingSlashingSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.UnbondingSlashingTx != nil {
+		{
+			size := m.UnbondingSlashingTx.Size()
+			i -= size
+			if _, err := m.UnbondingSlashingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.UnbondingValue != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingValue))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.UnbondingTx) > 0 {
+		i -= len(m.UnbondingTx)
+		copy(dAtA[i:], m.UnbondingTx)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UnbondingTx)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.UnbondingTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DelegatorSlashingSig != nil {
+		{
+			size := m.DelegatorSlashingSig.Size()
+			i -= size
+			if _, err := m.DelegatorSlashingSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SlashingTx != nil {
+		{
+			size := m.SlashingTx.Size()
+			i -= size
+			if _, err := m.SlashingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.StakingTx) > 0 {
+		i -= len(m.StakingTx)
+		copy(dAtA[i:], m.StakingTx)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTx)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StakingValue != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StakingValue))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StakingTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StakingTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.FpBtcPkList[iNdEx].Size()
+				i -= size
+				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PreviousStakingTxHash) > 0 {
+		i -= len(m.PreviousStakingTxHash)
+		copy(dAtA[i:], m.PreviousStakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PreviousStakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBTCRedelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBTCRedelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBTCRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateFinalityProviderKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateFinalityProviderKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateFinalityProviderKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HandoverSig != nil {
+		{
+			size := m.HandoverSig.Size()
+			i -= size
+			if _, err := m.HandoverSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RotationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RotationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NewPop != nil {
+		{
+			size, err := m.NewPop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NewBtcPk != nil {
+		{
+			size := m.NewBtcPk.Size()
+			i -= size
+			if _, err := m.NewBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldBtcPk != nil {
+		{
+			size := m.OldBtcPk.Size()
+			i -= size
+			if _, err := m.OldBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateFinalityProviderKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateFinalityProviderKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateFinalityProviderKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddCovenantSigs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddCovenantSigs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCovenantSigs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StakeSpendingTxSig != nil {
+		{
+			size := m.StakeSpendingTxSig.Size()
+			i -= size
+			if _, err := m.StakeSpendingTxSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.SlashingUnbondingTxSigs) > 0 {
+		for iNdEx := len(m.SlashingUnbondingTxSigs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashingUnbondingTxSigs[iNdEx])
+			copy(dAtA[i:], m.SlashingUnbondingTxSigs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.SlashingUnbondingTxSigs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.UnbondingTxSig != nil {
+		{
+			size := m.UnbondingTxSig.Size()
+			i -= size
+			if _, err := m.UnbondingTxSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SlashingTxSigs) > 0 {
+		for iNdEx := len(m.SlashingTxSigs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashingTxSigs[iNdEx])
+			copy(dAtA[i:], m.SlashingTxSigs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.SlashingTxSigs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pk != nil {
+		{
+			size := m.Pk.Size()
+			i -= size
+			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddCovenantSigsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddCovenantSigsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCovenantSigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBTCUndelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBTCUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBTCUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingTxSig != nil {
+		{
+			size := m.UnbondingTxSig.Size()
+			i -= size
+			if _, err := m.UnbondingTxSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBTCUndelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBTCUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBTCUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSelectiveSlashingEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSelectiveSlashingEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSelectiveSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecoveredFpBtcSk) > 0 {
+		i -= len(m.RecoveredFpBtcSk)
+		copy(dAtA[i:], m.RecoveredFpBtcSk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecoveredFpBtcSk)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSelectiveSlashingEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSelectiveSlashingEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSelectiveSlashingEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateCovenantCommittee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateCovenantCommittee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateCovenantCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewCovenantQuorum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewCovenantQuorum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NewCovenantPks) > 0 {
+		for iNdEx := len(m.NewCovenantPks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.NewCovenantPks[iNdEx].Size()
+				i -= size
+				if _, err := m.NewCovenantPks[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateCovenantCommitteeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateCovenantCommitteeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateCovenantCommitteeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Description != nil {
+		l = m.Description.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxRate != nil {
+		l = m.MaxRate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxChangeRate != nil {
+		l = m.MaxChangeRate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEditFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BtcPk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Description != nil {
+		l = m.Description.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateBTCDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for _, e := range m.FpBtcPkList {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.StakingTime != 0 {
+		n += 1 + sovTx(uint64(m.StakingTime))
+	}
+	if m.StakingValue != 0 {
+		n += 1 + sovTx(uint64(m.StakingValue))
+	}
+	if m.StakingTx != nil {
+		l = m.StakingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SlashingTx != nil {
+		l = m.SlashingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelegatorSlashingSig != nil {
+		l = m.DelegatorSlashingSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingTime))
+	}
+	l = len(m.UnbondingTx)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingValue != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingValue))
+	}
+	if m.UnbondingSlashingTx != nil {
+		l = m.UnbondingSlashingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelegatorUnbondingSlashingSig != nil {
+		l = m.DelegatorUnbondingSlashingSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateBTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExpandBTCDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PreviousStakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StakingTime != 0 {
+		n += 1 + sovTx(uint64(m.StakingTime))
+	}
+	if m.StakingValue != 0 {
+		n += 1 + sovTx(uint64(m.StakingValue))
+	}
+	l = len(m.StakingTx)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SlashingTx != nil {
+		l = m.SlashingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelegatorSlashingSig != nil {
+		l = m.DelegatorSlashingSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingTime))
+	}
+	l = len(m.UnbondingTx)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingValue != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingValue))
+	}
+	if m.UnbondingSlashingTx != nil {
+		l = m.UnbondingSlashingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelegatorUnbondingSlashingSig != nil {
+		l = m.DelegatorUnbondingSlashingSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FundingTxs) > 0 {
+		for _, b := range m.FundingTxs {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExpandBTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddBTCDelegationInclusionProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StakingTxKey != nil {
+		l = m.StakingTxKey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddBTCDelegationInclusionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBTCRedelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PreviousStakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for _, e := range m.FpBtcPkList {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.StakingTime != 0 {
+		n += 1 + sovTx(uint64(m.StakingTime))
+	}
+	if m.StakingValue != 0 {
+		n += 1 + sovTx(uint64(m.StakingValue))
+	}
+	l = len(m.StakingTx)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SlashingTx != nil {
+		l = m.SlashingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelegatorSlashingSig != nil {
+		l = m.DelegatorSlashingSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingTime))
+	}
+	l = len(m.UnbondingTx)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingValue != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingValue))
+	}
+	if m.UnbondingSlashingTx != nil {
+		l = m.UnbondingSlashingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelegatorUnbondingSlashingSig != nil {
+		l = m.DelegatorUnbondingSlashingSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBTCRedelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRotateFinalityProviderKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OldBtcPk != nil {
+		l = m.OldBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewBtcPk != nil {
+		l = m.NewBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewPop != nil {
+		l = m.NewPop.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RotationHeight != 0 {
+		n += 1 + sovTx(uint64(m.RotationHeight))
+	}
+	if m.HandoverSig != nil {
+		l = m.HandoverSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateFinalityProviderKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddCovenantSigs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pk != nil {
+		l = m.Pk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SlashingTxSigs) > 0 {
+		for _, b := range m.SlashingTxSigs {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.UnbondingTxSig != nil {
+		l = m.UnbondingTxSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SlashingUnbondingTxSigs) > 0 {
+		for _, b := range m.SlashingUnbondingTxSigs {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.StakeSpendingTxSig != nil {
+		l = m.StakeSpendingTxSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddCovenantSigsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBTCUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingTxSig != nil {
+		l = m.UnbondingTxSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBTCUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSelectiveSlashingEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecoveredFpBtcSk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSelectiveSlashingEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRotateCovenantCommittee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.NewCovenantPks) > 0 {
+		for _, e := range m.NewCovenantPks {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.NewCovenantQuorum != 0 {
+		n += 1 + sovTx(uint64(m.NewCovenantQuorum))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *MsgRotateCovenantCommitteeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Description == nil {
+				m.Description = &types.Description{}
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Commission = &v
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossessionBTC{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxRate = &v
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxChangeRate = &v
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcPk = append(m.BtcPk[:0], dAtA[iNdEx:postIndex]...)
+			if m.BtcPk == nil {
+				m.BtcPk = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Description == nil {
+				m.Description = &types.Description{}
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Commission = &v
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBTCDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBTCDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossessionBTC{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPkList = append(m.FpBtcPkList, v)
+			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
+			}
+			m.StakingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingValue", wireType)
+			}
+			m.StakingValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StakingTx == nil {
+				m.StakingTx = &types1.TransactionInfo{}
+			}
+			if err := m.StakingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v BTCSlashingTx
+			m.SlashingTx = &v
+			if err := m.SlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.DelegatorSlashingSig = &v
+			if err := m.DelegatorSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			m.UnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTx = append(m.UnbondingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnbondingTx == nil {
+				m.UnbondingTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingValue", wireType)
+			}
+			m.UnbondingValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v BTCSlashingTx
+			m.UnbondingSlashingTx = &v
+			if err := m.UnbondingSlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSlashingSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.DelegatorUnbondingSlashingSig = &v
+			if err := m.DelegatorUnbondingSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBTCDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgExpandBTCDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2229,17 +5207,242 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFinalityProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExpandBTCDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExpandBTCDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousStakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
+			}
+			m.StakingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingValue", wireType)
+			}
+			m.StakingValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTx = append(m.StakingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.StakingTx == nil {
+				m.StakingTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v BTCSlashingTx
+			m.SlashingTx = &v
+			if err := m.SlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.DelegatorSlashingSig = &v
+			if err := m.DelegatorSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			m.UnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2249,29 +5452,31 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.UnbondingTx = append(m.UnbondingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnbondingTx == nil {
+				m.UnbondingTx = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingValue", wireType)
 			}
-			var msglen int
+			m.UnbondingValue = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2281,33 +5486,16 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UnbondingValue |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Description == nil {
-				m.Description = &types.Description{}
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTx", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2317,31 +5505,30 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Commission = &v
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v BTCSlashingTx
+			m.UnbondingSlashingTx = &v
+			if err := m.UnbondingSlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSlashingSig", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2368,17 +5555,17 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.DelegatorUnbondingSlashingSig = &v
+			if err := m.DelegatorUnbondingSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingTxs", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2388,27 +5575,23 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pop == nil {
-				m.Pop = &ProofOfPossessionBTC{}
-			}
-			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FundingTxs = append(m.FundingTxs, make([]byte, postIndex-iNdEx))
+			copy(m.FundingTxs[len(m.FundingTxs)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2431,7 +5614,7 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExpandBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2454,10 +5637,10 @@ func (m *MsgCreateFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFinalityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExpandBTCDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExpandBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2481,7 +5664,7 @@ func (m *MsgCreateFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgAddBTCDelegationInclusionProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2504,15 +5687,15 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditFinalityProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2540,13 +5723,13 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2556,29 +5739,27 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcPk = append(m.BtcPk[:0], dAtA[iNdEx:postIndex]...)
-			if m.BtcPk == nil {
-				m.BtcPk = []byte{}
-			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2605,18 +5786,18 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Description == nil {
-				m.Description = &types.Description{}
+			if m.StakingTxKey == nil {
+				m.StakingTxKey = &types1.TransactionKey{}
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StakingTxKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxProof", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2626,26 +5807,24 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Commission = &v
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StakingTxProof = append(m.StakingTxProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.StakingTxProof == nil {
+				m.StakingTxProof = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2669,7 +5848,7 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddBTCDelegationInclusionProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2692,10 +5871,10 @@ func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditFinalityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2719,7 +5898,7 @@ func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
+func (m *MsgBTCRedelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2742,10 +5921,10 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBTCDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBTCRedelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBTCDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBTCRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2782,9 +5961,9 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStakingTxHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2794,31 +5973,134 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousStakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPkList = append(m.FpBtcPkList, v)
+			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
+			}
+			m.StakingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingValue", wireType)
+			}
+			m.StakingValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pop == nil {
-				m.Pop = &ProofOfPossessionBTC{}
-			}
-			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StakingTx = append(m.StakingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.StakingTx == nil {
+				m.StakingTx = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2845,15 +6127,15 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v BTCSlashingTx
+			m.SlashingTx = &v
+			if err := m.SlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSig", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2880,17 +6162,17 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.FpBtcPkList = append(m.FpBtcPkList, v)
-			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.DelegatorSlashingSig = &v
+			if err := m.DelegatorSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
 			}
-			m.StakingTime = 0
+			m.UnbondingTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2900,16 +6182,16 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StakingTime |= uint32(b&0x7F) << shift
+				m.UnbondingTime |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingValue", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
 			}
-			m.StakingValue = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2919,16 +6201,31 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StakingValue |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTx", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var msglen int
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTx = append(m.UnbondingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnbondingTx == nil {
+				m.UnbondingTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingValue", wireType)
+			}
+			m.UnbondingValue = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2938,31 +6235,14 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UnbondingValue |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StakingTx == nil {
-				m.StakingTx = &types1.TransactionInfo{}
-			}
-			if err := m.StakingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTx", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2990,14 +6270,14 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v BTCSlashingTx
-			m.SlashingTx = &v
-			if err := m.SlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnbondingSlashingTx = &v
+			if err := m.UnbondingSlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSlashingSig", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3025,16 +6305,116 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_babylonchain_babylon_types.BIP340Signature
-			m.DelegatorSlashingSig = &v
-			if err := m.DelegatorSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegatorUnbondingSlashingSig = &v
+			if err := m.DelegatorUnbondingSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.UnbondingTime = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBTCRedelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBTCRedelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBTCRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateFinalityProviderKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateFinalityProviderKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateFinalityProviderKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3044,14 +6424,27 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingTime |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldBtcPk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3078,16 +6471,17 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingTx = append(m.UnbondingTx[:0], dAtA[iNdEx:postIndex]...)
-			if m.UnbondingTx == nil {
-				m.UnbondingTx = []byte{}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.OldBtcPk = &v
+			if err := m.OldBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingValue", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBtcPk", wireType)
 			}
-			m.UnbondingValue = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3097,16 +6491,32 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingValue |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.NewBtcPk = &v
+			if err := m.NewBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPop", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3116,30 +6526,50 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v BTCSlashingTx
-			m.UnbondingSlashingTx = &v
-			if err := m.UnbondingSlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NewPop == nil {
+				m.NewPop = &ProofOfPossessionBTC{}
+			}
+			if err := m.NewPop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotationHeight", wireType)
+			}
+			m.RotationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RotationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSlashingSig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HandoverSig", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3167,8 +6597,8 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_babylonchain_babylon_types.BIP340Signature
-			m.DelegatorUnbondingSlashingSig = &v
-			if err := m.DelegatorUnbondingSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HandoverSig = &v
+			if err := m.HandoverSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3193,7 +6623,7 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateFinalityProviderKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3216,10 +6646,10 @@ func (m *MsgCreateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBTCDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateFinalityProviderKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateFinalityProviderKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3470,6 +6900,41 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 			m.SlashingUnbondingTxSigs = append(m.SlashingUnbondingTxSigs, make([]byte, postIndex-iNdEx))
 			copy(m.SlashingUnbondingTxSigs[len(m.SlashingUnbondingTxSigs)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeSpendingTxSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.StakeSpendingTxSig = &v
+			if err := m.StakeSpendingTxSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4103,6 +7568,211 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRotateCovenantCommittee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateCovenantCommittee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateCovenantCommittee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCovenantPks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.NewCovenantPks = append(m.NewCovenantPks, v)
+			if err := m.NewCovenantPks[len(m.NewCovenantPks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCovenantQuorum", wireType)
+			}
+			m.NewCovenantQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewCovenantQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateCovenantCommitteeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateCovenantCommitteeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateCovenantCommitteeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
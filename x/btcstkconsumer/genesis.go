@@ -0,0 +1,20 @@
+package btcstkconsumer
+
+import (
+	"context"
+
+	"github.com/babylonchain/babylon/x/btcstkconsumer/keeper"
+	"github.com/babylonchain/babylon/x/btcstkconsumer/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx context.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := k.InitGenesis(ctx, genState); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis
+func ExportGenesis(ctx context.Context, k keeper.Keeper) *types.GenesisState {
+	return k.ExportGenesis(ctx)
+}
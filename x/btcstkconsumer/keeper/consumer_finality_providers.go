@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstkconsumer/types"
+)
+
+// AddConsumerFinalityProvider binds the finality provider with the given BTC
+// PK to the registered consumer chain with the given chain ID
+func (k Keeper) AddConsumerFinalityProvider(ctx context.Context, chainID string, fpBTCPK *bbn.BIP340PubKey) error {
+	if !k.IsConsumerRegistered(ctx, chainID) {
+		return types.ErrConsumerNotRegistered.Wrapf("chain ID: %s", chainID)
+	}
+	store := k.consumerFinalityProviderStore(ctx, chainID)
+	if store.Has(*fpBTCPK) {
+		return types.ErrConsumerFinalityProviderRegistered.Wrapf("chain ID: %s, finality provider: %s", chainID, fpBTCPK.MarshalHex())
+	}
+	store.Set(*fpBTCPK, []byte{})
+	return nil
+}
+
+// HasConsumerFinalityProvider returns whether the finality provider with the
+// given BTC PK is bound to the consumer chain with the given chain ID
+func (k Keeper) HasConsumerFinalityProvider(ctx context.Context, chainID string, fpBTCPK *bbn.BIP340PubKey) bool {
+	return k.consumerFinalityProviderStore(ctx, chainID).Has(*fpBTCPK)
+}
+
+// iterateConsumerFinalityProviders iterates over the BTC PKs of the finality
+// providers bound to the consumer chain with the given chain ID
+func (k Keeper) iterateConsumerFinalityProviders(ctx context.Context, chainID string, handler func(fpBTCPK bbn.BIP340PubKey) bool) {
+	iter := k.consumerFinalityProviderStore(ctx, chainID).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		fpBTCPK := bbn.BIP340PubKey(append([]byte{}, iter.Key()...))
+		if !handler(fpBTCPK) {
+			break
+		}
+	}
+}
+
+// consumerFinalityProviderStore returns the KVStore of the finality providers
+// bound to the consumer chain with the given chain ID
+// prefix: ConsumerFinalityProviderKey || len(chain ID) || chain ID
+// key: finality provider's BTC PK
+// value: empty
+func (k Keeper) consumerFinalityProviderStore(ctx context.Context, chainID string) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	fpStore := prefix.NewStore(storeAdapter, types.ConsumerFinalityProviderKey)
+	return prefix.NewStore(fpStore, consumerPrefix(chainID))
+}
+
+// consumerPrefix returns the length-prefixed chain ID, such that the prefix
+// of one consumer chain never covers the prefix of another one
+func consumerPrefix(chainID string) []byte {
+	return append([]byte{byte(len(chainID))}, []byte(chainID)...)
+}
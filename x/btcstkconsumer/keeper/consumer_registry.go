@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/babylonchain/babylon/x/btcstkconsumer/types"
+)
+
+// RegisterConsumer adds the given consumer chain to the consumer registry
+func (k Keeper) RegisterConsumer(ctx context.Context, consumer *types.ConsumerRegister) error {
+	if k.IsConsumerRegistered(ctx, consumer.ChainId) {
+		return types.ErrConsumerAlreadyRegistered.Wrapf("chain ID: %s", consumer.ChainId)
+	}
+	k.setConsumerRegister(ctx, consumer)
+	return nil
+}
+
+// IsConsumerRegistered returns whether the consumer chain with the given chain
+// ID is registered
+func (k Keeper) IsConsumerRegistered(ctx context.Context, chainID string) bool {
+	return k.consumerRegisterStore(ctx).Has([]byte(chainID))
+}
+
+// GetConsumerRegister returns the register of the consumer chain with the
+// given chain ID
+func (k Keeper) GetConsumerRegister(ctx context.Context, chainID string) (*types.ConsumerRegister, error) {
+	consumerBytes := k.consumerRegisterStore(ctx).Get([]byte(chainID))
+	if consumerBytes == nil {
+		return nil, types.ErrConsumerNotRegistered.Wrapf("chain ID: %s", chainID)
+	}
+	var consumer types.ConsumerRegister
+	k.cdc.MustUnmarshal(consumerBytes, &consumer)
+	return &consumer, nil
+}
+
+func (k Keeper) setConsumerRegister(ctx context.Context, consumer *types.ConsumerRegister) {
+	k.consumerRegisterStore(ctx).Set([]byte(consumer.ChainId), k.cdc.MustMarshal(consumer))
+}
+
+// iterateConsumerRegisters iterates over all registered consumer chains
+func (k Keeper) iterateConsumerRegisters(ctx context.Context, handler func(consumer *types.ConsumerRegister) bool) {
+	iter := k.consumerRegisterStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var consumer types.ConsumerRegister
+		k.cdc.MustUnmarshal(iter.Value(), &consumer)
+		if !handler(&consumer) {
+			break
+		}
+	}
+}
+
+// consumerRegisterStore returns the KVStore of the consumer chain registers
+// prefix: ConsumerRegisterKey
+// key: chain ID
+// value: ConsumerRegister
+func (k Keeper) consumerRegisterStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.ConsumerRegisterKey)
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstkconsumer/types"
+)
+
+// InitGenesis initializes the keeper state from a provided initial genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
+	for _, consumer := range gs.Consumers {
+		if err := k.RegisterConsumer(ctx, consumer); err != nil {
+			return err
+		}
+	}
+	for _, entry := range gs.ConsumerFinalityProviders {
+		if err := k.AddConsumerFinalityProvider(ctx, entry.ChainId, entry.FpBtcPk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportGenesis returns the keeper state into an exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	gs := types.DefaultGenesis()
+	k.iterateConsumerRegisters(ctx, func(consumer *types.ConsumerRegister) bool {
+		gs.Consumers = append(gs.Consumers, consumer)
+		k.iterateConsumerFinalityProviders(ctx, consumer.ChainId, func(fpBTCPK bbn.BIP340PubKey) bool {
+			gs.ConsumerFinalityProviders = append(gs.ConsumerFinalityProviders, &types.ConsumerFinalityProviderEntry{
+				ChainId: consumer.ChainId,
+				FpBtcPk: &fpBTCPK,
+			})
+			return true
+		})
+		return true
+	})
+	return gs
+}
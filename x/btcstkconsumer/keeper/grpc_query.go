@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/btcstkconsumer/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Consumers returns a paginated list of all registered consumer chains
+func (k Keeper) Consumers(c context.Context, req *types.QueryConsumersRequest) (*types.QueryConsumersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := k.consumerRegisterStore(ctx)
+
+	var consumers []*types.ConsumerRegister
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var consumer types.ConsumerRegister
+		if err := k.cdc.Unmarshal(value, &consumer); err != nil {
+			return err
+		}
+		consumers = append(consumers, &consumer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryConsumersResponse{Consumers: consumers, Pagination: pageRes}, nil
+}
+
+// Consumer returns the registered consumer chain with the given chain ID
+func (k Keeper) Consumer(c context.Context, req *types.QueryConsumerRequest) (*types.QueryConsumerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.ChainId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "chain ID cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	consumer, err := k.GetConsumerRegister(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryConsumerResponse{Consumer: consumer}, nil
+}
+
+// ConsumerFinalityProviders returns a paginated list of the finality providers
+// bound to the registered consumer chain with the given chain ID
+func (k Keeper) ConsumerFinalityProviders(c context.Context, req *types.QueryConsumerFinalityProvidersRequest) (*types.QueryConsumerFinalityProvidersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.ChainId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "chain ID cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.IsConsumerRegistered(ctx, req.ChainId) {
+		return nil, types.ErrConsumerNotRegistered.Wrapf("chain ID: %s", req.ChainId)
+	}
+
+	store := k.consumerFinalityProviderStore(ctx, req.ChainId)
+	var fps []*bstypes.FinalityProvider
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		fp, err := k.btcStakingKeeper.GetFinalityProvider(ctx, key)
+		if err != nil {
+			return err
+		}
+		fps = append(fps, fp)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryConsumerFinalityProvidersResponse{FinalityProviders: fps, Pagination: pageRes}, nil
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/btcstkconsumer/types"
+)
+
+func FuzzConsumerFinalityProviders(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC staking keeper
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+
+		k, ctx := testkeeper.BTCStkConsumerKeeper(t, nil, bsKeeper)
+
+		// finality providers cannot be bound to an unregistered consumer chain
+		consumer := datagen.GenRandomConsumerRegister(r)
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		err = k.AddConsumerFinalityProvider(ctx, consumer.ChainId, fp.BtcPk)
+		require.ErrorIs(t, err, types.ErrConsumerNotRegistered)
+		_, err = k.ConsumerFinalityProviders(ctx, &types.QueryConsumerFinalityProvidersRequest{ChainId: consumer.ChainId})
+		require.ErrorIs(t, err, types.ErrConsumerNotRegistered)
+
+		// register the consumer chain, and another one with a chain ID
+		// prefixed by the former chain ID
+		err = k.RegisterConsumer(ctx, consumer)
+		require.NoError(t, err)
+		otherConsumer := datagen.GenRandomConsumerRegister(r)
+		otherConsumer.ChainId = consumer.ChainId + "0"
+		err = k.RegisterConsumer(ctx, otherConsumer)
+		require.NoError(t, err)
+
+		consumersResp, err := k.Consumers(ctx, &types.QueryConsumersRequest{})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []*types.ConsumerRegister{consumer, otherConsumer}, consumersResp.Consumers)
+		consumerResp, err := k.Consumer(ctx, &types.QueryConsumerRequest{ChainId: consumer.ChainId})
+		require.NoError(t, err)
+		require.Equal(t, consumer, consumerResp.Consumer)
+
+		// bind a random number of finality providers to the consumer chain, and
+		// one finality provider to the other consumer chain
+		fpsMap := map[string]*bstypes.FinalityProvider{}
+		numFps := datagen.RandomInt(r, 10) + 1
+		for i := uint64(0); i < numFps; i++ {
+			fp, err := datagen.GenRandomFinalityProvider(r)
+			require.NoError(t, err)
+			fp.ConsumerId = consumer.ChainId
+			err = k.AddConsumerFinalityProvider(ctx, consumer.ChainId, fp.BtcPk)
+			require.NoError(t, err)
+			fpsMap[fp.BtcPk.MarshalHex()] = fp
+			bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), []byte(*fp.BtcPk)).Return(fp, nil).AnyTimes()
+		}
+		otherFp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		err = k.AddConsumerFinalityProvider(ctx, otherConsumer.ChainId, otherFp.BtcPk)
+		require.NoError(t, err)
+
+		// a finality provider cannot be bound twice
+		for _, fp := range fpsMap {
+			err = k.AddConsumerFinalityProvider(ctx, consumer.ChainId, fp.BtcPk)
+			require.ErrorIs(t, err, types.ErrConsumerFinalityProviderRegistered)
+			break
+		}
+
+		// query the finality providers of the consumer chain page by page
+		limit := datagen.RandomInt(r, len(fpsMap)) + 1
+		pagination := &query.PageRequest{Limit: limit}
+		resultFps := map[string]struct{}{}
+		for {
+			resp, err := k.ConsumerFinalityProviders(ctx, &types.QueryConsumerFinalityProvidersRequest{
+				ChainId:    consumer.ChainId,
+				Pagination: pagination,
+			})
+			require.NoError(t, err)
+			for _, fp := range resp.FinalityProviders {
+				expectedFp, ok := fpsMap[fp.BtcPk.MarshalHex()]
+				require.True(t, ok)
+				require.Equal(t, expectedFp, fp)
+				resultFps[fp.BtcPk.MarshalHex()] = struct{}{}
+			}
+			if resp.Pagination.NextKey == nil {
+				break
+			}
+			pagination = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: limit}
+		}
+		require.Len(t, resultFps, len(fpsMap))
+
+		// the exported genesis restores the same state
+		gs := k.ExportGenesis(ctx)
+		require.NoError(t, gs.Validate())
+		require.Len(t, gs.Consumers, 2)
+		require.Len(t, gs.ConsumerFinalityProviders, len(fpsMap)+1)
+		k2, ctx2 := testkeeper.BTCStkConsumerKeeper(t, nil, bsKeeper)
+		err = k2.InitGenesis(ctx2, *gs)
+		require.NoError(t, err)
+		require.Equal(t, gs, k2.ExportGenesis(ctx2))
+	})
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"fmt"
+
+	corestoretypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btcstkconsumer/types"
+)
+
+type (
+	Keeper struct {
+		cdc          codec.BinaryCodec
+		storeService corestoretypes.KVStoreService
+
+		clientKeeper     types.ClientKeeper
+		btcStakingKeeper types.BTCStakingKeeper
+		// the address capable of executing a MsgRegisterConsumer message.
+		// Typically, this should be the x/gov module account.
+		authority string
+	}
+)
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService corestoretypes.KVStoreService,
+	clientKeeper types.ClientKeeper,
+	btcStakingKeeper types.BTCStakingKeeper,
+	authority string,
+) Keeper {
+	return Keeper{
+		cdc:              cdc,
+		storeService:     storeService,
+		clientKeeper:     clientKeeper,
+		btcStakingKeeper: btcStakingKeeper,
+		authority:        authority,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+
+	"github.com/babylonchain/babylon/x/btcstkconsumer/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// RegisterConsumer registers a consumer chain via governance
+func (ms msgServer) RegisterConsumer(goCtx context.Context, req *types.MsgRegisterConsumer) (*types.MsgRegisterConsumerResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+	consumer := req.ToConsumerRegister()
+	if err := consumer.Validate(); err != nil {
+		return nil, types.ErrInvalidConsumerRegister.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Babylon itself is not a consumer chain
+	if consumer.ChainId == ctx.ChainID() {
+		return nil, types.ErrInvalidConsumerRegister.Wrapf("cannot register Babylon itself as a consumer chain")
+	}
+
+	// ensure the IBC light client of the consumer chain exists, and tracks the
+	// consumer chain if it is a Tendermint light client
+	clientState, ok := ms.clientKeeper.GetClientState(ctx, consumer.ClientId)
+	if !ok {
+		return nil, types.ErrInvalidConsumerClient.Wrapf("client %s is not found", consumer.ClientId)
+	}
+	if tmClientState, ok := clientState.(*ibctm.ClientState); ok && tmClientState.ChainId != consumer.ChainId {
+		return nil, types.ErrInvalidConsumerClient.Wrapf("client %s tracks chain %s rather than %s", consumer.ClientId, tmClientState.ChainId, consumer.ChainId)
+	}
+
+	if err := ms.Keeper.RegisterConsumer(ctx, consumer); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterConsumerResponse{}, nil
+}
@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/btcstkconsumer/keeper"
+	"github.com/babylonchain/babylon/x/btcstkconsumer/types"
+)
+
+func FuzzRegisterConsumer(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock IBC client keeper
+		clientKeeper := types.NewMockClientKeeper(ctrl)
+
+		k, ctx := testkeeper.BTCStkConsumerKeeper(t, clientKeeper, nil)
+		ms := keeper.NewMsgServerImpl(*k)
+
+		consumer := datagen.GenRandomConsumerRegister(r)
+		msg := &types.MsgRegisterConsumer{
+			Authority:   authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			ChainId:     consumer.ChainId,
+			Name:        consumer.Name,
+			Description: consumer.Description,
+			ClientId:    consumer.ClientId,
+		}
+
+		// only the governance can register a consumer chain
+		invalidMsg := *msg
+		invalidMsg.Authority = datagen.GenRandomAccount().Address
+		_, err := ms.RegisterConsumer(ctx, &invalidMsg)
+		require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+		// the consumer chain has to be tracked by an existing IBC light client
+		clientKeeper.EXPECT().GetClientState(gomock.Any(), consumer.ClientId).Return(nil, false).Times(1)
+		_, err = ms.RegisterConsumer(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidConsumerClient)
+		clientKeeper.EXPECT().GetClientState(gomock.Any(), consumer.ClientId).Return(&ibctm.ClientState{ChainId: "other-chain"}, true).Times(1)
+		_, err = ms.RegisterConsumer(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidConsumerClient)
+		require.False(t, k.IsConsumerRegistered(ctx, consumer.ChainId))
+
+		// register the consumer chain
+		clientKeeper.EXPECT().GetClientState(gomock.Any(), consumer.ClientId).Return(&ibctm.ClientState{ChainId: consumer.ChainId}, true).Times(2)
+		_, err = ms.RegisterConsumer(ctx, msg)
+		require.NoError(t, err)
+		require.True(t, k.IsConsumerRegistered(ctx, consumer.ChainId))
+		actualConsumer, err := k.GetConsumerRegister(ctx, consumer.ChainId)
+		require.NoError(t, err)
+		require.Equal(t, consumer, actualConsumer)
+
+		// the consumer chain cannot be registered again
+		_, err = ms.RegisterConsumer(ctx, msg)
+		require.ErrorIs(t, err, types.ErrConsumerAlreadyRegistered)
+	})
+}
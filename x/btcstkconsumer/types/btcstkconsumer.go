@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+const (
+	// MaxConsumerChainIDLength is the maximum length of the chain ID of a
+	// consumer chain, in line with the maximum length of CometBFT chain IDs
+	MaxConsumerChainIDLength = 50
+	// MaxConsumerNameLength is the maximum length of the name of a consumer chain
+	MaxConsumerNameLength = 70
+	// MaxConsumerDescriptionLength is the maximum length of the description of
+	// a consumer chain
+	MaxConsumerDescriptionLength = 280
+)
+
+// Validate performs stateless checks on the consumer chain register
+func (cr *ConsumerRegister) Validate() error {
+	if len(cr.ChainId) == 0 {
+		return fmt.Errorf("empty chain ID")
+	}
+	if len(cr.ChainId) > MaxConsumerChainIDLength {
+		return fmt.Errorf("chain ID is longer than %d bytes", MaxConsumerChainIDLength)
+	}
+	if len(cr.Name) == 0 {
+		return fmt.Errorf("empty name")
+	}
+	if len(cr.Name) > MaxConsumerNameLength {
+		return fmt.Errorf("name is longer than %d bytes", MaxConsumerNameLength)
+	}
+	if len(cr.Description) > MaxConsumerDescriptionLength {
+		return fmt.Errorf("description is longer than %d bytes", MaxConsumerDescriptionLength)
+	}
+	if err := host.ClientIdentifierValidator(cr.ClientId); err != nil {
+		return fmt.Errorf("invalid client ID %s: %w", cr.ClientId, err)
+	}
+	return nil
+}
@@ -0,0 +1,715 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: babylon/btcstkconsumer/v1/btcstkconsumer.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ConsumerRegister is the metadata of a consumer chain that receives BTC
+// staking security from Babylon
+type ConsumerRegister struct {
+	// chain_id is the chain ID of the consumer chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// name is the name of the consumer chain
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// description is a description of the consumer chain
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// client_id is the ID of the IBC light client of the consumer chain on
+	// Babylon
+	ClientId string `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *ConsumerRegister) Reset()         { *m = ConsumerRegister{} }
+func (m *ConsumerRegister) String() string { return proto.CompactTextString(m) }
+func (*ConsumerRegister) ProtoMessage()    {}
+func (*ConsumerRegister) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de3ccd621fe1efd4, []int{0}
+}
+func (m *ConsumerRegister) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerRegister) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerRegister.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerRegister) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerRegister.Merge(m, src)
+}
+func (m *ConsumerRegister) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerRegister) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerRegister.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerRegister proto.InternalMessageInfo
+
+func (m *ConsumerRegister) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ConsumerRegister) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ConsumerRegister) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ConsumerRegister) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// ConsumerFinalityProviderEntry records that a finality provider is bound to
+// a consumer chain
+type ConsumerFinalityProviderEntry struct {
+	// chain_id is the chain ID of the consumer chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// fp_btc_pk is the Bitcoin secp256k1 PK of the finality provider
+	// the PK follows encoding in BIP-340 spec
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+}
+
+func (m *ConsumerFinalityProviderEntry) Reset()         { *m = ConsumerFinalityProviderEntry{} }
+func (m *ConsumerFinalityProviderEntry) String() string { return proto.CompactTextString(m) }
+func (*ConsumerFinalityProviderEntry) ProtoMessage()    {}
+func (*ConsumerFinalityProviderEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de3ccd621fe1efd4, []int{1}
+}
+func (m *ConsumerFinalityProviderEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerFinalityProviderEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerFinalityProviderEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerFinalityProviderEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerFinalityProviderEntry.Merge(m, src)
+}
+func (m *ConsumerFinalityProviderEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerFinalityProviderEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerFinalityProviderEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerFinalityProviderEntry proto.InternalMessageInfo
+
+func (m *ConsumerFinalityProviderEntry) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ConsumerRegister)(nil), "babylon.btcstkconsumer.v1.ConsumerRegister")
+	proto.RegisterType((*ConsumerFinalityProviderEntry)(nil), "babylon.btcstkconsumer.v1.ConsumerFinalityProviderEntry")
+}
+
+func init() {
+	proto.RegisterFile("babylon/btcstkconsumer/v1/btcstkconsumer.proto", fileDescriptor_de3ccd621fe1efd4)
+}
+
+var fileDescriptor_de3ccd621fe1efd4 = []byte{
+	// 312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4e, 0x3a, 0x31,
+	0x10, 0xc6, 0xe9, 0xff, 0x4f, 0x04, 0xaa, 0x07, 0xd3, 0x78, 0x00, 0x8d, 0x95, 0x70, 0xf2, 0xb4,
+	0x2b, 0x62, 0x78, 0x80, 0x35, 0x9a, 0x10, 0x2f, 0x9b, 0x3d, 0x7a, 0x21, 0xdb, 0x6e, 0x59, 0x1a,
+	0xa0, 0x6d, 0xba, 0x03, 0x71, 0x6f, 0x3e, 0x81, 0xf1, 0xb1, 0x3c, 0x72, 0x34, 0x1e, 0x8c, 0x61,
+	0x5f, 0xc4, 0x50, 0x96, 0xc4, 0xec, 0x41, 0x6f, 0x33, 0xbf, 0xef, 0x9b, 0xe9, 0x97, 0x29, 0xf6,
+	0x58, 0xcc, 0xf2, 0xb9, 0x56, 0x3e, 0x03, 0x9e, 0xc1, 0x8c, 0x6b, 0x95, 0x2d, 0x17, 0xc2, 0xfa,
+	0xab, 0x7e, 0x85, 0x78, 0xc6, 0x6a, 0xd0, 0xa4, 0x53, 0xfa, 0xbd, 0x8a, 0xba, 0xea, 0x9f, 0x9e,
+	0xa4, 0x3a, 0xd5, 0xce, 0xe5, 0x6f, 0xab, 0xdd, 0x40, 0xef, 0x19, 0xe1, 0xe3, 0xdb, 0xd2, 0x15,
+	0x89, 0x54, 0x66, 0x20, 0x2c, 0xe9, 0xe0, 0x26, 0x9f, 0xc6, 0x52, 0x8d, 0x65, 0xd2, 0x46, 0x5d,
+	0x74, 0xd9, 0x8a, 0x1a, 0xae, 0x1f, 0x25, 0x84, 0xe0, 0xba, 0x8a, 0x17, 0xa2, 0xfd, 0xcf, 0x61,
+	0x57, 0x93, 0x2e, 0x3e, 0x4c, 0x44, 0xc6, 0xad, 0x34, 0x20, 0xb5, 0x6a, 0xff, 0x77, 0xd2, 0x4f,
+	0x44, 0xce, 0x70, 0x8b, 0xcf, 0xa5, 0x50, 0xb0, 0xdd, 0x58, 0x77, 0x7a, 0x73, 0x07, 0x46, 0x49,
+	0xef, 0x05, 0xe1, 0xf3, 0x7d, 0x84, 0x7b, 0xa9, 0xe2, 0xb9, 0x84, 0x3c, 0xb4, 0x7a, 0x25, 0x13,
+	0x61, 0xef, 0x14, 0xd8, 0xfc, 0xb7, 0x3c, 0x11, 0x6e, 0x4d, 0xcc, 0x98, 0x01, 0x1f, 0x9b, 0x99,
+	0x0b, 0x75, 0x14, 0x0c, 0x3f, 0x3e, 0x2f, 0xae, 0x53, 0x09, 0xd3, 0x25, 0xf3, 0xb8, 0x5e, 0xf8,
+	0xe5, 0x49, 0xdc, 0xc4, 0xbe, 0xf1, 0x21, 0x37, 0x22, 0xf3, 0x82, 0x51, 0x38, 0xb8, 0xb9, 0x0a,
+	0x97, 0xec, 0x41, 0xe4, 0x51, 0x63, 0x62, 0x02, 0xe0, 0xe1, 0x2c, 0x08, 0xdf, 0x36, 0x14, 0xad,
+	0x37, 0x14, 0x7d, 0x6d, 0x28, 0x7a, 0x2d, 0x68, 0x6d, 0x5d, 0xd0, 0xda, 0x7b, 0x41, 0x6b, 0x8f,
+	0xc3, 0xbf, 0xd6, 0x3e, 0x55, 0x3f, 0xca, 0xbd, 0xc3, 0x0e, 0xdc, 0xb1, 0x07, 0xdf, 0x03, 0x00,
+	0x39, 0x4f, 0x83, 0xf3, 0xcf, 0x01, 0x00, 0x00,
+}
+
+func (m *ConsumerRegister) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRegister) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRegister) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintBtcstkconsumer(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintBtcstkconsumer(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBtcstkconsumer(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintBtcstkconsumer(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerFinalityProviderEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerFinalityProviderEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerFinalityProviderEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstkconsumer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintBtcstkconsumer(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBtcstkconsumer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBtcstkconsumer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConsumerRegister) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovBtcstkconsumer(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBtcstkconsumer(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovBtcstkconsumer(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovBtcstkconsumer(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerFinalityProviderEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovBtcstkconsumer(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovBtcstkconsumer(uint64(l))
+	}
+	return n
+}
+
+func sovBtcstkconsumer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBtcstkconsumer(x uint64) (n int) {
+	return sovBtcstkconsumer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ConsumerRegister) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstkconsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerRegister: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerRegister: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstkconsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstkconsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstkconsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstkconsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstkconsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstkconsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstkconsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstkconsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstkconsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstkconsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstkconsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstkconsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstkconsumer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstkconsumer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerFinalityProviderEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstkconsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerFinalityProviderEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerFinalityProviderEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstkconsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstkconsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstkconsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstkconsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstkconsumer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstkconsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstkconsumer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstkconsumer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBtcstkconsumer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBtcstkconsumer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBtcstkconsumer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBtcstkconsumer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBtcstkconsumer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBtcstkconsumer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBtcstkconsumer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBtcstkconsumer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBtcstkconsumer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBtcstkconsumer = fmt.Errorf("proto: unexpected end of group")
+)
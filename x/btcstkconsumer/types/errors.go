@@ -0,0 +1,14 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/btcstkconsumer module sentinel errors
+var (
+	ErrInvalidConsumerRegister            = errorsmod.Register(ModuleName, 1100, "the consumer chain register is not valid")
+	ErrConsumerAlreadyRegistered          = errorsmod.Register(ModuleName, 1101, "the consumer chain is already registered")
+	ErrConsumerNotRegistered              = errorsmod.Register(ModuleName, 1102, "the consumer chain is not registered")
+	ErrInvalidConsumerClient              = errorsmod.Register(ModuleName, 1103, "the IBC light client of the consumer chain is not valid")
+	ErrConsumerFinalityProviderRegistered = errorsmod.Register(ModuleName, 1104, "the finality provider is already bound to the consumer chain")
+)
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Consumers:                 []*ConsumerRegister{},
+		ConsumerFinalityProviders: []*ConsumerFinalityProviderEntry{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	consumers := map[string]struct{}{}
+	for _, consumer := range gs.Consumers {
+		if err := consumer.Validate(); err != nil {
+			return err
+		}
+		if _, ok := consumers[consumer.ChainId]; ok {
+			return fmt.Errorf("duplicate consumer chain %s", consumer.ChainId)
+		}
+		consumers[consumer.ChainId] = struct{}{}
+	}
+
+	for _, entry := range gs.ConsumerFinalityProviders {
+		if _, ok := consumers[entry.ChainId]; !ok {
+			return fmt.Errorf("finality provider is bound to unregistered consumer chain %s", entry.ChainId)
+		}
+		if entry.FpBtcPk == nil {
+			return fmt.Errorf("empty finality provider BTC public key")
+		}
+		if _, err := entry.FpBtcPk.ToBTCPK(); err != nil {
+			return fmt.Errorf("invalid finality provider BTC public key: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "btcstkconsumer"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_btcstkconsumer"
+)
+
+var (
+	ConsumerRegisterKey         = []byte{0x01} // key prefix for the consumer chain registers
+	ConsumerFinalityProviderKey = []byte{0x02} // key prefix for the finality providers bound to each consumer chain
+)
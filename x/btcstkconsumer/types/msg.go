@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ensure that these message types implement the sdk.Msg interface
+var (
+	_ sdk.Msg = &MsgRegisterConsumer{}
+)
+
+func (m *MsgRegisterConsumer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %s - %v", m.Authority, err)
+	}
+	return m.ToConsumerRegister().Validate()
+}
+
+// ToConsumerRegister returns the consumer chain register carried by the message
+func (m *MsgRegisterConsumer) ToConsumerRegister() *ConsumerRegister {
+	return &ConsumerRegister{
+		ChainId:     m.ChainId,
+		Name:        m.Name,
+		Description: m.Description,
+		ClientId:    m.ClientId,
+	}
+}
@@ -0,0 +1,1602 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: babylon/btcstkconsumer/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/babylonchain/babylon/x/btcstaking/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryConsumersRequest is the request type for the
+// Query/Consumers RPC method.
+type QueryConsumersRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConsumersRequest) Reset()         { *m = QueryConsumersRequest{} }
+func (m *QueryConsumersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumersRequest) ProtoMessage()    {}
+func (*QueryConsumersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e6c48e60b6a8bd8, []int{0}
+}
+func (m *QueryConsumersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumersRequest.Merge(m, src)
+}
+func (m *QueryConsumersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumersRequest proto.InternalMessageInfo
+
+func (m *QueryConsumersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryConsumersResponse is the response type for the
+// Query/Consumers RPC method.
+type QueryConsumersResponse struct {
+	// consumers are the registered consumer chains
+	Consumers []*ConsumerRegister `protobuf:"bytes,1,rep,name=consumers,proto3" json:"consumers,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConsumersResponse) Reset()         { *m = QueryConsumersResponse{} }
+func (m *QueryConsumersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumersResponse) ProtoMessage()    {}
+func (*QueryConsumersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e6c48e60b6a8bd8, []int{1}
+}
+func (m *QueryConsumersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumersResponse.Merge(m, src)
+}
+func (m *QueryConsumersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumersResponse proto.InternalMessageInfo
+
+func (m *QueryConsumersResponse) GetConsumers() []*ConsumerRegister {
+	if m != nil {
+		return m.Consumers
+	}
+	return nil
+}
+
+func (m *QueryConsumersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryConsumerRequest is the request type for the
+// Query/Consumer RPC method.
+type QueryConsumerRequest struct {
+	// chain_id is the chain ID of the consumer chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryConsumerRequest) Reset()         { *m = QueryConsumerRequest{} }
+func (m *QueryConsumerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerRequest) ProtoMessage()    {}
+func (*QueryConsumerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e6c48e60b6a8bd8, []int{2}
+}
+func (m *QueryConsumerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerRequest.Merge(m, src)
+}
+func (m *QueryConsumerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+// QueryConsumerResponse is the response type for the
+// Query/Consumer RPC method.
+type QueryConsumerResponse struct {
+	// consumer is the registered consumer chain
+	Consumer *ConsumerRegister `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+}
+
+func (m *QueryConsumerResponse) Reset()         { *m = QueryConsumerResponse{} }
+func (m *QueryConsumerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerResponse) ProtoMessage()    {}
+func (*QueryConsumerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e6c48e60b6a8bd8, []int{3}
+}
+func (m *QueryConsumerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerResponse.Merge(m, src)
+}
+func (m *QueryConsumerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerResponse) GetConsumer() *ConsumerRegister {
+	if m != nil {
+		return m.Consumer
+	}
+	return nil
+}
+
+// QueryConsumerFinalityProvidersRequest is the request type for the
+// Query/ConsumerFinalityProviders RPC method.
+type QueryConsumerFinalityProvidersRequest struct {
+	// chain_id is the chain ID of the consumer chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConsumerFinalityProvidersRequest) Reset()         { *m = QueryConsumerFinalityProvidersRequest{} }
+func (m *QueryConsumerFinalityProvidersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerFinalityProvidersRequest) ProtoMessage()    {}
+func (*QueryConsumerFinalityProvidersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e6c48e60b6a8bd8, []int{4}
+}
+func (m *QueryConsumerFinalityProvidersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerFinalityProvidersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerFinalityProvidersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerFinalityProvidersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerFinalityProvidersRequest.Merge(m, src)
+}
+func (m *QueryConsumerFinalityProvidersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerFinalityProvidersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerFinalityProvidersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerFinalityProvidersRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerFinalityProvidersRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryConsumerFinalityProvidersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryConsumerFinalityProvidersResponse is the response type for the
+// Query/ConsumerFinalityProviders RPC method.
+type QueryConsumerFinalityProvidersResponse struct {
+	// finality_providers are the finality providers bound to the consumer chain
+	FinalityProviders []*types.FinalityProvider `protobuf:"bytes,1,rep,name=finality_providers,json=finalityProviders,proto3" json:"finality_providers,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConsumerFinalityProvidersResponse) Reset() {
+	*m = QueryConsumerFinalityProvidersResponse{}
+}
+func (m *QueryConsumerFinalityProvidersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerFinalityProvidersResponse) ProtoMessage()    {}
+func (*QueryConsumerFinalityProvidersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e6c48e60b6a8bd8, []int{5}
+}
+func (m *QueryConsumerFinalityProvidersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerFinalityProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerFinalityProvidersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerFinalityProvidersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerFinalityProvidersResponse.Merge(m, src)
+}
+func (m *QueryConsumerFinalityProvidersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerFinalityProvidersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerFinalityProvidersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerFinalityProvidersResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerFinalityProvidersResponse) GetFinalityProviders() []*types.FinalityProvider {
+	if m != nil {
+		return m.FinalityProviders
+	}
+	return nil
+}
+
+func (m *QueryConsumerFinalityProvidersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryConsumersRequest)(nil), "babylon.btcstkconsumer.v1.QueryConsumersRequest")
+	proto.RegisterType((*QueryConsumersResponse)(nil), "babylon.btcstkconsumer.v1.QueryConsumersResponse")
+	proto.RegisterType((*QueryConsumerRequest)(nil), "babylon.btcstkconsumer.v1.QueryConsumerRequest")
+	proto.RegisterType((*QueryConsumerResponse)(nil), "babylon.btcstkconsumer.v1.QueryConsumerResponse")
+	proto.RegisterType((*QueryConsumerFinalityProvidersRequest)(nil), "babylon.btcstkconsumer.v1.QueryConsumerFinalityProvidersRequest")
+	proto.RegisterType((*QueryConsumerFinalityProvidersResponse)(nil), "babylon.btcstkconsumer.v1.QueryConsumerFinalityProvidersResponse")
+}
+
+func init() {
+	proto.RegisterFile("babylon/btcstkconsumer/v1/query.proto", fileDescriptor_4e6c48e60b6a8bd8)
+}
+
+var fileDescriptor_4e6c48e60b6a8bd8 = []byte{
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x3d, 0x6f, 0x13, 0x31,
+	0x18, 0xc7, 0xe3, 0xf2, 0x96, 0xb8, 0x13, 0x16, 0xa0, 0x26, 0x42, 0xa7, 0x2a, 0xa2, 0x69, 0x05,
+	0xc8, 0xee, 0x05, 0x09, 0x56, 0x28, 0x28, 0x55, 0x99, 0xc2, 0x0d, 0x0c, 0x2c, 0xc1, 0x97, 0xb8,
+	0x57, 0xab, 0x89, 0x7d, 0x3d, 0x3b, 0x27, 0x22, 0xc4, 0xc2, 0xc8, 0x84, 0xc4, 0xce, 0xc8, 0xc4,
+	0x87, 0x60, 0x84, 0xad, 0x12, 0x0b, 0x23, 0x4a, 0x18, 0xf9, 0x10, 0x28, 0x3e, 0x5f, 0xd2, 0x3b,
+	0x7a, 0x34, 0x01, 0x46, 0x9f, 0x9f, 0x97, 0xdf, 0xf3, 0x7f, 0xfe, 0x3e, 0xb8, 0xe1, 0x53, 0x7f,
+	0xd4, 0x97, 0x82, 0xf8, 0xba, 0xab, 0xf4, 0x61, 0x57, 0x0a, 0x35, 0x1c, 0xb0, 0x88, 0xc4, 0x2e,
+	0x39, 0x1a, 0xb2, 0x68, 0x84, 0xc3, 0x48, 0x6a, 0x89, 0xaa, 0x36, 0x0c, 0x67, 0xc3, 0x70, 0xec,
+	0xd6, 0xae, 0x07, 0x52, 0x06, 0x7d, 0x46, 0x68, 0xc8, 0x09, 0x15, 0x42, 0x6a, 0xaa, 0xb9, 0x14,
+	0x2a, 0x49, 0xac, 0xdd, 0xec, 0x4a, 0x35, 0x90, 0x8a, 0xf8, 0x54, 0xb1, 0xa4, 0x22, 0x89, 0x5d,
+	0x9f, 0x69, 0xea, 0x92, 0x90, 0x06, 0x5c, 0x98, 0x60, 0x1b, 0xdb, 0xc8, 0xb0, 0xd0, 0x43, 0x2e,
+	0x82, 0x29, 0xc7, 0xfc, 0x64, 0xe3, 0x70, 0x31, 0x73, 0x0e, 0xcf, 0xc4, 0xd7, 0x3b, 0xf0, 0xea,
+	0x93, 0x69, 0xe7, 0x87, 0xf6, 0xb3, 0xf2, 0xd8, 0xd1, 0x90, 0x29, 0x8d, 0x5a, 0x10, 0xce, 0x21,
+	0xd6, 0xc0, 0x3a, 0xd8, 0x5a, 0x6d, 0x36, 0x70, 0x42, 0x8c, 0xa7, 0xc4, 0x38, 0xd1, 0xc0, 0x12,
+	0xe3, 0x36, 0x0d, 0x98, 0xcd, 0xf5, 0x4e, 0x64, 0xd6, 0x3f, 0x02, 0x78, 0x2d, 0xdf, 0x41, 0x85,
+	0x52, 0x28, 0x86, 0xf6, 0x60, 0x25, 0xa5, 0x51, 0x6b, 0x60, 0xfd, 0xdc, 0xd6, 0x6a, 0xf3, 0x16,
+	0x2e, 0x14, 0x13, 0xa7, 0x05, 0x3c, 0x16, 0x70, 0xa5, 0x59, 0xe4, 0xcd, 0xb3, 0xd1, 0x6e, 0x86,
+	0x76, 0xc5, 0xd0, 0x6e, 0x9e, 0x49, 0x9b, 0x70, 0x64, 0x70, 0x5d, 0x78, 0x25, 0x43, 0x9b, 0xca,
+	0x51, 0x85, 0xe5, 0xee, 0x01, 0xe5, 0xa2, 0xc3, 0x7b, 0x46, 0x8c, 0x8a, 0x77, 0xc9, 0x9c, 0xf7,
+	0x7a, 0xf5, 0xe7, 0x39, 0x09, 0x67, 0xf3, 0xed, 0xc2, 0x72, 0x4a, 0x68, 0x05, 0x5c, 0x6a, 0xbc,
+	0x59, 0x72, 0xfd, 0x0d, 0x80, 0x1b, 0x99, 0x16, 0x2d, 0x2e, 0x68, 0x9f, 0xeb, 0x51, 0x3b, 0x92,
+	0x31, 0xef, 0x9d, 0xd8, 0x5a, 0x31, 0x26, 0x6a, 0x9d, 0x22, 0xd1, 0xdf, 0x2c, 0xf4, 0x0b, 0x80,
+	0x8d, 0xb3, 0x60, 0xac, 0x00, 0x4f, 0x21, 0xda, 0xb7, 0x97, 0x9d, 0x30, 0xbd, 0xb5, 0x9b, 0xde,
+	0xcc, 0x4a, 0x91, 0x78, 0x38, 0x76, 0x71, 0xbe, 0x9a, 0x77, 0x79, 0x3f, 0x5f, 0xff, 0xbf, 0x6d,
+	0xbb, 0xf9, 0xe9, 0x3c, 0xbc, 0x60, 0x66, 0x41, 0xef, 0x01, 0xac, 0xcc, 0x1c, 0x8a, 0xb6, 0xff,
+	0xb0, 0xa7, 0x53, 0x9f, 0x4b, 0xcd, 0x5d, 0x22, 0x23, 0x01, 0xa9, 0xdf, 0x7e, 0xfd, 0xf5, 0xc7,
+	0xbb, 0x95, 0x06, 0xba, 0x41, 0x8a, 0xdf, 0xec, 0xdc, 0xe1, 0x1f, 0x00, 0x2c, 0xa7, 0x35, 0x10,
+	0x59, 0xb4, 0x5b, 0x8a, 0xb7, 0xbd, 0x78, 0x82, 0xa5, 0xbb, 0x67, 0xe8, 0x5c, 0x44, 0x16, 0xa1,
+	0x23, 0x2f, 0x53, 0xd7, 0xbd, 0x42, 0x3f, 0x01, 0xac, 0x16, 0x5a, 0x03, 0xdd, 0x5f, 0x14, 0xa4,
+	0xc8, 0xe2, 0xb5, 0x07, 0xff, 0x50, 0xc1, 0xce, 0xf6, 0xd8, 0xcc, 0xf6, 0x08, 0xed, 0x2c, 0x39,
+	0x1b, 0xf9, 0xdd, 0xcd, 0x3b, 0xed, 0xcf, 0x63, 0x07, 0x1c, 0x8f, 0x1d, 0xf0, 0x7d, 0xec, 0x80,
+	0xb7, 0x13, 0xa7, 0x74, 0x3c, 0x71, 0x4a, 0xdf, 0x26, 0x4e, 0xe9, 0xd9, 0xdd, 0x80, 0xeb, 0x83,
+	0xa1, 0x8f, 0xbb, 0x72, 0x90, 0xf6, 0x31, 0x95, 0x66, 0x4d, 0x5f, 0xe4, 0xdb, 0xea, 0x51, 0xc8,
+	0x94, 0x7f, 0xd1, 0xfc, 0x99, 0xef, 0xfc, 0x1a, 0x00, 0xda, 0xef, 0xa8, 0x84, 0x7f, 0x06, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Consumers queries all registered consumer chains
+	Consumers(ctx context.Context, in *QueryConsumersRequest, opts ...grpc.CallOption) (*QueryConsumersResponse, error)
+	// Consumer queries a registered consumer chain
+	Consumer(ctx context.Context, in *QueryConsumerRequest, opts ...grpc.CallOption) (*QueryConsumerResponse, error)
+	// ConsumerFinalityProviders queries all finality providers bound to a
+	// registered consumer chain
+	ConsumerFinalityProviders(ctx context.Context, in *QueryConsumerFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryConsumerFinalityProvidersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Consumers(ctx context.Context, in *QueryConsumersRequest, opts ...grpc.CallOption) (*QueryConsumersResponse, error) {
+	out := new(QueryConsumersResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstkconsumer.v1.Query/Consumers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Consumer(ctx context.Context, in *QueryConsumerRequest, opts ...grpc.CallOption) (*QueryConsumerResponse, error) {
+	out := new(QueryConsumerResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstkconsumer.v1.Query/Consumer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConsumerFinalityProviders(ctx context.Context, in *QueryConsumerFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryConsumerFinalityProvidersResponse, error) {
+	out := new(QueryConsumerFinalityProvidersResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstkconsumer.v1.Query/ConsumerFinalityProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Consumers queries all registered consumer chains
+	Consumers(context.Context, *QueryConsumersRequest) (*QueryConsumersResponse, error)
+	// Consumer queries a registered consumer chain
+	Consumer(context.Context, *QueryConsumerRequest) (*QueryConsumerResponse, error)
+	// ConsumerFinalityProviders queries all finality providers bound to a
+	// registered consumer chain
+	ConsumerFinalityProviders(context.Context, *QueryConsumerFinalityProvidersRequest) (*QueryConsumerFinalityProvidersResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Consumers(ctx context.Context, req *QueryConsumersRequest) (*QueryConsumersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Consumers not implemented")
+}
+func (*UnimplementedQueryServer) Consumer(ctx context.Context, req *QueryConsumerRequest) (*QueryConsumerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Consumer not implemented")
+}
+func (*UnimplementedQueryServer) ConsumerFinalityProviders(ctx context.Context, req *QueryConsumerFinalityProvidersRequest) (*QueryConsumerFinalityProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsumerFinalityProviders not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Consumers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Consumers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstkconsumer.v1.Query/Consumers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Consumers(ctx, req.(*QueryConsumersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Consumer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Consumer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstkconsumer.v1.Query/Consumer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Consumer(ctx, req.(*QueryConsumerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConsumerFinalityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerFinalityProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsumerFinalityProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstkconsumer.v1.Query/ConsumerFinalityProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsumerFinalityProviders(ctx, req.(*QueryConsumerFinalityProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.btcstkconsumer.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Consumers",
+			Handler:    _Query_Consumers_Handler,
+		},
+		{
+			MethodName: "Consumer",
+			Handler:    _Query_Consumer_Handler,
+		},
+		{
+			MethodName: "ConsumerFinalityProviders",
+			Handler:    _Query_ConsumerFinalityProviders_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/btcstkconsumer/v1/query.proto",
+}
+
+func (m *QueryConsumersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Consumers) > 0 {
+		for iNdEx := len(m.Consumers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Consumers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Consumer != nil {
+		{
+			size, err := m.Consumer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerFinalityProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerFinalityProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerFinalityProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerFinalityProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerFinalityProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerFinalityProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryConsumersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Consumers) > 0 {
+		for _, e := range m.Consumers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Consumer != nil {
+		l = m.Consumer.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerFinalityProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerFinalityProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryConsumersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumers = append(m.Consumers, &ConsumerRegister{})
+			if err := m.Consumers[len(m.Consumers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Consumer == nil {
+				m.Consumer = &ConsumerRegister{}
+			}
+			if err := m.Consumer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerFinalityProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerFinalityProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerFinalityProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerFinalityProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityProviders = append(m.FinalityProviders, &types.FinalityProvider{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
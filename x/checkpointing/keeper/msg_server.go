@@ -49,7 +49,9 @@ func (m msgServer) WrappedCreateValidator(goCtx context.Context, msg *types.MsgW
 		Msg: &epochingtypes.QueuedMessage_MsgCreateValidator{MsgCreateValidator: msg.MsgCreateValidator},
 	}
 
-	m.k.epochingKeeper.EnqueueMsg(ctx, queueMsg)
+	if err := m.k.epochingKeeper.EnqueueMsg(ctx, queueMsg); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgWrappedCreateValidatorResponse{}, err
 }
@@ -13,7 +13,7 @@ import (
 // EpochingKeeper defines the expected interface needed to retrieve epoch info
 type EpochingKeeper interface {
 	GetEpoch(ctx context.Context) *epochingtypes.Epoch
-	EnqueueMsg(ctx context.Context, msg epochingtypes.QueuedMessage)
+	EnqueueMsg(ctx context.Context, msg epochingtypes.QueuedMessage) error
 	GetValidatorSet(ctx context.Context, epochNumer uint64) epochingtypes.ValidatorSet
 	GetTotalVotingPower(ctx context.Context, epochNumber uint64) int64
 	CheckMsgCreateValidator(ctx context.Context, msg *stakingtypes.MsgCreateValidator) error
@@ -68,7 +68,8 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 
 // EndBlocker is called at the end of every block.
 // If reaching an epoch boundary, then
-// - forward validator-related msgs (bonded -> unbonding) to the staking module
+// - forward validator-related msgs (bonded -> unbonding) to the staking module, up to the limit of executed msgs per epoch
+// - carry over or drop the msgs exceeding the limit
 // - trigger AfterEpochEnds hook
 // - emit EndEpoch event
 // NOTE: The epoching module is not responsible for checkpoint-assisted unbonding (unbonding -> unbonded). Instead, it wraps the staking module and exposes interfaces to the checkpointing module. The checkpointing module will do the actual checkpoint-assisted unbonding upon each EndBlock.
@@ -85,10 +86,12 @@ func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, e
 		if err := k.RecordLastHeaderTime(ctx); err != nil {
 			return nil, err
 		}
-		// get all msgs in the msg queue
+		// get all msgs in the msg queue, and select the msgs to execute in
+		// this epoch by their priorities
 		queuedMsgs := k.GetCurrentEpochMsgs(ctx)
-		// forward each msg in the msg queue to the right keeper
-		for _, msg := range queuedMsgs {
+		msgsToExecute, unexecutedMsgs := k.GetMsgsToExecute(ctx, queuedMsgs)
+		// forward each msg to execute to the right keeper
+		for _, msg := range msgsToExecute {
 			res, err := k.HandleQueuedMsg(ctx, msg)
 			// skip this failed msg and emit and event signalling it
 			// we do not panic here as some users may wrap an invalid message
@@ -128,6 +131,11 @@ func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, e
 			}
 		}
 
+		// carry over or drop the msgs exceeding the limit of executed msgs
+		if err := k.HandleUnexecutedMsgs(ctx, unexecutedMsgs); err != nil {
+			return nil, err
+		}
+
 		// update validator set
 		validatorSetUpdate = k.ApplyAndReturnValidatorSetUpdates(ctx)
 		sdkCtx.Logger().Info(fmt.Sprintf("Epoching: validator set update of epoch %d: %v", epoch.EpochNumber, validatorSetUpdate))
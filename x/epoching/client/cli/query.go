@@ -26,6 +26,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		CmdQueryParams(),
 		CmdQueryEpochInfo(),
 		CmdQueryEpochsInfo(),
+		CmdQueryEpochIntervalHistory(),
 		CmdQueryEpochMsgs(),
 		CmdQueryEpochValidators(),
 	)
@@ -126,6 +127,30 @@ func CmdQueryEpochsInfo() *cobra.Command {
 	return cmd
 }
 
+func CmdQueryEpochIntervalHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-interval-history",
+		Short: "shows the epoch interval of each range of epochs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EpochIntervalHistory(context.Background(), &types.QueryEpochIntervalHistoryRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdQueryEpochMsgs() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "epoch-msgs [epoch_number]",
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
@@ -32,6 +33,7 @@ func GetTxCmd() *cobra.Command {
 		NewRedelegateCmd(),
 		NewUnbondCmd(),
 		NewCancelUnbondingCmd(),
+		NewCancelQueuedMsgCmd(),
 	)
 
 	return cmd
@@ -226,3 +228,46 @@ $ %s tx epoching unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100%s --from m
 
 	return cmd
 }
+
+func NewCancelQueuedMsgCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-queued-msg [tx-id] [msg-id]",
+		Short: "Cancel a message queued in the current epoch",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a message queued in the current epoch before it is executed at the end of the epoch.
+The message is identified by the hex-encoded ID of the tx containing it and its own hex-encoded ID,
+as shown by the epoch-msgs query. Only the signer of the queued message can cancel it.
+A queued create-validator message cannot be cancelled.
+
+Example:
+$ %s tx epoching cancel-queued-msg [tx-id] [msg-id] --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+			msgID, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelQueuedMsg(clientCtx.GetFromAddress().String(), txID, msgID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -22,12 +22,17 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, genState types.GenesisSta
 	k.InitValidatorSet(ctx)
 	// init slashed voting power
 	k.InitSlashedVotingPower(ctx)
+	// init epoch interval history
+	for _, record := range genState.EpochIntervalHistory {
+		k.SetEpochIntervalRecord(ctx, record)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx context.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.EpochIntervalHistory = k.GetEpochIntervalHistory(ctx)
 
 	return genesis
 }
@@ -1,8 +1,10 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"sort"
 
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -11,14 +13,19 @@ import (
 	"cosmossdk.io/store/prefix"
 	"github.com/babylonchain/babylon/x/epoching/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 )
 
-// InitMsgQueue initialises the msg queue length of the current epoch to 0
+// InitMsgQueue initialises the msg queue length of the current epoch to 0,
+// unless some msgs have been carried over from the previous epoch
 func (k Keeper) InitMsgQueue(ctx context.Context) {
 	store := k.msgQueueLengthStore(ctx)
 
 	epochNumber := k.GetEpoch(ctx).EpochNumber
 	epochNumberBytes := sdk.Uint64ToBigEndian(epochNumber)
+	if store.Has(epochNumberBytes) {
+		return
+	}
 	queueLenBytes := sdk.Uint64ToBigEndian(0)
 	store.Set(epochNumberBytes, queueLenBytes)
 }
@@ -43,37 +50,99 @@ func (k Keeper) GetCurrentQueueLength(ctx context.Context) uint64 {
 	return k.GetQueueLength(ctx, epochNumber)
 }
 
-// incCurrentQueueLength adds the queue length of the current epoch by 1
-func (k Keeper) incCurrentQueueLength(ctx context.Context) {
+// setQueueLength sets the queue length of the given epoch
+func (k Keeper) setQueueLength(ctx context.Context, epochNumber uint64, queueLen uint64) {
 	store := k.msgQueueLengthStore(ctx)
+	epochNumberBytes := sdk.Uint64ToBigEndian(epochNumber)
+	queueLenBytes := sdk.Uint64ToBigEndian(queueLen)
+	store.Set(epochNumberBytes, queueLenBytes)
+}
 
+// EnqueueMsg enqueues a message to the queue of the current epoch, with the
+// priority of the tx that contains the message. It returns an error if the
+// queue of the current epoch is full.
+func (k Keeper) EnqueueMsg(ctx context.Context, msg types.QueuedMessage) error {
 	epochNumber := k.GetEpoch(ctx).EpochNumber
-	epochNumberBytes := sdk.Uint64ToBigEndian(epochNumber)
 
-	queueLen := k.GetQueueLength(ctx, epochNumber)
-	incrementedQueueLen := queueLen + 1
-	incrementedQueueLenBytes := sdk.Uint64ToBigEndian(incrementedQueueLen)
+	// ensure the queue is not full
+	queueCapacity := k.GetParams(ctx).MsgQueueCapacity
+	if queueCapacity > 0 && k.GetQueueLength(ctx, epochNumber) >= queueCapacity {
+		return types.ErrMsgQueueFull.Wrapf("epoch %d, capacity %d", epochNumber, queueCapacity)
+	}
 
-	store.Set(epochNumberBytes, incrementedQueueLenBytes)
+	msg.Priority = sdk.UnwrapSDKContext(ctx).Priority()
+	k.appendMsg(ctx, epochNumber, &msg)
+	return nil
 }
 
-// EnqueueMsg enqueues a message to the queue of the current epoch
-func (k Keeper) EnqueueMsg(ctx context.Context, msg types.QueuedMessage) {
-	epochNumber := k.GetEpoch(ctx).EpochNumber
+// appendMsg appends a message to the end of the queue of the given epoch
+func (k Keeper) appendMsg(ctx context.Context, epochNumber uint64, msg *types.QueuedMessage) {
 	store := k.msgQueueStore(ctx, epochNumber)
 
 	// key: index, in this case = queueLenBytes
-	queueLen := k.GetCurrentQueueLength(ctx)
+	queueLen := k.GetQueueLength(ctx, epochNumber)
 	queueLenBytes := sdk.Uint64ToBigEndian(queueLen)
 	// value: msgBytes
-	msgBytes, err := k.cdc.MarshalInterface(&msg)
+	msgBytes, err := k.cdc.MarshalInterface(msg)
 	if err != nil {
 		panic(errorsmod.Wrap(types.ErrMarshal, err.Error()))
 	}
 	store.Set(queueLenBytes, msgBytes)
 
 	// increment queue length
-	k.incCurrentQueueLength(ctx)
+	k.setQueueLength(ctx, epochNumber, queueLen+1)
+}
+
+// CancelQueuedMsg removes the msg with the given tx ID and msg ID from the
+// queue of the current epoch, such that it will not be executed at the end of
+// the epoch. Only the signer of the queued msg can cancel it. As a queued msg
+// does not lock any funds before its execution, there is nothing to refund.
+// A queued MsgCreateValidator cannot be cancelled, as the BLS key of the
+// validator has been registered when the msg was queued.
+func (k Keeper) CancelQueuedMsg(ctx context.Context, signer sdk.AccAddress, txID []byte, msgID []byte) (*types.QueuedMessage, error) {
+	epochNumber := k.GetEpoch(ctx).EpochNumber
+
+	// find the msg in the queue of the current epoch
+	var (
+		index     uint64
+		queuedMsg *types.QueuedMessage
+	)
+	for i, msg := range k.GetEpochMsgs(ctx, epochNumber) {
+		if bytes.Equal(msg.TxId, txID) && bytes.Equal(msg.MsgId, msgID) {
+			index, queuedMsg = uint64(i), msg
+			break
+		}
+	}
+	if queuedMsg == nil {
+		return nil, types.ErrQueuedMsgNotFound.Wrapf("epoch %d, tx ID %X, msg ID %X", epochNumber, txID, msgID)
+	}
+	if _, ok := queuedMsg.Msg.(*types.QueuedMessage_MsgCreateValidator); ok {
+		return nil, types.ErrQueuedMsgNotCancellable.Wrap("MsgCreateValidator cannot be cancelled")
+	}
+
+	// ensure the msg is cancelled by its signer
+	msgSigner, err := queuedMsg.GetSignerAddress()
+	if err != nil {
+		return nil, err
+	}
+	if !msgSigner.Equals(signer) {
+		return nil, types.ErrNotQueuedMsgSigner.Wrapf("expected %s, got %s", msgSigner.String(), signer.String())
+	}
+
+	k.removeMsg(ctx, epochNumber, index)
+	return queuedMsg, nil
+}
+
+// removeMsg removes the msg at the given index from the queue of the given
+// epoch, and moves the subsequent msgs forward to keep the queue contiguous
+func (k Keeper) removeMsg(ctx context.Context, epochNumber uint64, index uint64) {
+	store := k.msgQueueStore(ctx, epochNumber)
+	queueLen := k.GetQueueLength(ctx, epochNumber)
+	for i := index; i+1 < queueLen; i++ {
+		store.Set(sdk.Uint64ToBigEndian(i), store.Get(sdk.Uint64ToBigEndian(i+1)))
+	}
+	store.Delete(sdk.Uint64ToBigEndian(queueLen - 1))
+	k.setQueueLength(ctx, epochNumber, queueLen-1)
 }
 
 // GetEpochMsgs returns the set of messages queued in a given epoch
@@ -106,6 +175,110 @@ func (k Keeper) GetCurrentEpochMsgs(ctx context.Context) []*types.QueuedMessage
 	return k.GetEpochMsgs(ctx, epochNumber)
 }
 
+// GetMsgsToExecute splits the given queued msgs into the msgs to be executed
+// at the end of the current epoch, and the msgs that exceed the limit of
+// executed msgs per epoch. MsgCreateValidator msgs are always executed first
+// and do not count towards the limit, as the other msgs might depend on the
+// validators they create. Among the other msgs, the ones with the highest
+// priorities are selected, where msgs with the same priority are selected in
+// the order of the queue. A msg is not selected if an earlier msg of the same
+// signer is not selected, so that the msgs of a signer are never executed out
+// of order. Both returned lists keep the order of the queue, and are never nil.
+func (k Keeper) GetMsgsToExecute(ctx context.Context, queuedMsgs []*types.QueuedMessage) ([]*types.QueuedMessage, []*types.QueuedMessage) {
+	createValMsgs, otherMsgs := []*types.QueuedMessage{}, []*types.QueuedMessage{}
+	for _, msg := range queuedMsgs {
+		if _, ok := msg.Msg.(*types.QueuedMessage_MsgCreateValidator); ok {
+			createValMsgs = append(createValMsgs, msg)
+		} else {
+			otherMsgs = append(otherMsgs, msg)
+		}
+	}
+
+	maxExecutedMsgs := k.GetParams(ctx).MaxExecutedMsgsPerEpoch
+	if maxExecutedMsgs == 0 || uint64(len(otherMsgs)) <= maxExecutedMsgs {
+		return append(createValMsgs, otherMsgs...), []*types.QueuedMessage{}
+	}
+
+	// select the msgs with the highest priorities
+	indices := make([]int, len(otherMsgs))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return otherMsgs[indices[i]].Priority > otherMsgs[indices[j]].Priority
+	})
+	selected := make([]bool, len(otherMsgs))
+	for _, i := range indices[:maxExecutedMsgs] {
+		selected[i] = true
+	}
+
+	// split the msgs in the order of the queue, and defer all msgs of a signer
+	// after its first unselected msg
+	msgsToExecute := createValMsgs
+	unexecutedMsgs := []*types.QueuedMessage{}
+	deferredSigners := map[string]struct{}{}
+	for i, msg := range otherMsgs {
+		signer := ""
+		if signerAddr, err := msg.GetSignerAddress(); err == nil {
+			signer = signerAddr.String()
+		}
+		if _, deferred := deferredSigners[signer]; deferred || !selected[i] {
+			deferredSigners[signer] = struct{}{}
+			unexecutedMsgs = append(unexecutedMsgs, msg)
+			continue
+		}
+		msgsToExecute = append(msgsToExecute, msg)
+	}
+	return msgsToExecute, unexecutedMsgs
+}
+
+// HandleUnexecutedMsgs handles the queued msgs that are not executed at the
+// end of the current epoch due to the limit of executed msgs per epoch. They
+// are either carried over to the queue of the next epoch, or dropped,
+// depending on the parameters. The carried-over msgs count towards the
+// capacity of the queue of the next epoch, and the msgs that exceed the
+// capacity are dropped, which only happens if the capacity has been lowered.
+// An event is emitted for each of them.
+func (k Keeper) HandleUnexecutedMsgs(ctx context.Context, unexecutedMsgs []*types.QueuedMessage) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	epochNumber := k.GetEpoch(ctx).EpochNumber
+	params := k.GetParams(ctx)
+
+	for _, msg := range unexecutedMsgs {
+		var event proto.Message
+		switch {
+		case !params.CarryOverUnexecutedMsgs:
+			event = &types.EventHandleQueuedMsg{
+				EpochNumber: epochNumber,
+				Height:      msg.BlockHeight,
+				TxId:        msg.TxId,
+				MsgId:       msg.MsgId,
+				Error:       types.ErrMsgNotExecuted.Error(),
+			}
+		case params.MsgQueueCapacity > 0 && k.GetQueueLength(ctx, epochNumber+1) >= params.MsgQueueCapacity:
+			event = &types.EventHandleQueuedMsg{
+				EpochNumber: epochNumber,
+				Height:      msg.BlockHeight,
+				TxId:        msg.TxId,
+				MsgId:       msg.MsgId,
+				Error:       types.ErrMsgQueueFull.Wrapf("epoch %d, capacity %d", epochNumber+1, params.MsgQueueCapacity).Error(),
+			}
+		default:
+			k.appendMsg(ctx, epochNumber+1, msg)
+			event = &types.EventQueuedMsgCarriedOver{
+				FromEpochNumber: epochNumber,
+				ToEpochNumber:   epochNumber + 1,
+				TxId:            msg.TxId,
+				MsgId:           msg.MsgId,
+			}
+		}
+		if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // HandleQueuedMsg unwraps a QueuedMessage and forwards it to the staking module
 func (k Keeper) HandleQueuedMsg(ctx context.Context, msg *types.QueuedMessage) (*sdk.Result, error) {
 	var (
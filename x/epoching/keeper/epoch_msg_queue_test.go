@@ -47,7 +47,8 @@ func FuzzEnqueueMsg(f *testing.F) {
 				MsgId: sdk.Uint64ToBigEndian(i),
 				Msg:   &types.QueuedMessage_MsgDelegate{MsgDelegate: &stakingtypes.MsgDelegate{}},
 			}
-			keeper.EnqueueMsg(ctx, msg)
+			err := keeper.EnqueueMsg(ctx, msg)
+			require.NoError(t, err)
 		}
 
 		// ensure that each msg in the queue is correct
@@ -60,6 +61,137 @@ func FuzzEnqueueMsg(f *testing.F) {
 	})
 }
 
+// FuzzMsgQueueCapacityAndPriority tests the capacity of the message queue and
+// the selection of the msgs to execute at the end of an epoch. It enqueues
+// msgs of a few signers with random priorities until the queue is full, and
+// checks that the MsgCreateValidator msgs and the msgs with the highest
+// priorities are executed without reordering the msgs of a signer, while the
+// others are carried over or dropped
+func FuzzMsgQueueCapacityAndPriority(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		helper := testhelper.NewHelper(t)
+		ctx, keeper := helper.Ctx, helper.App.EpochingKeeper
+		epochNumber := keeper.GetEpoch(ctx).EpochNumber
+
+		// set a random queue capacity and limit of executed msgs
+		params := keeper.GetParams(ctx)
+		params.MsgQueueCapacity = datagen.RandomInt(r, 50) + 2
+		params.MaxExecutedMsgsPerEpoch = datagen.RandomInt(r, int(params.MsgQueueCapacity)-1) + 1
+		params.CarryOverUnexecutedMsgs = datagen.OneInN(r, 2)
+		err := keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		// enqueue msgs of a few signers with random priorities until the
+		// queue is full
+		signers := make([]sdk.AccAddress, datagen.RandomInt(r, 5)+1)
+		for i := range signers {
+			signers[i] = datagen.GenRandomByteArray(r, 20)
+		}
+		for i := uint64(0); i < params.MsgQueueCapacity; i++ {
+			signer := signers[r.Intn(len(signers))]
+			msg := types.QueuedMessage{
+				TxId:  sdk.Uint64ToBigEndian(i),
+				MsgId: sdk.Uint64ToBigEndian(i),
+				Msg: &types.QueuedMessage_MsgDelegate{MsgDelegate: &stakingtypes.MsgDelegate{
+					DelegatorAddress: signer.String(),
+				}},
+			}
+			if datagen.OneInN(r, 5) {
+				msg.Msg = &types.QueuedMessage_MsgCreateValidator{MsgCreateValidator: &stakingtypes.MsgCreateValidator{
+					ValidatorAddress: sdk.ValAddress(signer).String(),
+				}}
+			}
+			err := keeper.EnqueueMsg(ctx.WithPriority(int64(datagen.RandomInt(r, 5))), msg)
+			require.NoError(t, err)
+		}
+		require.Equal(t, params.MsgQueueCapacity, keeper.GetCurrentQueueLength(ctx))
+
+		// the msg is rejected if the queue is full
+		msg := types.QueuedMessage{
+			TxId:  sdk.Uint64ToBigEndian(params.MsgQueueCapacity),
+			MsgId: sdk.Uint64ToBigEndian(params.MsgQueueCapacity),
+			Msg:   &types.QueuedMessage_MsgDelegate{MsgDelegate: &stakingtypes.MsgDelegate{}},
+		}
+		err = keeper.EnqueueMsg(ctx, msg)
+		require.ErrorIs(t, err, types.ErrMsgQueueFull)
+
+		queuedMsgs := keeper.GetCurrentEpochMsgs(ctx)
+		msgsToExecute, unexecutedMsgs := keeper.GetMsgsToExecute(ctx, queuedMsgs)
+		require.Len(t, unexecutedMsgs, len(queuedMsgs)-len(msgsToExecute))
+
+		// all MsgCreateValidator msgs are executed first, and at most
+		// MaxExecutedMsgsPerEpoch other msgs are executed, both in the order of
+		// the queue
+		isCreateVal := func(msg *types.QueuedMessage) bool {
+			_, ok := msg.Msg.(*types.QueuedMessage_MsgCreateValidator)
+			return ok
+		}
+		numCreateVal := 0
+		for _, msg := range queuedMsgs {
+			if isCreateVal(msg) {
+				numCreateVal++
+			}
+		}
+		for i, msg := range msgsToExecute {
+			require.Equal(t, i < numCreateVal, isCreateVal(msg))
+			if i > 0 && i != numCreateVal {
+				require.Less(t, sdk.BigEndianToUint64(msgsToExecute[i-1].TxId), sdk.BigEndianToUint64(msg.TxId))
+			}
+		}
+		require.LessOrEqual(t, uint64(len(msgsToExecute)-numCreateVal), params.MaxExecutedMsgsPerEpoch)
+		for i := 1; i < len(unexecutedMsgs); i++ {
+			require.Less(t, sdk.BigEndianToUint64(unexecutedMsgs[i-1].TxId), sdk.BigEndianToUint64(unexecutedMsgs[i].TxId))
+		}
+
+		// the msgs of a signer are not reordered, i.e., no msg of a signer is
+		// executed after an unexecuted msg of the same signer, and the first
+		// unexecuted msg of each signer has a lower priority than all executed
+		// msgs
+		firstUnexecuted := map[string]*types.QueuedMessage{}
+		for _, msg := range unexecutedMsgs {
+			signer, err := msg.GetSignerAddress()
+			require.NoError(t, err)
+			if _, ok := firstUnexecuted[signer.String()]; !ok {
+				firstUnexecuted[signer.String()] = msg
+			}
+		}
+		for _, msg := range msgsToExecute[numCreateVal:] {
+			signer, err := msg.GetSignerAddress()
+			require.NoError(t, err)
+			if unexecuted, ok := firstUnexecuted[signer.String()]; ok {
+				require.Less(t, sdk.BigEndianToUint64(msg.TxId), sdk.BigEndianToUint64(unexecuted.TxId))
+			}
+			for _, unexecuted := range firstUnexecuted {
+				require.True(t, msg.Priority > unexecuted.Priority ||
+					(msg.Priority == unexecuted.Priority && sdk.BigEndianToUint64(msg.TxId) < sdk.BigEndianToUint64(unexecuted.TxId)))
+			}
+		}
+
+		// the unexecuted msgs are carried over to the next epoch up to the
+		// capacity of its queue, which might have been lowered, or dropped
+		numCarriedOver := len(unexecutedMsgs)
+		if params.CarryOverUnexecutedMsgs && numCarriedOver > 1 && datagen.OneInN(r, 2) {
+			params.MsgQueueCapacity = datagen.RandomInt(r, numCarriedOver-1) + 1
+			err = keeper.SetParams(ctx, params)
+			require.NoError(t, err)
+			numCarriedOver = int(params.MsgQueueCapacity)
+		}
+		err = keeper.HandleUnexecutedMsgs(ctx, unexecutedMsgs)
+		require.NoError(t, err)
+		nextEpochMsgs := keeper.GetEpochMsgs(ctx, epochNumber+1)
+		if params.CarryOverUnexecutedMsgs {
+			require.Equal(t, unexecutedMsgs[:numCarriedOver], nextEpochMsgs)
+			require.Equal(t, uint64(numCarriedOver), keeper.GetQueueLength(ctx, epochNumber+1))
+		} else {
+			require.Empty(t, nextEpochMsgs)
+			require.Zero(t, keeper.GetQueueLength(ctx, epochNumber+1))
+		}
+	})
+}
+
 // FuzzHandleQueuedMsg_MsgWrappedDelegate tests HandleQueueMsg over MsgWrappedDelegate.
 // It enqueues some MsgWrappedDelegate, enters a new epoch (which triggers HandleQueueMsg), and check if the newly delegated tokens take effect or not
 func FuzzHandleQueuedMsg_MsgWrappedDelegate(f *testing.F) {
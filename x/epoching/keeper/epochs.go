@@ -118,6 +118,8 @@ func (k Keeper) RecordSealerBlockHashForEpoch(ctx context.Context) *types.Epoch
 }
 
 // IncEpoch adds epoch number by 1
+// The new epoch takes the epoch interval in the current parameters, such that
+// a change of the epoch interval takes effect at the next epoch boundary.
 // CONTRACT: can only be invoked at the first block of an epoch
 func (k Keeper) IncEpoch(ctx context.Context) types.Epoch {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -127,10 +129,54 @@ func (k Keeper) IncEpoch(ctx context.Context) types.Epoch {
 	epochInterval := k.GetParams(ctx).EpochInterval
 	newEpoch := types.NewEpoch(incrementedEpochNumber, epochInterval, uint64(sdkCtx.HeaderInfo().Height), nil)
 	k.setEpochInfo(ctx, incrementedEpochNumber, &newEpoch)
+	k.recordEpochInterval(ctx, &newEpoch)
 
 	return newEpoch
 }
 
+// recordEpochInterval records the epoch interval of the given new epoch if it
+// differs from the epoch interval of the previous epochs
+func (k Keeper) recordEpochInterval(ctx context.Context, epoch *types.Epoch) {
+	store := k.epochIntervalStore(ctx)
+
+	// skip if the epoch interval is unchanged
+	iter := store.ReverseIterator(nil, nil)
+	defer iter.Close()
+	if iter.Valid() {
+		var latest types.EpochIntervalRecord
+		k.cdc.MustUnmarshal(iter.Value(), &latest)
+		if latest.EpochInterval == epoch.CurrentEpochInterval {
+			return
+		}
+	}
+
+	k.SetEpochIntervalRecord(ctx, &types.EpochIntervalRecord{
+		StartEpoch:       epoch.EpochNumber,
+		EpochInterval:    epoch.CurrentEpochInterval,
+		FirstBlockHeight: epoch.FirstBlockHeight,
+	})
+}
+
+// SetEpochIntervalRecord saves the given record to the epoch interval history
+func (k Keeper) SetEpochIntervalRecord(ctx context.Context, record *types.EpochIntervalRecord) {
+	store := k.epochIntervalStore(ctx)
+	store.Set(sdk.Uint64ToBigEndian(record.StartEpoch), k.cdc.MustMarshal(record))
+}
+
+// GetEpochIntervalHistory returns the epoch interval of each range of epochs,
+// in ascending order of their start epochs
+func (k Keeper) GetEpochIntervalHistory(ctx context.Context) []*types.EpochIntervalRecord {
+	records := []*types.EpochIntervalRecord{}
+	iter := k.epochIntervalStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.EpochIntervalRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		records = append(records, &record)
+	}
+	return records
+}
+
 // epochInfoStore returns the store for epoch metadata
 // prefix: EpochInfoKey
 // key: epochNumber
@@ -139,3 +185,12 @@ func (k Keeper) epochInfoStore(ctx context.Context) prefix.Store {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	return prefix.NewStore(storeAdapter, types.EpochInfoKey)
 }
+
+// epochIntervalStore returns the store for the history of epoch intervals
+// prefix: EpochIntervalKey
+// key: the first epoch number with the epoch interval
+// value: EpochIntervalRecord
+func (k Keeper) epochIntervalStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.EpochIntervalKey)
+}
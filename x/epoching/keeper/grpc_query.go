@@ -55,6 +55,14 @@ func (k Keeper) EpochInfo(c context.Context, req *types.QueryEpochInfoRequest) (
 	}, nil
 }
 
+// EpochIntervalHistory handles the QueryEpochIntervalHistoryRequest query
+func (k Keeper) EpochIntervalHistory(c context.Context, req *types.QueryEpochIntervalHistoryRequest) (*types.QueryEpochIntervalHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryEpochIntervalHistoryResponse{
+		Records: k.GetEpochIntervalHistory(ctx),
+	}, nil
+}
+
 // EpochsInfo handles the QueryEpochsInfoRequest query
 func (k Keeper) EpochsInfo(c context.Context, req *types.QueryEpochsInfoRequest) (*types.QueryEpochsInfoResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -13,6 +13,7 @@ import (
 
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testhelper "github.com/babylonchain/babylon/testutil/helper"
+	"github.com/babylonchain/babylon/x/epoching"
 	"github.com/babylonchain/babylon/x/epoching/types"
 )
 
@@ -97,6 +98,77 @@ func FuzzCurrentEpoch(f *testing.F) {
 	})
 }
 
+// FuzzEpochIntervalHistory fuzzes queryClient.EpochIntervalHistory and
+// queryClient.EpochInfo
+// 1. generate a random number of epochs to increment, and change the epoch
+// interval at random epochs
+// 2. query the epoch interval history and the info of each epoch
+// 3. compare them with the correctly calculated ones
+func FuzzEpochIntervalHistory(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		numEpochs := datagen.RandomInt(r, 20) + 2
+
+		helper := testhelper.NewHelper(t)
+		ctx, keeper, queryClient := helper.Ctx, helper.App.EpochingKeeper, helper.QueryClient
+
+		// epoch 1 starts at height 1 with the epoch interval at genesis
+		epochs := []types.Epoch{*keeper.GetEpoch(ctx)}
+		expectedRecords := []*types.EpochIntervalRecord{{
+			StartEpoch:       1,
+			EpochInterval:    epochs[0].CurrentEpochInterval,
+			FirstBlockHeight: 1,
+		}}
+		for i := uint64(1); i < numEpochs; i++ {
+			prevEpoch := epochs[len(epochs)-1]
+			// change the epoch interval in the middle of an epoch at random,
+			// which shall take effect from the next epoch
+			if datagen.OneInN(r, 2) {
+				params := keeper.GetParams(ctx)
+				params.EpochInterval = datagen.GenRandomEpochInterval(r)
+				err := keeper.SetParams(ctx, params)
+				require.NoError(t, err)
+				if params.EpochInterval != prevEpoch.CurrentEpochInterval {
+					expectedRecords = append(expectedRecords, &types.EpochIntervalRecord{
+						StartEpoch:       prevEpoch.EpochNumber + 1,
+						EpochInterval:    params.EpochInterval,
+						FirstBlockHeight: prevEpoch.GetLastBlockHeight() + 1,
+					})
+				}
+			}
+			require.Equal(t, prevEpoch, *keeper.GetEpoch(ctx))
+
+			// this ensures that IncEpoch is invoked only at the first header of each epoch
+			ctx = ctx.WithHeaderInfo(header.Info{Height: int64(prevEpoch.GetLastBlockHeight() + 1)})
+			epochs = append(epochs, keeper.IncEpoch(ctx))
+		}
+
+		// the epoch interval history records each change of the epoch interval
+		historyResp, err := queryClient.EpochIntervalHistory(ctx, &types.QueryEpochIntervalHistoryRequest{})
+		require.NoError(t, err)
+		require.Equal(t, expectedRecords, historyResp.Records)
+
+		// the epoch interval history is exported in the genesis state
+		gs := epoching.ExportGenesis(ctx, keeper)
+		require.Equal(t, expectedRecords, gs.EpochIntervalHistory)
+		require.NoError(t, gs.Validate())
+
+		// the boundaries of each epoch follow its own epoch interval
+		for i, epoch := range epochs {
+			resp, err := queryClient.EpochInfo(ctx, &types.QueryEpochInfoRequest{EpochNum: epoch.EpochNumber})
+			require.NoError(t, err)
+			require.Equal(t, epoch.CurrentEpochInterval, resp.Epoch.CurrentEpochInterval)
+			require.Equal(t, epoch.FirstBlockHeight+epoch.CurrentEpochInterval-1, resp.Epoch.LastBlockHeight)
+			if i > 0 {
+				require.Equal(t, epochs[i-1].GetLastBlockHeight()+1, resp.Epoch.FirstBlockHeight)
+			}
+		}
+	})
+}
+
 func FuzzEpochsInfo(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
@@ -157,7 +229,8 @@ func FuzzEpochMsgsQuery(f *testing.F) {
 				TxId: txid,
 				Msg:  &types.QueuedMessage_MsgDelegate{MsgDelegate: &stakingtypes.MsgDelegate{}},
 			}
-			keeper.EnqueueMsg(ctx, queuedMsg)
+			err := keeper.EnqueueMsg(ctx, queuedMsg)
+			require.NoError(t, err)
 		}
 		// get epoch msgs
 		req := types.QueryEpochMsgsRequest{
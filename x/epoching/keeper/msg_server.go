@@ -63,7 +63,9 @@ func (ms msgServer) WrappedDelegate(goCtx context.Context, msg *types.MsgWrapped
 		return nil, err
 	}
 
-	ms.EnqueueMsg(ctx, queuedMsg)
+	if err := ms.EnqueueMsg(ctx, queuedMsg); err != nil {
+		return nil, err
+	}
 
 	err = ctx.EventManager().EmitTypedEvents(
 		&types.EventWrappedDelegate{
@@ -122,7 +124,9 @@ func (ms msgServer) WrappedUndelegate(goCtx context.Context, msg *types.MsgWrapp
 		return nil, err
 	}
 
-	ms.EnqueueMsg(ctx, queuedMsg)
+	if err := ms.EnqueueMsg(ctx, queuedMsg); err != nil {
+		return nil, err
+	}
 
 	err = ctx.EventManager().EmitTypedEvents(
 		&types.EventWrappedUndelegate{
@@ -184,7 +188,9 @@ func (ms msgServer) WrappedBeginRedelegate(goCtx context.Context, msg *types.Msg
 		return nil, err
 	}
 
-	ms.EnqueueMsg(ctx, queuedMsg)
+	if err := ms.EnqueueMsg(ctx, queuedMsg); err != nil {
+		return nil, err
+	}
 	err = ctx.EventManager().EmitTypedEvents(
 		&types.EventWrappedBeginRedelegate{
 			DelegatorAddress:            msg.Msg.DelegatorAddress,
@@ -253,7 +259,9 @@ func (ms msgServer) WrappedCancelUnbondingDelegation(goCtx context.Context, msg
 		return nil, err
 	}
 
-	ms.EnqueueMsg(ctx, queuedMsg)
+	if err := ms.EnqueueMsg(ctx, queuedMsg); err != nil {
+		return nil, err
+	}
 	err = ctx.EventManager().EmitTypedEvents(
 		&types.EventWrappedCancelUnbondingDelegation{
 			DelegatorAddress: msg.Msg.DelegatorAddress,
@@ -270,10 +278,39 @@ func (ms msgServer) WrappedCancelUnbondingDelegation(goCtx context.Context, msg
 	return &types.MsgWrappedCancelUnbondingDelegationResponse{}, nil
 }
 
+// CancelQueuedMsg handles the MsgCancelQueuedMsg request
+func (ms msgServer) CancelQueuedMsg(goCtx context.Context, msg *types.MsgCancelQueuedMsg) (*types.MsgCancelQueuedMsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	queuedMsg, err := ms.Keeper.CancelQueuedMsg(ctx, signer, msg.TxId, msg.MsgId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(
+		&types.EventCancelQueuedMsg{
+			EpochNumber: ms.GetEpoch(ctx).EpochNumber,
+			Height:      queuedMsg.BlockHeight,
+			TxId:        queuedMsg.TxId,
+			MsgId:       queuedMsg.MsgId,
+			Signer:      msg.Signer,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelQueuedMsgResponse{}, nil
+}
+
 // UpdateParams updates the params.
-// TODO investigate when it is the best time to update the params. We can update them
-// when the epoch changes, but we can also update them during the epoch and extend
-// the epoch duration.
+// The current epoch keeps its epoch interval, and a new epoch interval takes
+// effect from the next epoch, which begins at the current epoch's boundary.
 func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if ms.authority != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
@@ -5,9 +5,11 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/babylonchain/babylon/testutil/datagen"
 	testhelper "github.com/babylonchain/babylon/testutil/helper"
 	"github.com/babylonchain/babylon/x/epoching/types"
 )
@@ -134,3 +136,76 @@ func TestMsgWrappedCancelUnbondingDelegation(t *testing.T) {
 		}
 	}
 }
+
+// FuzzMsgCancelQueuedMsg enqueues a random number of msgs, and cancels a
+// random one of them
+func FuzzMsgCancelQueuedMsg(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		helper := testhelper.NewHelper(t)
+		ctx, keeper, msgSrvr, queryClient := helper.Ctx, helper.App.EpochingKeeper, helper.MsgSrvr, helper.QueryClient
+
+		// enqueue a random number of msgs from random delegators
+		numMsgs := datagen.RandomInt(r, 20) + 1
+		for i := uint64(0); i < numMsgs; i++ {
+			msg := types.QueuedMessage{
+				TxId:  sdk.Uint64ToBigEndian(i),
+				MsgId: sdk.Uint64ToBigEndian(i),
+				Msg: &types.QueuedMessage_MsgDelegate{MsgDelegate: &stakingtypes.MsgDelegate{
+					DelegatorAddress: datagen.GenRandomAccount().Address,
+				}},
+			}
+			err := keeper.EnqueueMsg(ctx, msg)
+			require.NoError(t, err)
+		}
+		queuedMsgs := keeper.GetCurrentEpochMsgs(ctx)
+		cancelledMsg := queuedMsgs[datagen.RandomInt(r, int(numMsgs))]
+		signer := cancelledMsg.GetMsgDelegate().DelegatorAddress
+
+		// fail if the msg is not queued
+		_, err := msgSrvr.CancelQueuedMsg(ctx, types.NewMsgCancelQueuedMsg(signer, cancelledMsg.TxId, sdk.Uint64ToBigEndian(numMsgs)))
+		require.ErrorIs(t, err, types.ErrQueuedMsgNotFound)
+
+		// fail if the msg is not cancelled by its signer
+		_, err = msgSrvr.CancelQueuedMsg(ctx, types.NewMsgCancelQueuedMsg(datagen.GenRandomAccount().Address, cancelledMsg.TxId, cancelledMsg.MsgId))
+		require.ErrorIs(t, err, types.ErrNotQueuedMsgSigner)
+
+		// the signer cancels the msg
+		_, err = msgSrvr.CancelQueuedMsg(ctx, types.NewMsgCancelQueuedMsg(signer, cancelledMsg.TxId, cancelledMsg.MsgId))
+		require.NoError(t, err)
+
+		// the msg is removed from the queue, and the other msgs keep their order
+		expectedMsgs := []*types.QueuedMessage{}
+		for _, msg := range queuedMsgs {
+			if msg != cancelledMsg {
+				expectedMsgs = append(expectedMsgs, msg)
+			}
+		}
+		require.Equal(t, expectedMsgs, keeper.GetCurrentEpochMsgs(ctx))
+		require.Equal(t, numMsgs-1, keeper.GetCurrentQueueLength(ctx))
+		resp, err := queryClient.EpochMsgs(ctx, &types.QueryEpochMsgsRequest{EpochNum: keeper.GetEpoch(ctx).EpochNumber})
+		require.NoError(t, err)
+		require.Len(t, resp.Msgs, len(expectedMsgs))
+
+		// the cancelled msg cannot be cancelled again
+		_, err = msgSrvr.CancelQueuedMsg(ctx, types.NewMsgCancelQueuedMsg(signer, cancelledMsg.TxId, cancelledMsg.MsgId))
+		require.ErrorIs(t, err, types.ErrQueuedMsgNotFound)
+
+		// a queued MsgCreateValidator cannot be cancelled
+		valSigner := datagen.GenRandomAccount().GetAddress()
+		createValMsg := types.QueuedMessage{
+			TxId:  sdk.Uint64ToBigEndian(numMsgs),
+			MsgId: sdk.Uint64ToBigEndian(numMsgs),
+			Msg: &types.QueuedMessage_MsgCreateValidator{MsgCreateValidator: &stakingtypes.MsgCreateValidator{
+				ValidatorAddress: sdk.ValAddress(valSigner).String(),
+			}},
+		}
+		err = keeper.EnqueueMsg(ctx, createValMsg)
+		require.NoError(t, err)
+		_, err = msgSrvr.CancelQueuedMsg(ctx, types.NewMsgCancelQueuedMsg(valSigner.String(), createValMsg.TxId, createValMsg.MsgId))
+		require.ErrorIs(t, err, types.ErrQueuedMsgNotCancellable)
+		require.Equal(t, numMsgs, keeper.GetCurrentQueueLength(ctx))
+	})
+}
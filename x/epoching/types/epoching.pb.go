@@ -168,6 +168,73 @@ func (m *Epoch) GetSealerBlockHash() []byte {
 	return nil
 }
 
+// EpochIntervalRecord is the epoch interval of a range of consecutive epochs.
+// A new record is created upon the beginning of the first epoch after the
+// epoch interval is changed, and is valid until the start epoch of the next
+// record.
+type EpochIntervalRecord struct {
+	// start_epoch is the first epoch with this epoch interval
+	StartEpoch uint64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// epoch_interval is the number of blocks in each epoch since start_epoch
+	EpochInterval uint64 `protobuf:"varint,2,opt,name=epoch_interval,json=epochInterval,proto3" json:"epoch_interval,omitempty"`
+	// first_block_height is the height of the first block of start_epoch
+	FirstBlockHeight uint64 `protobuf:"varint,3,opt,name=first_block_height,json=firstBlockHeight,proto3" json:"first_block_height,omitempty"`
+}
+
+func (m *EpochIntervalRecord) Reset()         { *m = EpochIntervalRecord{} }
+func (m *EpochIntervalRecord) String() string { return proto.CompactTextString(m) }
+func (*EpochIntervalRecord) ProtoMessage()    {}
+func (*EpochIntervalRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f2f209d5311f84c, []int{1}
+}
+func (m *EpochIntervalRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochIntervalRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochIntervalRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochIntervalRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochIntervalRecord.Merge(m, src)
+}
+func (m *EpochIntervalRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochIntervalRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochIntervalRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochIntervalRecord proto.InternalMessageInfo
+
+func (m *EpochIntervalRecord) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *EpochIntervalRecord) GetEpochInterval() uint64 {
+	if m != nil {
+		return m.EpochInterval
+	}
+	return 0
+}
+
+func (m *EpochIntervalRecord) GetFirstBlockHeight() uint64 {
+	if m != nil {
+		return m.FirstBlockHeight
+	}
+	return 0
+}
+
 // QueuedMessage is a message that can change the validator set and is delayed
 // to the end of an epoch
 type QueuedMessage struct {
@@ -189,13 +256,17 @@ type QueuedMessage struct {
 	//	*QueuedMessage_MsgBeginRedelegate
 	//	*QueuedMessage_MsgCancelUnbondingDelegation
 	Msg isQueuedMessage_Msg `protobuf_oneof:"msg"`
+	// priority is the priority of the tx that contains the message, i.e., the
+	// fee per gas unit paid by the tx. At the end of an epoch, the queued
+	// messages with the highest priorities are selected for execution.
+	Priority int64 `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (m *QueuedMessage) Reset()         { *m = QueuedMessage{} }
 func (m *QueuedMessage) String() string { return proto.CompactTextString(m) }
 func (*QueuedMessage) ProtoMessage()    {}
 func (*QueuedMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f2f209d5311f84c, []int{1}
+	return fileDescriptor_2f2f209d5311f84c, []int{2}
 }
 func (m *QueuedMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -322,6 +393,13 @@ func (m *QueuedMessage) GetMsgCancelUnbondingDelegation() *types.MsgCancelUnbond
 	return nil
 }
 
+func (m *QueuedMessage) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*QueuedMessage) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -344,7 +422,7 @@ func (m *ValStateUpdate) Reset()         { *m = ValStateUpdate{} }
 func (m *ValStateUpdate) String() string { return proto.CompactTextString(m) }
 func (*ValStateUpdate) ProtoMessage()    {}
 func (*ValStateUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f2f209d5311f84c, []int{2}
+	return fileDescriptor_2f2f209d5311f84c, []int{3}
 }
 func (m *ValStateUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,7 +483,7 @@ func (m *ValidatorLifecycle) Reset()         { *m = ValidatorLifecycle{} }
 func (m *ValidatorLifecycle) String() string { return proto.CompactTextString(m) }
 func (*ValidatorLifecycle) ProtoMessage()    {}
 func (*ValidatorLifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f2f209d5311f84c, []int{3}
+	return fileDescriptor_2f2f209d5311f84c, []int{4}
 }
 func (m *ValidatorLifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +540,7 @@ func (m *DelegationStateUpdate) Reset()         { *m = DelegationStateUpdate{} }
 func (m *DelegationStateUpdate) String() string { return proto.CompactTextString(m) }
 func (*DelegationStateUpdate) ProtoMessage()    {}
 func (*DelegationStateUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f2f209d5311f84c, []int{4}
+	return fileDescriptor_2f2f209d5311f84c, []int{5}
 }
 func (m *DelegationStateUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -537,7 +615,7 @@ func (m *DelegationLifecycle) Reset()         { *m = DelegationLifecycle{} }
 func (m *DelegationLifecycle) String() string { return proto.CompactTextString(m) }
 func (*DelegationLifecycle) ProtoMessage()    {}
 func (*DelegationLifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f2f209d5311f84c, []int{5}
+	return fileDescriptor_2f2f209d5311f84c, []int{6}
 }
 func (m *DelegationLifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -592,7 +670,7 @@ func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f2f209d5311f84c, []int{6}
+	return fileDescriptor_2f2f209d5311f84c, []int{7}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,6 +716,7 @@ func (m *Validator) GetPower() int64 {
 func init() {
 	proto.RegisterEnum("babylon.epoching.v1.BondState", BondState_name, BondState_value)
 	proto.RegisterType((*Epoch)(nil), "babylon.epoching.v1.Epoch")
+	proto.RegisterType((*EpochIntervalRecord)(nil), "babylon.epoching.v1.EpochIntervalRecord")
 	proto.RegisterType((*QueuedMessage)(nil), "babylon.epoching.v1.QueuedMessage")
 	proto.RegisterType((*ValStateUpdate)(nil), "babylon.epoching.v1.ValStateUpdate")
 	proto.RegisterType((*ValidatorLifecycle)(nil), "babylon.epoching.v1.ValidatorLifecycle")
@@ -651,63 +730,66 @@ func init() {
 }
 
 var fileDescriptor_2f2f209d5311f84c = []byte{
-	// 881 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xdf, 0x6e, 0xe3, 0x44,
-	0x14, 0xc6, 0xe3, 0xfc, 0x6b, 0x73, 0x92, 0x74, 0xc3, 0xb4, 0x8b, 0xb2, 0x15, 0x4a, 0x4b, 0x10,
-	0xa8, 0xaa, 0x90, 0x4d, 0x4b, 0xb9, 0x05, 0x35, 0x4d, 0x84, 0x8b, 0x68, 0x56, 0x98, 0x6d, 0x2f,
-	0xb8, 0xc0, 0x1a, 0xdb, 0x53, 0xdb, 0x5a, 0x7b, 0xc6, 0xf2, 0x8c, 0xb3, 0xed, 0x05, 0xef, 0xb0,
-	0xcf, 0x81, 0x78, 0x10, 0x2e, 0xf7, 0x92, 0x3b, 0x50, 0xfb, 0x20, 0x8b, 0x66, 0xec, 0x38, 0x29,
-	0x8d, 0x5a, 0x95, 0xbd, 0xf3, 0x9c, 0xf3, 0x9d, 0x6f, 0xce, 0xf9, 0xe5, 0x68, 0x02, 0x43, 0x07,
-	0x3b, 0xd7, 0x11, 0xa3, 0x06, 0x49, 0x98, 0x1b, 0x84, 0xd4, 0x37, 0x66, 0x07, 0xe5, 0xb7, 0x9e,
-	0xa4, 0x4c, 0x30, 0xb4, 0x59, 0x68, 0xf4, 0x32, 0x3e, 0x3b, 0xd8, 0xde, 0xf1, 0x19, 0xf3, 0x23,
-	0x62, 0x28, 0x89, 0x93, 0x5d, 0x1a, 0x22, 0x8c, 0x09, 0x17, 0x38, 0x4e, 0xf2, 0xaa, 0xed, 0x2d,
-	0x9f, 0xf9, 0x4c, 0x7d, 0x1a, 0xf2, 0xab, 0x88, 0xee, 0xb8, 0x8c, 0xc7, 0x8c, 0x1b, 0x5c, 0xe0,
-	0xd7, 0xf9, 0x6d, 0x0e, 0x11, 0xf8, 0xc0, 0x10, 0x57, 0x85, 0x60, 0x50, 0x08, 0x1c, 0xcc, 0x49,
-	0x99, 0x75, 0x59, 0x48, 0xf3, 0xfc, 0xf0, 0x8f, 0x2a, 0x34, 0x26, 0xb2, 0x0f, 0xf4, 0x29, 0x74,
-	0x54, 0x43, 0x36, 0xcd, 0x62, 0x87, 0xa4, 0x7d, 0x6d, 0x57, 0xdb, 0xab, 0x5b, 0x6d, 0x15, 0x9b,
-	0xaa, 0x10, 0x3a, 0x82, 0x8f, 0xdd, 0x2c, 0x4d, 0x09, 0x15, 0x76, 0x2e, 0x0d, 0xa9, 0x20, 0xe9,
-	0x0c, 0x47, 0xfd, 0xaa, 0x12, 0x6f, 0x15, 0x59, 0x65, 0x78, 0x5a, 0xe4, 0xd0, 0x97, 0x80, 0x2e,
-	0xc3, 0x94, 0x0b, 0xdb, 0x89, 0x98, 0xfb, 0xda, 0x0e, 0x48, 0xe8, 0x07, 0xa2, 0x5f, 0x53, 0x15,
-	0x3d, 0x95, 0x19, 0xc9, 0x84, 0xa9, 0xe2, 0xc8, 0x84, 0x67, 0x11, 0x2e, 0xc5, 0x92, 0x42, 0xbf,
-	0xbe, 0xab, 0xed, 0xb5, 0x0f, 0xb7, 0xf5, 0x1c, 0x91, 0x3e, 0x47, 0xa4, 0xbf, 0x9a, 0x23, 0x1a,
-	0xd5, 0xdf, 0xfe, 0xbd, 0xa3, 0x59, 0x5d, 0x59, 0xa8, 0xbc, 0x64, 0x06, 0x7d, 0x01, 0xcf, 0x38,
-	0xc1, 0x11, 0x49, 0x6d, 0x9c, 0x24, 0x76, 0x80, 0x79, 0xd0, 0x6f, 0xec, 0x6a, 0x7b, 0x1d, 0xab,
-	0x9b, 0x87, 0x8f, 0x93, 0xc4, 0xc4, 0x3c, 0x40, 0xfb, 0xf0, 0x51, 0xa1, 0x2b, 0x1a, 0x94, 0xca,
-	0xa6, 0x52, 0x16, 0x06, 0x79, 0x7f, 0x98, 0x07, 0xc3, 0xf7, 0x75, 0xe8, 0xfe, 0x94, 0x91, 0x8c,
-	0x78, 0x67, 0x84, 0x73, 0xec, 0x13, 0xb4, 0x09, 0x0d, 0x71, 0x65, 0x87, 0x9e, 0xe2, 0xd5, 0xb1,
-	0xea, 0xe2, 0xea, 0xd4, 0x43, 0xcf, 0xa1, 0x19, 0x73, 0x5f, 0x46, 0xab, 0x2a, 0xda, 0x88, 0xb9,
-	0x7f, 0xea, 0x49, 0xc4, 0x2b, 0x18, 0xb4, 0x9d, 0xa5, 0xf1, 0xbf, 0x03, 0xf8, 0x1f, 0x93, 0xb7,
-	0x9c, 0x72, 0xea, 0x5f, 0x61, 0x4b, 0x5e, 0xed, 0xa6, 0x04, 0x0b, 0x62, 0xcf, 0x70, 0x14, 0x7a,
-	0x58, 0xb0, 0x54, 0x8d, 0xde, 0x3e, 0xdc, 0xd7, 0xf3, 0x7d, 0xd0, 0x8b, 0x85, 0xd1, 0x8b, 0x95,
-	0xd0, 0xcf, 0xb8, 0x7f, 0xa2, 0x4a, 0x2e, 0xe6, 0x15, 0x66, 0xc5, 0x42, 0xf1, 0xbd, 0x28, 0x32,
-	0xa1, 0x23, 0xfd, 0x3d, 0x12, 0x11, 0x1f, 0x0b, 0xa2, 0x40, 0xb5, 0x0f, 0x3f, 0x7b, 0xc0, 0x77,
-	0x5c, 0x48, 0xcd, 0x8a, 0xd5, 0x8e, 0x17, 0x47, 0x34, 0x85, 0x0d, 0xe9, 0x94, 0xd1, 0xd2, 0x6b,
-	0x4d, 0x79, 0x7d, 0xfe, 0x80, 0xd7, 0x79, 0x29, 0x36, 0x2b, 0x56, 0x37, 0x5e, 0x0e, 0xcc, 0x27,
-	0x77, 0x88, 0x1f, 0x52, 0x3b, 0x25, 0xa5, 0xeb, 0xfa, 0xa3, 0x93, 0x8f, 0x64, 0x89, 0x45, 0x96,
-	0xac, 0xe5, 0xe4, 0xff, 0x89, 0xa2, 0xdf, 0x60, 0x47, 0x91, 0xc5, 0xd4, 0x25, 0x91, 0x9d, 0x51,
-	0x87, 0x51, 0x2f, 0xa4, 0x25, 0x8a, 0x90, 0xd1, 0x7e, 0x4b, 0x5d, 0x75, 0xf4, 0x10, 0x64, 0x55,
-	0x7d, 0x3e, 0x2f, 0x1e, 0x97, 0xb5, 0x66, 0xc5, 0xfa, 0x24, 0x7e, 0x20, 0x3f, 0x6a, 0x40, 0x2d,
-	0xe6, 0xfe, 0xf0, 0x77, 0x0d, 0x36, 0x2e, 0x70, 0xf4, 0xb3, 0xc0, 0x82, 0x9c, 0x27, 0x9e, 0x6c,
-	0xec, 0x08, 0x1a, 0x5c, 0x1e, 0xd5, 0x0a, 0x6e, 0x1c, 0x0e, 0xf4, 0x15, 0x0f, 0x8c, 0x3e, 0x62,
-	0xd4, 0x53, 0x45, 0x56, 0x2e, 0xbe, 0xb7, 0x8c, 0xd5, 0xc7, 0x96, 0xb1, 0xf6, 0xe4, 0x65, 0x1c,
-	0x32, 0x40, 0xe5, 0xe6, 0xfc, 0x18, 0x5e, 0x12, 0xf7, 0xda, 0x8d, 0x08, 0x7a, 0x01, 0xeb, 0x33,
-	0x1c, 0xd9, 0xd8, 0xf3, 0xf2, 0x57, 0xa6, 0x65, 0xad, 0xcd, 0x70, 0x74, 0xec, 0x79, 0x29, 0xfa,
-	0x36, 0x4f, 0x45, 0xe1, 0x25, 0xe9, 0x57, 0x77, 0x6b, 0x6a, 0xb3, 0x56, 0x4d, 0x73, 0x97, 0x80,
-	0xaa, 0x97, 0xfe, 0xc3, 0xf7, 0x1a, 0x3c, 0x5f, 0x30, 0xfb, 0x70, 0x48, 0xcb, 0xad, 0x56, 0xef,
-	0xb6, 0x7a, 0x00, 0x4d, 0x1c, 0xb3, 0x8c, 0x8a, 0x02, 0xcc, 0x8b, 0xf9, 0xaf, 0x2e, 0x9f, 0xda,
-	0xf2, 0x27, 0x3f, 0x61, 0x21, 0xb5, 0x0a, 0xe1, 0x3d, 0xe4, 0xf5, 0xc7, 0x90, 0x37, 0x9e, 0x8e,
-	0xfc, 0x0d, 0x6c, 0x2e, 0x00, 0xdc, 0x61, 0xee, 0x91, 0xbb, 0xcc, 0x3d, 0x92, 0x0f, 0x32, 0xc9,
-	0x53, 0x4b, 0xcc, 0xf7, 0x57, 0xc2, 0x59, 0xc9, 0x55, 0xd9, 0x28, 0xf4, 0xdf, 0x40, 0x6b, 0xf1,
-	0x4a, 0x20, 0xa8, 0x97, 0x57, 0x75, 0x2c, 0xf5, 0x8d, 0xb6, 0xa0, 0x91, 0xb0, 0x37, 0x24, 0x07,
-	0x59, 0xb3, 0xf2, 0xc3, 0xfe, 0x14, 0x5a, 0x25, 0x75, 0xd4, 0x86, 0xb5, 0x13, 0x6b, 0x72, 0xfc,
-	0x6a, 0x32, 0xee, 0x55, 0x10, 0x40, 0x73, 0xf4, 0x72, 0x3a, 0x9e, 0x8c, 0x7b, 0x1a, 0xea, 0x42,
-	0xeb, 0x7c, 0x2a, 0x4f, 0xa7, 0xd3, 0xef, 0x7b, 0x55, 0xd4, 0x81, 0xf5, 0xfc, 0x38, 0x19, 0xf7,
-	0x6a, 0xb2, 0xca, 0x9a, 0x9c, 0xbd, 0xbc, 0x98, 0x8c, 0x7b, 0xf5, 0xd1, 0x0f, 0x7f, 0xde, 0x0c,
-	0xb4, 0x77, 0x37, 0x03, 0xed, 0x9f, 0x9b, 0x81, 0xf6, 0xf6, 0x76, 0x50, 0x79, 0x77, 0x3b, 0xa8,
-	0xfc, 0x75, 0x3b, 0xa8, 0xfc, 0xf2, 0x95, 0x1f, 0x8a, 0x20, 0x73, 0x74, 0x97, 0xc5, 0x46, 0x31,
-	0x9f, 0x1b, 0xe0, 0x90, 0xce, 0x0f, 0xc6, 0xd5, 0xe2, 0x5f, 0x5b, 0x5c, 0x27, 0x84, 0x3b, 0x4d,
-	0x05, 0xfc, 0xeb, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x6a, 0x0b, 0x3c, 0xd6, 0x07, 0x00,
-	0x00,
+	// 933 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcf, 0x6e, 0xe3, 0x36,
+	0x10, 0xc6, 0x2d, 0xff, 0x49, 0xe2, 0xb1, 0x9d, 0x75, 0x99, 0x6c, 0xe1, 0x0d, 0x0a, 0xdb, 0x75,
+	0xb1, 0x45, 0x10, 0x14, 0x72, 0x93, 0xa6, 0xd7, 0x16, 0x71, 0x6c, 0xd4, 0x29, 0x1a, 0x2f, 0xaa,
+	0x6e, 0x72, 0xe8, 0xa1, 0x02, 0x25, 0x31, 0x32, 0xb1, 0x92, 0x28, 0x88, 0x94, 0x37, 0x39, 0xf4,
+	0x09, 0x7a, 0xd9, 0x7b, 0xdf, 0xa0, 0xe8, 0x83, 0xf4, 0xb8, 0xc7, 0xde, 0x5a, 0x24, 0x0f, 0xd2,
+	0x82, 0x14, 0x2d, 0xdb, 0x88, 0x91, 0x60, 0x77, 0x6f, 0xe4, 0xcc, 0x37, 0x1f, 0x87, 0x3f, 0x0e,
+	0x64, 0x43, 0xcf, 0xc1, 0xce, 0x4d, 0xc0, 0xa2, 0x3e, 0x89, 0x99, 0x3b, 0xa5, 0x91, 0xdf, 0x9f,
+	0x1d, 0xe6, 0x6b, 0x33, 0x4e, 0x98, 0x60, 0x68, 0x47, 0x6b, 0xcc, 0x3c, 0x3e, 0x3b, 0xdc, 0xeb,
+	0xf8, 0x8c, 0xf9, 0x01, 0xe9, 0x2b, 0x89, 0x93, 0x5e, 0xf5, 0x05, 0x0d, 0x09, 0x17, 0x38, 0x8c,
+	0xb3, 0xaa, 0xbd, 0x5d, 0x9f, 0xf9, 0x4c, 0x2d, 0xfb, 0x72, 0xa5, 0xa3, 0x1d, 0x97, 0xf1, 0x90,
+	0xf1, 0x3e, 0x17, 0xf8, 0x55, 0x76, 0x9a, 0x43, 0x04, 0x3e, 0xec, 0x8b, 0x6b, 0x2d, 0x68, 0x6b,
+	0x81, 0x83, 0x39, 0xc9, 0xb3, 0x2e, 0xa3, 0x51, 0x96, 0xef, 0xfd, 0x59, 0x84, 0xca, 0x48, 0xf6,
+	0x81, 0x3e, 0x85, 0xba, 0x6a, 0xc8, 0x8e, 0xd2, 0xd0, 0x21, 0x49, 0xcb, 0xe8, 0x1a, 0xfb, 0x65,
+	0xab, 0xa6, 0x62, 0x13, 0x15, 0x42, 0xc7, 0xf0, 0xb1, 0x9b, 0x26, 0x09, 0x89, 0x84, 0x9d, 0x49,
+	0x69, 0x24, 0x48, 0x32, 0xc3, 0x41, 0xab, 0xa8, 0xc4, 0xbb, 0x3a, 0xab, 0x0c, 0xcf, 0x74, 0x0e,
+	0x7d, 0x01, 0xe8, 0x8a, 0x26, 0x5c, 0xd8, 0x4e, 0xc0, 0xdc, 0x57, 0xf6, 0x94, 0x50, 0x7f, 0x2a,
+	0x5a, 0x25, 0x55, 0xd1, 0x54, 0x99, 0x81, 0x4c, 0x8c, 0x55, 0x1c, 0x8d, 0xe1, 0x49, 0x80, 0x73,
+	0xb1, 0xa4, 0xd0, 0x2a, 0x77, 0x8d, 0xfd, 0xda, 0xd1, 0x9e, 0x99, 0x21, 0x32, 0xe7, 0x88, 0xcc,
+	0x97, 0x73, 0x44, 0x83, 0xf2, 0x9b, 0x7f, 0x3a, 0x86, 0xd5, 0x08, 0xb0, 0xf6, 0x92, 0x19, 0xf4,
+	0x39, 0x3c, 0xe1, 0x04, 0x07, 0x24, 0xb1, 0x71, 0x1c, 0xdb, 0x53, 0xcc, 0xa7, 0xad, 0x4a, 0xd7,
+	0xd8, 0xaf, 0x5b, 0x8d, 0x2c, 0x7c, 0x12, 0xc7, 0x63, 0xcc, 0xa7, 0xe8, 0x00, 0x3e, 0xd2, 0x3a,
+	0xdd, 0xa0, 0x54, 0x6e, 0x28, 0xa5, 0x36, 0xc8, 0xfa, 0xc3, 0x7c, 0xda, 0xfb, 0xcd, 0x80, 0x9d,
+	0x95, 0xdb, 0x59, 0xc4, 0x65, 0x89, 0x87, 0x3a, 0x50, 0xe3, 0x02, 0x27, 0x9a, 0x8b, 0x66, 0x07,
+	0x2a, 0x94, 0xd1, 0x7d, 0x0e, 0xdb, 0x6b, 0x91, 0x35, 0xc8, 0xfb, 0xb3, 0xea, 0xfd, 0x5e, 0x81,
+	0xc6, 0x8f, 0x29, 0x49, 0x89, 0x77, 0x4e, 0x38, 0xc7, 0x3e, 0x41, 0x3b, 0x50, 0x11, 0xd7, 0x36,
+	0xf5, 0x54, 0x07, 0x75, 0xab, 0x2c, 0xae, 0xcf, 0x3c, 0xf4, 0x14, 0x36, 0x42, 0xee, 0xcb, 0x68,
+	0x51, 0x45, 0x2b, 0x21, 0xf7, 0xcf, 0x3c, 0xf9, 0xe0, 0x6b, 0x4e, 0xa9, 0x39, 0x4b, 0x8f, 0xf1,
+	0x2d, 0xc0, 0x7b, 0xbc, 0x43, 0xd5, 0xc9, 0xdf, 0xe0, 0x17, 0xd8, 0x95, 0x47, 0xbb, 0x09, 0xc1,
+	0x82, 0xd8, 0x33, 0x1c, 0x50, 0x0f, 0x0b, 0x96, 0xa8, 0x87, 0xa8, 0x1d, 0x1d, 0x98, 0xd9, 0x74,
+	0x9a, 0x7a, 0x7c, 0x4d, 0x3d, 0xa0, 0xe6, 0x39, 0xf7, 0x4f, 0x55, 0xc9, 0xe5, 0xbc, 0x62, 0x5c,
+	0xb0, 0x50, 0x78, 0x2f, 0x8a, 0xc6, 0x50, 0x97, 0xfe, 0x1e, 0x09, 0x88, 0x8f, 0x05, 0x51, 0xcf,
+	0x56, 0x3b, 0xfa, 0xec, 0x01, 0xdf, 0xa1, 0x96, 0x8e, 0x0b, 0x56, 0x2d, 0x5c, 0x6c, 0xd1, 0x04,
+	0xb6, 0xa5, 0x53, 0x1a, 0xe5, 0x5e, 0x9b, 0xca, 0xeb, 0xf9, 0x03, 0x5e, 0x17, 0xb9, 0x78, 0x5c,
+	0xb0, 0x1a, 0xe1, 0x72, 0x60, 0x7e, 0x73, 0x87, 0xf8, 0x34, 0xb2, 0x13, 0x92, 0xbb, 0x6e, 0x3d,
+	0x7a, 0xf3, 0x81, 0x2c, 0xb1, 0xc8, 0x92, 0x35, 0x0a, 0xef, 0x45, 0xd1, 0xaf, 0xd0, 0x51, 0x64,
+	0x71, 0xe4, 0x92, 0xc0, 0x4e, 0x23, 0x87, 0x45, 0x1e, 0x8d, 0x72, 0x14, 0x94, 0x45, 0xad, 0xaa,
+	0x3a, 0xea, 0xf8, 0x21, 0xc8, 0xaa, 0xfa, 0x62, 0x5e, 0x3c, 0xcc, 0x6b, 0xc7, 0x05, 0xeb, 0x93,
+	0xf0, 0x81, 0x3c, 0xda, 0x83, 0xad, 0x38, 0xa1, 0x2c, 0xa1, 0xe2, 0xa6, 0x05, 0x5d, 0x63, 0xbf,
+	0x64, 0xe5, 0xfb, 0x41, 0x05, 0x4a, 0x21, 0xf7, 0x7b, 0x7f, 0x18, 0xb0, 0x7d, 0x89, 0x83, 0x9f,
+	0x04, 0x16, 0xe4, 0x22, 0xf6, 0x64, 0xd3, 0xc7, 0x50, 0xe1, 0x72, 0xab, 0xc6, 0x73, 0xfb, 0xa8,
+	0x6d, 0xae, 0xf9, 0x14, 0x9a, 0x03, 0x16, 0x79, 0xaa, 0xc8, 0xca, 0xc4, 0xf7, 0x06, 0xb5, 0xf8,
+	0xd8, 0xa0, 0x96, 0xde, 0x79, 0x50, 0x7b, 0x0c, 0x50, 0x3e, 0x55, 0x3f, 0xd0, 0x2b, 0xe2, 0xde,
+	0xb8, 0x01, 0x41, 0xcf, 0x60, 0x6b, 0x86, 0x03, 0x1b, 0x7b, 0x5e, 0xf6, 0x3d, 0xac, 0x5a, 0x9b,
+	0x33, 0x1c, 0x9c, 0x78, 0x5e, 0x82, 0xbe, 0xc9, 0x52, 0x01, 0xbd, 0x22, 0xad, 0x62, 0xb7, 0xa4,
+	0xa6, 0x6e, 0xdd, 0x6d, 0x56, 0x09, 0xa8, 0x7a, 0xe9, 0xdf, 0xfb, 0xcf, 0x80, 0xa7, 0x0b, 0x9e,
+	0x1f, 0x0e, 0x69, 0xb9, 0xd5, 0xe2, 0x6a, 0xab, 0x87, 0xb0, 0x81, 0x43, 0x96, 0x46, 0x42, 0x83,
+	0x79, 0x36, 0x9f, 0x08, 0xf9, 0xa3, 0x90, 0x8f, 0xc3, 0x29, 0xa3, 0x91, 0xa5, 0x85, 0xf7, 0x90,
+	0x97, 0x1f, 0x43, 0x5e, 0x79, 0x77, 0xe4, 0xaf, 0x61, 0x67, 0x01, 0x60, 0x85, 0xb9, 0x47, 0x56,
+	0x99, 0x7b, 0x24, 0xbb, 0xc8, 0x28, 0x4b, 0x2d, 0x31, 0x3f, 0x58, 0x0b, 0x67, 0x2d, 0x57, 0x65,
+	0xa3, 0xd0, 0x7f, 0x0d, 0xd5, 0xc5, 0x17, 0x04, 0x41, 0x39, 0x3f, 0xaa, 0x6e, 0xa9, 0x35, 0xda,
+	0x85, 0x4a, 0xcc, 0x5e, 0x93, 0x0c, 0x64, 0xc9, 0xca, 0x36, 0x07, 0x13, 0xa8, 0xe6, 0xd4, 0x51,
+	0x0d, 0x36, 0x4f, 0xad, 0xd1, 0xc9, 0xcb, 0xd1, 0xb0, 0x59, 0x40, 0x00, 0x1b, 0x83, 0x17, 0x93,
+	0xe1, 0x68, 0xd8, 0x34, 0x50, 0x03, 0xaa, 0x17, 0x13, 0xb9, 0x3b, 0x9b, 0x7c, 0xd7, 0x2c, 0xa2,
+	0x3a, 0x6c, 0x65, 0xdb, 0xd1, 0xb0, 0x59, 0x92, 0x55, 0xd6, 0xe8, 0xfc, 0xc5, 0xe5, 0x68, 0xd8,
+	0x2c, 0x0f, 0xbe, 0xff, 0xeb, 0xb6, 0x6d, 0xbc, 0xbd, 0x6d, 0x1b, 0xff, 0xde, 0xb6, 0x8d, 0x37,
+	0x77, 0xed, 0xc2, 0xdb, 0xbb, 0x76, 0xe1, 0xef, 0xbb, 0x76, 0xe1, 0xe7, 0x2f, 0x7d, 0x2a, 0xa6,
+	0xa9, 0x63, 0xba, 0x2c, 0xec, 0xeb, 0xfb, 0xb9, 0x53, 0x4c, 0xa3, 0xf9, 0xa6, 0x7f, 0xbd, 0xf8,
+	0x7f, 0x21, 0x6e, 0x62, 0xc2, 0x9d, 0x0d, 0x05, 0xfc, 0xab, 0xff, 0x07, 0x00, 0xf3, 0xe6, 0x59,
+	0x99, 0x80, 0x08, 0x00, 0x00,
 }
 
 func (m *Epoch) Marshal() (dAtA []byte, err error) {
@@ -772,6 +854,44 @@ func (m *Epoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EpochIntervalRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochIntervalRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochIntervalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FirstBlockHeight != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.FirstBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochInterval != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.EpochInterval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueuedMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -792,6 +912,11 @@ func (m *QueuedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.Msg != nil {
 		{
 			size := m.Msg.Size()
@@ -1207,6 +1332,24 @@ func (m *Epoch) Size() (n int) {
 	return n
 }
 
+func (m *EpochIntervalRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartEpoch != 0 {
+		n += 1 + sovEpoching(uint64(m.StartEpoch))
+	}
+	if m.EpochInterval != 0 {
+		n += 1 + sovEpoching(uint64(m.EpochInterval))
+	}
+	if m.FirstBlockHeight != 0 {
+		n += 1 + sovEpoching(uint64(m.FirstBlockHeight))
+	}
+	return n
+}
+
 func (m *QueuedMessage) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1231,6 +1374,9 @@ func (m *QueuedMessage) Size() (n int) {
 	if m.Msg != nil {
 		n += m.Msg.Size()
 	}
+	if m.Priority != 0 {
+		n += 1 + sovEpoching(uint64(m.Priority))
+	}
 	return n
 }
 
@@ -1611,6 +1757,113 @@ func (m *Epoch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EpochIntervalRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEpoching
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochIntervalRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochIntervalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochInterval", wireType)
+			}
+			m.EpochInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstBlockHeight", wireType)
+			}
+			m.FirstBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEpoching(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueuedMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1938,6 +2191,25 @@ func (m *QueuedMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.Msg = &QueuedMessage_MsgCancelUnbondingDelegation{v}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEpoching(dAtA[iNdEx:])
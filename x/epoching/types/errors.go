@@ -23,4 +23,9 @@ var (
 	ErrInvalidEpoch              = errorsmod.Register(ModuleName, 12, "the epoch is invalid")
 	ErrInvalidHeight             = errorsmod.Register(ModuleName, 13, "the height is invalid")
 	ErrInsufficientBalance       = errorsmod.Register(ModuleName, 14, "the delegator has insufficient balance to perform delegate")
+	ErrMsgQueueFull              = errorsmod.Register(ModuleName, 15, "the message queue of the current epoch is full")
+	ErrMsgNotExecuted            = errorsmod.Register(ModuleName, 16, "the queued message is not executed due to the limit of executed messages per epoch")
+	ErrQueuedMsgNotFound         = errorsmod.Register(ModuleName, 17, "the message is not found in the message queue of the current epoch")
+	ErrNotQueuedMsgSigner        = errorsmod.Register(ModuleName, 18, "the queued message can only be cancelled by its signer")
+	ErrQueuedMsgNotCancellable   = errorsmod.Register(ModuleName, 19, "the queued message cannot be cancelled")
 )
@@ -201,6 +201,155 @@ func (m *EventHandleQueuedMsg) GetError() string {
 	return ""
 }
 
+// EventCancelQueuedMsg is the event emitted when a queued message has been
+// cancelled by its signer before being handled
+type EventCancelQueuedMsg struct {
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	Height      uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	TxId        []byte `protobuf:"bytes,3,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	MsgId       []byte `protobuf:"bytes,4,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	Signer      string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *EventCancelQueuedMsg) Reset()         { *m = EventCancelQueuedMsg{} }
+func (m *EventCancelQueuedMsg) String() string { return proto.CompactTextString(m) }
+func (*EventCancelQueuedMsg) ProtoMessage()    {}
+func (*EventCancelQueuedMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f0a2c43c7aaeb43, []int{3}
+}
+func (m *EventCancelQueuedMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelQueuedMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelQueuedMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelQueuedMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelQueuedMsg.Merge(m, src)
+}
+func (m *EventCancelQueuedMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelQueuedMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelQueuedMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelQueuedMsg proto.InternalMessageInfo
+
+func (m *EventCancelQueuedMsg) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EventCancelQueuedMsg) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EventCancelQueuedMsg) GetTxId() []byte {
+	if m != nil {
+		return m.TxId
+	}
+	return nil
+}
+
+func (m *EventCancelQueuedMsg) GetMsgId() []byte {
+	if m != nil {
+		return m.MsgId
+	}
+	return nil
+}
+
+func (m *EventCancelQueuedMsg) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// EventQueuedMsgCarriedOver is the event emitted when a queued message is not
+// executed at the end of an epoch due to the limit of executed messages per
+// epoch, and is carried over to the message queue of the next epoch
+type EventQueuedMsgCarriedOver struct {
+	FromEpochNumber uint64 `protobuf:"varint,1,opt,name=from_epoch_number,json=fromEpochNumber,proto3" json:"from_epoch_number,omitempty"`
+	ToEpochNumber   uint64 `protobuf:"varint,2,opt,name=to_epoch_number,json=toEpochNumber,proto3" json:"to_epoch_number,omitempty"`
+	TxId            []byte `protobuf:"bytes,3,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	MsgId           []byte `protobuf:"bytes,4,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+}
+
+func (m *EventQueuedMsgCarriedOver) Reset()         { *m = EventQueuedMsgCarriedOver{} }
+func (m *EventQueuedMsgCarriedOver) String() string { return proto.CompactTextString(m) }
+func (*EventQueuedMsgCarriedOver) ProtoMessage()    {}
+func (*EventQueuedMsgCarriedOver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f0a2c43c7aaeb43, []int{4}
+}
+func (m *EventQueuedMsgCarriedOver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventQueuedMsgCarriedOver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventQueuedMsgCarriedOver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventQueuedMsgCarriedOver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventQueuedMsgCarriedOver.Merge(m, src)
+}
+func (m *EventQueuedMsgCarriedOver) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventQueuedMsgCarriedOver) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventQueuedMsgCarriedOver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventQueuedMsgCarriedOver proto.InternalMessageInfo
+
+func (m *EventQueuedMsgCarriedOver) GetFromEpochNumber() uint64 {
+	if m != nil {
+		return m.FromEpochNumber
+	}
+	return 0
+}
+
+func (m *EventQueuedMsgCarriedOver) GetToEpochNumber() uint64 {
+	if m != nil {
+		return m.ToEpochNumber
+	}
+	return 0
+}
+
+func (m *EventQueuedMsgCarriedOver) GetTxId() []byte {
+	if m != nil {
+		return m.TxId
+	}
+	return nil
+}
+
+func (m *EventQueuedMsgCarriedOver) GetMsgId() []byte {
+	if m != nil {
+		return m.MsgId
+	}
+	return nil
+}
+
 // EventSlashThreshold is the event emitted when a set of validators have been
 // slashed
 type EventSlashThreshold struct {
@@ -213,7 +362,7 @@ func (m *EventSlashThreshold) Reset()         { *m = EventSlashThreshold{} }
 func (m *EventSlashThreshold) String() string { return proto.CompactTextString(m) }
 func (*EventSlashThreshold) ProtoMessage()    {}
 func (*EventSlashThreshold) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f0a2c43c7aaeb43, []int{3}
+	return fileDescriptor_2f0a2c43c7aaeb43, []int{5}
 }
 func (m *EventSlashThreshold) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,7 +426,7 @@ func (m *EventWrappedDelegate) Reset()         { *m = EventWrappedDelegate{} }
 func (m *EventWrappedDelegate) String() string { return proto.CompactTextString(m) }
 func (*EventWrappedDelegate) ProtoMessage()    {}
 func (*EventWrappedDelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f0a2c43c7aaeb43, []int{4}
+	return fileDescriptor_2f0a2c43c7aaeb43, []int{6}
 }
 func (m *EventWrappedDelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -355,7 +504,7 @@ func (m *EventWrappedUndelegate) Reset()         { *m = EventWrappedUndelegate{}
 func (m *EventWrappedUndelegate) String() string { return proto.CompactTextString(m) }
 func (*EventWrappedUndelegate) ProtoMessage()    {}
 func (*EventWrappedUndelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f0a2c43c7aaeb43, []int{5}
+	return fileDescriptor_2f0a2c43c7aaeb43, []int{7}
 }
 func (m *EventWrappedUndelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -434,7 +583,7 @@ func (m *EventWrappedBeginRedelegate) Reset()         { *m = EventWrappedBeginRe
 func (m *EventWrappedBeginRedelegate) String() string { return proto.CompactTextString(m) }
 func (*EventWrappedBeginRedelegate) ProtoMessage()    {}
 func (*EventWrappedBeginRedelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f0a2c43c7aaeb43, []int{6}
+	return fileDescriptor_2f0a2c43c7aaeb43, []int{8}
 }
 func (m *EventWrappedBeginRedelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,7 +668,7 @@ func (m *EventWrappedCancelUnbondingDelegation) Reset()         { *m = EventWrap
 func (m *EventWrappedCancelUnbondingDelegation) String() string { return proto.CompactTextString(m) }
 func (*EventWrappedCancelUnbondingDelegation) ProtoMessage()    {}
 func (*EventWrappedCancelUnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f0a2c43c7aaeb43, []int{7}
+	return fileDescriptor_2f0a2c43c7aaeb43, []int{9}
 }
 func (m *EventWrappedCancelUnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,6 +736,8 @@ func init() {
 	proto.RegisterType((*EventBeginEpoch)(nil), "babylon.epoching.v1.EventBeginEpoch")
 	proto.RegisterType((*EventEndEpoch)(nil), "babylon.epoching.v1.EventEndEpoch")
 	proto.RegisterType((*EventHandleQueuedMsg)(nil), "babylon.epoching.v1.EventHandleQueuedMsg")
+	proto.RegisterType((*EventCancelQueuedMsg)(nil), "babylon.epoching.v1.EventCancelQueuedMsg")
+	proto.RegisterType((*EventQueuedMsgCarriedOver)(nil), "babylon.epoching.v1.EventQueuedMsgCarriedOver")
 	proto.RegisterType((*EventSlashThreshold)(nil), "babylon.epoching.v1.EventSlashThreshold")
 	proto.RegisterType((*EventWrappedDelegate)(nil), "babylon.epoching.v1.EventWrappedDelegate")
 	proto.RegisterType((*EventWrappedUndelegate)(nil), "babylon.epoching.v1.EventWrappedUndelegate")
@@ -597,50 +748,55 @@ func init() {
 func init() { proto.RegisterFile("babylon/epoching/v1/events.proto", fileDescriptor_2f0a2c43c7aaeb43) }
 
 var fileDescriptor_2f0a2c43c7aaeb43 = []byte{
-	// 674 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcd, 0x6e, 0xd4, 0x3c,
-	0x14, 0x6d, 0xe6, 0xef, 0x53, 0xfd, 0xf5, 0xd7, 0x33, 0x8c, 0x22, 0x2a, 0x86, 0x61, 0xa4, 0x8a,
-	0x4a, 0xc0, 0xa4, 0x05, 0x84, 0x10, 0xbb, 0x0e, 0x54, 0x6a, 0x91, 0x40, 0x10, 0xda, 0x22, 0xb1,
-	0x89, 0x9c, 0xd8, 0x24, 0x16, 0x89, 0x1d, 0xd9, 0xce, 0xd0, 0x79, 0x0b, 0x5e, 0x80, 0x1d, 0x0f,
-	0xc0, 0x4b, 0x20, 0x58, 0x76, 0x89, 0x58, 0x20, 0xd4, 0xae, 0x78, 0x0b, 0x14, 0xe7, 0xa7, 0x81,
-	0x4e, 0x51, 0xc5, 0x06, 0xb1, 0xf3, 0x3d, 0xe7, 0xdc, 0xeb, 0x9c, 0x7b, 0x1d, 0x1b, 0xf4, 0x5d,
-	0xe4, 0x4e, 0x42, 0xce, 0x2c, 0x12, 0x73, 0x2f, 0xa0, 0xcc, 0xb7, 0xc6, 0x1b, 0x16, 0x19, 0x13,
-	0xa6, 0xe4, 0x30, 0x16, 0x5c, 0x71, 0xd8, 0xce, 0x15, 0xc3, 0x42, 0x31, 0x1c, 0x6f, 0x5c, 0xec,
-	0xf8, 0xdc, 0xe7, 0x9a, 0xb7, 0xd2, 0x55, 0x26, 0x1d, 0xdc, 0x06, 0x8b, 0x5b, 0x69, 0xea, 0x88,
-	0xf8, 0x94, 0x6d, 0xa5, 0x72, 0x78, 0x05, 0xcc, 0xe9, 0x3c, 0x87, 0x25, 0x91, 0x4b, 0x84, 0x69,
-	0xf4, 0x8d, 0xb5, 0x86, 0xfd, 0xbf, 0xc6, 0x1e, 0x6b, 0x68, 0x70, 0x13, 0xcc, 0xeb, 0xac, 0x2d,
-	0x86, 0xcf, 0x9d, 0xf3, 0xbe, 0x06, 0x3a, 0x3a, 0x69, 0x1b, 0x31, 0x1c, 0x92, 0xa7, 0x09, 0x49,
-	0x08, 0x7e, 0x24, 0x7d, 0x38, 0x04, 0x6d, 0x2e, 0xa8, 0x4f, 0x19, 0x0a, 0x1d, 0x6d, 0xc3, 0x51,
-	0x93, 0x98, 0xe8, 0x12, 0xb3, 0xf6, 0x72, 0x41, 0xe9, 0xd4, 0xdd, 0x49, 0x4c, 0x4e, 0xed, 0x55,
-	0x3b, 0xb5, 0x17, 0xec, 0x82, 0x56, 0x40, 0xa8, 0x1f, 0x28, 0xb3, 0xae, 0xc9, 0x3c, 0x82, 0x6d,
-	0xd0, 0x54, 0x07, 0x0e, 0xc5, 0x66, 0xa3, 0x6f, 0xac, 0xcd, 0xd9, 0x0d, 0x75, 0xb0, 0x83, 0xe1,
-	0x05, 0xd0, 0x8a, 0xa4, 0x9f, 0xa2, 0x4d, 0x8d, 0x36, 0x23, 0xe9, 0xef, 0x60, 0xf8, 0xaa, 0xf2,
-	0x59, 0x48, 0x29, 0x41, 0xdd, 0x44, 0x11, 0x69, 0xb6, 0xfa, 0xf5, 0xb5, 0xb9, 0xd1, 0xbd, 0x2f,
-	0x5f, 0x2f, 0xdf, 0xf1, 0xa9, 0x0a, 0x12, 0x77, 0xe8, 0xf1, 0xc8, 0xf2, 0x78, 0x44, 0x94, 0xfb,
-	0x52, 0x9d, 0x2c, 0x90, 0xeb, 0x51, 0x2b, 0x35, 0x22, 0x87, 0xfa, 0xd3, 0x37, 0x8b, 0x12, 0x36,
-	0x2c, 0xca, 0x96, 0x90, 0x84, 0x1d, 0xd0, 0x24, 0x42, 0x70, 0x61, 0xfe, 0xa7, 0x5d, 0x67, 0xc1,
-	0xe0, 0x9d, 0x01, 0xda, 0x3a, 0xf9, 0x59, 0x88, 0x64, 0xb0, 0x1b, 0x08, 0x22, 0x03, 0x1e, 0x62,
-	0xb8, 0x0e, 0x3a, 0x32, 0x45, 0x08, 0x76, 0xc6, 0x5c, 0x51, 0xe6, 0x3b, 0x31, 0x7f, 0x9d, 0x77,
-	0xbd, 0x6e, 0xc3, 0x9c, 0xdb, 0xd7, 0xd4, 0x93, 0x94, 0x81, 0xd7, 0x01, 0x54, 0x5c, 0xa1, 0xf0,
-	0x67, 0x7d, 0x4d, 0xeb, 0x97, 0x34, 0x53, 0x55, 0xdf, 0x00, 0xb0, 0xac, 0x8f, 0x42, 0x8a, 0x91,
-	0xe2, 0x42, 0x9a, 0xf5, 0xd4, 0xb9, 0xbd, 0x5c, 0x54, 0x2f, 0x89, 0xc1, 0x07, 0x23, 0x9f, 0xec,
-	0x73, 0x81, 0xe2, 0x98, 0xe0, 0x07, 0x24, 0x24, 0x3e, 0x52, 0x04, 0x5e, 0x03, 0xcb, 0x38, 0x5b,
-	0x73, 0xe1, 0x20, 0x8c, 0x05, 0x91, 0x32, 0x9f, 0xeb, 0x52, 0x49, 0x6c, 0x66, 0x78, 0x2a, 0x2e,
-	0x37, 0x2b, 0xc5, 0xb5, 0x4c, 0x5c, 0x12, 0x85, 0xb8, 0x0b, 0x5a, 0x28, 0xe2, 0x09, 0x2b, 0x07,
-	0x9c, 0x45, 0x69, 0x1f, 0x31, 0x61, 0x3c, 0xd2, 0x03, 0x9e, 0xb5, 0xb3, 0x00, 0xae, 0x82, 0x85,
-	0xec, 0xc4, 0xb8, 0x3c, 0x61, 0x18, 0x89, 0x89, 0x9e, 0x74, 0xc3, 0x9e, 0xd7, 0xe8, 0x28, 0x07,
-	0x07, 0x1f, 0x0d, 0xd0, 0xad, 0xfa, 0xd8, 0x63, 0xf8, 0x1f, 0x75, 0xf2, 0xb6, 0x06, 0x56, 0xaa,
-	0x4e, 0xf4, 0xdf, 0x6d, 0x93, 0x3f, 0xb3, 0x73, 0x17, 0x98, 0x92, 0x27, 0xc2, 0x23, 0xce, 0x59,
-	0xae, 0xba, 0x19, 0xbf, 0xff, 0xab, 0xb7, 0x11, 0xb8, 0x84, 0x89, 0x54, 0x94, 0x21, 0x45, 0x39,
-	0x9b, 0x92, 0x5e, 0xd7, 0xe9, 0x2b, 0x15, 0xd1, 0xfe, 0xd9, 0xfd, 0x69, 0x4c, 0xef, 0x4f, 0xf3,
-	0xf7, 0xfd, 0x69, 0x4d, 0xeb, 0xcf, 0x77, 0x03, 0xac, 0x56, 0xfb, 0x73, 0x1f, 0x31, 0x8f, 0x84,
-	0x7b, 0xcc, 0xe5, 0x0c, 0x53, 0xe6, 0xe7, 0x07, 0x98, 0x72, 0xf6, 0x17, 0x06, 0x7f, 0x15, 0x2c,
-	0x7a, 0x82, 0x64, 0x1d, 0xcb, 0x2f, 0xb1, 0x86, 0xfe, 0x4f, 0x17, 0x0a, 0x78, 0x3b, 0xbb, 0xcc,
-	0xce, 0x77, 0x16, 0x46, 0x0f, 0x3f, 0x1d, 0xf5, 0x8c, 0xc3, 0xa3, 0x9e, 0xf1, 0xed, 0xa8, 0x67,
-	0xbc, 0x39, 0xee, 0xcd, 0x1c, 0x1e, 0xf7, 0x66, 0x3e, 0x1f, 0xf7, 0x66, 0x5e, 0xac, 0x57, 0x2e,
-	0xb0, 0xfc, 0xc5, 0xf0, 0x02, 0x44, 0x59, 0x11, 0x58, 0x07, 0x27, 0x4f, 0x8c, 0xbe, 0xc9, 0xdc,
-	0x96, 0x7e, 0x34, 0x6e, 0xfd, 0x08, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x83, 0x9b, 0xc9, 0x83, 0x06,
-	0x00, 0x00,
+	// 758 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcb, 0x6e, 0x13, 0x49,
+	0x14, 0x4d, 0xfb, 0x35, 0x4a, 0x4d, 0x12, 0xc7, 0x65, 0x8f, 0xe5, 0x99, 0x68, 0x3c, 0x1e, 0x4b,
+	0x99, 0x89, 0x78, 0xd8, 0x09, 0x20, 0x84, 0xd8, 0xc5, 0xc1, 0x52, 0x82, 0xc4, 0xab, 0x49, 0x82,
+	0xc4, 0xa6, 0x55, 0xdd, 0x55, 0xe9, 0x2e, 0xd1, 0x5d, 0x65, 0x55, 0x55, 0x9b, 0xf8, 0x2f, 0xd8,
+	0xb0, 0x42, 0xec, 0xf8, 0x00, 0x7e, 0x02, 0xc1, 0x32, 0x4b, 0xc4, 0x02, 0xa1, 0x64, 0xc5, 0x5f,
+	0xa0, 0xae, 0x7e, 0xa4, 0x21, 0x0e, 0x04, 0x36, 0x88, 0x5d, 0xdd, 0x73, 0xce, 0xad, 0xba, 0xf7,
+	0xd4, 0x0b, 0x74, 0x6c, 0x64, 0x4f, 0x7c, 0xce, 0xfa, 0x64, 0xc4, 0x1d, 0x8f, 0x32, 0xb7, 0x3f,
+	0x5e, 0xeb, 0x93, 0x31, 0x61, 0x4a, 0xf6, 0x46, 0x82, 0x2b, 0x0e, 0xeb, 0x89, 0xa2, 0x97, 0x2a,
+	0x7a, 0xe3, 0xb5, 0xbf, 0x1a, 0x2e, 0x77, 0xb9, 0xe6, 0xfb, 0xd1, 0x28, 0x96, 0x76, 0xaf, 0x80,
+	0xea, 0x30, 0x4a, 0x1d, 0x10, 0x97, 0xb2, 0x61, 0x24, 0x87, 0xff, 0x82, 0x39, 0x9d, 0x67, 0xb1,
+	0x30, 0xb0, 0x89, 0x68, 0x19, 0x1d, 0x63, 0xa5, 0x64, 0xfe, 0xae, 0xb1, 0xdb, 0x1a, 0xea, 0x5e,
+	0x02, 0xf3, 0x3a, 0x6b, 0xc8, 0xf0, 0x99, 0x73, 0x5e, 0x16, 0x40, 0x43, 0x27, 0x6d, 0x22, 0x86,
+	0x7d, 0x72, 0x2f, 0x24, 0x21, 0xc1, 0xb7, 0xa4, 0x0b, 0x7b, 0xa0, 0xce, 0x05, 0x75, 0x29, 0x43,
+	0xbe, 0xa5, 0xdb, 0xb0, 0xd4, 0x64, 0x44, 0xf4, 0x14, 0xb3, 0x66, 0x2d, 0xa5, 0x74, 0xea, 0xf6,
+	0x64, 0x44, 0x4e, 0xac, 0x55, 0x38, 0xb1, 0x16, 0x6c, 0x82, 0x8a, 0x47, 0xa8, 0xeb, 0xa9, 0x56,
+	0x51, 0x93, 0x49, 0x04, 0xeb, 0xa0, 0xac, 0xf6, 0x2d, 0x8a, 0x5b, 0xa5, 0x8e, 0xb1, 0x32, 0x67,
+	0x96, 0xd4, 0xfe, 0x16, 0x86, 0x7f, 0x80, 0x4a, 0x20, 0xdd, 0x08, 0x2d, 0x6b, 0xb4, 0x1c, 0x48,
+	0x77, 0x0b, 0xc3, 0x47, 0xb9, 0xb2, 0x90, 0x52, 0x82, 0xda, 0xa1, 0x22, 0xb2, 0x55, 0xe9, 0x14,
+	0x57, 0xe6, 0x06, 0xd7, 0xdf, 0xbd, 0xff, 0xe7, 0xaa, 0x4b, 0x95, 0x17, 0xda, 0x3d, 0x87, 0x07,
+	0x7d, 0x87, 0x07, 0x44, 0xd9, 0x7b, 0xea, 0x78, 0x80, 0x6c, 0x87, 0xf6, 0xa3, 0x46, 0x64, 0x4f,
+	0x97, 0xbe, 0x9e, 0x4e, 0x61, 0xc2, 0x74, 0xda, 0x0c, 0x92, 0xb0, 0x01, 0xca, 0x44, 0x08, 0x2e,
+	0x5a, 0xbf, 0xe9, 0xae, 0xe3, 0xa0, 0xfb, 0xd4, 0x48, 0x2c, 0xdb, 0x40, 0xcc, 0x21, 0xfe, 0xb1,
+	0x65, 0xdf, 0xb6, 0x3b, 0x67, 0x41, 0x61, 0xba, 0x05, 0xc5, 0xa9, 0x16, 0x94, 0xf2, 0x16, 0x34,
+	0x41, 0x45, 0x52, 0x97, 0x11, 0xa1, 0x9d, 0x99, 0x35, 0x93, 0xa8, 0xfb, 0xcc, 0x00, 0x7f, 0xea,
+	0xba, 0xb2, 0x8a, 0x36, 0x90, 0x10, 0x94, 0xe0, 0x3b, 0x63, 0x22, 0xe0, 0x39, 0x50, 0xdb, 0x13,
+	0x3c, 0xb0, 0xa6, 0x54, 0x58, 0x8d, 0x88, 0x61, 0xae, 0xca, 0xff, 0x40, 0x55, 0x71, 0x6b, 0xca,
+	0x76, 0xce, 0x2b, 0x9e, 0xd7, 0x7d, 0x47, 0xd5, 0xdd, 0x17, 0x06, 0xa8, 0xeb, 0xea, 0xee, 0xfb,
+	0x48, 0x7a, 0xdb, 0x9e, 0x20, 0xd2, 0xe3, 0x3e, 0x86, 0xab, 0xa0, 0x21, 0x23, 0x84, 0x60, 0x6b,
+	0xcc, 0x15, 0x65, 0xae, 0x35, 0xe2, 0x8f, 0x93, 0xd2, 0x8a, 0x26, 0x4c, 0xb8, 0x5d, 0x4d, 0xdd,
+	0x8d, 0x18, 0x78, 0x01, 0x40, 0xc5, 0x15, 0xf2, 0x3f, 0xd7, 0x17, 0xb4, 0x7e, 0x51, 0x33, 0x79,
+	0xf5, 0x45, 0x00, 0xb3, 0xf9, 0x91, 0x4f, 0x31, 0x52, 0x5c, 0xc8, 0x56, 0x31, 0x3a, 0x2f, 0x66,
+	0x2d, 0x9d, 0x3d, 0x23, 0xba, 0xaf, 0xd2, 0xcd, 0x7d, 0x20, 0xd0, 0x68, 0x44, 0xf0, 0x0d, 0xe2,
+	0x13, 0x17, 0x29, 0x02, 0xcf, 0x83, 0x1a, 0x8e, 0xc7, 0x5c, 0x58, 0x08, 0x63, 0x41, 0xa4, 0x4c,
+	0x6e, 0xc3, 0x62, 0x46, 0xac, 0xc7, 0x78, 0x24, 0xce, 0x16, 0xcb, 0xc4, 0x85, 0x58, 0x9c, 0x11,
+	0xa9, 0xb8, 0x09, 0x2a, 0x28, 0xe0, 0x21, 0xcb, 0xae, 0x45, 0x1c, 0x45, 0xa7, 0x0f, 0x13, 0xc6,
+	0x03, 0xed, 0xe3, 0xac, 0x19, 0x07, 0x70, 0x19, 0x2c, 0xc4, 0x1b, 0x63, 0xf3, 0x90, 0x61, 0x24,
+	0x26, 0xfa, 0x14, 0x94, 0xcc, 0x79, 0x8d, 0x0e, 0x12, 0xb0, 0xfb, 0xda, 0x00, 0xcd, 0x7c, 0x1f,
+	0x3b, 0x0c, 0xff, 0xa2, 0x9d, 0x3c, 0x2f, 0x80, 0xa5, 0x7c, 0x27, 0xfa, 0x4d, 0x34, 0xc9, 0x8f,
+	0xb5, 0x73, 0x0d, 0xb4, 0x24, 0x0f, 0x85, 0x43, 0xac, 0xd3, 0xba, 0x6a, 0xc6, 0xfc, 0xee, 0x97,
+	0xbd, 0x0d, 0xc0, 0xdf, 0x98, 0x48, 0x45, 0x19, 0x52, 0x94, 0xb3, 0x29, 0xe9, 0x45, 0x9d, 0xbe,
+	0x94, 0x13, 0xed, 0x9e, 0xee, 0x4f, 0x69, 0xba, 0x3f, 0xe5, 0xaf, 0xfb, 0x53, 0x99, 0xe6, 0xcf,
+	0x47, 0x03, 0x2c, 0xe7, 0xfd, 0x89, 0x5f, 0xa5, 0x1d, 0x66, 0x73, 0x86, 0x29, 0x73, 0x93, 0x03,
+	0x4c, 0x39, 0xfb, 0x09, 0x1b, 0xff, 0x3f, 0xa8, 0x3a, 0x82, 0xc4, 0x8e, 0x25, 0xef, 0x5e, 0x49,
+	0xdf, 0xd3, 0x85, 0x14, 0xde, 0xd4, 0xe8, 0x19, 0xcf, 0xc2, 0xe0, 0xe6, 0x9b, 0xc3, 0xb6, 0x71,
+	0x70, 0xd8, 0x36, 0x3e, 0x1c, 0xb6, 0x8d, 0x27, 0x47, 0xed, 0x99, 0x83, 0xa3, 0xf6, 0xcc, 0xdb,
+	0xa3, 0xf6, 0xcc, 0xc3, 0xd5, 0xdc, 0xb3, 0x9f, 0xfc, 0xb3, 0x8e, 0x87, 0x28, 0x4b, 0x83, 0xfe,
+	0xfe, 0xf1, 0xc7, 0xac, 0xdf, 0x7f, 0xbb, 0xa2, 0xbf, 0xda, 0xcb, 0x9f, 0x06, 0x00, 0x1d, 0x6c,
+	0x03, 0x6f, 0xb9, 0x07, 0x00, 0x00,
 }
 
 func (m *EventBeginEpoch) Marshal() (dAtA []byte, err error) {
@@ -774,6 +930,107 @@ func (m *EventHandleQueuedMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventCancelQueuedMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelQueuedMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelQueuedMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MsgId) > 0 {
+		i -= len(m.MsgId)
+		copy(dAtA[i:], m.MsgId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventQueuedMsgCarriedOver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventQueuedMsgCarriedOver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventQueuedMsgCarriedOver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgId) > 0 {
+		i -= len(m.MsgId)
+		copy(dAtA[i:], m.MsgId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ToEpochNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ToEpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromEpochNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FromEpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventSlashThreshold) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1109,6 +1366,56 @@ func (m *EventHandleQueuedMsg) Size() (n int) {
 	return n
 }
 
+func (m *EventCancelQueuedMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovEvents(uint64(m.EpochNumber))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MsgId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventQueuedMsgCarriedOver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromEpochNumber != 0 {
+		n += 1 + sovEvents(uint64(m.FromEpochNumber))
+	}
+	if m.ToEpochNumber != 0 {
+		n += 1 + sovEvents(uint64(m.ToEpochNumber))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MsgId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventSlashThreshold) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1640,6 +1947,350 @@ func (m *EventHandleQueuedMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventCancelQueuedMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelQueuedMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelQueuedMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgId = append(m.MsgId[:0], dAtA[iNdEx:postIndex]...)
+			if m.MsgId == nil {
+				m.MsgId = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventQueuedMsgCarriedOver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventQueuedMsgCarriedOver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventQueuedMsgCarriedOver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpochNumber", wireType)
+			}
+			m.FromEpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpochNumber", wireType)
+			}
+			m.ToEpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgId = append(m.MsgId[:0], dAtA[iNdEx:postIndex]...)
+			if m.MsgId == nil {
+				m.MsgId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventSlashThreshold) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultIndex is the default capability global index
 const DefaultIndex uint64 = 1
 
@@ -20,6 +22,31 @@ func NewGenesis(params Params) *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	for i, record := range gs.EpochIntervalHistory {
+		if record == nil {
+			return fmt.Errorf("empty epoch interval record at index %d", i)
+		}
+		if err := validateEpochInterval(record.EpochInterval); err != nil {
+			return fmt.Errorf("invalid epoch interval record of start epoch %d: %w", record.StartEpoch, err)
+		}
+		if i == 0 {
+			continue
+		}
+		// the records are sorted by their start epochs, and each epoch of a
+		// record spans its epoch interval
+		prev := gs.EpochIntervalHistory[i-1]
+		if record.StartEpoch <= prev.StartEpoch {
+			return fmt.Errorf("epoch interval records are not in ascending order of start epochs: %d after %d", record.StartEpoch, prev.StartEpoch)
+		}
+		expectedFirstBlockHeight := prev.FirstBlockHeight + (record.StartEpoch-prev.StartEpoch)*prev.EpochInterval
+		if record.FirstBlockHeight != expectedFirstBlockHeight {
+			return fmt.Errorf("invalid first block height of start epoch %d: expected %d, got %d", record.StartEpoch, expectedFirstBlockHeight, record.FirstBlockHeight)
+		}
+	}
 
-	return gs.Params.Validate()
+	return nil
 }
@@ -49,6 +49,39 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "valid epoch interval history",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				EpochIntervalHistory: []*types.EpochIntervalRecord{
+					{StartEpoch: 1, EpochInterval: 10, FirstBlockHeight: 1},
+					{StartEpoch: 4, EpochInterval: 20, FirstBlockHeight: 31},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "epoch interval history not in ascending order",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				EpochIntervalHistory: []*types.EpochIntervalRecord{
+					{StartEpoch: 4, EpochInterval: 20, FirstBlockHeight: 31},
+					{StartEpoch: 1, EpochInterval: 10, FirstBlockHeight: 1},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "epoch interval history with inconsistent first block height",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				EpochIntervalHistory: []*types.EpochIntervalRecord{
+					{StartEpoch: 1, EpochInterval: 10, FirstBlockHeight: 1},
+					{StartEpoch: 4, EpochInterval: 20, FirstBlockHeight: 41},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
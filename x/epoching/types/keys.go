@@ -28,6 +28,7 @@ var (
 	ValidatorLifecycleKey  = []byte{0x18} // key prefix for validator life cycle
 	DelegationLifecycleKey = []byte{0x19} // key prefix for delegation life cycle
 	ParamsKey              = []byte{0x20} // key prefix for the parameters
+	EpochIntervalKey       = []byte{0x21} // key prefix for the history of epoch intervals
 )
 
 func KeyPrefix(p string) []byte {
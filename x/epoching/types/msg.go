@@ -11,6 +11,7 @@ var (
 	_ sdk.Msg = &MsgWrappedUndelegate{}
 	_ sdk.Msg = &MsgWrappedBeginRedelegate{}
 	_ sdk.Msg = &MsgWrappedCancelUnbondingDelegation{}
+	_ sdk.Msg = &MsgCancelQueuedMsg{}
 	_ sdk.Msg = &MsgUpdateParams{}
 )
 
@@ -41,3 +42,12 @@ func NewMsgWrappedCancelUnbondingDelegation(msg *stakingtypes.MsgCancelUnbonding
 		Msg: msg,
 	}
 }
+
+// NewMsgCancelQueuedMsg creates a new MsgCancelQueuedMsg instance.
+func NewMsgCancelQueuedMsg(signer string, txID []byte, msgID []byte) *MsgCancelQueuedMsg {
+	return &MsgCancelQueuedMsg{
+		Signer: signer,
+		TxId:   txID,
+		MsgId:  msgID,
+	}
+}
@@ -27,6 +27,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// epoch_interval is the number of consecutive blocks to form an epoch
 	EpochInterval uint64 `protobuf:"varint,1,opt,name=epoch_interval,json=epochInterval,proto3" json:"epoch_interval,omitempty" yaml:"epoch_interval"`
+	// msg_queue_capacity is the maximum number of messages in the message queue
+	// of an epoch. Messages are rejected once the queue is full. Zero means
+	// unlimited.
+	MsgQueueCapacity uint64 `protobuf:"varint,2,opt,name=msg_queue_capacity,json=msgQueueCapacity,proto3" json:"msg_queue_capacity,omitempty" yaml:"msg_queue_capacity"`
+	// max_executed_msgs_per_epoch is the maximum number of queued messages that
+	// are executed at the end of an epoch, selected by their priorities.
+	// MsgCreateValidator messages do not count towards the limit. Zero means
+	// unlimited.
+	MaxExecutedMsgsPerEpoch uint64 `protobuf:"varint,3,opt,name=max_executed_msgs_per_epoch,json=maxExecutedMsgsPerEpoch,proto3" json:"max_executed_msgs_per_epoch,omitempty" yaml:"max_executed_msgs_per_epoch"`
+	// carry_over_unexecuted_msgs is whether the queued messages that are not
+	// executed at the end of an epoch due to max_executed_msgs_per_epoch are
+	// carried over to the message queue of the next epoch, up to its
+	// msg_queue_capacity. Otherwise, they are dropped.
+	CarryOverUnexecutedMsgs bool `protobuf:"varint,4,opt,name=carry_over_unexecuted_msgs,json=carryOverUnexecutedMsgs,proto3" json:"carry_over_unexecuted_msgs,omitempty" yaml:"carry_over_unexecuted_msgs"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,6 +83,27 @@ func (m *Params) GetEpochInterval() uint64 {
 	return 0
 }
 
+func (m *Params) GetMsgQueueCapacity() uint64 {
+	if m != nil {
+		return m.MsgQueueCapacity
+	}
+	return 0
+}
+
+func (m *Params) GetMaxExecutedMsgsPerEpoch() uint64 {
+	if m != nil {
+		return m.MaxExecutedMsgsPerEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetCarryOverUnexecutedMsgs() bool {
+	if m != nil {
+		return m.CarryOverUnexecutedMsgs
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.epoching.v1.Params")
 }
@@ -76,20 +111,30 @@ func init() {
 func init() { proto.RegisterFile("babylon/epoching/v1/params.proto", fileDescriptor_c9e38cfe55335900) }
 
 var fileDescriptor_c9e38cfe55335900 = []byte{
-	// 201 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2d, 0xc8, 0x4f, 0xce, 0xc8, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0xd4,
-	0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xaa,
-	0xd0, 0x83, 0xa9, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83,
-	0x58, 0x10, 0xa5, 0x4a, 0x01, 0x5c, 0x6c, 0x01, 0x60, 0xad, 0x42, 0x0e, 0x5c, 0x7c, 0x60, 0xe5,
-	0xf1, 0x99, 0x79, 0x25, 0xa9, 0x45, 0x65, 0x89, 0x39, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x2c, 0x4e,
-	0x92, 0x9f, 0xee, 0xc9, 0x8b, 0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xa1, 0xca, 0x2b, 0x05, 0xf1,
-	0x82, 0x05, 0x3c, 0xa1, 0x7c, 0x2b, 0x96, 0x17, 0x0b, 0xe4, 0x19, 0x9d, 0xbc, 0x4e, 0x3c, 0x92,
-	0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c,
-	0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x20, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f,
-	0x39, 0x3f, 0x57, 0x1f, 0xea, 0xc2, 0xe4, 0x8c, 0xc4, 0xcc, 0x3c, 0x18, 0x47, 0xbf, 0x02, 0xe1,
-	0xa5, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x23, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xa6, 0x7d, 0x61, 0x54, 0xf3, 0x00, 0x00, 0x00,
+	// 353 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x41, 0x4b, 0xe3, 0x40,
+	0x1c, 0xc5, 0x9b, 0x6e, 0x29, 0x4b, 0x60, 0x97, 0x25, 0xab, 0x34, 0xad, 0x98, 0xd4, 0x01, 0xa5,
+	0xa7, 0xc4, 0xe2, 0xad, 0x27, 0xa9, 0xf4, 0xa0, 0x22, 0xd6, 0x80, 0x17, 0x2f, 0xc3, 0x64, 0x3a,
+	0x4c, 0x03, 0x9d, 0x4c, 0x9c, 0x49, 0x42, 0xf2, 0x2d, 0xfc, 0x08, 0x7e, 0x1c, 0x8f, 0x3d, 0x7a,
+	0x0a, 0xd2, 0x1e, 0xf4, 0x9c, 0x4f, 0x20, 0x9d, 0xa6, 0x68, 0x91, 0xde, 0xe6, 0xff, 0xde, 0x6f,
+	0xde, 0x3b, 0x3c, 0xbd, 0xeb, 0x23, 0x3f, 0x9f, 0xf1, 0xd0, 0x25, 0x11, 0xc7, 0xd3, 0x20, 0xa4,
+	0x6e, 0xda, 0x77, 0x23, 0x24, 0x10, 0x93, 0x4e, 0x24, 0x78, 0xcc, 0x8d, 0xff, 0x15, 0xe1, 0x6c,
+	0x08, 0x27, 0xed, 0x77, 0xf6, 0x28, 0xa7, 0x5c, 0xf9, 0xee, 0xea, 0xb5, 0x46, 0xc1, 0x7b, 0x5d,
+	0x6f, 0x8e, 0xd5, 0x5f, 0xe3, 0x5c, 0xff, 0xab, 0x78, 0x18, 0x84, 0x31, 0x11, 0x29, 0x9a, 0x99,
+	0x5a, 0x57, 0xeb, 0x35, 0x86, 0xed, 0xb2, 0xb0, 0xf7, 0x73, 0xc4, 0x66, 0x03, 0xb0, 0xed, 0x03,
+	0xef, 0x8f, 0x12, 0x2e, 0xab, 0xdb, 0xb8, 0xd6, 0x0d, 0x26, 0x29, 0x7c, 0x4c, 0x48, 0x42, 0x20,
+	0x46, 0x11, 0xc2, 0x41, 0x9c, 0x9b, 0x75, 0x95, 0x72, 0x58, 0x16, 0x76, 0x7b, 0x9d, 0xf2, 0x93,
+	0x01, 0xde, 0x3f, 0x26, 0xe9, 0xdd, 0x4a, 0xbb, 0xa8, 0x24, 0x63, 0xa2, 0x1f, 0x30, 0x94, 0x41,
+	0x92, 0x11, 0x9c, 0xc4, 0x64, 0x02, 0x99, 0xa4, 0x12, 0x46, 0x44, 0x40, 0xd5, 0x69, 0xfe, 0x52,
+	0xa9, 0x27, 0x65, 0x61, 0x83, 0x2a, 0x75, 0x37, 0x0c, 0xbc, 0x16, 0x43, 0xd9, 0xa8, 0x32, 0x6f,
+	0x24, 0x95, 0x63, 0x22, 0x46, 0x2b, 0xc7, 0xf0, 0xf5, 0x0e, 0x46, 0x42, 0xe4, 0x90, 0xa7, 0x44,
+	0xc0, 0x24, 0xdc, 0x4a, 0x30, 0x1b, 0x5d, 0xad, 0xf7, 0x7b, 0x78, 0x5c, 0x16, 0xf6, 0xd1, 0xba,
+	0x64, 0x37, 0x0b, 0xbc, 0x96, 0x32, 0x6f, 0x53, 0x22, 0xee, 0x43, 0xf2, 0xad, 0x6b, 0xd0, 0xf8,
+	0x78, 0xb6, 0xb5, 0xe1, 0xd5, 0xcb, 0xc2, 0xd2, 0xe6, 0x0b, 0x4b, 0x7b, 0x5b, 0x58, 0xda, 0xd3,
+	0xd2, 0xaa, 0xcd, 0x97, 0x56, 0xed, 0x75, 0x69, 0xd5, 0x1e, 0x4e, 0x69, 0x10, 0x4f, 0x13, 0xdf,
+	0xc1, 0x9c, 0xb9, 0xd5, 0x72, 0x78, 0x8a, 0x82, 0x70, 0x73, 0xb8, 0xd9, 0xd7, 0xd4, 0x71, 0x1e,
+	0x11, 0xe9, 0x37, 0xd5, 0x78, 0x67, 0x9f, 0x03, 0x00, 0x99, 0xc4, 0x72, 0x67, 0x0b, 0x02, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -114,6 +159,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.EpochInterval != that1.EpochInterval {
 		return false
 	}
+	if this.MsgQueueCapacity != that1.MsgQueueCapacity {
+		return false
+	}
+	if this.MaxExecutedMsgsPerEpoch != that1.MaxExecutedMsgsPerEpoch {
+		return false
+	}
+	if this.CarryOverUnexecutedMsgs != that1.CarryOverUnexecutedMsgs {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -136,6 +190,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CarryOverUnexecutedMsgs {
+		i--
+		if m.CarryOverUnexecutedMsgs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxExecutedMsgsPerEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxExecutedMsgsPerEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MsgQueueCapacity != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MsgQueueCapacity))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.EpochInterval != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.EpochInterval))
 		i--
@@ -164,6 +238,15 @@ func (m *Params) Size() (n int) {
 	if m.EpochInterval != 0 {
 		n += 1 + sovParams(uint64(m.EpochInterval))
 	}
+	if m.MsgQueueCapacity != 0 {
+		n += 1 + sovParams(uint64(m.MsgQueueCapacity))
+	}
+	if m.MaxExecutedMsgsPerEpoch != 0 {
+		n += 1 + sovParams(uint64(m.MaxExecutedMsgsPerEpoch))
+	}
+	if m.CarryOverUnexecutedMsgs {
+		n += 2
+	}
 	return n
 }
 
@@ -221,6 +304,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgQueueCapacity", wireType)
+			}
+			m.MsgQueueCapacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgQueueCapacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutedMsgsPerEpoch", wireType)
+			}
+			m.MaxExecutedMsgsPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutedMsgsPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarryOverUnexecutedMsgs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CarryOverUnexecutedMsgs = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
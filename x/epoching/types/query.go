@@ -13,6 +13,7 @@ func (e *Epoch) ToResponse() *EpochResponse {
 		LastBlockTime:        e.LastBlockTime,
 		SealerAppHashHex:     hex.EncodeToString(e.SealerAppHash),
 		SealerBlockHash:      hex.EncodeToString(e.SealerBlockHash),
+		LastBlockHeight:      e.GetLastBlockHeight(),
 	}
 }
 
@@ -24,6 +25,7 @@ func (q *QueuedMessage) ToResponse() *QueuedMessageResponse {
 		BlockHeight: q.BlockHeight,
 		BlockTime:   q.BlockTime,
 		Msg:         q.UnwrapToSdkMsg().String(),
+		Priority:    q.Priority,
 	}
 }
 
@@ -307,6 +307,92 @@ func (m *QueryEpochsInfoResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryEpochIntervalHistoryRequest is the request type for the
+// Query/EpochIntervalHistory RPC method
+type QueryEpochIntervalHistoryRequest struct {
+}
+
+func (m *QueryEpochIntervalHistoryRequest) Reset()         { *m = QueryEpochIntervalHistoryRequest{} }
+func (m *QueryEpochIntervalHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochIntervalHistoryRequest) ProtoMessage()    {}
+func (*QueryEpochIntervalHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1821b530f2ec2711, []int{6}
+}
+func (m *QueryEpochIntervalHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochIntervalHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochIntervalHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochIntervalHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochIntervalHistoryRequest.Merge(m, src)
+}
+func (m *QueryEpochIntervalHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochIntervalHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochIntervalHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochIntervalHistoryRequest proto.InternalMessageInfo
+
+// QueryEpochIntervalHistoryResponse is the response type for the
+// Query/EpochIntervalHistory RPC method
+type QueryEpochIntervalHistoryResponse struct {
+	// records is the list of epoch interval records in ascending order of
+	// their start epochs
+	Records []*EpochIntervalRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (m *QueryEpochIntervalHistoryResponse) Reset()         { *m = QueryEpochIntervalHistoryResponse{} }
+func (m *QueryEpochIntervalHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochIntervalHistoryResponse) ProtoMessage()    {}
+func (*QueryEpochIntervalHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1821b530f2ec2711, []int{7}
+}
+func (m *QueryEpochIntervalHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochIntervalHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochIntervalHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochIntervalHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochIntervalHistoryResponse.Merge(m, src)
+}
+func (m *QueryEpochIntervalHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochIntervalHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochIntervalHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochIntervalHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryEpochIntervalHistoryResponse) GetRecords() []*EpochIntervalRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 // QueryCurrentEpochRequest is the request type for the Query/CurrentEpoch RPC
 // method
 type QueryCurrentEpochRequest struct {
@@ -316,7 +402,7 @@ func (m *QueryCurrentEpochRequest) Reset()         { *m = QueryCurrentEpochReque
 func (m *QueryCurrentEpochRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCurrentEpochRequest) ProtoMessage()    {}
 func (*QueryCurrentEpochRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{6}
+	return fileDescriptor_1821b530f2ec2711, []int{8}
 }
 func (m *QueryCurrentEpochRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,7 +444,7 @@ func (m *QueryCurrentEpochResponse) Reset()         { *m = QueryCurrentEpochResp
 func (m *QueryCurrentEpochResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCurrentEpochResponse) ProtoMessage()    {}
 func (*QueryCurrentEpochResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{7}
+	return fileDescriptor_1821b530f2ec2711, []int{9}
 }
 func (m *QueryCurrentEpochResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -413,7 +499,7 @@ func (m *QueryEpochMsgsRequest) Reset()         { *m = QueryEpochMsgsRequest{} }
 func (m *QueryEpochMsgsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEpochMsgsRequest) ProtoMessage()    {}
 func (*QueryEpochMsgsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{8}
+	return fileDescriptor_1821b530f2ec2711, []int{10}
 }
 func (m *QueryEpochMsgsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -469,7 +555,7 @@ func (m *QueryEpochMsgsResponse) Reset()         { *m = QueryEpochMsgsResponse{}
 func (m *QueryEpochMsgsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEpochMsgsResponse) ProtoMessage()    {}
 func (*QueryEpochMsgsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{9}
+	return fileDescriptor_1821b530f2ec2711, []int{11}
 }
 func (m *QueryEpochMsgsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,7 +613,7 @@ func (m *QueryLatestEpochMsgsRequest) Reset()         { *m = QueryLatestEpochMsg
 func (m *QueryLatestEpochMsgsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLatestEpochMsgsRequest) ProtoMessage()    {}
 func (*QueryLatestEpochMsgsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{10}
+	return fileDescriptor_1821b530f2ec2711, []int{12}
 }
 func (m *QueryLatestEpochMsgsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,7 +676,7 @@ func (m *QueryLatestEpochMsgsResponse) Reset()         { *m = QueryLatestEpochMs
 func (m *QueryLatestEpochMsgsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLatestEpochMsgsResponse) ProtoMessage()    {}
 func (*QueryLatestEpochMsgsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{11}
+	return fileDescriptor_1821b530f2ec2711, []int{13}
 }
 func (m *QueryLatestEpochMsgsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -643,7 +729,7 @@ func (m *QueryValidatorLifecycleRequest) Reset()         { *m = QueryValidatorLi
 func (m *QueryValidatorLifecycleRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorLifecycleRequest) ProtoMessage()    {}
 func (*QueryValidatorLifecycleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{12}
+	return fileDescriptor_1821b530f2ec2711, []int{14}
 }
 func (m *QueryValidatorLifecycleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -690,7 +776,7 @@ func (m *QueryValidatorLifecycleResponse) Reset()         { *m = QueryValidatorL
 func (m *QueryValidatorLifecycleResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorLifecycleResponse) ProtoMessage()    {}
 func (*QueryValidatorLifecycleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{13}
+	return fileDescriptor_1821b530f2ec2711, []int{15}
 }
 func (m *QueryValidatorLifecycleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -743,7 +829,7 @@ func (m *QueryDelegationLifecycleRequest) Reset()         { *m = QueryDelegation
 func (m *QueryDelegationLifecycleRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationLifecycleRequest) ProtoMessage()    {}
 func (*QueryDelegationLifecycleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{14}
+	return fileDescriptor_1821b530f2ec2711, []int{16}
 }
 func (m *QueryDelegationLifecycleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -789,7 +875,7 @@ func (m *QueryDelegationLifecycleResponse) Reset()         { *m = QueryDelegatio
 func (m *QueryDelegationLifecycleResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationLifecycleResponse) ProtoMessage()    {}
 func (*QueryDelegationLifecycleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{15}
+	return fileDescriptor_1821b530f2ec2711, []int{17}
 }
 func (m *QueryDelegationLifecycleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -836,7 +922,7 @@ func (m *QueryEpochValSetRequest) Reset()         { *m = QueryEpochValSetRequest
 func (m *QueryEpochValSetRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEpochValSetRequest) ProtoMessage()    {}
 func (*QueryEpochValSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{16}
+	return fileDescriptor_1821b530f2ec2711, []int{18}
 }
 func (m *QueryEpochValSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,7 +977,7 @@ func (m *QueryEpochValSetResponse) Reset()         { *m = QueryEpochValSetRespon
 func (m *QueryEpochValSetResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEpochValSetResponse) ProtoMessage()    {}
 func (*QueryEpochValSetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{17}
+	return fileDescriptor_1821b530f2ec2711, []int{19}
 }
 func (m *QueryEpochValSetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,13 +1049,16 @@ type EpochResponse struct {
 	// the validator set has generated a BLS multisig on the hash,
 	// i.e., hash of the last block in the epoch as hex string.
 	SealerBlockHash string `protobuf:"bytes,6,opt,name=sealer_block_hash,json=sealerBlockHash,proto3" json:"sealer_block_hash,omitempty"`
+	// last_block_height is the height of the last block in this epoch, w.r.t.
+	// the epoch interval of this epoch
+	LastBlockHeight uint64 `protobuf:"varint,7,opt,name=last_block_height,json=lastBlockHeight,proto3" json:"last_block_height,omitempty"`
 }
 
 func (m *EpochResponse) Reset()         { *m = EpochResponse{} }
 func (m *EpochResponse) String() string { return proto.CompactTextString(m) }
 func (*EpochResponse) ProtoMessage()    {}
 func (*EpochResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{18}
+	return fileDescriptor_1821b530f2ec2711, []int{20}
 }
 func (m *EpochResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1040,6 +1129,13 @@ func (m *EpochResponse) GetSealerBlockHash() string {
 	return ""
 }
 
+func (m *EpochResponse) GetLastBlockHeight() uint64 {
+	if m != nil {
+		return m.LastBlockHeight
+	}
+	return 0
+}
+
 // QueuedMessageResponse is a message that can change the validator set and is delayed
 // to the end of an epoch
 type QueuedMessageResponse struct {
@@ -1054,13 +1150,16 @@ type QueuedMessageResponse struct {
 	// msg is the actual message that is sent by a user and is queued by the
 	// epoching module as string.
 	Msg string `protobuf:"bytes,5,opt,name=msg,proto3" json:"msg,omitempty"`
+	// priority is the priority of the tx that contains the message, i.e., the
+	// fee per gas unit paid by the tx
+	Priority int64 `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (m *QueuedMessageResponse) Reset()         { *m = QueuedMessageResponse{} }
 func (m *QueuedMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*QueuedMessageResponse) ProtoMessage()    {}
 func (*QueuedMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{19}
+	return fileDescriptor_1821b530f2ec2711, []int{21}
 }
 func (m *QueuedMessageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1124,6 +1223,13 @@ func (m *QueuedMessageResponse) GetMsg() string {
 	return ""
 }
 
+func (m *QueuedMessageResponse) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 // QueuedMessageList is a message that contains a list of staking-related
 // messages queued for an epoch
 type QueuedMessageList struct {
@@ -1135,7 +1241,7 @@ func (m *QueuedMessageList) Reset()         { *m = QueuedMessageList{} }
 func (m *QueuedMessageList) String() string { return proto.CompactTextString(m) }
 func (*QueuedMessageList) ProtoMessage()    {}
 func (*QueuedMessageList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{20}
+	return fileDescriptor_1821b530f2ec2711, []int{22}
 }
 func (m *QueuedMessageList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1190,7 +1296,7 @@ func (m *ValStateUpdateResponse) Reset()         { *m = ValStateUpdateResponse{}
 func (m *ValStateUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*ValStateUpdateResponse) ProtoMessage()    {}
 func (*ValStateUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1821b530f2ec2711, []int{21}
+	return fileDescriptor_1821b530f2ec2711, []int{23}
 }
 func (m *ValStateUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1247,6 +1353,8 @@ func init() {
 	proto.RegisterType((*QueryEpochInfoResponse)(nil), "babylon.epoching.v1.QueryEpochInfoResponse")
 	proto.RegisterType((*QueryEpochsInfoRequest)(nil), "babylon.epoching.v1.QueryEpochsInfoRequest")
 	proto.RegisterType((*QueryEpochsInfoResponse)(nil), "babylon.epoching.v1.QueryEpochsInfoResponse")
+	proto.RegisterType((*QueryEpochIntervalHistoryRequest)(nil), "babylon.epoching.v1.QueryEpochIntervalHistoryRequest")
+	proto.RegisterType((*QueryEpochIntervalHistoryResponse)(nil), "babylon.epoching.v1.QueryEpochIntervalHistoryResponse")
 	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "babylon.epoching.v1.QueryCurrentEpochRequest")
 	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "babylon.epoching.v1.QueryCurrentEpochResponse")
 	proto.RegisterType((*QueryEpochMsgsRequest)(nil), "babylon.epoching.v1.QueryEpochMsgsRequest")
@@ -1268,94 +1376,100 @@ func init() {
 func init() { proto.RegisterFile("babylon/epoching/v1/query.proto", fileDescriptor_1821b530f2ec2711) }
 
 var fileDescriptor_1821b530f2ec2711 = []byte{
-	// 1384 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x1b, 0xce, 0x3a, 0x4e, 0xbe, 0xe6, 0x4d, 0xf3, 0x25, 0x9d, 0xb4, 0xfd, 0x52, 0xa7, 0x75, 0xfa,
-	0x6d, 0xa1, 0x2d, 0x49, 0xb3, 0xdb, 0x34, 0x29, 0xd0, 0x1f, 0x50, 0x35, 0x2d, 0x25, 0x41, 0x2d,
-	0x4a, 0x17, 0xe8, 0x81, 0xcb, 0x32, 0xf6, 0x4e, 0xd6, 0x2b, 0xd6, 0xbb, 0xdb, 0x9d, 0xb1, 0x49,
-	0x54, 0x8a, 0x10, 0xe2, 0xc8, 0xa1, 0x12, 0x07, 0x84, 0x90, 0x10, 0x88, 0x23, 0x7f, 0x01, 0x2a,
-	0x07, 0x8e, 0x3d, 0x16, 0x71, 0xe1, 0x04, 0xa8, 0xe5, 0x0f, 0x41, 0xfb, 0xce, 0xac, 0xbd, 0x76,
-	0xd6, 0xb5, 0x13, 0x55, 0xdc, 0xec, 0xf7, 0xe7, 0xf3, 0x3e, 0xef, 0xcc, 0xec, 0x03, 0x73, 0x15,
-	0x5a, 0xd9, 0xf6, 0xc3, 0xc0, 0x64, 0x51, 0x58, 0xad, 0x79, 0x81, 0x6b, 0x36, 0x97, 0xcc, 0xbb,
-	0x0d, 0x16, 0x6f, 0x1b, 0x51, 0x1c, 0x8a, 0x90, 0x4c, 0xab, 0x00, 0x23, 0x0d, 0x30, 0x9a, 0x4b,
-	0xa5, 0x83, 0x6e, 0xe8, 0x86, 0xe8, 0x37, 0x93, 0x5f, 0x32, 0xb4, 0x34, 0xe7, 0x86, 0xa1, 0xeb,
-	0x33, 0x13, 0xff, 0x55, 0x1a, 0x9b, 0xa6, 0xf0, 0xea, 0x8c, 0x0b, 0x5a, 0x8f, 0x54, 0xc0, 0x51,
-	0x15, 0x40, 0x23, 0xcf, 0xa4, 0x41, 0x10, 0x0a, 0x2a, 0xbc, 0x30, 0xe0, 0xca, 0x3b, 0x5f, 0x0d,
-	0x79, 0x3d, 0xe4, 0x66, 0x85, 0x72, 0x26, 0x21, 0x98, 0xcd, 0xa5, 0x0a, 0x13, 0x74, 0xc9, 0x8c,
-	0xa8, 0xeb, 0x05, 0x18, 0xac, 0x62, 0x8f, 0xe7, 0xc1, 0x8e, 0x68, 0x4c, 0xeb, 0x69, 0x35, 0x3d,
-	0x2f, 0xa2, 0x35, 0x03, 0xc6, 0xe8, 0x07, 0x81, 0xdc, 0x4e, 0xfa, 0x6c, 0x60, 0xa2, 0xc5, 0xee,
-	0x36, 0x18, 0x17, 0xfa, 0x06, 0x4c, 0x77, 0x58, 0x79, 0x14, 0x06, 0x9c, 0x91, 0x0b, 0x30, 0x2a,
-	0x1b, 0xcc, 0x68, 0xc7, 0xb5, 0xd3, 0xe3, 0xe7, 0x66, 0x8d, 0x1c, 0x66, 0x0c, 0x99, 0xb4, 0x5a,
-	0x7c, 0xf4, 0xc7, 0xdc, 0x90, 0xa5, 0x12, 0xf4, 0x15, 0x38, 0x84, 0x15, 0xdf, 0x48, 0x02, 0xd7,
-	0x83, 0xcd, 0x50, 0xb5, 0x22, 0xb3, 0x30, 0x86, 0xc9, 0x76, 0xd0, 0xa8, 0x63, 0xd9, 0xa2, 0xb5,
-	0x0f, 0x0d, 0x6f, 0x37, 0xea, 0xba, 0x05, 0x87, 0xbb, 0xb3, 0x14, 0x94, 0x57, 0x61, 0x04, 0xa3,
-	0x14, 0x12, 0x3d, 0x17, 0x09, 0xa6, 0xa5, 0x29, 0x96, 0x4c, 0xd0, 0x3f, 0xc8, 0xd6, 0xe4, 0x59,
-	0x28, 0x37, 0x00, 0xda, 0x2c, 0xab, 0xc2, 0x27, 0x0d, 0xb9, 0x12, 0x23, 0x59, 0x89, 0x21, 0x4f,
-	0x85, 0x5a, 0x89, 0xb1, 0x41, 0x5d, 0xa6, 0x72, 0xad, 0x4c, 0xa6, 0xfe, 0xad, 0x06, 0xff, 0xdb,
-	0xd1, 0x42, 0xe1, 0xbe, 0x08, 0xa3, 0x08, 0x23, 0xa1, 0x70, 0x78, 0x40, 0xe0, 0x2a, 0x83, 0xbc,
-	0xd9, 0x81, 0xaf, 0x80, 0xf8, 0x4e, 0xf5, 0xc5, 0xa7, 0x8a, 0x64, 0x01, 0x96, 0x60, 0x06, 0xf1,
-	0x5d, 0x6b, 0xc4, 0x31, 0x0b, 0x84, 0xea, 0x26, 0x57, 0xef, 0xc2, 0x91, 0x1c, 0x9f, 0x42, 0x7f,
-	0x02, 0x26, 0xaa, 0xd2, 0x6e, 0xb7, 0xd9, 0x2f, 0x5a, 0xfb, 0xab, 0x99, 0x60, 0xf2, 0x22, 0xfc,
-	0x57, 0x6e, 0xb4, 0x12, 0x36, 0x02, 0x87, 0xc6, 0xdb, 0x08, 0xb5, 0x68, 0x4d, 0xa0, 0x75, 0x55,
-	0x19, 0xf5, 0x8f, 0xb3, 0x27, 0xe2, 0x16, 0x77, 0xf9, 0x20, 0x27, 0xa2, 0x6b, 0x47, 0x85, 0x3d,
-	0xef, 0xe8, 0x7b, 0x2d, 0x7b, 0x0c, 0x64, 0x7b, 0x35, 0xe4, 0xeb, 0x50, 0xac, 0x73, 0x37, 0x5d,
-	0xd0, 0x7c, 0xee, 0x82, 0x6e, 0x37, 0x58, 0x83, 0x39, 0xb7, 0x18, 0xe7, 0x59, 0x8e, 0x31, 0xef,
-	0xf9, 0xad, 0xe9, 0x07, 0x0d, 0x66, 0x11, 0xe3, 0x4d, 0x2a, 0x18, 0x17, 0xb9, 0x44, 0x05, 0x4e,
-	0xc7, 0x26, 0xf6, 0xb1, 0xc0, 0x91, 0x5b, 0x98, 0x83, 0x71, 0xc9, 0x62, 0x35, 0x6c, 0x04, 0x42,
-	0xad, 0x00, 0xd0, 0x74, 0x2d, 0xb1, 0x74, 0x31, 0x39, 0xbc, 0x67, 0x26, 0x1f, 0x6a, 0x70, 0x34,
-	0x1f, 0xa5, 0xe2, 0xd3, 0x82, 0x03, 0x3e, 0xba, 0x24, 0x52, 0x3b, 0x43, 0xee, 0xc9, 0xfe, 0xe4,
-	0xde, 0xf4, 0xb8, 0xb0, 0x26, 0xfd, 0xce, 0xda, 0xcf, 0x8f, 0xe3, 0x4b, 0x50, 0x46, 0xf0, 0x77,
-	0xa8, 0xef, 0x39, 0x54, 0x84, 0xf1, 0x4d, 0x6f, 0x93, 0x55, 0xb7, 0xab, 0x7e, 0x3a, 0x2b, 0x39,
-	0x02, 0xfb, 0x9a, 0xd4, 0xb7, 0xa9, 0xe3, 0xc4, 0x48, 0xf2, 0x98, 0xf5, 0x9f, 0x26, 0xf5, 0xaf,
-	0x3a, 0x4e, 0xac, 0x7f, 0xae, 0xc1, 0x5c, 0xcf, 0x6c, 0x35, 0x7d, 0xef, 0x74, 0x72, 0x43, 0xba,
-	0x7c, 0x6f, 0x93, 0xcd, 0x14, 0x90, 0x8f, 0x85, 0x5c, 0x3e, 0xee, 0x50, 0xff, 0x1d, 0x41, 0x05,
-	0x7b, 0x2f, 0x72, 0xa8, 0x68, 0x8f, 0x91, 0xd4, 0x49, 0xfa, 0xe9, 0x97, 0x15, 0x8a, 0xeb, 0xcc,
-	0x67, 0x2e, 0x8e, 0x95, 0x37, 0x84, 0xc3, 0x3a, 0x51, 0x38, 0x4c, 0x0e, 0xe1, 0xc2, 0xf1, 0xde,
-	0xd9, 0x6a, 0x88, 0x6b, 0x32, 0x1d, 0x91, 0xca, 0x77, 0xf1, 0x74, 0x2e, 0xd2, 0xbc, 0x1a, 0x49,
-	0x23, 0x84, 0xf9, 0x49, 0xf6, 0x55, 0x4c, 0x66, 0x62, 0xe2, 0x5f, 0xbd, 0xf2, 0xbf, 0x6a, 0xea,
-	0xd9, 0xeb, 0x00, 0xd0, 0xba, 0xf4, 0xd0, 0x4c, 0x97, 0x98, 0x9e, 0xce, 0x72, 0xaf, 0x6d, 0xc8,
-	0x30, 0x2b, 0x93, 0x41, 0xce, 0x00, 0x11, 0xa1, 0xa0, 0xbe, 0xdd, 0x0c, 0x85, 0x17, 0xb8, 0x76,
-	0x14, 0x7e, 0xc4, 0x62, 0x04, 0x3b, 0x6c, 0x4d, 0xa1, 0xe7, 0x0e, 0x3a, 0x36, 0x12, 0x7b, 0xd7,
-	0xf1, 0x1d, 0xde, 0xfb, 0xf1, 0x7d, 0x58, 0x80, 0x89, 0xce, 0x27, 0xfa, 0xff, 0xb0, 0xbf, 0x45,
-	0x65, 0x85, 0xc5, 0x8a, 0xcd, 0xf1, 0x94, 0xcd, 0x0a, 0x8b, 0xc9, 0x0a, 0x1c, 0xee, 0x78, 0xc5,
-	0x6d, 0x2f, 0x10, 0x2c, 0x6e, 0x52, 0x5f, 0xbd, 0x12, 0x07, 0xb3, 0xcf, 0xf9, 0xba, 0xf2, 0x25,
-	0x13, 0x6e, 0x7a, 0x31, 0x17, 0x76, 0xc5, 0x0f, 0xab, 0x1f, 0xda, 0x35, 0xe6, 0xb9, 0x35, 0x81,
-	0xd8, 0x8b, 0xd6, 0x14, 0x7a, 0x56, 0x13, 0xc7, 0x1a, 0xda, 0xc9, 0x1a, 0x4c, 0xfa, 0xb4, 0x15,
-	0x9c, 0xa8, 0xa0, 0x99, 0x22, 0x8e, 0x59, 0x32, 0xa4, 0x02, 0x32, 0x52, 0x89, 0x64, 0xbc, 0x9b,
-	0x4a, 0xa4, 0xd5, 0xe2, 0x83, 0x3f, 0xe7, 0x34, 0x6b, 0x22, 0x49, 0xc4, 0x5a, 0x89, 0x87, 0x2c,
-	0xc2, 0x34, 0x67, 0xd4, 0x67, 0xb1, 0x4d, 0xa3, 0xc8, 0xae, 0x51, 0x5e, 0xb3, 0x6b, 0x6c, 0x6b,
-	0x66, 0x04, 0x4f, 0xf1, 0x94, 0x74, 0x5d, 0x8d, 0xa2, 0x35, 0xca, 0x6b, 0x6b, 0x6c, 0x8b, 0xcc,
-	0xc3, 0x01, 0x15, 0xae, 0x70, 0x52, 0x5e, 0x9b, 0x19, 0xc5, 0xe0, 0x49, 0xe9, 0x90, 0x30, 0x29,
-	0xaf, 0xe9, 0x3f, 0x69, 0xf8, 0x0d, 0xda, 0xf9, 0x92, 0x93, 0x69, 0x18, 0x11, 0x5b, 0xb6, 0xe7,
-	0xa8, 0xcb, 0x52, 0x14, 0x5b, 0xeb, 0x0e, 0x39, 0x04, 0xa3, 0x75, 0xee, 0x26, 0xd6, 0x02, 0x5a,
-	0x47, 0xea, 0xdc, 0x5d, 0x77, 0x12, 0xc6, 0x73, 0x28, 0x19, 0xaf, 0x64, 0xd8, 0xb8, 0x02, 0xb0,
-	0x07, 0x22, 0xc6, 0x2a, 0x2d, 0x12, 0xa6, 0x60, 0xb8, 0xce, 0x5d, 0x35, 0x74, 0xf2, 0x53, 0x6f,
-	0xc2, 0x81, 0x1d, 0xef, 0xe4, 0x20, 0xcb, 0x4f, 0xbf, 0x6e, 0x85, 0xbd, 0x7d, 0xdd, 0xf4, 0x6f,
-	0x34, 0x38, 0x9c, 0xff, 0x20, 0x91, 0x63, 0x00, 0x3c, 0x31, 0xdb, 0x0e, 0xe3, 0x55, 0xc5, 0xdc,
-	0x18, 0x5a, 0xae, 0x33, 0x5e, 0xdd, 0xc1, 0x53, 0xa1, 0x1f, 0x4f, 0xc3, 0xbb, 0xe6, 0xe9, 0xdc,
-	0xe3, 0x71, 0x18, 0xc1, 0x3b, 0x4e, 0x3e, 0xd5, 0x60, 0x54, 0x2a, 0x51, 0x72, 0xaa, 0xd7, 0x90,
-	0x5d, 0xb2, 0xb7, 0x74, 0xba, 0x7f, 0xa0, 0x1c, 0x55, 0x3f, 0xf1, 0xd9, 0x6f, 0x7f, 0x7f, 0x59,
-	0x38, 0x46, 0x66, 0xcd, 0xde, 0x2a, 0x9c, 0x7c, 0xa5, 0xc1, 0x58, 0x4b, 0xb9, 0x92, 0xf9, 0xde,
-	0xc5, 0xbb, 0x45, 0x71, 0x69, 0x61, 0xa0, 0x58, 0x85, 0x65, 0x09, 0xb1, 0x2c, 0x90, 0x97, 0xcc,
-	0x9e, 0x7a, 0x9f, 0x9b, 0xf7, 0x5a, 0xe7, 0xe2, 0xb5, 0xf9, 0xfb, 0xe4, 0x0b, 0x0d, 0xa0, 0x2d,
-	0x4e, 0x49, 0xbf, 0x76, 0x59, 0x95, 0x5c, 0x3a, 0x33, 0x58, 0xf0, 0x40, 0x44, 0x29, 0x61, 0xfb,
-	0xb5, 0x06, 0xfb, 0xb3, 0x7a, 0x93, 0x2c, 0xf6, 0xee, 0x91, 0xa3, 0x59, 0x4b, 0xc6, 0xa0, 0xe1,
-	0x0a, 0xd4, 0x3c, 0x82, 0x7a, 0x81, 0xe8, 0xb9, 0xa0, 0x3a, 0xde, 0x46, 0xf2, 0x5d, 0xba, 0x44,
-	0xd4, 0x1d, 0xfd, 0x96, 0x98, 0x91, 0x67, 0x7d, 0x97, 0x98, 0x15, 0x49, 0xfa, 0x45, 0x84, 0xb4,
-	0x42, 0xce, 0x0d, 0xbc, 0x44, 0xb3, 0x2e, 0xef, 0x27, 0x27, 0x3f, 0x6a, 0x30, 0xd9, 0x25, 0xbe,
-	0xc8, 0xd9, 0xde, 0xcd, 0xf3, 0xd5, 0x64, 0x69, 0x69, 0x17, 0x19, 0x0a, 0xf4, 0x32, 0x82, 0x5e,
-	0x24, 0x0b, 0xcf, 0x00, 0x7d, 0x51, 0x4a, 0xb7, 0x36, 0xda, 0x9f, 0x35, 0x20, 0x3b, 0xf5, 0x12,
-	0x59, 0xee, 0xdd, 0xbe, 0xa7, 0x36, 0x2b, 0xad, 0xec, 0x2e, 0x49, 0xc1, 0xbe, 0x84, 0xb0, 0xcf,
-	0x93, 0xe5, 0x5c, 0xd8, 0xad, 0x8f, 0x3a, 0xca, 0x1d, 0xcc, 0x34, 0xef, 0xa5, 0x12, 0xee, 0x3e,
-	0xf9, 0x45, 0x83, 0xe9, 0x1c, 0x99, 0x43, 0x9e, 0x01, 0xa5, 0xb7, 0x2e, 0x2b, 0x9d, 0xdf, 0x65,
-	0x96, 0x9a, 0xe0, 0x32, 0x4e, 0xf0, 0x32, 0x59, 0xc9, 0x9d, 0xc0, 0x69, 0x65, 0x66, 0x47, 0x48,
-	0xf5, 0xdf, 0xfd, 0xe4, 0xbc, 0x8c, 0x67, 0x34, 0x10, 0xe9, 0x77, 0xa3, 0x3b, 0xb4, 0x5a, 0x69,
-	0x71, 0xc0, 0x68, 0x05, 0xf5, 0x0a, 0x42, 0xbd, 0x40, 0x5e, 0x19, 0xfc, 0x60, 0xb7, 0x37, 0xc0,
-	0x99, 0x58, 0x7d, 0xeb, 0xd1, 0x93, 0xb2, 0xf6, 0xf8, 0x49, 0x59, 0xfb, 0xeb, 0x49, 0x59, 0x7b,
-	0xf0, 0xb4, 0x3c, 0xf4, 0xf8, 0x69, 0x79, 0xe8, 0xf7, 0xa7, 0xe5, 0xa1, 0xf7, 0xcf, 0xba, 0x9e,
-	0xa8, 0x35, 0x2a, 0x46, 0x35, 0xac, 0xa7, 0xc5, 0xab, 0x35, 0xea, 0x05, 0xad, 0x4e, 0x5b, 0xed,
-	0x5e, 0x62, 0x3b, 0x62, 0xbc, 0x32, 0x8a, 0xdf, 0x90, 0xe5, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xad, 0xee, 0x6f, 0xab, 0xf3, 0x11, 0x00, 0x00,
+	// 1476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x37, 0x9b, 0x34, 0x79, 0x69, 0x48, 0x3a, 0x49, 0xc3, 0xd6, 0x69, 0x37, 0xa9, 0x0b,
+	0x6d, 0x49, 0x1a, 0xbb, 0x69, 0xd2, 0x42, 0x7f, 0x40, 0xd5, 0xb4, 0xb4, 0x29, 0x6a, 0x51, 0x6a,
+	0xa0, 0x07, 0x2e, 0x66, 0x76, 0x3d, 0xf1, 0x5a, 0x78, 0x6d, 0xd7, 0x33, 0xbb, 0x64, 0x55, 0x8a,
+	0x10, 0xe2, 0x08, 0x52, 0x25, 0x0e, 0x08, 0x21, 0x21, 0x10, 0x47, 0xfe, 0x03, 0xe0, 0xc0, 0xb1,
+	0xc7, 0x22, 0x84, 0xc4, 0x09, 0x50, 0x83, 0xc4, 0xbf, 0x81, 0x3c, 0x1e, 0x7b, 0xbd, 0x1b, 0xbb,
+	0xbb, 0x89, 0x2a, 0x6e, 0xc9, 0x9b, 0xf7, 0xe3, 0x7b, 0xdf, 0x3c, 0xbf, 0xf9, 0x16, 0xe6, 0x2a,
+	0xb8, 0xd2, 0x72, 0x3c, 0x57, 0x23, 0xbe, 0x57, 0xad, 0xd9, 0xae, 0xa5, 0x35, 0x97, 0xb5, 0x7b,
+	0x0d, 0x12, 0xb4, 0x54, 0x3f, 0xf0, 0x98, 0x87, 0xa6, 0x84, 0x83, 0x1a, 0x3b, 0xa8, 0xcd, 0x65,
+	0x79, 0xda, 0xf2, 0x2c, 0x8f, 0x9f, 0x6b, 0xe1, 0x5f, 0x91, 0xab, 0x3c, 0x67, 0x79, 0x9e, 0xe5,
+	0x10, 0x8d, 0xff, 0x57, 0x69, 0x6c, 0x6a, 0xcc, 0xae, 0x13, 0xca, 0x70, 0xdd, 0x17, 0x0e, 0x87,
+	0x85, 0x03, 0xf6, 0x6d, 0x0d, 0xbb, 0xae, 0xc7, 0x30, 0xb3, 0x3d, 0x97, 0x8a, 0xd3, 0x85, 0xaa,
+	0x47, 0xeb, 0x1e, 0xd5, 0x2a, 0x98, 0x92, 0x08, 0x82, 0xd6, 0x5c, 0xae, 0x10, 0x86, 0x97, 0x35,
+	0x1f, 0x5b, 0xb6, 0xcb, 0x9d, 0x85, 0xef, 0x7c, 0x16, 0x6c, 0x1f, 0x07, 0xb8, 0x1e, 0x67, 0x53,
+	0xb2, 0x3c, 0x92, 0x1e, 0xb8, 0x8f, 0x32, 0x0d, 0xe8, 0x4e, 0x58, 0x67, 0x83, 0x07, 0xea, 0xe4,
+	0x5e, 0x83, 0x50, 0xa6, 0x6c, 0xc0, 0x54, 0x87, 0x95, 0xfa, 0x9e, 0x4b, 0x09, 0x3a, 0x0f, 0xc3,
+	0x51, 0x81, 0x92, 0x34, 0x2f, 0x9d, 0x1c, 0x3b, 0x33, 0xab, 0x66, 0x30, 0xa3, 0x46, 0x41, 0x6b,
+	0xc5, 0x47, 0x7f, 0xce, 0x0d, 0xe8, 0x22, 0x40, 0x59, 0x85, 0x83, 0x3c, 0xe3, 0xeb, 0xa1, 0xe3,
+	0x4d, 0x77, 0xd3, 0x13, 0xa5, 0xd0, 0x2c, 0x8c, 0xf2, 0x60, 0xc3, 0x6d, 0xd4, 0x79, 0xda, 0xa2,
+	0x3e, 0xc2, 0x0d, 0x6f, 0x36, 0xea, 0x8a, 0x0e, 0x33, 0xdd, 0x51, 0x02, 0xca, 0x2b, 0x30, 0xc4,
+	0xbd, 0x04, 0x12, 0x25, 0x13, 0x09, 0x0f, 0x8b, 0x43, 0xf4, 0x28, 0x40, 0x79, 0x2f, 0x9d, 0x93,
+	0xa6, 0xa1, 0x5c, 0x07, 0x68, 0xb3, 0x2c, 0x12, 0x1f, 0x57, 0xa3, 0x2b, 0x51, 0xc3, 0x2b, 0x51,
+	0xa3, 0xa9, 0x10, 0x57, 0xa2, 0x6e, 0x60, 0x8b, 0x88, 0x58, 0x3d, 0x15, 0xa9, 0x7c, 0x23, 0xc1,
+	0xf3, 0x3b, 0x4a, 0x08, 0xdc, 0x17, 0x60, 0x98, 0xc3, 0x08, 0x29, 0x1c, 0xec, 0x13, 0xb8, 0x88,
+	0x40, 0x37, 0x3a, 0xf0, 0x15, 0x38, 0xbe, 0x13, 0x3d, 0xf1, 0x89, 0x24, 0x69, 0x80, 0x0a, 0xcc,
+	0xa7, 0x69, 0x65, 0x24, 0x68, 0x62, 0x67, 0xdd, 0xa6, 0xcc, 0x0b, 0x5a, 0xf1, 0x08, 0x58, 0x70,
+	0xf4, 0x29, 0x3e, 0xa2, 0x9b, 0x35, 0xd8, 0x17, 0x90, 0xaa, 0x17, 0x98, 0x71, 0x3b, 0x27, 0xf3,
+	0xdb, 0x89, 0x73, 0xe8, 0x3c, 0x40, 0x8f, 0x03, 0x15, 0x19, 0x4a, 0xbc, 0xd0, 0xd5, 0x46, 0x10,
+	0x10, 0x97, 0x89, 0xd6, 0x63, 0x10, 0x87, 0x32, 0xce, 0x44, 0xf1, 0x63, 0x30, 0x5e, 0x8d, 0xec,
+	0x46, 0x7b, 0x14, 0x8a, 0xfa, 0xfe, 0x6a, 0xca, 0x19, 0xbd, 0x08, 0xcf, 0x45, 0xe3, 0x55, 0xf1,
+	0x1a, 0xae, 0x89, 0x83, 0x16, 0xe7, 0xad, 0xa8, 0x8f, 0x73, 0xeb, 0x9a, 0x30, 0x2a, 0x1f, 0xa6,
+	0xc7, 0xf3, 0x36, 0xb5, 0x68, 0x3f, 0xe3, 0xd9, 0x35, 0x30, 0x85, 0x3d, 0x0f, 0xcc, 0x77, 0x12,
+	0xcc, 0x74, 0x97, 0x17, 0x4d, 0xbe, 0x06, 0xc5, 0x3a, 0xb5, 0x62, 0x7a, 0x17, 0x32, 0xe9, 0xbd,
+	0xd3, 0x20, 0x0d, 0x62, 0xde, 0x26, 0x94, 0xa6, 0x2f, 0x9c, 0xc7, 0x3d, 0xbb, 0x99, 0xf9, 0x5e,
+	0x82, 0x59, 0x8e, 0xf1, 0x16, 0x66, 0x84, 0xb2, 0x4c, 0xa2, 0x5c, 0xb3, 0xe3, 0x26, 0x46, 0x88,
+	0x6b, 0x46, 0xb7, 0x30, 0x07, 0x63, 0x11, 0x8b, 0x55, 0xaf, 0xe1, 0x32, 0x71, 0x05, 0xc0, 0x4d,
+	0x57, 0x43, 0x4b, 0x17, 0x93, 0x83, 0x7b, 0x66, 0xf2, 0x27, 0x09, 0x0e, 0x67, 0xa3, 0x14, 0x7c,
+	0xea, 0x70, 0xc0, 0xe1, 0x47, 0x11, 0x52, 0x23, 0x45, 0xee, 0xf1, 0xde, 0xe4, 0xde, 0xb2, 0x29,
+	0xd3, 0x27, 0x9c, 0xce, 0xdc, 0xcf, 0x8e, 0xe3, 0x8b, 0x50, 0xe6, 0xe0, 0xef, 0x62, 0xc7, 0x36,
+	0x31, 0xf3, 0x82, 0x5b, 0xf6, 0x26, 0xa9, 0xb6, 0xaa, 0x4e, 0xdc, 0x2b, 0x3a, 0x04, 0x23, 0x4d,
+	0xec, 0x18, 0xd8, 0x34, 0x03, 0x4e, 0xf2, 0xa8, 0xbe, 0xaf, 0x89, 0x9d, 0x2b, 0xa6, 0x19, 0x28,
+	0x9f, 0x4a, 0x30, 0x97, 0x1b, 0x2d, 0xba, 0xcf, 0x0f, 0x47, 0xd7, 0xa3, 0x23, 0xc7, 0xde, 0x24,
+	0xa5, 0x02, 0xe7, 0x63, 0x31, 0x93, 0x8f, 0xbb, 0xd8, 0x79, 0x8b, 0x61, 0x46, 0xde, 0xf1, 0x4d,
+	0xcc, 0xda, 0x6d, 0x84, 0x79, 0xc2, 0x7a, 0xca, 0x25, 0x81, 0xe2, 0x1a, 0x71, 0x88, 0xc5, 0xdb,
+	0xca, 0x6a, 0xc2, 0x24, 0x9d, 0x28, 0x4c, 0x12, 0x35, 0x61, 0xc1, 0x7c, 0x7e, 0xb4, 0x68, 0xe2,
+	0x6a, 0x14, 0xce, 0x91, 0x46, 0x4b, 0x3a, 0x7b, 0xeb, 0x64, 0xe5, 0x08, 0x0b, 0x71, 0x98, 0x1f,
+	0xa5, 0x57, 0x74, 0xd8, 0x13, 0x61, 0xff, 0xeb, 0x27, 0xff, 0xab, 0x04, 0xa5, 0x9d, 0x00, 0x92,
+	0x8f, 0x1e, 0x9a, 0xf1, 0x25, 0xc6, 0xd3, 0x59, 0xce, 0xbb, 0x8d, 0xc8, 0x4d, 0x4f, 0x45, 0xa0,
+	0x53, 0x80, 0x98, 0xc7, 0xb0, 0x63, 0x34, 0x3d, 0x66, 0xbb, 0x96, 0xe1, 0x7b, 0x1f, 0x90, 0x80,
+	0x83, 0x1d, 0xd4, 0x27, 0xf9, 0xc9, 0x5d, 0x7e, 0xb0, 0x11, 0xda, 0xd1, 0x8d, 0x8c, 0x6f, 0x6f,
+	0x4f, 0xe3, 0xfb, 0x6f, 0x01, 0xc6, 0x3b, 0x57, 0xf4, 0x51, 0xd8, 0x9f, 0x50, 0x59, 0x21, 0x81,
+	0x60, 0x73, 0x2c, 0x66, 0xb3, 0x42, 0x02, 0xb4, 0x0a, 0x33, 0x1d, 0x5b, 0xdc, 0xb0, 0xc5, 0x3b,
+	0x21, 0xb6, 0xc4, 0x74, 0x7a, 0x9d, 0xc7, 0x6f, 0x48, 0xd8, 0xe1, 0xa6, 0x1d, 0x50, 0x66, 0x54,
+	0x1c, 0xaf, 0xfa, 0xbe, 0x51, 0x23, 0xb6, 0x55, 0x63, 0x1c, 0x7b, 0x51, 0x9f, 0xe4, 0x27, 0x6b,
+	0xe1, 0xc1, 0x3a, 0xb7, 0xa3, 0x75, 0x98, 0x70, 0x70, 0xe2, 0x1c, 0x4a, 0xb2, 0x52, 0x91, 0xb7,
+	0x29, 0xab, 0x91, 0x1c, 0x53, 0x63, 0xbd, 0xa6, 0xbe, 0x1d, 0xeb, 0xb5, 0xb5, 0xe2, 0xc3, 0xbf,
+	0xe6, 0x24, 0x7d, 0xdc, 0xc1, 0x22, 0x57, 0x78, 0x82, 0x96, 0x60, 0x8a, 0x12, 0xec, 0x90, 0xc0,
+	0xc0, 0xbe, 0x6f, 0xd4, 0x30, 0xad, 0x19, 0x35, 0xb2, 0x55, 0x1a, 0xe2, 0x53, 0x3c, 0x19, 0x1d,
+	0x5d, 0xf1, 0xfd, 0x75, 0x4c, 0x6b, 0xeb, 0x64, 0x0b, 0x2d, 0xc0, 0x01, 0xe1, 0x2e, 0x70, 0x62,
+	0x5a, 0x2b, 0x0d, 0x73, 0xe7, 0x89, 0xe8, 0x20, 0x82, 0x89, 0x69, 0x2d, 0xf4, 0x75, 0x70, 0x77,
+	0x47, 0xfb, 0x78, 0x47, 0x13, 0x0e, 0xee, 0x68, 0x48, 0xf9, 0x5d, 0x82, 0x83, 0x1d, 0x8b, 0x29,
+	0x61, 0x7c, 0x0a, 0x86, 0xd8, 0x96, 0x61, 0x9b, 0xe2, 0xc3, 0x2a, 0xb2, 0xad, 0x9b, 0x26, 0x3a,
+	0x08, 0xc3, 0x75, 0x6a, 0x85, 0xd6, 0x02, 0xb7, 0x0e, 0xd5, 0xa9, 0x75, 0xd3, 0x0c, 0x6f, 0x27,
+	0x83, 0xbe, 0xb1, 0x4a, 0x8a, 0xb9, 0xcb, 0x00, 0x7b, 0x20, 0x6d, 0xb4, 0x92, 0x10, 0x36, 0x09,
+	0x83, 0x75, 0x6a, 0x09, 0x82, 0xc2, 0x3f, 0x91, 0x0c, 0x23, 0x7e, 0x60, 0x7b, 0x81, 0xcd, 0x5a,
+	0x9c, 0x8a, 0x41, 0x3d, 0xf9, 0x5f, 0x69, 0xc2, 0x81, 0x1d, 0xfb, 0xb6, 0x9f, 0x21, 0x8a, 0x5f,
+	0xc9, 0xc2, 0xde, 0x5e, 0x49, 0xe5, 0x6b, 0x09, 0x66, 0xb2, 0x17, 0x1b, 0x3a, 0x02, 0x40, 0x43,
+	0xb3, 0x61, 0x12, 0x5a, 0x15, 0xac, 0x8e, 0x72, 0xcb, 0x35, 0x42, 0xab, 0x3b, 0x38, 0x2c, 0xf4,
+	0xe2, 0x70, 0x70, 0xd7, 0x1c, 0x9e, 0xf9, 0x7c, 0x1c, 0x86, 0xf8, 0xae, 0x40, 0x1f, 0x4b, 0x30,
+	0x1c, 0xc9, 0x6b, 0x74, 0x22, 0xaf, 0xc9, 0x2e, 0x2d, 0x2f, 0x9f, 0xec, 0xed, 0x18, 0xb5, 0xaa,
+	0x1c, 0xfb, 0xe4, 0xb7, 0x7f, 0xbe, 0x28, 0x1c, 0x41, 0xb3, 0x5a, 0xfe, 0x4f, 0x0b, 0xf4, 0xa5,
+	0x04, 0xa3, 0x89, 0x1c, 0x47, 0x0b, 0xf9, 0xc9, 0xbb, 0x95, 0xbe, 0xbc, 0xd8, 0x97, 0xaf, 0xc0,
+	0xb2, 0xcc, 0xb1, 0x2c, 0xa2, 0x97, 0xb4, 0xdc, 0x1f, 0x31, 0x54, 0xbb, 0x9f, 0xcc, 0xc5, 0xab,
+	0x0b, 0x0f, 0xd0, 0x67, 0x12, 0x40, 0x5b, 0x71, 0xa3, 0x5e, 0xe5, 0xd2, 0xd2, 0x5f, 0x3e, 0xd5,
+	0x9f, 0x73, 0x5f, 0x44, 0x09, 0xb5, 0xfe, 0xa3, 0x04, 0xd3, 0x59, 0xe2, 0x19, 0x9d, 0xed, 0xc9,
+	0x43, 0x96, 0x20, 0x97, 0xcf, 0xed, 0x36, 0x4c, 0x80, 0x5d, 0xe1, 0x60, 0x97, 0xd0, 0x62, 0x3e,
+	0xd8, 0x64, 0xe7, 0x1a, 0x35, 0x81, 0xf1, 0x2b, 0x09, 0xf6, 0xa7, 0x45, 0x37, 0x5a, 0xca, 0xaf,
+	0x9e, 0x21, 0xdc, 0x65, 0xb5, 0x5f, 0x77, 0x01, 0x72, 0x81, 0x83, 0x7c, 0x01, 0x29, 0x99, 0x20,
+	0x3b, 0x1e, 0x08, 0xf4, 0x6d, 0x3c, 0x81, 0x5c, 0x7c, 0xf5, 0x9a, 0xc0, 0x94, 0x46, 0x95, 0x17,
+	0xfb, 0xf2, 0x15, 0x90, 0x2e, 0x70, 0x48, 0xab, 0xe8, 0x4c, 0xdf, 0x13, 0xa8, 0xd5, 0xa3, 0xe5,
+	0x42, 0xd1, 0x0f, 0x12, 0x4c, 0x74, 0x29, 0x50, 0x74, 0x3a, 0xbf, 0x78, 0xb6, 0xa4, 0x96, 0x97,
+	0x77, 0x11, 0xd1, 0xff, 0x65, 0xd3, 0x0b, 0x91, 0x7e, 0x6d, 0xa3, 0xfd, 0x59, 0x02, 0xb4, 0x53,
+	0x34, 0xa2, 0x95, 0xfc, 0xf2, 0xb9, 0x02, 0x55, 0x5e, 0xdd, 0x5d, 0x90, 0x80, 0x7d, 0x91, 0xc3,
+	0x3e, 0x8b, 0x56, 0x32, 0x61, 0x27, 0xca, 0xc6, 0x70, 0xe2, 0x48, 0xed, 0x7e, 0xac, 0x63, 0x1f,
+	0xa0, 0x5f, 0x24, 0x98, 0xca, 0xd0, 0x7a, 0xe8, 0x29, 0x50, 0xf2, 0xc5, 0xa9, 0x7c, 0x76, 0x97,
+	0x51, 0xa2, 0x83, 0x4b, 0xbc, 0x83, 0x73, 0x68, 0x35, 0xb3, 0x03, 0x33, 0x89, 0x4c, 0xb7, 0x10,
+	0x8b, 0xe0, 0x07, 0xe1, 0xbc, 0x8c, 0xa5, 0x84, 0x20, 0xea, 0xb5, 0x8e, 0x3a, 0x04, 0xab, 0xbc,
+	0xd4, 0xa7, 0xb7, 0x80, 0x7a, 0x99, 0x43, 0x3d, 0x8f, 0x5e, 0xee, 0x7f, 0xb0, 0xdb, 0x37, 0x40,
+	0x09, 0x5b, 0x7b, 0xe3, 0xd1, 0x93, 0xb2, 0xf4, 0xf8, 0x49, 0x59, 0xfa, 0xfb, 0x49, 0x59, 0x7a,
+	0xb8, 0x5d, 0x1e, 0x78, 0xbc, 0x5d, 0x1e, 0xf8, 0x63, 0xbb, 0x3c, 0xf0, 0xee, 0x69, 0xcb, 0x66,
+	0xb5, 0x46, 0x45, 0xad, 0x7a, 0xf5, 0x38, 0x79, 0xb5, 0x86, 0x6d, 0x37, 0xa9, 0xb4, 0xd5, 0xae,
+	0xc5, 0x5a, 0x3e, 0xa1, 0x95, 0x61, 0xfe, 0x00, 0xae, 0xfc, 0x37, 0x00, 0xa2, 0x3b, 0x70, 0x1b,
+	0x85, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1378,6 +1492,9 @@ type QueryClient interface {
 	// the parameters in the pagination request. Th main use case will be querying
 	// the latest epochs in time order.
 	EpochsInfo(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error)
+	// EpochIntervalHistory queries the history of epoch intervals, i.e., the
+	// epoch interval of each range of epochs
+	EpochIntervalHistory(ctx context.Context, in *QueryEpochIntervalHistoryRequest, opts ...grpc.CallOption) (*QueryEpochIntervalHistoryResponse, error)
 	// CurrentEpoch queries the current epoch
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
 	// EpochMsgs queries the messages of a given epoch
@@ -1428,6 +1545,15 @@ func (c *queryClient) EpochsInfo(ctx context.Context, in *QueryEpochsInfoRequest
 	return out, nil
 }
 
+func (c *queryClient) EpochIntervalHistory(ctx context.Context, in *QueryEpochIntervalHistoryRequest, opts ...grpc.CallOption) (*QueryEpochIntervalHistoryResponse, error) {
+	out := new(QueryEpochIntervalHistoryResponse)
+	err := c.cc.Invoke(ctx, "/babylon.epoching.v1.Query/EpochIntervalHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error) {
 	out := new(QueryCurrentEpochResponse)
 	err := c.cc.Invoke(ctx, "/babylon.epoching.v1.Query/CurrentEpoch", in, out, opts...)
@@ -1492,6 +1618,9 @@ type QueryServer interface {
 	// the parameters in the pagination request. Th main use case will be querying
 	// the latest epochs in time order.
 	EpochsInfo(context.Context, *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error)
+	// EpochIntervalHistory queries the history of epoch intervals, i.e., the
+	// epoch interval of each range of epochs
+	EpochIntervalHistory(context.Context, *QueryEpochIntervalHistoryRequest) (*QueryEpochIntervalHistoryResponse, error)
 	// CurrentEpoch queries the current epoch
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
 	// EpochMsgs queries the messages of a given epoch
@@ -1520,6 +1649,9 @@ func (*UnimplementedQueryServer) EpochInfo(ctx context.Context, req *QueryEpochI
 func (*UnimplementedQueryServer) EpochsInfo(ctx context.Context, req *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochsInfo not implemented")
 }
+func (*UnimplementedQueryServer) EpochIntervalHistory(ctx context.Context, req *QueryEpochIntervalHistoryRequest) (*QueryEpochIntervalHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochIntervalHistory not implemented")
+}
 func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
@@ -1597,6 +1729,24 @@ func _Query_EpochsInfo_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EpochIntervalHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochIntervalHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochIntervalHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.epoching.v1.Query/EpochIntervalHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochIntervalHistory(ctx, req.(*QueryEpochIntervalHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCurrentEpochRequest)
 	if err := dec(in); err != nil {
@@ -1721,6 +1871,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EpochsInfo",
 			Handler:    _Query_EpochsInfo_Handler,
 		},
+		{
+			MethodName: "EpochIntervalHistory",
+			Handler:    _Query_EpochIntervalHistory_Handler,
+		},
 		{
 			MethodName: "CurrentEpoch",
 			Handler:    _Query_CurrentEpoch_Handler,
@@ -1953,6 +2107,66 @@ func (m *QueryEpochsInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEpochIntervalHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochIntervalHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochIntervalHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochIntervalHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochIntervalHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochIntervalHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryCurrentEpochRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2445,6 +2659,11 @@ func (m *EpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastBlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.SealerBlockHash) > 0 {
 		i -= len(m.SealerBlockHash)
 		copy(dAtA[i:], m.SealerBlockHash)
@@ -2507,6 +2726,11 @@ func (m *QueuedMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Msg) > 0 {
 		i -= len(m.Msg)
 		copy(dAtA[i:], m.Msg)
@@ -2721,6 +2945,30 @@ func (m *QueryEpochsInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEpochIntervalHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochIntervalHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryCurrentEpochRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2941,6 +3189,9 @@ func (m *EpochResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.LastBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastBlockHeight))
+	}
 	return n
 }
 
@@ -2969,6 +3220,9 @@ func (m *QueuedMessageResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovQuery(uint64(m.Priority))
+	}
 	return n
 }
 
@@ -3510,6 +3764,140 @@ func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEpochIntervalHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochIntervalHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochIntervalHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochIntervalHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochIntervalHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochIntervalHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &EpochIntervalRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4913,6 +5301,25 @@ func (m *EpochResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.SealerBlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
+			}
+			m.LastBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5114,6 +5521,25 @@ func (m *QueuedMessageResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
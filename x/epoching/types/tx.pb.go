@@ -340,6 +340,110 @@ func (m *MsgWrappedCancelUnbondingDelegationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWrappedCancelUnbondingDelegationResponse proto.InternalMessageInfo
 
+// MsgCancelQueuedMsg is the message for cancelling a message queued in the
+// current epoch. It can only be submitted by the signer of the queued message.
+// A queued MsgCreateValidator cannot be cancelled.
+type MsgCancelQueuedMsg struct {
+	// signer is the address of the signer of the queued message
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// tx_id is the ID of the tx that contains the queued message
+	TxId []byte `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// msg_id is the ID of the queued message, i.e., hash of the marshaled
+	// message
+	MsgId []byte `protobuf:"bytes,3,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+}
+
+func (m *MsgCancelQueuedMsg) Reset()         { *m = MsgCancelQueuedMsg{} }
+func (m *MsgCancelQueuedMsg) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelQueuedMsg) ProtoMessage()    {}
+func (*MsgCancelQueuedMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5fc8fed8f4e58b6, []int{8}
+}
+func (m *MsgCancelQueuedMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelQueuedMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelQueuedMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelQueuedMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelQueuedMsg.Merge(m, src)
+}
+func (m *MsgCancelQueuedMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelQueuedMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelQueuedMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelQueuedMsg proto.InternalMessageInfo
+
+func (m *MsgCancelQueuedMsg) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgCancelQueuedMsg) GetTxId() []byte {
+	if m != nil {
+		return m.TxId
+	}
+	return nil
+}
+
+func (m *MsgCancelQueuedMsg) GetMsgId() []byte {
+	if m != nil {
+		return m.MsgId
+	}
+	return nil
+}
+
+// MsgCancelQueuedMsgResponse is the response to the MsgCancelQueuedMsg message
+type MsgCancelQueuedMsgResponse struct {
+}
+
+func (m *MsgCancelQueuedMsgResponse) Reset()         { *m = MsgCancelQueuedMsgResponse{} }
+func (m *MsgCancelQueuedMsgResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelQueuedMsgResponse) ProtoMessage()    {}
+func (*MsgCancelQueuedMsgResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5fc8fed8f4e58b6, []int{9}
+}
+func (m *MsgCancelQueuedMsgResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelQueuedMsgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelQueuedMsgResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelQueuedMsgResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelQueuedMsgResponse.Merge(m, src)
+}
+func (m *MsgCancelQueuedMsgResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelQueuedMsgResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelQueuedMsgResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelQueuedMsgResponse proto.InternalMessageInfo
+
 // MsgUpdateParams defines a message for updating epoching module parameters.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -357,7 +461,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5fc8fed8f4e58b6, []int{8}
+	return fileDescriptor_a5fc8fed8f4e58b6, []int{10}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -408,7 +512,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5fc8fed8f4e58b6, []int{9}
+	return fileDescriptor_a5fc8fed8f4e58b6, []int{11}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,6 +550,8 @@ func init() {
 	proto.RegisterType((*MsgWrappedBeginRedelegateResponse)(nil), "babylon.epoching.v1.MsgWrappedBeginRedelegateResponse")
 	proto.RegisterType((*MsgWrappedCancelUnbondingDelegation)(nil), "babylon.epoching.v1.MsgWrappedCancelUnbondingDelegation")
 	proto.RegisterType((*MsgWrappedCancelUnbondingDelegationResponse)(nil), "babylon.epoching.v1.MsgWrappedCancelUnbondingDelegationResponse")
+	proto.RegisterType((*MsgCancelQueuedMsg)(nil), "babylon.epoching.v1.MsgCancelQueuedMsg")
+	proto.RegisterType((*MsgCancelQueuedMsgResponse)(nil), "babylon.epoching.v1.MsgCancelQueuedMsgResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.epoching.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.epoching.v1.MsgUpdateParamsResponse")
 }
@@ -453,44 +559,49 @@ func init() {
 func init() { proto.RegisterFile("babylon/epoching/v1/tx.proto", fileDescriptor_a5fc8fed8f4e58b6) }
 
 var fileDescriptor_a5fc8fed8f4e58b6 = []byte{
-	// 585 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x6f, 0x12, 0x41,
-	0x18, 0xc6, 0x77, 0x6d, 0x6d, 0xd2, 0x57, 0x63, 0x75, 0x25, 0x16, 0x56, 0xb2, 0x20, 0x68, 0x54,
-	0xb4, 0xbb, 0x52, 0xb5, 0x6a, 0xe3, 0x41, 0xd1, 0x78, 0x30, 0x21, 0x31, 0x98, 0xc6, 0xc4, 0xc4,
-	0x98, 0x59, 0x76, 0x32, 0x6c, 0x60, 0x67, 0xd6, 0x9d, 0x69, 0x53, 0x4e, 0x36, 0x9e, 0x3c, 0x7a,
-	0xf0, 0x6c, 0xfa, 0x11, 0x7a, 0xf0, 0x43, 0xf4, 0xd8, 0x78, 0xf2, 0x64, 0x0c, 0x1c, 0xea, 0x07,
-	0xf0, 0x03, 0x18, 0xf6, 0x2f, 0x02, 0x0b, 0xe8, 0x8d, 0xe1, 0x7d, 0xde, 0xe7, 0xf9, 0xc1, 0x3e,
-	0x3b, 0x90, 0x37, 0x91, 0xd9, 0xed, 0x30, 0x6a, 0x60, 0x97, 0x35, 0x5b, 0x36, 0x25, 0xc6, 0x4e,
-	0xd5, 0x10, 0xbb, 0xba, 0xeb, 0x31, 0xc1, 0x94, 0xf3, 0xe1, 0x54, 0x8f, 0xa6, 0xfa, 0x4e, 0x55,
-	0xcd, 0x10, 0x46, 0x98, 0x3f, 0x37, 0x06, 0x9f, 0x02, 0xa9, 0x5a, 0x68, 0x32, 0xee, 0x30, 0x6e,
-	0x70, 0x81, 0xda, 0x81, 0x8d, 0x89, 0x05, 0x4a, 0xbc, 0xd4, 0xe2, 0xa4, 0x24, 0x17, 0x79, 0xc8,
-	0xe1, 0xa1, 0x22, 0x17, 0x58, 0xbc, 0x0d, 0xbc, 0x83, 0x43, 0x38, 0x5a, 0x0d, 0xdd, 0x1d, 0xee,
-	0xaf, 0x39, 0x9c, 0x04, 0x83, 0xd2, 0x1b, 0x50, 0xea, 0x9c, 0xbc, 0xf2, 0x90, 0xeb, 0x62, 0xeb,
-	0x29, 0xee, 0x60, 0x82, 0x04, 0x56, 0xee, 0xc2, 0x82, 0xc3, 0x49, 0x56, 0x2e, 0xca, 0xd7, 0x4e,
-	0xad, 0x97, 0xf5, 0xd0, 0x2a, 0x44, 0xd3, 0x43, 0x34, 0xbd, 0xce, 0x49, 0xb4, 0xd1, 0x18, 0xe8,
-	0x37, 0xcf, 0x7e, 0xdc, 0x2f, 0x48, 0xbf, 0xf6, 0x0b, 0xd2, 0x87, 0xe3, 0x83, 0xca, 0xe0, 0x9b,
-	0x52, 0x1e, 0xd4, 0x71, 0xfb, 0x06, 0xe6, 0x2e, 0xa3, 0x1c, 0x97, 0x10, 0x64, 0x92, 0xe9, 0x16,
-	0xb5, 0xa2, 0xf8, 0x7b, 0xc3, 0xf1, 0x57, 0xa6, 0xc4, 0x27, 0x3b, 0x69, 0x00, 0x1a, 0xe4, 0x27,
-	0x45, 0xc4, 0x08, 0x6d, 0xc8, 0x25, 0xf3, 0x1a, 0x26, 0x36, 0x6d, 0xe0, 0x98, 0xe3, 0xe1, 0x30,
-	0x47, 0x65, 0x0a, 0xc7, 0xc8, 0x62, 0x1a, 0x4c, 0x19, 0x2e, 0xa5, 0x86, 0xc5, 0x44, 0xef, 0xa1,
-	0x9c, 0x88, 0x9e, 0x20, 0xda, 0xc4, 0x9d, 0x2d, 0x6a, 0x32, 0x6a, 0xd9, 0x34, 0xfa, 0xbb, 0x6d,
-	0x46, 0x95, 0x67, 0xc3, 0x6c, 0x77, 0xa6, 0xb0, 0xa5, 0x5a, 0xa4, 0x51, 0xae, 0xc1, 0x8d, 0x39,
-	0x00, 0x62, 0xde, 0xcf, 0x32, 0xac, 0x0c, 0x1e, 0x85, 0x6b, 0x21, 0x81, 0x5f, 0xf8, 0x7d, 0x54,
-	0x36, 0x60, 0x19, 0x6d, 0x8b, 0x16, 0xf3, 0x6c, 0xd1, 0xf5, 0x11, 0x97, 0x6b, 0xd9, 0x6f, 0x5f,
-	0xd7, 0x32, 0x21, 0xe5, 0x63, 0xcb, 0xf2, 0x30, 0xe7, 0x2f, 0x85, 0x67, 0x53, 0xd2, 0x48, 0xa4,
-	0xca, 0x03, 0x58, 0x0a, 0x1a, 0x9d, 0x3d, 0xe1, 0xff, 0xae, 0x8b, 0xfa, 0x84, 0x17, 0x48, 0x0f,
-	0x42, 0x6a, 0x8b, 0x87, 0x3f, 0x0a, 0x52, 0x23, 0x5c, 0xd8, 0x3c, 0x33, 0xe0, 0x4f, 0xac, 0x4a,
-	0x39, 0x58, 0x1d, 0xa1, 0x8a, 0x88, 0xd7, 0x7f, 0x2f, 0xc2, 0x42, 0x9d, 0x13, 0xa5, 0x0d, 0x2b,
-	0xa3, 0xc5, 0xbf, 0x3a, 0x31, 0x70, 0xbc, 0xc2, 0xaa, 0x31, 0xa7, 0x30, 0x0a, 0x55, 0xde, 0xc1,
-	0xb9, 0xf1, 0xa2, 0x5f, 0x9f, 0xe1, 0x92, 0x48, 0xd5, 0xea, 0xdc, 0xd2, 0x38, 0x72, 0x4f, 0x86,
-	0x0b, 0x29, 0xcd, 0xd6, 0x67, 0xb8, 0x8d, 0xe8, 0xd5, 0x8d, 0x7f, 0xd3, 0xc7, 0x08, 0x5f, 0x64,
-	0x28, 0xce, 0xac, 0xf2, 0xfd, 0x19, 0xe6, 0xa9, 0x9b, 0xea, 0xa3, 0xff, 0xdd, 0x8c, 0x01, 0x4d,
-	0x38, 0xfd, 0x57, 0x73, 0x2f, 0xa7, 0x39, 0x0e, 0xab, 0xd4, 0x9b, 0xf3, 0xa8, 0xa2, 0x0c, 0xf5,
-	0xe4, 0xde, 0xf1, 0x41, 0x45, 0xae, 0x3d, 0x3f, 0xec, 0x69, 0xf2, 0x51, 0x4f, 0x93, 0x7f, 0xf6,
-	0x34, 0xf9, 0x53, 0x5f, 0x93, 0x8e, 0xfa, 0x9a, 0xf4, 0xbd, 0xaf, 0x49, 0xaf, 0x6f, 0x11, 0x5b,
-	0xb4, 0xb6, 0x4d, 0xbd, 0xc9, 0x1c, 0x23, 0x34, 0x6e, 0xb6, 0x90, 0x4d, 0xa3, 0x83, 0xb1, 0x9b,
-	0x5c, 0xfa, 0xa2, 0xeb, 0x62, 0x6e, 0x2e, 0xf9, 0xb7, 0xf7, 0xed, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xdf, 0xdb, 0xcd, 0x7f, 0x7f, 0x06, 0x00, 0x00,
+	// 662 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x41, 0x4f, 0xd4, 0x4e,
+	0x18, 0xc6, 0xb7, 0x7f, 0xd8, 0x4d, 0x78, 0x21, 0x7f, 0xb4, 0xa0, 0x40, 0x25, 0xdd, 0x75, 0xd1,
+	0x88, 0x28, 0x2d, 0xa0, 0xa2, 0x12, 0x0f, 0xba, 0x1a, 0x13, 0x4c, 0x48, 0xb4, 0x86, 0x98, 0x98,
+	0x18, 0xd2, 0x6e, 0x27, 0xb3, 0xcd, 0xd2, 0x99, 0xda, 0x99, 0x25, 0xcb, 0x49, 0xe2, 0xc9, 0xa3,
+	0x07, 0xcf, 0x86, 0x8f, 0xc0, 0xc1, 0x0f, 0xc1, 0x91, 0x78, 0xf2, 0x64, 0x14, 0x0e, 0xf8, 0x31,
+	0x4c, 0xdb, 0x69, 0xbb, 0x96, 0xed, 0xee, 0xea, 0xad, 0x9d, 0xf7, 0x79, 0x9f, 0xe7, 0x97, 0x66,
+	0x9e, 0x14, 0x66, 0x2d, 0xd3, 0xda, 0xdd, 0xa6, 0x44, 0x47, 0x1e, 0xad, 0x37, 0x1c, 0x82, 0xf5,
+	0x9d, 0x65, 0x9d, 0xb7, 0x35, 0xcf, 0xa7, 0x9c, 0xca, 0x13, 0x62, 0xaa, 0xc5, 0x53, 0x6d, 0x67,
+	0x59, 0x99, 0xc4, 0x14, 0xd3, 0x70, 0xae, 0x07, 0x4f, 0x91, 0x54, 0x29, 0xd7, 0x29, 0x73, 0x29,
+	0xd3, 0x19, 0x37, 0x9b, 0x91, 0x8d, 0x85, 0xb8, 0x99, 0x7a, 0x29, 0x95, 0x6e, 0x49, 0x9e, 0xe9,
+	0x9b, 0x2e, 0x13, 0x8a, 0x99, 0xc8, 0x62, 0x2b, 0xf2, 0x8e, 0x5e, 0xc4, 0x68, 0x4a, 0xb8, 0xbb,
+	0x2c, 0x5c, 0x73, 0x19, 0x8e, 0x06, 0xd5, 0x37, 0x20, 0x6f, 0x30, 0xfc, 0xca, 0x37, 0x3d, 0x0f,
+	0xd9, 0x4f, 0xd0, 0x36, 0xc2, 0x26, 0x47, 0xf2, 0x1d, 0x18, 0x72, 0x19, 0x9e, 0x96, 0x2a, 0xd2,
+	0xfc, 0xe8, 0xca, 0x9c, 0x26, 0xac, 0x04, 0x9a, 0x26, 0xd0, 0xb4, 0x0d, 0x86, 0xe3, 0x0d, 0x23,
+	0xd0, 0xaf, 0x9d, 0xfb, 0xb0, 0x5f, 0x2e, 0xfc, 0xda, 0x2f, 0x17, 0xde, 0x9f, 0x1e, 0x2c, 0x04,
+	0x27, 0xd5, 0x59, 0x50, 0xce, 0xda, 0x1b, 0x88, 0x79, 0x94, 0x30, 0x54, 0x35, 0x61, 0x32, 0x9d,
+	0x6e, 0x12, 0x3b, 0x8e, 0xbf, 0xdb, 0x19, 0x7f, 0xb5, 0x47, 0x7c, 0xba, 0x93, 0x07, 0xa0, 0xc2,
+	0x6c, 0xb7, 0x88, 0x04, 0xa1, 0x09, 0x33, 0xe9, 0xbc, 0x86, 0xb0, 0x43, 0x0c, 0x94, 0x70, 0x3c,
+	0xe8, 0xe4, 0x58, 0xe8, 0xc1, 0x91, 0x59, 0xcc, 0x83, 0x99, 0x83, 0xcb, 0xb9, 0x61, 0x09, 0xd1,
+	0x3b, 0x98, 0x4b, 0x45, 0x8f, 0x4d, 0x52, 0x47, 0xdb, 0x9b, 0xc4, 0xa2, 0xc4, 0x76, 0x48, 0xfc,
+	0xb9, 0x1d, 0x4a, 0xe4, 0xa7, 0x9d, 0x6c, 0xb7, 0x7b, 0xb0, 0xe5, 0x5a, 0xe4, 0x51, 0x2e, 0xc2,
+	0x8d, 0x01, 0x00, 0x3a, 0x78, 0xe5, 0x24, 0xe5, 0x45, 0x0b, 0xb5, 0x90, 0xbd, 0xc1, 0xb0, 0xbc,
+	0x04, 0x25, 0xe6, 0x60, 0x82, 0xfc, 0x90, 0x70, 0xa4, 0x36, 0xfd, 0xf5, 0xcb, 0xe2, 0xa4, 0x80,
+	0x7c, 0x64, 0xdb, 0x3e, 0x62, 0xec, 0x25, 0xf7, 0x1d, 0x82, 0x0d, 0xa1, 0x93, 0x27, 0xa0, 0xc8,
+	0xdb, 0x5b, 0x8e, 0x3d, 0xfd, 0x5f, 0x45, 0x9a, 0x1f, 0x33, 0x86, 0x79, 0x7b, 0xdd, 0x96, 0x2f,
+	0x40, 0xc9, 0x65, 0x38, 0x38, 0x1d, 0x0a, 0x4f, 0x8b, 0x2e, 0xc3, 0xeb, 0xf6, 0xda, 0x68, 0x00,
+	0x2b, 0x16, 0xc5, 0x1d, 0xcb, 0x00, 0x24, 0x78, 0x9f, 0x24, 0x18, 0x0f, 0x6e, 0x8a, 0x67, 0x9b,
+	0x1c, 0x3d, 0x0f, 0xeb, 0x22, 0xaf, 0xc2, 0x88, 0xd9, 0xe2, 0x0d, 0xea, 0x3b, 0x7c, 0xb7, 0x2f,
+	0x5f, 0x2a, 0x95, 0xef, 0x43, 0x29, 0x2a, 0x5c, 0xc8, 0x38, 0xba, 0x72, 0x49, 0xeb, 0xd2, 0x6f,
+	0x2d, 0x0a, 0xa9, 0x0d, 0x1f, 0x7e, 0x2f, 0x17, 0x0c, 0xb1, 0xb0, 0xf6, 0x7f, 0x40, 0x9c, 0x5a,
+	0x55, 0x67, 0x60, 0x2a, 0x43, 0x15, 0x13, 0xaf, 0xfc, 0x2c, 0xc2, 0x50, 0xf0, 0x09, 0x9b, 0x30,
+	0x9e, 0xed, 0xe5, 0xb5, 0xae, 0x81, 0x67, 0x1b, 0xa6, 0xe8, 0x03, 0x0a, 0xe3, 0x50, 0xf9, 0x2d,
+	0x9c, 0x3f, 0xdb, 0xc3, 0xeb, 0x7d, 0x5c, 0x52, 0xa9, 0xb2, 0x3c, 0xb0, 0x34, 0x89, 0xdc, 0x93,
+	0xe0, 0x62, 0x4e, 0xf1, 0xb4, 0x3e, 0x6e, 0x19, 0xbd, 0xb2, 0xfa, 0x77, 0xfa, 0x04, 0xe1, 0xb3,
+	0x04, 0x95, 0xbe, 0x4d, 0xbb, 0xd7, 0xc7, 0x3c, 0x77, 0x53, 0x79, 0xf8, 0xaf, 0x9b, 0x09, 0x60,
+	0x13, 0xc6, 0xb3, 0xcd, 0xca, 0xbd, 0x03, 0x19, 0xa1, 0xa2, 0x0f, 0x28, 0x4c, 0xc2, 0x2c, 0x18,
+	0xfb, 0xa3, 0x26, 0x57, 0xf2, 0x0c, 0x3a, 0x55, 0xca, 0xcd, 0x41, 0x54, 0x71, 0x86, 0x52, 0xdc,
+	0x3b, 0x3d, 0x58, 0x90, 0x6a, 0xcf, 0x0e, 0x8f, 0x55, 0xe9, 0xe8, 0x58, 0x95, 0x7e, 0x1c, 0xab,
+	0xd2, 0xc7, 0x13, 0xb5, 0x70, 0x74, 0xa2, 0x16, 0xbe, 0x9d, 0xa8, 0x85, 0xd7, 0x4b, 0xd8, 0xe1,
+	0x8d, 0x96, 0xa5, 0xd5, 0xa9, 0xab, 0x0b, 0xe3, 0x7a, 0xc3, 0x74, 0x48, 0xfc, 0xa2, 0xb7, 0xd3,
+	0x1f, 0x20, 0xdf, 0xf5, 0x10, 0xb3, 0x4a, 0xe1, 0x9f, 0xec, 0xd6, 0xef, 0x01, 0x00, 0x48, 0xd2,
+	0x16, 0x96, 0x8b, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -517,6 +628,9 @@ type MsgClient interface {
 	// WrappedCancelUnbondingDelegation defines a method for cancelling unbonding of
 	// coins from a delegator and source validator to a destination validator.
 	WrappedCancelUnbondingDelegation(ctx context.Context, in *MsgWrappedCancelUnbondingDelegation, opts ...grpc.CallOption) (*MsgWrappedCancelUnbondingDelegationResponse, error)
+	// CancelQueuedMsg defines a method for cancelling a message queued in the
+	// current epoch before it is executed at the end of the epoch.
+	CancelQueuedMsg(ctx context.Context, in *MsgCancelQueuedMsg, opts ...grpc.CallOption) (*MsgCancelQueuedMsgResponse, error)
 	// UpdateParams defines a method for updating epoching module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -565,6 +679,15 @@ func (c *msgClient) WrappedCancelUnbondingDelegation(ctx context.Context, in *Ms
 	return out, nil
 }
 
+func (c *msgClient) CancelQueuedMsg(ctx context.Context, in *MsgCancelQueuedMsg, opts ...grpc.CallOption) (*MsgCancelQueuedMsgResponse, error) {
+	out := new(MsgCancelQueuedMsgResponse)
+	err := c.cc.Invoke(ctx, "/babylon.epoching.v1.Msg/CancelQueuedMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/babylon.epoching.v1.Msg/UpdateParams", in, out, opts...)
@@ -588,6 +711,9 @@ type MsgServer interface {
 	// WrappedCancelUnbondingDelegation defines a method for cancelling unbonding of
 	// coins from a delegator and source validator to a destination validator.
 	WrappedCancelUnbondingDelegation(context.Context, *MsgWrappedCancelUnbondingDelegation) (*MsgWrappedCancelUnbondingDelegationResponse, error)
+	// CancelQueuedMsg defines a method for cancelling a message queued in the
+	// current epoch before it is executed at the end of the epoch.
+	CancelQueuedMsg(context.Context, *MsgCancelQueuedMsg) (*MsgCancelQueuedMsgResponse, error)
 	// UpdateParams defines a method for updating epoching module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
@@ -608,6 +734,9 @@ func (*UnimplementedMsgServer) WrappedBeginRedelegate(ctx context.Context, req *
 func (*UnimplementedMsgServer) WrappedCancelUnbondingDelegation(ctx context.Context, req *MsgWrappedCancelUnbondingDelegation) (*MsgWrappedCancelUnbondingDelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WrappedCancelUnbondingDelegation not implemented")
 }
+func (*UnimplementedMsgServer) CancelQueuedMsg(ctx context.Context, req *MsgCancelQueuedMsg) (*MsgCancelQueuedMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelQueuedMsg not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -688,6 +817,24 @@ func _Msg_WrappedCancelUnbondingDelegation_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelQueuedMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelQueuedMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelQueuedMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.epoching.v1.Msg/CancelQueuedMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelQueuedMsg(ctx, req.(*MsgCancelQueuedMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -726,6 +873,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WrappedCancelUnbondingDelegation",
 			Handler:    _Msg_WrappedCancelUnbondingDelegation_Handler,
 		},
+		{
+			MethodName: "CancelQueuedMsg",
+			Handler:    _Msg_CancelQueuedMsg_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -967,6 +1118,73 @@ func (m *MsgWrappedCancelUnbondingDelegationResponse) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelQueuedMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelQueuedMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelQueuedMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgId) > 0 {
+		i -= len(m.MsgId)
+		copy(dAtA[i:], m.MsgId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelQueuedMsgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelQueuedMsgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelQueuedMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1129,6 +1347,36 @@ func (m *MsgWrappedCancelUnbondingDelegationResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelQueuedMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelQueuedMsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1703,6 +1951,206 @@ func (m *MsgWrappedCancelUnbondingDelegationResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
+func (m *MsgCancelQueuedMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelQueuedMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelQueuedMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgId = append(m.MsgId[:0], dAtA[iNdEx:postIndex]...)
+			if m.MsgId == nil {
+				m.MsgId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelQueuedMsgResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelQueuedMsgResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelQueuedMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -32,6 +32,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListPublicRandomness())
 	cmd.AddCommand(CmdListPubRandCommit())
 	cmd.AddCommand(CmdBlock())
+	cmd.AddCommand(CmdFinalityProof())
 	cmd.AddCommand(CmdListBlocks())
 	cmd.AddCommand(CmdVotesAtHeight())
 	cmd.AddCommand(CmdListEvidences())
@@ -207,6 +208,37 @@ func CmdBlock() *cobra.Command {
 	return cmd
 }
 
+func CmdFinalityProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-proof [height]",
+		Short: "show the proof that the block at a given height is BTC-finalized",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			queriedBlockHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FinalityProof(cmd.Context(), &types.QueryFinalityProofRequest{
+				Height: queriedBlockHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdListEvidences() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-evidences",
@@ -27,7 +27,10 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		NewCommitPubRandListCmd(),
+		NewRevokePubRandCommitCmd(),
 		NewAddFinalitySigCmd(),
+		NewAddFinalitySigsCmd(),
+		NewUnjailFinalityProviderCmd(),
 	)
 
 	return cmd
@@ -93,6 +96,56 @@ func NewCommitPubRandListCmd() *cobra.Command {
 	return cmd
 }
 
+func NewRevokePubRandCommitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-pubrand-commit [fp_btc_pk] [revoked_from_height] [sig]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Revoke the committed public randomness from a given height",
+		Long: strings.TrimSpace(
+			`Revoke the committed public randomness from a given height, which has to be higher than the current height.
+The signature is over (revoked_from_height || commitment), where commitment is the value of the public
+randomness commitment that includes revoked_from_height.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get finality provider BTC PK
+			fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			// get revoked height
+			revokedFromHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// get signature
+			sig, err := bbn.NewBIP340SignatureFromHex(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRevokePubRandCommit{
+				Signer:            clientCtx.FromAddress.String(),
+				FpBtcPk:           fpBTCPK,
+				RevokedFromHeight: revokedFromHeight,
+				Sig:               sig,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewAddFinalitySigCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add-finality-sig [fp_btc_pk] [block_height] [pub_rand] [proof] [block_app_hash] [finality_sig]",
@@ -165,3 +218,124 @@ func NewAddFinalitySigCmd() *cobra.Command {
 
 	return cmd
 }
+
+func NewAddFinalitySigsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-finality-sigs [fp_btc_pk] [start_height] [pub_rand_list] [proof] [block_app_hash_list] [finality_sig_list]",
+		Args:  cobra.ExactArgs(6),
+		Short: "Add finality signatures to a contiguous range of blocks",
+		Long: strings.TrimSpace(
+			`Add finality signatures to a contiguous range of blocks starting from start_height.
+The public randomness, block app hashes and finality signatures are comma-separated lists of hex strings,
+and the proof is the hex of the range proof of the public randomness list.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get finality provider BTC PK
+			fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			// get start height
+			startHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// get public randomness list
+			pubRandList := []bbn.SchnorrPubRand{}
+			for _, pubRandHex := range strings.Split(args[2], ",") {
+				pubRand, err := bbn.NewSchnorrPubRandFromHex(pubRandHex)
+				if err != nil {
+					return err
+				}
+				pubRandList = append(pubRandList, *pubRand)
+			}
+
+			// get range proof
+			proofBytes, err := hex.DecodeString(args[3])
+			if err != nil {
+				return err
+			}
+			var proof types.RangeProof
+			if err := proof.Unmarshal(proofBytes); err != nil {
+				return err
+			}
+
+			// get block app hash list
+			appHashList := [][]byte{}
+			for _, appHashHex := range strings.Split(args[4], ",") {
+				appHash, err := hex.DecodeString(appHashHex)
+				if err != nil {
+					return err
+				}
+				appHashList = append(appHashList, appHash)
+			}
+
+			// get finality signature list
+			finalitySigList := []bbn.SchnorrEOTSSig{}
+			for _, finalitySigHex := range strings.Split(args[5], ",") {
+				finalitySig, err := bbn.NewSchnorrEOTSSigFromHex(finalitySigHex)
+				if err != nil {
+					return err
+				}
+				finalitySigList = append(finalitySigList, *finalitySig)
+			}
+
+			msg := types.MsgAddFinalitySigs{
+				Signer:           clientCtx.FromAddress.String(),
+				FpBtcPk:          fpBTCPK,
+				StartHeight:      startHeight,
+				PubRandList:      pubRandList,
+				Proof:            &proof,
+				BlockAppHashList: appHashList,
+				FinalitySigList:  finalitySigList,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewUnjailFinalityProviderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail-finality-provider [fp_btc_pk]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Unjail a jailed finality provider",
+		Long: strings.TrimSpace(
+			`Unjail a jailed finality provider whose jailing period has passed.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get finality provider BTC PK
+			fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUnjailFinalityProvider{
+				Signer:  clientCtx.FromAddress.String(),
+				FpBtcPk: fpBTCPK,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+// SlashConsumerFinalityProvider slashes a finality provider of the consumer
+// chain with the given chain ID, upon the evidence that the finality provider
+// has signed two conflicting blocks at the same height of the consumer chain.
+// The evidence is verified against the public randomness that the finality
+// provider has committed for this height, and the finality provider's BTC SK
+// is extracted from the two finality signatures.
+func (k Keeper) SlashConsumerFinalityProvider(ctx context.Context, consumerID string, evidence *types.Evidence, pubRandProof *cmtcrypto.Proof) error {
+	if evidence == nil || evidence.FpBtcPk == nil {
+		return types.ErrInvalidConsumerEvidence.Wrap("empty evidence")
+	}
+	fpBTCPK := evidence.FpBtcPk
+
+	// ensure the finality provider exists, belongs to the consumer chain and
+	// is not slashed yet
+	fp, err := k.BTCStakingKeeper.GetFinalityProvider(ctx, fpBTCPK.MustMarshal())
+	if err != nil {
+		return err
+	}
+	if fp.ConsumerId != consumerID {
+		return types.ErrInvalidConsumerEvidence.Wrapf("the finality provider %s does not belong to the consumer chain %s", fpBTCPK.MarshalHex(), consumerID)
+	}
+	if fp.IsSlashed() {
+		return bstypes.ErrFpAlreadySlashed
+	}
+
+	// verify the evidence w.r.t. the public randomness commitment for the
+	// height of the conflicting blocks
+	prCommit, err := k.GetPubRandCommitForHeight(ctx, fpBTCPK, evidence.BlockHeight)
+	if err != nil {
+		return err
+	}
+	if err := types.VerifyConsumerEvidence(evidence, pubRandProof, prCommit); err != nil {
+		return err
+	}
+
+	// extract the BTC SK of the finality provider and ensure it corresponds
+	// to the finality provider's BTC PK
+	btcSK, err := evidence.ExtractBTCSK()
+	if err != nil {
+		return types.ErrInvalidConsumerEvidence.Wrapf("failed to extract BTC SK: %v", err)
+	}
+	if !bytes.Equal(bbn.NewBIP340PubKeyFromBTCPK(btcSK.PubKey()).MustMarshal(), fpBTCPK.MustMarshal()) {
+		return types.ErrInvalidConsumerEvidence.Wrap("the extracted BTC SK does not correspond to the finality provider's BTC PK")
+	}
+
+	// slash this finality provider, including setting its voting power to
+	// zero and emitting an event with the evidence
+	k.slashFinalityProvider(ctx, fpBTCPK, evidence)
+	return nil
+}
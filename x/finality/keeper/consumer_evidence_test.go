@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/finality/keeper"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+func FuzzSlashConsumerFinalityProvider(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		// create and register a random finality provider of a consumer chain
+		consumerID := datagen.GenRandomHexStr(r, 10)
+		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, btcSK)
+		require.NoError(t, err)
+		fp.ConsumerId = consumerID
+		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+		fpBTCPKBytes := fpBTCPK.MustMarshal()
+		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).AnyTimes()
+
+		// commit some public randomness
+		startHeight := uint64(0)
+		numPubRand := uint64(200)
+		randListInfo, msgCommitPubRandList, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msgCommitPubRandList)
+		require.NoError(t, err)
+
+		// the finality provider signs two conflicting blocks of the consumer
+		// chain at the same height
+		blockHeight := startHeight + datagen.RandomInt(r, 10) + 1
+		signer := datagen.GenRandomAccount().Address
+		canonicalMsg, err := datagen.NewMsgAddFinalitySig(signer, btcSK, startHeight, blockHeight, randListInfo, datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+		forkMsg, err := datagen.NewMsgAddFinalitySig(signer, btcSK, startHeight, blockHeight, randListInfo, datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+		evidence := &types.Evidence{
+			FpBtcPk:              fpBTCPK,
+			BlockHeight:          blockHeight,
+			PubRand:              canonicalMsg.PubRand,
+			CanonicalAppHash:     canonicalMsg.BlockAppHash,
+			ForkAppHash:          forkMsg.BlockAppHash,
+			CanonicalFinalitySig: canonicalMsg.FinalitySig,
+			ForkFinalitySig:      forkMsg.FinalitySig,
+		}
+
+		// Case 1: fail if the finality provider does not belong to the
+		// consumer chain
+		err = fKeeper.SlashConsumerFinalityProvider(ctx, datagen.GenRandomHexStr(r, 10), evidence, canonicalMsg.Proof)
+		require.ErrorIs(t, err, types.ErrInvalidConsumerEvidence)
+
+		// Case 2: fail if the public randomness is not committed
+		otherRandListInfo, err := datagen.GenRandomPubRandList(r, numPubRand)
+		require.NoError(t, err)
+		err = fKeeper.SlashConsumerFinalityProvider(ctx, consumerID, evidence, otherRandListInfo.ProofList[blockHeight-startHeight].ToProto())
+		require.ErrorIs(t, err, types.ErrInvalidConsumerEvidence)
+
+		// Case 3: fail if a finality signature is invalid
+		invalidEvidence := *evidence
+		invalidEvidence.ForkAppHash = datagen.GenRandomByteArray(r, 32)
+		err = fKeeper.SlashConsumerFinalityProvider(ctx, consumerID, &invalidEvidence, canonicalMsg.Proof)
+		require.ErrorIs(t, err, types.ErrInvalidConsumerEvidence)
+
+		// Case 4: fail if both finality signatures are to the same block
+		invalidEvidence = *evidence
+		invalidEvidence.ForkAppHash = evidence.CanonicalAppHash
+		invalidEvidence.ForkFinalitySig = evidence.CanonicalFinalitySig
+		err = fKeeper.SlashConsumerFinalityProvider(ctx, consumerID, &invalidEvidence, canonicalMsg.Proof)
+		require.ErrorIs(t, err, types.ErrInvalidConsumerEvidence)
+
+		// Case 5: the finality provider is slashed upon a valid evidence
+		bsKeeper.EXPECT().SlashFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(nil).Times(1)
+		err = fKeeper.SlashConsumerFinalityProvider(ctx, consumerID, evidence, canonicalMsg.Proof)
+		require.NoError(t, err)
+
+		// Case 6: a slashed finality provider cannot be slashed again
+		fp.SlashedBabylonHeight = 1
+		err = fKeeper.SlashConsumerFinalityProvider(ctx, consumerID, evidence, canonicalMsg.Proof)
+		require.ErrorIs(t, err, bstypes.ErrFpAlreadySlashed)
+	})
+}
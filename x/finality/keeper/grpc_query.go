@@ -100,6 +100,19 @@ func (k Keeper) Block(ctx context.Context, req *types.QueryBlockRequest) (*types
 	return &types.QueryBlockResponse{Block: b}, nil
 }
 
+// FinalityProof returns a proof that the block at the given height is BTC-finalized
+func (k Keeper) FinalityProof(ctx context.Context, req *types.QueryFinalityProofRequest) (*types.QueryFinalityProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	proof, err := k.ProveFinality(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFinalityProofResponse{Proof: proof}, nil
+}
+
 // ListBlocks returns a list of blocks at the given finalisation status
 func (k Keeper) ListBlocks(ctx context.Context, req *types.QueryListBlocksRequest) (*types.QueryListBlocksResponse, error) {
 	if req == nil {
@@ -39,3 +39,40 @@ func (h Hooks) AfterFinalityProviderActivated(ctx context.Context, fpPk *bbntype
 
 	return h.k.FinalityProviderSigningTracker.Set(ctx, fpPk.MustMarshal(), signingInfo)
 }
+
+// AfterFinalityProviderKeyRotated re-keys the signing info and the missed block
+// bitmap of the finality provider from the old BTC PK to the new BTC PK
+func (h Hooks) AfterFinalityProviderKeyRotated(ctx context.Context, oldFpPk *bbntypes.BIP340PubKey, newFpPk *bbntypes.BIP340PubKey) error {
+	signingInfo, err := h.k.FinalityProviderSigningTracker.Get(ctx, oldFpPk.MustMarshal())
+	if errors.Is(err, collections.ErrNotFound) {
+		// the finality provider has never been activated
+		return nil
+	} else if err != nil {
+		return err
+	}
+	signingInfo.FpBtcPk = newFpPk
+	if err := h.k.FinalityProviderSigningTracker.Set(ctx, newFpPk.MustMarshal(), signingInfo); err != nil {
+		return err
+	}
+	if err := h.k.FinalityProviderSigningTracker.Remove(ctx, oldFpPk.MustMarshal()); err != nil {
+		return err
+	}
+
+	// move the missed block bitmap chunks
+	rng := collections.NewPrefixedPairRange[[]byte, uint64](oldFpPk.MustMarshal())
+	chunkIndices, chunks := []uint64{}, [][]byte{}
+	err = h.k.FinalityProviderMissedBlockBitmap.Walk(ctx, rng, func(key collections.Pair[[]byte, uint64], value []byte) (bool, error) {
+		chunkIndices = append(chunkIndices, key.K2())
+		chunks = append(chunks, value)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for i := range chunks {
+		if err := h.k.SetMissedBlockBitmapChunk(ctx, newFpPk, int64(chunkIndices[i]), chunks[i]); err != nil {
+			return err
+		}
+	}
+	return h.k.DeleteMissedBlockBitmap(ctx, oldFpPk)
+}
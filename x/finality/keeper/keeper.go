@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/collections"
 	corestoretypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -20,6 +21,7 @@ type (
 
 		BTCStakingKeeper types.BTCStakingKeeper
 		IncentiveKeeper  types.IncentiveKeeper
+		storeQuerier     storetypes.Queryable
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
 		authority string
@@ -38,6 +40,7 @@ func NewKeeper(
 	storeService corestoretypes.KVStoreService,
 	btcstakingKeeper types.BTCStakingKeeper,
 	incentiveKeeper types.IncentiveKeeper,
+	storeQuerier storetypes.Queryable,
 	authority string,
 ) Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
@@ -47,6 +50,7 @@ func NewKeeper(
 
 		BTCStakingKeeper: btcstakingKeeper,
 		IncentiveKeeper:  incentiveKeeper,
+		storeQuerier:     storeQuerier,
 		authority:        authority,
 		FinalityProviderSigningTracker: collections.NewMap(
 			sb,
@@ -48,9 +48,14 @@ func (k Keeper) HandleFinalityProviderLiveness(ctx context.Context, fpPk *types.
 	}
 
 	// don't update missed blocks when finality provider is already detected slashed
-	if fp.IsSlashed() {
+	// or jailed. A jailed finality provider might still be in the voting power table
+	// of the heights before its jailing takes effect
+	if fp.IsSlashed() || fp.IsJailed() {
 		return nil
 	}
+	// the finality provider might have rotated its BTC PK since the given
+	// height, in which case its signing info is recorded under the new BTC PK
+	fpPk = fp.BtcPk
 
 	updated, signInfo, err := k.updateSigningInfo(ctx, fpPk, missed, height)
 	if err != nil {
@@ -100,6 +105,12 @@ func (k Keeper) HandleFinalityProviderLiveness(ctx context.Context, fpPk *types.
 		}
 
 		finalitytypes.IncrementSluggishFinalityProviderCounter()
+
+		// jail the sluggish finality provider, such that it loses its voting
+		// power until it is unjailed via `MsgUnjailFinalityProvider`
+		if err := k.jailSluggishFinalityProvider(ctx, fpPk, signInfo); err != nil {
+			return err
+		}
 	} else if fp.IsSluggish() {
 		updated = true
 
@@ -132,6 +143,41 @@ func (k Keeper) HandleFinalityProviderLiveness(ctx context.Context, fpPk *types.
 	return nil
 }
 
+// jailSluggishFinalityProvider jails the given sluggish finality provider for
+// the jail duration, and resets its missed blocks counter and bitmap so that
+// it starts with a clean record once unjailed
+func (k Keeper) jailSluggishFinalityProvider(
+	ctx context.Context,
+	fpPk *types.BIP340PubKey,
+	signInfo *finalitytypes.FinalityProviderSigningInfo,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	jailedUntil := sdkCtx.HeaderInfo().Time.Add(k.GetParams(ctx).JailDuration)
+	if err := k.BTCStakingKeeper.JailFinalityProvider(ctx, fpPk.MustMarshal(), jailedUntil); err != nil {
+		return fmt.Errorf("failed to jail sluggish finality provider %s: %w", fpPk.MarshalHex(), err)
+	}
+
+	signInfo.MissedBlocksCounter = 0
+	if err := k.DeleteMissedBlockBitmap(ctx, fpPk); err != nil {
+		return fmt.Errorf("failed to delete missed block bitmap of finality provider %s: %w", fpPk.MarshalHex(), err)
+	}
+
+	k.Logger(sdkCtx).Info(
+		"jailed sluggish finality provider",
+		"height", sdkCtx.HeaderInfo().Height,
+		"public_key", fpPk.MarshalHex(),
+		"jailed_until", jailedUntil,
+	)
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(
+		finalitytypes.NewEventJailedFinalityProvider(fpPk, jailedUntil),
+	); err != nil {
+		panic(fmt.Errorf("failed to emit jailed finality provider event: %w", err))
+	}
+
+	return nil
+}
+
 func (k Keeper) updateSigningInfo(
 	ctx context.Context,
 	fpPk *types.BIP340PubKey,
@@ -1,8 +1,10 @@
 package keeper_test
 
 import (
+	"context"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -33,7 +35,15 @@ func FuzzHandleLiveness(f *testing.F) {
 		params := fKeeper.GetParams(ctx)
 		fpPk, err := datagen.GenRandomBIP340PubKey(r)
 		require.NoError(t, err)
-		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), fpPk.MustMarshal()).Return(&bstypes.FinalityProvider{Sluggish: false}, nil).AnyTimes()
+		fp := &bstypes.FinalityProvider{BtcPk: fpPk, Sluggish: false}
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), fpPk.MustMarshal()).Return(fp, nil).AnyTimes()
+		// the finality provider is expected to be jailed exactly once
+		expectedJailedUntil := ctx.HeaderInfo().Time.Add(params.JailDuration)
+		bsKeeper.EXPECT().JailFinalityProvider(gomock.Any(), fpPk.MustMarshal(), expectedJailedUntil).
+			DoAndReturn(func(_ context.Context, _ []byte, jailedUntil time.Time) error {
+				fp.JailedUntil = &jailedUntil
+				return nil
+			}).Times(1)
 		signingInfo := types.NewFinalityProviderSigningInfo(
 			fpPk,
 			1,
@@ -66,25 +76,79 @@ func FuzzHandleLiveness(f *testing.F) {
 			require.NoError(t, err)
 			if height < sluggishDetectedHeight-1 {
 				require.GreaterOrEqual(t, maxMissed, signingInfo.MissedBlocksCounter)
+				require.False(t, fp.IsJailed())
 			} else {
-				require.Less(t, maxMissed, signingInfo.MissedBlocksCounter)
+				// the sluggish fp is jailed and its missed blocks counter is reset
+				require.True(t, fp.IsJailed())
+				require.Equal(t, int64(0), signingInfo.MissedBlocksCounter)
 			}
 		}
 
-		// perform heights that not missed, expect the sluggish is reverted
-		bsKeeper.EXPECT().RevertSluggishFinalityProvider(gomock.Any(), fpPk.MustMarshal()).Return(nil).AnyTimes()
-		sluggishRevertedHeight := height + maxMissed
-		for ; height < sluggishRevertedHeight; height++ {
-			err := fKeeper.HandleFinalityProviderLiveness(ctx, fpPk, false, height)
+		// the liveness of a jailed fp is no longer tracked
+		for ; height < sluggishDetectedHeight+maxMissed; height++ {
+			err := fKeeper.HandleFinalityProviderLiveness(ctx, fpPk, true, height)
 			require.NoError(t, err)
 			signingInfo, err = fKeeper.FinalityProviderSigningTracker.Get(ctx, fpPk.MustMarshal())
 			require.NoError(t, err)
-			if height < sluggishRevertedHeight-1 {
-				require.Less(t, maxMissed, signingInfo.MissedBlocksCounter)
-			} else {
-				// the sluggish fp is reverted
-				require.Equal(t, maxMissed, signingInfo.MissedBlocksCounter)
-			}
+			require.Equal(t, int64(0), signingInfo.MissedBlocksCounter)
+		}
+	})
+}
+
+func FuzzHandleLivenessAfterKeyRotation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil)
+
+		params := fKeeper.GetParams(ctx)
+		oldFpPk, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		newFpPk, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		fp := &bstypes.FinalityProvider{BtcPk: oldFpPk}
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Any()).Return(fp, nil).AnyTimes()
+
+		// the finality provider misses some blocks under the old BTC PK
+		err = fKeeper.Hooks().AfterFinalityProviderActivated(ctx, oldFpPk)
+		require.NoError(t, err)
+		numMissed := int64(datagen.RandomInt(r, int(params.SignedBlocksWindow-params.MinSignedPerWindowInt()))) + 1
+		height := int64(1)
+		for ; height <= numMissed; height++ {
+			err := fKeeper.HandleFinalityProviderLiveness(ctx, oldFpPk, true, height)
+			require.NoError(t, err)
 		}
+
+		// re-key the finality provider
+		err = fKeeper.Hooks().AfterFinalityProviderKeyRotated(ctx, oldFpPk, newFpPk)
+		require.NoError(t, err)
+		fp.BtcPk = newFpPk
+
+		// the signing info and the missed block bitmap are moved to the new BTC PK
+		_, err = fKeeper.FinalityProviderSigningTracker.Get(ctx, oldFpPk.MustMarshal())
+		require.Error(t, err)
+		oldMissedBlocks, err := fKeeper.GetFinalityProviderMissedBlocks(ctx, oldFpPk)
+		require.NoError(t, err)
+		require.Empty(t, oldMissedBlocks)
+		signingInfo, err := fKeeper.FinalityProviderSigningTracker.Get(ctx, newFpPk.MustMarshal())
+		require.NoError(t, err)
+		require.Equal(t, newFpPk, signingInfo.FpBtcPk)
+		require.Equal(t, numMissed, signingInfo.MissedBlocksCounter)
+		newMissedBlocks, err := fKeeper.GetFinalityProviderMissedBlocks(ctx, newFpPk)
+		require.NoError(t, err)
+		require.Len(t, newMissedBlocks, int(numMissed))
+
+		// missed blocks at heights voted by the old BTC PK are counted under
+		// the new BTC PK
+		err = fKeeper.HandleFinalityProviderLiveness(ctx, oldFpPk, true, height)
+		require.NoError(t, err)
+		signingInfo, err = fKeeper.FinalityProviderSigningTracker.Get(ctx, newFpPk.MustMarshal())
+		require.NoError(t, err)
+		require.Equal(t, numMissed+1, signingInfo.MissedBlocksCounter)
 	})
 }
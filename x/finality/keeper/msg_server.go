@@ -12,6 +12,7 @@ import (
 	"github.com/babylonchain/babylon/x/finality/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -107,36 +108,131 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 	if err := types.VerifyFinalitySig(req, prCommit); err != nil {
 		return nil, err
 	}
+	// the public randomness is good, process the finality signature
+	if _, err := ms.processFinalitySig(ctx, fpPK, req.BlockHeight, req.PubRand, req.BlockAppHash, req.FinalitySig); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddFinalitySigResponse{}, nil
+}
+
+// AddFinalitySigs adds new votes to a contiguous range of blocks in a batch
+func (ms msgServer) AddFinalitySigs(goCtx context.Context, req *types.MsgAddFinalitySigs) (*types.MsgAddFinalitySigsResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyAddFinalitySigs)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// basic stateless checks
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	fpPK := req.FpBtcPk
+
+	// ensure the finality provider exists and is not slashed at this time
+	// point, for the same reason as in `AddFinalitySig`
+	fp, err := ms.BTCStakingKeeper.GetFinalityProvider(ctx, fpPK.MustMarshal())
+	if err != nil {
+		return nil, err
+	}
+	if fp.IsSlashed() {
+		return nil, bstypes.ErrFpAlreadySlashed
+	}
+
+	// ensure the finality provider has voting power at each height, and
+	// each voted height has been indexed
+	for height := req.StartHeight; height <= req.EndHeight(); height++ {
+		if ms.BTCStakingKeeper.GetVotingPower(ctx, fpPK.MustMarshal(), height) == 0 {
+			return nil, types.ErrInvalidFinalitySig.Wrapf("the finality provider %v does not have voting power at height %d", fpPK.MustMarshal(), height)
+		}
+		if _, err := ms.GetBlock(ctx, height); err != nil {
+			return nil, err
+		}
+	}
+
+	// find the public randomness commitment for this range from this
+	// finality provider
+	prCommit, err := ms.GetPubRandCommitForHeight(ctx, fpPK, req.StartHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// verify the batch w.r.t. the public randomness commitment, including
+	// the multi-proof of the public randomness list and each finality
+	// signature
+	if err := types.VerifyFinalitySigs(req, prCommit); err != nil {
+		return nil, err
+	}
+
+	// process each finality signature, including the equivocation check
+	for i := range req.FinalitySigList {
+		height := req.StartHeight + uint64(i)
+		sig := &req.FinalitySigList[i]
+
+		// skip the vote that has been cast already
+		existingSig, err := ms.GetSig(ctx, height, fpPK)
+		if err == nil && existingSig.Equals(sig) {
+			ms.Logger(ctx).Debug("Received duplicated finiality vote", "block height", height, "finality provider", fpPK)
+			continue
+		}
+
+		slashed, err := ms.processFinalitySig(ctx, fpPK, height, &req.PubRandList[i], req.BlockAppHashList[i], sig)
+		if err != nil {
+			return nil, err
+		}
+		// reject the rest of the votes once the finality provider is slashed,
+		// without returning error so that the evidence is not rolled back
+		if slashed {
+			break
+		}
+	}
+
+	return &types.MsgAddFinalitySigsResponse{}, nil
+}
+
+// processFinalitySig processes a finality signature whose public randomness
+// and EOTS signature have been verified. It records the public randomness
+// and the vote, and slashes the finality provider if it has voted for a fork
+// at the same height. It returns whether the finality provider is slashed.
+func (ms msgServer) processFinalitySig(
+	ctx context.Context,
+	fpPK *bbn.BIP340PubKey,
+	height uint64,
+	pubRand *bbn.SchnorrPubRand,
+	blockAppHash []byte,
+	finalitySig *bbn.SchnorrEOTSSig,
+) (bool, error) {
 	// the public randomness is good, set the public randomness
-	ms.SetPubRand(ctx, req.FpBtcPk, req.BlockHeight, *req.PubRand)
+	ms.SetPubRand(ctx, fpPK, height, *pubRand)
 
 	// verify whether the voted block is a fork or not
-	indexedBlock, err := ms.GetBlock(ctx, req.BlockHeight)
+	indexedBlock, err := ms.GetBlock(ctx, height)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
-	if !bytes.Equal(indexedBlock.AppHash, req.BlockAppHash) {
+	if !bytes.Equal(indexedBlock.AppHash, blockAppHash) {
 		// the finality provider votes for a fork!
 
 		// construct evidence
 		evidence := &types.Evidence{
-			FpBtcPk:              req.FpBtcPk,
-			BlockHeight:          req.BlockHeight,
-			PubRand:              req.PubRand,
+			FpBtcPk:              fpPK,
+			BlockHeight:          height,
+			PubRand:              pubRand,
 			CanonicalAppHash:     indexedBlock.AppHash,
 			CanonicalFinalitySig: nil,
-			ForkAppHash:          req.BlockAppHash,
-			ForkFinalitySig:      req.FinalitySig,
+			ForkAppHash:          blockAppHash,
+			ForkFinalitySig:      finalitySig,
 		}
 
 		// if this finality provider has also signed canonical block, slash it
-		canonicalSig, err := ms.GetSig(ctx, req.BlockHeight, fpPK)
+		slashed := false
+		canonicalSig, err := ms.GetSig(ctx, height, fpPK)
 		if err == nil {
 			//set canonial sig
 			evidence.CanonicalFinalitySig = canonicalSig
 			// slash this finality provider, including setting its voting power to
 			// zero, extracting its BTC SK, and emit an event
-			ms.slashFinalityProvider(ctx, req.FpBtcPk, evidence)
+			ms.slashFinalityProvider(ctx, fpPK, evidence)
+			slashed = true
 		}
 
 		// save evidence
@@ -144,34 +240,35 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 
 		// NOTE: we should NOT return error here, otherwise the state change triggered in this tx
 		// (including the evidence) will be rolled back
-		return &types.MsgAddFinalitySigResponse{}, nil
+		return slashed, nil
 	}
 
 	// this signature is good, add vote to DB
-	ms.SetSig(ctx, req.BlockHeight, fpPK, req.FinalitySig)
+	ms.SetSig(ctx, height, fpPK, finalitySig)
 
-	// if this finality provider has signed the canonical block before,
-	// slash it via extracting its secret key, and emit an event
-	if ms.HasEvidence(ctx, req.FpBtcPk, req.BlockHeight) {
+	// if this finality provider has signed the canonical block, slash it
+	// via extracting its secret key, and emit an event
+	if ms.HasEvidence(ctx, fpPK, height) {
 		// the finality provider has voted for a fork before!
 		// If this evidence is at the same height as this signature, slash this finality provider
 
 		// get evidence
-		evidence, err := ms.GetEvidence(ctx, req.FpBtcPk, req.BlockHeight)
+		evidence, err := ms.GetEvidence(ctx, fpPK, height)
 		if err != nil {
 			panic(fmt.Errorf("failed to get evidence despite HasEvidence returns true"))
 		}
 
 		// set canonical sig to this evidence
-		evidence.CanonicalFinalitySig = req.FinalitySig
+		evidence.CanonicalFinalitySig = finalitySig
 		ms.SetEvidence(ctx, evidence)
 
 		// slash this finality provider, including setting its voting power to
 		// zero, extracting its BTC SK, and emit an event
-		ms.slashFinalityProvider(ctx, req.FpBtcPk, evidence)
+		ms.slashFinalityProvider(ctx, fpPK, evidence)
+		return true, nil
 	}
 
-	return &types.MsgAddFinalitySigResponse{}, nil
+	return false, nil
 }
 
 // CommitPubRandList commits a list of EOTS public randomness
@@ -220,6 +317,8 @@ func (ms msgServer) CommitPubRandList(goCtx context.Context, req *types.MsgCommi
 	}
 
 	// ensure height and req.StartHeight do not overlap, i.e., height < req.StartHeight
+	// NOTE: the revoked heights of the last commitment are excluded, such that
+	// a replacement commitment can overlap the revoked range
 	lastPrHeightCommitted := lastPrCommit.EndHeight()
 	if req.StartHeight <= lastPrCommit.EndHeight() {
 		return nil, types.ErrInvalidPubRand.Wrapf("the start height (%d) has overlap with the height of the highest public randomness committed (%d)", req.StartHeight, lastPrHeightCommitted)
@@ -230,6 +329,121 @@ func (ms msgServer) CommitPubRandList(goCtx context.Context, req *types.MsgCommi
 	return &types.MsgCommitPubRandListResponse{}, nil
 }
 
+// RevokePubRandCommit revokes the public randomness committed by a finality
+// provider from a given height, such that the finality provider can commit
+// replacement public randomness for the revoked heights
+func (ms msgServer) RevokePubRandCommit(goCtx context.Context, req *types.MsgRevokePubRandCommit) (*types.MsgRevokePubRandCommitResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyRevokePubRandCommit)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// ensure the finality provider is registered
+	if req.FpBtcPk == nil {
+		return nil, types.ErrInvalidPubRandRevocation.Wrap("empty finality provider public key")
+	}
+	fpBTCPKBytes := req.FpBtcPk.MustMarshal()
+	fp, err := ms.BTCStakingKeeper.GetFinalityProvider(ctx, fpBTCPKBytes)
+	if err != nil {
+		return nil, bstypes.ErrFpNotFound.Wrapf("the finality provider with BTC PK %v is not registered", fpBTCPKBytes)
+	}
+
+	// ensure the finality provider secures Babylon. A consumer finality
+	// provider signs blocks at consumer heights, which cannot be compared
+	// with the current height, so that revoking its public randomness would
+	// allow it to escape slashing for double-signing at revoked heights
+	if !fp.SecuresBabylon() {
+		return nil, types.ErrInvalidPubRandRevocation.Wrapf("the finality provider with BTC PK %v does not secure Babylon", fpBTCPKBytes)
+	}
+
+	// ensure the revoked public randomness is not used yet, i.e., the
+	// revoked heights are all higher than the current height
+	curHeight := uint64(ctx.HeaderInfo().Height)
+	if req.RevokedFromHeight <= curHeight {
+		return nil, types.ErrInvalidPubRandRevocation.Wrapf("the revoked height (%d) is not higher than the current height (%d)", req.RevokedFromHeight, curHeight)
+	}
+
+	// find the commitment that includes the revoked height
+	prCommit, err := ms.GetPubRandCommitForHeight(ctx, req.FpBtcPk, req.RevokedFromHeight)
+	if err != nil {
+		return nil, types.ErrInvalidPubRandRevocation.Wrapf("no public randomness committed at height %d", req.RevokedFromHeight)
+	}
+
+	// verify signature over the revocation of this commitment
+	if err := req.VerifySig(prCommit.Commitment); err != nil {
+		return nil, types.ErrInvalidPubRandRevocation.Wrapf("invalid signature over the revocation: %v", err)
+	}
+
+	// all good, revoke all public randomness from the given height
+	ms.RevokePubRandCommits(ctx, req.FpBtcPk, req.RevokedFromHeight)
+
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventRevokedPubRandCommit(req.FpBtcPk, req.RevokedFromHeight)); err != nil {
+		panic(fmt.Errorf("failed to emit revoked public randomness commitment event: %w", err))
+	}
+
+	return &types.MsgRevokePubRandCommitResponse{}, nil
+}
+
+// UnjailFinalityProvider unjails a jailed finality provider whose jailing
+// period has passed, such that it can receive voting power again
+func (ms msgServer) UnjailFinalityProvider(goCtx context.Context, req *types.MsgUnjailFinalityProvider) (*types.MsgUnjailFinalityProviderResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyUnjailFinalityProvider)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// ensure the finality provider exists
+	if req.FpBtcPk == nil {
+		return nil, bstypes.ErrFpNotFound.Wrap("empty finality provider public key")
+	}
+	fp, err := ms.BTCStakingKeeper.GetFinalityProvider(ctx, req.FpBtcPk.MustMarshal())
+	if err != nil {
+		return nil, err
+	}
+
+	// ensure the signer is the finality provider
+	if req.Signer != fp.Addr {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "the signer %s does not correspond to the finality provider %s", req.Signer, fp.Addr)
+	}
+
+	// ensure the finality provider is jailed and its jailing period has passed
+	if !fp.IsJailed() {
+		return nil, bstypes.ErrFpNotJailed
+	}
+	curTime := ctx.HeaderInfo().Time
+	if curTime.Before(*fp.JailedUntil) {
+		return nil, types.ErrJailingPeriodNotPassed.Wrapf("jailed until %s, current time %s", fp.JailedUntil, curTime)
+	}
+
+	// ensure the finality provider has committed public randomness for at
+	// least the next `MinPubRand` heights, so that it is able to vote once
+	// it regains voting power
+	curHeight := uint64(ctx.HeaderInfo().Height)
+	minPubRand := ms.GetParams(ctx).MinPubRand
+	if _, err := ms.GetPubRandCommitForHeight(ctx, req.FpBtcPk, curHeight+1); err != nil {
+		return nil, types.ErrInsufficientPubRand.Wrapf("no public randomness committed for height %d", curHeight+1)
+	}
+	lastPrCommit := ms.GetLastPubRandCommit(ctx, req.FpBtcPk)
+	if lastPrCommit.EndHeight() < curHeight+minPubRand {
+		return nil, types.ErrInsufficientPubRand.Wrapf(
+			"the highest public randomness committed (%d) is lower than required (%d)",
+			lastPrCommit.EndHeight(), curHeight+minPubRand)
+	}
+
+	// all good, unjail the finality provider
+	if err := ms.BTCStakingKeeper.UnjailFinalityProvider(ctx, req.FpBtcPk.MustMarshal()); err != nil {
+		return nil, err
+	}
+	// unjailing clears the sluggish flag, as the liveness recovery does
+	if fp.IsSluggish() {
+		types.DecrementSluggishFinalityProviderCounter()
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventUnjailedFinalityProvider(req.FpBtcPk)); err != nil {
+		panic(fmt.Errorf("failed to emit unjailed finality provider event: %w", err))
+	}
+
+	return &types.MsgUnjailFinalityProviderResponse{}, nil
+}
+
 // slashFinalityProvider slashes a finality provider with the given evidence
 // including setting its voting power to zero, extracting its BTC SK,
 // and emit an event
@@ -92,6 +92,129 @@ func FuzzCommitPubRandList(f *testing.F) {
 	})
 }
 
+func FuzzRevokePubRandCommit(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		// create a random finality provider
+		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, btcSK)
+		require.NoError(t, err)
+		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+		fpBTCPKBytes := fpBTCPK.MustMarshal()
+
+		// set the current height
+		curHeight := datagen.RandomInt(r, 10) + 1
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(curHeight)})
+
+		// commit two consecutive public randomness lists
+		startHeight := uint64(1)
+		numPubRand := uint64(200)
+		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(true).Times(2)
+		oldRandListInfo, msgCommit, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msgCommit)
+		require.NoError(t, err)
+		_, msgCommit2, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight+numPubRand, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msgCommit2)
+		require.NoError(t, err)
+
+		revokedFromHeight := curHeight + 1 + datagen.RandomInt(r, 50)
+		msg, err := datagen.NewMsgRevokePubRandCommit(btcSK, revokedFromHeight, msgCommit.Commitment)
+		require.NoError(t, err)
+
+		// Case 1: fail if the finality provider is not registered
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(nil, bstypes.ErrFpNotFound).Times(1)
+		_, err = ms.RevokePubRandCommit(ctx, msg)
+		require.Error(t, err)
+
+		// Case 2: fail if the finality provider secures a consumer chain, whose
+		// heights cannot be compared with the current height
+		consumerFp := *fp
+		consumerFp.ConsumerId = datagen.GenRandomHexStr(r, 10)
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(&consumerFp, nil).Times(1)
+		_, err = ms.RevokePubRandCommit(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidPubRandRevocation)
+
+		// register the finality provider
+		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).AnyTimes()
+
+		// Case 3: fail if the revoked public randomness may have been used
+		usedMsg, err := datagen.NewMsgRevokePubRandCommit(btcSK, curHeight, msgCommit.Commitment)
+		require.NoError(t, err)
+		_, err = ms.RevokePubRandCommit(ctx, usedMsg)
+		require.ErrorIs(t, err, types.ErrInvalidPubRandRevocation)
+
+		// Case 4: fail if no public randomness is committed at the revoked height
+		notCommittedMsg, err := datagen.NewMsgRevokePubRandCommit(btcSK, startHeight+2*numPubRand, msgCommit.Commitment)
+		require.NoError(t, err)
+		_, err = ms.RevokePubRandCommit(ctx, notCommittedMsg)
+		require.ErrorIs(t, err, types.ErrInvalidPubRandRevocation)
+
+		// Case 5: fail if the signature is not over the commitment including the revoked height
+		invalidSigMsg, err := datagen.NewMsgRevokePubRandCommit(btcSK, revokedFromHeight, msgCommit2.Commitment)
+		require.NoError(t, err)
+		_, err = ms.RevokePubRandCommit(ctx, invalidSigMsg)
+		require.ErrorIs(t, err, types.ErrInvalidPubRandRevocation)
+
+		// Case 6: successfully revoke the public randomness from the given height,
+		// where the commitment including the height is partially revoked and the
+		// later commitment is fully revoked
+		_, err = ms.RevokePubRandCommit(ctx, msg)
+		require.NoError(t, err)
+		prCommit, err := fKeeper.GetPubRandCommitForHeight(ctx, fpBTCPK, revokedFromHeight-1)
+		require.NoError(t, err)
+		require.Equal(t, revokedFromHeight, prCommit.RevokedFromHeight)
+		_, err = fKeeper.GetPubRandCommitForHeight(ctx, fpBTCPK, revokedFromHeight)
+		require.ErrorIs(t, err, types.ErrPubRandNotFound)
+		_, err = fKeeper.GetPubRandCommitForHeight(ctx, fpBTCPK, startHeight+numPubRand)
+		require.ErrorIs(t, err, types.ErrPubRandNotFound)
+		lastPrCommit := fKeeper.GetLastPubRandCommit(ctx, fpBTCPK)
+		require.Equal(t, revokedFromHeight-1, lastPrCommit.EndHeight())
+
+		// Case 7: commit a replacement public randomness list overlapping the revoked range
+		randListInfo, msgReplace, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, revokedFromHeight, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msgReplace)
+		require.NoError(t, err)
+		prCommit, err = fKeeper.GetPubRandCommitForHeight(ctx, fpBTCPK, revokedFromHeight)
+		require.NoError(t, err)
+		require.Equal(t, msgReplace.Commitment, prCommit.Commitment)
+
+		// Case 8: the revocation cannot be replayed against the replacement commitment
+		_, err = ms.RevokePubRandCommit(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidPubRandRevocation)
+
+		// Case 9: votes at the revoked height are verified against the replacement commitment
+		blockAppHash := datagen.GenRandomByteArray(r, 32)
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(revokedFromHeight), AppHash: blockAppHash})
+		fKeeper.IndexBlock(ctx)
+		bsKeeper.EXPECT().GetVotingPower(gomock.Any(), gomock.Eq(fpBTCPKBytes), gomock.Eq(revokedFromHeight)).Return(uint64(1)).AnyTimes()
+		signer := datagen.GenRandomAccount().Address
+		// a vote with the revoked public randomness is rejected
+		revokedVoteMsg, err := datagen.NewMsgAddFinalitySig(signer, btcSK, startHeight, revokedFromHeight, oldRandListInfo, blockAppHash)
+		require.NoError(t, err)
+		_, err = ms.AddFinalitySig(ctx, revokedVoteMsg)
+		require.Error(t, err)
+		// a vote with the replacement public randomness is accepted
+		voteMsg, err := datagen.NewMsgAddFinalitySig(signer, btcSK, revokedFromHeight, revokedFromHeight, randListInfo, blockAppHash)
+		require.NoError(t, err)
+		_, err = ms.AddFinalitySig(ctx, voteMsg)
+		require.NoError(t, err)
+	})
+}
+
 func FuzzAddFinalitySig(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
@@ -203,6 +326,192 @@ func FuzzAddFinalitySig(f *testing.F) {
 	})
 }
 
+func FuzzAddFinalitySigs(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		// create and register a random finality provider
+		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, btcSK)
+		require.NoError(t, err)
+		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+		fpBTCPKBytes := fpBTCPK.MustMarshal()
+		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
+		// commit some public randomness
+		startHeight := uint64(0)
+		numPubRand := uint64(200)
+		randListInfo, msgCommitPubRandList, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msgCommitPubRandList)
+		require.NoError(t, err)
+
+		// index a range of blocks
+		blockHeight := startHeight + uint64(1)
+		numBlocks := datagen.RandomInt(r, 20) + 2
+		blockAppHashList := [][]byte{}
+		for i := uint64(0); i < numBlocks; i++ {
+			blockAppHash := datagen.GenRandomByteArray(r, 32)
+			blockAppHashList = append(blockAppHashList, blockAppHash)
+			ctx = ctx.WithHeaderInfo(header.Info{Height: int64(blockHeight + i), AppHash: blockAppHash})
+			fKeeper.IndexBlock(ctx)
+		}
+
+		// generate votes for the first numVotes blocks
+		numVotes := datagen.RandomInt(r, int(numBlocks)-1) + 1
+		signer := datagen.GenRandomAccount().Address
+		msg, err := datagen.NewMsgAddFinalitySigs(signer, btcSK, startHeight, blockHeight, randListInfo, blockAppHashList[:numVotes])
+		require.NoError(t, err)
+
+		// Case 1: fail if the finality provider does not have voting power at some height
+		bsKeeper.EXPECT().GetVotingPower(gomock.Any(), gomock.Eq(fpBTCPKBytes), gomock.Eq(blockHeight)).Return(uint64(0)).Times(1)
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
+		_, err = ms.AddFinalitySigs(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidFinalitySig)
+
+		// mock voting power
+		bsKeeper.EXPECT().GetVotingPower(gomock.Any(), gomock.Eq(fpBTCPKBytes), gomock.Any()).Return(uint64(1)).AnyTimes()
+
+		// Case 2: fail if any of the finality signatures is invalid, and no
+		// vote is recorded
+		invalidMsg := *msg
+		invalidMsg.BlockAppHashList = append([][]byte{}, msg.BlockAppHashList...)
+		invalidMsg.BlockAppHashList[datagen.RandomInt(r, int(numVotes))] = datagen.GenRandomByteArray(r, 32)
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
+		_, err = ms.AddFinalitySigs(ctx, &invalidMsg)
+		require.ErrorIs(t, err, types.ErrInvalidFinalitySig)
+		_, err = fKeeper.GetSig(ctx, blockHeight, fpBTCPK)
+		require.Error(t, err)
+
+		// Case 3: successful if the finality provider has voting power and
+		// all finality signatures are valid
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
+		_, err = ms.AddFinalitySigs(ctx, msg)
+		require.NoError(t, err)
+		// query these votes and assert
+		for i := uint64(0); i < numVotes; i++ {
+			sig, err := fKeeper.GetSig(ctx, blockHeight+i, fpBTCPK)
+			require.NoError(t, err)
+			require.Equal(t, msg.FinalitySigList[i].MustMarshal(), sig.MustMarshal())
+		}
+
+		// Case 4: In case of duplicate votes return success
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
+		_, err = ms.AddFinalitySigs(ctx, msg)
+		require.NoError(t, err)
+
+		// Case 5: the finality provider is slashed if it votes for a fork at
+		// some height, and the rest of the votes are rejected
+		forkIdx := datagen.RandomInt(r, int(numVotes))
+		forkAppHashList := append([][]byte{}, blockAppHashList...)
+		forkAppHashList[forkIdx] = datagen.GenRandomByteArray(r, 32)
+		msg2, err := datagen.NewMsgAddFinalitySigs(signer, btcSK, startHeight, blockHeight, randListInfo, forkAppHashList)
+		require.NoError(t, err)
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
+		// mock slashing interface
+		bsKeeper.EXPECT().SlashFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(nil).Times(1)
+		// NOTE: even though this finality provider is slashed, the msg should be successful
+		// Otherwise the saved evidence will be rolled back
+		_, err = ms.AddFinalitySigs(ctx, msg2)
+		require.NoError(t, err)
+		// ensure the evidence has been stored and allows extracting the SK
+		evidence, err := fKeeper.GetEvidence(ctx, fpBTCPK, blockHeight+forkIdx)
+		require.NoError(t, err)
+		require.Equal(t, forkAppHashList[forkIdx], evidence.ForkAppHash)
+		btcSK2, err := evidence.ExtractBTCSK()
+		require.NoError(t, err)
+		require.Equal(t, btcSK.PubKey().SerializeCompressed()[1:], btcSK2.PubKey().SerializeCompressed()[1:])
+		// ensure the votes after the fork are not recorded
+		_, err = fKeeper.GetSig(ctx, blockHeight+numBlocks-1, fpBTCPK)
+		require.Error(t, err)
+
+		// Case 6: slashed finality provider cannot vote
+		fp.SlashedBabylonHeight = blockHeight
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
+		_, err = ms.AddFinalitySigs(ctx, msg)
+		require.Equal(t, bstypes.ErrFpAlreadySlashed, err)
+	})
+}
+
+func FuzzUnjailFinalityProvider(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+		params := fKeeper.GetParams(ctx)
+
+		// create and register a random finality provider that is jailed
+		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, btcSK)
+		require.NoError(t, err)
+		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+		fpBTCPKBytes := fpBTCPK.MustMarshal()
+		jailedUntil := time.Unix(int64(datagen.RandomInt(r, 1000000)+1), 0).UTC()
+		fp.JailedUntil = &jailedUntil
+		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).AnyTimes()
+
+		blockHeight := datagen.RandomInt(r, 100) + 1
+		msg := &types.MsgUnjailFinalityProvider{
+			Signer:  fp.Addr,
+			FpBtcPk: fpBTCPK,
+		}
+
+		// Case 1: fail if the signer is not the finality provider
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(blockHeight), Time: jailedUntil})
+		_, err = ms.UnjailFinalityProvider(ctx, &types.MsgUnjailFinalityProvider{
+			Signer:  datagen.GenRandomAccount().Address,
+			FpBtcPk: fpBTCPK,
+		})
+		require.Error(t, err)
+
+		// Case 2: fail if the jailing period is not passed
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(blockHeight), Time: jailedUntil.Add(-time.Second)})
+		_, err = ms.UnjailFinalityProvider(ctx, msg)
+		require.ErrorIs(t, err, types.ErrJailingPeriodNotPassed)
+
+		// Case 3: fail if the finality provider has not committed enough
+		// public randomness
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(blockHeight), Time: jailedUntil})
+		_, err = ms.UnjailFinalityProvider(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInsufficientPubRand)
+		_, msgCommitPubRandList, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, blockHeight+1, params.MinPubRand-1)
+		require.NoError(t, err)
+		fKeeper.SetPubRandCommit(ctx, fpBTCPK, &types.PubRandCommit{
+			StartHeight: msgCommitPubRandList.StartHeight,
+			NumPubRand:  msgCommitPubRandList.NumPubRand,
+			Commitment:  msgCommitPubRandList.Commitment,
+		})
+		_, err = ms.UnjailFinalityProvider(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInsufficientPubRand)
+
+		// Case 4: successfully unjail the finality provider after committing
+		// enough public randomness
+		_, msgCommitPubRandList, err = datagen.GenRandomMsgCommitPubRandList(r, btcSK, blockHeight+params.MinPubRand, params.MinPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msgCommitPubRandList)
+		require.NoError(t, err)
+		bsKeeper.EXPECT().UnjailFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(nil).Times(1)
+		_, err = ms.UnjailFinalityProvider(ctx, msg)
+		require.NoError(t, err)
+	})
+}
+
 func TestVoteForConflictingHashShouldRetrieveEvidenceAndSlash(t *testing.T) {
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	ctrl := gomock.NewController(t)
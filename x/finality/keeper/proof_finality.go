@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+// ProveFinality generates a proof that the block at the given height is
+// BTC-finalized. The proof includes the IndexedBlock, the voting power table
+// and the EOTS signatures with their public randomness at this height, each
+// with a Merkle proof against the AppHash of the header at the next height.
+// The given context has to be a query context, whose state is the one
+// committed at its height, such that the voting power table and the EOTS
+// signatures read from it are the ones proven against the AppHash
+func (k Keeper) ProveFinality(ctx context.Context, height uint64) (*types.FinalityProof, error) {
+	// the state committed at the context's height is included in the AppHash
+	// of the header at the next height
+	proofHeight := sdk.UnwrapSDKContext(ctx).BlockHeight() + 1
+
+	// prove the block is finalized
+	_, blockBytes, proofBlock, err := k.QueryStore(types.StoreKey, types.GetBlockKey(height), proofHeight)
+	if err != nil {
+		return nil, err
+	}
+	if len(blockBytes) == 0 {
+		return nil, types.ErrBlockNotFound.Wrapf("height: %d", height)
+	}
+	var block types.IndexedBlock
+	if err := k.cdc.Unmarshal(blockBytes, &block); err != nil {
+		return nil, err
+	}
+	if !block.Finalized {
+		return nil, types.ErrBlockNotFinalized.Wrapf("height: %d", height)
+	}
+
+	// prove the voting power table at this height
+	vpTable := k.BTCStakingKeeper.GetVotingPowerTable(ctx, height)
+	votingPowers := make([]*types.VotingPowerWithProof, 0, len(vpTable))
+	for _, fpBTCPKHex := range sortedKeys(vpTable) {
+		fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(fpBTCPKHex)
+		if err != nil {
+			return nil, err
+		}
+		_, vpBytes, proof, err := k.QueryStore(bstypes.StoreKey, bstypes.GetVotingPowerKey(height, fpBTCPK), proofHeight)
+		if err != nil {
+			return nil, err
+		}
+		votingPowers = append(votingPowers, &types.VotingPowerWithProof{
+			FpBtcPk:     fpBTCPK,
+			VotingPower: sdk.BigEndianToUint64(vpBytes),
+			Proof:       proof,
+		})
+	}
+
+	// prove the EOTS signatures on the block and their public randomness
+	sigSet := k.GetSigSet(ctx, height)
+	sigs := make([]*types.FinalitySigWithProof, 0, len(sigSet))
+	for _, fpBTCPKHex := range sortedKeys(sigSet) {
+		fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(fpBTCPKHex)
+		if err != nil {
+			return nil, err
+		}
+		_, sigBytes, proof, err := k.QueryStore(types.StoreKey, types.GetVoteKey(height, fpBTCPK), proofHeight)
+		if err != nil {
+			return nil, err
+		}
+		sig, err := bbn.NewSchnorrEOTSSig(sigBytes)
+		if err != nil {
+			return nil, err
+		}
+		_, pubRandBytes, proofPubRand, err := k.QueryStore(types.StoreKey, types.GetPubRandKey(height, fpBTCPK), proofHeight)
+		if err != nil {
+			return nil, err
+		}
+		if len(pubRandBytes) == 0 {
+			return nil, types.ErrPubRandNotFound.Wrapf("finality provider %s at height %d", fpBTCPKHex, height)
+		}
+		pubRand, err := bbn.NewSchnorrPubRand(pubRandBytes)
+		if err != nil {
+			return nil, err
+		}
+		sigs = append(sigs, &types.FinalitySigWithProof{
+			FpBtcPk:      fpBTCPK,
+			Sig:          sig,
+			Proof:        proof,
+			PubRand:      pubRand,
+			ProofPubRand: proofPubRand,
+		})
+	}
+
+	return &types.FinalityProof{
+		Block:        &block,
+		ProofBlock:   proofBlock,
+		VotingPowers: votingPowers,
+		Sigs:         sigs,
+		ProofHeight:  uint64(proofHeight),
+	}, nil
+}
+
+// sortedKeys returns the keys of the given map in ascending order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/finality/keeper"
+	"github.com/babylonchain/babylon/x/finality/types"
+	"github.com/babylonchain/babylon/x/finality/verifier"
+)
+
+func FuzzProveFinality(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mount the KVStores of both x/finality and x/btcstaking, such that
+		// the voting power table can be proven against the same AppHash
+		storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+		bsStoreKey := storetypes.NewKVStoreKey(bstypes.StoreKey)
+		db := dbm.NewMemDB()
+		stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), storemetrics.NewNoOpMetrics())
+		stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+		stateStore.MountStoreWithDB(bsStoreKey, storetypes.StoreTypeIAVL, nil)
+		require.NoError(t, stateStore.LoadLatestVersion())
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper := keeper.NewKeeper(
+			codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+			runtime.NewKVStoreService(storeKey),
+			bsKeeper,
+			nil,
+			stateStore.(storetypes.Queryable),
+			authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		)
+		ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+
+		// generate a finalized block with a voting power table, where
+		// all finality providers but the last one have voted
+		height := datagen.RandomInt(r, 10) + 1
+		for stateStore.LastCommitID().Version < int64(height) {
+			stateStore.Commit()
+		}
+		block := &types.IndexedBlock{
+			Height:    height,
+			AppHash:   datagen.GenRandomByteArray(r, 32),
+			Finalized: true,
+		}
+		fKeeper.SetBlock(ctx, block)
+		signer := datagen.GenRandomAccount().Address
+		numFps := int(datagen.RandomInt(r, 5)) + 4
+		vpTable := map[string]uint64{}
+		var voterSK *btcec.PrivateKey
+		var voterRandListInfo *datagen.RandListInfo
+		for i := 0; i < numFps; i++ {
+			btcSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcSK.PubKey())
+			power := uint64(10)
+			if i == numFps-1 {
+				power = 1
+			} else {
+				randListInfo, err := datagen.GenRandomPubRandList(r, 1)
+				require.NoError(t, err)
+				msg, err := datagen.NewMsgAddFinalitySig(signer, btcSK, height, height, randListInfo, block.AppHash)
+				require.NoError(t, err)
+				fKeeper.SetPubRand(ctx, fpBTCPK, height, *msg.PubRand)
+				fKeeper.SetSig(ctx, height, fpBTCPK, msg.FinalitySig)
+				voterSK, voterRandListInfo = btcSK, randListInfo
+			}
+			vpTable[fpBTCPK.MarshalHex()] = power
+			ctx.KVStore(bsStoreKey).Set(bstypes.GetVotingPowerKey(height, fpBTCPK), sdk.Uint64ToBigEndian(power))
+		}
+		bsKeeper.EXPECT().GetVotingPowerTable(gomock.Any(), gomock.Eq(height)).Return(vpTable).AnyTimes()
+
+		// commit the state, and prove the finality in the committed state,
+		// whose AppHash is contained in the next header
+		commitID := stateStore.Commit()
+		ctx = ctx.WithBlockHeight(commitID.Version)
+		proofHeight := commitID.Version + 1
+		trustedHeader := &cmtproto.Header{Height: proofHeight, AppHash: commitID.Hash}
+
+		proof, err := fKeeper.ProveFinality(ctx, height)
+		require.NoError(t, err)
+		require.Equal(t, uint64(proofHeight), proof.ProofHeight)
+		require.Len(t, proof.VotingPowers, numFps)
+		require.Len(t, proof.Sigs, numFps-1)
+		require.NoError(t, verifier.VerifyFinalityProof(proof, trustedHeader))
+
+		// a proof against another AppHash is rejected
+		err = verifier.VerifyFinalityProof(proof, &cmtproto.Header{Height: proofHeight, AppHash: datagen.GenRandomByteArray(r, 32)})
+		require.Error(t, err)
+
+		// a proof with a tampered voting power is rejected
+		proof.VotingPowers[0].VotingPower++
+		err = verifier.VerifyFinalityProof(proof, trustedHeader)
+		require.Error(t, err)
+		proof.VotingPowers[0].VotingPower--
+
+		// a proof with a tampered signature is rejected
+		originalSig := proof.Sigs[0].Sig
+		tamperedSig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+		proof.Sigs[0].Sig = tamperedSig
+		err = verifier.VerifyFinalityProof(proof, trustedHeader)
+		require.Error(t, err)
+		proof.Sigs[0].Sig = originalSig
+
+		// a proof with a tampered public randomness is rejected
+		originalPubRand := proof.Sigs[0].PubRand
+		tamperedRandListInfo, err := datagen.GenRandomPubRandList(r, 1)
+		require.NoError(t, err)
+		proof.Sigs[0].PubRand = &tamperedRandListInfo.PRList[0]
+		err = verifier.VerifyFinalityProof(proof, trustedHeader)
+		require.Error(t, err)
+		proof.Sigs[0].PubRand = originalPubRand
+
+		// a proof with a subset of the signatures is accepted, as it relies
+		// only on the finalized status of the block
+		proof.Sigs = proof.Sigs[:1]
+		err = verifier.VerifyFinalityProof(proof, trustedHeader)
+		require.NoError(t, err)
+
+		// a block that does not exist cannot be proven
+		_, err = fKeeper.ProveFinality(ctx, height+1)
+		require.ErrorIs(t, err, types.ErrBlockNotFound)
+
+		// an EOTS signature committed to the AppHash that does not sign the
+		// block under the committed public randomness is rejected
+		forkMsg, err := datagen.NewMsgAddFinalitySig(signer, voterSK, height, height, voterRandListInfo, datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+		fKeeper.SetSig(ctx, height, forkMsg.FpBtcPk, forkMsg.FinalitySig)
+		commitID = stateStore.Commit()
+		ctx = ctx.WithBlockHeight(commitID.Version)
+		trustedHeader = &cmtproto.Header{Height: commitID.Version + 1, AppHash: commitID.Hash}
+		proof, err = fKeeper.ProveFinality(ctx, height)
+		require.NoError(t, err)
+		err = verifier.VerifyFinalityProof(proof, trustedHeader)
+		require.ErrorIs(t, err, types.ErrInvalidFinalityProof)
+		require.ErrorContains(t, err, "invalid EOTS signature")
+	})
+}
@@ -17,7 +17,9 @@ import (
 */
 
 // GetPubRandCommitForHeight finds the public randomness commitment that includes the given
-// height for the given finality provider
+// height for the given finality provider. Revoked heights of commitments are skipped,
+// such that a replacement commitment is returned for a height whose original
+// commitment is revoked
 func (k Keeper) GetPubRandCommitForHeight(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, height uint64) (*types.PubRandCommit, error) {
 	store := k.pubRandCommitFpStore(ctx, fpBtcPK)
 	iter := store.ReverseIterator(nil, nil)
@@ -41,19 +43,50 @@ func (k Keeper) SetPubRandCommit(ctx context.Context, fpBtcPK *bbn.BIP340PubKey,
 }
 
 // GetLastPubRandCommit retrieves the last public randomness commitment of the given finality provider
+// Fully revoked commitments are skipped as none of their public randomness can be used
 func (k Keeper) GetLastPubRandCommit(ctx context.Context, fpBtcPK *bbn.BIP340PubKey) *types.PubRandCommit {
 	store := k.pubRandCommitFpStore(ctx, fpBtcPK)
 	iter := store.ReverseIterator(nil, nil)
 	defer iter.Close()
 
-	if !iter.Valid() {
-		// this finality provider does not commit any randomness
-		return nil
+	for ; iter.Valid(); iter.Next() {
+		var prCommit types.PubRandCommit
+		k.cdc.MustUnmarshal(iter.Value(), &prCommit)
+		if !prCommit.IsFullyRevoked() {
+			return &prCommit
+		}
 	}
 
-	var prCommit types.PubRandCommit
-	k.cdc.MustUnmarshal(iter.Value(), &prCommit)
-	return &prCommit
+	// this finality provider does not commit any usable randomness
+	return nil
+}
+
+// RevokePubRandCommits revokes all public randomness of the given finality
+// provider from the given height, i.e., the commitment including the height
+// is revoked from the height and all later commitments are fully revoked
+func (k Keeper) RevokePubRandCommits(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, revokedFromHeight uint64) {
+	store := k.pubRandCommitFpStore(ctx, fpBtcPK)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	revokedCommits := []*types.PubRandCommit{}
+	for ; iter.Valid(); iter.Next() {
+		var prCommit types.PubRandCommit
+		k.cdc.MustUnmarshal(iter.Value(), &prCommit)
+		if prCommit.IsFullyRevoked() || prCommit.EndHeight() < revokedFromHeight {
+			continue
+		}
+		prCommit.RevokedFromHeight = revokedFromHeight
+		if prCommit.StartHeight > revokedFromHeight {
+			prCommit.RevokedFromHeight = prCommit.StartHeight
+		}
+		revokedCommits = append(revokedCommits, &prCommit)
+	}
+
+	// write back after iterating so that the store is not modified during the iteration
+	for _, prCommit := range revokedCommits {
+		k.SetPubRandCommit(ctx, fpBtcPK, prCommit)
+	}
 }
 
 // pubRandCommitFpStore returns the KVStore of the commitment of public randomness
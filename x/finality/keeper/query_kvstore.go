@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+)
+
+// QueryStore queries a KV pair with its Merkle proof in the KVStore of the
+// given module, e.g., types.StoreKey or btcstakingtypes.StoreKey, at the
+// state committed to the AppHash of the header at queryHeight
+// (adapted from https://github.com/cosmos/cosmos-sdk/blob/v0.46.6/baseapp/abci.go#L774-L795)
+func (k Keeper) QueryStore(moduleStoreKey string, key []byte, queryHeight int64) ([]byte, []byte, *cmtcrypto.ProofOps, error) {
+	// since we are querying the DB directly, the path does not need the
+	// prefix "/store" as done in ABCIQuery
+	path := fmt.Sprintf("/%s/key", moduleStoreKey)
+
+	resp, err := k.storeQuerier.Query(&storetypes.RequestQuery{
+		Path:   path,
+		Data:   key,
+		Height: queryHeight - 1, // NOTE: the inclusion proof corresponds to the NEXT header
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if resp.Code != 0 {
+		return nil, nil, nil, fmt.Errorf("query (with path %s) failed with response: %v", path, resp)
+	}
+
+	return resp.Key, resp.Value, resp.ProofOps, nil
+}
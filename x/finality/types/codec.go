@@ -9,7 +9,10 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCommitPubRandList{}, "finality/MsgCommitPubRandList", nil)
+	cdc.RegisterConcrete(&MsgRevokePubRandCommit{}, "finality/MsgRevokePubRandCommit", nil)
 	cdc.RegisterConcrete(&MsgAddFinalitySig{}, "finality/MsgAddFinalitySig", nil)
+	cdc.RegisterConcrete(&MsgAddFinalitySigs{}, "finality/MsgAddFinalitySigs", nil)
+	cdc.RegisterConcrete(&MsgUnjailFinalityProvider{}, "finality/MsgUnjailFinalityProvider", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "finality/MsgUpdateParams", nil)
 }
 
@@ -18,7 +21,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgCommitPubRandList{},
+		&MsgRevokePubRandCommit{},
 		&MsgAddFinalitySig{},
+		&MsgAddFinalitySigs{},
+		&MsgUnjailFinalityProvider{},
 		&MsgUpdateParams{},
 	)
 
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	"github.com/babylonchain/babylon/crypto/eots"
+)
+
+// VerifyConsumerEvidence verifies the evidence that a finality provider of a
+// consumer chain has signed two conflicting blocks at the same height of the
+// consumer chain, w.r.t. the public randomness commitment of the finality
+// provider. The verification includes
+// - verifying the proof of inclusion of the public randomness in the evidence
+// - verifying both finality signatures w.r.t. the public randomness and the
+// conflicting block hashes
+func VerifyConsumerEvidence(e *Evidence, pubRandProof *cmtcrypto.Proof, prCommit *PubRandCommit) error {
+	if !e.IsSlashable() {
+		return ErrInvalidConsumerEvidence.Wrap("the evidence lacks some fields")
+	}
+	if bytes.Equal(e.CanonicalAppHash, e.ForkAppHash) {
+		return ErrInvalidConsumerEvidence.Wrap("the evidence does not contain conflicting blocks")
+	}
+	if pubRandProof == nil {
+		return ErrInvalidConsumerEvidence.Wrap("empty inclusion proof of the public randomness")
+	}
+
+	// verify the index of the public randomness
+	heightOfProof := prCommit.StartHeight + uint64(pubRandProof.Index)
+	if e.BlockHeight != heightOfProof {
+		return ErrInvalidConsumerEvidence.Wrapf("the inclusion proof (for height %d) does not correspond to the given height (%d) in the evidence", heightOfProof, e.BlockHeight)
+	}
+	// verify the total number of randomness is same as in the commit
+	if uint64(pubRandProof.Total) != prCommit.NumPubRand {
+		return ErrInvalidConsumerEvidence.Wrapf("the total number of public randomnesses in the proof (%d) does not match the number of public randomnesses committed (%d)", pubRandProof.Total, prCommit.NumPubRand)
+	}
+	// verify the proof of inclusion for this public randomness
+	unwrappedProof, err := merkle.ProofFromProto(pubRandProof)
+	if err != nil {
+		return ErrInvalidConsumerEvidence.Wrapf("failed to unwrap proof: %v", err)
+	}
+	if err := unwrappedProof.Verify(prCommit.Commitment, *e.PubRand); err != nil {
+		return ErrInvalidConsumerEvidence.Wrapf("the inclusion proof of the public randomness is invalid: %v", err)
+	}
+
+	// public randomness is good, verify both finality signatures
+	pk, err := e.FpBtcPk.ToBTCPK()
+	if err != nil {
+		return err
+	}
+	if err := eots.Verify(pk, e.PubRand.ToFieldVal(), e.canonicalMsgToSign(), e.CanonicalFinalitySig.ToModNScalar()); err != nil {
+		return ErrInvalidConsumerEvidence.Wrapf("invalid finality signature to the canonical block: %v", err)
+	}
+	if err := eots.Verify(pk, e.PubRand.ToFieldVal(), e.forkMsgToSign(), e.ForkFinalitySig.ToModNScalar()); err != nil {
+		return ErrInvalidConsumerEvidence.Wrapf("invalid finality signature to the fork block: %v", err)
+	}
+	return nil
+}
@@ -1,6 +1,10 @@
 package types
 
-import "github.com/babylonchain/babylon/types"
+import (
+	"time"
+
+	"github.com/babylonchain/babylon/types"
+)
 
 func NewEventSlashedFinalityProvider(evidence *Evidence) *EventSlashedFinalityProvider {
 	return &EventSlashedFinalityProvider{
@@ -15,3 +19,15 @@ func NewEventSluggishFinalityProviderDetected(fpPk *types.BIP340PubKey) *EventSl
 func NewEventSluggishFinalityProviderReverted(fpPk *types.BIP340PubKey) *EventSluggishFinalityProviderReverted {
 	return &EventSluggishFinalityProviderReverted{PublicKey: fpPk.MarshalHex()}
 }
+
+func NewEventJailedFinalityProvider(fpPk *types.BIP340PubKey, jailedUntil time.Time) *EventJailedFinalityProvider {
+	return &EventJailedFinalityProvider{PublicKey: fpPk.MarshalHex(), JailedUntil: jailedUntil}
+}
+
+func NewEventUnjailedFinalityProvider(fpPk *types.BIP340PubKey) *EventUnjailedFinalityProvider {
+	return &EventUnjailedFinalityProvider{PublicKey: fpPk.MarshalHex()}
+}
+
+func NewEventRevokedPubRandCommit(fpPk *types.BIP340PubKey, revokedFromHeight uint64) *EventRevokedPubRandCommit {
+	return &EventRevokedPubRandCommit{PublicKey: fpPk.MarshalHex(), RevokedFromHeight: revokedFromHeight}
+}
@@ -5,16 +5,21 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -163,32 +168,203 @@ func (m *EventSluggishFinalityProviderReverted) GetPublicKey() string {
 	return ""
 }
 
+// EventJailedFinalityProvider is the event emitted when a sluggish finality
+// provider is jailed
+type EventJailedFinalityProvider struct {
+	// public_key is the BTC public key of the finality provider
+	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// jailed_until is the time until which the finality provider is jailed
+	JailedUntil time.Time `protobuf:"bytes,2,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+}
+
+func (m *EventJailedFinalityProvider) Reset()         { *m = EventJailedFinalityProvider{} }
+func (m *EventJailedFinalityProvider) String() string { return proto.CompactTextString(m) }
+func (*EventJailedFinalityProvider) ProtoMessage()    {}
+func (*EventJailedFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c34c03aae5e3e6bf, []int{3}
+}
+func (m *EventJailedFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventJailedFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventJailedFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventJailedFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventJailedFinalityProvider.Merge(m, src)
+}
+func (m *EventJailedFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventJailedFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventJailedFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventJailedFinalityProvider proto.InternalMessageInfo
+
+func (m *EventJailedFinalityProvider) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *EventJailedFinalityProvider) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
+// EventUnjailedFinalityProvider is the event emitted when a jailed finality
+// provider is unjailed
+type EventUnjailedFinalityProvider struct {
+	// public_key is the BTC public key of the finality provider
+	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+}
+
+func (m *EventUnjailedFinalityProvider) Reset()         { *m = EventUnjailedFinalityProvider{} }
+func (m *EventUnjailedFinalityProvider) String() string { return proto.CompactTextString(m) }
+func (*EventUnjailedFinalityProvider) ProtoMessage()    {}
+func (*EventUnjailedFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c34c03aae5e3e6bf, []int{4}
+}
+func (m *EventUnjailedFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnjailedFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnjailedFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUnjailedFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnjailedFinalityProvider.Merge(m, src)
+}
+func (m *EventUnjailedFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnjailedFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnjailedFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnjailedFinalityProvider proto.InternalMessageInfo
+
+func (m *EventUnjailedFinalityProvider) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+// EventRevokedPubRandCommit is the event emitted when a finality provider
+// revokes its committed public randomness from a given height
+type EventRevokedPubRandCommit struct {
+	// public_key is the BTC public key of the finality provider
+	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// revoked_from_height is the height from which the public randomness is revoked
+	RevokedFromHeight uint64 `protobuf:"varint,2,opt,name=revoked_from_height,json=revokedFromHeight,proto3" json:"revoked_from_height,omitempty"`
+}
+
+func (m *EventRevokedPubRandCommit) Reset()         { *m = EventRevokedPubRandCommit{} }
+func (m *EventRevokedPubRandCommit) String() string { return proto.CompactTextString(m) }
+func (*EventRevokedPubRandCommit) ProtoMessage()    {}
+func (*EventRevokedPubRandCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c34c03aae5e3e6bf, []int{5}
+}
+func (m *EventRevokedPubRandCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRevokedPubRandCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRevokedPubRandCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRevokedPubRandCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRevokedPubRandCommit.Merge(m, src)
+}
+func (m *EventRevokedPubRandCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRevokedPubRandCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRevokedPubRandCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRevokedPubRandCommit proto.InternalMessageInfo
+
+func (m *EventRevokedPubRandCommit) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *EventRevokedPubRandCommit) GetRevokedFromHeight() uint64 {
+	if m != nil {
+		return m.RevokedFromHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventSlashedFinalityProvider)(nil), "babylon.finality.v1.EventSlashedFinalityProvider")
 	proto.RegisterType((*EventSluggishFinalityProviderDetected)(nil), "babylon.finality.v1.EventSluggishFinalityProviderDetected")
 	proto.RegisterType((*EventSluggishFinalityProviderReverted)(nil), "babylon.finality.v1.EventSluggishFinalityProviderReverted")
+	proto.RegisterType((*EventJailedFinalityProvider)(nil), "babylon.finality.v1.EventJailedFinalityProvider")
+	proto.RegisterType((*EventUnjailedFinalityProvider)(nil), "babylon.finality.v1.EventUnjailedFinalityProvider")
+	proto.RegisterType((*EventRevokedPubRandCommit)(nil), "babylon.finality.v1.EventRevokedPubRandCommit")
 }
 
 func init() { proto.RegisterFile("babylon/finality/v1/events.proto", fileDescriptor_c34c03aae5e3e6bf) }
 
 var fileDescriptor_c34c03aae5e3e6bf = []byte{
-	// 250 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0xcb, 0xcc, 0x4b, 0xcc, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4,
-	0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xaa,
-	0xd0, 0x83, 0xa9, 0xd0, 0x2b, 0x33, 0x94, 0x52, 0xc2, 0xa6, 0x0d, 0xae, 0x00, 0xac, 0x51, 0x29,
-	0x92, 0x4b, 0xc6, 0x15, 0x64, 0x50, 0x70, 0x4e, 0x62, 0x71, 0x46, 0x6a, 0x8a, 0x1b, 0x54, 0x36,
-	0xa0, 0x28, 0xbf, 0x2c, 0x33, 0x25, 0xb5, 0x48, 0xc8, 0x92, 0x8b, 0x23, 0x15, 0xc4, 0xca, 0x4b,
-	0x4e, 0x95, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd5, 0xc3, 0x62, 0x97, 0x9e, 0x2b, 0x54,
-	0x51, 0x10, 0x5c, 0xb9, 0x92, 0x1b, 0x97, 0x2a, 0xd4, 0xe8, 0xd2, 0xf4, 0xf4, 0xcc, 0xe2, 0x0c,
-	0x74, 0xb3, 0x5d, 0x52, 0x4b, 0x52, 0x93, 0x4b, 0x52, 0x53, 0x84, 0x64, 0xb9, 0xb8, 0x0a, 0x4a,
-	0x93, 0x72, 0x32, 0x93, 0xe3, 0xb3, 0x53, 0x2b, 0xc1, 0xb6, 0x70, 0x06, 0x71, 0x42, 0x44, 0xbc,
-	0x53, 0x2b, 0x09, 0x9a, 0x13, 0x94, 0x5a, 0x96, 0x5a, 0x44, 0xd8, 0x1c, 0x27, 0xaf, 0x13, 0x8f,
-	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
-	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x48, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x7a, 0x2e, 0x39, 0x23, 0x31, 0x33, 0x0f, 0xc6, 0xd1, 0xaf, 0x40,
-	0x04, 0x61, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xf4, 0x8c, 0x01, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x62, 0xf7, 0x2c, 0xf5, 0x9a, 0x01, 0x00, 0x00,
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x3d, 0xef, 0xd2, 0x40,
+	0x18, 0x6f, 0x8d, 0x31, 0x7f, 0x0e, 0x17, 0x8b, 0x03, 0xa2, 0x14, 0xd2, 0xc4, 0xc4, 0xe9, 0x4e,
+	0x74, 0x72, 0x71, 0x40, 0x41, 0x83, 0x0b, 0xa9, 0x32, 0xe8, 0xd2, 0xf4, 0xe5, 0xe1, 0x7a, 0xd0,
+	0xde, 0x35, 0xed, 0xb5, 0xb1, 0x1f, 0xc0, 0x9d, 0x8f, 0xc5, 0xc8, 0xe8, 0xa4, 0x06, 0xbe, 0x88,
+	0xe9, 0xb5, 0xc5, 0xc4, 0x10, 0x31, 0xff, 0xed, 0x9e, 0xfb, 0xbd, 0x3c, 0xaf, 0x68, 0xec, 0xb9,
+	0x5e, 0x19, 0x09, 0x4e, 0xd6, 0x8c, 0xbb, 0x11, 0x93, 0x25, 0x29, 0x26, 0x04, 0x0a, 0xe0, 0x32,
+	0xc3, 0x49, 0x2a, 0xa4, 0x30, 0x7a, 0x0d, 0x03, 0xb7, 0x0c, 0x5c, 0x4c, 0x06, 0x0f, 0xa9, 0xa0,
+	0x42, 0xe1, 0xa4, 0x7a, 0xd5, 0xd4, 0xc1, 0x88, 0x0a, 0x41, 0x23, 0x20, 0x2a, 0xf2, 0xf2, 0x35,
+	0x91, 0x2c, 0x86, 0x4c, 0xba, 0x71, 0xd2, 0x10, 0xac, 0x4b, 0xd9, 0xce, 0xbe, 0x8a, 0x63, 0x7d,
+	0x46, 0x4f, 0x66, 0x55, 0xfe, 0x8f, 0x91, 0x9b, 0x85, 0x10, 0xcc, 0x1b, 0x74, 0x99, 0x8a, 0x82,
+	0x05, 0x90, 0x1a, 0xaf, 0xd0, 0x0d, 0x54, 0x2f, 0xee, 0x43, 0x5f, 0x1f, 0xeb, 0xcf, 0xba, 0x2f,
+	0x86, 0xf8, 0x42, 0x89, 0x78, 0xd6, 0x90, 0xec, 0x33, 0xdd, 0x9a, 0xa3, 0xa7, 0x8d, 0x75, 0x4e,
+	0x29, 0xcb, 0xc2, 0xbf, 0xbd, 0xdf, 0x82, 0x04, 0x5f, 0x42, 0x60, 0x0c, 0x11, 0x4a, 0x72, 0x2f,
+	0x62, 0xbe, 0xb3, 0x85, 0x52, 0x65, 0xe9, 0xd8, 0x9d, 0xfa, 0xe7, 0x03, 0x94, 0x57, 0x7d, 0x6c,
+	0x28, 0x20, 0xfd, 0x0f, 0x9f, 0x6f, 0x3a, 0x7a, 0xac, 0x8c, 0x16, 0x2e, 0x8b, 0x2e, 0xb4, 0xfa,
+	0x6f, 0xb9, 0xf1, 0x0e, 0xdd, 0xdf, 0x28, 0xa1, 0x93, 0x73, 0xc9, 0xa2, 0xfe, 0x1d, 0x35, 0x8d,
+	0x01, 0xae, 0xb7, 0x80, 0xdb, 0x2d, 0xe0, 0x4f, 0xed, 0x16, 0xa6, 0x37, 0xfb, 0x1f, 0x23, 0x6d,
+	0xf7, 0x73, 0xa4, 0xdb, 0xdd, 0x5a, 0xb9, 0xaa, 0x84, 0xd6, 0x6b, 0x34, 0x54, 0x65, 0xac, 0xf8,
+	0xe6, 0x36, 0x85, 0x58, 0x1b, 0xf4, 0x48, 0xe9, 0x6d, 0x28, 0xc4, 0x16, 0x82, 0x65, 0xee, 0xd9,
+	0x2e, 0x0f, 0xde, 0x88, 0x38, 0x66, 0xf2, 0x5a, 0x13, 0x18, 0xf5, 0xd2, 0x5a, 0xe6, 0xac, 0x53,
+	0x11, 0x3b, 0x21, 0x30, 0x1a, 0x4a, 0xd5, 0xcb, 0x5d, 0xfb, 0x41, 0x03, 0xcd, 0x53, 0x11, 0xbf,
+	0x57, 0xc0, 0x74, 0xb1, 0x3f, 0x9a, 0xfa, 0xe1, 0x68, 0xea, 0xbf, 0x8e, 0xa6, 0xbe, 0x3b, 0x99,
+	0xda, 0xe1, 0x64, 0x6a, 0xdf, 0x4f, 0xa6, 0xf6, 0xe5, 0x39, 0x65, 0x32, 0xcc, 0x3d, 0xec, 0x8b,
+	0x98, 0x34, 0x07, 0xe1, 0x87, 0x2e, 0xe3, 0x6d, 0x40, 0xbe, 0xfe, 0x39, 0x3b, 0x59, 0x26, 0x90,
+	0x79, 0xf7, 0xd4, 0x88, 0x5e, 0xfe, 0x1e, 0x00, 0x26, 0x83, 0x55, 0x21, 0x05, 0x03, 0x00, 0x00,
 }
 
 func (m *EventSlashedFinalityProvider) Marshal() (dAtA []byte, err error) {
@@ -286,6 +462,109 @@ func (m *EventSluggishFinalityProviderReverted) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *EventJailedFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventJailedFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventJailedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvents(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnjailedFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUnjailedFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnjailedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRevokedPubRandCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRevokedPubRandCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRevokedPubRandCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RevokedFromHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RevokedFromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -336,6 +615,50 @@ func (m *EventSluggishFinalityProviderReverted) Size() (n int) {
 	return n
 }
 
+func (m *EventJailedFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventUnjailedFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRevokedPubRandCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.RevokedFromHeight != 0 {
+		n += 1 + sovEvents(uint64(m.RevokedFromHeight))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -592,6 +915,304 @@ func (m *EventSluggishFinalityProviderReverted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventJailedFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventJailedFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventJailedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUnjailedFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUnjailedFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUnjailedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRevokedPubRandCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRevokedPubRandCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRevokedPubRandCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedFromHeight", wireType)
+			}
+			m.RevokedFromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevokedFromHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"time"
 
 	bbn "github.com/babylonchain/babylon/types"
 	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
@@ -12,6 +13,8 @@ type BTCStakingKeeper interface {
 	GetFinalityProvider(ctx context.Context, fpBTCPK []byte) (*bstypes.FinalityProvider, error)
 	HasFinalityProvider(ctx context.Context, fpBTCPK []byte) bool
 	SlashFinalityProvider(ctx context.Context, fpBTCPK []byte) error
+	JailFinalityProvider(ctx context.Context, fpBTCPK []byte, jailedUntil time.Time) error
+	UnjailFinalityProvider(ctx context.Context, fpBTCPK []byte) error
 	GetVotingPower(ctx context.Context, fpBTCPK []byte, height uint64) uint64
 	GetVotingPowerTable(ctx context.Context, height uint64) map[string]uint64
 	GetBTCStakingActivatedHeight(ctx context.Context) (uint64, error)
@@ -28,6 +31,7 @@ type IncentiveKeeper interface {
 
 type BtcStakingHooks interface {
 	AfterFinalityProviderActivated(ctx context.Context, btcPk *bbn.BIP340PubKey) error
+	AfterFinalityProviderKeyRotated(ctx context.Context, oldBtcPk *bbn.BIP340PubKey, newBtcPk *bbn.BIP340PubKey) error
 }
 
 type FinalityHooks interface {
@@ -22,10 +22,33 @@ func (c *PubRandCommit) GetIndex(height uint64) (uint64, error) {
 	return 0, ErrPubRandNotFound.Wrapf("the given height (%d) is not in range [%d, %d]", height, start, end)
 }
 
+// EndHeight returns the last height that the commitment can be used for.
+// If the commitment is revoked, the heights from RevokedFromHeight on are
+// excluded. A fully revoked commitment thus has EndHeight() < StartHeight
 func (c *PubRandCommit) EndHeight() uint64 {
+	if c.IsRevoked() {
+		return c.RevokedFromHeight - 1
+	}
+	return c.CommittedEndHeight()
+}
+
+// CommittedEndHeight returns the last height that the commitment commits to,
+// regardless of whether the commitment is revoked or not
+func (c *PubRandCommit) CommittedEndHeight() uint64 {
 	return c.StartHeight + c.NumPubRand - 1
 }
 
+// IsRevoked returns whether the commitment is (partially) revoked
+func (c *PubRandCommit) IsRevoked() bool {
+	return c.RevokedFromHeight > 0
+}
+
+// IsFullyRevoked returns whether none of the public randomness in the
+// commitment can be used
+func (c *PubRandCommit) IsFullyRevoked() bool {
+	return c.IsRevoked() && c.RevokedFromHeight <= c.StartHeight
+}
+
 // Range() returns the range of the heights that a public randomness is committed
 // both values are inclusive
 func (c *PubRandCommit) Range() (uint64, uint64) {
@@ -34,8 +57,9 @@ func (c *PubRandCommit) Range() (uint64, uint64) {
 
 func (c *PubRandCommit) ToResponse() *PubRandCommitResponse {
 	return &PubRandCommitResponse{
-		NumPubRand: c.NumPubRand,
-		Commitment: c.Commitment,
+		NumPubRand:        c.NumPubRand,
+		Commitment:        c.Commitment,
+		RevokedFromHeight: c.RevokedFromHeight,
 	}
 }
 
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -100,6 +101,10 @@ type PubRandCommit struct {
 	// commitment is the value of the commitment
 	// currently, it is the root of the merkle tree constructed by the public randomness
 	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// revoked_from_height is the height from which the public randomness in
+	// this commitment is revoked and can no longer be used for voting.
+	// 0 means the commitment is not revoked
+	RevokedFromHeight uint64 `protobuf:"varint,4,opt,name=revoked_from_height,json=revokedFromHeight,proto3" json:"revoked_from_height,omitempty"`
 }
 
 func (m *PubRandCommit) Reset()         { *m = PubRandCommit{} }
@@ -156,6 +161,81 @@ func (m *PubRandCommit) GetCommitment() []byte {
 	return nil
 }
 
+func (m *PubRandCommit) GetRevokedFromHeight() uint64 {
+	if m != nil {
+		return m.RevokedFromHeight
+	}
+	return 0
+}
+
+// RangeProof is a Merkle multi-proof that a contiguous range of leaves is
+// included in a Merkle tree. The Merkle tree follows the same construction
+// as CometBFT's `merkle.HashFromByteSlices`, such that a single RangeProof
+// can prove a range of public randomness against a PubRandCommit.
+type RangeProof struct {
+	// total is the total number of leaves in the Merkle tree
+	Total uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// index is the index of the first leaf in the range
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// aunts are the hashes of all maximal subtrees that do not contain any
+	// leaf in the range, ordered from left to right
+	Aunts [][]byte `protobuf:"bytes,3,rep,name=aunts,proto3" json:"aunts,omitempty"`
+}
+
+func (m *RangeProof) Reset()         { *m = RangeProof{} }
+func (m *RangeProof) String() string { return proto.CompactTextString(m) }
+func (*RangeProof) ProtoMessage()    {}
+func (*RangeProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{2}
+}
+func (m *RangeProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangeProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RangeProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeProof.Merge(m, src)
+}
+func (m *RangeProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangeProof proto.InternalMessageInfo
+
+func (m *RangeProof) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *RangeProof) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *RangeProof) GetAunts() [][]byte {
+	if m != nil {
+		return m.Aunts
+	}
+	return nil
+}
+
 // Evidence is the evidence that a finality provider has signed finality
 // signatures with correct public randomness on two conflicting Babylon headers
 type Evidence struct {
@@ -183,7 +263,7 @@ func (m *Evidence) Reset()         { *m = Evidence{} }
 func (m *Evidence) String() string { return proto.CompactTextString(m) }
 func (*Evidence) ProtoMessage()    {}
 func (*Evidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca5b87e52e3e6d02, []int{2}
+	return fileDescriptor_ca5b87e52e3e6d02, []int{3}
 }
 func (m *Evidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -249,7 +329,7 @@ func (m *FinalityProviderSigningInfo) Reset()         { *m = FinalityProviderSig
 func (m *FinalityProviderSigningInfo) String() string { return proto.CompactTextString(m) }
 func (*FinalityProviderSigningInfo) ProtoMessage()    {}
 func (*FinalityProviderSigningInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca5b87e52e3e6d02, []int{3}
+	return fileDescriptor_ca5b87e52e3e6d02, []int{4}
 }
 func (m *FinalityProviderSigningInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -292,11 +372,226 @@ func (m *FinalityProviderSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+// FinalityProof is a proof that a block is BTC-finalized, which can be
+// verified against a trusted Babylon header without trusting any node
+type FinalityProof struct {
+	// block is the BTC-finalized block, including its AppHash
+	Block *IndexedBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	// proof_block is the Merkle proof that the block is committed
+	// to the AppHash of the header at proof_height
+	ProofBlock *crypto.ProofOps `protobuf:"bytes,2,opt,name=proof_block,json=proofBlock,proto3" json:"proof_block,omitempty"`
+	// voting_powers is the voting power table at the block's height
+	VotingPowers []*VotingPowerWithProof `protobuf:"bytes,3,rep,name=voting_powers,json=votingPowers,proto3" json:"voting_powers,omitempty"`
+	// sigs are the EOTS signatures of the finality providers that
+	// have voted for the block
+	Sigs []*FinalitySigWithProof `protobuf:"bytes,4,rep,name=sigs,proto3" json:"sigs,omitempty"`
+	// proof_height is the height of the Babylon header whose AppHash
+	// all Merkle proofs are verified against
+	ProofHeight uint64 `protobuf:"varint,5,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height,omitempty"`
+}
+
+func (m *FinalityProof) Reset()         { *m = FinalityProof{} }
+func (m *FinalityProof) String() string { return proto.CompactTextString(m) }
+func (*FinalityProof) ProtoMessage()    {}
+func (*FinalityProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{5}
+}
+func (m *FinalityProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProof.Merge(m, src)
+}
+func (m *FinalityProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProof proto.InternalMessageInfo
+
+func (m *FinalityProof) GetBlock() *IndexedBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *FinalityProof) GetProofBlock() *crypto.ProofOps {
+	if m != nil {
+		return m.ProofBlock
+	}
+	return nil
+}
+
+func (m *FinalityProof) GetVotingPowers() []*VotingPowerWithProof {
+	if m != nil {
+		return m.VotingPowers
+	}
+	return nil
+}
+
+func (m *FinalityProof) GetSigs() []*FinalitySigWithProof {
+	if m != nil {
+		return m.Sigs
+	}
+	return nil
+}
+
+func (m *FinalityProof) GetProofHeight() uint64 {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return 0
+}
+
+// VotingPowerWithProof is the voting power of a finality provider at a
+// height with a Merkle proof
+type VotingPowerWithProof struct {
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// voting_power is the voting power of the finality provider
+	VotingPower uint64 `protobuf:"varint,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// proof is the Merkle proof that the voting power is committed
+	// to the AppHash
+	Proof *crypto.ProofOps `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *VotingPowerWithProof) Reset()         { *m = VotingPowerWithProof{} }
+func (m *VotingPowerWithProof) String() string { return proto.CompactTextString(m) }
+func (*VotingPowerWithProof) ProtoMessage()    {}
+func (*VotingPowerWithProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{6}
+}
+func (m *VotingPowerWithProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingPowerWithProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingPowerWithProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotingPowerWithProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingPowerWithProof.Merge(m, src)
+}
+func (m *VotingPowerWithProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingPowerWithProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingPowerWithProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingPowerWithProof proto.InternalMessageInfo
+
+func (m *VotingPowerWithProof) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+func (m *VotingPowerWithProof) GetProof() *crypto.ProofOps {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// FinalitySigWithProof is the EOTS signature of a finality provider on a
+// block with a Merkle proof
+type FinalitySigWithProof struct {
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// sig is the EOTS signature of the finality provider
+	Sig *github_com_babylonchain_babylon_types.SchnorrEOTSSig `protobuf:"bytes,2,opt,name=sig,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrEOTSSig" json:"sig,omitempty"`
+	// proof is the Merkle proof that the EOTS signature is committed
+	// to the AppHash
+	Proof *crypto.ProofOps `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	// pub_rand is the public randomness of the EOTS signature, which has
+	// been verified against the finality provider's public randomness
+	// commitment upon voting
+	PubRand *github_com_babylonchain_babylon_types.SchnorrPubRand `protobuf:"bytes,4,opt,name=pub_rand,json=pubRand,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrPubRand" json:"pub_rand,omitempty"`
+	// proof_pub_rand is the Merkle proof that the public randomness is
+	// committed to the AppHash
+	ProofPubRand *crypto.ProofOps `protobuf:"bytes,5,opt,name=proof_pub_rand,json=proofPubRand,proto3" json:"proof_pub_rand,omitempty"`
+}
+
+func (m *FinalitySigWithProof) Reset()         { *m = FinalitySigWithProof{} }
+func (m *FinalitySigWithProof) String() string { return proto.CompactTextString(m) }
+func (*FinalitySigWithProof) ProtoMessage()    {}
+func (*FinalitySigWithProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{7}
+}
+func (m *FinalitySigWithProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalitySigWithProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalitySigWithProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalitySigWithProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalitySigWithProof.Merge(m, src)
+}
+func (m *FinalitySigWithProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalitySigWithProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalitySigWithProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalitySigWithProof proto.InternalMessageInfo
+
+func (m *FinalitySigWithProof) GetProof() *crypto.ProofOps {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *FinalitySigWithProof) GetProofPubRand() *crypto.ProofOps {
+	if m != nil {
+		return m.ProofPubRand
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*IndexedBlock)(nil), "babylon.finality.v1.IndexedBlock")
 	proto.RegisterType((*PubRandCommit)(nil), "babylon.finality.v1.PubRandCommit")
+	proto.RegisterType((*RangeProof)(nil), "babylon.finality.v1.RangeProof")
 	proto.RegisterType((*Evidence)(nil), "babylon.finality.v1.Evidence")
 	proto.RegisterType((*FinalityProviderSigningInfo)(nil), "babylon.finality.v1.FinalityProviderSigningInfo")
+	proto.RegisterType((*FinalityProof)(nil), "babylon.finality.v1.FinalityProof")
+	proto.RegisterType((*VotingPowerWithProof)(nil), "babylon.finality.v1.VotingPowerWithProof")
+	proto.RegisterType((*FinalitySigWithProof)(nil), "babylon.finality.v1.FinalitySigWithProof")
 }
 
 func init() {
@@ -304,41 +599,59 @@ func init() {
 }
 
 var fileDescriptor_ca5b87e52e3e6d02 = []byte{
-	// 533 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x6f, 0xd3, 0x30,
-	0x18, 0x6e, 0x68, 0x69, 0x3b, 0x37, 0x13, 0x90, 0x8d, 0xa9, 0x7c, 0x28, 0x2b, 0x39, 0xf5, 0x80,
-	0xda, 0x7d, 0x09, 0x71, 0x25, 0xd3, 0xd0, 0x0a, 0x07, 0x2a, 0x87, 0x13, 0x17, 0xcb, 0x71, 0xdc,
-	0xc4, 0x6a, 0x63, 0x5b, 0x89, 0x53, 0xad, 0xfc, 0x0a, 0x7e, 0xd6, 0x8e, 0x3b, 0xa2, 0x1d, 0x26,
-	0xd4, 0xfe, 0x0f, 0x84, 0xe2, 0xa4, 0xe9, 0x7a, 0x02, 0x81, 0xb8, 0xc5, 0xef, 0xfb, 0xea, 0xf9,
-	0x78, 0xfd, 0x38, 0xc0, 0xf1, 0xb1, 0xbf, 0x98, 0x09, 0x3e, 0x9c, 0x30, 0x8e, 0x67, 0x4c, 0x2d,
-	0x86, 0xf3, 0xe3, 0xea, 0x7b, 0x20, 0x13, 0xa1, 0x84, 0xb5, 0x57, 0xce, 0x0c, 0xaa, 0xfa, 0xfc,
-	0xf8, 0xf9, 0x7e, 0x28, 0x42, 0xa1, 0xfb, 0xc3, 0xfc, 0xab, 0x18, 0x75, 0x10, 0x30, 0x47, 0x3c,
-	0xa0, 0x57, 0x34, 0x70, 0x67, 0x82, 0x4c, 0xad, 0x03, 0xd0, 0x8c, 0x28, 0x0b, 0x23, 0xd5, 0x35,
-	0x7a, 0x46, 0xbf, 0x01, 0xcb, 0x93, 0xf5, 0x0c, 0xb4, 0xb1, 0x94, 0x28, 0xc2, 0x69, 0xd4, 0x7d,
-	0xd0, 0x33, 0xfa, 0x26, 0x6c, 0x61, 0x29, 0x2f, 0x71, 0x1a, 0x59, 0x2f, 0xc1, 0x4e, 0xc1, 0xf3,
-	0x95, 0x06, 0xdd, 0x7a, 0xcf, 0xe8, 0xb7, 0xe1, 0xa6, 0xe0, 0x28, 0xb0, 0x3b, 0xce, 0x7c, 0x88,
-	0x79, 0x70, 0x2e, 0xe2, 0x98, 0x29, 0xeb, 0x15, 0x30, 0x53, 0x85, 0x13, 0x85, 0xb6, 0x78, 0x3a,
-	0xba, 0x76, 0x59, 0x90, 0xf5, 0x80, 0xc9, 0xb3, 0x18, 0xc9, 0xcc, 0x47, 0x09, 0xe6, 0x81, 0x26,
-	0x6c, 0x40, 0xc0, 0xb3, 0xb8, 0x84, 0xb2, 0x6c, 0x00, 0x88, 0x86, 0x8b, 0x29, 0x57, 0x9a, 0xd4,
-	0x84, 0xf7, 0x2a, 0xce, 0xcf, 0x3a, 0x68, 0x5f, 0xcc, 0x59, 0x40, 0x39, 0xa1, 0x16, 0x04, 0x3b,
-	0x13, 0x89, 0x7c, 0x45, 0x90, 0x9c, 0x6a, 0x3a, 0xd3, 0x7d, 0x73, 0x7b, 0x77, 0x78, 0x12, 0x32,
-	0x15, 0x65, 0xfe, 0x80, 0x88, 0x78, 0x58, 0x2e, 0x8c, 0x44, 0x98, 0xf1, 0xf5, 0x61, 0xa8, 0x16,
-	0x92, 0xa6, 0x03, 0x77, 0x34, 0x3e, 0x3d, 0x3b, 0x1a, 0x67, 0xfe, 0x47, 0xba, 0x80, 0xad, 0x89,
-	0x74, 0x15, 0x19, 0x4f, 0x73, 0x17, 0x7e, 0xbe, 0xb0, 0xb5, 0x8b, 0x42, 0x62, 0x47, 0xd7, 0x4a,
-	0x17, 0x1e, 0x68, 0x57, 0x0e, 0xb4, 0x42, 0xf7, 0xed, 0xed, 0xdd, 0xe1, 0xd9, 0x9f, 0xb1, 0x7a,
-	0x24, 0xe2, 0x22, 0x49, 0x4a, 0xbf, 0xb0, 0x25, 0x4b, 0xe3, 0xaf, 0x81, 0x45, 0x30, 0x17, 0x9c,
-	0x11, 0x3c, 0x43, 0xd5, 0x8d, 0x34, 0xf4, 0x02, 0x1e, 0x57, 0x9d, 0x77, 0xe5, 0xd5, 0x38, 0x60,
-	0x77, 0x22, 0x92, 0xe9, 0x66, 0xf0, 0xa1, 0x1e, 0xec, 0xe4, 0xc5, 0xf5, 0x0c, 0x07, 0x07, 0x1b,
-	0xc4, 0x75, 0x60, 0x50, 0xca, 0xc2, 0x6e, 0xf3, 0x2f, 0x45, 0x5f, 0x7c, 0xfa, 0xec, 0x79, 0x2c,
-	0x84, 0xfb, 0x15, 0xee, 0xfb, 0x12, 0xd6, 0x63, 0xa1, 0x15, 0x80, 0x27, 0x5a, 0xd3, 0x16, 0x55,
-	0xeb, 0x1f, 0xa9, 0x1e, 0xe5, 0x90, 0xf7, 0x58, 0x9c, 0x6b, 0x03, 0xbc, 0x58, 0x9f, 0xc7, 0x89,
-	0xc8, 0xa3, 0x90, 0x78, 0x2c, 0xe4, 0x8c, 0x87, 0x23, 0x3e, 0x11, 0xff, 0x2b, 0x13, 0x5b, 0xc9,
-	0xce, 0x33, 0x51, 0xdf, 0x4e, 0xf6, 0x09, 0x78, 0x1a, 0xb3, 0x34, 0xa5, 0x01, 0xd2, 0x49, 0x49,
-	0x11, 0x11, 0x19, 0x57, 0x34, 0xd1, 0x01, 0xa9, 0xc3, 0xbd, 0xa2, 0xa9, 0x9f, 0x62, 0x7a, 0x5e,
-	0xb4, 0xdc, 0x0f, 0xd7, 0x4b, 0xdb, 0xb8, 0x59, 0xda, 0xc6, 0x8f, 0xa5, 0x6d, 0x7c, 0x5b, 0xd9,
-	0xb5, 0x9b, 0x95, 0x5d, 0xfb, 0xbe, 0xb2, 0x6b, 0x5f, 0x8e, 0x7e, 0xa7, 0xf6, 0x6a, 0xf3, 0x97,
-	0xd0, 0xc2, 0xfd, 0xa6, 0x7e, 0xf5, 0xa7, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x32, 0x14,
-	0xff, 0x46, 0x04, 0x00, 0x00,
+	// 827 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0x4d, 0xc9, 0x52, 0x46, 0x54, 0xdb, 0xd0, 0x6a, 0xa0, 0x26, 0xad, 0xa2, 0xf0, 0xa4,
+	0x02, 0x05, 0x15, 0x2b, 0x41, 0xdb, 0x43, 0x7b, 0x88, 0x82, 0x04, 0xb1, 0x0b, 0xd8, 0xc2, 0xaa,
+	0x68, 0x81, 0x5e, 0x08, 0xfe, 0x2c, 0xc9, 0x85, 0xc4, 0x5d, 0x62, 0xb9, 0x54, 0xad, 0xde, 0xfa,
+	0x06, 0x7d, 0x88, 0x3e, 0x44, 0x8f, 0x3d, 0xfa, 0xe8, 0x63, 0xe1, 0x83, 0x51, 0xd8, 0xef, 0x51,
+	0x14, 0xdc, 0xa5, 0xfe, 0x00, 0xa1, 0x2e, 0xec, 0xf8, 0xa6, 0x9d, 0x1d, 0x7e, 0xdf, 0x37, 0xdf,
+	0xce, 0x8c, 0x0d, 0x96, 0xe7, 0x7a, 0xf3, 0x29, 0xa3, 0xfd, 0x90, 0x50, 0x77, 0x4a, 0xc4, 0xbc,
+	0x3f, 0x3b, 0x58, 0xfe, 0xb6, 0x53, 0xce, 0x04, 0x33, 0xf7, 0xcb, 0x1c, 0x7b, 0x19, 0x9f, 0x1d,
+	0x3c, 0x6e, 0x45, 0x2c, 0x62, 0xf2, 0xbe, 0x5f, 0xfc, 0x52, 0xa9, 0x8f, 0x3f, 0x13, 0x98, 0x06,
+	0x98, 0x27, 0x84, 0x8a, 0xbe, 0xcf, 0xe7, 0xa9, 0x60, 0xfd, 0x94, 0x33, 0x16, 0xaa, 0x6b, 0xcb,
+	0x01, 0xe3, 0x90, 0x06, 0xf8, 0x14, 0x07, 0xc3, 0x29, 0xf3, 0x27, 0xe6, 0x23, 0xd8, 0x8b, 0x31,
+	0x89, 0x62, 0xd1, 0xd6, 0xba, 0x5a, 0xaf, 0x82, 0xca, 0x93, 0xf9, 0x09, 0xd4, 0xdd, 0x34, 0x75,
+	0x62, 0x37, 0x8b, 0xdb, 0xbb, 0x5d, 0xad, 0x67, 0xa0, 0x9a, 0x9b, 0xa6, 0xef, 0xdc, 0x2c, 0x36,
+	0x3f, 0x85, 0x07, 0x4a, 0xc6, 0x2f, 0x38, 0x68, 0xeb, 0x5d, 0xad, 0x57, 0x47, 0xab, 0x80, 0xf5,
+	0xbb, 0x06, 0xcd, 0x51, 0xee, 0x21, 0x97, 0x06, 0xaf, 0x59, 0x92, 0x10, 0x61, 0x3e, 0x03, 0x23,
+	0x13, 0x2e, 0x17, 0xce, 0x06, 0x51, 0x43, 0xc6, 0xde, 0x29, 0xb6, 0x2e, 0x18, 0x34, 0x4f, 0x9c,
+	0x34, 0xf7, 0x1c, 0xee, 0xd2, 0x40, 0x32, 0x56, 0x10, 0xd0, 0x3c, 0x29, 0xa1, 0xcc, 0x0e, 0x80,
+	0x2f, 0xe1, 0x12, 0x4c, 0x85, 0x64, 0x35, 0xd0, 0x5a, 0xc4, 0xb4, 0x61, 0x9f, 0xe3, 0x19, 0x9b,
+	0xe0, 0xc0, 0x09, 0x39, 0x4b, 0x16, 0x5c, 0x15, 0x09, 0xf4, 0xb0, 0xbc, 0x7a, 0xcb, 0x59, 0xa2,
+	0x18, 0xad, 0x63, 0x00, 0xe4, 0xd2, 0x08, 0x8f, 0x0a, 0x6f, 0xcc, 0x16, 0x54, 0x05, 0x13, 0xee,
+	0xb4, 0xd4, 0xa6, 0x0e, 0x45, 0x94, 0x14, 0x5e, 0x95, 0x72, 0xd4, 0xa1, 0x88, 0xba, 0x39, 0x15,
+	0x59, 0x5b, 0xef, 0xea, 0x3d, 0x03, 0xa9, 0x83, 0xf5, 0x8f, 0x0e, 0xf5, 0x37, 0x33, 0x12, 0x60,
+	0xea, 0x63, 0x13, 0xc1, 0x83, 0x30, 0x75, 0x3c, 0xe1, 0x3b, 0xe9, 0x44, 0x42, 0x1a, 0xc3, 0x2f,
+	0x2f, 0x2e, 0x9f, 0x0e, 0x22, 0x22, 0xe2, 0xdc, 0xb3, 0x7d, 0x96, 0xf4, 0xcb, 0x07, 0xf5, 0x63,
+	0x97, 0xd0, 0xc5, 0xa1, 0x2f, 0xe6, 0x29, 0xce, 0xec, 0xe1, 0xe1, 0xe8, 0xc5, 0xcb, 0xe7, 0xa3,
+	0xdc, 0xfb, 0x0e, 0xcf, 0x51, 0x2d, 0x4c, 0x87, 0xc2, 0x1f, 0x4d, 0x0a, 0x17, 0xbd, 0xe2, 0xc5,
+	0x16, 0x95, 0x29, 0x4d, 0x0d, 0x19, 0x2b, 0x5d, 0x1c, 0x43, 0x7d, 0xe9, 0xa0, 0x74, 0x68, 0xf8,
+	0xf5, 0xc5, 0xe5, 0xd3, 0x97, 0xff, 0x8f, 0x75, 0xec, 0xc7, 0x94, 0x71, 0x5e, 0xfa, 0x8d, 0x6a,
+	0x69, 0x69, 0xfc, 0x17, 0x60, 0xfa, 0x2e, 0x65, 0x94, 0xf8, 0xee, 0xd4, 0x59, 0xb6, 0x44, 0x45,
+	0x3e, 0xc0, 0x47, 0xcb, 0x9b, 0x57, 0x65, 0x6f, 0x58, 0xd0, 0x0c, 0x19, 0x9f, 0xac, 0x12, 0xab,
+	0x32, 0xb1, 0x51, 0x04, 0x17, 0x39, 0x14, 0x1e, 0xad, 0x10, 0x17, 0x0d, 0xed, 0x64, 0x24, 0x6a,
+	0xef, 0xdd, 0x52, 0xf4, 0x9b, 0x93, 0xef, 0xc7, 0x63, 0x12, 0xa1, 0xd6, 0x12, 0xf7, 0x6d, 0x09,
+	0x3b, 0x26, 0x91, 0x19, 0xc0, 0x43, 0xa9, 0x69, 0x83, 0xaa, 0x76, 0x47, 0xaa, 0x0f, 0x0b, 0xc8,
+	0x35, 0x16, 0xeb, 0x4c, 0x83, 0x27, 0x8b, 0xf3, 0x88, 0xb3, 0xa2, 0x15, 0xf8, 0x98, 0x44, 0x94,
+	0xd0, 0xe8, 0x90, 0x86, 0xec, 0xbe, 0x7a, 0x62, 0x63, 0xb2, 0x8a, 0x9e, 0xd0, 0x37, 0x27, 0x6b,
+	0x00, 0x1f, 0x27, 0x24, 0xcb, 0x70, 0xe0, 0xc8, 0x4e, 0xc9, 0x1c, 0x9f, 0xe5, 0x54, 0x60, 0x2e,
+	0x1b, 0x44, 0x47, 0xfb, 0xea, 0x52, 0xee, 0x82, 0xec, 0xb5, 0xba, 0xb2, 0xfe, 0xd8, 0x85, 0xe6,
+	0x5a, 0x29, 0x2c, 0x34, 0xbf, 0x82, 0xaa, 0xfc, 0x5c, 0x0a, 0x6f, 0x0c, 0x9e, 0xd9, 0x5b, 0xf6,
+	0x91, 0xbd, 0xbe, 0x57, 0x90, 0xca, 0x37, 0xbf, 0x81, 0x86, 0xdc, 0x3e, 0x8a, 0x5d, 0x0a, 0x6c,
+	0x0c, 0x9e, 0xd8, 0xab, 0x1d, 0x65, 0xab, 0x1d, 0x65, 0x4b, 0x9e, 0x93, 0x34, 0x43, 0x20, 0xf3,
+	0xd5, 0x72, 0x3a, 0x86, 0xe6, 0x8c, 0x09, 0x42, 0x23, 0x27, 0x65, 0x3f, 0x63, 0xae, 0x46, 0xae,
+	0x31, 0xf8, 0x7c, 0x2b, 0xfd, 0x0f, 0x32, 0x73, 0x54, 0x24, 0xfe, 0x48, 0x44, 0x2c, 0x01, 0x91,
+	0x31, 0x5b, 0x45, 0x33, 0xf3, 0x5b, 0xa8, 0x64, 0x24, 0xca, 0xda, 0x95, 0xff, 0x80, 0x59, 0x7b,
+	0xd3, 0x15, 0x8c, 0xfc, 0xac, 0xb0, 0x5b, 0x15, 0x53, 0xda, 0x5d, 0x55, 0x23, 0x28, 0x63, 0xe5,
+	0x5a, 0xf9, 0x53, 0x83, 0xd6, 0x36, 0x21, 0xf7, 0xf5, 0xfc, 0xeb, 0xf6, 0x2c, 0x56, 0xc2, 0x5a,
+	0xc9, 0xe6, 0x01, 0x54, 0xa5, 0xbc, 0xb6, 0x7e, 0xb3, 0xf3, 0x2a, 0xd3, 0xfa, 0x55, 0x87, 0xd6,
+	0x36, 0x13, 0xee, 0xa5, 0x84, 0x23, 0xd0, 0x8b, 0x69, 0xdc, 0xbd, 0xe3, 0x34, 0x16, 0x20, 0xb7,
+	0xa8, 0x75, 0x63, 0x63, 0x56, 0xde, 0xd7, 0xc6, 0x7c, 0x05, 0x1f, 0xa8, 0x36, 0x59, 0x42, 0x57,
+	0x6f, 0x16, 0xa4, 0x3a, 0xab, 0xc4, 0x1a, 0x1e, 0x9d, 0x5d, 0x75, 0xb4, 0xf3, 0xab, 0x8e, 0xf6,
+	0xf7, 0x55, 0x47, 0xfb, 0xed, 0xba, 0xb3, 0x73, 0x7e, 0xdd, 0xd9, 0xf9, 0xeb, 0xba, 0xb3, 0xf3,
+	0xd3, 0xf3, 0x9b, 0xb4, 0x9d, 0xae, 0xfe, 0x8f, 0x90, 0x32, 0xbd, 0x3d, 0xf9, 0x87, 0xff, 0xc5,
+	0xbf, 0x03, 0x00, 0x31, 0xeb, 0xb0, 0x68, 0x68, 0x08, 0x00, 0x00,
 }
 
 func (m *IndexedBlock) Marshal() (dAtA []byte, err error) {
@@ -406,6 +719,11 @@ func (m *PubRandCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RevokedFromHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.RevokedFromHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Commitment) > 0 {
 		i -= len(m.Commitment)
 		copy(dAtA[i:], m.Commitment)
@@ -426,6 +744,48 @@ func (m *PubRandCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RangeProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aunts) > 0 {
+		for iNdEx := len(m.Aunts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aunts[iNdEx])
+			copy(dAtA[i:], m.Aunts[iNdEx])
+			i = encodeVarintFinality(dAtA, i, uint64(len(m.Aunts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Index != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Total != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Evidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -561,42 +921,257 @@ func (m *FinalityProviderSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFinality(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFinality(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FinalityProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *IndexedBlock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *FinalityProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovFinality(uint64(m.Height))
+	if m.ProofHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.ProofHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Sigs) > 0 {
+		for iNdEx := len(m.Sigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFinality(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.AppHash)
-	if l > 0 {
-		n += 1 + l + sovFinality(uint64(l))
+	if len(m.VotingPowers) > 0 {
+		for iNdEx := len(m.VotingPowers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFinality(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Finalized {
-		n += 2
+	if m.ProofBlock != nil {
+		{
+			size, err := m.ProofBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PubRandCommit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VotingPowerWithProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	return dAtA[:n], nil
+}
+
+func (m *VotingPowerWithProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingPowerWithProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.VotingPower != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalitySigWithProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalitySigWithProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalitySigWithProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProofPubRand != nil {
+		{
+			size, err := m.ProofPubRand.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PubRand != nil {
+		{
+			size := m.PubRand.Size()
+			i -= size
+			if _, err := m.PubRand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sig != nil {
+		{
+			size := m.Sig.Size()
+			i -= size
+			if _, err := m.Sig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFinality(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFinality(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IndexedBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFinality(uint64(m.Height))
+	}
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	return n
+}
+
+func (m *PubRandCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.StartHeight != 0 {
 		n += 1 + sovFinality(uint64(m.StartHeight))
 	}
@@ -607,6 +1182,30 @@ func (m *PubRandCommit) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovFinality(uint64(l))
 	}
+	if m.RevokedFromHeight != 0 {
+		n += 1 + sovFinality(uint64(m.RevokedFromHeight))
+	}
+	return n
+}
+
+func (m *RangeProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovFinality(uint64(m.Total))
+	}
+	if m.Index != 0 {
+		n += 1 + sovFinality(uint64(m.Index))
+	}
+	if len(m.Aunts) > 0 {
+		for _, b := range m.Aunts {
+			l = len(b)
+			n += 1 + l + sovFinality(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -665,6 +1264,87 @@ func (m *FinalityProviderSigningInfo) Size() (n int) {
 	return n
 }
 
+func (m *FinalityProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.ProofBlock != nil {
+		l = m.ProofBlock.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if len(m.VotingPowers) > 0 {
+		for _, e := range m.VotingPowers {
+			l = e.Size()
+			n += 1 + l + sovFinality(uint64(l))
+		}
+	}
+	if len(m.Sigs) > 0 {
+		for _, e := range m.Sigs {
+			l = e.Size()
+			n += 1 + l + sovFinality(uint64(l))
+		}
+	}
+	if m.ProofHeight != 0 {
+		n += 1 + sovFinality(uint64(m.ProofHeight))
+	}
+	return n
+}
+
+func (m *VotingPowerWithProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovFinality(uint64(m.VotingPower))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	return n
+}
+
+func (m *FinalitySigWithProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.Sig != nil {
+		l = m.Sig.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.PubRand != nil {
+		l = m.PubRand.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.ProofPubRand != nil {
+		l = m.ProofPubRand.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	return n
+}
+
 func sovFinality(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -895,6 +1575,25 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 				m.Commitment = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedFromHeight", wireType)
+			}
+			m.RevokedFromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevokedFromHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFinality(dAtA[iNdEx:])
@@ -916,7 +1615,7 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Evidence) Unmarshal(dAtA []byte) error {
+func (m *RangeProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -939,17 +1638,17 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: RangeProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RangeProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var byteLen int
+			m.Total = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -959,30 +1658,150 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Total |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthFinality
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFinality
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.FpBtcPk = &v
-			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aunts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aunts = append(m.Aunts, make([]byte, postIndex-iNdEx))
+			copy(m.Aunts[len(m.Aunts)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Evidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
 			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
@@ -1316,6 +2135,582 @@ func (m *FinalityProviderSigningInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FinalityProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &IndexedBlock{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProofBlock == nil {
+				m.ProofBlock = &crypto.ProofOps{}
+			}
+			if err := m.ProofBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPowers = append(m.VotingPowers, &VotingPowerWithProof{})
+			if err := m.VotingPowers[len(m.VotingPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sigs = append(m.Sigs, &FinalitySigWithProof{})
+			if err := m.Sigs[len(m.Sigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			m.ProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotingPowerWithProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotingPowerWithProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotingPowerWithProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.ProofOps{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalitySigWithProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalitySigWithProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalitySigWithProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
+			m.Sig = &v
+			if err := m.Sig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.ProofOps{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrPubRand
+			m.PubRand = &v
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofPubRand", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProofPubRand == nil {
+				m.ProofPubRand = &crypto.ProofOps{}
+			}
+			if err := m.ProofPubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipFinality(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
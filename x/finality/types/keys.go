@@ -2,6 +2,7 @@ package types
 
 import (
 	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 
 	"github.com/babylonchain/babylon/types"
@@ -61,3 +62,27 @@ func FinalityProviderSigningInfoKey(pk *types.BIP340PubKey) []byte {
 func FinalityProviderMissedBlockBitmapKey(pk *types.BIP340PubKey) []byte {
 	return append(FinalityProviderMissedBlockBitmapKeyPrefix, address.MustLengthPrefix(pk.MustMarshal())...)
 }
+
+// GetBlockKey returns the key of the IndexedBlock at the given height in the
+// finality store
+func GetBlockKey(height uint64) []byte {
+	key := append([]byte{}, BlockKey...)
+	return append(key, sdk.Uint64ToBigEndian(height)...)
+}
+
+// GetVoteKey returns the key of the finality provider's EOTS signature on the
+// block at the given height in the finality store
+func GetVoteKey(height uint64, fpBtcPK *types.BIP340PubKey) []byte {
+	key := append([]byte{}, VoteKey...)
+	key = append(key, sdk.Uint64ToBigEndian(height)...)
+	return append(key, fpBtcPK.MustMarshal()...)
+}
+
+// GetPubRandKey returns the key of the public randomness that the finality
+// provider has used for voting on the block at the given height in the
+// finality store
+func GetPubRandKey(height uint64, fpBtcPK *types.BIP340PubKey) []byte {
+	key := append([]byte{}, PubRandKey...)
+	key = append(key, fpBtcPK.MustMarshal()...)
+	return append(key, sdk.Uint64ToBigEndian(height)...)
+}
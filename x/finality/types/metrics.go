@@ -7,8 +7,11 @@ import (
 
 // performance oriented metrics measuring the execution time of each message
 const (
-	MetricsKeyCommitPubRandList = "commit_pub_rand_list"
-	MetricsKeyAddFinalitySig    = "add_finality_sig"
+	MetricsKeyCommitPubRandList      = "commit_pub_rand_list"
+	MetricsKeyRevokePubRandCommit    = "revoke_pub_rand_commit"
+	MetricsKeyAddFinalitySig         = "add_finality_sig"
+	MetricsKeyAddFinalitySigs        = "add_finality_sigs"
+	MetricsKeyUnjailFinalityProvider = "unjail_finality_provider"
 )
 
 const (
@@ -7,6 +7,7 @@ package types
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	types "github.com/babylonchain/babylon/types"
 	types0 "github.com/babylonchain/babylon/x/btcstaking/types"
@@ -151,6 +152,20 @@ func (mr *MockBTCStakingKeeperMockRecorder) HasFinalityProvider(ctx, fpBTCPK int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).HasFinalityProvider), ctx, fpBTCPK)
 }
 
+// JailFinalityProvider mocks base method.
+func (m *MockBTCStakingKeeper) JailFinalityProvider(ctx context.Context, fpBTCPK []byte, jailedUntil time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JailFinalityProvider", ctx, fpBTCPK, jailedUntil)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JailFinalityProvider indicates an expected call of JailFinalityProvider.
+func (mr *MockBTCStakingKeeperMockRecorder) JailFinalityProvider(ctx, fpBTCPK, jailedUntil interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).JailFinalityProvider), ctx, fpBTCPK, jailedUntil)
+}
+
 // RemoveVotingPowerDistCache mocks base method.
 func (m *MockBTCStakingKeeper) RemoveVotingPowerDistCache(ctx context.Context, height uint64) {
 	m.ctrl.T.Helper()
@@ -191,6 +206,20 @@ func (mr *MockBTCStakingKeeperMockRecorder) SlashFinalityProvider(ctx, fpBTCPK i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).SlashFinalityProvider), ctx, fpBTCPK)
 }
 
+// UnjailFinalityProvider mocks base method.
+func (m *MockBTCStakingKeeper) UnjailFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnjailFinalityProvider", ctx, fpBTCPK)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnjailFinalityProvider indicates an expected call of UnjailFinalityProvider.
+func (mr *MockBTCStakingKeeperMockRecorder) UnjailFinalityProvider(ctx, fpBTCPK interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnjailFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).UnjailFinalityProvider), ctx, fpBTCPK)
+}
+
 // MockIncentiveKeeper is a mock of IncentiveKeeper interface.
 type MockIncentiveKeeper struct {
 	ctrl     *gomock.Controller
@@ -263,6 +292,20 @@ func (mr *MockBtcStakingHooksMockRecorder) AfterFinalityProviderActivated(ctx, b
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterFinalityProviderActivated", reflect.TypeOf((*MockBtcStakingHooks)(nil).AfterFinalityProviderActivated), ctx, btcPk)
 }
 
+// AfterFinalityProviderKeyRotated mocks base method.
+func (m *MockBtcStakingHooks) AfterFinalityProviderKeyRotated(ctx context.Context, oldBtcPk, newBtcPk *types.BIP340PubKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterFinalityProviderKeyRotated", ctx, oldBtcPk, newBtcPk)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterFinalityProviderKeyRotated indicates an expected call of AfterFinalityProviderKeyRotated.
+func (mr *MockBtcStakingHooksMockRecorder) AfterFinalityProviderKeyRotated(ctx, oldBtcPk, newBtcPk interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterFinalityProviderKeyRotated", reflect.TypeOf((*MockBtcStakingHooks)(nil).AfterFinalityProviderKeyRotated), ctx, oldBtcPk, newBtcPk)
+}
+
 // MockFinalityHooks is a mock of FinalityHooks interface.
 type MockFinalityHooks struct {
 	ctrl     *gomock.Controller
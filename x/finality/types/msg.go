@@ -13,7 +13,10 @@ import (
 var (
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgAddFinalitySig{}
+	_ sdk.Msg = &MsgAddFinalitySigs{}
 	_ sdk.Msg = &MsgCommitPubRandList{}
+	_ sdk.Msg = &MsgRevokePubRandCommit{}
+	_ sdk.Msg = &MsgUnjailFinalityProvider{}
 )
 
 func (m *MsgAddFinalitySig) MsgToSign() []byte {
@@ -52,6 +55,80 @@ func VerifyFinalitySig(m *MsgAddFinalitySig, prCommit *PubRandCommit) error {
 	return eots.Verify(pk, m.PubRand.ToFieldVal(), msgToSign, m.FinalitySig.ToModNScalar())
 }
 
+// EndHeight returns the height of the last voted block in the batch
+func (m *MsgAddFinalitySigs) EndHeight() uint64 {
+	return m.StartHeight + uint64(len(m.PubRandList)) - 1
+}
+
+// MsgToSign returns the message for the EOTS signature at the given index
+func (m *MsgAddFinalitySigs) MsgToSign(idx int) []byte {
+	return msgToSignForVote(m.StartHeight+uint64(idx), m.BlockAppHashList[idx])
+}
+
+// ValidateBasic performs stateless checks on the batch, i.e., the batch is
+// non-empty and all lists have the same length
+func (m *MsgAddFinalitySigs) ValidateBasic() error {
+	if m.FpBtcPk == nil {
+		return ErrInvalidFinalitySig.Wrap("empty finality provider BTC PK")
+	}
+	if m.Proof == nil {
+		return ErrInvalidFinalitySig.Wrap("empty proof")
+	}
+	numSigs := len(m.PubRandList)
+	if numSigs == 0 {
+		return ErrInvalidFinalitySig.Wrap("empty batch")
+	}
+	if len(m.BlockAppHashList) != numSigs || len(m.FinalitySigList) != numSigs {
+		return ErrInvalidFinalitySig.Wrapf(
+			"mismatched lengths of public randomness (%d), block app hashes (%d) and finality signatures (%d)",
+			numSigs, len(m.BlockAppHashList), len(m.FinalitySigList))
+	}
+	if m.EndHeight() < m.StartHeight {
+		return ErrInvalidFinalitySig.Wrap("the range of heights overflows")
+	}
+	return nil
+}
+
+// VerifyFinalitySigs verifies the batch of finality signatures w.r.t. the
+// public randomness commitment. The verification includes
+// - verifying the proof of inclusion of the given range of public randomness
+// - verifying each finality signature w.r.t. the given block height/hash
+func VerifyFinalitySigs(m *MsgAddFinalitySigs, prCommit *PubRandCommit) error {
+	// verify the range of the public randomness
+	if !prCommit.IsInRange(m.StartHeight) || !prCommit.IsInRange(m.EndHeight()) {
+		startHeight, endHeight := prCommit.Range()
+		return ErrInvalidFinalitySig.Wrapf("the heights [%d, %d] are not in the range [%d, %d] of the public randomness commitment", m.StartHeight, m.EndHeight(), startHeight, endHeight)
+	}
+	heightOfProof := prCommit.StartHeight + m.Proof.Index
+	if m.StartHeight != heightOfProof {
+		return ErrInvalidFinalitySig.Wrapf("the inclusion proof (for height %d) does not correspond to the start height (%d) in the message", heightOfProof, m.StartHeight)
+	}
+	// verify the total number of randomness is same as in the commit
+	if m.Proof.Total != prCommit.NumPubRand {
+		return ErrInvalidFinalitySig.Wrapf("the total number of public randomnesses in the proof (%d) does not match the number of public randomnesses committed (%d)", m.Proof.Total, prCommit.NumPubRand)
+	}
+	// verify the proof of inclusion for the public randomness list
+	prByteList := make([][]byte, 0, len(m.PubRandList))
+	for i := range m.PubRandList {
+		prByteList = append(prByteList, m.PubRandList[i])
+	}
+	if err := m.Proof.Verify(prCommit.Commitment, prByteList); err != nil {
+		return ErrInvalidFinalitySig.Wrapf("the inclusion proof of the public randomness list is invalid: %v", err)
+	}
+
+	// public randomness is good, verify each finality signature
+	pk, err := m.FpBtcPk.ToBTCPK()
+	if err != nil {
+		return err
+	}
+	for i := range m.FinalitySigList {
+		if err := eots.Verify(pk, m.PubRandList[i].ToFieldVal(), m.MsgToSign(i), m.FinalitySigList[i].ToModNScalar()); err != nil {
+			return ErrInvalidFinalitySig.Wrapf("invalid finality signature at height %d: %v", m.StartHeight+uint64(i), err)
+		}
+	}
+	return nil
+}
+
 // HashToSign returns a 32-byte hash of (start_height || num_pub_rand || commitment)
 // The signature in MsgCommitPubRandList will be on this hash
 func (m *MsgCommitPubRandList) HashToSign() ([]byte, error) {
@@ -89,3 +166,40 @@ func (m *MsgCommitPubRandList) VerifySig() error {
 	}
 	return nil
 }
+
+// HashToSign returns the hash of (revoked_from_height || commitment), where
+// commitment is the value of the public randomness commitment to revoke
+func (m *MsgRevokePubRandCommit) HashToSign(commitment []byte) ([]byte, error) {
+	hasher := tmhash.New()
+	if _, err := hasher.Write(sdk.Uint64ToBigEndian(m.RevokedFromHeight)); err != nil {
+		return nil, err
+	}
+	if _, err := hasher.Write(commitment); err != nil {
+		return nil, err
+	}
+	return hasher.Sum(nil), nil
+}
+
+// VerifySig verifies the signature over the revocation of the public
+// randomness commitment with the given commitment value
+func (m *MsgRevokePubRandCommit) VerifySig(commitment []byte) error {
+	msgHash, err := m.HashToSign(commitment)
+	if err != nil {
+		return err
+	}
+	pk, err := m.FpBtcPk.ToBTCPK()
+	if err != nil {
+		return err
+	}
+	if m.Sig == nil {
+		return fmt.Errorf("empty signature")
+	}
+	schnorrSig, err := m.Sig.ToBTCSig()
+	if err != nil {
+		return err
+	}
+	if !schnorrSig.Verify(msgHash, pk) {
+		return fmt.Errorf("failed to verify signature")
+	}
+	return nil
+}
@@ -43,6 +43,50 @@ func FuzzMsgAddFinalitySig(f *testing.F) {
 	})
 }
 
+func FuzzMsgAddFinalitySigs(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		sk, err := eots.KeyGen(r)
+		require.NoError(t, err)
+
+		numPubRand := uint64(100)
+		randListInfo, err := datagen.GenRandomPubRandList(r, numPubRand)
+		require.NoError(t, err)
+
+		startHeight := datagen.RandomInt(r, 10)
+		blockHeight := startHeight + datagen.RandomInt(r, 10)
+		numSigs := datagen.RandomInt(r, 50) + 1
+		blockHashList := [][]byte{}
+		for i := uint64(0); i < numSigs; i++ {
+			blockHashList = append(blockHashList, datagen.GenRandomByteArray(r, 32))
+		}
+
+		signer := datagen.GenRandomAccount().Address
+		msg, err := datagen.NewMsgAddFinalitySigs(signer, sk, startHeight, blockHeight, randListInfo, blockHashList)
+		require.NoError(t, err)
+		require.NoError(t, msg.ValidateBasic())
+
+		prCommit := &types.PubRandCommit{
+			StartHeight: startHeight,
+			NumPubRand:  numPubRand,
+			Commitment:  randListInfo.Commitment,
+		}
+
+		// verify the batch of finality signatures
+		err = types.VerifyFinalitySigs(msg, prCommit)
+		require.NoError(t, err)
+
+		// a tampered finality signature should fail the verification
+		idx := datagen.RandomInt(r, int(numSigs))
+		msg.BlockAppHashList[idx] = datagen.GenRandomByteArray(r, 32)
+		err = types.VerifyFinalitySigs(msg, prCommit)
+		require.ErrorIs(t, err, types.ErrInvalidFinalitySig)
+	})
+}
+
 func FuzzMsgCommitPubRandList(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
@@ -62,3 +106,27 @@ func FuzzMsgCommitPubRandList(f *testing.F) {
 		require.NoError(t, err)
 	})
 }
+
+func FuzzMsgRevokePubRandCommit(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		sk, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+
+		revokedFromHeight := datagen.RandomInt(r, 100) + 1
+		commitment := datagen.GenRandomByteArray(r, 32)
+		msg, err := datagen.NewMsgRevokePubRandCommit(sk, revokedFromHeight, commitment)
+		require.NoError(t, err)
+
+		// the signature is valid w.r.t. the revoked commitment
+		err = msg.VerifySig(commitment)
+		require.NoError(t, err)
+
+		// the signature cannot be replayed against another commitment
+		err = msg.VerifySig(datagen.GenRandomByteArray(r, 32))
+		require.Error(t, err)
+	})
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -13,6 +14,7 @@ const (
 	DefaultSignedBlocksWindow = int64(100)
 	DefaultMinPubRand         = 100
 	DefaultFinalitySigTimeout = 3
+	DefaultJailDuration       = 24 * 60 * 60 * time.Second // 1 day
 )
 
 var (
@@ -28,6 +30,7 @@ func DefaultParams() Params {
 		SignedBlocksWindow: DefaultSignedBlocksWindow,
 		MinSignedPerWindow: DefaultMinSignedPerWindow,
 		MinPubRand:         DefaultMinPubRand,
+		JailDuration:       DefaultJailDuration,
 	}
 }
 
@@ -67,6 +70,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateJailDuration(p.JailDuration); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -115,6 +122,19 @@ func validateMinSignedPerWindow(i interface{}) error {
 	return nil
 }
 
+func validateJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("jail duration must be positive: %s", v)
+	}
+
+	return nil
+}
+
 // MinSignedPerWindowInt returns min signed per window as an integer (vs the decimal in the param)
 func (p *Params) MinSignedPerWindowInt() int64 {
 	signedBlocksWindow := p.SignedBlocksWindow
@@ -10,15 +10,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -39,6 +43,9 @@ type Params struct {
 	// min_pub_rand is the minimum number of public randomness each
 	// message should commit
 	MinPubRand uint64 `protobuf:"varint,4,opt,name=min_pub_rand,json=minPubRand,proto3" json:"min_pub_rand,omitempty"`
+	// jail_duration is the minimum period of time that a finality provider remains jailed
+	// after being detected as sluggish
+	JailDuration time.Duration `protobuf:"bytes,5,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -94,6 +101,13 @@ func (m *Params) GetMinPubRand() uint64 {
 	return 0
 }
 
+func (m *Params) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.finality.v1.Params")
 }
@@ -101,30 +115,34 @@ func init() {
 func init() { proto.RegisterFile("babylon/finality/v1/params.proto", fileDescriptor_25539c9a61c72ee9) }
 
 var fileDescriptor_25539c9a61c72ee9 = []byte{
-	// 359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x91, 0x31, 0x4b, 0xfb, 0x40,
-	0x18, 0xc6, 0x73, 0xff, 0x96, 0x0e, 0xa1, 0xcb, 0x3f, 0x56, 0xa8, 0x15, 0xd2, 0xe0, 0x54, 0x04,
-	0x73, 0x2d, 0x82, 0x83, 0x63, 0xe9, 0x24, 0x0e, 0x25, 0x15, 0x04, 0x97, 0x70, 0x49, 0xce, 0xf4,
-	0xa5, 0xbd, 0xbb, 0x90, 0x4b, 0x5a, 0xf3, 0x2d, 0x1c, 0x1d, 0x1d, 0x1d, 0x1d, 0xfc, 0x10, 0x1d,
-	0x8b, 0x93, 0x38, 0x14, 0x69, 0x07, 0x3f, 0x86, 0xd2, 0xbb, 0x04, 0x97, 0xe3, 0xde, 0xfb, 0x3d,
-	0xef, 0x3d, 0xcf, 0xbd, 0x67, 0x3a, 0x01, 0x09, 0x8a, 0xb9, 0xe0, 0xf8, 0x1e, 0x38, 0x99, 0x43,
-	0x56, 0xe0, 0xc5, 0x00, 0x27, 0x24, 0x25, 0x4c, 0xba, 0x49, 0x2a, 0x32, 0x61, 0x1d, 0x94, 0x0a,
-	0xb7, 0x52, 0xb8, 0x8b, 0x41, 0xa7, 0x15, 0x8b, 0x58, 0x28, 0x8e, 0xf7, 0x3b, 0x2d, 0xed, 0xfc,
-	0x27, 0x0c, 0xb8, 0xc0, 0x6a, 0x2d, 0x8f, 0x8e, 0x42, 0x21, 0x99, 0x90, 0xbe, 0xd6, 0xea, 0x42,
-	0xa3, 0x93, 0x1f, 0x64, 0x36, 0xc6, 0xca, 0xc9, 0xea, 0x9b, 0x2d, 0x09, 0x31, 0xa7, 0x91, 0x1f,
-	0xcc, 0x45, 0x38, 0x93, 0xfe, 0x12, 0x78, 0x24, 0x96, 0x6d, 0xe4, 0xa0, 0x5e, 0xcd, 0xb3, 0x34,
-	0x1b, 0x2a, 0x74, 0xab, 0xc8, 0xbe, 0xa3, 0xca, 0xe3, 0x4b, 0x88, 0xfd, 0x0c, 0x18, 0x15, 0x79,
-	0xd6, 0xfe, 0xa7, 0x3b, 0x2a, 0x36, 0x81, 0xf8, 0x46, 0x13, 0x0b, 0xcc, 0x43, 0x06, 0xdc, 0x2f,
-	0x7d, 0x12, 0x9a, 0x56, 0x26, 0x35, 0x07, 0xf5, 0x9a, 0xc3, 0x8b, 0xd5, 0xa6, 0x6b, 0x7c, 0x6e,
-	0xba, 0xc7, 0x3a, 0xa3, 0x8c, 0x66, 0x2e, 0x08, 0xcc, 0x48, 0x36, 0x75, 0xaf, 0x69, 0x4c, 0xc2,
-	0x62, 0x44, 0xc3, 0xf7, 0xb7, 0x33, 0xb3, 0x7c, 0xc2, 0x88, 0x86, 0x2f, 0xdf, 0xaf, 0xa7, 0xc8,
-	0xb3, 0x18, 0xf0, 0x89, 0xba, 0x73, 0x4c, 0xd3, 0x32, 0x9c, 0x63, 0x36, 0xf7, 0x56, 0x49, 0x1e,
-	0xf8, 0x29, 0xe1, 0x51, 0xbb, 0xee, 0xa0, 0x5e, 0xdd, 0x33, 0x19, 0xf0, 0x71, 0x1e, 0x78, 0x84,
-	0x47, 0x97, 0xf5, 0xa7, 0xe7, 0xae, 0x31, 0xbc, 0x5a, 0x6d, 0x6d, 0xb4, 0xde, 0xda, 0xe8, 0x6b,
-	0x6b, 0xa3, 0xc7, 0x9d, 0x6d, 0xac, 0x77, 0xb6, 0xf1, 0xb1, 0xb3, 0x8d, 0xbb, 0x7e, 0x0c, 0xd9,
-	0x34, 0x0f, 0xdc, 0x50, 0x30, 0x5c, 0xce, 0x3f, 0x9c, 0x12, 0xe0, 0x55, 0x81, 0x1f, 0xfe, 0x3e,
-	0x2c, 0x2b, 0x12, 0x2a, 0x83, 0x86, 0x1a, 0xea, 0xf9, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb0,
-	0x39, 0x84, 0xbb, 0xd1, 0x01, 0x00, 0x00,
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x92, 0xbf, 0x6e, 0xd4, 0x40,
+	0x10, 0xc6, 0xbd, 0xc9, 0x91, 0xc2, 0x5c, 0x0a, 0x4c, 0x90, 0x2e, 0x41, 0xf2, 0x59, 0x54, 0x27,
+	0x24, 0x76, 0x13, 0x90, 0x28, 0x28, 0x4f, 0x57, 0x21, 0x90, 0x4e, 0x17, 0x24, 0x24, 0x1a, 0x6b,
+	0x6d, 0x6f, 0xf6, 0x86, 0x78, 0x77, 0x2c, 0xff, 0x49, 0xf0, 0x5b, 0x50, 0xa6, 0xa4, 0xa4, 0xa4,
+	0xe0, 0x21, 0x52, 0xa1, 0x88, 0x0a, 0x51, 0x04, 0x74, 0x57, 0xf0, 0x1a, 0xc8, 0xfb, 0x47, 0x69,
+	0x2c, 0x8f, 0x7f, 0xdf, 0xf8, 0xfb, 0x66, 0x76, 0xc3, 0x24, 0xe3, 0x59, 0x5f, 0xa2, 0x66, 0x67,
+	0xa0, 0x79, 0x09, 0x6d, 0xcf, 0x2e, 0x4e, 0x58, 0xc5, 0x6b, 0xae, 0x1a, 0x5a, 0xd5, 0xd8, 0x62,
+	0xf4, 0xd0, 0x29, 0xa8, 0x57, 0xd0, 0x8b, 0x93, 0xa3, 0x03, 0x89, 0x12, 0x0d, 0x67, 0xc3, 0x9b,
+	0x95, 0x1e, 0x3d, 0xe0, 0x0a, 0x34, 0x32, 0xf3, 0x74, 0x9f, 0x0e, 0x73, 0x6c, 0x14, 0x36, 0xa9,
+	0xd5, 0xda, 0xc2, 0xa1, 0x58, 0x22, 0xca, 0x52, 0x30, 0x53, 0x65, 0xdd, 0x19, 0x2b, 0xba, 0x9a,
+	0xb7, 0x80, 0xda, 0xf2, 0x27, 0x3f, 0x76, 0xc2, 0xbd, 0xa5, 0x49, 0x12, 0x1d, 0x87, 0x07, 0x0d,
+	0x48, 0x2d, 0x8a, 0x34, 0x2b, 0x31, 0x3f, 0x6f, 0xd2, 0x4b, 0xd0, 0x05, 0x5e, 0x4e, 0x48, 0x42,
+	0x66, 0xbb, 0xab, 0xc8, 0xb2, 0xb9, 0x41, 0xef, 0x0d, 0x19, 0x3a, 0x7c, 0xde, 0xb4, 0x01, 0x99,
+	0xb6, 0xa0, 0x04, 0x76, 0xed, 0x64, 0xc7, 0x76, 0x78, 0x76, 0x0a, 0xf2, 0x9d, 0x25, 0x11, 0x84,
+	0x8f, 0x14, 0xe8, 0xd4, 0xf9, 0x54, 0xa2, 0xf6, 0x26, 0xbb, 0x09, 0x99, 0x8d, 0xe7, 0x2f, 0xaf,
+	0x6f, 0xa7, 0xc1, 0xef, 0xdb, 0xe9, 0x63, 0x3b, 0x43, 0x53, 0x9c, 0x53, 0x40, 0xa6, 0x78, 0xbb,
+	0xa6, 0x6f, 0x84, 0xe4, 0x79, 0xbf, 0x10, 0xf9, 0xcf, 0xef, 0xcf, 0x42, 0x37, 0xe2, 0x42, 0xe4,
+	0x5f, 0xff, 0x7d, 0x7b, 0x4a, 0x56, 0x91, 0x02, 0x7d, 0x6a, 0xfe, 0xb9, 0x14, 0xb5, 0x0b, 0x97,
+	0x84, 0xe3, 0xc1, 0xaa, 0xea, 0xb2, 0xb4, 0xe6, 0xba, 0x98, 0x8c, 0x12, 0x32, 0x1b, 0xad, 0x42,
+	0x05, 0x7a, 0xd9, 0x65, 0x2b, 0xae, 0x8b, 0xe8, 0x6d, 0xb8, 0xff, 0x91, 0x43, 0x99, 0xfa, 0x95,
+	0x4c, 0xee, 0x25, 0x64, 0x76, 0xff, 0xf9, 0x21, 0xb5, 0x3b, 0xa3, 0x7e, 0x67, 0x74, 0xe1, 0x04,
+	0xf3, 0xfd, 0x21, 0xdf, 0xd5, 0x9f, 0x29, 0xb1, 0xb6, 0xe3, 0xa1, 0xdd, 0xc3, 0x57, 0xa3, 0xab,
+	0x2f, 0xd3, 0x60, 0xfe, 0xfa, 0x7a, 0x13, 0x93, 0x9b, 0x4d, 0x4c, 0xfe, 0x6e, 0x62, 0xf2, 0x79,
+	0x1b, 0x07, 0x37, 0xdb, 0x38, 0xf8, 0xb5, 0x8d, 0x83, 0x0f, 0xc7, 0x12, 0xda, 0x75, 0x97, 0xd1,
+	0x1c, 0x15, 0x73, 0xc7, 0x9d, 0xaf, 0x39, 0x68, 0x5f, 0xb0, 0x4f, 0x77, 0xf7, 0xa3, 0xed, 0x2b,
+	0xd1, 0x64, 0x7b, 0x26, 0xc1, 0x8b, 0xff, 0x03, 0x00, 0xcc, 0x9f, 0x8d, 0x65, 0x40, 0x02, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -147,6 +165,14 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
 	if m.MinPubRand != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MinPubRand))
 		i--
@@ -203,6 +229,8 @@ func (m *Params) Size() (n int) {
 	if m.MinPubRand != 0 {
 		n += 1 + sovParams(uint64(m.MinPubRand))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -331,6 +359,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
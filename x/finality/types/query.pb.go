@@ -258,6 +258,9 @@ type PubRandCommitResponse struct {
 	NumPubRand uint64 `protobuf:"varint,1,opt,name=num_pub_rand,json=numPubRand,proto3" json:"num_pub_rand,omitempty"`
 	// commitment is the value of the commitment
 	Commitment []byte `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// revoked_from_height is the height from which the commitment is revoked.
+	// 0 means the commitment is not revoked
+	RevokedFromHeight uint64 `protobuf:"varint,3,opt,name=revoked_from_height,json=revokedFromHeight,proto3" json:"revoked_from_height,omitempty"`
 }
 
 func (m *PubRandCommitResponse) Reset()         { *m = PubRandCommitResponse{} }
@@ -307,6 +310,13 @@ func (m *PubRandCommitResponse) GetCommitment() []byte {
 	return nil
 }
 
+func (m *PubRandCommitResponse) GetRevokedFromHeight() uint64 {
+	if m != nil {
+		return m.RevokedFromHeight
+	}
+	return 0
+}
+
 // QueryListPubRandCommitRequest is the request type for the
 // Query/ListPubRandCommit RPC method.
 type QueryListPubRandCommitRequest struct {
@@ -514,6 +524,100 @@ func (m *QueryBlockResponse) GetBlock() *IndexedBlock {
 	return nil
 }
 
+// QueryFinalityProofRequest is the request type for the
+// Query/FinalityProof RPC method.
+type QueryFinalityProofRequest struct {
+	// height is the height of the BTC-finalized Babylon block
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryFinalityProofRequest) Reset()         { *m = QueryFinalityProofRequest{} }
+func (m *QueryFinalityProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityProofRequest) ProtoMessage()    {}
+func (*QueryFinalityProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{9}
+}
+func (m *QueryFinalityProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProofRequest.Merge(m, src)
+}
+func (m *QueryFinalityProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProofRequest proto.InternalMessageInfo
+
+func (m *QueryFinalityProofRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryFinalityProofResponse is the response type for the
+// Query/FinalityProof RPC method.
+type QueryFinalityProofResponse struct {
+	// proof is the proof that the block at the given height is BTC-finalized
+	Proof *FinalityProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QueryFinalityProofResponse) Reset()         { *m = QueryFinalityProofResponse{} }
+func (m *QueryFinalityProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityProofResponse) ProtoMessage()    {}
+func (*QueryFinalityProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{10}
+}
+func (m *QueryFinalityProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProofResponse.Merge(m, src)
+}
+func (m *QueryFinalityProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProofResponse proto.InternalMessageInfo
+
+func (m *QueryFinalityProofResponse) GetProof() *FinalityProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 // QueryListBlocksRequest is the request type for the
 // Query/ListBlocks RPC method.
 type QueryListBlocksRequest struct {
@@ -527,7 +631,7 @@ func (m *QueryListBlocksRequest) Reset()         { *m = QueryListBlocksRequest{}
 func (m *QueryListBlocksRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryListBlocksRequest) ProtoMessage()    {}
 func (*QueryListBlocksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{9}
+	return fileDescriptor_32bddab77af6fdae, []int{11}
 }
 func (m *QueryListBlocksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,7 +687,7 @@ func (m *QueryListBlocksResponse) Reset()         { *m = QueryListBlocksResponse
 func (m *QueryListBlocksResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryListBlocksResponse) ProtoMessage()    {}
 func (*QueryListBlocksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{10}
+	return fileDescriptor_32bddab77af6fdae, []int{12}
 }
 func (m *QueryListBlocksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -637,7 +741,7 @@ func (m *QueryVotesAtHeightRequest) Reset()         { *m = QueryVotesAtHeightReq
 func (m *QueryVotesAtHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesAtHeightRequest) ProtoMessage()    {}
 func (*QueryVotesAtHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{11}
+	return fileDescriptor_32bddab77af6fdae, []int{13}
 }
 func (m *QueryVotesAtHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -685,7 +789,7 @@ func (m *QueryVotesAtHeightResponse) Reset()         { *m = QueryVotesAtHeightRe
 func (m *QueryVotesAtHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesAtHeightResponse) ProtoMessage()    {}
 func (*QueryVotesAtHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{12}
+	return fileDescriptor_32bddab77af6fdae, []int{14}
 }
 func (m *QueryVotesAtHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -726,7 +830,7 @@ func (m *QueryEvidenceRequest) Reset()         { *m = QueryEvidenceRequest{} }
 func (m *QueryEvidenceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEvidenceRequest) ProtoMessage()    {}
 func (*QueryEvidenceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{13}
+	return fileDescriptor_32bddab77af6fdae, []int{15}
 }
 func (m *QueryEvidenceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -772,7 +876,7 @@ func (m *QueryEvidenceResponse) Reset()         { *m = QueryEvidenceResponse{} }
 func (m *QueryEvidenceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEvidenceResponse) ProtoMessage()    {}
 func (*QueryEvidenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{14}
+	return fileDescriptor_32bddab77af6fdae, []int{16}
 }
 func (m *QueryEvidenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -822,7 +926,7 @@ func (m *QueryListEvidencesRequest) Reset()         { *m = QueryListEvidencesReq
 func (m *QueryListEvidencesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryListEvidencesRequest) ProtoMessage()    {}
 func (*QueryListEvidencesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{15}
+	return fileDescriptor_32bddab77af6fdae, []int{17}
 }
 func (m *QueryListEvidencesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,7 +982,7 @@ func (m *QueryListEvidencesResponse) Reset()         { *m = QueryListEvidencesRe
 func (m *QueryListEvidencesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryListEvidencesResponse) ProtoMessage()    {}
 func (*QueryListEvidencesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{16}
+	return fileDescriptor_32bddab77af6fdae, []int{18}
 }
 func (m *QueryListEvidencesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,7 +1037,7 @@ func (m *QuerySigningInfoRequest) Reset()         { *m = QuerySigningInfoRequest
 func (m *QuerySigningInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningInfoRequest) ProtoMessage()    {}
 func (*QuerySigningInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{17}
+	return fileDescriptor_32bddab77af6fdae, []int{19}
 }
 func (m *QuerySigningInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -980,7 +1084,7 @@ func (m *QuerySigningInfoResponse) Reset()         { *m = QuerySigningInfoRespon
 func (m *QuerySigningInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningInfoResponse) ProtoMessage()    {}
 func (*QuerySigningInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{18}
+	return fileDescriptor_32bddab77af6fdae, []int{20}
 }
 func (m *QuerySigningInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1026,7 +1130,7 @@ func (m *QuerySigningInfosRequest) Reset()         { *m = QuerySigningInfosReque
 func (m *QuerySigningInfosRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningInfosRequest) ProtoMessage()    {}
 func (*QuerySigningInfosRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{19}
+	return fileDescriptor_32bddab77af6fdae, []int{21}
 }
 func (m *QuerySigningInfosRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1074,7 +1178,7 @@ func (m *QuerySigningInfosResponse) Reset()         { *m = QuerySigningInfosResp
 func (m *QuerySigningInfosResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningInfosResponse) ProtoMessage()    {}
 func (*QuerySigningInfosResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{20}
+	return fileDescriptor_32bddab77af6fdae, []int{22}
 }
 func (m *QuerySigningInfosResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1130,6 +1234,8 @@ func init() {
 	proto.RegisterMapType((map[uint64]*PubRandCommitResponse)(nil), "babylon.finality.v1.QueryListPubRandCommitResponse.PubRandCommitMapEntry")
 	proto.RegisterType((*QueryBlockRequest)(nil), "babylon.finality.v1.QueryBlockRequest")
 	proto.RegisterType((*QueryBlockResponse)(nil), "babylon.finality.v1.QueryBlockResponse")
+	proto.RegisterType((*QueryFinalityProofRequest)(nil), "babylon.finality.v1.QueryFinalityProofRequest")
+	proto.RegisterType((*QueryFinalityProofResponse)(nil), "babylon.finality.v1.QueryFinalityProofResponse")
 	proto.RegisterType((*QueryListBlocksRequest)(nil), "babylon.finality.v1.QueryListBlocksRequest")
 	proto.RegisterType((*QueryListBlocksResponse)(nil), "babylon.finality.v1.QueryListBlocksResponse")
 	proto.RegisterType((*QueryVotesAtHeightRequest)(nil), "babylon.finality.v1.QueryVotesAtHeightRequest")
@@ -1147,90 +1253,95 @@ func init() {
 func init() { proto.RegisterFile("babylon/finality/v1/query.proto", fileDescriptor_32bddab77af6fdae) }
 
 var fileDescriptor_32bddab77af6fdae = []byte{
-	// 1317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdf, 0x6f, 0xdb, 0xd4,
-	0x17, 0xef, 0xcd, 0xd6, 0x6c, 0x3b, 0x4d, 0xb6, 0xee, 0xae, 0xdb, 0xb7, 0xdf, 0x8c, 0xa5, 0x99,
-	0x37, 0xda, 0xd2, 0x0e, 0xbb, 0x4d, 0xcb, 0xd8, 0x06, 0x68, 0x6b, 0xa0, 0xa5, 0x81, 0x2e, 0x0b,
-	0x2e, 0x9a, 0xb4, 0x3d, 0x60, 0xec, 0xf4, 0x26, 0xb1, 0x9a, 0xd8, 0x5e, 0xec, 0x44, 0x0d, 0xd3,
-	0x24, 0x84, 0xc4, 0x1e, 0x10, 0x48, 0x48, 0xbc, 0xc0, 0xc3, 0x1e, 0xd8, 0x03, 0x2f, 0xfc, 0x1f,
-	0x68, 0x8f, 0x15, 0xf0, 0x80, 0x26, 0x51, 0xa1, 0x96, 0x3f, 0x04, 0xe5, 0xde, 0xeb, 0xc4, 0x4e,
-	0x9c, 0x1f, 0x2d, 0x11, 0x6f, 0xf6, 0xf5, 0xf9, 0xf1, 0xf9, 0x7c, 0xce, 0xc9, 0xb9, 0xa7, 0x85,
-	0x29, 0x4d, 0xd5, 0xea, 0x25, 0xd3, 0x90, 0xf2, 0xba, 0xa1, 0x96, 0x74, 0xa7, 0x2e, 0xd5, 0x16,
-	0xa5, 0x47, 0x55, 0x52, 0xa9, 0x8b, 0x56, 0xc5, 0x74, 0x4c, 0x7c, 0x8e, 0x1b, 0x88, 0xae, 0x81,
-	0x58, 0x5b, 0x8c, 0x4d, 0x14, 0xcc, 0x82, 0x49, 0xbf, 0x4b, 0x8d, 0x27, 0x66, 0x1a, 0x7b, 0xa5,
-	0x60, 0x9a, 0x85, 0x12, 0x91, 0x54, 0x4b, 0x97, 0x54, 0xc3, 0x30, 0x1d, 0xd5, 0xd1, 0x4d, 0xc3,
-	0xe6, 0x5f, 0xe7, 0x72, 0xa6, 0x5d, 0x36, 0x6d, 0x49, 0x53, 0x6d, 0xc2, 0x32, 0x48, 0xb5, 0x45,
-	0x8d, 0x38, 0xea, 0xa2, 0x64, 0xa9, 0x05, 0xdd, 0xa0, 0xc6, 0xdc, 0x36, 0x11, 0x84, 0xca, 0x52,
-	0x2b, 0x6a, 0xd9, 0x8d, 0x26, 0x04, 0x59, 0x34, 0x21, 0x52, 0x1b, 0x61, 0x02, 0xf0, 0x47, 0x8d,
-	0x3c, 0x59, 0xea, 0x28, 0x93, 0x47, 0x55, 0x62, 0x3b, 0x42, 0x16, 0xce, 0xf9, 0x4e, 0x6d, 0xcb,
-	0x34, 0x6c, 0x82, 0x6f, 0x42, 0x98, 0x25, 0x98, 0x44, 0x09, 0x34, 0x3b, 0x96, 0xbc, 0x28, 0x06,
-	0x10, 0x17, 0x99, 0x53, 0xea, 0xf8, 0x8b, 0xbd, 0xa9, 0x11, 0x99, 0x3b, 0x08, 0xdf, 0x20, 0x48,
-	0xd0, 0x90, 0x1b, 0xba, 0xed, 0x64, 0xab, 0x5a, 0x49, 0xcf, 0xc9, 0xaa, 0xb1, 0x65, 0x96, 0x0d,
-	0x62, 0xbb, 0x69, 0xf1, 0x65, 0x88, 0xe6, 0x2d, 0x45, 0x73, 0x72, 0x8a, 0xb5, 0xad, 0x14, 0xc9,
-	0x0e, 0x4d, 0x73, 0x4a, 0x86, 0xbc, 0x95, 0x72, 0x72, 0xd9, 0xed, 0x75, 0xb2, 0x83, 0xd7, 0x00,
-	0x5a, 0x4a, 0x4c, 0x86, 0x28, 0x8c, 0x69, 0x91, 0xc9, 0x26, 0x36, 0x64, 0x13, 0x59, 0x61, 0xb8,
-	0x6c, 0x62, 0x56, 0x2d, 0x10, 0x1e, 0x5e, 0xf6, 0x78, 0x0a, 0xbb, 0x21, 0xb8, 0xdc, 0x03, 0x0f,
-	0x27, 0xfc, 0x1c, 0x41, 0xc4, 0xaa, 0x6a, 0x4a, 0x45, 0x35, 0xb6, 0x94, 0xb2, 0x6a, 0x4d, 0xa2,
-	0xc4, 0xb1, 0xd9, 0xb1, 0xe4, 0x5a, 0x20, 0xef, 0xbe, 0xe1, 0xc4, 0x6c, 0x55, 0x6b, 0x9c, 0xde,
-	0x55, 0xad, 0x55, 0xc3, 0xa9, 0xd4, 0x53, 0x37, 0x5e, 0xee, 0x4d, 0x2d, 0x17, 0x74, 0xa7, 0x58,
-	0xd5, 0xc4, 0x9c, 0x59, 0x96, 0x78, 0xd4, 0x5c, 0x51, 0xd5, 0x0d, 0xf7, 0x45, 0x72, 0xea, 0x16,
-	0xb1, 0xc5, 0xcd, 0x5c, 0xd1, 0x30, 0x2b, 0x15, 0x1e, 0x41, 0x06, 0xab, 0x19, 0x0a, 0xbf, 0x1f,
-	0x20, 0xc9, 0x4c, 0x5f, 0x49, 0x18, 0x24, 0xaf, 0x26, 0xb1, 0x77, 0xe0, 0x4c, 0x1b, 0x42, 0x3c,
-	0x0e, 0xc7, 0xb6, 0x49, 0x9d, 0xd6, 0xe1, 0xb8, 0xdc, 0x78, 0xc4, 0x13, 0x30, 0x5a, 0x53, 0x4b,
-	0x55, 0x42, 0x13, 0x45, 0x64, 0xf6, 0x72, 0x2b, 0x74, 0x03, 0x09, 0x0f, 0xe0, 0x3c, 0x77, 0x7f,
-	0xd7, 0x2c, 0x97, 0x75, 0xa7, 0xa9, 0x62, 0x02, 0x22, 0x46, 0xb5, 0xac, 0xb8, 0x42, 0xf2, 0x68,
-	0x60, 0x54, 0xcb, 0xdc, 0x1e, 0xc7, 0x01, 0x72, 0xd4, 0xa7, 0x4c, 0x0c, 0x87, 0x47, 0xf6, 0x9c,
-	0x08, 0x5f, 0x21, 0xb8, 0xe4, 0x95, 0xd7, 0x9b, 0xe4, 0x3f, 0x6f, 0x9d, 0xdf, 0x43, 0x10, 0xef,
-	0x06, 0x86, 0x33, 0xde, 0x81, 0x73, 0xcd, 0xb6, 0x61, 0x34, 0x3c, 0xdd, 0x93, 0xee, 0xdb, 0x3d,
-	0x9d, 0x11, 0x45, 0xdf, 0xa9, 0x5b, 0x1e, 0x79, 0xdc, 0x6a, 0x3b, 0x1e, 0x5e, 0x33, 0x98, 0x6d,
-	0xd5, 0xec, 0xd1, 0x12, 0x77, 0xbc, 0x2d, 0x31, 0x96, 0x9c, 0x0b, 0x9e, 0x0a, 0x41, 0xb4, 0xbc,
-	0xed, 0x33, 0x0f, 0x67, 0xa9, 0x06, 0xa9, 0x92, 0x99, 0xdb, 0x76, 0xcb, 0x7a, 0x01, 0xc2, 0x45,
-	0xa2, 0x17, 0x8a, 0x0e, 0xcf, 0xc7, 0xdf, 0x84, 0xbb, 0x7c, 0x6c, 0x71, 0x63, 0x2e, 0xfb, 0x9b,
-	0x30, 0xaa, 0x35, 0x0e, 0xf8, 0x78, 0xba, 0x1c, 0x08, 0x24, 0x6d, 0x6c, 0x91, 0x1d, 0xb2, 0xc5,
-	0x3c, 0x99, 0xbd, 0xf0, 0x23, 0x82, 0x0b, 0xcd, 0x02, 0xd0, 0x2f, 0xcd, 0x99, 0x74, 0x1b, 0xc2,
-	0xb6, 0xa3, 0x3a, 0x55, 0x36, 0xf3, 0x4e, 0x27, 0x67, 0xba, 0x56, 0x4f, 0xe7, 0x41, 0x37, 0xa9,
-	0xb9, 0xcc, 0xdd, 0x86, 0xd6, 0x76, 0xcf, 0x10, 0xfc, 0xaf, 0x03, 0x63, 0x6b, 0x30, 0x53, 0x22,
-	0x36, 0x6f, 0xb1, 0x01, 0x98, 0x73, 0x87, 0xa1, 0x35, 0x8c, 0xb0, 0x04, 0xff, 0xa7, 0xf0, 0xee,
-	0x9b, 0x0e, 0xb1, 0x57, 0x9c, 0x75, 0x5a, 0xa8, 0x7e, 0x75, 0x2c, 0x43, 0x2c, 0xc8, 0x89, 0xd3,
-	0xba, 0x07, 0x27, 0xd8, 0x2f, 0x9a, 0xf1, 0x8a, 0xa4, 0xae, 0xbf, 0xdc, 0x9b, 0x4a, 0x0e, 0x36,
-	0x30, 0x53, 0xe9, 0xec, 0xd2, 0xf2, 0x42, 0xb6, 0xaa, 0x7d, 0x48, 0xea, 0x72, 0x58, 0x6b, 0x0c,
-	0x01, 0x5b, 0xb8, 0x09, 0x13, 0x34, 0xdd, 0x6a, 0x4d, 0xdf, 0x22, 0x46, 0x8e, 0x0c, 0x3e, 0x3d,
-	0x04, 0x19, 0xce, 0xb7, 0xb9, 0x36, 0xb5, 0x3f, 0x49, 0xf8, 0x19, 0xef, 0xbb, 0x4b, 0x81, 0xea,
-	0x37, 0x1d, 0x9b, 0xe6, 0xc2, 0x53, 0xc4, 0x35, 0x6b, 0x94, 0xd4, 0xfd, 0xee, 0xb9, 0x0d, 0x23,
-	0xb6, 0xa3, 0x56, 0x1c, 0xc5, 0xa7, 0xdc, 0x18, 0x3d, 0x63, 0x42, 0x0d, 0xad, 0xb7, 0x9e, 0x23,
-	0x5e, 0x87, 0x36, 0x20, 0x9c, 0xe2, 0x5b, 0x70, 0xca, 0xc5, 0xec, 0x76, 0x58, 0x1f, 0x8e, 0x2d,
-	0xfb, 0xe1, 0x35, 0xd8, 0xdb, 0xbc, 0xff, 0x37, 0xf5, 0x82, 0xa1, 0x1b, 0x85, 0xb4, 0x91, 0x37,
-	0x0f, 0x51, 0xbf, 0xcf, 0x60, 0xb2, 0xd3, 0x9b, 0xf3, 0xfb, 0x04, 0xce, 0xe4, 0x2d, 0xc5, 0x66,
-	0x5f, 0x14, 0xdd, 0xc8, 0x9b, 0xbc, 0x92, 0x0b, 0x81, 0x2c, 0xd7, 0xf8, 0x73, 0xb6, 0x62, 0x36,
-	0x58, 0x56, 0x3c, 0x21, 0xf9, 0xd6, 0x13, 0xcd, 0x5b, 0x9e, 0x43, 0x41, 0xeb, 0xcc, 0xdd, 0xac,
-	0xb2, 0xbf, 0x84, 0xe8, 0xc8, 0x25, 0xfc, 0xc5, 0xed, 0x25, 0x7f, 0x12, 0xce, 0xf0, 0x53, 0x18,
-	0x6f, 0x63, 0xe8, 0x16, 0xf2, 0xa8, 0x14, 0x4f, 0xfb, 0x28, 0x0e, 0xaf, 0xcc, 0x73, 0xb7, 0xd9,
-	0x68, 0xf7, 0x4f, 0x53, 0x7c, 0x16, 0xa2, 0x99, 0x7b, 0x19, 0x65, 0x2d, 0x9d, 0x59, 0xd9, 0x48,
-	0x3f, 0x5c, 0x7d, 0x6f, 0x7c, 0x04, 0x47, 0xe1, 0x54, 0xeb, 0x15, 0xe1, 0x13, 0x70, 0x6c, 0x25,
-	0xf3, 0x60, 0x3c, 0x94, 0xfc, 0x32, 0x0a, 0xa3, 0x54, 0x09, 0xfc, 0x39, 0x82, 0x30, 0xdb, 0x46,
-	0x71, 0xf7, 0xb1, 0xed, 0x5f, 0x7d, 0x63, 0xb3, 0xfd, 0x0d, 0x19, 0x68, 0xe1, 0xca, 0x17, 0xbf,
-	0xfd, 0xfd, 0x5d, 0xe8, 0x12, 0xbe, 0x28, 0x75, 0xdf, 0xc4, 0xf1, 0x9f, 0x08, 0x26, 0x82, 0x76,
-	0x42, 0xfc, 0xc6, 0x61, 0x77, 0x48, 0x06, 0xef, 0xfa, 0xd1, 0x56, 0x4f, 0xe1, 0x3e, 0x05, 0x9b,
-	0xc5, 0x19, 0xa9, 0xd7, 0x1f, 0x05, 0x8a, 0xc5, 0xeb, 0x6d, 0x4b, 0x8f, 0x7d, 0x3f, 0xa8, 0x27,
-	0x92, 0x45, 0x23, 0xd3, 0x95, 0x86, 0x85, 0x56, 0x4a, 0xba, 0xed, 0xe0, 0x5f, 0x11, 0x9c, 0xed,
-	0xd8, 0x5a, 0x70, 0xf2, 0x50, 0x2b, 0x0e, 0x63, 0xb6, 0x74, 0x84, 0xb5, 0x48, 0xf8, 0x98, 0xd2,
-	0xca, 0xe0, 0x8d, 0x7f, 0x41, 0xcb, 0xb7, 0xa6, 0x51, 0x52, 0x4f, 0x11, 0x8c, 0xd2, 0xe6, 0xc3,
-	0xd3, 0xdd, 0x41, 0x79, 0xf7, 0x94, 0xd8, 0x4c, 0x5f, 0x3b, 0x0e, 0xf8, 0x1a, 0x05, 0x3c, 0x8d,
-	0xaf, 0x06, 0x02, 0x66, 0x77, 0xb2, 0xf4, 0x98, 0x4d, 0xfc, 0x27, 0xf8, 0x6b, 0x04, 0xd0, 0xba,
-	0xee, 0xf1, 0x7c, 0x6f, 0x89, 0x7c, 0x8b, 0x4b, 0xec, 0xda, 0x60, 0xc6, 0x03, 0x35, 0x33, 0xdf,
-	0x15, 0x9e, 0x21, 0x88, 0xfa, 0x6e, 0x6a, 0x2c, 0x76, 0x4f, 0x12, 0xb4, 0x07, 0xc4, 0xa4, 0x81,
-	0xed, 0x39, 0xae, 0x79, 0x8a, 0xeb, 0x55, 0x7c, 0x25, 0x10, 0x57, 0xad, 0xe1, 0xd3, 0x92, 0xeb,
-	0x67, 0x04, 0x27, 0xdd, 0x2b, 0x08, 0xbf, 0xd6, 0x3d, 0x55, 0xdb, 0xf5, 0x1f, 0x9b, 0x1b, 0xc4,
-	0x94, 0x03, 0x5a, 0xa7, 0x80, 0x52, 0xf8, 0xce, 0x51, 0x3b, 0xce, 0xbd, 0x19, 0xf1, 0xf7, 0x08,
-	0xa2, 0xbe, 0xfb, 0xb6, 0x97, 0x9a, 0x41, 0x1b, 0x42, 0x2f, 0x35, 0x03, 0x2f, 0x72, 0x61, 0x9a,
-	0x82, 0x4f, 0xe0, 0x78, 0x20, 0xf8, 0xd6, 0x9d, 0xfd, 0x13, 0x82, 0x31, 0xcf, 0x74, 0xc7, 0x3d,
-	0x7a, 0xa9, 0xf3, 0x36, 0x8e, 0xbd, 0x3e, 0xa0, 0x35, 0x07, 0x75, 0x8b, 0x82, 0x5a, 0xc6, 0xc9,
-	0x40, 0x50, 0xbe, 0x3b, 0xab, 0x5d, 0x4c, 0xfc, 0x03, 0x82, 0x88, 0xef, 0x1a, 0x1a, 0x2c, 0x77,
-	0x53, 0x41, 0x71, 0x50, 0x73, 0x8e, 0x75, 0x8e, 0x62, 0xbd, 0x8a, 0x85, 0xfe, 0x58, 0x53, 0x1f,
-	0xbc, 0xd8, 0x8f, 0xa3, 0xdd, 0xfd, 0x38, 0xfa, 0x6b, 0x3f, 0x8e, 0xbe, 0x3d, 0x88, 0x8f, 0xec,
-	0x1e, 0xc4, 0x47, 0xfe, 0x38, 0x88, 0x8f, 0x3c, 0x5c, 0xe8, 0xb7, 0xc2, 0xee, 0xb4, 0xc2, 0xd2,
-	0x6d, 0x56, 0x0b, 0xd3, 0xff, 0xd6, 0x2c, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0x75, 0xb1, 0x13,
-	0x1f, 0x8b, 0x12, 0x00, 0x00,
+	// 1399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0xd4, 0xc6,
+	0x17, 0xcf, 0x24, 0x24, 0xc0, 0x4b, 0x16, 0x92, 0x49, 0xe0, 0x1b, 0xcc, 0x97, 0x4d, 0x30, 0x34,
+	0xd0, 0x40, 0x6d, 0xb2, 0x01, 0xca, 0x8f, 0x56, 0xc0, 0xb6, 0xa4, 0xa4, 0x85, 0xb0, 0x35, 0x15,
+	0x52, 0x39, 0xd4, 0xb5, 0x37, 0xb3, 0x1b, 0x2b, 0x6b, 0x8f, 0xb1, 0xbd, 0xab, 0x6c, 0x11, 0x52,
+	0xd5, 0x03, 0x52, 0xab, 0x56, 0xaa, 0xd4, 0x4b, 0x7b, 0xe0, 0x50, 0x0e, 0xbd, 0x54, 0x3d, 0xf6,
+	0x5f, 0xa8, 0x38, 0xa2, 0xb6, 0x87, 0x0a, 0xa9, 0xa8, 0x82, 0xfe, 0x21, 0xd5, 0xce, 0x8c, 0xbd,
+	0x76, 0xd6, 0x9b, 0x35, 0xe9, 0xaa, 0xb7, 0x78, 0xfc, 0x7e, 0x7c, 0xde, 0xe7, 0x3d, 0xbf, 0xf9,
+	0x6c, 0x60, 0xc6, 0x34, 0xcc, 0x66, 0x8d, 0x3a, 0x6a, 0xc5, 0x72, 0x8c, 0x9a, 0x15, 0x34, 0xd5,
+	0xc6, 0x82, 0x7a, 0xb7, 0x4e, 0xbc, 0xa6, 0xe2, 0x7a, 0x34, 0xa0, 0x78, 0x52, 0x18, 0x28, 0xa1,
+	0x81, 0xd2, 0x58, 0x90, 0xa6, 0xaa, 0xb4, 0x4a, 0xd9, 0x7b, 0xb5, 0xf5, 0x17, 0x37, 0x95, 0xfe,
+	0x5f, 0xa5, 0xb4, 0x5a, 0x23, 0xaa, 0xe1, 0x5a, 0xaa, 0xe1, 0x38, 0x34, 0x30, 0x02, 0x8b, 0x3a,
+	0xbe, 0x78, 0x3b, 0x5f, 0xa6, 0xbe, 0x4d, 0x7d, 0xd5, 0x34, 0x7c, 0xc2, 0x33, 0xa8, 0x8d, 0x05,
+	0x93, 0x04, 0xc6, 0x82, 0xea, 0x1a, 0x55, 0xcb, 0x61, 0xc6, 0xc2, 0x76, 0x36, 0x0d, 0x95, 0x6b,
+	0x78, 0x86, 0x1d, 0x46, 0x93, 0xd3, 0x2c, 0x22, 0x88, 0xcc, 0x46, 0x9e, 0x02, 0xfc, 0x7e, 0x2b,
+	0x4f, 0x89, 0x39, 0x6a, 0xe4, 0x6e, 0x9d, 0xf8, 0x81, 0x5c, 0x82, 0xc9, 0xc4, 0xa9, 0xef, 0x52,
+	0xc7, 0x27, 0xf8, 0x3c, 0x8c, 0xf0, 0x04, 0xd3, 0x68, 0x16, 0x1d, 0x1f, 0x2d, 0x1c, 0x54, 0x52,
+	0x0a, 0x57, 0xb8, 0x53, 0x71, 0xc7, 0xe3, 0x67, 0x33, 0x03, 0x9a, 0x70, 0x90, 0xbf, 0x42, 0x30,
+	0xcb, 0x42, 0x5e, 0xb7, 0xfc, 0xa0, 0x54, 0x37, 0x6b, 0x56, 0x59, 0x33, 0x9c, 0x55, 0x6a, 0x3b,
+	0xc4, 0x0f, 0xd3, 0xe2, 0xc3, 0x90, 0xab, 0xb8, 0xba, 0x19, 0x94, 0x75, 0x77, 0x5d, 0x5f, 0x23,
+	0x1b, 0x2c, 0xcd, 0x6e, 0x0d, 0x2a, 0x6e, 0x31, 0x28, 0x97, 0xd6, 0xaf, 0x91, 0x0d, 0xbc, 0x04,
+	0xd0, 0x66, 0x62, 0x7a, 0x90, 0xc1, 0x98, 0x53, 0x38, 0x6d, 0x4a, 0x8b, 0x36, 0x85, 0x37, 0x46,
+	0xd0, 0xa6, 0x94, 0x8c, 0x2a, 0x11, 0xe1, 0xb5, 0x98, 0xa7, 0xfc, 0x64, 0x10, 0x0e, 0x6f, 0x81,
+	0x47, 0x14, 0xfc, 0x08, 0xc1, 0x98, 0x5b, 0x37, 0x75, 0xcf, 0x70, 0x56, 0x75, 0xdb, 0x70, 0xa7,
+	0xd1, 0xec, 0xd0, 0xf1, 0xd1, 0xc2, 0x52, 0x6a, 0xdd, 0x3d, 0xc3, 0x29, 0xa5, 0xba, 0xd9, 0x3a,
+	0xbd, 0x61, 0xb8, 0x57, 0x9d, 0xc0, 0x6b, 0x16, 0xcf, 0x3d, 0x7d, 0x36, 0x73, 0xba, 0x6a, 0x05,
+	0x6b, 0x75, 0x53, 0x29, 0x53, 0x5b, 0x15, 0x51, 0xcb, 0x6b, 0x86, 0xe5, 0x84, 0x0f, 0x6a, 0xd0,
+	0x74, 0x89, 0xaf, 0xdc, 0x2a, 0xaf, 0x39, 0xd4, 0xf3, 0x44, 0x04, 0x0d, 0xdc, 0x28, 0x14, 0x7e,
+	0x27, 0x85, 0x92, 0x63, 0x3d, 0x29, 0xe1, 0x90, 0xe2, 0x9c, 0x48, 0x6f, 0xc2, 0xde, 0x4d, 0x08,
+	0xf1, 0x38, 0x0c, 0xad, 0x93, 0x26, 0xeb, 0xc3, 0x0e, 0xad, 0xf5, 0x27, 0x9e, 0x82, 0xe1, 0x86,
+	0x51, 0xab, 0x13, 0x96, 0x68, 0x4c, 0xe3, 0x0f, 0x17, 0x06, 0xcf, 0x21, 0xf9, 0x73, 0x04, 0xfb,
+	0x84, 0xff, 0x5b, 0xd4, 0xb6, 0xad, 0x20, 0xa2, 0x71, 0x16, 0xc6, 0x9c, 0xba, 0xad, 0x87, 0x4c,
+	0x8a, 0x70, 0xe0, 0xd4, 0x6d, 0x61, 0x8f, 0xf3, 0x00, 0x65, 0xe6, 0x63, 0x13, 0x27, 0x10, 0xa1,
+	0x63, 0x27, 0x58, 0x81, 0x49, 0x8f, 0x34, 0xe8, 0x3a, 0x59, 0xd5, 0x2b, 0x1e, 0xb5, 0xf5, 0x35,
+	0x62, 0x55, 0xd7, 0x82, 0xe9, 0x21, 0x16, 0x68, 0x42, 0xbc, 0x5a, 0xf2, 0xa8, 0x7d, 0x8d, 0xbd,
+	0x90, 0xbf, 0x40, 0x70, 0x28, 0xde, 0x8f, 0x38, 0xa8, 0xff, 0x7c, 0xd6, 0x7e, 0x1f, 0x84, 0x7c,
+	0x37, 0x30, 0x82, 0xa1, 0x0d, 0x98, 0x8c, 0xe6, 0x8c, 0x97, 0x1d, 0x1b, 0xb7, 0xe5, 0x9e, 0xe3,
+	0xd6, 0x19, 0x51, 0x49, 0x9c, 0x86, 0xfd, 0xd4, 0xc6, 0xdd, 0x4d, 0xc7, 0xfd, 0x9b, 0x1e, 0x0a,
+	0xfb, 0x52, 0x73, 0xa6, 0xcc, 0xd0, 0xe5, 0xf8, 0x0c, 0x8d, 0x16, 0xe6, 0xd3, 0xd7, 0x48, 0x5a,
+	0x59, 0xf1, 0x79, 0x3b, 0x01, 0x13, 0x8c, 0x83, 0x62, 0x8d, 0x96, 0xd7, 0xc3, 0xb6, 0xee, 0x87,
+	0x11, 0x31, 0x1b, 0x3c, 0x9f, 0x78, 0x92, 0x6f, 0x00, 0x8e, 0x1b, 0x0b, 0xda, 0x5f, 0x87, 0x61,
+	0xb3, 0x75, 0x20, 0xf6, 0xd9, 0xe1, 0x54, 0x20, 0xcb, 0xce, 0x2a, 0xd9, 0x20, 0xab, 0xdc, 0x93,
+	0xdb, 0xcb, 0x8b, 0x70, 0x80, 0x85, 0x5b, 0x12, 0x76, 0x25, 0x8f, 0xd2, 0x4a, 0x2f, 0x0c, 0xb7,
+	0x41, 0x4a, 0x73, 0x12, 0x58, 0xce, 0xc1, 0xb0, 0xdb, 0x3a, 0x10, 0x58, 0xe4, 0x54, 0x2c, 0x49,
+	0x57, 0xee, 0x20, 0x7f, 0x8f, 0x60, 0x7f, 0x34, 0x0d, 0x0c, 0x66, 0xb4, 0x51, 0x2f, 0xc1, 0x88,
+	0x1f, 0x18, 0x41, 0x9d, 0x6f, 0xec, 0x3d, 0x85, 0x63, 0x5d, 0x47, 0xc9, 0x12, 0x15, 0xde, 0x62,
+	0xe6, 0x9a, 0x70, 0xeb, 0xdb, 0x37, 0xf0, 0x10, 0xc1, 0xff, 0x3a, 0x30, 0xb6, 0xaf, 0x15, 0xc6,
+	0xaa, 0x2f, 0xe6, 0x3d, 0x43, 0x1b, 0x84, 0x43, 0xdf, 0xa6, 0x37, 0x6a, 0xe8, 0x6d, 0x1a, 0x10,
+	0xff, 0x4a, 0xc0, 0xd7, 0x48, 0xaf, 0x86, 0xda, 0x20, 0xa5, 0x39, 0x89, 0xb2, 0x6e, 0xc2, 0x4e,
+	0xbe, 0x5e, 0x78, 0x5d, 0x63, 0xc5, 0xb3, 0x4f, 0x9f, 0xcd, 0x14, 0xb2, 0xad, 0xfb, 0xe2, 0x72,
+	0x69, 0xf1, 0xf4, 0xa9, 0x52, 0xdd, 0x7c, 0x8f, 0x34, 0xb5, 0x11, 0xb3, 0xb5, 0x91, 0x7c, 0xf9,
+	0x3c, 0x4c, 0xb1, 0x74, 0x57, 0x1b, 0xd6, 0x2a, 0x71, 0xca, 0x24, 0xfb, 0x2a, 0x93, 0x35, 0xd8,
+	0xb7, 0xc9, 0x35, 0xe2, 0x7e, 0x17, 0x11, 0x67, 0x62, 0xf0, 0x0e, 0xa5, 0xb2, 0x1f, 0x39, 0x46,
+	0xe6, 0xf2, 0x03, 0x04, 0x07, 0xa2, 0x96, 0x86, 0xef, 0x63, 0x77, 0xf9, 0x98, 0x1f, 0x18, 0x5e,
+	0xa0, 0x27, 0x98, 0x1b, 0x65, 0x67, 0x9c, 0xa8, 0xbe, 0xcd, 0xd6, 0x23, 0x04, 0x52, 0x1a, 0x10,
+	0x51, 0xe2, 0x45, 0xd8, 0x1d, 0x62, 0x0e, 0x27, 0xac, 0x47, 0x8d, 0x6d, 0xfb, 0xfe, 0x0d, 0xd8,
+	0x1b, 0x62, 0xfe, 0x6f, 0x59, 0x55, 0xc7, 0x72, 0xaa, 0xcb, 0x4e, 0x85, 0xbe, 0x44, 0xff, 0x3e,
+	0x81, 0xe9, 0x4e, 0x6f, 0x51, 0xdf, 0x47, 0xb0, 0xb7, 0xe2, 0xea, 0x3e, 0x7f, 0xa3, 0x5b, 0x4e,
+	0x85, 0x8a, 0x4e, 0x9e, 0xea, 0xb5, 0x42, 0x5a, 0x55, 0x7a, 0xb1, 0x90, 0x42, 0xb3, 0xe5, 0x2a,
+	0x6e, 0xec, 0x50, 0x36, 0x3b, 0x73, 0x47, 0x5d, 0x4e, 0xb6, 0x10, 0x6d, 0xbb, 0x85, 0xbf, 0x84,
+	0xb3, 0x94, 0x4c, 0x22, 0x2a, 0xfc, 0x18, 0xc6, 0x37, 0x55, 0x18, 0x36, 0x72, 0xbb, 0x25, 0xee,
+	0x49, 0x94, 0xd8, 0xbf, 0x36, 0xcf, 0x5f, 0x02, 0xdc, 0xb9, 0x4d, 0xf1, 0x04, 0xe4, 0x56, 0x6e,
+	0xae, 0xe8, 0x4b, 0xcb, 0x2b, 0x57, 0xae, 0x2f, 0xdf, 0xb9, 0xfa, 0xf6, 0xf8, 0x00, 0xce, 0xc1,
+	0xee, 0xf6, 0x23, 0xc2, 0x3b, 0x61, 0xe8, 0xca, 0xca, 0x87, 0xe3, 0x83, 0x85, 0x9f, 0xf7, 0xc0,
+	0x30, 0x63, 0x02, 0x7f, 0x8a, 0x60, 0x84, 0x6b, 0x69, 0xdc, 0x7d, 0x6d, 0x27, 0x85, 0xbb, 0x74,
+	0xbc, 0xb7, 0x21, 0x07, 0x2d, 0x1f, 0xf9, 0xec, 0xb7, 0xbf, 0xbf, 0x19, 0x3c, 0x84, 0x0f, 0xaa,
+	0xdd, 0x7f, 0x47, 0xe0, 0x3f, 0x11, 0x4c, 0xa5, 0x29, 0x5a, 0x7c, 0xe6, 0x65, 0x15, 0x30, 0x87,
+	0x77, 0x76, 0x7b, 0xc2, 0x59, 0xbe, 0xcd, 0xc0, 0x96, 0xf0, 0x8a, 0xba, 0xd5, 0x4f, 0x1a, 0xdd,
+	0x15, 0xfd, 0xf6, 0xd5, 0x7b, 0x89, 0x0f, 0xea, 0xbe, 0xea, 0xb2, 0xc8, 0xba, 0x17, 0x85, 0xd6,
+	0x6b, 0x96, 0x1f, 0xe0, 0x5f, 0x11, 0x4c, 0x74, 0x48, 0x28, 0x5c, 0x78, 0x29, 0xbd, 0xc5, 0x2b,
+	0x5b, 0xdc, 0x86, 0x46, 0x93, 0x3f, 0x60, 0x65, 0xad, 0xe0, 0xeb, 0xff, 0xa2, 0xac, 0x84, 0x66,
+	0x64, 0x45, 0x3d, 0x40, 0x30, 0xcc, 0x86, 0x0f, 0xcf, 0x75, 0x07, 0x15, 0x17, 0x4d, 0xd2, 0xb1,
+	0x9e, 0x76, 0x02, 0xf0, 0x49, 0x06, 0x78, 0x0e, 0x1f, 0x4d, 0x05, 0xcc, 0xef, 0x64, 0xf5, 0x1e,
+	0xdf, 0xf8, 0xf7, 0xf1, 0x4f, 0x08, 0x72, 0x09, 0xc1, 0x82, 0x95, 0xee, 0x89, 0xd2, 0x94, 0x94,
+	0xa4, 0x66, 0xb6, 0x17, 0x00, 0x2f, 0x32, 0x80, 0x67, 0xf0, 0x62, 0x16, 0x80, 0x09, 0x86, 0x69,
+	0x05, 0x7f, 0x89, 0x00, 0xda, 0xf2, 0x04, 0x9f, 0xd8, 0xba, 0xa5, 0x09, 0xa1, 0x25, 0x9d, 0xcc,
+	0x66, 0x9c, 0xe9, 0xe3, 0x13, 0xda, 0xe6, 0x21, 0x82, 0x5c, 0x42, 0x59, 0x6c, 0x45, 0x5f, 0x9a,
+	0x6e, 0x91, 0xd4, 0xcc, 0xf6, 0x02, 0xd7, 0x09, 0x86, 0xeb, 0x15, 0x7c, 0x24, 0x15, 0x57, 0xa3,
+	0xe5, 0xd3, 0x6e, 0xef, 0x8f, 0x08, 0x76, 0x85, 0x57, 0x26, 0x7e, 0xb5, 0x7b, 0xaa, 0x4d, 0x72,
+	0x45, 0x9a, 0xcf, 0x62, 0x2a, 0x00, 0x5d, 0x63, 0x80, 0x8a, 0xf8, 0xf2, 0x76, 0xbf, 0x90, 0xf0,
+	0x26, 0xc7, 0xdf, 0x22, 0xc8, 0x25, 0xf4, 0xc1, 0x56, 0x6c, 0xa6, 0x29, 0x1a, 0x49, 0xcd, 0x6c,
+	0x2f, 0xc0, 0xcf, 0x31, 0xf0, 0xb3, 0x38, 0x9f, 0x0a, 0xbe, 0xad, 0x31, 0x7e, 0x40, 0x30, 0x1a,
+	0xbb, 0x8d, 0xf0, 0x16, 0xb3, 0xd4, 0xa9, 0x1e, 0xa4, 0xd7, 0x32, 0x5a, 0x0b, 0x50, 0x17, 0x18,
+	0xa8, 0xd3, 0xb8, 0x90, 0x0a, 0x2a, 0x71, 0xc7, 0x6e, 0x26, 0x13, 0x7f, 0x87, 0x60, 0x2c, 0x71,
+	0x6d, 0x66, 0xcb, 0x1d, 0x31, 0xa8, 0x64, 0x35, 0x17, 0x58, 0xe7, 0x19, 0xd6, 0xa3, 0x58, 0xee,
+	0x8d, 0xb5, 0xf8, 0xee, 0xe3, 0xe7, 0x79, 0xf4, 0xe4, 0x79, 0x1e, 0xfd, 0xf5, 0x3c, 0x8f, 0xbe,
+	0x7e, 0x91, 0x1f, 0x78, 0xf2, 0x22, 0x3f, 0xf0, 0xc7, 0x8b, 0xfc, 0xc0, 0x9d, 0x53, 0xbd, 0x24,
+	0xf7, 0x46, 0x3b, 0x2c, 0x53, 0xdf, 0xe6, 0x08, 0xfb, 0xdf, 0xd8, 0xe2, 0x3f, 0x03, 0x00, 0x2c,
+	0x93, 0x18, 0x4f, 0xf9, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1257,6 +1368,9 @@ type QueryClient interface {
 	ListPubRandCommit(ctx context.Context, in *QueryListPubRandCommitRequest, opts ...grpc.CallOption) (*QueryListPubRandCommitResponse, error)
 	// Block queries a block at a given height
 	Block(ctx context.Context, in *QueryBlockRequest, opts ...grpc.CallOption) (*QueryBlockResponse, error)
+	// FinalityProof queries a proof that the block at a given height is
+	// BTC-finalized, which can be verified against a trusted Babylon header
+	FinalityProof(ctx context.Context, in *QueryFinalityProofRequest, opts ...grpc.CallOption) (*QueryFinalityProofResponse, error)
 	// ListBlocks is a range query for blocks at a given status
 	ListBlocks(ctx context.Context, in *QueryListBlocksRequest, opts ...grpc.CallOption) (*QueryListBlocksResponse, error)
 	// VotesAtHeight queries finality providers who have signed the block at given height.
@@ -1315,6 +1429,15 @@ func (c *queryClient) Block(ctx context.Context, in *QueryBlockRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) FinalityProof(ctx context.Context, in *QueryFinalityProofRequest, opts ...grpc.CallOption) (*QueryFinalityProofResponse, error) {
+	out := new(QueryFinalityProofResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/FinalityProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ListBlocks(ctx context.Context, in *QueryListBlocksRequest, opts ...grpc.CallOption) (*QueryListBlocksResponse, error) {
 	out := new(QueryListBlocksResponse)
 	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/ListBlocks", in, out, opts...)
@@ -1383,6 +1506,9 @@ type QueryServer interface {
 	ListPubRandCommit(context.Context, *QueryListPubRandCommitRequest) (*QueryListPubRandCommitResponse, error)
 	// Block queries a block at a given height
 	Block(context.Context, *QueryBlockRequest) (*QueryBlockResponse, error)
+	// FinalityProof queries a proof that the block at a given height is
+	// BTC-finalized, which can be verified against a trusted Babylon header
+	FinalityProof(context.Context, *QueryFinalityProofRequest) (*QueryFinalityProofResponse, error)
 	// ListBlocks is a range query for blocks at a given status
 	ListBlocks(context.Context, *QueryListBlocksRequest) (*QueryListBlocksResponse, error)
 	// VotesAtHeight queries finality providers who have signed the block at given height.
@@ -1413,6 +1539,9 @@ func (*UnimplementedQueryServer) ListPubRandCommit(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) Block(ctx context.Context, req *QueryBlockRequest) (*QueryBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Block not implemented")
 }
+func (*UnimplementedQueryServer) FinalityProof(ctx context.Context, req *QueryFinalityProofRequest) (*QueryFinalityProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProof not implemented")
+}
 func (*UnimplementedQueryServer) ListBlocks(ctx context.Context, req *QueryListBlocksRequest) (*QueryListBlocksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBlocks not implemented")
 }
@@ -1508,6 +1637,24 @@ func _Query_Block_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FinalityProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/FinalityProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProof(ctx, req.(*QueryFinalityProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ListBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryListBlocksRequest)
 	if err := dec(in); err != nil {
@@ -1636,6 +1783,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Block",
 			Handler:    _Query_Block_Handler,
 		},
+		{
+			MethodName: "FinalityProof",
+			Handler:    _Query_FinalityProof_Handler,
+		},
 		{
 			MethodName: "ListBlocks",
 			Handler:    _Query_ListBlocks_Handler,
@@ -1842,6 +1993,11 @@ func (m *PubRandCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RevokedFromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevokedFromHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Commitment) > 0 {
 		i -= len(m.Commitment)
 		copy(dAtA[i:], m.Commitment)
@@ -2021,6 +2177,69 @@ func (m *QueryBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFinalityProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryListBlocksRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2563,6 +2782,9 @@ func (m *PubRandCommitResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.RevokedFromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.RevokedFromHeight))
+	}
 	return n
 }
 
@@ -2634,6 +2856,31 @@ func (m *QueryBlockResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFinalityProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryFinalityProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryListBlocksRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3354,6 +3601,25 @@ func (m *PubRandCommitResponse) Unmarshal(dAtA []byte) error {
 				m.Commitment = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedFromHeight", wireType)
+			}
+			m.RevokedFromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevokedFromHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3849,6 +4115,161 @@ func (m *QueryBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFinalityProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &FinalityProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryListBlocksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
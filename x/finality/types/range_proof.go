@@ -0,0 +1,136 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/bits"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+)
+
+// the prefixes of leaf and inner nodes, following CometBFT's Merkle tree
+var (
+	leafPrefix  = []byte{0}
+	innerPrefix = []byte{1}
+)
+
+// NewRangeProof returns the Merkle tree root of the given items and a
+// RangeProof that items[start:end] are included in the Merkle tree
+func NewRangeProof(items [][]byte, start, end uint64) ([]byte, *RangeProof, error) {
+	total := uint64(len(items))
+	if start >= end || end > total {
+		return nil, nil, fmt.Errorf("invalid range [%d, %d) of %d items", start, end, total)
+	}
+
+	proof := &RangeProof{
+		Total: total,
+		Index: start,
+		Aunts: [][]byte{},
+	}
+	collectAunts(items, 0, start, end, &proof.Aunts)
+
+	return merkle.HashFromByteSlices(items), proof, nil
+}
+
+// collectAunts appends the hashes of all maximal subtrees of the given items
+// that do not contain any leaf in [start, end) to aunts, from left to right.
+// offset is the index of the first item of the subtree in the entire tree.
+func collectAunts(items [][]byte, offset, start, end uint64, aunts *[][]byte) {
+	total := uint64(len(items))
+	if offset+total <= start || offset >= end {
+		*aunts = append(*aunts, merkle.HashFromByteSlices(items))
+		return
+	}
+	if total == 1 {
+		return
+	}
+	k := getSplitPoint(total)
+	collectAunts(items[:k], offset, start, end, aunts)
+	collectAunts(items[k:], offset+k, start, end, aunts)
+}
+
+// ComputeRootHash computes the Merkle tree root from the given leaves, which
+// are at [p.Index, p.Index+len(leaves)) in the Merkle tree, and the aunts
+func (p *RangeProof) ComputeRootHash(leaves [][]byte) ([]byte, error) {
+	if len(leaves) == 0 {
+		return nil, fmt.Errorf("empty leaves")
+	}
+	start := p.Index
+	end := start + uint64(len(leaves))
+	if end < start || end > p.Total {
+		return nil, fmt.Errorf("the range [%d, %d) exceeds the total number of leaves %d", start, end, p.Total)
+	}
+
+	auntIdx := 0
+	var compute func(offset, total uint64) ([]byte, error)
+	compute = func(offset, total uint64) ([]byte, error) {
+		if offset+total <= start || offset >= end {
+			if auntIdx >= len(p.Aunts) {
+				return nil, fmt.Errorf("insufficient aunts")
+			}
+			aunt := p.Aunts[auntIdx]
+			auntIdx++
+			return aunt, nil
+		}
+		if total == 1 {
+			return leafHash(leaves[offset-start]), nil
+		}
+		k := getSplitPoint(total)
+		left, err := compute(offset, k)
+		if err != nil {
+			return nil, err
+		}
+		right, err := compute(offset+k, total-k)
+		if err != nil {
+			return nil, err
+		}
+		return innerHash(left, right), nil
+	}
+
+	rootHash, err := compute(0, p.Total)
+	if err != nil {
+		return nil, err
+	}
+	if auntIdx != len(p.Aunts) {
+		return nil, fmt.Errorf("expected %d aunts, got %d", auntIdx, len(p.Aunts))
+	}
+	return rootHash, nil
+}
+
+// Verify verifies that the given leaves are included at
+// [p.Index, p.Index+len(leaves)) in the Merkle tree with the given root
+func (p *RangeProof) Verify(rootHash []byte, leaves [][]byte) error {
+	if p.Total == 0 {
+		return fmt.Errorf("empty Merkle tree")
+	}
+	computedHash, err := p.ComputeRootHash(leaves)
+	if err != nil {
+		return fmt.Errorf("failed to compute root hash: %w", err)
+	}
+	if !bytes.Equal(computedHash, rootHash) {
+		return fmt.Errorf("invalid root hash: wanted %X got %X", rootHash, computedHash)
+	}
+	return nil
+}
+
+func leafHash(leaf []byte) []byte {
+	return tmhash.Sum(append(leafPrefix, leaf...))
+}
+
+func innerHash(left []byte, right []byte) []byte {
+	data := make([]byte, 0, len(innerPrefix)+len(left)+len(right))
+	data = append(data, innerPrefix...)
+	data = append(data, left...)
+	data = append(data, right...)
+	return tmhash.Sum(data)
+}
+
+// getSplitPoint returns the largest power of 2 less than length
+func getSplitPoint(length uint64) uint64 {
+	k := uint64(1) << (bits.Len64(length) - 1)
+	if k == length {
+		k >>= 1
+	}
+	return k
+}
@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/babylonchain/babylon/x/finality/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzRangeProof(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// generate a random list of items
+		total := datagen.RandomInt(r, 200) + 1
+		items := [][]byte{}
+		for i := uint64(0); i < total; i++ {
+			items = append(items, datagen.GenRandomByteArray(r, 32))
+		}
+		// pick a random range [start, end)
+		start := datagen.RandomInt(r, int(total))
+		end := start + datagen.RandomInt(r, int(total-start)) + 1
+
+		rootHash, proof, err := types.NewRangeProof(items, start, end)
+		require.NoError(t, err)
+		// the root is consistent with CometBFT's Merkle tree
+		require.Equal(t, merkle.HashFromByteSlices(items), rootHash)
+
+		// the range proof is valid
+		err = proof.Verify(rootHash, items[start:end])
+		require.NoError(t, err)
+
+		// the range proof of a single leaf carries the same aunts as the
+		// inclusion proof, though in a different order
+		if end-start == 1 {
+			_, proofs := merkle.ProofsFromByteSlices(items)
+			require.ElementsMatch(t, proofs[start].Aunts, proof.Aunts)
+		}
+
+		// a tampered leaf should fail the verification
+		tamperedLeaves := make([][]byte, end-start)
+		copy(tamperedLeaves, items[start:end])
+		tamperedLeaves[datagen.RandomInt(r, int(end-start))] = datagen.GenRandomByteArray(r, 32)
+		err = proof.Verify(rootHash, tamperedLeaves)
+		require.Error(t, err)
+
+		// a shifted range should fail the verification
+		if end < total {
+			err = proof.Verify(rootHash, items[start+1:end+1])
+			require.Error(t, err)
+		}
+
+		// a proof with extra aunts should fail the verification
+		proof.Aunts = append(proof.Aunts, datagen.GenRandomByteArray(r, 32))
+		err = proof.Verify(rootHash, items[start:end])
+		require.Error(t, err)
+	})
+}
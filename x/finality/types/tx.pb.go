@@ -150,6 +150,107 @@ func (m *MsgCommitPubRandListResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCommitPubRandListResponse proto.InternalMessageInfo
 
+// MsgRevokePubRandCommit defines a message for revoking the public randomness
+// committed by a finality provider from a given height
+type MsgRevokePubRandCommit struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// fp_btc_pk is the BTC PK of the finality provider that revokes the public randomness
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// revoked_from_height is the height from which the public randomness is revoked.
+	// It has to be higher than the current height, i.e., the revoked public
+	// randomness is not used yet
+	RevokedFromHeight uint64 `protobuf:"varint,3,opt,name=revoked_from_height,json=revokedFromHeight,proto3" json:"revoked_from_height,omitempty"`
+	// sig is the signature on (revoked_from_height || commitment) signed by SK
+	// corresponding to fp_btc_pk, where commitment is the value of the public
+	// randomness commitment that includes revoked_from_height. Including the
+	// commitment prevents replaying the message against a replacement commitment
+	Sig *github_com_babylonchain_babylon_types.BIP340Signature `protobuf:"bytes,4,opt,name=sig,proto3,customtype=github.com/babylonchain/babylon/types.BIP340Signature" json:"sig,omitempty"`
+}
+
+func (m *MsgRevokePubRandCommit) Reset()         { *m = MsgRevokePubRandCommit{} }
+func (m *MsgRevokePubRandCommit) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokePubRandCommit) ProtoMessage()    {}
+func (*MsgRevokePubRandCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{2}
+}
+func (m *MsgRevokePubRandCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokePubRandCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokePubRandCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokePubRandCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokePubRandCommit.Merge(m, src)
+}
+func (m *MsgRevokePubRandCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokePubRandCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokePubRandCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokePubRandCommit proto.InternalMessageInfo
+
+func (m *MsgRevokePubRandCommit) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgRevokePubRandCommit) GetRevokedFromHeight() uint64 {
+	if m != nil {
+		return m.RevokedFromHeight
+	}
+	return 0
+}
+
+// MsgRevokePubRandCommitResponse is the response to the MsgRevokePubRandCommit message
+type MsgRevokePubRandCommitResponse struct {
+}
+
+func (m *MsgRevokePubRandCommitResponse) Reset()         { *m = MsgRevokePubRandCommitResponse{} }
+func (m *MsgRevokePubRandCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokePubRandCommitResponse) ProtoMessage()    {}
+func (*MsgRevokePubRandCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{3}
+}
+func (m *MsgRevokePubRandCommitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokePubRandCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokePubRandCommitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokePubRandCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokePubRandCommitResponse.Merge(m, src)
+}
+func (m *MsgRevokePubRandCommitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokePubRandCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokePubRandCommitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokePubRandCommitResponse proto.InternalMessageInfo
+
 // MsgAddFinalitySig defines a message for adding a finality vote
 type MsgAddFinalitySig struct {
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
@@ -174,7 +275,7 @@ func (m *MsgAddFinalitySig) Reset()         { *m = MsgAddFinalitySig{} }
 func (m *MsgAddFinalitySig) String() string { return proto.CompactTextString(m) }
 func (*MsgAddFinalitySig) ProtoMessage()    {}
 func (*MsgAddFinalitySig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2dd6da066b6baf1d, []int{2}
+	return fileDescriptor_2dd6da066b6baf1d, []int{4}
 }
 func (m *MsgAddFinalitySig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -239,7 +340,7 @@ func (m *MsgAddFinalitySigResponse) Reset()         { *m = MsgAddFinalitySigResp
 func (m *MsgAddFinalitySigResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddFinalitySigResponse) ProtoMessage()    {}
 func (*MsgAddFinalitySigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2dd6da066b6baf1d, []int{3}
+	return fileDescriptor_2dd6da066b6baf1d, []int{5}
 }
 func (m *MsgAddFinalitySigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -268,6 +369,211 @@ func (m *MsgAddFinalitySigResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddFinalitySigResponse proto.InternalMessageInfo
 
+// MsgAddFinalitySigs defines a message for adding finality signatures to a
+// contiguous range of blocks in a batch, e.g., when a finality provider
+// catches up after downtime. All public randomness in the range has to be
+// committed under the same public randomness commitment.
+type MsgAddFinalitySigs struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// fp_btc_pk is the BTC PK of the finality provider that casts these votes
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// start_height is the height of the first voted block
+	StartHeight uint64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// pub_rand_list is the list of public randomness committed at the heights
+	// [start_height, start_height + len(pub_rand_list) - 1]
+	PubRandList []github_com_babylonchain_babylon_types.SchnorrPubRand `protobuf:"bytes,4,rep,name=pub_rand_list,json=pubRandList,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrPubRand" json:"pub_rand_list"`
+	// proof is the proof that all public randomness in the list is committed
+	// under the commitment
+	Proof *RangeProof `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+	// block_app_hash_list is the list of AppHashes of the voted blocks
+	BlockAppHashList [][]byte `protobuf:"bytes,6,rep,name=block_app_hash_list,json=blockAppHashList,proto3" json:"block_app_hash_list,omitempty"`
+	// finality_sig_list is the list of finality signatures to the voted blocks
+	FinalitySigList []github_com_babylonchain_babylon_types.SchnorrEOTSSig `protobuf:"bytes,7,rep,name=finality_sig_list,json=finalitySigList,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrEOTSSig" json:"finality_sig_list"`
+}
+
+func (m *MsgAddFinalitySigs) Reset()         { *m = MsgAddFinalitySigs{} }
+func (m *MsgAddFinalitySigs) String() string { return proto.CompactTextString(m) }
+func (*MsgAddFinalitySigs) ProtoMessage()    {}
+func (*MsgAddFinalitySigs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{6}
+}
+func (m *MsgAddFinalitySigs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddFinalitySigs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddFinalitySigs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddFinalitySigs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddFinalitySigs.Merge(m, src)
+}
+func (m *MsgAddFinalitySigs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddFinalitySigs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddFinalitySigs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddFinalitySigs proto.InternalMessageInfo
+
+func (m *MsgAddFinalitySigs) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgAddFinalitySigs) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *MsgAddFinalitySigs) GetProof() *RangeProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *MsgAddFinalitySigs) GetBlockAppHashList() [][]byte {
+	if m != nil {
+		return m.BlockAppHashList
+	}
+	return nil
+}
+
+// MsgAddFinalitySigsResponse is the response to the MsgAddFinalitySigs message
+type MsgAddFinalitySigsResponse struct {
+}
+
+func (m *MsgAddFinalitySigsResponse) Reset()         { *m = MsgAddFinalitySigsResponse{} }
+func (m *MsgAddFinalitySigsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddFinalitySigsResponse) ProtoMessage()    {}
+func (*MsgAddFinalitySigsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{7}
+}
+func (m *MsgAddFinalitySigsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddFinalitySigsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddFinalitySigsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddFinalitySigsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddFinalitySigsResponse.Merge(m, src)
+}
+func (m *MsgAddFinalitySigsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddFinalitySigsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddFinalitySigsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddFinalitySigsResponse proto.InternalMessageInfo
+
+// MsgUnjailFinalityProvider defines the Msg/UnjailFinalityProvider request type
+type MsgUnjailFinalityProvider struct {
+	// signer is the address of the finality provider
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// fp_btc_pk is the BTC PK of the finality provider to unjail
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+}
+
+func (m *MsgUnjailFinalityProvider) Reset()         { *m = MsgUnjailFinalityProvider{} }
+func (m *MsgUnjailFinalityProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailFinalityProvider) ProtoMessage()    {}
+func (*MsgUnjailFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{8}
+}
+func (m *MsgUnjailFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailFinalityProvider.Merge(m, src)
+}
+func (m *MsgUnjailFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailFinalityProvider proto.InternalMessageInfo
+
+func (m *MsgUnjailFinalityProvider) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// MsgUnjailFinalityProviderResponse defines the Msg/UnjailFinalityProvider response type
+type MsgUnjailFinalityProviderResponse struct {
+}
+
+func (m *MsgUnjailFinalityProviderResponse) Reset()         { *m = MsgUnjailFinalityProviderResponse{} }
+func (m *MsgUnjailFinalityProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailFinalityProviderResponse) ProtoMessage()    {}
+func (*MsgUnjailFinalityProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{9}
+}
+func (m *MsgUnjailFinalityProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailFinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailFinalityProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailFinalityProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailFinalityProviderResponse.Merge(m, src)
+}
+func (m *MsgUnjailFinalityProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailFinalityProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailFinalityProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailFinalityProviderResponse proto.InternalMessageInfo
+
 // MsgUpdateParams defines a message for updating finality module parameters.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -285,7 +591,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2dd6da066b6baf1d, []int{4}
+	return fileDescriptor_2dd6da066b6baf1d, []int{10}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,7 +642,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2dd6da066b6baf1d, []int{5}
+	return fileDescriptor_2dd6da066b6baf1d, []int{11}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -368,8 +674,14 @@ var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*MsgCommitPubRandList)(nil), "babylon.finality.v1.MsgCommitPubRandList")
 	proto.RegisterType((*MsgCommitPubRandListResponse)(nil), "babylon.finality.v1.MsgCommitPubRandListResponse")
+	proto.RegisterType((*MsgRevokePubRandCommit)(nil), "babylon.finality.v1.MsgRevokePubRandCommit")
+	proto.RegisterType((*MsgRevokePubRandCommitResponse)(nil), "babylon.finality.v1.MsgRevokePubRandCommitResponse")
 	proto.RegisterType((*MsgAddFinalitySig)(nil), "babylon.finality.v1.MsgAddFinalitySig")
 	proto.RegisterType((*MsgAddFinalitySigResponse)(nil), "babylon.finality.v1.MsgAddFinalitySigResponse")
+	proto.RegisterType((*MsgAddFinalitySigs)(nil), "babylon.finality.v1.MsgAddFinalitySigs")
+	proto.RegisterType((*MsgAddFinalitySigsResponse)(nil), "babylon.finality.v1.MsgAddFinalitySigsResponse")
+	proto.RegisterType((*MsgUnjailFinalityProvider)(nil), "babylon.finality.v1.MsgUnjailFinalityProvider")
+	proto.RegisterType((*MsgUnjailFinalityProviderResponse)(nil), "babylon.finality.v1.MsgUnjailFinalityProviderResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.finality.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.finality.v1.MsgUpdateParamsResponse")
 }
@@ -377,52 +689,67 @@ func init() {
 func init() { proto.RegisterFile("babylon/finality/v1/tx.proto", fileDescriptor_2dd6da066b6baf1d) }
 
 var fileDescriptor_2dd6da066b6baf1d = []byte{
-	// 712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4f, 0x4f, 0x13, 0x4d,
-	0x1c, 0xee, 0x52, 0x28, 0x2f, 0xd3, 0x86, 0x37, 0xec, 0x4b, 0x5e, 0x96, 0x82, 0xdb, 0xda, 0x10,
-	0x83, 0x44, 0x77, 0xa1, 0x20, 0x11, 0x6e, 0xd4, 0x68, 0x50, 0x6c, 0x6c, 0xb6, 0x7a, 0xd1, 0xc3,
-	0x66, 0xff, 0x75, 0x76, 0x02, 0x3b, 0x33, 0xee, 0xcc, 0x12, 0x7a, 0x33, 0x7e, 0x02, 0x0f, 0x7e,
-	0x10, 0x0e, 0x26, 0x9e, 0xbd, 0x71, 0x31, 0x21, 0x9e, 0x0c, 0x87, 0xc6, 0xc0, 0x81, 0xaf, 0x61,
-	0x76, 0x77, 0x4a, 0x29, 0x94, 0x58, 0x3d, 0x78, 0xdb, 0x99, 0xdf, 0x33, 0xf3, 0x3c, 0xfb, 0x7b,
-	0x9e, 0xdf, 0x80, 0x79, 0xdb, 0xb2, 0xdb, 0x7b, 0x04, 0xeb, 0x2d, 0x84, 0xad, 0x3d, 0xc4, 0xdb,
-	0xfa, 0xfe, 0x8a, 0xce, 0x0f, 0x34, 0x1a, 0x12, 0x4e, 0xe4, 0xff, 0x44, 0x55, 0xeb, 0x56, 0xb5,
-	0xfd, 0x95, 0xe2, 0x34, 0x24, 0x90, 0x24, 0x75, 0x3d, 0xfe, 0x4a, 0xa1, 0xc5, 0x5b, 0xdc, 0xc3,
-	0xae, 0x17, 0x06, 0x08, 0x73, 0xdd, 0x09, 0xdb, 0x94, 0x13, 0x9d, 0x86, 0x84, 0xb4, 0x44, 0x79,
-	0xd6, 0x21, 0x2c, 0x20, 0xcc, 0x4c, 0xcf, 0xa5, 0x0b, 0x51, 0x9a, 0x49, 0x57, 0x7a, 0xc0, 0x60,
-	0x4c, 0x1e, 0x30, 0x28, 0x0a, 0xe5, 0x41, 0xda, 0xa8, 0x15, 0x5a, 0x81, 0x38, 0x5a, 0xf9, 0x32,
-	0x02, 0xa6, 0xeb, 0x0c, 0x3e, 0x22, 0x41, 0x80, 0x78, 0x23, 0xb2, 0x0d, 0x0b, 0xbb, 0xcf, 0x11,
-	0xe3, 0xf2, 0xff, 0x20, 0xc7, 0x10, 0xc4, 0x5e, 0xa8, 0x48, 0x65, 0x69, 0x71, 0xc2, 0x10, 0x2b,
-	0xd9, 0x00, 0x13, 0x2d, 0x6a, 0xda, 0xdc, 0x31, 0xe9, 0xae, 0x32, 0x52, 0x96, 0x16, 0x0b, 0xb5,
-	0xf5, 0x93, 0x4e, 0xa9, 0x0a, 0x11, 0xf7, 0x23, 0x5b, 0x73, 0x48, 0xa0, 0x0b, 0x52, 0xc7, 0xb7,
-	0x10, 0xee, 0x2e, 0x74, 0xde, 0xa6, 0x1e, 0xd3, 0x6a, 0x4f, 0x1b, 0xab, 0x6b, 0xcb, 0x8d, 0xc8,
-	0xde, 0xf1, 0xda, 0xc6, 0x78, 0x8b, 0xd6, 0xb8, 0xd3, 0xd8, 0x95, 0x6f, 0x83, 0x02, 0xe3, 0x56,
-	0xc8, 0x4d, 0xdf, 0x43, 0xd0, 0xe7, 0x4a, 0xb6, 0x2c, 0x2d, 0x8e, 0x1a, 0xf9, 0x64, 0x6f, 0x3b,
-	0xd9, 0x92, 0xcb, 0xa0, 0x80, 0xa3, 0xc0, 0xa4, 0x91, 0x6d, 0x86, 0x16, 0x76, 0x95, 0xd1, 0x04,
-	0x02, 0x70, 0x14, 0x08, 0xd1, 0xb2, 0x0a, 0x80, 0x93, 0xfc, 0x45, 0xe0, 0x61, 0xae, 0x8c, 0xc5,
-	0xca, 0x8c, 0x4b, 0x3b, 0xf2, 0x0e, 0xc8, 0x32, 0x04, 0x95, 0x5c, 0x22, 0x79, 0xe3, 0xa4, 0x53,
-	0x7a, 0xf0, 0x3b, 0x92, 0x9b, 0x08, 0x62, 0x8b, 0x47, 0xa1, 0x67, 0xc4, 0xb7, 0x6c, 0xe6, 0xdf,
-	0x9f, 0x1f, 0x2e, 0x89, 0x96, 0x54, 0x54, 0x30, 0x3f, 0xa8, 0x85, 0x86, 0xc7, 0x28, 0xc1, 0xcc,
-	0xab, 0x7c, 0xce, 0x82, 0xa9, 0x3a, 0x83, 0x5b, 0xae, 0xfb, 0x44, 0xd8, 0xd0, 0x44, 0xf0, 0x6f,
-	0x37, 0xd8, 0xde, 0x23, 0xce, 0xee, 0x95, 0x06, 0x27, 0x7b, 0xa2, 0xc1, 0x4d, 0xf0, 0x4f, 0x5f,
-	0x73, 0x0b, 0xb5, 0x87, 0x27, 0x9d, 0xd2, 0xda, 0x70, 0xac, 0x4d, 0xc7, 0xc7, 0x24, 0x0c, 0xc5,
-	0xcf, 0x1b, 0xe3, 0x54, 0x78, 0xa2, 0x81, 0xb1, 0x24, 0xc2, 0x89, 0x1d, 0xf9, 0xaa, 0xa2, 0xf5,
-	0x22, 0xae, 0xa5, 0x11, 0xd7, 0x1a, 0x71, 0xdd, 0x48, 0x61, 0xf2, 0x02, 0x98, 0x4c, 0x75, 0x5a,
-	0x94, 0x9a, 0xbe, 0xc5, 0xfc, 0xd4, 0x2e, 0x23, 0x55, 0xbf, 0x45, 0xe9, 0xb6, 0xc5, 0x7c, 0xf9,
-	0x0d, 0x28, 0x74, 0xf3, 0x6c, 0xc6, 0x96, 0x8e, 0xff, 0xa1, 0xdc, 0xc7, 0x2f, 0x5e, 0x36, 0x9b,
-	0x08, 0x1a, 0xf9, 0x56, 0xcf, 0x96, 0x7e, 0x67, 0xe7, 0xc0, 0xec, 0x35, 0xe3, 0x2e, 0x6c, 0xfd,
-	0x28, 0x81, 0x7f, 0xeb, 0x0c, 0xbe, 0xa2, 0xae, 0xc5, 0xbd, 0x46, 0x32, 0x54, 0xf2, 0x3a, 0x98,
-	0xb0, 0x22, 0xee, 0x93, 0x10, 0xf1, 0x76, 0xea, 0x6b, 0x4d, 0xf9, 0xf6, 0xe9, 0xfe, 0xb4, 0x18,
-	0xd7, 0x2d, 0xd7, 0x0d, 0x3d, 0xc6, 0x9a, 0x3c, 0x44, 0x18, 0x1a, 0x3d, 0xa8, 0xbc, 0x01, 0x72,
-	0xe9, 0x58, 0x26, 0x8e, 0xe7, 0xab, 0x73, 0xda, 0x80, 0x77, 0x43, 0x4b, 0x49, 0x6a, 0xa3, 0x47,
-	0x9d, 0x52, 0xc6, 0x10, 0x07, 0x36, 0x27, 0x63, 0xc1, 0xbd, 0xab, 0x2a, 0xb3, 0x60, 0xe6, 0x8a,
-	0xaa, 0xae, 0xe2, 0xea, 0xd7, 0x11, 0x90, 0xad, 0x33, 0x28, 0xbf, 0x05, 0x53, 0xd7, 0x07, 0xfe,
-	0xee, 0x40, 0xca, 0x41, 0xc1, 0x2e, 0xae, 0x0c, 0x0d, 0xed, 0x52, 0xcb, 0x3e, 0x98, 0xbc, 0x92,
-	0xff, 0x3b, 0x37, 0x5d, 0xd2, 0x8f, 0x2b, 0x6a, 0xc3, 0xe1, 0x2e, 0x98, 0x6c, 0x50, 0xe8, 0xb3,
-	0x64, 0xe1, 0xa6, 0xf3, 0x97, 0x51, 0xc5, 0x7b, 0xc3, 0xa0, 0xba, 0x1c, 0xc5, 0xb1, 0x77, 0xe7,
-	0x87, 0x4b, 0x52, 0xed, 0xd9, 0xd1, 0xa9, 0x2a, 0x1d, 0x9f, 0xaa, 0xd2, 0x8f, 0x53, 0x55, 0xfa,
-	0x70, 0xa6, 0x66, 0x8e, 0xcf, 0xd4, 0xcc, 0xf7, 0x33, 0x35, 0xf3, 0x7a, 0xf9, 0x57, 0x41, 0x3c,
-	0xe8, 0x3d, 0xc9, 0x49, 0x26, 0xed, 0x5c, 0xf2, 0x1e, 0xaf, 0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff,
-	0x95, 0x73, 0xec, 0x64, 0x4f, 0x06, 0x00, 0x00,
+	// 949 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x73, 0xdb, 0x44,
+	0x14, 0x8e, 0x62, 0xc7, 0x21, 0xcf, 0x26, 0x21, 0x4a, 0x26, 0x55, 0xd4, 0x20, 0xbb, 0xa6, 0x03,
+	0xa1, 0x50, 0x29, 0x3f, 0xda, 0x0c, 0xed, 0x70, 0x89, 0x19, 0x3a, 0x85, 0x92, 0xc1, 0x23, 0xc3,
+	0x05, 0x0e, 0x42, 0xbf, 0x2c, 0x2d, 0xb6, 0xb4, 0x62, 0x77, 0x1d, 0xea, 0x5b, 0x87, 0x3f, 0x80,
+	0xe1, 0xc0, 0xdf, 0xc1, 0xf4, 0xc0, 0xc0, 0x99, 0x5b, 0x8f, 0x1d, 0x4e, 0x4c, 0x0e, 0x19, 0x26,
+	0x39, 0xf4, 0xdf, 0x60, 0xf4, 0xd3, 0x56, 0x2c, 0x4f, 0x9d, 0x02, 0x9d, 0xde, 0xbc, 0xfb, 0x3e,
+	0xed, 0xfb, 0xde, 0xfb, 0xbe, 0xdd, 0x67, 0xd8, 0x32, 0x74, 0x63, 0xd8, 0xc7, 0xbe, 0xd2, 0x45,
+	0xbe, 0xde, 0x47, 0x6c, 0xa8, 0x1c, 0xef, 0x2a, 0xec, 0xa1, 0x1c, 0x10, 0xcc, 0x30, 0xbf, 0x96,
+	0x44, 0xe5, 0x34, 0x2a, 0x1f, 0xef, 0x8a, 0xeb, 0x0e, 0x76, 0x70, 0x14, 0x57, 0xc2, 0x5f, 0x31,
+	0x54, 0x7c, 0x93, 0xd9, 0xbe, 0x65, 0x13, 0x0f, 0xf9, 0x4c, 0x31, 0xc9, 0x30, 0x60, 0x58, 0x09,
+	0x08, 0xc6, 0xdd, 0x24, 0xbc, 0x69, 0x62, 0xea, 0x61, 0xaa, 0xc5, 0xdf, 0xc5, 0x8b, 0x24, 0x74,
+	0x25, 0x5e, 0x29, 0x1e, 0x75, 0xc2, 0xe4, 0x1e, 0x75, 0x92, 0x40, 0xa3, 0x88, 0x5b, 0xa0, 0x13,
+	0xdd, 0x4b, 0x3f, 0x6d, 0x16, 0x21, 0x32, 0xae, 0x11, 0xa6, 0xf9, 0xc7, 0x3c, 0xac, 0x1f, 0x51,
+	0xe7, 0x23, 0xec, 0x79, 0x88, 0xb5, 0x07, 0x86, 0xaa, 0xfb, 0xd6, 0x67, 0x88, 0x32, 0x7e, 0x03,
+	0x2a, 0x14, 0x39, 0xbe, 0x4d, 0x04, 0xae, 0xc1, 0x6d, 0x2f, 0xa9, 0xc9, 0x8a, 0x57, 0x61, 0xa9,
+	0x1b, 0x68, 0x06, 0x33, 0xb5, 0xa0, 0x27, 0xcc, 0x37, 0xb8, 0xed, 0x5a, 0xeb, 0xe0, 0xe4, 0xb4,
+	0xbe, 0xe7, 0x20, 0xe6, 0x0e, 0x0c, 0xd9, 0xc4, 0x9e, 0x92, 0xa4, 0x35, 0x5d, 0x1d, 0xf9, 0xe9,
+	0x42, 0x61, 0xc3, 0xc0, 0xa6, 0x72, 0xeb, 0x93, 0xf6, 0xfe, 0xad, 0x9d, 0xf6, 0xc0, 0x78, 0x60,
+	0x0f, 0xd5, 0xc5, 0x6e, 0xd0, 0x62, 0x66, 0xbb, 0xc7, 0x5f, 0x83, 0x1a, 0x65, 0x3a, 0x61, 0x9a,
+	0x6b, 0x23, 0xc7, 0x65, 0x42, 0xa9, 0xc1, 0x6d, 0x97, 0xd5, 0x6a, 0xb4, 0x77, 0x3f, 0xda, 0xe2,
+	0x1b, 0x50, 0xf3, 0x07, 0x9e, 0x16, 0x0c, 0x0c, 0x8d, 0xe8, 0xbe, 0x25, 0x94, 0x23, 0x08, 0xf8,
+	0x03, 0x2f, 0x21, 0xcd, 0x4b, 0x00, 0x66, 0x54, 0x85, 0x67, 0xfb, 0x4c, 0x58, 0x08, 0x99, 0xa9,
+	0x63, 0x3b, 0xfc, 0x03, 0x28, 0x51, 0xe4, 0x08, 0x95, 0x88, 0xf2, 0x9d, 0x93, 0xd3, 0xfa, 0xed,
+	0xcb, 0x50, 0xee, 0x20, 0xc7, 0xd7, 0xd9, 0x80, 0xd8, 0x6a, 0x78, 0xca, 0xdd, 0xea, 0x0f, 0xcf,
+	0x1e, 0xdf, 0x48, 0x5a, 0xd2, 0x94, 0x60, 0xab, 0xa8, 0x85, 0xaa, 0x4d, 0x03, 0xec, 0x53, 0xbb,
+	0xf9, 0xe3, 0x3c, 0x6c, 0x1c, 0x51, 0x47, 0xb5, 0x8f, 0x71, 0xcf, 0x4e, 0x00, 0x31, 0xfa, 0xa5,
+	0x76, 0x59, 0x86, 0x35, 0x12, 0x51, 0xb0, 0xb4, 0x2e, 0xc1, 0x5e, 0xbe, 0xd9, 0xab, 0x49, 0xe8,
+	0x1e, 0xc1, 0x5e, 0xd2, 0xf2, 0xa4, 0x61, 0xe5, 0xff, 0xbe, 0x61, 0x0d, 0x90, 0x8a, 0xfb, 0x91,
+	0xb5, 0xec, 0xf7, 0x12, 0xac, 0x1e, 0x51, 0xe7, 0xd0, 0xb2, 0xee, 0x25, 0x7e, 0xed, 0x20, 0xe7,
+	0x65, 0x7b, 0xd2, 0xe8, 0x63, 0xb3, 0x77, 0xc1, 0x93, 0xd1, 0x5e, 0xd2, 0xa0, 0x0e, 0xbc, 0x96,
+	0xf3, 0x63, 0xad, 0xf5, 0xc1, 0xc9, 0x69, 0xfd, 0xd6, 0x6c, 0x59, 0x3b, 0xa6, 0xeb, 0x63, 0x42,
+	0x92, 0xf2, 0xd5, 0xc5, 0x20, 0xfe, 0xc1, 0xcb, 0xb0, 0x10, 0xbd, 0x0c, 0x91, 0x83, 0xab, 0x7b,
+	0x82, 0x3c, 0x7a, 0x39, 0xe4, 0xf8, 0xe5, 0x90, 0xdb, 0x61, 0x5c, 0x8d, 0x61, 0xfc, 0x75, 0x58,
+	0x8e, 0x79, 0xea, 0x41, 0xa0, 0xb9, 0x3a, 0x75, 0x63, 0x87, 0xab, 0x31, 0xfb, 0xc3, 0x20, 0xb8,
+	0xaf, 0x53, 0x97, 0xff, 0x1a, 0x6a, 0xe9, 0xc5, 0xd7, 0x42, 0x51, 0x17, 0x5f, 0x90, 0xee, 0xc7,
+	0x9f, 0x7f, 0xd1, 0xe9, 0x20, 0x47, 0xad, 0x76, 0x47, 0xb2, 0xe4, 0xb5, 0xbd, 0x0a, 0x9b, 0x13,
+	0xc2, 0x65, 0xb2, 0x9e, 0x95, 0x80, 0x9f, 0x88, 0xd2, 0x57, 0xed, 0xad, 0xf9, 0x06, 0x5e, 0x4f,
+	0x75, 0xd5, 0xfa, 0x88, 0x32, 0xa1, 0xdc, 0x28, 0x6d, 0xd7, 0x5a, 0x1f, 0x3e, 0x39, 0xad, 0xcf,
+	0xbd, 0xb0, 0xc0, 0xd5, 0x60, 0xec, 0x71, 0xbd, 0x9d, 0x17, 0xb9, 0x2e, 0x17, 0x4c, 0x12, 0x59,
+	0xd5, 0x7d, 0xc7, 0xce, 0x69, 0x7d, 0x13, 0xd6, 0xf2, 0x5a, 0xc7, 0xf4, 0x2a, 0x21, 0x3d, 0xf5,
+	0x8d, 0x71, 0xc1, 0xa3, 0x2c, 0x2e, 0xac, 0x8e, 0x8b, 0x1e, 0x83, 0x17, 0xff, 0x45, 0x2d, 0xa9,
+	0xfa, 0x2b, 0x63, 0xea, 0x87, 0x99, 0xf2, 0x0e, 0xd8, 0x02, 0x71, 0x52, 0xe3, 0xcc, 0x02, 0xbf,
+	0x70, 0x91, 0x41, 0xbe, 0xf4, 0xbf, 0xd5, 0x51, 0x3f, 0x45, 0xb4, 0x09, 0x3e, 0x46, 0x96, 0x4d,
+	0xf8, 0x9d, 0xbc, 0x13, 0x5a, 0xc2, 0x9f, 0xbf, 0xde, 0x5c, 0x4f, 0xe6, 0xe1, 0xa1, 0x65, 0x11,
+	0x9b, 0xd2, 0x0e, 0x23, 0xc8, 0x77, 0xfe, 0x4f, 0x8f, 0xe4, 0xcb, 0x79, 0x0b, 0xae, 0x4d, 0xe5,
+	0x9b, 0x55, 0xf5, 0x33, 0x07, 0x2b, 0x21, 0x2a, 0xb0, 0x74, 0x66, 0xb7, 0xa3, 0x21, 0xcc, 0x1f,
+	0xc0, 0x92, 0x3e, 0x60, 0x2e, 0x26, 0x88, 0x0d, 0x9f, 0x5b, 0xce, 0x08, 0xca, 0xdf, 0x81, 0x4a,
+	0x3c, 0xc6, 0xa3, 0x72, 0xaa, 0x7b, 0x57, 0x0b, 0xdd, 0x11, 0x27, 0x69, 0x95, 0x43, 0x21, 0xd5,
+	0xe4, 0x83, 0xbb, 0xcb, 0x21, 0xf1, 0xd1, 0x51, 0xcd, 0x4d, 0xb8, 0x72, 0x81, 0x55, 0xca, 0x78,
+	0xef, 0xb7, 0x05, 0x28, 0x1d, 0x51, 0x87, 0xff, 0x0e, 0x56, 0x27, 0x87, 0xff, 0xbb, 0x85, 0x29,
+	0x8b, 0x86, 0x9c, 0xb8, 0x3b, 0x33, 0x34, 0x4d, 0xcd, 0x7f, 0x0f, 0x6b, 0x45, 0xb3, 0xf0, 0xbd,
+	0x69, 0x27, 0x15, 0x80, 0xc5, 0xfd, 0x4b, 0x80, 0xb3, 0xc4, 0x2e, 0x2c, 0x5f, 0x98, 0x28, 0x6f,
+	0x4f, 0x3b, 0x26, 0x8f, 0x13, 0xe5, 0xd9, 0x70, 0x59, 0xa6, 0x1e, 0xac, 0x5c, 0x7c, 0xe4, 0xde,
+	0x99, 0xed, 0x08, 0x2a, 0x2a, 0x33, 0x02, 0xb3, 0x64, 0x8f, 0x38, 0xd8, 0x98, 0x72, 0x9f, 0xa6,
+	0xf2, 0x2e, 0xc6, 0x8b, 0x07, 0x97, 0xc3, 0x67, 0x14, 0x0c, 0xa8, 0xe5, 0xbc, 0x7f, 0x7d, 0xea,
+	0x39, 0x63, 0x28, 0xf1, 0xfd, 0x59, 0x50, 0x69, 0x0e, 0x71, 0xe1, 0xd1, 0xb3, 0xc7, 0x37, 0xb8,
+	0xd6, 0xa7, 0x4f, 0xce, 0x24, 0xee, 0xe9, 0x99, 0xc4, 0xfd, 0x7d, 0x26, 0x71, 0x3f, 0x9d, 0x4b,
+	0x73, 0x4f, 0xcf, 0xa5, 0xb9, 0xbf, 0xce, 0xa5, 0xb9, 0xaf, 0x76, 0x9e, 0x77, 0xe7, 0x1f, 0x8e,
+	0xfe, 0x09, 0x47, 0xd7, 0xdf, 0xa8, 0x44, 0x7f, 0x82, 0xf7, 0xff, 0x19, 0x00, 0xa6, 0xb3, 0x7e,
+	0x48, 0xe8, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -439,8 +766,16 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// CommitPubRandList commits a list of public randomness for EOTS
 	CommitPubRandList(ctx context.Context, in *MsgCommitPubRandList, opts ...grpc.CallOption) (*MsgCommitPubRandListResponse, error)
+	// RevokePubRandCommit revokes the unused public randomness committed by a
+	// finality provider from a given height
+	RevokePubRandCommit(ctx context.Context, in *MsgRevokePubRandCommit, opts ...grpc.CallOption) (*MsgRevokePubRandCommitResponse, error)
 	// AddFinalitySig adds a finality signature to a given block
 	AddFinalitySig(ctx context.Context, in *MsgAddFinalitySig, opts ...grpc.CallOption) (*MsgAddFinalitySigResponse, error)
+	// AddFinalitySigs adds finality signatures to a contiguous range of blocks
+	AddFinalitySigs(ctx context.Context, in *MsgAddFinalitySigs, opts ...grpc.CallOption) (*MsgAddFinalitySigsResponse, error)
+	// UnjailFinalityProvider defines a method for unjailing a jailed
+	// finality provider, thus it can receive voting power
+	UnjailFinalityProvider(ctx context.Context, in *MsgUnjailFinalityProvider, opts ...grpc.CallOption) (*MsgUnjailFinalityProviderResponse, error)
 	// TODO: msg for evidence of equivocation. this is not specified yet
 	// UpdateParams updates the finality module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -463,6 +798,15 @@ func (c *msgClient) CommitPubRandList(ctx context.Context, in *MsgCommitPubRandL
 	return out, nil
 }
 
+func (c *msgClient) RevokePubRandCommit(ctx context.Context, in *MsgRevokePubRandCommit, opts ...grpc.CallOption) (*MsgRevokePubRandCommitResponse, error) {
+	out := new(MsgRevokePubRandCommitResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Msg/RevokePubRandCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AddFinalitySig(ctx context.Context, in *MsgAddFinalitySig, opts ...grpc.CallOption) (*MsgAddFinalitySigResponse, error) {
 	out := new(MsgAddFinalitySigResponse)
 	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Msg/AddFinalitySig", in, out, opts...)
@@ -472,6 +816,24 @@ func (c *msgClient) AddFinalitySig(ctx context.Context, in *MsgAddFinalitySig, o
 	return out, nil
 }
 
+func (c *msgClient) AddFinalitySigs(ctx context.Context, in *MsgAddFinalitySigs, opts ...grpc.CallOption) (*MsgAddFinalitySigsResponse, error) {
+	out := new(MsgAddFinalitySigsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Msg/AddFinalitySigs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnjailFinalityProvider(ctx context.Context, in *MsgUnjailFinalityProvider, opts ...grpc.CallOption) (*MsgUnjailFinalityProviderResponse, error) {
+	out := new(MsgUnjailFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Msg/UnjailFinalityProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Msg/UpdateParams", in, out, opts...)
@@ -485,8 +847,16 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 type MsgServer interface {
 	// CommitPubRandList commits a list of public randomness for EOTS
 	CommitPubRandList(context.Context, *MsgCommitPubRandList) (*MsgCommitPubRandListResponse, error)
+	// RevokePubRandCommit revokes the unused public randomness committed by a
+	// finality provider from a given height
+	RevokePubRandCommit(context.Context, *MsgRevokePubRandCommit) (*MsgRevokePubRandCommitResponse, error)
 	// AddFinalitySig adds a finality signature to a given block
 	AddFinalitySig(context.Context, *MsgAddFinalitySig) (*MsgAddFinalitySigResponse, error)
+	// AddFinalitySigs adds finality signatures to a contiguous range of blocks
+	AddFinalitySigs(context.Context, *MsgAddFinalitySigs) (*MsgAddFinalitySigsResponse, error)
+	// UnjailFinalityProvider defines a method for unjailing a jailed
+	// finality provider, thus it can receive voting power
+	UnjailFinalityProvider(context.Context, *MsgUnjailFinalityProvider) (*MsgUnjailFinalityProviderResponse, error)
 	// TODO: msg for evidence of equivocation. this is not specified yet
 	// UpdateParams updates the finality module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -499,9 +869,18 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) CommitPubRandList(ctx context.Context, req *MsgCommitPubRandList) (*MsgCommitPubRandListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommitPubRandList not implemented")
 }
+func (*UnimplementedMsgServer) RevokePubRandCommit(ctx context.Context, req *MsgRevokePubRandCommit) (*MsgRevokePubRandCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePubRandCommit not implemented")
+}
 func (*UnimplementedMsgServer) AddFinalitySig(ctx context.Context, req *MsgAddFinalitySig) (*MsgAddFinalitySigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddFinalitySig not implemented")
 }
+func (*UnimplementedMsgServer) AddFinalitySigs(ctx context.Context, req *MsgAddFinalitySigs) (*MsgAddFinalitySigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFinalitySigs not implemented")
+}
+func (*UnimplementedMsgServer) UnjailFinalityProvider(ctx context.Context, req *MsgUnjailFinalityProvider) (*MsgUnjailFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailFinalityProvider not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -528,6 +907,24 @@ func _Msg_CommitPubRandList_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevokePubRandCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokePubRandCommit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokePubRandCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Msg/RevokePubRandCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokePubRandCommit(ctx, req.(*MsgRevokePubRandCommit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AddFinalitySig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAddFinalitySig)
 	if err := dec(in); err != nil {
@@ -546,6 +943,42 @@ func _Msg_AddFinalitySig_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddFinalitySigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddFinalitySigs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddFinalitySigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Msg/AddFinalitySigs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddFinalitySigs(ctx, req.(*MsgAddFinalitySigs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnjailFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailFinalityProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailFinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Msg/UnjailFinalityProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailFinalityProvider(ctx, req.(*MsgUnjailFinalityProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -572,10 +1005,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommitPubRandList",
 			Handler:    _Msg_CommitPubRandList_Handler,
 		},
+		{
+			MethodName: "RevokePubRandCommit",
+			Handler:    _Msg_RevokePubRandCommit_Handler,
+		},
 		{
 			MethodName: "AddFinalitySig",
 			Handler:    _Msg_AddFinalitySig_Handler,
 		},
+		{
+			MethodName: "AddFinalitySigs",
+			Handler:    _Msg_AddFinalitySigs_Handler,
+		},
+		{
+			MethodName: "UnjailFinalityProvider",
+			Handler:    _Msg_UnjailFinalityProvider_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -679,7 +1124,7 @@ func (m *MsgCommitPubRandListResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddFinalitySig) Marshal() (dAtA []byte, err error) {
+func (m *MsgRevokePubRandCommit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -689,31 +1134,113 @@ func (m *MsgAddFinalitySig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddFinalitySig) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRevokePubRandCommit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddFinalitySig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRevokePubRandCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.FinalitySig != nil {
+	if m.Sig != nil {
 		{
-			size := m.FinalitySig.Size()
+			size := m.Sig.Size()
 			i -= size
-			if _, err := m.FinalitySig.MarshalTo(dAtA[i:]); err != nil {
+			if _, err := m.Sig.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x22
 	}
-	if len(m.BlockAppHash) > 0 {
-		i -= len(m.BlockAppHash)
-		copy(dAtA[i:], m.BlockAppHash)
+	if m.RevokedFromHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RevokedFromHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokePubRandCommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokePubRandCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokePubRandCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddFinalitySig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddFinalitySig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFinalitySig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalitySig != nil {
+		{
+			size := m.FinalitySig.Size()
+			i -= size
+			if _, err := m.FinalitySig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BlockAppHash) > 0 {
+		i -= len(m.BlockAppHash)
+		copy(dAtA[i:], m.BlockAppHash)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockAppHash)))
 		i--
 		dAtA[i] = 0x32
@@ -792,6 +1319,190 @@ func (m *MsgAddFinalitySigResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAddFinalitySigs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddFinalitySigs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFinalitySigs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FinalitySigList) > 0 {
+		for iNdEx := len(m.FinalitySigList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.FinalitySigList[iNdEx].Size()
+				i -= size
+				if _, err := m.FinalitySigList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BlockAppHashList) > 0 {
+		for iNdEx := len(m.BlockAppHashList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockAppHashList[iNdEx])
+			copy(dAtA[i:], m.BlockAppHashList[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.BlockAppHashList[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PubRandList) > 0 {
+		for iNdEx := len(m.PubRandList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PubRandList[iNdEx].Size()
+				i -= size
+				if _, err := m.PubRandList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddFinalitySigsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddFinalitySigsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFinalitySigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -906,6 +1617,39 @@ func (m *MsgCommitPubRandListResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRevokePubRandCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RevokedFromHeight != 0 {
+		n += 1 + sovTx(uint64(m.RevokedFromHeight))
+	}
+	if m.Sig != nil {
+		l = m.Sig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokePubRandCommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgAddFinalitySig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -951,13 +1695,90 @@ func (m *MsgAddFinalitySigResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
+func (m *MsgAddFinalitySigs) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if len(m.PubRandList) > 0 {
+		for _, e := range m.PubRandList {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.BlockAppHashList) > 0 {
+		for _, b := range m.BlockAppHashList {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.FinalitySigList) > 0 {
+		for _, e := range m.FinalitySigList {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddFinalitySigsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnjailFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -1091,16 +1912,546 @@ func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPubRand", wireType)
+			}
+			m.NumPubRand = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPubRand |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.Sig = &v
+			if err := m.Sig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitPubRandListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitPubRandListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitPubRandListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokePubRandCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokePubRandCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokePubRandCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedFromHeight", wireType)
+			}
+			m.RevokedFromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevokedFromHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.Sig = &v
+			if err := m.Sig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokePubRandCommitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokePubRandCommitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokePubRandCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFinalitySig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFinalitySig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumPubRand", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.NumPubRand = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrPubRand
+			m.PubRand = &v
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1110,14 +2461,31 @@ func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumPubRand |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockAppHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1144,14 +2512,14 @@ func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.Commitment == nil {
-				m.Commitment = []byte{}
+			m.BlockAppHash = append(m.BlockAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockAppHash == nil {
+				m.BlockAppHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySig", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1178,9 +2546,9 @@ func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340Signature
-			m.Sig = &v
-			if err := m.Sig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
+			m.FinalitySig = &v
+			if err := m.FinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1205,7 +2573,7 @@ func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCommitPubRandListResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddFinalitySigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1228,10 +2596,10 @@ func (m *MsgCommitPubRandListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCommitPubRandListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddFinalitySigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCommitPubRandListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddFinalitySigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1255,7 +2623,7 @@ func (m *MsgCommitPubRandListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
+func (m *MsgAddFinalitySigs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1278,10 +2646,10 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddFinalitySig: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddFinalitySigs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddFinalitySig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddFinalitySigs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1353,9 +2721,9 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			m.BlockHeight = 0
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1365,14 +2733,14 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				m.StartHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRandList", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1400,8 +2768,8 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_babylonchain_babylon_types.SchnorrPubRand
-			m.PubRand = &v
-			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PubRandList = append(m.PubRandList, v)
+			if err := m.PubRandList[len(m.PubRandList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1435,7 +2803,7 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Proof == nil {
-				m.Proof = &crypto.Proof{}
+				m.Proof = &RangeProof{}
 			}
 			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1443,7 +2811,7 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockAppHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockAppHashList", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1470,14 +2838,12 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockAppHash = append(m.BlockAppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockAppHash == nil {
-				m.BlockAppHash = []byte{}
-			}
+			m.BlockAppHashList = append(m.BlockAppHashList, make([]byte, postIndex-iNdEx))
+			copy(m.BlockAppHashList[len(m.BlockAppHashList)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySigList", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1505,8 +2871,8 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
-			m.FinalitySig = &v
-			if err := m.FinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FinalitySigList = append(m.FinalitySigList, v)
+			if err := m.FinalitySigList[len(m.FinalitySigList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1531,7 +2897,7 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddFinalitySigResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddFinalitySigsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1554,10 +2920,177 @@ func (m *MsgAddFinalitySigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddFinalitySigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddFinalitySigsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddFinalitySigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddFinalitySigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjailFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjailFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,153 @@
+// Package verifier verifies proofs that Babylon blocks are BTC-finalized
+// against trusted Babylon headers. It does not depend on the state of any
+// Babylon node, and can be used by external verifiers such as consumer
+// chains and light clients.
+package verifier
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/rootmulti"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/crypto/eots"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+// VerifyFinalityProof verifies that the block in the given proof is
+// BTC-finalized, given a trusted Babylon header at the proof height.
+// It verifies that
+//   - the block is committed to the trusted header's AppHash as finalized,
+//   - the voting power table and the EOTS signatures at the block's height are
+//     committed to the trusted header's AppHash, and
+//   - each EOTS signature is valid for the block under the finality provider's
+//     public randomness at the block's height, which is committed to the
+//     trusted header's AppHash.
+//
+// All Merkle proofs are verified against the same AppHash, i.e., the same
+// state, such that the voting power table, the EOTS signatures and the public
+// randomness are all at the block's height in this state. The public
+// randomness is only recorded in the state once its Merkle inclusion proof
+// against the finality provider's public randomness commitment is verified
+// upon voting. As the inclusion proof is not kept in the state, the proof of
+// the recorded public randomness stands for it.
+//
+// NOTE: the Merkle proofs cannot prove that the voting power table is complete,
+// as the prover chooses which entries to include, and the voting power
+// distribution cache holding the total voting power is removed from the state
+// once the block is finalized. The proof thus relies only on the block's
+// finalized status committed to the AppHash, and does not check that the
+// finality providers with EOTS signatures hold more than 2/3 of the voting
+// power. The voting power table and EOTS signatures only serve as the evidence
+// of the finalization.
+func VerifyFinalityProof(proof *types.FinalityProof, trustedHeader *cmtproto.Header) error {
+	if err := validateBasic(proof, trustedHeader); err != nil {
+		return types.ErrInvalidFinalityProof.Wrap(err.Error())
+	}
+	root := trustedHeader.AppHash
+	height := proof.Block.Height
+
+	// verify the block is finalized
+	if !proof.Block.Finalized {
+		return types.ErrInvalidFinalityProof.Wrapf("the block at height %d is not finalized", height)
+	}
+	blockBytes, err := proof.Block.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := verifyStore(root, types.StoreKey, types.GetBlockKey(height), blockBytes, proof.ProofBlock); err != nil {
+		return types.ErrInvalidFinalityProof.Wrapf("invalid proof of the block: %v", err)
+	}
+
+	// verify the voting power table
+	vpTable := map[string]struct{}{}
+	for _, vp := range proof.VotingPowers {
+		fpBTCPKHex := vp.FpBtcPk.MarshalHex()
+		if _, ok := vpTable[fpBTCPKHex]; ok {
+			return types.ErrInvalidFinalityProof.Wrapf("duplicated voting power of finality provider %s", fpBTCPKHex)
+		}
+		key := bstypes.GetVotingPowerKey(height, vp.FpBtcPk)
+		if err := verifyStore(root, bstypes.StoreKey, key, sdk.Uint64ToBigEndian(vp.VotingPower), vp.Proof); err != nil {
+			return types.ErrInvalidFinalityProof.Wrapf("invalid proof of the voting power of finality provider %s: %v", fpBTCPKHex, err)
+		}
+		vpTable[fpBTCPKHex] = struct{}{}
+	}
+
+	// verify the EOTS signatures
+	msgToSign := proof.Block.MsgToSign()
+	voters := map[string]struct{}{}
+	for _, sig := range proof.Sigs {
+		fpBTCPKHex := sig.FpBtcPk.MarshalHex()
+		if _, ok := voters[fpBTCPKHex]; ok {
+			return types.ErrInvalidFinalityProof.Wrapf("duplicated EOTS signature of finality provider %s", fpBTCPKHex)
+		}
+		key := types.GetVoteKey(height, sig.FpBtcPk)
+		if err := verifyStore(root, types.StoreKey, key, sig.Sig.MustMarshal(), sig.Proof); err != nil {
+			return types.ErrInvalidFinalityProof.Wrapf("invalid proof of the EOTS signature of finality provider %s: %v", fpBTCPKHex, err)
+		}
+		key = types.GetPubRandKey(height, sig.FpBtcPk)
+		if err := verifyStore(root, types.StoreKey, key, sig.PubRand.MustMarshal(), sig.ProofPubRand); err != nil {
+			return types.ErrInvalidFinalityProof.Wrapf("invalid proof of the public randomness of finality provider %s: %v", fpBTCPKHex, err)
+		}
+		fpBTCPK, err := sig.FpBtcPk.ToBTCPK()
+		if err != nil {
+			return types.ErrInvalidFinalityProof.Wrapf("invalid BTC PK of finality provider %s: %v", fpBTCPKHex, err)
+		}
+		if err := eots.Verify(fpBTCPK, sig.PubRand.ToFieldVal(), msgToSign, sig.Sig.ToModNScalar()); err != nil {
+			return types.ErrInvalidFinalityProof.Wrapf("invalid EOTS signature of finality provider %s: %v", fpBTCPKHex, err)
+		}
+		voters[fpBTCPKHex] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateBasic(proof *types.FinalityProof, trustedHeader *cmtproto.Header) error {
+	if proof == nil {
+		return fmt.Errorf("empty finality proof")
+	}
+	if trustedHeader == nil {
+		return fmt.Errorf("empty trusted header")
+	}
+	if proof.Block == nil || proof.ProofBlock == nil {
+		return fmt.Errorf("empty block or proof of the block")
+	}
+	if trustedHeader.Height < 0 || uint64(trustedHeader.Height) != proof.ProofHeight {
+		return fmt.Errorf("the trusted header is at height %d rather than the proof height %d", trustedHeader.Height, proof.ProofHeight)
+	}
+	if proof.Block.Height >= proof.ProofHeight {
+		return fmt.Errorf("the block height (%d) is not lower than the proof height (%d)", proof.Block.Height, proof.ProofHeight)
+	}
+	for _, vp := range proof.VotingPowers {
+		if vp.FpBtcPk == nil || vp.Proof == nil {
+			return fmt.Errorf("empty finality provider or proof of the voting power")
+		}
+	}
+	for _, sig := range proof.Sigs {
+		if sig.FpBtcPk == nil || sig.Sig == nil || sig.Proof == nil {
+			return fmt.Errorf("empty finality provider, EOTS signature or proof of the EOTS signature")
+		}
+		if sig.PubRand == nil || sig.ProofPubRand == nil {
+			return fmt.Errorf("empty public randomness or proof of the public randomness")
+		}
+	}
+	return nil
+}
+
+// verifyStore verifies whether a KV pair is committed to the given AppHash,
+// with the assistance of a Merkle proof from the given module's KVStore
+func verifyStore(root []byte, moduleStoreKey string, key []byte, value []byte, proof *cmtcrypto.ProofOps) error {
+	if len(value) == 0 {
+		return fmt.Errorf("empty value")
+	}
+
+	keypath := merkle.KeyPath{}
+	keypath = keypath.AppendKey([]byte(moduleStoreKey), merkle.KeyEncodingURL)
+	keypath = keypath.AppendKey(key, merkle.KeyEncodingURL)
+
+	return rootmulti.DefaultProofRuntime().VerifyValue(proof, root, keypath.String(), value)
+}
@@ -26,6 +26,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		CmdQueryRewardGauges(),
 		CmdQueryBTCStakingGauge(),
 		CmdQueryBTCTimestampingGauge(),
+		CmdQueryBTCHeaderReportingGauge(),
 	)
 
 	return cmd
@@ -130,3 +131,30 @@ func CmdQueryBTCTimestampingGauge() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryBTCHeaderReportingGauge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-header-reporting-gauge",
+		Short: "shows the gauge of the pool for rewarding bonded BTC header reporters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BTCHeaderReportingGauge(cmd.Context(), &types.QueryBTCHeaderReportingGaugeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/babylonchain/babylon/x/incentive/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RewardBTCHeaderReporters distributes the coins in the BTC header reporting gauge to the
+// reporters of BTC headers that are settled on the canonical BTC chain. Each settled header
+// earns its reporter the given reward, i.e., a reporter appears in the given list once per
+// settled header. The reporters are rewarded in the given order until the gauge runs out,
+// such that the last rewarded reporter may get less than the given reward.
+func (k Keeper) RewardBTCHeaderReporters(ctx context.Context, reporters []sdk.AccAddress, rewardPerHeader sdk.Coins) {
+	if len(reporters) == 0 || !rewardPerHeader.IsAllPositive() {
+		return
+	}
+	gauge := k.GetBTCHeaderReportingGauge(ctx)
+	if gauge == nil {
+		// nothing to distribute
+		return
+	}
+
+	for _, reporter := range reporters {
+		reward := rewardPerHeader.Min(gauge.Coins)
+		if reward.IsZero() {
+			// the gauge runs out
+			break
+		}
+		k.accumulateRewardGauge(ctx, types.BTCHeaderReporterType, reporter, reward)
+		gauge.Coins = gauge.Coins.Sub(reward...)
+	}
+
+	k.SetBTCHeaderReportingGauge(ctx, gauge)
+}
+
+// FundBTCHeaderReportingPool transfers the given coins from the given module account to the
+// incentive module account, and adds them to the BTC header reporting gauge. It is used for
+// sending the slashed bonds of BTC header reporters to the reporting pool.
+func (k Keeper) FundBTCHeaderReportingPool(ctx context.Context, senderModule string, coins sdk.Coins) error {
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, coins); err != nil {
+		return err
+	}
+	k.addToBTCHeaderReportingGauge(ctx, coins)
+	return nil
+}
+
+func (k Keeper) accumulateBTCHeaderReportingReward(ctx context.Context, btcHeaderReportingReward sdk.Coins) {
+	k.addToBTCHeaderReportingGauge(ctx, btcHeaderReportingReward)
+
+	// transfer the BTC header reporting reward from fee collector account to incentive module account
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, btcHeaderReportingReward)
+	if err != nil {
+		// this can only be programming error and is unrecoverable
+		panic(err)
+	}
+}
+
+func (k Keeper) addToBTCHeaderReportingGauge(ctx context.Context, coins sdk.Coins) {
+	gauge := k.GetBTCHeaderReportingGauge(ctx)
+	if gauge == nil {
+		// if there is no gauge yet, create a new one
+		gauge = types.NewGauge(coins...)
+	} else {
+		// otherwise, accumulate coins in the gauge
+		gauge.Coins = gauge.Coins.Add(coins...)
+	}
+	k.SetBTCHeaderReportingGauge(ctx, gauge)
+}
+
+// SetBTCHeaderReportingGauge sets the gauge of the pool for rewarding bonded BTC header reporters
+func (k Keeper) SetBTCHeaderReportingGauge(ctx context.Context, gauge *types.Gauge) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.BTCHeaderReportingGaugeKey, k.cdc.MustMarshal(gauge))
+}
+
+// GetBTCHeaderReportingGauge returns the gauge of the pool for rewarding bonded BTC header
+// reporters, or nil if the pool has never been funded
+func (k Keeper) GetBTCHeaderReportingGauge(ctx context.Context) *types.Gauge {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	gaugeBytes := store.Get(types.BTCHeaderReportingGaugeKey)
+	if gaugeBytes == nil {
+		return nil
+	}
+
+	var gauge types.Gauge
+	k.cdc.MustUnmarshal(gaugeBytes, &gauge)
+	return &gauge
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/incentive/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzRewardBTCHeaderReporters(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock bank keeper
+		bankKeeper := types.NewMockBankKeeper(ctrl)
+
+		// create incentive keeper
+		keeper, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, nil, nil)
+
+		// fund the reporting pool with a random gauge
+		gauge := datagen.GenRandomGauge(r)
+		senderModule := datagen.GenRandomHexStr(r, 10)
+		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), senderModule, types.ModuleName, gauge.Coins).Return(nil).Times(1)
+		err := keeper.FundBTCHeaderReportingPool(ctx, senderModule, gauge.Coins)
+		require.NoError(t, err)
+		require.Equal(t, gauge.Coins, keeper.GetBTCHeaderReportingGauge(ctx).Coins)
+
+		// generate random reporters, each of which reports a random number of
+		// settled headers
+		numReporters := datagen.RandomInt(r, 10) + 1
+		reporters := []sdk.AccAddress{}
+		numHeadersMap := map[string]int64{} // key: address, value: number of settled headers
+		for i := uint64(0); i < numReporters; i++ {
+			reporter := datagen.GenRandomAccount().GetAddress()
+			numHeaders := int64(datagen.RandomInt(r, 5) + 1)
+			for j := int64(0); j < numHeaders; j++ {
+				reporters = append(reporters, reporter)
+			}
+			numHeadersMap[reporter.String()] = numHeaders
+		}
+
+		// a random reward per header, which may drain the gauge before all
+		// settled headers are rewarded
+		rewardPerHeader := gauge.GetCoinsPortion(math.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 10)+1), 2))
+
+		// distribute rewards in the gauge to reporters
+		keeper.RewardBTCHeaderReporters(ctx, reporters, rewardPerHeader)
+
+		// expected values, where the reporters are rewarded in order until
+		// the gauge runs out
+		remaining := gauge.Coins
+		expectedRewards := map[string]sdk.Coins{} // key: address, value: expected reward
+		for _, reporter := range reporters {
+			reward := rewardPerHeader.Min(remaining)
+			if reward.IsZero() {
+				break
+			}
+			expectedRewards[reporter.String()] = expectedRewards[reporter.String()].Add(reward...)
+			remaining = remaining.Sub(reward...)
+		}
+		distributedCoins := sdk.NewCoins()
+
+		// assert consistency between the number of settled headers and reward gauge
+		for addrStr, numHeaders := range numHeadersMap {
+			addr, err := sdk.AccAddressFromBech32(addrStr)
+			require.NoError(t, err)
+			rg := keeper.GetRewardGauge(ctx, types.BTCHeaderReporterType, addr)
+			reward, ok := expectedRewards[addrStr]
+			if !ok {
+				require.Nil(t, rg)
+				continue
+			}
+			require.NotNil(t, rg)
+			require.Equal(t, reward, rg.Coins)
+			require.True(t, reward.IsAllLTE(rewardPerHeader.MulInt(math.NewInt(numHeaders))))
+			distributedCoins = distributedCoins.Add(reward...)
+		}
+
+		// assert the distributed coins are subtracted from the pool
+		require.True(t, gauge.Coins.Sub(distributedCoins...).Equal(keeper.GetBTCHeaderReportingGauge(ctx).Coins))
+	})
+}
@@ -24,7 +24,10 @@ func (k Keeper) RewardBTCStaking(ctx context.Context, height uint64, filteredDc
 		// get coins that will be allocated to the finality provider and its BTC delegations
 		fpPortion := filteredDc.GetFinalityProviderPortion(fp)
 		coinsForFpsAndDels := gauge.GetCoinsPortion(fpPortion)
-		// reward the finality provider with commission
+		// reward the finality provider with commission. The commission is the
+		// one recorded in the distribution cache at this height rather than the
+		// finality provider's current one, so that a later commission change
+		// does not affect the rewards of already finalised heights
 		coinsForCommission := types.GetCoinsPortion(coinsForFpsAndDels, *fp.Commission)
 		k.accumulateRewardGauge(ctx, types.FinalityProviderType, fp.GetAddress(), coinsForCommission)
 		// reward the rest of coins to each BTC delegation proportional to its voting power portion
@@ -71,3 +71,18 @@ func (k Keeper) BTCTimestampingGauge(goCtx context.Context, req *types.QueryBTCT
 
 	return &types.QueryBTCTimestampingGaugeResponse{Gauge: gauge}, nil
 }
+
+func (k Keeper) BTCHeaderReportingGauge(goCtx context.Context, req *types.QueryBTCHeaderReportingGaugeRequest) (*types.QueryBTCHeaderReportingGaugeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// find gauge
+	gauge := k.GetBTCHeaderReportingGauge(ctx)
+	if gauge == nil {
+		return nil, types.ErrBTCHeaderReportingGaugeNotFound
+	}
+
+	return &types.QueryBTCHeaderReportingGaugeResponse{Gauge: gauge}, nil
+}
@@ -6,7 +6,8 @@ import (
 )
 
 // HandleCoinsInFeeCollector intercepts a portion of coins in fee collector, and distributes
-// them to BTC staking gauge and BTC timestamping gauge of the current height and epoch, respectively.
+// them to BTC staking gauge and BTC timestamping gauge of the current height and epoch, respectively,
+// and to the BTC header reporting gauge.
 // It is invoked upon every `BeginBlock`.
 // adapted from https://github.com/cosmos/cosmos-sdk/blob/release/v0.47.x/x/distribution/keeper/allocation.go#L15-L26
 func (k Keeper) HandleCoinsInFeeCollector(ctx context.Context) {
@@ -37,4 +38,13 @@ func (k Keeper) HandleCoinsInFeeCollector(ctx context.Context) {
 	btcTimestampingPortion := params.BTCTimestampingPortion()
 	btcTimestampingReward := types.GetCoinsPortion(feesCollectedInt, btcTimestampingPortion)
 	k.accumulateBTCTimestampingReward(ctx, btcTimestampingReward)
+
+	// record the reward for BTC header reporting in the reporting pool, and
+	// transfer corresponding amount from fee collector account to incentive
+	// module account
+	btcHeaderReportingPortion := params.BTCHeaderReportingPortion()
+	btcHeaderReportingReward := types.GetCoinsPortion(feesCollectedInt, btcHeaderReportingPortion)
+	if btcHeaderReportingReward.IsAllPositive() {
+		k.accumulateBTCHeaderReportingReward(ctx, btcHeaderReportingReward)
+	}
 }
@@ -6,8 +6,9 @@ import (
 
 // x/incentive module sentinel errors
 var (
-	ErrBTCStakingGaugeNotFound      = errorsmod.Register(ModuleName, 1100, "BTC staking gauge not found")
-	ErrBTCTimestampingGaugeNotFound = errorsmod.Register(ModuleName, 1101, "BTC timestamping gauge not found")
-	ErrRewardGaugeNotFound          = errorsmod.Register(ModuleName, 1102, "reward gauge not found")
-	ErrNoWithdrawableCoins          = errorsmod.Register(ModuleName, 1103, "no coin is withdrawable")
+	ErrBTCStakingGaugeNotFound         = errorsmod.Register(ModuleName, 1100, "BTC staking gauge not found")
+	ErrBTCTimestampingGaugeNotFound    = errorsmod.Register(ModuleName, 1101, "BTC timestamping gauge not found")
+	ErrRewardGaugeNotFound             = errorsmod.Register(ModuleName, 1102, "reward gauge not found")
+	ErrNoWithdrawableCoins             = errorsmod.Register(ModuleName, 1103, "no coin is withdrawable")
+	ErrBTCHeaderReportingGaugeNotFound = errorsmod.Register(ModuleName, 1104, "BTC header reporting gauge not found")
 )
@@ -18,6 +18,17 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: types.DefaultGenesis(),
 			valid:    true,
 		},
+		{
+			desc: "params without BTC header reporting portion are valid",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					SubmitterPortion:  types.DefaultParams().SubmitterPortion,
+					ReporterPortion:   types.DefaultParams().ReporterPortion,
+					BtcStakingPortion: types.DefaultParams().BtcStakingPortion,
+				},
+			},
+			valid: true,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
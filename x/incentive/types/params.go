@@ -18,9 +18,10 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
 	return Params{
-		SubmitterPortion:  math.LegacyNewDecWithPrec(5, 2), // 5 * 10^{-2} = 0.05
-		ReporterPortion:   math.LegacyNewDecWithPrec(5, 2), // 5 * 10^{-2} = 0.05
-		BtcStakingPortion: math.LegacyNewDecWithPrec(2, 1), // 2 * 10^{-1} = 0.2
+		SubmitterPortion:          math.LegacyNewDecWithPrec(5, 2), // 5 * 10^{-2} = 0.05
+		ReporterPortion:           math.LegacyNewDecWithPrec(5, 2), // 5 * 10^{-2} = 0.05
+		BtcStakingPortion:         math.LegacyNewDecWithPrec(2, 1), // 2 * 10^{-1} = 0.2
+		BtcHeaderReportingPortion: math.LegacyZeroDec(),            // disabled until bonded BTC header reporting is enabled
 	}
 }
 
@@ -34,6 +35,7 @@ func (p *Params) TotalPortion() math.LegacyDec {
 	sum := p.SubmitterPortion
 	sum = sum.Add(p.ReporterPortion)
 	sum = sum.Add(p.BtcStakingPortion)
+	sum = sum.Add(p.BTCHeaderReportingPortion())
 	return sum
 }
 
@@ -49,6 +51,16 @@ func (p *Params) BTCStakingPortion() math.LegacyDec {
 	return p.BtcStakingPortion
 }
 
+// BTCHeaderReportingPortion calculates the portion of the pool for rewarding
+// bonded reporters of BTC headers. The portion is zero if it is not set, e.g.,
+// in the params from before bonded BTC header reporting was introduced.
+func (p *Params) BTCHeaderReportingPortion() math.LegacyDec {
+	if p.BtcHeaderReportingPortion.IsNil() {
+		return math.LegacyZeroDec()
+	}
+	return p.BtcHeaderReportingPortion
+}
+
 // Validate validates the set of params
 func (p Params) Validate() error {
 	if p.SubmitterPortion.IsNil() {
@@ -60,6 +72,8 @@ func (p Params) Validate() error {
 	if p.BtcStakingPortion.IsNil() {
 		return fmt.Errorf("BtcStakingPortion should not be nil")
 	}
+	// BtcHeaderReportingPortion is not set in the params from before bonded
+	// BTC header reporting was introduced, in which case it is zero
 
 	// sum of all portions should be less than 1
 	if p.TotalPortion().GTE(math.LegacyOneDec()) {
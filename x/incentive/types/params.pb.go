@@ -38,6 +38,11 @@ type Params struct {
 	// NOTE: the portion of each Finality Provider/delegation is calculated by using its voting
 	// power and finality provider's commission
 	BtcStakingPortion cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=btc_staking_portion,json=btcStakingPortion,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"btc_staking_portion"`
+	// btc_header_reporting_portion is the portion of rewards that goes to the
+	// pool for rewarding bonded reporters of BTC headers. If it is not set,
+	// e.g., in the params from before it was introduced, no rewards go to the
+	// pool.
+	BtcHeaderReportingPortion cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=btc_header_reporting_portion,json=btcHeaderReportingPortion,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"btc_header_reporting_portion"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -79,26 +84,27 @@ func init() {
 func init() { proto.RegisterFile("babylon/incentive/params.proto", fileDescriptor_c42276168f0adf4b) }
 
 var fileDescriptor_c42276168f0adf4b = []byte{
-	// 294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0xcf, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0xd5, 0x2f, 0x48,
-	0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0xca, 0xeb, 0xc1,
-	0xe5, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xb2, 0xfa, 0x20, 0x16, 0x44, 0xa1, 0x94, 0x64,
-	0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x3c, 0x44, 0x02, 0xc2, 0x81, 0x48, 0x29, 0xad, 0x67, 0xe2,
-	0x62, 0x0b, 0x00, 0x1b, 0x2a, 0x14, 0xc7, 0x25, 0x58, 0x5c, 0x9a, 0x94, 0x9b, 0x59, 0x52, 0x92,
-	0x5a, 0x14, 0x5f, 0x90, 0x5f, 0x54, 0x92, 0x99, 0x9f, 0x27, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9,
-	0x64, 0x78, 0xe2, 0x9e, 0x3c, 0xc3, 0xad, 0x7b, 0xf2, 0xd2, 0x10, 0xbd, 0xc5, 0x29, 0xd9, 0x7a,
-	0x99, 0xf9, 0xfa, 0xb9, 0x89, 0x25, 0x19, 0x7a, 0x3e, 0xa9, 0xe9, 0x89, 0xc9, 0x95, 0x2e, 0xa9,
-	0xc9, 0x97, 0xb6, 0xe8, 0x72, 0x41, 0x8d, 0x76, 0x49, 0x4d, 0x0e, 0x12, 0x80, 0x9b, 0x15, 0x00,
-	0x31, 0x4a, 0x28, 0x86, 0x4b, 0xa0, 0x28, 0x15, 0x64, 0x2e, 0x92, 0xf1, 0x4c, 0xe4, 0x1a, 0xcf,
-	0x0f, 0x33, 0x0a, 0x66, 0x7a, 0x22, 0x97, 0x70, 0x52, 0x49, 0x72, 0x7c, 0x71, 0x49, 0x62, 0x76,
-	0x66, 0x5e, 0x3a, 0xdc, 0x02, 0x66, 0x72, 0x2d, 0x10, 0x4c, 0x2a, 0x49, 0x0e, 0x86, 0x18, 0x06,
-	0xb5, 0xc2, 0x8a, 0x65, 0xc6, 0x02, 0x79, 0x06, 0x27, 0xef, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c,
-	0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e,
-	0x3c, 0x96, 0x63, 0x88, 0x32, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
-	0x87, 0x46, 0x4d, 0x72, 0x46, 0x62, 0x66, 0x1e, 0x8c, 0xa3, 0x5f, 0x81, 0x14, 0x93, 0x25, 0x95,
-	0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x58, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x55, 0x13,
-	0x48, 0x09, 0xeb, 0x01, 0x00, 0x00,
+	// 320 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0xd2, 0xb1, 0x4a, 0x03, 0x31,
+	0x18, 0x07, 0xf0, 0x3b, 0x5b, 0x0a, 0x66, 0xb1, 0xad, 0x0e, 0xb6, 0x4a, 0x2a, 0x4e, 0x2e, 0x5e,
+	0x28, 0x6e, 0x8e, 0xa5, 0x83, 0xa0, 0x43, 0xa9, 0x9b, 0x88, 0x47, 0x92, 0x86, 0xbb, 0x50, 0x2f,
+	0x39, 0x92, 0xaf, 0x62, 0xdf, 0xc2, 0xd1, 0x51, 0xf0, 0x15, 0x7c, 0x88, 0x8e, 0xc5, 0x49, 0x1c,
+	0x8a, 0xb4, 0x2f, 0x22, 0xbd, 0xdc, 0x95, 0x9b, 0x6f, 0xbb, 0x8f, 0xff, 0xe5, 0xf7, 0x0f, 0xe4,
+	0x43, 0x98, 0x51, 0x36, 0x7f, 0xd6, 0x8a, 0x48, 0xc5, 0x85, 0x02, 0xf9, 0x22, 0x48, 0x4a, 0x0d,
+	0x4d, 0x6c, 0x90, 0x1a, 0x0d, 0xba, 0xdd, 0xca, 0xf3, 0x60, 0x97, 0x77, 0x8f, 0x22, 0x1d, 0xe9,
+	0x2c, 0x25, 0xdb, 0x2f, 0xf7, 0x63, 0xb7, 0xc3, 0xb5, 0x4d, 0xb4, 0x0d, 0x5d, 0xe0, 0x06, 0x17,
+	0x9d, 0x7f, 0xd6, 0x50, 0x63, 0x94, 0xa1, 0xed, 0x27, 0xd4, 0xb2, 0x33, 0x96, 0x48, 0x00, 0x61,
+	0xc2, 0x54, 0x1b, 0x90, 0x5a, 0x1d, 0xfb, 0x67, 0xfe, 0xc5, 0xfe, 0xa0, 0xbf, 0x58, 0xf5, 0xbc,
+	0xdf, 0x55, 0xef, 0xc4, 0x9d, 0xb5, 0x93, 0x69, 0x20, 0x35, 0x49, 0x28, 0xc4, 0xc1, 0x9d, 0x88,
+	0x28, 0x9f, 0x0f, 0x05, 0xff, 0xfe, 0xba, 0x44, 0x39, 0x3d, 0x14, 0x7c, 0xdc, 0xdc, 0x59, 0x23,
+	0x47, 0xb5, 0x1f, 0x51, 0xd3, 0x88, 0xad, 0x5b, 0xe2, 0xf7, 0xaa, 0xf2, 0x07, 0x05, 0x55, 0xe8,
+	0x14, 0x1d, 0x32, 0xe0, 0xa1, 0x05, 0x3a, 0x95, 0x2a, 0xda, 0x15, 0xd4, 0xaa, 0x16, 0xb4, 0x18,
+	0xf0, 0x7b, 0x87, 0x15, 0x15, 0x06, 0x9d, 0x6e, 0x2b, 0x62, 0x41, 0x27, 0xc2, 0x84, 0xee, 0x02,
+	0xe5, 0xae, 0x7a, 0xd5, 0xae, 0x0e, 0x03, 0x7e, 0x93, 0xa9, 0xe3, 0x02, 0xcd, 0x3b, 0xaf, 0xeb,
+	0xef, 0x1f, 0x3d, 0x6f, 0x70, 0xbb, 0x58, 0x63, 0x7f, 0xb9, 0xc6, 0xfe, 0xdf, 0x1a, 0xfb, 0x6f,
+	0x1b, 0xec, 0x2d, 0x37, 0xd8, 0xfb, 0xd9, 0x60, 0xef, 0xa1, 0x1f, 0x49, 0x88, 0x67, 0x2c, 0xe0,
+	0x3a, 0x21, 0xf9, 0x3a, 0xf0, 0x98, 0x4a, 0x55, 0x0c, 0xe4, 0xb5, 0xb4, 0x3d, 0x30, 0x4f, 0x85,
+	0x65, 0x8d, 0xec, 0xe5, 0xaf, 0xfe, 0x07, 0x00, 0xaa, 0x54, 0xa9, 0xa5, 0x5f, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -121,6 +127,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BtcHeaderReportingPortion.Size()
+		i -= size
+		if _, err := m.BtcHeaderReportingPortion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size := m.BtcStakingPortion.Size()
 		i -= size
@@ -177,6 +193,8 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.BtcStakingPortion.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.BtcHeaderReportingPortion.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -317,6 +335,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeaderReportingPortion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BtcHeaderReportingPortion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
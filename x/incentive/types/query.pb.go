@@ -389,6 +389,89 @@ func (m *QueryBTCTimestampingGaugeResponse) GetGauge() *Gauge {
 	return nil
 }
 
+// QueryBTCHeaderReportingGaugeRequest is request type for the Query/BTCHeaderReportingGauge RPC method.
+type QueryBTCHeaderReportingGaugeRequest struct {
+}
+
+func (m *QueryBTCHeaderReportingGaugeRequest) Reset()         { *m = QueryBTCHeaderReportingGaugeRequest{} }
+func (m *QueryBTCHeaderReportingGaugeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCHeaderReportingGaugeRequest) ProtoMessage()    {}
+func (*QueryBTCHeaderReportingGaugeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{8}
+}
+func (m *QueryBTCHeaderReportingGaugeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCHeaderReportingGaugeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCHeaderReportingGaugeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCHeaderReportingGaugeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCHeaderReportingGaugeRequest.Merge(m, src)
+}
+func (m *QueryBTCHeaderReportingGaugeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCHeaderReportingGaugeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCHeaderReportingGaugeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCHeaderReportingGaugeRequest proto.InternalMessageInfo
+
+// QueryBTCHeaderReportingGaugeResponse is response type for the Query/BTCHeaderReportingGauge RPC method.
+type QueryBTCHeaderReportingGaugeResponse struct {
+	// gauge is the gauge of the pool for rewarding bonded BTC header reporters
+	Gauge *Gauge `protobuf:"bytes,1,opt,name=gauge,proto3" json:"gauge,omitempty"`
+}
+
+func (m *QueryBTCHeaderReportingGaugeResponse) Reset()         { *m = QueryBTCHeaderReportingGaugeResponse{} }
+func (m *QueryBTCHeaderReportingGaugeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCHeaderReportingGaugeResponse) ProtoMessage()    {}
+func (*QueryBTCHeaderReportingGaugeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{9}
+}
+func (m *QueryBTCHeaderReportingGaugeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCHeaderReportingGaugeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCHeaderReportingGaugeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCHeaderReportingGaugeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCHeaderReportingGaugeResponse.Merge(m, src)
+}
+func (m *QueryBTCHeaderReportingGaugeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCHeaderReportingGaugeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCHeaderReportingGaugeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCHeaderReportingGaugeResponse proto.InternalMessageInfo
+
+func (m *QueryBTCHeaderReportingGaugeResponse) GetGauge() *Gauge {
+	if m != nil {
+		return m.Gauge
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.incentive.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.incentive.QueryParamsResponse")
@@ -399,51 +482,56 @@ func init() {
 	proto.RegisterType((*QueryBTCStakingGaugeResponse)(nil), "babylon.incentive.QueryBTCStakingGaugeResponse")
 	proto.RegisterType((*QueryBTCTimestampingGaugeRequest)(nil), "babylon.incentive.QueryBTCTimestampingGaugeRequest")
 	proto.RegisterType((*QueryBTCTimestampingGaugeResponse)(nil), "babylon.incentive.QueryBTCTimestampingGaugeResponse")
+	proto.RegisterType((*QueryBTCHeaderReportingGaugeRequest)(nil), "babylon.incentive.QueryBTCHeaderReportingGaugeRequest")
+	proto.RegisterType((*QueryBTCHeaderReportingGaugeResponse)(nil), "babylon.incentive.QueryBTCHeaderReportingGaugeResponse")
 }
 
 func init() { proto.RegisterFile("babylon/incentive/query.proto", fileDescriptor_e1a59cc0c7c44135) }
 
 var fileDescriptor_e1a59cc0c7c44135 = []byte{
-	// 610 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0xe3, 0xb4, 0x09, 0xf4, 0x5a, 0x04, 0x3d, 0x22, 0xe4, 0x38, 0xc5, 0x24, 0x96, 0x40,
-	0x95, 0x00, 0x5b, 0xcd, 0x1f, 0x15, 0x90, 0x00, 0x29, 0x08, 0x31, 0x20, 0x45, 0xe0, 0x76, 0x62,
-	0x89, 0x2e, 0xce, 0xc9, 0xb1, 0x1a, 0xfb, 0x5c, 0xfb, 0x5c, 0x08, 0x55, 0x16, 0x3e, 0x01, 0x12,
-	0x13, 0x3b, 0x0b, 0xdf, 0x82, 0xb1, 0x63, 0x25, 0x16, 0x26, 0x04, 0x09, 0x1f, 0x04, 0xe5, 0xee,
-	0x1c, 0xb9, 0x8d, 0x5d, 0x5a, 0xb6, 0xf3, 0xfb, 0x3e, 0xef, 0xf3, 0xfe, 0x72, 0x7e, 0x1c, 0x70,
-	0xb3, 0x87, 0x7a, 0xa3, 0x21, 0xf1, 0x0c, 0xc7, 0xb3, 0xb0, 0x47, 0x9d, 0x03, 0x6c, 0xec, 0x47,
-	0x38, 0x18, 0xe9, 0x7e, 0x40, 0x28, 0x81, 0xeb, 0xa2, 0xad, 0xcf, 0xdb, 0x4a, 0xc9, 0x26, 0x36,
-	0x61, 0x5d, 0x63, 0x76, 0xe2, 0x42, 0x65, 0xc3, 0x26, 0xc4, 0x1e, 0x62, 0x03, 0xf9, 0x8e, 0x81,
-	0x3c, 0x8f, 0x50, 0x44, 0x1d, 0xe2, 0x85, 0xa2, 0xab, 0x2e, 0x6e, 0xf1, 0x51, 0x80, 0xdc, 0xb8,
-	0x5f, 0x5b, 0xec, 0xcf, 0x4f, 0x5c, 0xa2, 0x95, 0x00, 0x7c, 0x3d, 0x03, 0x7b, 0xc5, 0xe6, 0x4c,
-	0xbc, 0x1f, 0xe1, 0x90, 0x6a, 0x1d, 0x70, 0xfd, 0x44, 0x35, 0xf4, 0x89, 0x17, 0x62, 0xb8, 0x0d,
-	0x8a, 0xdc, 0x5f, 0x96, 0xaa, 0xd2, 0xe6, 0x6a, 0xbd, 0xac, 0x2f, 0xfc, 0x0e, 0x9d, 0x8f, 0xb4,
-	0x97, 0x8f, 0x7e, 0xde, 0xca, 0x99, 0x42, 0xae, 0x35, 0x81, 0xcc, 0xfc, 0x4c, 0xfc, 0x16, 0x05,
-	0xfd, 0x17, 0x28, 0xb2, 0x71, 0xbc, 0x0b, 0xca, 0xe0, 0x12, 0xea, 0xf7, 0x03, 0x1c, 0x72, 0xd7,
-	0x15, 0x33, 0x7e, 0xd4, 0x7e, 0x4b, 0xa0, 0x9c, 0x32, 0x26, 0x60, 0x2c, 0x70, 0x25, 0x60, 0xf5,
-	0xae, 0xcd, 0x1a, 0xb2, 0x54, 0x5d, 0xda, 0x5c, 0xad, 0x3f, 0x49, 0x61, 0xca, 0x34, 0xd1, 0x93,
-	0xc5, 0xe7, 0x1e, 0x0d, 0x46, 0xe6, 0x5a, 0x90, 0x28, 0x29, 0x5d, 0xb0, 0xbe, 0x20, 0x81, 0xd7,
-	0xc0, 0xd2, 0x1e, 0x1e, 0x09, 0xda, 0xd9, 0x11, 0x36, 0x41, 0xe1, 0x00, 0x0d, 0x23, 0x2c, 0xe7,
-	0xd9, 0xbd, 0xa8, 0x29, 0x0c, 0x09, 0x1b, 0x93, 0x8b, 0x1f, 0xe5, 0x1f, 0x48, 0x5a, 0x0b, 0x54,
-	0x18, 0x5d, 0x7b, 0xf7, 0xd9, 0x0e, 0x45, 0x7b, 0x8e, 0x67, 0x73, 0x89, 0xb8, 0x9c, 0x1b, 0xa0,
-	0x38, 0xc0, 0x8e, 0x3d, 0xa0, 0x6c, 0xdb, 0xb2, 0x29, 0x9e, 0xb4, 0x0e, 0xd8, 0x48, 0x1f, 0x13,
-	0x97, 0xa3, 0x83, 0x02, 0xbb, 0x15, 0xf1, 0xa2, 0xe4, 0x14, 0x20, 0x81, 0xc2, 0x64, 0xda, 0x53,
-	0x50, 0x8d, 0xfd, 0x76, 0x1d, 0x17, 0x87, 0x14, 0xb9, 0xfe, 0x69, 0x96, 0x0a, 0x58, 0xc1, 0x3e,
-	0xb1, 0x06, 0x5d, 0x2f, 0x72, 0x05, 0xce, 0x65, 0x56, 0xe8, 0x44, 0xae, 0xb6, 0x03, 0x6a, 0x67,
-	0x18, 0xfc, 0x1f, 0x55, 0xfd, 0x73, 0x01, 0x14, 0x98, 0x2b, 0x7c, 0x0f, 0x8a, 0x3c, 0x58, 0xf0,
-	0x76, 0xd6, 0xfb, 0x3d, 0x91, 0x60, 0xe5, 0xce, 0xbf, 0x64, 0x1c, 0x49, 0xab, 0x7d, 0xf8, 0xfe,
-	0xe7, 0x53, 0xbe, 0x02, 0xcb, 0x46, 0xd6, 0xb7, 0x04, 0xbf, 0x48, 0x60, 0x2d, 0x19, 0x02, 0x78,
-	0xf7, 0x7c, 0x11, 0xe3, 0x20, 0xf7, 0x2e, 0x92, 0x47, 0xed, 0x21, 0xc3, 0x69, 0xc0, 0xad, 0x14,
-	0x1c, 0xf1, 0x59, 0x18, 0x87, 0xe2, 0x30, 0x36, 0x92, 0xf9, 0x87, 0x5f, 0x25, 0x70, 0xf5, 0x54,
-	0x1c, 0xa0, 0x9e, 0xb5, 0x3c, 0x3d, 0x6e, 0x8a, 0x71, 0x6e, 0xbd, 0xe0, 0x6d, 0x31, 0x5e, 0x03,
-	0xde, 0x4f, 0xe1, 0xed, 0x51, 0xab, 0x1b, 0xf2, 0x21, 0x8e, 0x68, 0x1c, 0xf2, 0xf4, 0x8e, 0xe1,
-	0x37, 0x09, 0x94, 0xd2, 0x92, 0x02, 0x1b, 0x67, 0x00, 0x64, 0x05, 0x53, 0x69, 0x5e, 0x6c, 0x48,
-	0xa0, 0x3f, 0x66, 0xe8, 0xdb, 0xb0, 0x95, 0x81, 0x4e, 0x13, 0x93, 0x31, 0xff, 0x3c, 0xff, 0xe3,
-	0xf6, 0xcb, 0xa3, 0x89, 0x2a, 0x1d, 0x4f, 0x54, 0xe9, 0xd7, 0x44, 0x95, 0x3e, 0x4e, 0xd5, 0xdc,
-	0xf1, 0x54, 0xcd, 0xfd, 0x98, 0xaa, 0xb9, 0x37, 0x5b, 0xb6, 0x43, 0x07, 0x51, 0x4f, 0xb7, 0x88,
-	0x1b, 0x5b, 0x5b, 0x03, 0xe4, 0x78, 0xf3, 0x3d, 0xef, 0x12, 0x9b, 0xe8, 0xc8, 0xc7, 0x61, 0xaf,
-	0xc8, 0xfe, 0x8c, 0x1b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x1f, 0x12, 0x0c, 0x37, 0x06,
-	0x00, 0x00,
+	// 662 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xdf, 0x4e, 0xd4, 0x4e,
+	0x14, 0xc7, 0x77, 0xf8, 0xb3, 0xbf, 0x1f, 0x07, 0x8c, 0x32, 0x12, 0x5d, 0x0a, 0x56, 0xa8, 0x62,
+	0x48, 0xd4, 0x36, 0xfc, 0x13, 0x35, 0x51, 0x13, 0x8c, 0xd1, 0xc4, 0x84, 0x68, 0x21, 0x5e, 0x78,
+	0xb3, 0x99, 0xed, 0x4e, 0xda, 0x06, 0xda, 0x29, 0xed, 0x14, 0x5d, 0x09, 0x37, 0x3e, 0x81, 0x89,
+	0xaf, 0xe0, 0x8d, 0x6f, 0xa1, 0x77, 0x5c, 0x92, 0x78, 0xe3, 0x8d, 0x46, 0xc1, 0x07, 0x31, 0xcc,
+	0x4c, 0x37, 0x85, 0x6d, 0x57, 0x96, 0xbb, 0xe9, 0x39, 0xe7, 0xfb, 0x3d, 0x9f, 0x4e, 0xcf, 0x49,
+	0xe1, 0x4a, 0x83, 0x34, 0x5a, 0x9b, 0x2c, 0xb4, 0xfc, 0xd0, 0xa1, 0x21, 0xf7, 0xb7, 0xa9, 0xb5,
+	0x95, 0xd2, 0xb8, 0x65, 0x46, 0x31, 0xe3, 0x0c, 0x8f, 0xaa, 0xb4, 0xd9, 0x4e, 0x6b, 0x63, 0x2e,
+	0x73, 0x99, 0xc8, 0x5a, 0x47, 0x27, 0x59, 0xa8, 0x4d, 0xba, 0x8c, 0xb9, 0x9b, 0xd4, 0x22, 0x91,
+	0x6f, 0x91, 0x30, 0x64, 0x9c, 0x70, 0x9f, 0x85, 0x89, 0xca, 0xea, 0x9d, 0x5d, 0x22, 0x12, 0x93,
+	0x20, 0xcb, 0x4f, 0x77, 0xe6, 0xdb, 0x27, 0x59, 0x62, 0x8c, 0x01, 0x7e, 0x79, 0x04, 0xf6, 0x42,
+	0xe8, 0x6c, 0xba, 0x95, 0xd2, 0x84, 0x1b, 0xab, 0x70, 0xf1, 0x58, 0x34, 0x89, 0x58, 0x98, 0x50,
+	0xbc, 0x0c, 0x55, 0xe9, 0x5f, 0x43, 0x53, 0x68, 0x76, 0x78, 0x7e, 0xdc, 0xec, 0x78, 0x0f, 0x53,
+	0x4a, 0x56, 0x06, 0xf6, 0x7e, 0x5e, 0xad, 0xd8, 0xaa, 0xdc, 0x58, 0x84, 0x9a, 0xf0, 0xb3, 0xe9,
+	0x1b, 0x12, 0x37, 0x9f, 0x92, 0xd4, 0xa5, 0x59, 0x2f, 0x5c, 0x83, 0xff, 0x48, 0xb3, 0x19, 0xd3,
+	0x44, 0xba, 0x0e, 0xd9, 0xd9, 0xa3, 0xf1, 0x1b, 0xc1, 0x78, 0x81, 0x4c, 0xc1, 0x38, 0x70, 0x2e,
+	0x16, 0xf1, 0xba, 0x2b, 0x12, 0x35, 0x34, 0xd5, 0x3f, 0x3b, 0x3c, 0xff, 0xb0, 0x80, 0xa9, 0xd4,
+	0xc4, 0xcc, 0x07, 0x9f, 0x84, 0x3c, 0x6e, 0xd9, 0x23, 0x71, 0x2e, 0xa4, 0xd5, 0x61, 0xb4, 0xa3,
+	0x04, 0x5f, 0x80, 0xfe, 0x0d, 0xda, 0x52, 0xb4, 0x47, 0x47, 0xbc, 0x08, 0x83, 0xdb, 0x64, 0x33,
+	0xa5, 0xb5, 0x3e, 0x71, 0x2f, 0x7a, 0x01, 0x43, 0xce, 0xc6, 0x96, 0xc5, 0xf7, 0xfb, 0xee, 0x22,
+	0x63, 0x09, 0x26, 0x04, 0xdd, 0xca, 0xfa, 0xe3, 0x35, 0x4e, 0x36, 0xfc, 0xd0, 0x95, 0x25, 0xea,
+	0x72, 0x2e, 0x41, 0xd5, 0xa3, 0xbe, 0xeb, 0x71, 0xd1, 0x6d, 0xc0, 0x56, 0x4f, 0xc6, 0x2a, 0x4c,
+	0x16, 0xcb, 0xd4, 0xe5, 0x98, 0x30, 0x28, 0x6e, 0x45, 0x7d, 0xa8, 0x5a, 0x01, 0x90, 0x42, 0x11,
+	0x65, 0xc6, 0x23, 0x98, 0xca, 0xfc, 0xd6, 0xfd, 0x80, 0x26, 0x9c, 0x04, 0xd1, 0x49, 0x96, 0x09,
+	0x18, 0xa2, 0x11, 0x73, 0xbc, 0x7a, 0x98, 0x06, 0x0a, 0xe7, 0x7f, 0x11, 0x58, 0x4d, 0x03, 0x63,
+	0x0d, 0xa6, 0xbb, 0x18, 0x9c, 0x91, 0x6a, 0x06, 0xae, 0x65, 0xa6, 0xcf, 0x28, 0x69, 0xd2, 0xd8,
+	0xa6, 0x11, 0x8b, 0xf9, 0x09, 0x30, 0xe3, 0x15, 0x5c, 0xef, 0x5e, 0x76, 0xb6, 0xf6, 0xf3, 0x3f,
+	0xaa, 0x30, 0x28, 0x8c, 0xf1, 0x3b, 0xa8, 0xca, 0xb9, 0xc6, 0x33, 0x65, 0xe3, 0x75, 0x6c, 0x81,
+	0xb4, 0x1b, 0xff, 0x2a, 0x93, 0x48, 0xc6, 0xf4, 0xfb, 0x6f, 0x7f, 0x3e, 0xf6, 0x4d, 0xe0, 0x71,
+	0xab, 0x6c, 0x95, 0xf1, 0x27, 0x04, 0x23, 0xf9, 0x19, 0xc4, 0x37, 0x4f, 0x37, 0xe1, 0x12, 0xe4,
+	0x56, 0x2f, 0xeb, 0x60, 0xdc, 0x13, 0x38, 0x0b, 0x78, 0xae, 0x00, 0x47, 0x6d, 0xa5, 0xb5, 0xa3,
+	0x0e, 0xbb, 0x56, 0x7e, 0xfd, 0xf0, 0x67, 0x04, 0xe7, 0x4f, 0x4c, 0x23, 0x36, 0xcb, 0x9a, 0x17,
+	0x4f, 0xbb, 0x66, 0x9d, 0xba, 0x5e, 0xf1, 0x2e, 0x09, 0x5e, 0x0b, 0xdf, 0x2e, 0xe0, 0x6d, 0x70,
+	0xa7, 0x9e, 0x48, 0x91, 0x44, 0xb4, 0x76, 0xe4, 0xf2, 0xec, 0xe2, 0x2f, 0x08, 0xc6, 0x8a, 0x06,
+	0x15, 0x2f, 0x74, 0x01, 0x28, 0xdb, 0x0b, 0x6d, 0xb1, 0x37, 0x91, 0x42, 0x7f, 0x20, 0xd0, 0x97,
+	0xf1, 0x52, 0x09, 0x3a, 0xcf, 0x29, 0x33, 0xfe, 0xf6, 0xfa, 0xed, 0xe2, 0xaf, 0x08, 0x2e, 0x97,
+	0xcc, 0x3b, 0xbe, 0xd3, 0x05, 0xa8, 0xcb, 0x1e, 0x69, 0xcb, 0x3d, 0xeb, 0x4e, 0xf9, 0x19, 0x3c,
+	0x21, 0xae, 0xc7, 0x99, 0x5a, 0xbe, 0xcf, 0xca, 0xf3, 0xbd, 0x03, 0x1d, 0xed, 0x1f, 0xe8, 0xe8,
+	0xd7, 0x81, 0x8e, 0x3e, 0x1c, 0xea, 0x95, 0xfd, 0x43, 0xbd, 0xf2, 0xfd, 0x50, 0xaf, 0xbc, 0x9e,
+	0x73, 0x7d, 0xee, 0xa5, 0x0d, 0xd3, 0x61, 0x41, 0x66, 0xe9, 0x78, 0xc4, 0x0f, 0xdb, 0xfe, 0x6f,
+	0x73, 0x1d, 0x78, 0x2b, 0xa2, 0x49, 0xa3, 0x2a, 0xfe, 0x67, 0x0b, 0x7f, 0x07, 0x00, 0x33, 0xad,
+	0x92, 0x28, 0x7a, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -466,6 +554,8 @@ type QueryClient interface {
 	BTCStakingGauge(ctx context.Context, in *QueryBTCStakingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCStakingGaugeResponse, error)
 	// BTCTimestampingGauge queries the BTC timestamping gauge of a given epoch
 	BTCTimestampingGauge(ctx context.Context, in *QueryBTCTimestampingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCTimestampingGaugeResponse, error)
+	// BTCHeaderReportingGauge queries the gauge of the pool for rewarding bonded BTC header reporters
+	BTCHeaderReportingGauge(ctx context.Context, in *QueryBTCHeaderReportingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCHeaderReportingGaugeResponse, error)
 }
 
 type queryClient struct {
@@ -512,6 +602,15 @@ func (c *queryClient) BTCTimestampingGauge(ctx context.Context, in *QueryBTCTime
 	return out, nil
 }
 
+func (c *queryClient) BTCHeaderReportingGauge(ctx context.Context, in *QueryBTCHeaderReportingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCHeaderReportingGaugeResponse, error) {
+	out := new(QueryBTCHeaderReportingGaugeResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/BTCHeaderReportingGauge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -522,6 +621,8 @@ type QueryServer interface {
 	BTCStakingGauge(context.Context, *QueryBTCStakingGaugeRequest) (*QueryBTCStakingGaugeResponse, error)
 	// BTCTimestampingGauge queries the BTC timestamping gauge of a given epoch
 	BTCTimestampingGauge(context.Context, *QueryBTCTimestampingGaugeRequest) (*QueryBTCTimestampingGaugeResponse, error)
+	// BTCHeaderReportingGauge queries the gauge of the pool for rewarding bonded BTC header reporters
+	BTCHeaderReportingGauge(context.Context, *QueryBTCHeaderReportingGaugeRequest) (*QueryBTCHeaderReportingGaugeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -540,6 +641,9 @@ func (*UnimplementedQueryServer) BTCStakingGauge(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) BTCTimestampingGauge(ctx context.Context, req *QueryBTCTimestampingGaugeRequest) (*QueryBTCTimestampingGaugeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BTCTimestampingGauge not implemented")
 }
+func (*UnimplementedQueryServer) BTCHeaderReportingGauge(ctx context.Context, req *QueryBTCHeaderReportingGaugeRequest) (*QueryBTCHeaderReportingGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCHeaderReportingGauge not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -617,6 +721,24 @@ func _Query_BTCTimestampingGauge_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BTCHeaderReportingGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCHeaderReportingGaugeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCHeaderReportingGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/BTCHeaderReportingGauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCHeaderReportingGauge(ctx, req.(*QueryBTCHeaderReportingGaugeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.incentive.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -637,6 +759,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BTCTimestampingGauge",
 			Handler:    _Query_BTCTimestampingGauge_Handler,
 		},
+		{
+			MethodName: "BTCHeaderReportingGauge",
+			Handler:    _Query_BTCHeaderReportingGauge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/incentive/query.proto",
@@ -903,6 +1029,64 @@ func (m *QueryBTCTimestampingGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBTCHeaderReportingGaugeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCHeaderReportingGaugeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCHeaderReportingGaugeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCHeaderReportingGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCHeaderReportingGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCHeaderReportingGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gauge != nil {
+		{
+			size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1019,6 +1203,28 @@ func (m *QueryBTCTimestampingGaugeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBTCHeaderReportingGaugeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBTCHeaderReportingGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gauge != nil {
+		l = m.Gauge.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1729,6 +1935,142 @@ func (m *QueryBTCTimestampingGaugeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBTCHeaderReportingGaugeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCHeaderReportingGaugeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCHeaderReportingGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCHeaderReportingGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCHeaderReportingGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCHeaderReportingGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gauge == nil {
+				m.Gauge = &Gauge{}
+			}
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
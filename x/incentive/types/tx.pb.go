@@ -34,7 +34,7 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // MsgWithdrawReward defines a message for withdrawing reward of a stakeholder.
 type MsgWithdrawReward struct {
-	// {submitter, reporter, finality_provider, btc_delegation}
+	// {submitter, reporter, finality_provider, btc_delegation, btc_header_reporter}
 	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	// address is the address of the stakeholder in bech32 string
 	// signer of this msg has to be this address
@@ -241,7 +241,7 @@ func init() {
 func init() { proto.RegisterFile("babylon/incentive/tx.proto", fileDescriptor_b4de6776d39a3a22) }
 
 var fileDescriptor_b4de6776d39a3a22 = []byte{
-	// 467 bytes of a gzipped FileDescriptorProto
+	// 464 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6b, 0xd4, 0x40,
 	0x1c, 0xdd, 0xb1, 0x7f, 0xa4, 0x63, 0xa9, 0x74, 0x28, 0x34, 0x9b, 0x43, 0x5a, 0x82, 0x87, 0x65,
 	0xb1, 0x19, 0xb7, 0x82, 0x42, 0x6f, 0xc6, 0xa3, 0x2c, 0x4a, 0x44, 0x04, 0x0f, 0xca, 0x24, 0x19,
@@ -270,8 +270,7 @@ var fileDescriptor_b4de6776d39a3a22 = []byte{
 	0x56, 0xdf, 0x5f, 0xf9, 0x74, 0x71, 0x32, 0x04, 0xf1, 0xb3, 0xd3, 0x59, 0x00, 0xce, 0x66, 0x01,
 	0xf8, 0x3d, 0x0b, 0xc0, 0x97, 0x79, 0xd0, 0x3b, 0x9b, 0x07, 0xbd, 0x1f, 0xf3, 0xa0, 0xf7, 0x66,
 	0xb4, 0xb0, 0x4f, 0x27, 0x9b, 0x15, 0x84, 0x8b, 0xb6, 0xc0, 0xc7, 0x8b, 0x9f, 0x90, 0x59, 0x6f,
-	0xba, 0xda, 0xfc, 0x53, 0x1f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x73, 0x0d, 0x40, 0x64,
-	0x03, 0x00, 0x00,
+	0xba, 0xda, 0xfc, 0x53, 0x1f, 0xfe, 0x19, 0x00, 0xc7, 0x73, 0x0d, 0x40, 0x64, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -17,7 +17,10 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	return nil
 }
 
+// EndBlocker sends the pending BTC staking events to each consumer chain
 func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.BroadcastBTCStakingEvents(ctx)
 	return []abci.ValidatorUpdate{}, nil
 }
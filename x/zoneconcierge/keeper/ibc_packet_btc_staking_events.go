@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+)
+
+// BroadcastBTCStakingEvents sends the BTC staking events pending to each
+// consumer chain over the first open IBC channel to ZoneConcierge of that
+// consumer chain. Only channels built upon the IBC light client registered for
+// the consumer chain are used, such that a channel upon another IBC light
+// client claiming the same chain ID never receives the events. At most one
+// packet of BTC staking events is in flight for each consumer chain, such that
+// the consumer chain receives the events in the order they happened. A packet
+// carries at most `MaxBtcStakingEventsPerPacket` events, and the remaining ones
+// are sent once it is acknowledged. The events are removed once the consumer
+// chain acknowledges them, and are re-sent upon an error acknowledgement or a
+// timeout.
+// This is triggered upon each `EndBlock`.
+func (k Keeper) BroadcastBTCStakingEvents(ctx context.Context) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// get all channels that are open and are connected to ZoneConcierge's port
+	openZCChannels := k.GetAllOpenZCChannels(ctx)
+	if len(openZCChannels) == 0 {
+		return
+	}
+	maxEvents := uint64(k.GetParams(ctx).MaxBtcStakingEventsPerPacketOrDefault())
+
+	// the packets in flight over channels that are no longer open will never
+	// be acknowledged, thus their events are re-sent over another channel
+	openChannelIDs := map[string]struct{}{}
+	for _, channel := range openZCChannels {
+		openChannelIDs[channel.ChannelId] = struct{}{}
+	}
+	k.iterateBTCStakingEventsInFlight(ctx, func(inFlight *types.BTCStakingEventsInFlight) {
+		if _, ok := openChannelIDs[inFlight.ChannelId]; !ok {
+			k.deleteBTCStakingEventsInFlight(ctx, inFlight.ConsumerId)
+		}
+	})
+
+	// consumer chains that BTC staking events have been considered for
+	handledChainIDs := map[string]struct{}{}
+	for _, channel := range openZCChannels {
+		// get the ID of the registered consumer chain under this channel
+		chainID, err := k.getConsumerID(ctx, channel)
+		if err != nil {
+			k.Logger(sdkCtx).Debug("skip sending BTC staking events over a channel not to a registered consumer chain", "channelID", channel.ChannelId, "error", err)
+			continue
+		}
+		if _, ok := handledChainIDs[chainID]; ok {
+			continue
+		}
+		handledChainIDs[chainID] = struct{}{}
+
+		// wait until the packet in flight is acknowledged or timed out
+		if k.hasBTCStakingEventsInFlight(ctx, chainID) {
+			continue
+		}
+
+		events := k.bsKeeper.GetConsumerEvents(ctx, chainID, maxEvents)
+		if len(events) == 0 {
+			continue
+		}
+
+		// wrap BTC staking events to IBC packet and send it
+		packet := types.NewBTCStakingEventsPacketData(events)
+		seq, err := k.SendIBCPacket(ctx, channel, packet)
+		if err != nil {
+			k.Logger(sdkCtx).Error("failed to send BTC staking events IBC packet, retry in the next block", "chainID", chainID, "channelID", channel.ChannelId, "error", err)
+			continue
+		}
+		k.setBTCStakingEventsInFlight(ctx, &types.BTCStakingEventsInFlight{
+			ConsumerId: chainID,
+			ChannelId:  channel.ChannelId,
+			Sequence:   seq,
+			NumEvents:  uint64(len(events)),
+		})
+	}
+}
+
+// OnBTCStakingEventsPacketResult handles the result of the given IBC packet
+// of BTC staking events. If the packet is acknowledged successfully, the BTC
+// staking events carried in it are removed. Otherwise, i.e., upon an error
+// acknowledgement or a timeout, they are kept and re-sent in the next block.
+func (k Keeper) OnBTCStakingEventsPacketResult(ctx context.Context, packet channeltypes.Packet, success bool) {
+	var inFlight *types.BTCStakingEventsInFlight
+	k.iterateBTCStakingEventsInFlight(ctx, func(f *types.BTCStakingEventsInFlight) {
+		if f.ChannelId == packet.SourceChannel && f.Sequence == packet.Sequence {
+			inFlight = f
+		}
+	})
+	if inFlight == nil {
+		// the events of this packet are already re-sent in another packet
+		k.Logger(sdk.UnwrapSDKContext(ctx)).Info("the IBC packet of BTC staking events is not in flight, ignore it", "channelID", packet.SourceChannel, "sequence", packet.Sequence)
+		return
+	}
+
+	if success {
+		k.bsKeeper.RemoveConsumerEvents(ctx, inFlight.ConsumerId, inFlight.NumEvents)
+	}
+	k.deleteBTCStakingEventsInFlight(ctx, inFlight.ConsumerId)
+}
+
+// getConsumerID returns the ID of the registered consumer chain under the
+// given channel. The channel has to be built upon the IBC light client that
+// is registered for the consumer chain, as anyone can create an IBC light
+// client with an arbitrary chain ID.
+func (k Keeper) getConsumerID(ctx context.Context, channel channeltypes.IdentifiedChannel) (string, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	clientID, clientState, err := k.channelKeeper.GetChannelClientState(sdkCtx, channel.PortId, channel.ChannelId)
+	if err != nil {
+		return "", err
+	}
+	cmtClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return "", fmt.Errorf("client must be a Comet client, expected: %T, got: %T", &ibctmtypes.ClientState{}, clientState)
+	}
+	consumerRegister, err := k.bscKeeper.GetConsumerRegister(ctx, cmtClientState.ChainId)
+	if err != nil {
+		return "", err
+	}
+	if consumerRegister.ClientId != clientID {
+		return "", types.ErrInvalidConsumerChannel.Wrapf(
+			"channel %s is built upon client %s, while consumer chain %s is registered with client %s",
+			channel.ChannelId, clientID, consumerRegister.ChainId, consumerRegister.ClientId)
+	}
+	return consumerRegister.ChainId, nil
+}
+
+/* BTC staking events in flight storage */
+
+func (k Keeper) setBTCStakingEventsInFlight(ctx context.Context, inFlight *types.BTCStakingEventsInFlight) {
+	store := k.btcStakingEventsInFlightStore(ctx)
+	store.Set([]byte(inFlight.ConsumerId), k.cdc.MustMarshal(inFlight))
+}
+
+func (k Keeper) hasBTCStakingEventsInFlight(ctx context.Context, consumerID string) bool {
+	store := k.btcStakingEventsInFlightStore(ctx)
+	return store.Has([]byte(consumerID))
+}
+
+func (k Keeper) deleteBTCStakingEventsInFlight(ctx context.Context, consumerID string) {
+	store := k.btcStakingEventsInFlightStore(ctx)
+	store.Delete([]byte(consumerID))
+}
+
+// iterateBTCStakingEventsInFlight iterates over the packets of BTC staking
+// events in flight, one per consumer chain
+func (k Keeper) iterateBTCStakingEventsInFlight(ctx context.Context, handler func(inFlight *types.BTCStakingEventsInFlight)) {
+	inFlights := []*types.BTCStakingEventsInFlight{}
+	// using an enclosure to ensure iterator is closed before the handler
+	// modifies the store
+	func() {
+		iter := k.btcStakingEventsInFlightStore(ctx).Iterator(nil, nil)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			var inFlight types.BTCStakingEventsInFlight
+			k.cdc.MustUnmarshal(iter.Value(), &inFlight)
+			inFlights = append(inFlights, &inFlight)
+		}
+	}()
+	for _, inFlight := range inFlights {
+		handler(inFlight)
+	}
+}
+
+// btcStakingEventsInFlightStore returns the KVStore of the IBC packets of BTC
+// staking events that are not acknowledged yet
+// prefix: BTCStakingEventsInFlightKey
+// key: consumer chain ID
+// value: BTCStakingEventsInFlight
+func (k Keeper) btcStakingEventsInFlightStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BTCStakingEventsInFlightKey)
+}
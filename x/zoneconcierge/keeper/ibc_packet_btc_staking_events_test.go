@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	bsctypes "github.com/babylonchain/babylon/x/btcstkconsumer/types"
+	zckeeper "github.com/babylonchain/babylon/x/zoneconcierge/keeper"
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+)
+
+func FuzzBroadcastBTCStakingEvents(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// an open channel to ZoneConcierge of a consumer chain
+		consumerID := datagen.GenRandomHexStr(r, 10)
+		channel := channeltypes.IdentifiedChannel{
+			State:        channeltypes.OPEN,
+			Ordering:     types.Ordering,
+			Counterparty: channeltypes.NewCounterparty(types.PortID, "channel-1"),
+			PortId:       types.PortID,
+			ChannelId:    "channel-0",
+		}
+		// another open channel upon an IBC light client that claims the
+		// chain ID of the consumer chain but is not registered for it
+		spoofedChannel := channeltypes.IdentifiedChannel{
+			State:        channeltypes.OPEN,
+			Ordering:     types.Ordering,
+			Counterparty: channeltypes.NewCounterparty(types.PortID, "channel-3"),
+			PortId:       types.PortID,
+			ChannelId:    "channel-2",
+		}
+		channelKeeper := types.NewMockChannelKeeper(ctrl)
+		channelKeeper.EXPECT().GetAllChannels(gomock.Any()).Return([]channeltypes.IdentifiedChannel{spoofedChannel, channel}).AnyTimes()
+		channelKeeper.EXPECT().GetChannelClientState(gomock.Any(), channel.PortId, channel.ChannelId).
+			Return("07-tendermint-0", &ibctmtypes.ClientState{ChainId: consumerID}, nil).AnyTimes()
+		channelKeeper.EXPECT().GetChannelClientState(gomock.Any(), spoofedChannel.PortId, spoofedChannel.ChannelId).
+			Return("07-tendermint-1", &ibctmtypes.ClientState{ChainId: consumerID}, nil).AnyTimes()
+		bscKeeper := types.NewMockBTCStkConsumerKeeper(ctrl)
+		bscKeeper.EXPECT().GetConsumerRegister(gomock.Any(), consumerID).
+			Return(&bsctypes.ConsumerRegister{ChainId: consumerID, ClientId: "07-tendermint-0"}, nil).AnyTimes()
+		scopedKeeper := types.NewMockScopedKeeper(ctrl)
+		scopedKeeper.EXPECT().GetCapability(gomock.Any(), gomock.Any()).Return(&capabilitytypes.Capability{}, true).AnyTimes()
+		ics4Wrapper := types.NewMockICS4Wrapper(ctrl)
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+		k, ctx := newZoneConciergeKeeperWithMocks(t, cdc, ics4Wrapper, channelKeeper, scopedKeeper, bsKeeper, bscKeeper, nil)
+
+		// random BTC staking events pending to be sent to the consumer chain,
+		// more than a packet may carry
+		numEvents := int(datagen.RandomInt(r, 10)) + 1
+		events := make([]*bstypes.BTCStakingConsumerEvent, 0, numEvents)
+		for i := 0; i < numEvents; i++ {
+			events = append(events, bstypes.NewConsumerEventWithBTCDel(&bstypes.EventBTCDelegationStateUpdate{
+				StakingTxHash: datagen.GenRandomHexStr(r, 32),
+				NewState:      bstypes.BTCDelegationStatus_ACTIVE,
+			}))
+		}
+		params := types.DefaultParams()
+		params.MaxBtcStakingEventsPerPacket = uint32(datagen.RandomInt(r, numEvents)) + 1
+		err := k.SetParams(ctx, params)
+		require.NoError(t, err)
+		maxEvents := uint64(params.MaxBtcStakingEventsPerPacket)
+		packetData := cdc.MustMarshal(types.NewBTCStakingEventsPacketData(events[:maxEvents]))
+
+		// the first pending events are sent to the consumer chain over the
+		// channel upon its registered IBC light client only
+		bsKeeper.EXPECT().GetConsumerEvents(gomock.Any(), consumerID, maxEvents).Return(events[:maxEvents]).Times(1)
+		ics4Wrapper.EXPECT().SendPacket(gomock.Any(), gomock.Any(), channel.PortId, channel.ChannelId, gomock.Any(), gomock.Any(), packetData).
+			Return(uint64(1), nil).Times(1)
+		k.BroadcastBTCStakingEvents(ctx)
+
+		// no more packet is sent while the packet is in flight
+		k.BroadcastBTCStakingEvents(ctx)
+
+		// an acknowledgement over the spoofed channel does not affect the events
+		k.OnBTCStakingEventsPacketResult(ctx, channeltypes.Packet{SourceChannel: spoofedChannel.ChannelId, Sequence: 1}, true)
+
+		// the events are re-sent upon an error acknowledgement or a timeout
+		k.OnBTCStakingEventsPacketResult(ctx, channeltypes.Packet{SourceChannel: channel.ChannelId, Sequence: 1}, false)
+		bsKeeper.EXPECT().GetConsumerEvents(gomock.Any(), consumerID, maxEvents).Return(events[:maxEvents]).Times(1)
+		ics4Wrapper.EXPECT().SendPacket(gomock.Any(), gomock.Any(), channel.PortId, channel.ChannelId, gomock.Any(), gomock.Any(), packetData).
+			Return(uint64(2), nil).Times(1)
+		k.BroadcastBTCStakingEvents(ctx)
+
+		// the result of a packet that is no longer in flight is ignored
+		k.OnBTCStakingEventsPacketResult(ctx, channeltypes.Packet{SourceChannel: channel.ChannelId, Sequence: 1}, true)
+
+		// the events carried in the packet are removed upon a successful
+		// acknowledgement
+		bsKeeper.EXPECT().RemoveConsumerEvents(gomock.Any(), consumerID, maxEvents).Times(1)
+		k.OnBTCStakingEventsPacketResult(ctx, channeltypes.Packet{SourceChannel: channel.ChannelId, Sequence: 2}, true)
+
+		// the remaining events are sent in the next packet, if any
+		bsKeeper.EXPECT().GetConsumerEvents(gomock.Any(), consumerID, maxEvents).Return(events[maxEvents:]).Times(1)
+		if uint64(numEvents) > maxEvents {
+			remainingPacketData := cdc.MustMarshal(types.NewBTCStakingEventsPacketData(events[maxEvents:]))
+			ics4Wrapper.EXPECT().SendPacket(gomock.Any(), gomock.Any(), channel.PortId, channel.ChannelId, gomock.Any(), gomock.Any(), remainingPacketData).
+				Return(uint64(3), nil).Times(1)
+		}
+		k.BroadcastBTCStakingEvents(ctx)
+	})
+}
+
+func newZoneConciergeKeeperWithMocks(
+	t *testing.T,
+	cdc codec.BinaryCodec,
+	ics4Wrapper types.ICS4Wrapper,
+	channelKeeper types.ChannelKeeper,
+	scopedKeeper types.ScopedKeeper,
+	bsKeeper types.BTCStakingKeeper,
+	bscKeeper types.BTCStkConsumerKeeper,
+	fKeeper types.FinalityKeeper,
+) (*zckeeper.Keeper, sdk.Context) {
+	logger := log.NewTestLogger(t)
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, logger, metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	k := zckeeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		ics4Wrapper,
+		nil,
+		channelKeeper,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		bsKeeper,
+		bscKeeper,
+		fKeeper,
+		nil,
+		scopedKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, logger)
+	ctx = ctx.WithHeaderInfo(header.Info{})
+
+	k.SetPort(ctx, types.PortID)
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+
+	return k, ctx
+}
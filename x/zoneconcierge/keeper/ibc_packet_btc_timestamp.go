@@ -183,10 +183,14 @@ func (k Keeper) getHeadersToBroadcast(ctx context.Context) []*btclctypes.BTCHead
 	// which is still in the main chain.
 	// In most cases it will be header just after the tip, but in case of the forks it may as well be some older header
 	// of the segment
+	// headers below the lowest unpruned height might still be found in the
+	// storage, e.g., at difficulty adjustment boundaries, but the main chain
+	// from them is not contiguous anymore
 	var initHeader *btclctypes.BTCHeaderInfo
+	unprunedHeight := k.btclcKeeper.GetLowestUnprunedHeight(ctx)
 	for i := len(lastSegment.BtcHeaders) - 1; i >= 0; i-- {
 		header := lastSegment.BtcHeaders[i]
-		if k.btclcKeeper.GetHeaderByHash(ctx, header.Hash) != nil {
+		if header.Height >= unprunedHeight && k.btclcKeeper.GetHeaderByHash(ctx, header.Hash) != nil {
 			initHeader = header
 			break
 		}
@@ -194,7 +198,8 @@ func (k Keeper) getHeadersToBroadcast(ctx context.Context) []*btclctypes.BTCHead
 
 	if initHeader == nil {
 		// if initHeader is nil, then this means a reorg happens such that all headers
-		// in the last segment are reverted. In this case, send the last w+1 BTC headers
+		// in the last segment are reverted, or all of them are pruned. In this case,
+		// send the last w+1 BTC headers
 		return k.getDeepEnoughBTCHeaders(ctx)
 	}
 
@@ -251,7 +256,7 @@ func (k Keeper) BroadcastBTCTimestamps(
 		// wrap BTC timestamp to IBC packet
 		packet := types.NewBTCTimestampPacketData(btcTimestamp)
 		// send IBC packet
-		if err := k.SendIBCPacket(ctx, channel, packet); err != nil {
+		if _, err := k.SendIBCPacket(ctx, channel, packet); err != nil {
 			k.Logger(sdkCtx).Error("failed to send BTC timestamp IBC packet, skip sending BTC timestamp for this chain", "chainID", chainID, "channelID", channel.ChannelId, "error", err)
 			continue
 		}
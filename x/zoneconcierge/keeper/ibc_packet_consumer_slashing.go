@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+)
+
+// HandleConsumerSlashingEvidence handles the evidence that a finality
+// provider of a consumer chain has signed two conflicting blocks of the
+// consumer chain, received in the given IBC packet. The consumer chain is
+// identified by the client of the channel the packet is received from, which
+// has to be the client registered for the consumer chain, such that a
+// consumer chain can only slash its own finality providers.
+func (k Keeper) HandleConsumerSlashingEvidence(ctx context.Context, packet channeltypes.Packet, evidence *types.ConsumerSlashingEvidence) error {
+	// get the ID of the consumer chain that sends this packet
+	channel := channeltypes.IdentifiedChannel{
+		PortId:    packet.DestinationPort,
+		ChannelId: packet.DestinationChannel,
+	}
+	consumerID, err := k.getConsumerID(ctx, channel)
+	if err != nil {
+		return err
+	}
+
+	// verify the evidence and slash the finality provider
+	return k.finalityKeeper.SlashConsumerFinalityProvider(ctx, consumerID, evidence.GetEvidence(), evidence.GetPubRandProof())
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bsctypes "github.com/babylonchain/babylon/x/btcstkconsumer/types"
+	finalitytypes "github.com/babylonchain/babylon/x/finality/types"
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+)
+
+func FuzzHandleConsumerSlashingEvidence(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// a packet received from ZoneConcierge of a consumer chain
+		consumerID := datagen.GenRandomHexStr(r, 10)
+		packet := channeltypes.Packet{
+			SourcePort:         types.PortID,
+			SourceChannel:      "channel-1",
+			DestinationPort:    types.PortID,
+			DestinationChannel: "channel-0",
+			Sequence:           datagen.RandomInt(r, 100) + 1,
+		}
+		channelKeeper := types.NewMockChannelKeeper(ctrl)
+		channelKeeper.EXPECT().GetChannelClientState(gomock.Any(), packet.DestinationPort, packet.DestinationChannel).
+			Return("07-tendermint-0", &ibctmtypes.ClientState{ChainId: consumerID}, nil).AnyTimes()
+		bscKeeper := types.NewMockBTCStkConsumerKeeper(ctrl)
+		bscKeeper.EXPECT().GetConsumerRegister(gomock.Any(), consumerID).
+			Return(&bsctypes.ConsumerRegister{ChainId: consumerID, ClientId: "07-tendermint-0"}, nil).AnyTimes()
+		fKeeper := types.NewMockFinalityKeeper(ctrl)
+		cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+		k, ctx := newZoneConciergeKeeperWithMocks(t, cdc, nil, channelKeeper, nil, nil, bscKeeper, fKeeper)
+
+		btcPK, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		evidence := &types.ConsumerSlashingEvidence{
+			Evidence: &finalitytypes.Evidence{
+				FpBtcPk:     btcPK,
+				BlockHeight: datagen.RandomInt(r, 100),
+			},
+		}
+
+		// the evidence is passed to the finality module along with the ID of
+		// the consumer chain that sends it
+		fKeeper.EXPECT().SlashConsumerFinalityProvider(gomock.Any(), consumerID, evidence.Evidence, evidence.PubRandProof).Return(nil).Times(1)
+		err = k.HandleConsumerSlashingEvidence(ctx, packet, evidence)
+		require.NoError(t, err)
+
+		// the error of verifying the evidence is returned
+		expectedErr := errors.New("invalid evidence")
+		fKeeper.EXPECT().SlashConsumerFinalityProvider(gomock.Any(), consumerID, evidence.Evidence, evidence.PubRandProof).Return(expectedErr).Times(1)
+		err = k.HandleConsumerSlashingEvidence(ctx, packet, evidence)
+		require.ErrorIs(t, err, expectedErr)
+
+		// the evidence received over a channel upon an IBC light client that
+		// claims the chain ID of the consumer chain but is not registered for
+		// it is rejected
+		spoofedPacket := packet
+		spoofedPacket.DestinationChannel = "channel-2"
+		channelKeeper.EXPECT().GetChannelClientState(gomock.Any(), spoofedPacket.DestinationPort, spoofedPacket.DestinationChannel).
+			Return("07-tendermint-1", &ibctmtypes.ClientState{ChainId: consumerID}, nil).AnyTimes()
+		err = k.HandleConsumerSlashingEvidence(ctx, spoofedPacket, evidence)
+		require.ErrorIs(t, err, types.ErrInvalidConsumerChannel)
+	})
+}
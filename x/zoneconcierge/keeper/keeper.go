@@ -29,6 +29,9 @@ type (
 		checkpointingKeeper types.CheckpointingKeeper
 		btccKeeper          types.BtcCheckpointKeeper
 		epochingKeeper      types.EpochingKeeper
+		bsKeeper            types.BTCStakingKeeper
+		bscKeeper           types.BTCStkConsumerKeeper
+		finalityKeeper      types.FinalityKeeper
 		storeQuerier        storetypes.Queryable
 		scopedKeeper        types.ScopedKeeper
 		// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -50,6 +53,9 @@ func NewKeeper(
 	checkpointingKeeper types.CheckpointingKeeper,
 	btccKeeper types.BtcCheckpointKeeper,
 	epochingKeeper types.EpochingKeeper,
+	bsKeeper types.BTCStakingKeeper,
+	bscKeeper types.BTCStkConsumerKeeper,
+	finalityKeeper types.FinalityKeeper,
 	storeQuerier storetypes.Queryable,
 	scopedKeeper types.ScopedKeeper,
 	authority string,
@@ -67,6 +73,9 @@ func NewKeeper(
 		checkpointingKeeper: checkpointingKeeper,
 		btccKeeper:          btccKeeper,
 		epochingKeeper:      epochingKeeper,
+		bsKeeper:            bsKeeper,
+		bscKeeper:           bscKeeper,
+		finalityKeeper:      finalityKeeper,
 		storeQuerier:        storeQuerier,
 		scopedKeeper:        scopedKeeper,
 		authority:           authority,
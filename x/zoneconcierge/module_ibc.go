@@ -149,9 +149,26 @@ func (im IBCModule) OnRecvPacket(
 	modulePacket channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
-	// Babylon is supposed to not take any IBC packet
+	var modulePacketData types.ZoneconciergePacketData
+	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error()))
+	}
+
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
-	return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "Babylon is supposed to not take any IBC packet"))
+	switch packet := modulePacketData.Packet.(type) {
+	case *types.ZoneconciergePacketData_ConsumerSlashingEvidence:
+		// a consumer chain reports that one of its finality providers has
+		// double-signed. Upon an error acknowledgement, the state changes
+		// made here are discarded
+		if err := im.keeper.HandleConsumerSlashingEvidence(ctx, modulePacket, packet.ConsumerSlashingEvidence); err != nil {
+			im.keeper.Logger(ctx).Error("failed to handle consumer slashing evidence", "error", err)
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+		return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	default:
+		// Babylon is supposed to not take any other IBC packet
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "Babylon is supposed to not take IBC packet of type %T", packet))
+	}
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface
@@ -173,12 +190,10 @@ func (im IBCModule) OnAcknowledgementPacket(
 		}
 	}
 
-	// // TODO (Babylon): Dispatch and process packet
-	// switch packet := modulePacketData.Packet.(type) {
-	// default:
-	// 	errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
-	// 	return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
-	// }
+	var modulePacketData types.ZoneconciergePacketData
+	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
+	}
 
 	switch resp := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Result:
@@ -201,6 +216,12 @@ func (im IBCModule) OnAcknowledgementPacket(
 		)
 	}
 
+	// BTC staking events are removed upon a successful acknowledgement, and
+	// are re-sent otherwise
+	if _, ok := modulePacketData.Packet.(*types.ZoneconciergePacketData_BtcStakingEvents); ok {
+		im.keeper.OnBTCStakingEventsPacketResult(ctx, modulePacket, ack.Success())
+	}
+
 	return nil
 }
 
@@ -215,12 +236,10 @@ func (im IBCModule) OnTimeoutPacket(
 		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
 	}
 
-	// // TODO (Babylon): Dispatch and process packet
-	// switch packet := modulePacketData.Packet.(type) {
-	// default:
-	// 	errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
-	// 	return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
-	// }
+	// BTC staking events of a timed out packet are re-sent
+	if _, ok := modulePacketData.Packet.(*types.ZoneconciergePacketData_BtcStakingEvents); ok {
+		im.keeper.OnBTCStakingEventsPacketResult(ctx, modulePacket, false)
+	}
 
 	// TODO: close channel upon timeout
 
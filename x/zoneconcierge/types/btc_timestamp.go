@@ -283,7 +283,7 @@ func (ts *BTCTimestamp) Verify(
 	for _, key := range ts.BtcSubmissionKey.Key {
 		header := btclcKeeper.GetHeaderByHash(ctx, key.Hash)
 		if header == nil {
-			return fmt.Errorf("header corresponding to the inclusion proof is not on BTC light client")
+			return fmt.Errorf("header corresponding to the inclusion proof is not on BTC light client, or has been pruned")
 		}
 		btcHeadersWithCkpt = append(btcHeadersWithCkpt, header.Header.ToBlockHeader())
 
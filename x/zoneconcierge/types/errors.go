@@ -16,4 +16,5 @@ var (
 	ErrInvalidMerkleProof      = errorsmod.Register(ModuleName, 1108, "invalid Merkle inclusion proof")
 	ErrInvalidChainInfo        = errorsmod.Register(ModuleName, 1109, "invalid chain info")
 	ErrInvalidChainIDs         = errorsmod.Register(ModuleName, 1110, "chain ids contain duplicates or empty strings")
+	ErrInvalidConsumerChannel  = errorsmod.Register(ModuleName, 1111, "channel is not built upon the IBC light client of a registered consumer chain")
 )
@@ -8,8 +8,12 @@ import (
 
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	bsctypes "github.com/babylonchain/babylon/x/btcstkconsumer/types"
 	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
 	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
+	finalitytypes "github.com/babylonchain/babylon/x/finality/types"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
@@ -84,6 +88,7 @@ type BTCLightClientKeeper interface {
 	GetMainChainFrom(ctx context.Context, startHeight uint64) []*btclctypes.BTCHeaderInfo
 	GetMainChainUpTo(ctx context.Context, depth uint64) []*btclctypes.BTCHeaderInfo
 	GetHeaderByHash(ctx context.Context, hash *bbn.BTCHeaderHashBytes) *btclctypes.BTCHeaderInfo
+	GetLowestUnprunedHeight(ctx context.Context) uint64
 }
 
 type BtcCheckpointKeeper interface {
@@ -105,6 +110,19 @@ type EpochingKeeper interface {
 	GetEpoch(ctx context.Context) *epochingtypes.Epoch
 }
 
+type BTCStakingKeeper interface {
+	GetConsumerEvents(ctx context.Context, consumerID string, maxEvents uint64) []*bstypes.BTCStakingConsumerEvent
+	RemoveConsumerEvents(ctx context.Context, consumerID string, numEvents uint64)
+}
+
+type BTCStkConsumerKeeper interface {
+	GetConsumerRegister(ctx context.Context, chainID string) (*bsctypes.ConsumerRegister, error)
+}
+
+type FinalityKeeper interface {
+	SlashConsumerFinalityProvider(ctx context.Context, consumerID string, evidence *finalitytypes.Evidence, pubRandProof *cmtcrypto.Proof) error
+}
+
 // CometClient is a Comet client that allows to query tx inclusion proofs
 type CometClient interface {
 	Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error)
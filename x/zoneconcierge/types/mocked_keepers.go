@@ -11,14 +11,18 @@ import (
 	types "github.com/babylonchain/babylon/types"
 	types0 "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	types1 "github.com/babylonchain/babylon/x/btclightclient/types"
-	types2 "github.com/babylonchain/babylon/x/checkpointing/types"
-	types3 "github.com/babylonchain/babylon/x/epoching/types"
+	types2 "github.com/babylonchain/babylon/x/btcstaking/types"
+	types3 "github.com/babylonchain/babylon/x/btcstkconsumer/types"
+	types4 "github.com/babylonchain/babylon/x/checkpointing/types"
+	types5 "github.com/babylonchain/babylon/x/epoching/types"
+	types6 "github.com/babylonchain/babylon/x/finality/types"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
-	types4 "github.com/cosmos/cosmos-sdk/types"
-	types5 "github.com/cosmos/ibc-go/modules/capability/types"
-	types6 "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
-	types7 "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
-	types8 "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	types7 "github.com/cosmos/cosmos-sdk/types"
+	types8 "github.com/cosmos/ibc-go/modules/capability/types"
+	types9 "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	types10 "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	types11 "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	exported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -47,10 +51,10 @@ func (m *MockAccountKeeper) EXPECT() *MockAccountKeeperMockRecorder {
 }
 
 // GetModuleAccount mocks base method.
-func (m *MockAccountKeeper) GetModuleAccount(ctx context.Context, name string) types4.ModuleAccountI {
+func (m *MockAccountKeeper) GetModuleAccount(ctx context.Context, name string) types7.ModuleAccountI {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetModuleAccount", ctx, name)
-	ret0, _ := ret[0].(types4.ModuleAccountI)
+	ret0, _ := ret[0].(types7.ModuleAccountI)
 	return ret0
 }
 
@@ -61,10 +65,10 @@ func (mr *MockAccountKeeperMockRecorder) GetModuleAccount(ctx, name interface{})
 }
 
 // GetModuleAddress mocks base method.
-func (m *MockAccountKeeper) GetModuleAddress(name string) types4.AccAddress {
+func (m *MockAccountKeeper) GetModuleAddress(name string) types7.AccAddress {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetModuleAddress", name)
-	ret0, _ := ret[0].(types4.AccAddress)
+	ret0, _ := ret[0].(types7.AccAddress)
 	return ret0
 }
 
@@ -98,7 +102,7 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 }
 
 // BlockedAddr mocks base method.
-func (m *MockBankKeeper) BlockedAddr(addr types4.AccAddress) bool {
+func (m *MockBankKeeper) BlockedAddr(addr types7.AccAddress) bool {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BlockedAddr", addr)
 	ret0, _ := ret[0].(bool)
@@ -112,7 +116,7 @@ func (mr *MockBankKeeperMockRecorder) BlockedAddr(addr interface{}) *gomock.Call
 }
 
 // BurnCoins mocks base method.
-func (m *MockBankKeeper) BurnCoins(ctx context.Context, moduleName string, amt types4.Coins) error {
+func (m *MockBankKeeper) BurnCoins(ctx context.Context, moduleName string, amt types7.Coins) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BurnCoins", ctx, moduleName, amt)
 	ret0, _ := ret[0].(error)
@@ -126,7 +130,7 @@ func (mr *MockBankKeeperMockRecorder) BurnCoins(ctx, moduleName, amt interface{}
 }
 
 // MintCoins mocks base method.
-func (m *MockBankKeeper) MintCoins(ctx context.Context, moduleName string, amt types4.Coins) error {
+func (m *MockBankKeeper) MintCoins(ctx context.Context, moduleName string, amt types7.Coins) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "MintCoins", ctx, moduleName, amt)
 	ret0, _ := ret[0].(error)
@@ -140,7 +144,7 @@ func (mr *MockBankKeeperMockRecorder) MintCoins(ctx, moduleName, amt interface{}
 }
 
 // SendCoins mocks base method.
-func (m *MockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr types4.AccAddress, amt types4.Coins) error {
+func (m *MockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr types7.AccAddress, amt types7.Coins) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendCoins", ctx, fromAddr, toAddr, amt)
 	ret0, _ := ret[0].(error)
@@ -154,7 +158,7 @@ func (mr *MockBankKeeperMockRecorder) SendCoins(ctx, fromAddr, toAddr, amt inter
 }
 
 // SendCoinsFromAccountToModule mocks base method.
-func (m *MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr types4.AccAddress, recipientModule string, amt types4.Coins) error {
+func (m *MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr types7.AccAddress, recipientModule string, amt types7.Coins) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendCoinsFromAccountToModule", ctx, senderAddr, recipientModule, amt)
 	ret0, _ := ret[0].(error)
@@ -168,7 +172,7 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromAccountToModule(ctx, senderAd
 }
 
 // SendCoinsFromModuleToAccount mocks base method.
-func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types4.AccAddress, amt types4.Coins) error {
+func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types7.AccAddress, amt types7.Coins) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendCoinsFromModuleToAccount", ctx, senderModule, recipientAddr, amt)
 	ret0, _ := ret[0].(error)
@@ -205,7 +209,7 @@ func (m *MockICS4Wrapper) EXPECT() *MockICS4WrapperMockRecorder {
 }
 
 // SendPacket mocks base method.
-func (m *MockICS4Wrapper) SendPacket(ctx types4.Context, channelCap *types5.Capability, sourcePort, sourceChannel string, timeoutHeight types6.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
+func (m *MockICS4Wrapper) SendPacket(ctx types7.Context, channelCap *types8.Capability, sourcePort, sourceChannel string, timeoutHeight types9.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendPacket", ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	ret0, _ := ret[0].(uint64)
@@ -243,10 +247,10 @@ func (m *MockChannelKeeper) EXPECT() *MockChannelKeeperMockRecorder {
 }
 
 // GetAllChannels mocks base method.
-func (m *MockChannelKeeper) GetAllChannels(ctx types4.Context) []types8.IdentifiedChannel {
+func (m *MockChannelKeeper) GetAllChannels(ctx types7.Context) []types11.IdentifiedChannel {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAllChannels", ctx)
-	ret0, _ := ret[0].([]types8.IdentifiedChannel)
+	ret0, _ := ret[0].([]types11.IdentifiedChannel)
 	return ret0
 }
 
@@ -257,10 +261,10 @@ func (mr *MockChannelKeeperMockRecorder) GetAllChannels(ctx interface{}) *gomock
 }
 
 // GetChannel mocks base method.
-func (m *MockChannelKeeper) GetChannel(ctx types4.Context, srcPort, srcChan string) (types8.Channel, bool) {
+func (m *MockChannelKeeper) GetChannel(ctx types7.Context, srcPort, srcChan string) (types11.Channel, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetChannel", ctx, srcPort, srcChan)
-	ret0, _ := ret[0].(types8.Channel)
+	ret0, _ := ret[0].(types11.Channel)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
@@ -272,7 +276,7 @@ func (mr *MockChannelKeeperMockRecorder) GetChannel(ctx, srcPort, srcChan interf
 }
 
 // GetChannelClientState mocks base method.
-func (m *MockChannelKeeper) GetChannelClientState(ctx types4.Context, portID, channelID string) (string, exported.ClientState, error) {
+func (m *MockChannelKeeper) GetChannelClientState(ctx types7.Context, portID, channelID string) (string, exported.ClientState, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetChannelClientState", ctx, portID, channelID)
 	ret0, _ := ret[0].(string)
@@ -288,7 +292,7 @@ func (mr *MockChannelKeeperMockRecorder) GetChannelClientState(ctx, portID, chan
 }
 
 // GetNextSequenceSend mocks base method.
-func (m *MockChannelKeeper) GetNextSequenceSend(ctx types4.Context, portID, channelID string) (uint64, bool) {
+func (m *MockChannelKeeper) GetNextSequenceSend(ctx types7.Context, portID, channelID string) (uint64, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetNextSequenceSend", ctx, portID, channelID)
 	ret0, _ := ret[0].(uint64)
@@ -326,7 +330,7 @@ func (m *MockClientKeeper) EXPECT() *MockClientKeeperMockRecorder {
 }
 
 // GetClientState mocks base method.
-func (m *MockClientKeeper) GetClientState(ctx types4.Context, clientID string) (exported.ClientState, bool) {
+func (m *MockClientKeeper) GetClientState(ctx types7.Context, clientID string) (exported.ClientState, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetClientState", ctx, clientID)
 	ret0, _ := ret[0].(exported.ClientState)
@@ -341,7 +345,7 @@ func (mr *MockClientKeeperMockRecorder) GetClientState(ctx, clientID interface{}
 }
 
 // SetClientState mocks base method.
-func (m *MockClientKeeper) SetClientState(ctx types4.Context, clientID string, clientState exported.ClientState) {
+func (m *MockClientKeeper) SetClientState(ctx types7.Context, clientID string, clientState exported.ClientState) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "SetClientState", ctx, clientID, clientState)
 }
@@ -376,10 +380,10 @@ func (m *MockConnectionKeeper) EXPECT() *MockConnectionKeeperMockRecorder {
 }
 
 // GetConnection mocks base method.
-func (m *MockConnectionKeeper) GetConnection(ctx types4.Context, connectionID string) (types7.ConnectionEnd, bool) {
+func (m *MockConnectionKeeper) GetConnection(ctx types7.Context, connectionID string) (types10.ConnectionEnd, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetConnection", ctx, connectionID)
-	ret0, _ := ret[0].(types7.ConnectionEnd)
+	ret0, _ := ret[0].(types10.ConnectionEnd)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
@@ -414,10 +418,10 @@ func (m *MockPortKeeper) EXPECT() *MockPortKeeperMockRecorder {
 }
 
 // BindPort mocks base method.
-func (m *MockPortKeeper) BindPort(ctx types4.Context, portID string) *types5.Capability {
+func (m *MockPortKeeper) BindPort(ctx types7.Context, portID string) *types8.Capability {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BindPort", ctx, portID)
-	ret0, _ := ret[0].(*types5.Capability)
+	ret0, _ := ret[0].(*types8.Capability)
 	return ret0
 }
 
@@ -451,7 +455,7 @@ func (m *MockScopedKeeper) EXPECT() *MockScopedKeeperMockRecorder {
 }
 
 // AuthenticateCapability mocks base method.
-func (m *MockScopedKeeper) AuthenticateCapability(ctx types4.Context, cap *types5.Capability, name string) bool {
+func (m *MockScopedKeeper) AuthenticateCapability(ctx types7.Context, cap *types8.Capability, name string) bool {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AuthenticateCapability", ctx, cap, name)
 	ret0, _ := ret[0].(bool)
@@ -465,7 +469,7 @@ func (mr *MockScopedKeeperMockRecorder) AuthenticateCapability(ctx, cap, name in
 }
 
 // ClaimCapability mocks base method.
-func (m *MockScopedKeeper) ClaimCapability(ctx types4.Context, cap *types5.Capability, name string) error {
+func (m *MockScopedKeeper) ClaimCapability(ctx types7.Context, cap *types8.Capability, name string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ClaimCapability", ctx, cap, name)
 	ret0, _ := ret[0].(error)
@@ -479,10 +483,10 @@ func (mr *MockScopedKeeperMockRecorder) ClaimCapability(ctx, cap, name interface
 }
 
 // GetCapability mocks base method.
-func (m *MockScopedKeeper) GetCapability(ctx types4.Context, name string) (*types5.Capability, bool) {
+func (m *MockScopedKeeper) GetCapability(ctx types7.Context, name string) (*types8.Capability, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetCapability", ctx, name)
-	ret0, _ := ret[0].(*types5.Capability)
+	ret0, _ := ret[0].(*types8.Capability)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
@@ -494,11 +498,11 @@ func (mr *MockScopedKeeperMockRecorder) GetCapability(ctx, name interface{}) *go
 }
 
 // LookupModules mocks base method.
-func (m *MockScopedKeeper) LookupModules(ctx types4.Context, name string) ([]string, *types5.Capability, error) {
+func (m *MockScopedKeeper) LookupModules(ctx types7.Context, name string) ([]string, *types8.Capability, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "LookupModules", ctx, name)
 	ret0, _ := ret[0].([]string)
-	ret1, _ := ret[1].(*types5.Capability)
+	ret1, _ := ret[1].(*types8.Capability)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
@@ -546,6 +550,20 @@ func (mr *MockBTCLightClientKeeperMockRecorder) GetHeaderByHash(ctx, hash interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeaderByHash", reflect.TypeOf((*MockBTCLightClientKeeper)(nil).GetHeaderByHash), ctx, hash)
 }
 
+// GetLowestUnprunedHeight mocks base method.
+func (m *MockBTCLightClientKeeper) GetLowestUnprunedHeight(ctx context.Context) uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLowestUnprunedHeight", ctx)
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// GetLowestUnprunedHeight indicates an expected call of GetLowestUnprunedHeight.
+func (mr *MockBTCLightClientKeeperMockRecorder) GetLowestUnprunedHeight(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLowestUnprunedHeight", reflect.TypeOf((*MockBTCLightClientKeeper)(nil).GetLowestUnprunedHeight), ctx)
+}
+
 // GetMainChainFrom mocks base method.
 func (m *MockBTCLightClientKeeper) GetMainChainFrom(ctx context.Context, startHeight uint64) []*types1.BTCHeaderInfo {
 	m.ctrl.T.Helper()
@@ -707,10 +725,10 @@ func (m *MockCheckpointingKeeper) EXPECT() *MockCheckpointingKeeperMockRecorder
 }
 
 // GetBLSPubKeySet mocks base method.
-func (m *MockCheckpointingKeeper) GetBLSPubKeySet(ctx context.Context, epochNumber uint64) ([]*types2.ValidatorWithBlsKey, error) {
+func (m *MockCheckpointingKeeper) GetBLSPubKeySet(ctx context.Context, epochNumber uint64) ([]*types4.ValidatorWithBlsKey, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetBLSPubKeySet", ctx, epochNumber)
-	ret0, _ := ret[0].([]*types2.ValidatorWithBlsKey)
+	ret0, _ := ret[0].([]*types4.ValidatorWithBlsKey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -736,10 +754,10 @@ func (mr *MockCheckpointingKeeperMockRecorder) GetLastFinalizedEpoch(ctx interfa
 }
 
 // GetRawCheckpoint mocks base method.
-func (m *MockCheckpointingKeeper) GetRawCheckpoint(ctx context.Context, epochNumber uint64) (*types2.RawCheckpointWithMeta, error) {
+func (m *MockCheckpointingKeeper) GetRawCheckpoint(ctx context.Context, epochNumber uint64) (*types4.RawCheckpointWithMeta, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRawCheckpoint", ctx, epochNumber)
-	ret0, _ := ret[0].(*types2.RawCheckpointWithMeta)
+	ret0, _ := ret[0].(*types4.RawCheckpointWithMeta)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -774,10 +792,10 @@ func (m *MockEpochingKeeper) EXPECT() *MockEpochingKeeperMockRecorder {
 }
 
 // GetEpoch mocks base method.
-func (m *MockEpochingKeeper) GetEpoch(ctx context.Context) *types3.Epoch {
+func (m *MockEpochingKeeper) GetEpoch(ctx context.Context) *types5.Epoch {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetEpoch", ctx)
-	ret0, _ := ret[0].(*types3.Epoch)
+	ret0, _ := ret[0].(*types5.Epoch)
 	return ret0
 }
 
@@ -788,10 +806,10 @@ func (mr *MockEpochingKeeperMockRecorder) GetEpoch(ctx interface{}) *gomock.Call
 }
 
 // GetHistoricalEpoch mocks base method.
-func (m *MockEpochingKeeper) GetHistoricalEpoch(ctx context.Context, epochNumber uint64) (*types3.Epoch, error) {
+func (m *MockEpochingKeeper) GetHistoricalEpoch(ctx context.Context, epochNumber uint64) (*types5.Epoch, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetHistoricalEpoch", ctx, epochNumber)
-	ret0, _ := ret[0].(*types3.Epoch)
+	ret0, _ := ret[0].(*types5.Epoch)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -802,6 +820,130 @@ func (mr *MockEpochingKeeperMockRecorder) GetHistoricalEpoch(ctx, epochNumber in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoricalEpoch", reflect.TypeOf((*MockEpochingKeeper)(nil).GetHistoricalEpoch), ctx, epochNumber)
 }
 
+// MockBTCStakingKeeper is a mock of BTCStakingKeeper interface.
+type MockBTCStakingKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBTCStakingKeeperMockRecorder
+}
+
+// MockBTCStakingKeeperMockRecorder is the mock recorder for MockBTCStakingKeeper.
+type MockBTCStakingKeeperMockRecorder struct {
+	mock *MockBTCStakingKeeper
+}
+
+// NewMockBTCStakingKeeper creates a new mock instance.
+func NewMockBTCStakingKeeper(ctrl *gomock.Controller) *MockBTCStakingKeeper {
+	mock := &MockBTCStakingKeeper{ctrl: ctrl}
+	mock.recorder = &MockBTCStakingKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBTCStakingKeeper) EXPECT() *MockBTCStakingKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetConsumerEvents mocks base method.
+func (m *MockBTCStakingKeeper) GetConsumerEvents(ctx context.Context, consumerID string, maxEvents uint64) []*types2.BTCStakingConsumerEvent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsumerEvents", ctx, consumerID, maxEvents)
+	ret0, _ := ret[0].([]*types2.BTCStakingConsumerEvent)
+	return ret0
+}
+
+// GetConsumerEvents indicates an expected call of GetConsumerEvents.
+func (mr *MockBTCStakingKeeperMockRecorder) GetConsumerEvents(ctx, consumerID, maxEvents interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumerEvents", reflect.TypeOf((*MockBTCStakingKeeper)(nil).GetConsumerEvents), ctx, consumerID, maxEvents)
+}
+
+// RemoveConsumerEvents mocks base method.
+func (m *MockBTCStakingKeeper) RemoveConsumerEvents(ctx context.Context, consumerID string, numEvents uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveConsumerEvents", ctx, consumerID, numEvents)
+}
+
+// RemoveConsumerEvents indicates an expected call of RemoveConsumerEvents.
+func (mr *MockBTCStakingKeeperMockRecorder) RemoveConsumerEvents(ctx, consumerID, numEvents interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveConsumerEvents", reflect.TypeOf((*MockBTCStakingKeeper)(nil).RemoveConsumerEvents), ctx, consumerID, numEvents)
+}
+
+// MockBTCStkConsumerKeeper is a mock of BTCStkConsumerKeeper interface.
+type MockBTCStkConsumerKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBTCStkConsumerKeeperMockRecorder
+}
+
+// MockBTCStkConsumerKeeperMockRecorder is the mock recorder for MockBTCStkConsumerKeeper.
+type MockBTCStkConsumerKeeperMockRecorder struct {
+	mock *MockBTCStkConsumerKeeper
+}
+
+// NewMockBTCStkConsumerKeeper creates a new mock instance.
+func NewMockBTCStkConsumerKeeper(ctrl *gomock.Controller) *MockBTCStkConsumerKeeper {
+	mock := &MockBTCStkConsumerKeeper{ctrl: ctrl}
+	mock.recorder = &MockBTCStkConsumerKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBTCStkConsumerKeeper) EXPECT() *MockBTCStkConsumerKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetConsumerRegister mocks base method.
+func (m *MockBTCStkConsumerKeeper) GetConsumerRegister(ctx context.Context, chainID string) (*types3.ConsumerRegister, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsumerRegister", ctx, chainID)
+	ret0, _ := ret[0].(*types3.ConsumerRegister)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsumerRegister indicates an expected call of GetConsumerRegister.
+func (mr *MockBTCStkConsumerKeeperMockRecorder) GetConsumerRegister(ctx, chainID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumerRegister", reflect.TypeOf((*MockBTCStkConsumerKeeper)(nil).GetConsumerRegister), ctx, chainID)
+}
+
+// MockFinalityKeeper is a mock of FinalityKeeper interface.
+type MockFinalityKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockFinalityKeeperMockRecorder
+}
+
+// MockFinalityKeeperMockRecorder is the mock recorder for MockFinalityKeeper.
+type MockFinalityKeeperMockRecorder struct {
+	mock *MockFinalityKeeper
+}
+
+// NewMockFinalityKeeper creates a new mock instance.
+func NewMockFinalityKeeper(ctrl *gomock.Controller) *MockFinalityKeeper {
+	mock := &MockFinalityKeeper{ctrl: ctrl}
+	mock.recorder = &MockFinalityKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFinalityKeeper) EXPECT() *MockFinalityKeeperMockRecorder {
+	return m.recorder
+}
+
+// SlashConsumerFinalityProvider mocks base method.
+func (m *MockFinalityKeeper) SlashConsumerFinalityProvider(ctx context.Context, consumerID string, evidence *types6.Evidence, pubRandProof *crypto.Proof) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlashConsumerFinalityProvider", ctx, consumerID, evidence, pubRandProof)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SlashConsumerFinalityProvider indicates an expected call of SlashConsumerFinalityProvider.
+func (mr *MockFinalityKeeperMockRecorder) SlashConsumerFinalityProvider(ctx, consumerID, evidence, pubRandProof interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashConsumerFinalityProvider", reflect.TypeOf((*MockFinalityKeeper)(nil).SlashConsumerFinalityProvider), ctx, consumerID, evidence, pubRandProof)
+}
+
 // MockCometClient is a mock of CometClient interface.
 type MockCometClient struct {
 	ctrl     *gomock.Controller
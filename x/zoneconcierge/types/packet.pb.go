@@ -5,10 +5,13 @@ package types
 
 import (
 	fmt "fmt"
-	types3 "github.com/babylonchain/babylon/x/btccheckpoint/types"
-	types "github.com/babylonchain/babylon/x/btclightclient/types"
-	types2 "github.com/babylonchain/babylon/x/checkpointing/types"
-	types1 "github.com/babylonchain/babylon/x/epoching/types"
+	types5 "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	types2 "github.com/babylonchain/babylon/x/btclightclient/types"
+	types "github.com/babylonchain/babylon/x/btcstaking/types"
+	types4 "github.com/babylonchain/babylon/x/checkpointing/types"
+	types3 "github.com/babylonchain/babylon/x/epoching/types"
+	types1 "github.com/babylonchain/babylon/x/finality/types"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -33,6 +36,8 @@ type ZoneconciergePacketData struct {
 	//
 	// Types that are valid to be assigned to Packet:
 	//	*ZoneconciergePacketData_BtcTimestamp
+	//	*ZoneconciergePacketData_BtcStakingEvents
+	//	*ZoneconciergePacketData_ConsumerSlashingEvidence
 	Packet isZoneconciergePacketData_Packet `protobuf_oneof:"packet"`
 }
 
@@ -78,8 +83,16 @@ type isZoneconciergePacketData_Packet interface {
 type ZoneconciergePacketData_BtcTimestamp struct {
 	BtcTimestamp *BTCTimestamp `protobuf:"bytes,1,opt,name=btc_timestamp,json=btcTimestamp,proto3,oneof" json:"btc_timestamp,omitempty"`
 }
+type ZoneconciergePacketData_BtcStakingEvents struct {
+	BtcStakingEvents *BTCStakingEvents `protobuf:"bytes,2,opt,name=btc_staking_events,json=btcStakingEvents,proto3,oneof" json:"btc_staking_events,omitempty"`
+}
+type ZoneconciergePacketData_ConsumerSlashingEvidence struct {
+	ConsumerSlashingEvidence *ConsumerSlashingEvidence `protobuf:"bytes,3,opt,name=consumer_slashing_evidence,json=consumerSlashingEvidence,proto3,oneof" json:"consumer_slashing_evidence,omitempty"`
+}
 
-func (*ZoneconciergePacketData_BtcTimestamp) isZoneconciergePacketData_Packet() {}
+func (*ZoneconciergePacketData_BtcTimestamp) isZoneconciergePacketData_Packet()             {}
+func (*ZoneconciergePacketData_BtcStakingEvents) isZoneconciergePacketData_Packet()         {}
+func (*ZoneconciergePacketData_ConsumerSlashingEvidence) isZoneconciergePacketData_Packet() {}
 
 func (m *ZoneconciergePacketData) GetPacket() isZoneconciergePacketData_Packet {
 	if m != nil {
@@ -95,13 +108,138 @@ func (m *ZoneconciergePacketData) GetBtcTimestamp() *BTCTimestamp {
 	return nil
 }
 
+func (m *ZoneconciergePacketData) GetBtcStakingEvents() *BTCStakingEvents {
+	if x, ok := m.GetPacket().(*ZoneconciergePacketData_BtcStakingEvents); ok {
+		return x.BtcStakingEvents
+	}
+	return nil
+}
+
+func (m *ZoneconciergePacketData) GetConsumerSlashingEvidence() *ConsumerSlashingEvidence {
+	if x, ok := m.GetPacket().(*ZoneconciergePacketData_ConsumerSlashingEvidence); ok {
+		return x.ConsumerSlashingEvidence
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ZoneconciergePacketData) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ZoneconciergePacketData_BtcTimestamp)(nil),
+		(*ZoneconciergePacketData_BtcStakingEvents)(nil),
+		(*ZoneconciergePacketData_ConsumerSlashingEvidence)(nil),
 	}
 }
 
+// BTCStakingEvents is a batch of BTC staking events that concern the finality
+// providers of a consumer chain, i.e., new finality providers, BTC delegation
+// state updates and selective slashings, in the order they happened.
+// Babylon sends the pending BTC staking events to each registered consumer
+// chain upon each block, and re-sends them until they are acknowledged.
+type BTCStakingEvents struct {
+	Events []*types.BTCStakingConsumerEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (m *BTCStakingEvents) Reset()         { *m = BTCStakingEvents{} }
+func (m *BTCStakingEvents) String() string { return proto.CompactTextString(m) }
+func (*BTCStakingEvents) ProtoMessage()    {}
+func (*BTCStakingEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be12e124c5c4fdb9, []int{1}
+}
+func (m *BTCStakingEvents) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCStakingEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCStakingEvents.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCStakingEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCStakingEvents.Merge(m, src)
+}
+func (m *BTCStakingEvents) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCStakingEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCStakingEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCStakingEvents proto.InternalMessageInfo
+
+func (m *BTCStakingEvents) GetEvents() []*types.BTCStakingConsumerEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+// ConsumerSlashingEvidence is the evidence that a finality provider of a
+// consumer chain has signed two conflicting blocks at the same height of the
+// consumer chain. A consumer chain sends it to Babylon, which verifies it
+// against the public randomness committed by the finality provider, extracts
+// the finality provider's BTC SK and slashes the finality provider.
+type ConsumerSlashingEvidence struct {
+	// evidence carries the two conflicting finality signatures
+	Evidence *types1.Evidence `protobuf:"bytes,1,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// pub_rand_proof is the proof that the public randomness in the evidence
+	// is committed by the finality provider
+	PubRandProof *crypto.Proof `protobuf:"bytes,2,opt,name=pub_rand_proof,json=pubRandProof,proto3" json:"pub_rand_proof,omitempty"`
+}
+
+func (m *ConsumerSlashingEvidence) Reset()         { *m = ConsumerSlashingEvidence{} }
+func (m *ConsumerSlashingEvidence) String() string { return proto.CompactTextString(m) }
+func (*ConsumerSlashingEvidence) ProtoMessage()    {}
+func (*ConsumerSlashingEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be12e124c5c4fdb9, []int{2}
+}
+func (m *ConsumerSlashingEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerSlashingEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerSlashingEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerSlashingEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerSlashingEvidence.Merge(m, src)
+}
+func (m *ConsumerSlashingEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerSlashingEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerSlashingEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerSlashingEvidence proto.InternalMessageInfo
+
+func (m *ConsumerSlashingEvidence) GetEvidence() *types1.Evidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+func (m *ConsumerSlashingEvidence) GetPubRandProof() *crypto.Proof {
+	if m != nil {
+		return m.PubRandProof
+	}
+	return nil
+}
+
 // BTCTimestamp is a BTC timestamp that carries information of a BTC-finalised epoch
 // It includes a number of BTC headers, a raw checkpoint, an epoch metadata, and
 // a CZ header if there exists CZ headers checkpointed to this epoch.
@@ -114,13 +252,13 @@ type BTCTimestamp struct {
 	// - the block AFTER the common ancestor of BTC tip at epoch `lastFinalizedEpoch-1` and BTC tip at epoch `lastFinalizedEpoch`
 	// - BTC tip at epoch `lastFinalizedEpoch`
 	// where `lastFinalizedEpoch` is the last finalised epoch in Babylon
-	BtcHeaders []*types.BTCHeaderInfo `protobuf:"bytes,2,rep,name=btc_headers,json=btcHeaders,proto3" json:"btc_headers,omitempty"`
+	BtcHeaders []*types2.BTCHeaderInfo `protobuf:"bytes,2,rep,name=btc_headers,json=btcHeaders,proto3" json:"btc_headers,omitempty"`
 	// epoch_info is the metadata of the sealed epoch
-	EpochInfo *types1.Epoch `protobuf:"bytes,3,opt,name=epoch_info,json=epochInfo,proto3" json:"epoch_info,omitempty"`
+	EpochInfo *types3.Epoch `protobuf:"bytes,3,opt,name=epoch_info,json=epochInfo,proto3" json:"epoch_info,omitempty"`
 	// raw_checkpoint is the raw checkpoint that seals this epoch
-	RawCheckpoint *types2.RawCheckpoint `protobuf:"bytes,4,opt,name=raw_checkpoint,json=rawCheckpoint,proto3" json:"raw_checkpoint,omitempty"`
+	RawCheckpoint *types4.RawCheckpoint `protobuf:"bytes,4,opt,name=raw_checkpoint,json=rawCheckpoint,proto3" json:"raw_checkpoint,omitempty"`
 	// btc_submission_key is position of two BTC txs that include the raw checkpoint of this epoch
-	BtcSubmissionKey *types3.SubmissionKey `protobuf:"bytes,5,opt,name=btc_submission_key,json=btcSubmissionKey,proto3" json:"btc_submission_key,omitempty"`
+	BtcSubmissionKey *types5.SubmissionKey `protobuf:"bytes,5,opt,name=btc_submission_key,json=btcSubmissionKey,proto3" json:"btc_submission_key,omitempty"`
 	//
 	//Proofs that the header is finalized
 	Proof *ProofFinalizedChainInfo `protobuf:"bytes,6,opt,name=proof,proto3" json:"proof,omitempty"`
@@ -130,7 +268,7 @@ func (m *BTCTimestamp) Reset()         { *m = BTCTimestamp{} }
 func (m *BTCTimestamp) String() string { return proto.CompactTextString(m) }
 func (*BTCTimestamp) ProtoMessage()    {}
 func (*BTCTimestamp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be12e124c5c4fdb9, []int{1}
+	return fileDescriptor_be12e124c5c4fdb9, []int{3}
 }
 func (m *BTCTimestamp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -166,28 +304,28 @@ func (m *BTCTimestamp) GetHeader() *IndexedHeader {
 	return nil
 }
 
-func (m *BTCTimestamp) GetBtcHeaders() []*types.BTCHeaderInfo {
+func (m *BTCTimestamp) GetBtcHeaders() []*types2.BTCHeaderInfo {
 	if m != nil {
 		return m.BtcHeaders
 	}
 	return nil
 }
 
-func (m *BTCTimestamp) GetEpochInfo() *types1.Epoch {
+func (m *BTCTimestamp) GetEpochInfo() *types3.Epoch {
 	if m != nil {
 		return m.EpochInfo
 	}
 	return nil
 }
 
-func (m *BTCTimestamp) GetRawCheckpoint() *types2.RawCheckpoint {
+func (m *BTCTimestamp) GetRawCheckpoint() *types4.RawCheckpoint {
 	if m != nil {
 		return m.RawCheckpoint
 	}
 	return nil
 }
 
-func (m *BTCTimestamp) GetBtcSubmissionKey() *types3.SubmissionKey {
+func (m *BTCTimestamp) GetBtcSubmissionKey() *types5.SubmissionKey {
 	if m != nil {
 		return m.BtcSubmissionKey
 	}
@@ -203,6 +341,8 @@ func (m *BTCTimestamp) GetProof() *ProofFinalizedChainInfo {
 
 func init() {
 	proto.RegisterType((*ZoneconciergePacketData)(nil), "babylon.zoneconcierge.v1.ZoneconciergePacketData")
+	proto.RegisterType((*BTCStakingEvents)(nil), "babylon.zoneconcierge.v1.BTCStakingEvents")
+	proto.RegisterType((*ConsumerSlashingEvidence)(nil), "babylon.zoneconcierge.v1.ConsumerSlashingEvidence")
 	proto.RegisterType((*BTCTimestamp)(nil), "babylon.zoneconcierge.v1.BTCTimestamp")
 }
 
@@ -211,37 +351,49 @@ func init() {
 }
 
 var fileDescriptor_be12e124c5c4fdb9 = []byte{
-	// 471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x1b, 0xca, 0x2a, 0x70, 0x37, 0x84, 0x7c, 0x21, 0xda, 0x21, 0x9a, 0x2a, 0x01, 0x45,
-	0x9a, 0x1c, 0x65, 0x88, 0x03, 0x27, 0xa4, 0x96, 0x3f, 0xab, 0x10, 0x30, 0x85, 0x71, 0xd9, 0xa5,
-	0xb2, 0xdd, 0xb7, 0x8d, 0xd5, 0xd6, 0x8e, 0x12, 0xaf, 0x5b, 0xf7, 0x29, 0xf8, 0x52, 0x48, 0x1c,
-	0x77, 0xe4, 0x88, 0xda, 0x2f, 0x82, 0xec, 0xfc, 0x69, 0x52, 0x94, 0x4b, 0xe4, 0xf7, 0xc9, 0xcf,
-	0x8f, 0xfd, 0x3e, 0x7e, 0xd1, 0x73, 0x46, 0xd9, 0x7a, 0xa1, 0xa4, 0x7f, 0xa7, 0x24, 0x70, 0x25,
-	0xb9, 0x80, 0x64, 0x06, 0xfe, 0x2a, 0xf0, 0x63, 0xca, 0xe7, 0xa0, 0x49, 0x9c, 0x28, 0xad, 0xb0,
-	0x9b, 0x63, 0xa4, 0x86, 0x91, 0x55, 0x70, 0x7c, 0x5a, 0x18, 0x30, 0xcd, 0x79, 0x04, 0x7c, 0x1e,
-	0x2b, 0x21, 0xb5, 0x31, 0xa8, 0x09, 0x99, 0xcf, 0xf1, 0xab, 0x82, 0xde, 0xfd, 0x11, 0x72, 0x66,
-	0xe8, 0xff, 0x50, 0x52, 0x31, 0x5e, 0x88, 0x59, 0x64, 0xbe, 0x50, 0x3a, 0x57, 0x94, 0x9c, 0xef,
-	0x15, 0x3c, 0xc4, 0x8a, 0x47, 0xb9, 0x6b, 0xb1, 0xce, 0x99, 0xd3, 0xc6, 0x6e, 0xeb, 0x7d, 0x59,
-	0xba, 0x97, 0xa0, 0x67, 0x57, 0x55, 0xf9, 0xc2, 0x26, 0xf2, 0x9e, 0x6a, 0x8a, 0xbf, 0xa0, 0x23,
-	0xa6, 0xf9, 0x58, 0x8b, 0x25, 0xa4, 0x9a, 0x2e, 0x63, 0xd7, 0x39, 0x71, 0xfa, 0xdd, 0xb3, 0x17,
-	0xa4, 0x29, 0x27, 0x32, 0xb8, 0x1c, 0x5e, 0x16, 0xf4, 0x79, 0x2b, 0x3c, 0x64, 0x9a, 0x97, 0xf5,
-	0xe0, 0x11, 0xea, 0x64, 0x71, 0xf7, 0x7e, 0xb5, 0xd1, 0x61, 0x15, 0xc5, 0xef, 0x50, 0x27, 0x02,
-	0x3a, 0x81, 0x24, 0x3f, 0xe2, 0x65, 0xf3, 0x11, 0x23, 0x39, 0x81, 0x5b, 0x98, 0x9c, 0x5b, 0x3c,
-	0xcc, 0xb7, 0xe1, 0x11, 0xea, 0x9a, 0xab, 0x66, 0x55, 0xea, 0x3e, 0x38, 0x69, 0xf7, 0xbb, 0x67,
-	0xfd, 0xd2, 0x65, 0x2f, 0xcb, 0xec, 0xa6, 0x99, 0xc5, 0x48, 0x4e, 0x55, 0x88, 0x98, 0xe6, 0x59,
-	0x99, 0xe2, 0xb7, 0x08, 0xd9, 0x40, 0xc7, 0x42, 0x4e, 0x95, 0xdb, 0xb6, 0xf7, 0x29, 0xdf, 0x89,
-	0x94, 0x59, 0xaf, 0x02, 0xf2, 0xc1, 0xac, 0xc3, 0xc7, 0x56, 0x32, 0x36, 0xf8, 0x2b, 0x7a, 0x92,
-	0xd0, 0x9b, 0xf1, 0xee, 0x95, 0xdd, 0x87, 0x7b, 0xed, 0xd4, 0x26, 0xc2, 0x78, 0x84, 0xf4, 0x66,
-	0x58, 0x6a, 0xe1, 0x51, 0x52, 0x2d, 0xf1, 0x0f, 0x84, 0x4d, 0x57, 0xe9, 0x35, 0x5b, 0x8a, 0x34,
-	0x15, 0x4a, 0x8e, 0xe7, 0xb0, 0x76, 0x0f, 0xf6, 0x3c, 0xeb, 0x23, 0xb8, 0x0a, 0xc8, 0xf7, 0x92,
-	0xff, 0x0c, 0xeb, 0xf0, 0x29, 0xd3, 0xbc, 0xa6, 0xe0, 0x4f, 0xe8, 0x20, 0x4e, 0x94, 0x9a, 0xba,
-	0x1d, 0xeb, 0x14, 0x34, 0x87, 0x7d, 0x61, 0xb0, 0x8f, 0x42, 0xd2, 0x85, 0xb8, 0x83, 0xc9, 0x30,
-	0xa2, 0x42, 0xda, 0xbc, 0xb2, 0xfd, 0x83, 0x6f, 0xbf, 0x37, 0x9e, 0x73, 0xbf, 0xf1, 0x9c, 0xbf,
-	0x1b, 0xcf, 0xf9, 0xb9, 0xf5, 0x5a, 0xf7, 0x5b, 0xaf, 0xf5, 0x67, 0xeb, 0xb5, 0xae, 0xde, 0xcc,
-	0x84, 0x8e, 0xae, 0x19, 0xe1, 0x6a, 0xe9, 0xe7, 0xee, 0xdc, 0xec, 0x2e, 0x0a, 0xff, 0x76, 0x6f,
-	0x3a, 0xf5, 0x3a, 0x86, 0x94, 0x75, 0xec, 0x4c, 0xbe, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0x31,
-	0xea, 0x29, 0xbc, 0xb1, 0x03, 0x00, 0x00,
+	// 664 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x4d, 0x6b, 0x1b, 0x3b,
+	0x14, 0xf5, 0x24, 0x2f, 0x26, 0x4f, 0xf9, 0x20, 0x68, 0xf3, 0x86, 0x40, 0x4c, 0x30, 0xbc, 0xf7,
+	0xd2, 0x12, 0x64, 0x9c, 0xd2, 0x45, 0x36, 0x2d, 0xc4, 0x4d, 0xea, 0x50, 0xda, 0x86, 0x49, 0xba,
+	0xf1, 0x66, 0x90, 0x34, 0xb2, 0x47, 0xd8, 0x96, 0x86, 0x19, 0xd9, 0x89, 0xf3, 0x2b, 0xba, 0xe9,
+	0x1f, 0x2a, 0x14, 0xba, 0xcc, 0xb2, 0xcb, 0x92, 0xfc, 0x91, 0xa2, 0x8f, 0x19, 0xcf, 0xb8, 0x4c,
+	0x37, 0xc3, 0xdc, 0xab, 0x73, 0x8f, 0x74, 0xcf, 0x3d, 0x12, 0xf8, 0x97, 0x60, 0xb2, 0x98, 0x48,
+	0xd1, 0xb9, 0x97, 0x82, 0x51, 0x29, 0x28, 0x67, 0xe9, 0x88, 0x75, 0xe6, 0xdd, 0x4e, 0x82, 0xe9,
+	0x98, 0x29, 0x94, 0xa4, 0x52, 0x49, 0xe8, 0x3b, 0x18, 0xaa, 0xc0, 0xd0, 0xbc, 0xbb, 0x7f, 0x9c,
+	0x13, 0x10, 0x45, 0x69, 0xcc, 0xe8, 0x38, 0x91, 0x5c, 0x28, 0x4d, 0x50, 0x49, 0x58, 0x9e, 0xfd,
+	0x67, 0x39, 0x7a, 0xb9, 0xc2, 0xc5, 0x48, 0xa3, 0x7f, 0x83, 0xa2, 0x12, 0xf1, 0x84, 0x8f, 0x62,
+	0xfd, 0x65, 0x05, 0x73, 0x29, 0xe3, 0xf0, 0xed, 0x1c, 0xcf, 0x12, 0x49, 0x63, 0xc7, 0x9a, 0xff,
+	0xaf, 0x62, 0x88, 0xa2, 0x99, 0xc2, 0xe3, 0x1c, 0x35, 0x67, 0x42, 0x65, 0xab, 0x98, 0x21, 0x17,
+	0x78, 0xc2, 0xd5, 0x42, 0x23, 0xf2, 0x7f, 0x87, 0x39, 0x50, 0x4c, 0x44, 0x2c, 0x9d, 0xea, 0x4e,
+	0x69, 0xba, 0x48, 0x94, 0xec, 0x24, 0xa9, 0x94, 0x43, 0xb7, 0x7c, 0x5c, 0x2b, 0x6a, 0x55, 0x3e,
+	0x83, 0x6e, 0x7f, 0x5d, 0x03, 0xff, 0x0c, 0xca, 0xf9, 0x2b, 0xa3, 0xfc, 0x1b, 0xac, 0x30, 0x7c,
+	0x0f, 0x76, 0x88, 0xa2, 0xa1, 0xe2, 0x53, 0x96, 0x29, 0x3c, 0x4d, 0x7c, 0xef, 0xd0, 0x3b, 0xda,
+	0x3a, 0xf9, 0x0f, 0xd5, 0xcd, 0x03, 0x9d, 0xdd, 0xf4, 0x6e, 0x72, 0x74, 0xbf, 0x11, 0x6c, 0x13,
+	0x45, 0x8b, 0x18, 0x0e, 0x00, 0xd4, 0x74, 0xae, 0xf5, 0xd0, 0xf6, 0xed, 0xaf, 0x19, 0xce, 0xe7,
+	0x7f, 0xe4, 0xbc, 0xb6, 0x25, 0xe7, 0xa6, 0xa2, 0xdf, 0x08, 0xf6, 0x88, 0xa2, 0x95, 0x1c, 0x4c,
+	0xc1, 0x3e, 0x95, 0x22, 0x9b, 0x4d, 0x59, 0x1a, 0x66, 0x13, 0x9c, 0xc5, 0x76, 0x07, 0x1e, 0x31,
+	0x41, 0x99, 0xbf, 0x6e, 0xf6, 0x38, 0xa9, 0xdf, 0xa3, 0xe7, 0x6a, 0xaf, 0x5d, 0xe9, 0xb9, 0xab,
+	0xec, 0x37, 0x02, 0x9f, 0xd6, 0xac, 0x9d, 0x6d, 0x82, 0xa6, 0xb5, 0x69, 0x7b, 0x00, 0xf6, 0x56,
+	0x4f, 0x09, 0x2f, 0x40, 0xd3, 0x75, 0xe8, 0x1d, 0xae, 0x1f, 0x6d, 0x9d, 0xa0, 0x62, 0xf7, 0xe5,
+	0xf8, 0xab, 0xed, 0xe5, 0x87, 0x30, 0x04, 0x81, 0xab, 0x6e, 0x7f, 0xf1, 0x80, 0x5f, 0x77, 0x3c,
+	0x78, 0x0a, 0x36, 0x8b, 0x26, 0xed, 0x70, 0x0e, 0x8a, 0x6d, 0x0a, 0xd7, 0xcc, 0xbb, 0x28, 0x2f,
+	0x08, 0x0a, 0x38, 0x7c, 0x05, 0x76, 0x93, 0x19, 0x09, 0x53, 0x2c, 0xa2, 0xd0, 0xd8, 0xc7, 0x4d,
+	0xc2, 0x47, 0x4b, 0x7b, 0x21, 0x6b, 0x2f, 0x74, 0xa5, 0xd7, 0x83, 0xed, 0x64, 0x46, 0x02, 0x2c,
+	0x22, 0x13, 0xb5, 0xbf, 0xad, 0x83, 0xed, 0xf2, 0xb8, 0xe1, 0x6b, 0xd0, 0x8c, 0x19, 0x8e, 0x58,
+	0xea, 0x4e, 0xf2, 0x7f, 0xbd, 0xdc, 0x97, 0x22, 0x62, 0x77, 0x2c, 0xea, 0x1b, 0x78, 0xe0, 0xca,
+	0xe0, 0x25, 0xd8, 0xd2, 0xfe, 0xb0, 0x91, 0x36, 0x86, 0x96, 0xed, 0xa8, 0x2c, 0x5b, 0xf9, 0xde,
+	0x59, 0xe9, 0x2c, 0xc5, 0xa5, 0x18, 0xca, 0x00, 0x10, 0x45, 0x6d, 0x98, 0xc1, 0x53, 0x00, 0xcc,
+	0xe5, 0x0b, 0xb9, 0x18, 0x4a, 0x37, 0xfe, 0xe2, 0x4e, 0xa3, 0xe2, 0x5e, 0x6a, 0x65, 0xf4, 0x7f,
+	0xf0, 0xb7, 0x49, 0x69, 0x1a, 0xf8, 0x01, 0xec, 0xa6, 0xf8, 0x36, 0x5c, 0xbe, 0x08, 0xfe, 0x5f,
+	0x2b, 0xed, 0x54, 0x5e, 0x0f, 0xcd, 0x11, 0xe0, 0xdb, 0x5e, 0x91, 0x0b, 0x76, 0xd2, 0x72, 0x08,
+	0x3f, 0x39, 0xd7, 0xcf, 0xc8, 0x94, 0x67, 0x19, 0x97, 0x22, 0x1c, 0xb3, 0x85, 0xbf, 0xb1, 0xc2,
+	0x59, 0x7d, 0xae, 0xe6, 0x5d, 0x74, 0x5d, 0xe0, 0xdf, 0xb1, 0x85, 0x35, 0x7c, 0x39, 0x03, 0xdf,
+	0x82, 0x0d, 0x3b, 0xb5, 0xa6, 0x61, 0xea, 0xd6, 0x8b, 0x6d, 0xc6, 0x75, 0x61, 0xcc, 0x70, 0xcf,
+	0xa2, 0x5e, 0x8c, 0xb9, 0x30, 0x7a, 0xd9, 0xfa, 0xb3, 0x8f, 0xdf, 0x1f, 0x5b, 0xde, 0xc3, 0x63,
+	0xcb, 0xfb, 0xf9, 0xd8, 0xf2, 0x3e, 0x3f, 0xb5, 0x1a, 0x0f, 0x4f, 0xad, 0xc6, 0x8f, 0xa7, 0x56,
+	0x63, 0xf0, 0x72, 0xc4, 0x55, 0x3c, 0x23, 0x88, 0xca, 0x69, 0xc7, 0xb1, 0x53, 0x5d, 0x9d, 0x07,
+	0x9d, 0xbb, 0x95, 0x27, 0x46, 0x2d, 0x12, 0x96, 0x91, 0xa6, 0x79, 0x58, 0x5e, 0xfc, 0x1a, 0x00,
+	0x97, 0xb9, 0xc4, 0x53, 0xdd, 0x05, 0x00, 0x00,
 }
 
 func (m *ZoneconciergePacketData) Marshal() (dAtA []byte, err error) {
@@ -297,6 +449,132 @@ func (m *ZoneconciergePacketData_BtcTimestamp) MarshalToSizedBuffer(dAtA []byte)
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ZoneconciergePacketData_BtcStakingEvents) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZoneconciergePacketData_BtcStakingEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BtcStakingEvents != nil {
+		{
+			size, err := m.BtcStakingEvents.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ZoneconciergePacketData_ConsumerSlashingEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZoneconciergePacketData_ConsumerSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ConsumerSlashingEvidence != nil {
+		{
+			size, err := m.ConsumerSlashingEvidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BTCStakingEvents) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCStakingEvents) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCStakingEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerSlashingEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerSlashingEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PubRandProof != nil {
+		{
+			size, err := m.PubRandProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Evidence != nil {
+		{
+			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BTCTimestamp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -429,6 +707,62 @@ func (m *ZoneconciergePacketData_BtcTimestamp) Size() (n int) {
 	}
 	return n
 }
+func (m *ZoneconciergePacketData_BtcStakingEvents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcStakingEvents != nil {
+		l = m.BtcStakingEvents.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *ZoneconciergePacketData_ConsumerSlashingEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsumerSlashingEvidence != nil {
+		l = m.ConsumerSlashingEvidence.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *BTCStakingEvents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerSlashingEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.PubRandProof != nil {
+		l = m.PubRandProof.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
 func (m *BTCTimestamp) Size() (n int) {
 	if m == nil {
 		return 0
@@ -534,6 +868,282 @@ func (m *ZoneconciergePacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Packet = &ZoneconciergePacketData_BtcTimestamp{v}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcStakingEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BTCStakingEvents{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &ZoneconciergePacketData_BtcStakingEvents{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerSlashingEvidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ConsumerSlashingEvidence{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &ZoneconciergePacketData_ConsumerSlashingEvidence{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCStakingEvents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCStakingEvents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCStakingEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &types.BTCStakingConsumerEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerSlashingEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerSlashingEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerSlashingEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &types1.Evidence{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRandProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubRandProof == nil {
+				m.PubRandProof = &crypto.Proof{}
+			}
+			if err := m.PubRandProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -649,7 +1259,7 @@ func (m *BTCTimestamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcHeaders = append(m.BtcHeaders, &types.BTCHeaderInfo{})
+			m.BtcHeaders = append(m.BtcHeaders, &types2.BTCHeaderInfo{})
 			if err := m.BtcHeaders[len(m.BtcHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -684,7 +1294,7 @@ func (m *BTCTimestamp) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.EpochInfo == nil {
-				m.EpochInfo = &types1.Epoch{}
+				m.EpochInfo = &types3.Epoch{}
 			}
 			if err := m.EpochInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -720,7 +1330,7 @@ func (m *BTCTimestamp) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.RawCheckpoint == nil {
-				m.RawCheckpoint = &types2.RawCheckpoint{}
+				m.RawCheckpoint = &types4.RawCheckpoint{}
 			}
 			if err := m.RawCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -756,7 +1366,7 @@ func (m *BTCTimestamp) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.BtcSubmissionKey == nil {
-				m.BtcSubmissionKey = &types3.SubmissionKey{}
+				m.BtcSubmissionKey = &types5.SubmissionKey{}
 			}
 			if err := m.BtcSubmissionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
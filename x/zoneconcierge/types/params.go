@@ -5,14 +5,16 @@ import (
 )
 
 const (
-	DefaultIbcPacketTimeoutSeconds uint32 = 60 * 60 * 24       // 24 hours
-	MaxIbcPacketTimeoutSeconds     uint32 = 60 * 60 * 24 * 365 // 1 year
+	DefaultIbcPacketTimeoutSeconds      uint32 = 60 * 60 * 24       // 24 hours
+	MaxIbcPacketTimeoutSeconds          uint32 = 60 * 60 * 24 * 365 // 1 year
+	DefaultMaxBtcStakingEventsPerPacket uint32 = 1000
 )
 
 // NewParams creates a new Params instance
 func NewParams(ibcPacketTimeoutSeconds uint32) Params {
 	return Params{
-		IbcPacketTimeoutSeconds: ibcPacketTimeoutSeconds,
+		IbcPacketTimeoutSeconds:      ibcPacketTimeoutSeconds,
+		MaxBtcStakingEventsPerPacket: DefaultMaxBtcStakingEventsPerPacket,
 	}
 }
 
@@ -32,3 +34,13 @@ func (p Params) Validate() error {
 
 	return nil
 }
+
+// MaxBtcStakingEventsPerPacketOrDefault returns the maximum number of BTC
+// staking events carried in an IBC packet, which is
+// DefaultMaxBtcStakingEventsPerPacket if it is not set
+func (p Params) MaxBtcStakingEventsPerPacketOrDefault() uint32 {
+	if p.MaxBtcStakingEventsPerPacket == 0 {
+		return DefaultMaxBtcStakingEventsPerPacket
+	}
+	return p.MaxBtcStakingEventsPerPacket
+}
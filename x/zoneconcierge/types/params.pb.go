@@ -28,6 +28,10 @@ type Params struct {
 	// ibc_packet_timeout_seconds is the time period after which an unrelayed
 	// IBC packet becomes timeout, measured in seconds
 	IbcPacketTimeoutSeconds uint32 `protobuf:"varint,1,opt,name=ibc_packet_timeout_seconds,json=ibcPacketTimeoutSeconds,proto3" json:"ibc_packet_timeout_seconds,omitempty" yaml:"ibc_packet_timeout_seconds"`
+	// max_btc_staking_events_per_packet is the maximum number of BTC staking
+	// events carried in an IBC packet to a consumer chain. The remaining events
+	// are sent in the following packets. 0 falls back to the default of 1000
+	MaxBtcStakingEventsPerPacket uint32 `protobuf:"varint,2,opt,name=max_btc_staking_events_per_packet,json=maxBtcStakingEventsPerPacket,proto3" json:"max_btc_staking_events_per_packet,omitempty" yaml:"max_btc_staking_events_per_packet"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,6 +74,13 @@ func (m *Params) GetIbcPacketTimeoutSeconds() uint32 {
 	return 0
 }
 
+func (m *Params) GetMaxBtcStakingEventsPerPacket() uint32 {
+	if m != nil {
+		return m.MaxBtcStakingEventsPerPacket
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.zoneconcierge.v1.Params")
 }
@@ -79,21 +90,25 @@ func init() {
 }
 
 var fileDescriptor_c0696c936eb15fe4 = []byte{
-	// 227 bytes of a gzipped FileDescriptorProto
+	// 291 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0x4a, 0x4c, 0xaa,
 	0xcc, 0xc9, 0xcf, 0xd3, 0xaf, 0xca, 0xcf, 0x4b, 0x4d, 0xce, 0xcf, 0x4b, 0xce, 0x4c, 0x2d, 0x4a,
 	0x4f, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
 	0xc9, 0x17, 0x92, 0x80, 0x2a, 0xd3, 0x43, 0x51, 0xa6, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f,
-	0x9e, 0x0f, 0x56, 0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x2b, 0x15, 0x71, 0xb1, 0x05, 0x80, 0xf5, 0x0b,
-	0x25, 0x71, 0x49, 0x65, 0x26, 0x25, 0xc7, 0x17, 0x24, 0x26, 0x67, 0xa7, 0x96, 0xc4, 0x97, 0x64,
-	0xe6, 0xa6, 0xe6, 0x97, 0x96, 0xc4, 0x17, 0x83, 0x0c, 0x49, 0x29, 0x96, 0x60, 0x54, 0x60, 0xd4,
-	0xe0, 0x75, 0x52, 0xfd, 0x74, 0x4f, 0x5e, 0xb1, 0x32, 0x31, 0x37, 0xc7, 0x4a, 0x09, 0xb7, 0x5a,
-	0xa5, 0x20, 0xf1, 0xcc, 0xa4, 0xe4, 0x00, 0xb0, 0x5c, 0x08, 0x44, 0x2a, 0x18, 0x22, 0x63, 0xc5,
-	0xf2, 0x62, 0x81, 0x3c, 0xa3, 0x93, 0xff, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e,
-	0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31,
-	0x44, 0x99, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x3d, 0x92,
-	0x9c, 0x91, 0x98, 0x99, 0x07, 0xe3, 0xe8, 0x57, 0xa0, 0x79, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x38,
-	0x89, 0x0d, 0xec, 0x17, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2d, 0xf3, 0xe4, 0xef, 0x24,
+	0x9e, 0x0f, 0x56, 0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x2b, 0xbd, 0x64, 0xe4, 0x62, 0x0b, 0x00, 0x1b,
+	0x20, 0x94, 0xc4, 0x25, 0x95, 0x99, 0x94, 0x1c, 0x5f, 0x90, 0x98, 0x9c, 0x9d, 0x5a, 0x12, 0x5f,
+	0x92, 0x99, 0x9b, 0x9a, 0x5f, 0x5a, 0x12, 0x5f, 0x0c, 0x32, 0x25, 0xa5, 0x58, 0x82, 0x51, 0x81,
+	0x51, 0x83, 0xd7, 0x49, 0xf5, 0xd3, 0x3d, 0x79, 0xc5, 0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25, 0xdc,
+	0x6a, 0x95, 0x82, 0xc4, 0x33, 0x93, 0x92, 0x03, 0xc0, 0x72, 0x21, 0x10, 0xa9, 0x60, 0x88, 0x8c,
+	0x50, 0x29, 0x97, 0x62, 0x6e, 0x62, 0x45, 0x7c, 0x52, 0x49, 0x72, 0x7c, 0x71, 0x49, 0x62, 0x76,
+	0x66, 0x5e, 0x7a, 0x7c, 0x6a, 0x59, 0x6a, 0x5e, 0x49, 0x71, 0x7c, 0x41, 0x6a, 0x11, 0xd4, 0x38,
+	0x09, 0x26, 0xb0, 0x55, 0x3a, 0x9f, 0xee, 0xc9, 0x6b, 0x40, 0xac, 0x22, 0xa8, 0x45, 0x29, 0x48,
+	0x26, 0x37, 0xb1, 0xc2, 0xa9, 0x24, 0x39, 0x18, 0xa2, 0xc2, 0x15, 0xac, 0x20, 0x20, 0xb5, 0x08,
+	0xe2, 0x08, 0x2b, 0x96, 0x17, 0x0b, 0xe4, 0x19, 0x9d, 0xfc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
+	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
+	0xf1, 0x58, 0x8e, 0x21, 0xca, 0x34, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57,
+	0x1f, 0x1a, 0x80, 0xc9, 0x19, 0x89, 0x99, 0x79, 0x30, 0x8e, 0x7e, 0x05, 0x5a, 0xb0, 0x97, 0x54,
+	0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xc3, 0xd0, 0x18, 0x30, 0x00, 0xf6, 0xe1, 0xe9, 0xdb, 0x9c,
 	0x01, 0x00, 0x00,
 }
 
@@ -119,6 +134,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.IbcPacketTimeoutSeconds != that1.IbcPacketTimeoutSeconds {
 		return false
 	}
+	if this.MaxBtcStakingEventsPerPacket != that1.MaxBtcStakingEventsPerPacket {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -141,6 +159,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxBtcStakingEventsPerPacket != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBtcStakingEventsPerPacket))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.IbcPacketTimeoutSeconds != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.IbcPacketTimeoutSeconds))
 		i--
@@ -169,6 +192,9 @@ func (m *Params) Size() (n int) {
 	if m.IbcPacketTimeoutSeconds != 0 {
 		n += 1 + sovParams(uint64(m.IbcPacketTimeoutSeconds))
 	}
+	if m.MaxBtcStakingEventsPerPacket != 0 {
+		n += 1 + sovParams(uint64(m.MaxBtcStakingEventsPerPacket))
+	}
 	return n
 }
 
@@ -226,6 +252,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBtcStakingEventsPerPacket", wireType)
+			}
+			m.MaxBtcStakingEventsPerPacket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBtcStakingEventsPerPacket |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
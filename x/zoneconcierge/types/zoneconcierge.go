@@ -7,6 +7,8 @@ import (
 	"cosmossdk.io/store/rootmulti"
 	"github.com/cometbft/cometbft/crypto/merkle"
 	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
 )
 
 // VerifyStore verifies whether a KV pair is committed to the Merkle root, with the assistance of a Merkle proof
@@ -136,3 +138,11 @@ func NewBTCTimestampPacketData(btcTimestamp *BTCTimestamp) *ZoneconciergePacketD
 		},
 	}
 }
+
+func NewBTCStakingEventsPacketData(events []*bstypes.BTCStakingConsumerEvent) *ZoneconciergePacketData {
+	return &ZoneconciergePacketData{
+		Packet: &ZoneconciergePacketData_BtcStakingEvents{
+			BtcStakingEvents: &BTCStakingEvents{Events: events},
+		},
+	}
+}
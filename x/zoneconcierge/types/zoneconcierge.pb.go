@@ -623,6 +623,82 @@ func (m *BTCChainSegment) GetBtcHeaders() []*types3.BTCHeaderInfo {
 	return nil
 }
 
+// BTCStakingEventsInFlight is an IBC packet of BTC staking events that is sent
+// to a consumer chain but is not acknowledged yet
+type BTCStakingEventsInFlight struct {
+	// consumer_id is the chain ID of the consumer chain
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// channel_id is the ID of the channel that the packet is sent over
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the sequence number of the packet
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// num_events is the number of BTC staking events carried in the packet,
+	// which are the first ones in the queue of pending BTC staking events of
+	// the consumer chain
+	NumEvents uint64 `protobuf:"varint,4,opt,name=num_events,json=numEvents,proto3" json:"num_events,omitempty"`
+}
+
+func (m *BTCStakingEventsInFlight) Reset()         { *m = BTCStakingEventsInFlight{} }
+func (m *BTCStakingEventsInFlight) String() string { return proto.CompactTextString(m) }
+func (*BTCStakingEventsInFlight) ProtoMessage()    {}
+func (*BTCStakingEventsInFlight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab886e1868e5c5cd, []int{8}
+}
+func (m *BTCStakingEventsInFlight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCStakingEventsInFlight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCStakingEventsInFlight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCStakingEventsInFlight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCStakingEventsInFlight.Merge(m, src)
+}
+func (m *BTCStakingEventsInFlight) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCStakingEventsInFlight) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCStakingEventsInFlight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCStakingEventsInFlight proto.InternalMessageInfo
+
+func (m *BTCStakingEventsInFlight) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *BTCStakingEventsInFlight) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *BTCStakingEventsInFlight) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *BTCStakingEventsInFlight) GetNumEvents() uint64 {
+	if m != nil {
+		return m.NumEvents
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*IndexedHeader)(nil), "babylon.zoneconcierge.v1.IndexedHeader")
 	proto.RegisterType((*Forks)(nil), "babylon.zoneconcierge.v1.Forks")
@@ -632,6 +708,7 @@ func init() {
 	proto.RegisterType((*ProofEpochSealed)(nil), "babylon.zoneconcierge.v1.ProofEpochSealed")
 	proto.RegisterType((*ProofFinalizedChainInfo)(nil), "babylon.zoneconcierge.v1.ProofFinalizedChainInfo")
 	proto.RegisterType((*BTCChainSegment)(nil), "babylon.zoneconcierge.v1.BTCChainSegment")
+	proto.RegisterType((*BTCStakingEventsInFlight)(nil), "babylon.zoneconcierge.v1.BTCStakingEventsInFlight")
 }
 
 func init() {
@@ -639,68 +716,73 @@ func init() {
 }
 
 var fileDescriptor_ab886e1868e5c5cd = []byte{
-	// 964 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc6, 0x4e, 0xd2, 0x3c, 0xc7, 0x6d, 0x98, 0xa4, 0xd4, 0x0d, 0xc2, 0xb1, 0x5c, 0xa9,
-	0xb8, 0x08, 0xd6, 0xb2, 0x81, 0x03, 0xdc, 0xb0, 0xd5, 0xd2, 0x14, 0x44, 0xd1, 0xda, 0x2d, 0x08,
-	0x81, 0x56, 0xfb, 0x67, 0xbc, 0xbb, 0xca, 0x7a, 0xc7, 0xda, 0x9d, 0xb8, 0x71, 0x3e, 0x45, 0xbf,
-	0x05, 0x5c, 0xf9, 0x00, 0xdc, 0x39, 0xf6, 0xc8, 0x0d, 0x94, 0x7c, 0x05, 0x2e, 0xdc, 0xd0, 0xbc,
-	0x99, 0x59, 0xef, 0x26, 0x32, 0x49, 0x2f, 0xd1, 0xce, 0xcc, 0xef, 0xbd, 0xf7, 0x7b, 0xbf, 0xf7,
-	0xc7, 0x81, 0x8f, 0x5c, 0xc7, 0x5d, 0xc4, 0x2c, 0xe9, 0x9e, 0xb1, 0x84, 0x7a, 0x2c, 0xf1, 0x22,
-	0x9a, 0x06, 0xb4, 0x3b, 0xef, 0x95, 0x2f, 0xcc, 0x59, 0xca, 0x38, 0x23, 0x0d, 0x85, 0x36, 0xcb,
-	0x8f, 0xf3, 0xde, 0xc1, 0x7e, 0xc0, 0x02, 0x86, 0xa0, 0xae, 0xf8, 0x92, 0xf8, 0x83, 0xc3, 0x80,
-	0xb1, 0x20, 0xa6, 0x5d, 0x3c, 0xb9, 0x27, 0x93, 0x2e, 0x8f, 0xa6, 0x34, 0xe3, 0xce, 0x74, 0xa6,
-	0x00, 0xef, 0x73, 0x9a, 0xf8, 0x34, 0x9d, 0x46, 0x09, 0xef, 0x7a, 0xe9, 0x62, 0xc6, 0x99, 0xc0,
-	0xb2, 0x89, 0x7a, 0xce, 0xd9, 0xb9, 0xdc, 0xf3, 0x42, 0xea, 0x1d, 0xcf, 0x98, 0x40, 0xce, 0x7b,
-	0xe5, 0x0b, 0x85, 0x7e, 0xa8, 0xd1, 0xcb, 0x97, 0x28, 0x09, 0x10, 0x1d, 0x67, 0xf6, 0x31, 0x5d,
-	0x28, 0xdc, 0xa3, 0x95, 0xb8, 0x2b, 0x2e, 0xdb, 0x1a, 0x4a, 0x67, 0xcc, 0x0b, 0x15, 0x4a, 0x7f,
-	0x2b, 0x8c, 0x59, 0x20, 0x19, 0x47, 0x41, 0x28, 0xfe, 0xd2, 0x9c, 0x65, 0xe1, 0x46, 0xe2, 0xdb,
-	0xbf, 0xaf, 0x43, 0xfd, 0x28, 0xf1, 0xe9, 0x29, 0xf5, 0x9f, 0x52, 0xc7, 0xa7, 0x29, 0xb9, 0x0f,
-	0xb7, 0xbc, 0xd0, 0x89, 0x12, 0x3b, 0xf2, 0x1b, 0x46, 0xcb, 0xe8, 0x6c, 0x5b, 0x5b, 0x78, 0x3e,
-	0xf2, 0x09, 0x81, 0x6a, 0xe8, 0x64, 0x61, 0x63, 0xbd, 0x65, 0x74, 0x76, 0x2c, 0xfc, 0x26, 0xef,
-	0xc2, 0x66, 0x48, 0x85, 0xdb, 0x46, 0xa5, 0x65, 0x74, 0xaa, 0x96, 0x3a, 0x91, 0x4f, 0xa1, 0x2a,
-	0xf4, 0x6d, 0x54, 0x5b, 0x46, 0xa7, 0xd6, 0x3f, 0x30, 0xa5, 0xf8, 0xa6, 0x16, 0xdf, 0x1c, 0x6b,
-	0xf1, 0x07, 0xd5, 0xd7, 0x7f, 0x1d, 0x1a, 0x16, 0xa2, 0x89, 0x09, 0x7b, 0x2a, 0x01, 0x3b, 0x44,
-	0x3a, 0x36, 0x06, 0xdc, 0xc0, 0x80, 0xef, 0xa8, 0x27, 0x49, 0xf4, 0xa9, 0x88, 0xde, 0x87, 0xbb,
-	0x97, 0xf1, 0x92, 0xcc, 0x26, 0x92, 0xd9, 0x2b, 0x5b, 0x48, 0x66, 0x0f, 0xa0, 0xae, 0x6d, 0x50,
-	0xbc, 0xc6, 0x16, 0x62, 0x77, 0xd4, 0xe5, 0x63, 0x71, 0x47, 0x1e, 0xc2, 0x1d, 0x0d, 0xe2, 0xa7,
-	0x92, 0xc4, 0x2d, 0x24, 0xa1, 0x6d, 0xc7, 0xa7, 0x82, 0x40, 0xfb, 0x19, 0x6c, 0x3c, 0x61, 0xe9,
-	0x71, 0x46, 0xbe, 0x84, 0x2d, 0xc9, 0x20, 0x6b, 0x54, 0x5a, 0x95, 0x4e, 0xad, 0xff, 0x81, 0xb9,
-	0xaa, 0x3f, 0xcd, 0x92, 0xe0, 0x96, 0xb6, 0x6b, 0xff, 0x63, 0xc0, 0xf6, 0x10, 0xa5, 0x4e, 0x26,
-	0xec, 0xff, 0xea, 0xf0, 0x0d, 0xd4, 0x63, 0x87, 0xd3, 0x8c, 0xab, 0xa4, 0xb1, 0x20, 0x6f, 0x11,
-	0x71, 0x47, 0x5a, 0xab, 0x82, 0x0f, 0x40, 0x9d, 0xed, 0x89, 0xc8, 0x04, 0xeb, 0x58, 0xeb, 0x1f,
-	0xae, 0x76, 0x86, 0x09, 0x5b, 0x35, 0x69, 0x24, 0xb3, 0xff, 0x02, 0xee, 0xe7, 0xd3, 0x44, 0x7d,
-	0x45, 0x2b, 0xb3, 0x3d, 0x76, 0x92, 0x70, 0x6c, 0x81, 0xaa, 0x75, 0xaf, 0x00, 0x90, 0x91, 0xb3,
-	0xa1, 0x78, 0x6e, 0xff, 0x6a, 0x00, 0xc9, 0xd3, 0xfe, 0x3e, 0xe2, 0xe1, 0x77, 0x62, 0xe8, 0xc8,
-	0x00, 0x40, 0xe5, 0x9f, 0x4c, 0x18, 0x2a, 0x50, 0xeb, 0x3f, 0x58, 0x4d, 0x2a, 0xf7, 0x60, 0x6d,
-	0x7b, 0xb9, 0x86, 0xdf, 0xc2, 0x5d, 0x9c, 0x60, 0xdd, 0x1c, 0x91, 0x2e, 0xb9, 0x14, 0xec, 0x3d,
-	0x73, 0x39, 0xf1, 0xa6, 0x9c, 0x78, 0x13, 0x83, 0x3f, 0x9f, 0x65, 0x16, 0x41, 0x4b, 0xc9, 0xf4,
-	0x48, 0x76, 0x45, 0xfb, 0xb7, 0x0a, 0x90, 0x27, 0x51, 0xe2, 0xc4, 0xd1, 0x19, 0xf5, 0x6f, 0x54,
-	0xaa, 0x17, 0xb0, 0x3f, 0xd1, 0x06, 0x76, 0x21, 0x9f, 0xf5, 0x9b, 0xe7, 0x43, 0x26, 0x57, 0x23,
-	0x7e, 0x0e, 0x80, 0x89, 0x48, 0x67, 0x15, 0x35, 0x63, 0xda, 0x59, 0xbe, 0x13, 0xe6, 0x3d, 0x13,
-	0x89, 0x5b, 0xdb, 0x78, 0xa5, 0x34, 0xb9, 0x9d, 0x3a, 0xaf, 0xec, 0xe5, 0x76, 0x51, 0x23, 0xba,
-	0xec, 0x9e, 0xd2, 0x26, 0x12, 0x3e, 0x2c, 0xe7, 0xd5, 0x30, 0xbf, 0xb3, 0xea, 0x69, 0xf1, 0x48,
-	0x5e, 0x00, 0x71, 0xb9, 0x67, 0x67, 0x27, 0xee, 0x34, 0xca, 0xb2, 0x88, 0x25, 0x62, 0xb9, 0xe1,
-	0xc4, 0x16, 0x7d, 0x96, 0x57, 0xe4, 0xbc, 0x67, 0x8e, 0x72, 0xfc, 0xd7, 0x74, 0x61, 0xed, 0xba,
-	0xdc, 0x2b, 0xdd, 0x90, 0xaf, 0x60, 0x03, 0x0b, 0x80, 0x93, 0x5c, 0xeb, 0xf7, 0x56, 0x2b, 0x85,
-	0x15, 0xbb, 0x5a, 0x15, 0x4b, 0xda, 0xb7, 0xff, 0x35, 0x60, 0x17, 0x21, 0xa8, 0xc4, 0x88, 0x3a,
-	0x31, 0xf5, 0x89, 0x05, 0xf5, 0xb9, 0x13, 0x47, 0xbe, 0xc3, 0x59, 0x6a, 0x67, 0x94, 0x37, 0x0c,
-	0x9c, 0xd9, 0x8f, 0x57, 0x6b, 0xf0, 0x52, 0xc3, 0x45, 0x87, 0x0e, 0xe2, 0x4c, 0xb0, 0xde, 0xc9,
-	0x7d, 0x8c, 0x28, 0x27, 0x8f, 0x61, 0x57, 0x36, 0x5b, 0xa1, 0x32, 0x37, 0xe8, 0xb3, 0xdb, 0xb3,
-	0x9c, 0x1c, 0xd6, 0xe7, 0x19, 0xec, 0x15, 0xdd, 0xcc, 0x9d, 0x18, 0x09, 0x56, 0xae, 0xf7, 0xb4,
-	0xbb, 0xf4, 0xf4, 0xd2, 0x89, 0x47, 0x94, 0xb7, 0x7f, 0x59, 0x87, 0x7b, 0x2b, 0xe4, 0x21, 0x23,
-	0x68, 0xc8, 0x38, 0xde, 0xd9, 0x95, 0xf1, 0x30, 0xae, 0x0f, 0xb6, 0x8f, 0xc6, 0xc3, 0xb3, 0xd2,
-	0x80, 0x90, 0x1f, 0x80, 0x14, 0xc9, 0x67, 0xa8, 0xb6, 0x52, 0xe1, 0xc3, 0x6b, 0x4a, 0x58, 0xa8,
-	0x4f, 0x31, 0x15, 0x55, 0xb1, 0x9f, 0xf5, 0x28, 0x2b, 0xcf, 0xa2, 0x59, 0x38, 0xa7, 0xbe, 0xda,
-	0xb6, 0x8f, 0x56, 0x77, 0xda, 0x38, 0x75, 0x92, 0xcc, 0xf1, 0x78, 0xc4, 0x64, 0x5f, 0xec, 0x15,
-	0x7c, 0x6b, 0x2f, 0xed, 0x9f, 0xe0, 0xce, 0x60, 0x3c, 0x44, 0x75, 0x46, 0x34, 0x98, 0xd2, 0x84,
-	0x93, 0x23, 0xa8, 0x89, 0xc6, 0xd6, 0x5b, 0x5d, 0x76, 0x48, 0xa7, 0x18, 0xa7, 0xf8, 0x73, 0x3a,
-	0xef, 0x99, 0x83, 0xf1, 0x50, 0xab, 0x31, 0x61, 0x16, 0xb8, 0xdc, 0x53, 0x7b, 0x6e, 0xf0, 0xfc,
-	0x8f, 0xf3, 0xa6, 0xf1, 0xe6, 0xbc, 0x69, 0xfc, 0x7d, 0xde, 0x34, 0x5e, 0x5f, 0x34, 0xd7, 0xde,
-	0x5c, 0x34, 0xd7, 0xfe, 0xbc, 0x68, 0xae, 0xfd, 0xf8, 0x59, 0x10, 0xf1, 0xf0, 0xc4, 0x35, 0x3d,
-	0x36, 0xed, 0x2a, 0xcf, 0xb8, 0x25, 0xf4, 0xa1, 0x7b, 0x7a, 0xe9, 0x9f, 0x21, 0xbe, 0x98, 0xd1,
-	0xcc, 0xdd, 0xc4, 0xdf, 0xd1, 0x4f, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xbe, 0xdf, 0x50, 0x58,
-	0x32, 0x09, 0x00, 0x00,
+	// 1042 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0x9b, 0xf4, 0x4f, 0x5e, 0xda, 0xdd, 0x32, 0x6d, 0x59, 0x6f, 0xd1, 0xa6, 0x55, 0x56,
+	0x5a, 0xb2, 0x08, 0x1c, 0x25, 0xc0, 0x01, 0x6e, 0x24, 0x6a, 0x69, 0x16, 0xc4, 0x22, 0x27, 0xbb,
+	0x20, 0x04, 0xb2, 0x1c, 0x7b, 0x12, 0x5b, 0x75, 0x66, 0x82, 0x67, 0x92, 0x6d, 0xfb, 0x29, 0xf6,
+	0xc2, 0x67, 0x80, 0x2b, 0x1f, 0x80, 0x3b, 0xc7, 0x3d, 0x72, 0x03, 0xb5, 0x5f, 0x81, 0x0b, 0x37,
+	0xe4, 0x37, 0x63, 0xd7, 0x6e, 0x15, 0x5a, 0x2e, 0x55, 0x66, 0xe6, 0xf7, 0xde, 0xfb, 0xbd, 0xdf,
+	0xfb, 0xe3, 0xc2, 0xfb, 0x43, 0x77, 0x78, 0x16, 0x71, 0xd6, 0x3c, 0xe7, 0x8c, 0x7a, 0x9c, 0x79,
+	0x21, 0x8d, 0xc7, 0xb4, 0x39, 0x6f, 0x15, 0x2f, 0xac, 0x69, 0xcc, 0x25, 0x27, 0xa6, 0x46, 0x5b,
+	0xc5, 0xc7, 0x79, 0x6b, 0x6f, 0x67, 0xcc, 0xc7, 0x1c, 0x41, 0xcd, 0xe4, 0x97, 0xc2, 0xef, 0xed,
+	0x8f, 0x39, 0x1f, 0x47, 0xb4, 0x89, 0xa7, 0xe1, 0x6c, 0xd4, 0x94, 0xe1, 0x84, 0x0a, 0xe9, 0x4e,
+	0xa6, 0x1a, 0xf0, 0x48, 0x52, 0xe6, 0xd3, 0x78, 0x12, 0x32, 0xd9, 0xf4, 0xe2, 0xb3, 0xa9, 0xe4,
+	0x09, 0x96, 0x8f, 0xf4, 0x73, 0xc6, 0x6e, 0x28, 0x3d, 0x2f, 0xa0, 0xde, 0xc9, 0x94, 0x27, 0xc8,
+	0x79, 0xab, 0x78, 0xa1, 0xd1, 0x4f, 0x52, 0xf4, 0xd5, 0x4b, 0xc8, 0xc6, 0x88, 0x8e, 0x84, 0x73,
+	0x42, 0xcf, 0x34, 0xee, 0xe9, 0x42, 0xdc, 0x0d, 0x97, 0xf5, 0x14, 0x4a, 0xa7, 0xdc, 0x0b, 0x34,
+	0x2a, 0xfd, 0xad, 0x31, 0x56, 0x8e, 0x64, 0x14, 0x8e, 0x83, 0xe4, 0x2f, 0xcd, 0x58, 0xe6, 0x6e,
+	0x14, 0xbe, 0xfe, 0xdb, 0x32, 0x6c, 0xf6, 0x98, 0x4f, 0x4f, 0xa9, 0x7f, 0x4c, 0x5d, 0x9f, 0xc6,
+	0xe4, 0x21, 0xac, 0x7b, 0x81, 0x1b, 0x32, 0x27, 0xf4, 0x4d, 0xe3, 0xc0, 0x68, 0x54, 0xec, 0x35,
+	0x3c, 0xf7, 0x7c, 0x42, 0xa0, 0x1c, 0xb8, 0x22, 0x30, 0x97, 0x0f, 0x8c, 0xc6, 0x86, 0x8d, 0xbf,
+	0xc9, 0xdb, 0xb0, 0x1a, 0xd0, 0xc4, 0xad, 0x59, 0x3a, 0x30, 0x1a, 0x65, 0x5b, 0x9f, 0xc8, 0x47,
+	0x50, 0x4e, 0xf4, 0x35, 0xcb, 0x07, 0x46, 0xa3, 0xda, 0xde, 0xb3, 0x94, 0xf8, 0x56, 0x2a, 0xbe,
+	0x35, 0x48, 0xc5, 0xef, 0x94, 0x5f, 0xff, 0xb9, 0x6f, 0xd8, 0x88, 0x26, 0x16, 0x6c, 0xeb, 0x04,
+	0x9c, 0x00, 0xe9, 0x38, 0x18, 0x70, 0x05, 0x03, 0xbe, 0xa5, 0x9f, 0x14, 0xd1, 0xe3, 0x24, 0x7a,
+	0x1b, 0x76, 0xaf, 0xe3, 0x15, 0x99, 0x55, 0x24, 0xb3, 0x5d, 0xb4, 0x50, 0xcc, 0x1e, 0xc3, 0x66,
+	0x6a, 0x83, 0xe2, 0x99, 0x6b, 0x88, 0xdd, 0xd0, 0x97, 0x87, 0xc9, 0x1d, 0x79, 0x02, 0xf7, 0x53,
+	0x90, 0x3c, 0x55, 0x24, 0xd6, 0x91, 0x44, 0x6a, 0x3b, 0x38, 0x4d, 0x08, 0xd4, 0x9f, 0xc1, 0xca,
+	0x11, 0x8f, 0x4f, 0x04, 0xf9, 0x0c, 0xd6, 0x14, 0x03, 0x61, 0x96, 0x0e, 0x4a, 0x8d, 0x6a, 0xfb,
+	0x5d, 0x6b, 0x51, 0x7f, 0x5a, 0x05, 0xc1, 0xed, 0xd4, 0xae, 0xfe, 0xb7, 0x01, 0x95, 0x2e, 0x4a,
+	0xcd, 0x46, 0xfc, 0xbf, 0xea, 0xf0, 0x25, 0x6c, 0x46, 0xae, 0xa4, 0x42, 0xea, 0xa4, 0xb1, 0x20,
+	0xff, 0x23, 0xe2, 0x86, 0xb2, 0xd6, 0x05, 0xef, 0x80, 0x3e, 0x3b, 0xa3, 0x24, 0x13, 0xac, 0x63,
+	0xb5, 0xbd, 0xbf, 0xd8, 0x19, 0x26, 0x6c, 0x57, 0x95, 0x91, 0xca, 0xfe, 0x53, 0x78, 0x98, 0x4d,
+	0x13, 0xf5, 0x35, 0x2d, 0xe1, 0x78, 0x7c, 0xc6, 0x24, 0xb6, 0x40, 0xd9, 0x7e, 0x90, 0x03, 0xa8,
+	0xc8, 0xa2, 0x9b, 0x3c, 0xd7, 0x7f, 0x31, 0x80, 0x64, 0x69, 0x7f, 0x13, 0xca, 0xe0, 0xeb, 0x64,
+	0xe8, 0x48, 0x07, 0x40, 0xe7, 0xcf, 0x46, 0x1c, 0x15, 0xa8, 0xb6, 0x1f, 0x2f, 0x26, 0x95, 0x79,
+	0xb0, 0x2b, 0x5e, 0xa6, 0xe1, 0x57, 0xb0, 0x8b, 0x13, 0x9c, 0x36, 0x47, 0x98, 0x96, 0x5c, 0x09,
+	0xf6, 0x8e, 0x75, 0x35, 0xf1, 0x96, 0x9a, 0x78, 0x0b, 0x83, 0x3f, 0x9f, 0x0a, 0x9b, 0xa0, 0xa5,
+	0x62, 0xda, 0x53, 0x5d, 0x51, 0xff, 0xb5, 0x04, 0xe4, 0x28, 0x64, 0x6e, 0x14, 0x9e, 0x53, 0xff,
+	0x4e, 0xa5, 0x7a, 0x01, 0x3b, 0xa3, 0xd4, 0xc0, 0xc9, 0xe5, 0xb3, 0x7c, 0xf7, 0x7c, 0xc8, 0xe8,
+	0x66, 0xc4, 0x4f, 0x00, 0x30, 0x11, 0xe5, 0xac, 0xa4, 0x67, 0x2c, 0x75, 0x96, 0xed, 0x84, 0x79,
+	0xcb, 0x42, 0xe2, 0x76, 0x05, 0xaf, 0xb4, 0x26, 0xf7, 0x62, 0xf7, 0x95, 0x73, 0xb5, 0x5d, 0xcc,
+	0xf2, 0xb5, 0xee, 0x29, 0x6c, 0xa2, 0xc4, 0x87, 0xed, 0xbe, 0xea, 0x66, 0x77, 0xf6, 0x66, 0x9c,
+	0x3f, 0x92, 0x17, 0x40, 0x86, 0xd2, 0x73, 0xc4, 0x6c, 0x38, 0x09, 0x85, 0x08, 0x39, 0x4b, 0x96,
+	0x9b, 0xb9, 0x72, 0xcd, 0x67, 0x71, 0x45, 0xce, 0x5b, 0x56, 0x3f, 0xc3, 0x7f, 0x41, 0xcf, 0xec,
+	0xad, 0xa1, 0xf4, 0x0a, 0x37, 0xe4, 0x73, 0x58, 0xc1, 0x02, 0xe0, 0x24, 0x57, 0xdb, 0xad, 0xc5,
+	0x4a, 0x61, 0xc5, 0x6e, 0x56, 0xc5, 0x56, 0xf6, 0xf5, 0x7f, 0x0c, 0xd8, 0x42, 0x08, 0x2a, 0xd1,
+	0xa7, 0x6e, 0x44, 0x7d, 0x62, 0xc3, 0xe6, 0xdc, 0x8d, 0x42, 0xdf, 0x95, 0x3c, 0x76, 0x04, 0x95,
+	0xa6, 0x81, 0x33, 0xfb, 0xc1, 0x62, 0x0d, 0x5e, 0xa6, 0xf0, 0xa4, 0x43, 0x3b, 0x91, 0x48, 0x58,
+	0x6f, 0x64, 0x3e, 0xfa, 0x54, 0x92, 0x43, 0xd8, 0x52, 0xcd, 0x96, 0xab, 0xcc, 0x1d, 0xfa, 0xec,
+	0xde, 0x34, 0x23, 0x87, 0xf5, 0x79, 0x06, 0xdb, 0x79, 0x37, 0x73, 0x37, 0x42, 0x82, 0xa5, 0xdb,
+	0x3d, 0x6d, 0x5d, 0x79, 0x7a, 0xe9, 0x46, 0x7d, 0x2a, 0xeb, 0x3f, 0x2f, 0xc3, 0x83, 0x05, 0xf2,
+	0x90, 0x3e, 0x98, 0x2a, 0x8e, 0x77, 0x7e, 0x63, 0x3c, 0x8c, 0xdb, 0x83, 0xed, 0xa0, 0x71, 0xf7,
+	0xbc, 0x30, 0x20, 0xe4, 0x5b, 0x20, 0x79, 0xf2, 0x02, 0xd5, 0xd6, 0x2a, 0xbc, 0x77, 0x4b, 0x09,
+	0x73, 0xf5, 0xc9, 0xa7, 0xa2, 0x2b, 0xf6, 0x03, 0xec, 0x16, 0x3c, 0x27, 0xcd, 0x22, 0x25, 0xf5,
+	0xf5, 0xb6, 0x7d, 0xba, 0xb8, 0xd3, 0x06, 0xb1, 0xcb, 0x84, 0xeb, 0xc9, 0x90, 0xab, 0xbe, 0xd8,
+	0xce, 0xf9, 0x4e, 0xbd, 0xd4, 0xbf, 0x87, 0xfb, 0x9d, 0x41, 0x17, 0xd5, 0xe9, 0xd3, 0xf1, 0x84,
+	0x32, 0x49, 0x7a, 0x50, 0x4d, 0x1a, 0x3b, 0xdd, 0xea, 0xaa, 0x43, 0x1a, 0xf9, 0x38, 0xf9, 0xcf,
+	0xe9, 0xbc, 0x65, 0x75, 0x06, 0xdd, 0x54, 0x8d, 0x11, 0xb7, 0x61, 0x28, 0xbd, 0x63, 0xbd, 0xd9,
+	0x7f, 0x32, 0xc0, 0xec, 0x0c, 0xba, 0x7d, 0xe9, 0x9e, 0x84, 0x6c, 0x7c, 0x38, 0xa7, 0x4c, 0x8a,
+	0x1e, 0x3b, 0x42, 0x7b, 0xb2, 0x0f, 0x55, 0x8f, 0x33, 0x31, 0x9b, 0xd0, 0xf8, 0x6a, 0x81, 0x40,
+	0x7a, 0xd5, 0xf3, 0xc9, 0x23, 0xdc, 0x84, 0x8c, 0xd1, 0xc8, 0x09, 0x95, 0x98, 0x15, 0xbb, 0xa2,
+	0x6f, 0x7a, 0x3e, 0xd9, 0x83, 0x75, 0x41, 0x7f, 0x9c, 0x51, 0xe6, 0x51, 0xfd, 0x0d, 0xce, 0xce,
+	0x89, 0x29, 0x9b, 0x4d, 0x1c, 0x8a, 0x11, 0xf5, 0x22, 0xae, 0xb0, 0xd9, 0x44, 0x51, 0xe8, 0x3c,
+	0xff, 0xfd, 0xa2, 0x66, 0xbc, 0xb9, 0xa8, 0x19, 0x7f, 0x5d, 0xd4, 0x8c, 0xd7, 0x97, 0xb5, 0xa5,
+	0x37, 0x97, 0xb5, 0xa5, 0x3f, 0x2e, 0x6b, 0x4b, 0xdf, 0x7d, 0x3c, 0x0e, 0x65, 0x30, 0x1b, 0x5a,
+	0x1e, 0x9f, 0x34, 0x75, 0xc6, 0xb8, 0xbd, 0xd2, 0x43, 0xf3, 0xf4, 0xda, 0x3f, 0x69, 0xf2, 0x6c,
+	0x4a, 0xc5, 0x70, 0x15, 0xbf, 0xef, 0x1f, 0xfe, 0x3b, 0x00, 0x25, 0x08, 0x27, 0x5a, 0xca, 0x09,
+	0x00, 0x00,
 }
 
 func (m *IndexedHeader) Marshal() (dAtA []byte, err error) {
@@ -1171,6 +1253,53 @@ func (m *BTCChainSegment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BTCStakingEventsInFlight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCStakingEventsInFlight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCStakingEventsInFlight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumEvents != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.NumEvents))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintZoneconcierge(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintZoneconcierge(dAtA []byte, offset int, v uint64) int {
 	offset -= sovZoneconcierge(v)
 	base := offset
@@ -1370,6 +1499,29 @@ func (m *BTCChainSegment) Size() (n int) {
 	return n
 }
 
+func (m *BTCStakingEventsInFlight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovZoneconcierge(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.Sequence))
+	}
+	if m.NumEvents != 0 {
+		n += 1 + sovZoneconcierge(uint64(m.NumEvents))
+	}
+	return n
+}
+
 func sovZoneconcierge(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2690,6 +2842,158 @@ func (m *BTCChainSegment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BTCStakingEventsInFlight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZoneconcierge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCStakingEventsInFlight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCStakingEventsInFlight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEvents", wireType)
+			}
+			m.NumEvents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZoneconcierge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEvents |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZoneconcierge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthZoneconcierge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipZoneconcierge(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0